@@ -0,0 +1,49 @@
+package converter
+
+import (
+	"config-client/api/config-api/dto/vo"
+	"config-client/config/domain/entity"
+)
+
+// APIKeyConverter API Key 转换器
+type APIKeyConverter struct{}
+
+// NewAPIKeyConverter 创建 API Key 转换器
+func NewAPIKeyConverter() *APIKeyConverter {
+	return &APIKeyConverter{}
+}
+
+// ToVO 将领域实体转换为VO
+func (c *APIKeyConverter) ToVO(key *entity.APIKey) *vo.APIKeyVO {
+	if key == nil {
+		return nil
+	}
+
+	return &vo.APIKeyVO{
+		ID:          key.ID,
+		TenantID:    key.TenantID,
+		Name:        key.Name,
+		Prefix:      key.Prefix,
+		Role:        key.Role,
+		NamespaceID: key.NamespaceID,
+		Environment: key.Environment,
+		KeyPrefixes: key.KeyPrefixes,
+		Description: key.Description,
+		ExpiresAt:   key.ExpiresAt,
+		LastUsedAt:  key.LastUsedAt,
+		Revoked:     key.IsRevoked(),
+		RevokedAt:   key.RevokedAt,
+		RevokedBy:   key.RevokedBy,
+		CreatedBy:   key.CreatedBy,
+		CreatedAt:   key.CreatedAt,
+	}
+}
+
+// ToVOList 批量转换为VO
+func (c *APIKeyConverter) ToVOList(keys []*entity.APIKey) []*vo.APIKeyVO {
+	result := make([]*vo.APIKeyVO, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, c.ToVO(key))
+	}
+	return result
+}
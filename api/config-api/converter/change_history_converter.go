@@ -3,6 +3,7 @@ package converter
 import (
 	"config-client/api/config-api/dto/vo"
 	"config-client/config/domain/entity"
+	domainService "config-client/config/domain/service"
 	shareRepo "config-client/share/repository"
 )
 
@@ -76,5 +77,29 @@ func (c *ChangeHistoryConverter) ToListVO(result *shareRepo.PageResult[*entity.C
 		Size:       result.Size,
 		TotalPages: int((result.Total + int64(result.Size) - 1) / int64(result.Size)),
 		Items:      items,
+		NextCursor: result.NextCursor,
 	}
 }
+
+// ToValueDiffVO 转换配置值差异
+func (c *ChangeHistoryConverter) ToValueDiffVO(diff *domainService.ValueDiff) *vo.ValueDiffVO {
+	return toValueDiffVO(diff)
+}
+
+// toValueDiffVO 转换配置值差异（变更历史与版本对比共用）
+func toValueDiffVO(diff *domainService.ValueDiff) *vo.ValueDiffVO {
+	if diff == nil {
+		return nil
+	}
+
+	diffVO := &vo.ValueDiffVO{UnifiedDiff: diff.UnifiedDiff}
+	for _, change := range diff.Changes {
+		diffVO.Changes = append(diffVO.Changes, vo.FieldChangeVO{
+			Path:     change.Path,
+			Type:     change.Type,
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+		})
+	}
+	return diffVO
+}
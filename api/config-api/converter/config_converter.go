@@ -4,7 +4,9 @@ import (
 	"config-client/api/config-api/dto/vo"
 	"config-client/config/domain/constants"
 	"config-client/config/domain/entity"
+	"config-client/config/domain/repository"
 	domainService "config-client/config/domain/service"
+	shareRepo "config-client/share/repository"
 )
 
 // ConfigConverter API层配置转换器，负责领域实体和视图对象之间的转换
@@ -47,7 +49,7 @@ func (c *ConfigConverter) ToVO(do *entity.Config) *vo.ConfigVO {
 		isMasked = true
 	}
 
-	return &vo.ConfigVO{
+	configVO := &vo.ConfigVO{
 		ID:                   do.ID,
 		NamespaceID:          do.NamespaceID,
 		Key:                  do.Key,
@@ -65,11 +67,17 @@ func (c *ConfigConverter) ToVO(do *entity.Config) *vo.ConfigVO {
 		ContentHash:          do.ContentHash,
 		ContentHashAlgorithm: do.ContentHashAlgorithm,
 		Tags:                 nil, // 标签需要单独查询和填充
+		ExpiresAt:            do.ExpiresAt,
 		CreatedBy:            do.CreatedBy,
 		UpdatedBy:            do.UpdatedBy,
 		CreatedAt:            do.CreatedAt,
 		UpdatedAt:            do.UpdatedAt,
 	}
+	if do.DeletedAt.Valid {
+		deletedAt := do.DeletedAt.Time
+		configVO.DeletedAt = &deletedAt
+	}
+	return configVO
 }
 
 // ToVOList 批量转换为视图对象列表
@@ -101,6 +109,67 @@ func (c *ConfigConverter) ToListVO(dos []*entity.Config, total int64, page, size
 	}
 }
 
+// configSearchFields 搜索结果中命中字段的输出顺序
+var configSearchFields = []string{
+	repository.ConfigSearchFieldKey,
+	repository.ConfigSearchFieldGroup,
+	repository.ConfigSearchFieldDescription,
+	repository.ConfigSearchFieldValue,
+}
+
+// ToSearchListVO 将搜索结果转换为列表VO，并生成命中字段的高亮片段
+// 脱敏或加密的配置值不参与高亮，避免泄露明文
+func (c *ConfigConverter) ToSearchListVO(result *shareRepo.PageResult[*entity.Config], query string, fields []string) *vo.ConfigSearchListVO {
+	searchFields := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		searchFields[field] = true
+	}
+	searchAll := len(fields) == 0
+
+	items := make([]*vo.ConfigSearchItemVO, 0, len(result.Items))
+	for _, do := range result.Items {
+		configVO := c.ToVO(do)
+		item := &vo.ConfigSearchItemVO{
+			Config:        configVO,
+			MatchedFields: make([]string, 0, 1),
+			Highlights:    make(map[string]string),
+		}
+
+		texts := map[string]string{
+			repository.ConfigSearchFieldKey:         configVO.Key,
+			repository.ConfigSearchFieldDescription: configVO.Description,
+			repository.ConfigSearchFieldGroup:       configVO.GroupName,
+		}
+		if !configVO.IsMasked && configVO.ValueType != constants.ValueTypeEncrypted {
+			texts[repository.ConfigSearchFieldValue] = configVO.Value
+		}
+		for _, field := range configSearchFields {
+			text, ok := texts[field]
+			if !ok || (!searchAll && !searchFields[field]) {
+				continue
+			}
+			if fragment, ok := highlight(text, query); ok {
+				item.MatchedFields = append(item.MatchedFields, field)
+				item.Highlights[field] = fragment
+			}
+		}
+		items = append(items, item)
+	}
+
+	totalPages := int(result.Total) / result.Size
+	if int(result.Total)%result.Size != 0 {
+		totalPages++
+	}
+
+	return &vo.ConfigSearchListVO{
+		Total:      result.Total,
+		Page:       result.Page,
+		Size:       result.Size,
+		TotalPages: totalPages,
+		Items:      items,
+	}
+}
+
 // ToVOWithTags 将领域实体转换为视图对象（包含标签）
 // 此方法需要传入标签列表，由调用方负责查询标签
 func (c *ConfigConverter) ToVOWithTags(do *entity.Config, tags []*entity.ConfigTag) *vo.ConfigVO {
@@ -110,3 +179,73 @@ func (c *ConfigConverter) ToVOWithTags(do *entity.Config, tags []*entity.ConfigT
 	}
 	return configVO
 }
+
+// ApplyResolved 将引用解析结果填充到视图对象
+// 配置自身或被引用的配置为敏感配置时，解析后的值进行脱敏
+func (c *ConfigConverter) ApplyResolved(configVO *vo.ConfigVO, resolved *domainService.ResolvedConfig, resolveErr error) {
+	if configVO == nil {
+		return
+	}
+	if resolveErr != nil {
+		configVO.ReferenceError = resolveErr.Error()
+		return
+	}
+	if resolved == nil {
+		return
+	}
+
+	configVO.References = resolved.References
+	configVO.ResolvedValue = resolved.Value
+	if (configVO.IsSensitive || resolved.Sensitive) && c.maskingSvc != nil {
+		configVO.ResolvedValue = c.maskingSvc.MaskValue(resolved.Value)
+	}
+}
+
+// ToEffectiveItemVO 将生效配置及其引用解析结果转换为视图对象
+func (c *ConfigConverter) ToEffectiveItemVO(do *entity.Config, resolved *domainService.ResolvedConfig, resolveErr error) *vo.EffectiveConfigItemVO {
+	item := &vo.EffectiveConfigItemVO{
+		Key:         do.Key,
+		Value:       do.Value,
+		ValueType:   do.ValueType,
+		GroupName:   do.GroupName,
+		Environment: do.Environment,
+		Version:     do.Version,
+	}
+	if c.maskingSvc != nil {
+		item.IsSensitive = c.maskingSvc.IsSensitiveKey(do.Key) || do.ValueType == constants.ValueTypeEncrypted
+	}
+
+	if resolveErr != nil {
+		item.Error = resolveErr.Error()
+	} else if resolved != nil {
+		item.Value = resolved.Value
+		item.References = resolved.References
+		item.IsSensitive = item.IsSensitive || resolved.Sensitive
+	}
+
+	if item.IsSensitive && c.maskingSvc != nil {
+		item.Value = c.maskingSvc.MaskValue(item.Value)
+	}
+	return item
+}
+
+// ToBatchMutationVO 将批量变更结果转换为VO
+func (c *ConfigConverter) ToBatchMutationVO(result *domainService.BatchMutationResult) *vo.BatchMutationVO {
+	items := make([]*vo.BatchMutationItemVO, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, &vo.BatchMutationItemVO{
+			Index:    item.Index,
+			Action:   string(item.Action),
+			Key:      item.Key,
+			ConfigID: item.ConfigID,
+			Version:  item.Version,
+			Status:   string(item.Status),
+			Error:    item.Error,
+		})
+	}
+	return &vo.BatchMutationVO{
+		Committed: result.Committed,
+		Total:     len(items),
+		Items:     items,
+	}
+}
@@ -0,0 +1,115 @@
+package converter
+
+import (
+	"config-client/api/config-api/dto/vo"
+	"config-client/config/domain/entity"
+	domainService "config-client/config/domain/service"
+)
+
+// ConfigDependencyConverter API层配置依赖转换器
+type ConfigDependencyConverter struct{}
+
+// NewConfigDependencyConverter 创建配置依赖转换器实例
+func NewConfigDependencyConverter() *ConfigDependencyConverter {
+	return &ConfigDependencyConverter{}
+}
+
+// ToDependenciesVO 将配置显式依赖转换为视图对象（DO -> VO）
+func (c *ConfigDependencyConverter) ToDependenciesVO(dependencies *domainService.ConfigDependencies) *vo.ConfigDependenciesVO {
+	if dependencies == nil {
+		return nil
+	}
+
+	return &vo.ConfigDependenciesVO{
+		Config:     c.toNodeVO(dependencies.Config, "", false),
+		DependsOn:  c.toNodeVOList(dependencies.DependsOn),
+		DependedBy: c.toNodeVOList(dependencies.DependedBy),
+	}
+}
+
+// ToGraphVO 将依赖图转换为视图对象（DO -> VO）
+func (c *ConfigDependencyConverter) ToGraphVO(graph *domainService.DependencyGraph) *vo.ConfigDependencyGraphVO {
+	if graph == nil {
+		return nil
+	}
+
+	graphVO := &vo.ConfigDependencyGraphVO{
+		Nodes: make([]*vo.ConfigDependencyNodeVO, 0, len(graph.Nodes)),
+		Edges: make([]*vo.ConfigDependencyEdgeVO, 0, len(graph.Edges)),
+	}
+	for _, node := range graph.Nodes {
+		graphVO.Nodes = append(graphVO.Nodes, c.toNodeVO(node.Config, node.NamespaceName, node.External))
+	}
+	for _, edge := range graph.Edges {
+		graphVO.Edges = append(graphVO.Edges, &vo.ConfigDependencyEdgeVO{
+			FromConfigID: edge.FromConfigID,
+			ToConfigID:   edge.ToConfigID,
+			Type:         edge.Type,
+		})
+	}
+	return graphVO
+}
+
+// ToImpactVO 将影响分析结果转换为视图对象（DO -> VO）
+func (c *ConfigDependencyConverter) ToImpactVO(analysis *domainService.ImpactAnalysis) *vo.ConfigImpactAnalysisVO {
+	if analysis == nil {
+		return nil
+	}
+
+	impactVO := &vo.ConfigImpactAnalysisVO{
+		Config:        c.toNodeVO(analysis.Config, "", false),
+		Impacted:      make([]*vo.ImpactedConfigVO, 0, len(analysis.Impacted)),
+		Subscribers:   make([]*vo.ImpactedSubscribersVO, 0, len(analysis.Subscribers)),
+		ImpactedCount: len(analysis.Impacted),
+		Truncated:     analysis.Truncated,
+	}
+	for _, impacted := range analysis.Impacted {
+		impactVO.Impacted = append(impactVO.Impacted, &vo.ImpactedConfigVO{
+			ConfigDependencyNodeVO: c.toNodeVO(impacted.Config, impacted.NamespaceName, false),
+			Depth:                  impacted.Depth,
+			DependencyType:         impacted.DependencyType,
+			ViaConfigID:            impacted.ViaConfigID,
+		})
+	}
+	clients := make(map[string]bool)
+	for _, subscribers := range analysis.Subscribers {
+		impactVO.Subscribers = append(impactVO.Subscribers, &vo.ImpactedSubscribersVO{
+			NamespaceID:   subscribers.NamespaceID,
+			NamespaceName: subscribers.NamespaceName,
+			Environment:   subscribers.Environment,
+			ClientIDs:     subscribers.ClientIDs,
+		})
+		for _, clientID := range subscribers.ClientIDs {
+			clients[clientID] = true
+		}
+	}
+	impactVO.SubscriberCount = len(clients)
+	return impactVO
+}
+
+// toNodeVO 将配置转换为依赖节点视图对象
+func (c *ConfigDependencyConverter) toNodeVO(config *entity.Config, namespaceName string, external bool) *vo.ConfigDependencyNodeVO {
+	if config == nil {
+		return nil
+	}
+
+	return &vo.ConfigDependencyNodeVO{
+		ConfigID:      config.ID,
+		NamespaceID:   config.NamespaceID,
+		NamespaceName: namespaceName,
+		Key:           config.Key,
+		Environment:   config.Environment,
+		GroupName:     config.GroupName,
+		IsReleased:    config.IsReleased,
+		External:      external,
+	}
+}
+
+// toNodeVOList 批量转换依赖节点
+func (c *ConfigDependencyConverter) toNodeVOList(configs []*entity.Config) []*vo.ConfigDependencyNodeVO {
+	result := make([]*vo.ConfigDependencyNodeVO, 0, len(configs))
+	for _, config := range configs {
+		result = append(result, c.toNodeVO(config, "", false))
+	}
+	return result
+}
@@ -0,0 +1,46 @@
+package converter
+
+import (
+	"config-client/api/config-api/dto/vo"
+	domainService "config-client/config/domain/service"
+)
+
+// ConfigFileConverter API层文件配置转换器
+type ConfigFileConverter struct{}
+
+// NewConfigFileConverter 创建文件配置转换器实例
+func NewConfigFileConverter() *ConfigFileConverter {
+	return &ConfigFileConverter{}
+}
+
+// ToVO 将文件配置转换为视图对象（DO -> VO），不包含文件内容
+func (c *ConfigFileConverter) ToVO(result *domainService.ConfigFileResult) *vo.ConfigFileVO {
+	if result == nil || result.Config == nil || result.File == nil {
+		return nil
+	}
+
+	return &vo.ConfigFileVO{
+		ConfigID:    result.Config.ID,
+		NamespaceID: result.Config.NamespaceID,
+		Key:         result.Config.Key,
+		Environment: result.Config.Environment,
+		Version:     result.Config.Version,
+		IsReleased:  result.Config.IsReleased,
+		FileName:    result.File.FileName,
+		ContentType: result.File.ContentType,
+		Size:        result.File.Size,
+		SHA256:      result.File.SHA256,
+		UploadedBy:  result.File.CreatedBy,
+		UpdatedAt:   result.Config.UpdatedAt,
+	}
+}
+
+// ToUploadVO 将上传结果转换为视图对象，附带内容是否变化
+func (c *ConfigFileConverter) ToUploadVO(result *domainService.ConfigFileResult) *vo.ConfigFileVO {
+	fileVO := c.ToVO(result)
+	if fileVO != nil {
+		changed := result.Changed
+		fileVO.Changed = &changed
+	}
+	return fileVO
+}
@@ -0,0 +1,104 @@
+package converter
+
+import (
+	"time"
+
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/dto/vo"
+	"config-client/config/domain/entity"
+	domainService "config-client/config/domain/service"
+)
+
+// ConfigGroupConverter API层配置分组转换器
+type ConfigGroupConverter struct{}
+
+// NewConfigGroupConverter 创建配置分组转换器实例
+func NewConfigGroupConverter() *ConfigGroupConverter {
+	return &ConfigGroupConverter{}
+}
+
+// ToEntity 将创建请求转换为领域实体（Request -> DO）
+func (c *ConfigGroupConverter) ToEntity(req *request.CreateConfigGroupRequest) *entity.ConfigGroup {
+	if req == nil {
+		return nil
+	}
+
+	group := &entity.ConfigGroup{
+		NamespaceID: req.NamespaceID,
+		Name:        req.Name,
+		Description: req.Description,
+		SortOrder:   req.SortOrder,
+	}
+	group.CreatedBy = req.CreatedBy
+	group.UpdatedBy = req.CreatedBy
+	return group
+}
+
+// ToVO 将分组概览转换为视图对象（DO -> VO）
+func (c *ConfigGroupConverter) ToVO(summary *domainService.ConfigGroupSummary) *vo.ConfigGroupVO {
+	if summary == nil || summary.Group == nil {
+		return nil
+	}
+
+	group := summary.Group
+	groupVO := &vo.ConfigGroupVO{
+		ID:          group.ID,
+		NamespaceID: group.NamespaceID,
+		Name:        group.Name,
+		Description: group.Description,
+		SortOrder:   group.SortOrder,
+		Registered:  summary.Registered,
+		Stats:       &vo.ConfigGroupStatsVO{},
+	}
+	if summary.Registered {
+		groupVO.CreatedBy = group.CreatedBy
+		groupVO.UpdatedBy = group.UpdatedBy
+		groupVO.CreatedAt = timePtr(group.CreatedAt)
+		groupVO.UpdatedAt = timePtr(group.UpdatedAt)
+	}
+	if stats := summary.Stats; stats != nil {
+		groupVO.Stats = &vo.ConfigGroupStatsVO{
+			Total:         stats.Total,
+			Released:      stats.Released,
+			Active:        stats.Active,
+			LastUpdatedAt: stats.LastUpdatedAt,
+		}
+	}
+	return groupVO
+}
+
+// ToVOList 批量转换为视图对象列表
+func (c *ConfigGroupConverter) ToVOList(summaries []*domainService.ConfigGroupSummary) []*vo.ConfigGroupVO {
+	vos := make([]*vo.ConfigGroupVO, 0, len(summaries))
+	for _, summary := range summaries {
+		vos = append(vos, c.ToVO(summary))
+	}
+	return vos
+}
+
+// ToReleaseVO 将分组发布结果转换为视图对象
+func (c *ConfigGroupConverter) ToReleaseVO(result *domainService.ConfigGroupReleaseResult) *vo.ConfigGroupReleaseVO {
+	if result == nil {
+		return nil
+	}
+
+	releasedIDs := result.ReleasedConfigIDs
+	if releasedIDs == nil {
+		releasedIDs = []int{}
+	}
+	return &vo.ConfigGroupReleaseVO{
+		GroupID:           result.Group.ID,
+		GroupName:         result.Group.Name,
+		Environment:       result.Environment,
+		ReleasedCount:     len(releasedIDs),
+		ReleasedConfigIDs: releasedIDs,
+	}
+}
+
+// timePtr 返回时间指针，零值返回 nil
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,63 @@
+package converter
+
+import (
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/dto/vo"
+	"config-client/config/domain/entity"
+)
+
+// ConfigSchemaConverter API层配置 Schema 转换器
+type ConfigSchemaConverter struct{}
+
+// NewConfigSchemaConverter 创建配置 Schema 转换器实例
+func NewConfigSchemaConverter() *ConfigSchemaConverter {
+	return &ConfigSchemaConverter{}
+}
+
+// ToEntity 将创建请求转换为领域实体（Request -> DO）
+func (c *ConfigSchemaConverter) ToEntity(req *request.CreateConfigSchemaRequest) *entity.ConfigSchema {
+	if req == nil {
+		return nil
+	}
+
+	schema := &entity.ConfigSchema{
+		NamespaceID: req.NamespaceID,
+		ConfigKey:   req.ConfigKey,
+		GroupName:   req.GroupName,
+		Schema:      req.Schema,
+		Description: req.Description,
+	}
+	schema.CreatedBy = req.CreatedBy
+	schema.UpdatedBy = req.CreatedBy
+	return schema
+}
+
+// ToVO 将领域实体转换为视图对象（DO -> VO）
+func (c *ConfigSchemaConverter) ToVO(do *entity.ConfigSchema) *vo.ConfigSchemaVO {
+	if do == nil {
+		return nil
+	}
+
+	return &vo.ConfigSchemaVO{
+		ID:          do.ID,
+		NamespaceID: do.NamespaceID,
+		ConfigKey:   do.ConfigKey,
+		GroupName:   do.GroupName,
+		Schema:      do.Schema,
+		Description: do.Description,
+		IsActive:    do.IsActive,
+		CreatedBy:   do.CreatedBy,
+		UpdatedBy:   do.UpdatedBy,
+		CreatedAt:   do.CreatedAt,
+		UpdatedAt:   do.UpdatedAt,
+	}
+}
+
+// ToVOList 批量转换为视图对象列表
+func (c *ConfigSchemaConverter) ToVOList(dos []*entity.ConfigSchema) []*vo.ConfigSchemaVO {
+	vos := make([]*vo.ConfigSchemaVO, 0, len(dos))
+	for _, do := range dos {
+		vos = append(vos, c.ToVO(do))
+	}
+	return vos
+}
@@ -0,0 +1,96 @@
+package converter
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+const (
+	// highlightPreTag 高亮片段中命中部分的起始标签
+	highlightPreTag = "<mark>"
+	// highlightPostTag 高亮片段中命中部分的结束标签
+	highlightPostTag = "</mark>"
+	// highlightFragmentContext 高亮片段中首个命中位置前后保留的字符数
+	highlightFragmentContext = 40
+)
+
+// highlight 生成文本中关键字（不区分大小写）的高亮片段
+// 片段以首个命中位置为中心截取，前后各保留 40 个字符，截断处以省略号表示；
+// 非命中部分做 HTML 转义，可直接渲染。未命中时返回 false
+func highlight(text, query string) (string, bool) {
+	textRunes := []rune(text)
+	queryRunes := toLowerRunes([]rune(query))
+	if len(queryRunes) == 0 || len(textRunes) < len(queryRunes) {
+		return "", false
+	}
+
+	// 1. 查找所有不重叠的命中区间
+	lowerRunes := toLowerRunes(textRunes)
+	var matches [][2]int
+	for i := 0; i+len(queryRunes) <= len(lowerRunes); {
+		if runesEqual(lowerRunes[i:i+len(queryRunes)], queryRunes) {
+			matches = append(matches, [2]int{i, i + len(queryRunes)})
+			i += len(queryRunes)
+			continue
+		}
+		i++
+	}
+	if len(matches) == 0 {
+		return "", false
+	}
+
+	// 2. 以首个命中位置为中心截取片段
+	start := matches[0][0] - highlightFragmentContext
+	if start < 0 {
+		start = 0
+	}
+	end := matches[0][1] + highlightFragmentContext
+	if end > len(textRunes) {
+		end = len(textRunes)
+	}
+
+	// 3. 拼接片段，包裹片段内的命中部分
+	var sb strings.Builder
+	if start > 0 {
+		sb.WriteString("…")
+	}
+	pos := start
+	for _, m := range matches {
+		if m[1] > end {
+			break
+		}
+		sb.WriteString(html.EscapeString(string(textRunes[pos:m[0]])))
+		sb.WriteString(highlightPreTag)
+		sb.WriteString(html.EscapeString(string(textRunes[m[0]:m[1]])))
+		sb.WriteString(highlightPostTag)
+		pos = m[1]
+	}
+	sb.WriteString(html.EscapeString(string(textRunes[pos:end])))
+	if end < len(textRunes) {
+		sb.WriteString("…")
+	}
+	return sb.String(), true
+}
+
+// toLowerRunes 逐字符转换为小写（保持字符数不变，便于按下标映射回原文）
+func toLowerRunes(runes []rune) []rune {
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+	return lower
+}
+
+// runesEqual 判断两个字符切片是否相等
+func runesEqual(a, b []rune) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
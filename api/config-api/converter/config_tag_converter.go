@@ -1,6 +1,7 @@
 package converter
 
 import (
+	"config-client/api/config-api/dto/request"
 	"config-client/api/config-api/dto/vo"
 	"config-client/config/domain/entity"
 )
@@ -50,6 +51,13 @@ func (c *ConfigTagConverter) ToTagListVO(configID int, tags []*entity.ConfigTag)
 }
 
 // ToDomainInputs 将请求DTO转换为领域实体输入
-func (c *ConfigTagConverter) ToDomainInputs(inputs []entity.TagInput) []entity.TagInput {
-	return inputs // TagInput在domain和api层使用相同结构
+func (c *ConfigTagConverter) ToDomainInputs(inputs []request.TagInput) []entity.TagInput {
+	result := make([]entity.TagInput, 0, len(inputs))
+	for _, input := range inputs {
+		result = append(result, entity.TagInput{
+			TagKey:   input.TagKey,
+			TagValue: input.TagValue,
+		})
+	}
+	return result
 }
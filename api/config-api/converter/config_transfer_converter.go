@@ -0,0 +1,148 @@
+package converter
+
+import (
+	"fmt"
+
+	"config-client/api/config-api/dto/vo"
+	domainService "config-client/config/domain/service"
+)
+
+// ConfigTransferConverter API层导入导出转换器
+type ConfigTransferConverter struct {
+	namespaceConverter *NamespaceConverter
+}
+
+// NewConfigTransferConverter 创建导入导出转换器实例
+func NewConfigTransferConverter() *ConfigTransferConverter {
+	return &ConfigTransferConverter{
+		namespaceConverter: NewNamespaceConverter(),
+	}
+}
+
+// ToImportResultVO 将导入结果转换为VO
+func (c *ConfigTransferConverter) ToImportResultVO(result *domainService.ImportResult) *vo.ConfigImportResultVO {
+	if result == nil {
+		return nil
+	}
+
+	items := make([]*vo.ConfigImportItemVO, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, &vo.ConfigImportItemVO{
+			Key:       item.Key,
+			ValueType: item.ValueType,
+			Action:    string(item.Action),
+			Reason:    item.Reason,
+		})
+	}
+
+	return &vo.ConfigImportResultVO{
+		DryRun:    result.DryRun,
+		Total:     result.Total,
+		Created:   result.Created,
+		Updated:   result.Updated,
+		Skipped:   result.Skipped,
+		Unchanged: result.Unchanged,
+		Failed:    result.Failed,
+		Items:     items,
+	}
+}
+
+// ToExportVO 将导出结果转换为VO
+func (c *ConfigTransferConverter) ToExportVO(result *domainService.ExportResult) *vo.ConfigExportVO {
+	if result == nil {
+		return nil
+	}
+
+	filename := fmt.Sprintf("%s-%s.%s", result.NamespaceName, result.Environment, result.Format)
+	if result.GroupName != "" {
+		filename = fmt.Sprintf("%s-%s-%s.%s", result.NamespaceName, result.GroupName, result.Environment, result.Format)
+	}
+
+	return &vo.ConfigExportVO{
+		Filename:    filename,
+		ContentType: result.ContentType,
+		Content:     result.Content,
+		Count:       result.Count,
+	}
+}
+
+// ToPromotionDiffVO 将晋升差异转换为VO
+func (c *ConfigTransferConverter) ToPromotionDiffVO(diff *domainService.PromotionDiff) *vo.PromotionDiffVO {
+	if diff == nil {
+		return nil
+	}
+
+	return &vo.PromotionDiffVO{
+		NamespaceID:       diff.NamespaceID,
+		SourceEnvironment: diff.SourceEnvironment,
+		TargetEnvironment: diff.TargetEnvironment,
+		Added:             c.toPromotionDiffItemVOs(diff.Added),
+		Modified:          c.toPromotionDiffItemVOs(diff.Modified),
+		Deleted:           c.toPromotionDiffItemVOs(diff.Deleted),
+		DiffToken:         diff.DiffToken,
+	}
+}
+
+// toPromotionDiffItemVOs 批量转换晋升差异项
+func (c *ConfigTransferConverter) toPromotionDiffItemVOs(items []*domainService.PromotionDiffItem) []*vo.PromotionDiffItemVO {
+	vos := make([]*vo.PromotionDiffItemVO, 0, len(items))
+	for _, item := range items {
+		vos = append(vos, &vo.PromotionDiffItemVO{
+			Key:            item.Key,
+			ValueType:      item.ValueType,
+			OldValue:       item.OldValue,
+			NewValue:       item.NewValue,
+			IsSensitive:    item.IsSensitive,
+			TargetReleased: item.TargetReleased,
+		})
+	}
+	return vos
+}
+
+// ToPromotionResultVO 将晋升结果转换为VO
+func (c *ConfigTransferConverter) ToPromotionResultVO(result *domainService.PromotionResult) *vo.PromotionResultVO {
+	if result == nil {
+		return nil
+	}
+
+	items := make([]*vo.PromotionItemResultVO, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, &vo.PromotionItemResultVO{
+			Key:    item.Key,
+			Action: string(item.Action),
+			Reason: item.Reason,
+		})
+	}
+
+	return &vo.PromotionResultVO{
+		Diff:    c.ToPromotionDiffVO(result.Diff),
+		Applied: result.Applied,
+		Skipped: result.Skipped,
+		Failed:  result.Failed,
+		Items:   items,
+	}
+}
+
+// ToCloneVO 将命名空间克隆结果转换为VO
+func (c *ConfigTransferConverter) ToCloneVO(result *domainService.CloneResult) *vo.NamespaceCloneVO {
+	if result == nil {
+		return nil
+	}
+
+	items := make([]*vo.NamespaceCloneItemVO, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, &vo.NamespaceCloneItemVO{
+			Key:         item.Key,
+			Environment: item.Environment,
+			Success:     item.Success,
+			Reason:      item.Reason,
+		})
+	}
+
+	return &vo.NamespaceCloneVO{
+		Namespace: c.namespaceConverter.ToVO(result.Namespace),
+		Cloned:    result.Cloned,
+		Failed:    result.Failed,
+		Items:     items,
+	}
+}
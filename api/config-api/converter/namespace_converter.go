@@ -4,6 +4,7 @@ import (
 	"config-client/api/config-api/dto/request"
 	"config-client/api/config-api/dto/vo"
 	"config-client/config/domain/entity"
+	domainService "config-client/config/domain/service"
 )
 
 // NamespaceConverter 命名空间转换器，负责 DTO 和领域实体之间的转换
@@ -43,15 +44,22 @@ func (c *NamespaceConverter) ToVO(do *entity.Namespace) *vo.NamespaceVO {
 
 	return &vo.NamespaceVO{
 		ID:          do.ID,
+		TenantID:    do.TenantID,
 		Name:        do.Name,
 		DisplayName: do.DisplayName,
 		Description: do.Description,
 		IsActive:    do.IsActive,
 		Metadata:    do.Metadata,
-		CreatedBy:   do.CreatedBy,
-		UpdatedBy:   do.UpdatedBy,
-		CreatedAt:   do.CreatedAt,
-		UpdatedAt:   do.UpdatedAt,
+		Quota: vo.NamespaceQuotaVO{
+			MaxConfigs:          do.Quota.MaxConfigs,
+			MaxValueSize:        do.Quota.MaxValueSize,
+			MaxSubscribers:      do.Quota.MaxSubscribers,
+			MaxReleasesRetained: do.Quota.MaxReleasesRetained,
+		},
+		CreatedBy: do.CreatedBy,
+		UpdatedBy: do.UpdatedBy,
+		CreatedAt: do.CreatedAt,
+		UpdatedAt: do.UpdatedAt,
 	}
 }
 
@@ -83,3 +91,37 @@ func (c *NamespaceConverter) UpdateEntityFromRequest(entity *entity.Namespace, r
 		entity.Metadata = "{}"
 	}
 }
+
+// ToQuota 将设置配额请求转换为领域值对象
+func (c *NamespaceConverter) ToQuota(req *request.UpdateNamespaceQuotaRequest) entity.NamespaceQuota {
+	return entity.NamespaceQuota{
+		MaxConfigs:          req.MaxConfigs,
+		MaxValueSize:        req.MaxValueSize,
+		MaxSubscribers:      req.MaxSubscribers,
+		MaxReleasesRetained: req.MaxReleasesRetained,
+	}
+}
+
+// ToStatsVO 将命名空间用量统计转换为视图对象
+func (c *NamespaceConverter) ToStatsVO(stats *domainService.NamespaceStats) *vo.NamespaceStatsVO {
+	if stats == nil {
+		return nil
+	}
+
+	releases := make([]*vo.ReleaseQuotaUsageVO, 0, len(stats.Releases))
+	for _, stat := range stats.Releases {
+		releases = append(releases, &vo.ReleaseQuotaUsageVO{
+			Environment: stat.Environment,
+			Used:        stat.Total,
+			Limit:       stats.Quota.MaxReleasesRetained,
+		})
+	}
+
+	return &vo.NamespaceStatsVO{
+		NamespaceID: stats.NamespaceID,
+		Configs:     &vo.QuotaUsageVO{Used: stats.Configs, Limit: stats.Quota.MaxConfigs},
+		ValueSize:   &vo.QuotaUsageVO{Used: stats.LargestValueSize, Limit: stats.Quota.MaxValueSize},
+		Subscribers: &vo.QuotaUsageVO{Used: stats.Subscribers, Limit: stats.Quota.MaxSubscribers},
+		Releases:    releases,
+	}
+}
@@ -0,0 +1,50 @@
+package converter
+
+import (
+	"config-client/api/config-api/dto/vo"
+	"config-client/config/domain/entity"
+)
+
+// NotificationChannelConverter 通知渠道转换器
+type NotificationChannelConverter struct{}
+
+// NewNotificationChannelConverter 创建通知渠道转换器
+func NewNotificationChannelConverter() *NotificationChannelConverter {
+	return &NotificationChannelConverter{}
+}
+
+// ToVO 将领域实体转换为VO
+func (c *NotificationChannelConverter) ToVO(channel *entity.NotificationChannel) *vo.NotificationChannelVO {
+	if channel == nil {
+		return nil
+	}
+
+	events := channel.Events
+	if events == nil {
+		events = []string{}
+	}
+	return &vo.NotificationChannelVO{
+		ID:          channel.ID,
+		NamespaceID: channel.NamespaceID,
+		Name:        channel.Name,
+		Type:        channel.Type,
+		Target:      channel.Target,
+		HasSecret:   channel.Secret != "",
+		Events:      events,
+		Template:    channel.Template,
+		Enabled:     channel.Enabled,
+		CreatedBy:   channel.CreatedBy,
+		UpdatedBy:   channel.UpdatedBy,
+		CreatedAt:   channel.CreatedAt,
+		UpdatedAt:   channel.UpdatedAt,
+	}
+}
+
+// ToVOList 批量转换为VO
+func (c *NotificationChannelConverter) ToVOList(channels []*entity.NotificationChannel) []*vo.NotificationChannelVO {
+	result := make([]*vo.NotificationChannelVO, 0, len(channels))
+	for _, channel := range channels {
+		result = append(result, c.ToVO(channel))
+	}
+	return result
+}
@@ -29,6 +29,11 @@ func (c *ReleaseConverter) ToVO(release *entity.Release, includeSnapshot bool) *
 		ConfigCount:         release.ConfigCount,
 		Status:              string(release.Status),
 		ReleaseType:         string(release.ReleaseType),
+		BaseReleaseID:       release.BaseReleaseID,
+		ReleaseNotes:        release.ReleaseNotes,
+		ApprovalStatus:      string(release.ApprovalStatus),
+		ApprovedBy:          release.ApprovedBy,
+		ApprovedAt:          release.ApprovedAt,
 		CanaryPercentage:    release.CanaryPercentage,
 		ReleasedBy:          release.ReleasedBy,
 		ReleasedAt:          release.ReleasedAt,
@@ -69,6 +74,7 @@ func (c *ReleaseConverter) ToVO(release *entity.Release, includeSnapshot bool) *
 					ContentHashAlgorithm: item.ContentHashAlgorithm,
 					Description:          item.Description,
 					Version:              item.Version,
+					Deleted:              item.Deleted,
 				})
 			}
 		}
@@ -90,6 +96,72 @@ func (c *ReleaseConverter) ToVOList(releases []*entity.Release) []*vo.ReleaseVO
 	return vos
 }
 
+// ToChangelogVO 转换发布版本变更日志
+// 历史版本没有记录变更明细时，变更列表为空
+func (c *ReleaseConverter) ToChangelogVO(release *entity.Release, changelog *entity.ReleaseChangelog) *vo.ReleaseChangelogVO {
+	if release == nil {
+		return nil
+	}
+
+	changelogVO := &vo.ReleaseChangelogVO{
+		ReleaseID:    release.ID,
+		Version:      release.Version,
+		VersionName:  release.VersionName,
+		Status:       string(release.Status),
+		ReleaseNotes: release.ReleaseNotes,
+		Added:        []string{},
+		Modified:     []string{},
+		Deleted:      []string{},
+		Text:         release.RenderChangelog(changelog),
+	}
+	if changelog != nil {
+		changelogVO.BaseReleaseID = changelog.BaseReleaseID
+		changelogVO.BaseVersion = changelog.BaseVersion
+		changelogVO.Added = changelog.Added
+		changelogVO.Modified = changelog.Modified
+		changelogVO.Deleted = changelog.Deleted
+	}
+	return changelogVO
+}
+
+// ToApprovalVOList 转换发布审批记录列表
+func (c *ReleaseConverter) ToApprovalVOList(approvals []*entity.ReleaseApproval) []*vo.ReleaseApprovalVO {
+	vos := make([]*vo.ReleaseApprovalVO, 0, len(approvals))
+	for _, approval := range approvals {
+		vos = append(vos, &vo.ReleaseApprovalVO{
+			ID:          approval.ID,
+			ReleaseID:   approval.ReleaseID,
+			NamespaceID: approval.NamespaceID,
+			Environment: approval.Environment,
+			Version:     approval.Version,
+			Action:      approval.Action,
+			Operator:    approval.Operator,
+			Comment:     approval.Comment,
+			CreatedAt:   approval.CreatedAt,
+		})
+	}
+	return vos
+}
+
+// ToFreezeOverrideVOList 转换冻结窗口覆盖记录列表
+func (c *ReleaseConverter) ToFreezeOverrideVOList(overrides []*entity.ReleaseFreezeOverride) []*vo.ReleaseFreezeOverrideVO {
+	vos := make([]*vo.ReleaseFreezeOverrideVO, 0, len(overrides))
+	for _, override := range overrides {
+		vos = append(vos, &vo.ReleaseFreezeOverrideVO{
+			ID:          override.ID,
+			ReleaseID:   override.ReleaseID,
+			NamespaceID: override.NamespaceID,
+			Environment: override.Environment,
+			WindowName:  override.WindowName,
+			Operation:   override.Operation,
+			Operator:    override.Operator,
+			Reason:      override.Reason,
+			CreatedAt:   override.CreatedAt,
+		})
+	}
+	return vos
+}
+
 // ToCompareVO 转换版本对比结果
 func (c *ReleaseConverter) ToCompareVO(result *domainService.ReleaseCompareResult) *vo.ReleaseCompareVO {
 	if result == nil {
@@ -97,6 +169,7 @@ func (c *ReleaseConverter) ToCompareVO(result *domainService.ReleaseCompareResul
 	}
 
 	compareVO := &vo.ReleaseCompareVO{
+		FromLive:      result.FromLive,
 		FromReleaseID: result.FromReleaseID,
 		ToReleaseID:   result.ToReleaseID,
 		FromVersion:   result.FromVersion,
@@ -139,9 +212,11 @@ func (c *ReleaseConverter) ToCompareVO(result *domainService.ReleaseCompareResul
 	// 转换修改的配置
 	for _, diff := range result.Modified {
 		compareVO.Modified = append(compareVO.Modified, vo.ConfigDiffVO{
-			Key:      diff.Key,
-			OldValue: diff.OldValue,
-			NewValue: diff.NewValue,
+			Key:       diff.Key,
+			ValueType: diff.ValueType,
+			OldValue:  diff.OldValue,
+			NewValue:  diff.NewValue,
+			Diff:      toValueDiffVO(diff.Diff),
 		})
 	}
 
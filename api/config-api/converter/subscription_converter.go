@@ -1,8 +1,13 @@
 package converter
 
 import (
+	"strconv"
+	"strings"
+	"time"
+
 	"config-client/api/config-api/dto/vo"
 	"config-client/config/domain/entity"
+	domainService "config-client/config/domain/service"
 )
 
 // SubscriptionConverter 订阅转换器
@@ -52,3 +57,95 @@ func (c *SubscriptionConverter) ToVOList(subscriptions []*entity.Subscription) [
 	}
 	return result
 }
+
+// ToListenerStatusVO 将配置键监听状态转换为VO
+func (c *SubscriptionConverter) ToListenerStatusVO(status *domainService.ListenerStatus) *vo.ListenerStatusVO {
+	if status == nil {
+		return nil
+	}
+
+	result := &vo.ListenerStatusVO{
+		NamespaceID:   status.NamespaceID,
+		Key:           status.ConfigKey,
+		Environment:   status.Environment,
+		ServerVersion: status.ServerVersion,
+		Total:         len(status.Listeners),
+		Synced:        status.SyncedCount,
+		Outdated:      status.OutdatedCount,
+		Expired:       status.ExpiredCount,
+		AllSynced:     status.OutdatedCount == 0,
+		Listeners:     make([]*vo.ConfigListenerVO, 0, len(status.Listeners)),
+	}
+	for _, listener := range status.Listeners {
+		result.Listeners = append(result.Listeners, &vo.ConfigListenerVO{
+			SubscriptionID:   listener.Subscription.ID,
+			ClientID:         listener.Subscription.ClientID,
+			ClientIP:         listener.Subscription.ClientIP,
+			ClientHostname:   listener.Subscription.ClientHostname,
+			ReportedVersion:  listener.ReportedVersion,
+			ExpectedVersion:  listener.ExpectedVersion,
+			Canary:           listener.Canary,
+			Status:           listener.Status,
+			HeartbeatExpired: listener.HeartbeatExpired,
+			LastHeartbeatAt:  listener.Subscription.LastHeartbeatAt,
+			ReportedAt:       listener.ReportedAt,
+		})
+	}
+	return result
+}
+
+// ToPushTraceVOList 将通知下发记录转换为VO列表
+func (c *SubscriptionConverter) ToPushTraceVOList(traces []*entity.PushTrace) []*vo.PushTraceVO {
+	result := make([]*vo.PushTraceVO, 0, len(traces))
+	for _, trace := range traces {
+		result = append(result, &vo.PushTraceVO{
+			ID:             trace.ID,
+			SubscriptionID: trace.SubscriptionID,
+			ClientID:       trace.ClientID,
+			NamespaceID:    trace.NamespaceID,
+			Environment:    trace.Environment,
+			Key:            trace.ConfigKey,
+			Version:        trace.Version,
+			ReleaseID:      trace.ReleaseID,
+			Sequence:       trace.Sequence,
+			TraceID:        trace.TraceID,
+			Source:         trace.Source,
+			PushedAt:       trace.PushedAt,
+			Acked:          trace.Acked,
+			AckedAt:        trace.AckedAt,
+		})
+	}
+	return result
+}
+
+// ToDetailVO 将订阅记录和当前实例的活跃订阅者状态转换为详情VO
+func (c *SubscriptionConverter) ToDetailVO(subscription *entity.Subscription, active *domainService.ActiveSubscriberInfo, heartbeatTimeout time.Duration) *vo.SubscriptionDetailVO {
+	if subscription == nil {
+		return nil
+	}
+
+	detail := &vo.SubscriptionDetailVO{
+		Subscription:     c.ToVO(subscription),
+		HeartbeatExpired: subscription.IsActive && subscription.IsExpired(heartbeatTimeout),
+		WatchedKeys:      []string{},
+	}
+	if active == nil {
+		return detail
+	}
+
+	detail.Online = true
+	registeredAt := active.RegisteredAt
+	detail.RegisteredAt = &registeredAt
+	// 内存中的配置键格式为 "namespaceID:configKey"，展示时去掉命名空间前缀
+	prefix := strconv.Itoa(active.NamespaceID) + ":"
+	for _, key := range active.ConfigKeys {
+		detail.WatchedKeys = append(detail.WatchedKeys, strings.TrimPrefix(key, prefix))
+	}
+	if len(active.CurrentVersions) > 0 {
+		detail.CurrentVersions = make(map[string]string, len(active.CurrentVersions))
+		for key, version := range active.CurrentVersions {
+			detail.CurrentVersions[strings.TrimPrefix(key, prefix)] = version
+		}
+	}
+	return detail
+}
@@ -0,0 +1,40 @@
+package converter
+
+import (
+	"config-client/api/config-api/dto/vo"
+	"config-client/config/domain/entity"
+)
+
+// SystemConfigConverter 系统配置转换器
+type SystemConfigConverter struct{}
+
+// NewSystemConfigConverter 创建系统配置转换器
+func NewSystemConfigConverter() *SystemConfigConverter {
+	return &SystemConfigConverter{}
+}
+
+// ToVO 将领域实体转换为VO
+func (c *SystemConfigConverter) ToVO(s *entity.SystemConfig) *vo.SystemConfigVO {
+	if s == nil {
+		return nil
+	}
+
+	return &vo.SystemConfigVO{
+		ID:          s.ID,
+		ConfigKey:   s.ConfigKey,
+		ConfigValue: s.ConfigValue,
+		Description: s.Description,
+		IsActive:    s.IsActive,
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+}
+
+// ToVOList 批量转换为VO
+func (c *SystemConfigConverter) ToVOList(configs []*entity.SystemConfig) []*vo.SystemConfigVO {
+	result := make([]*vo.SystemConfigVO, 0, len(configs))
+	for _, s := range configs {
+		result = append(result, c.ToVO(s))
+	}
+	return result
+}
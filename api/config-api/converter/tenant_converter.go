@@ -0,0 +1,42 @@
+package converter
+
+import (
+	"config-client/api/config-api/dto/vo"
+	"config-client/config/domain/entity"
+)
+
+// TenantConverter 租户转换器
+type TenantConverter struct{}
+
+// NewTenantConverter 创建租户转换器
+func NewTenantConverter() *TenantConverter {
+	return &TenantConverter{}
+}
+
+// ToVO 将领域实体转换为VO
+func (c *TenantConverter) ToVO(t *entity.Tenant) *vo.TenantVO {
+	if t == nil {
+		return nil
+	}
+
+	return &vo.TenantVO{
+		ID:          t.ID,
+		Code:        t.Code,
+		Name:        t.Name,
+		Description: t.Description,
+		IsActive:    t.IsActive,
+		CreatedBy:   t.CreatedBy,
+		UpdatedBy:   t.UpdatedBy,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}
+}
+
+// ToVOList 批量转换为VO
+func (c *TenantConverter) ToVOList(tenants []*entity.Tenant) []*vo.TenantVO {
+	result := make([]*vo.TenantVO, 0, len(tenants))
+	for _, t := range tenants {
+		result = append(result, c.ToVO(t))
+	}
+	return result
+}
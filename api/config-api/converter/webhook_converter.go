@@ -0,0 +1,85 @@
+package converter
+
+import (
+	"config-client/api/config-api/dto/vo"
+	"config-client/config/domain/entity"
+)
+
+// WebhookConverter Webhook 转换器
+type WebhookConverter struct{}
+
+// NewWebhookConverter 创建 Webhook 转换器
+func NewWebhookConverter() *WebhookConverter {
+	return &WebhookConverter{}
+}
+
+// ToVO 将领域实体转换为VO
+func (c *WebhookConverter) ToVO(webhook *entity.Webhook) *vo.WebhookVO {
+	if webhook == nil {
+		return nil
+	}
+
+	events := webhook.Events
+	if events == nil {
+		events = []string{}
+	}
+	return &vo.WebhookVO{
+		ID:          webhook.ID,
+		NamespaceID: webhook.NamespaceID,
+		Name:        webhook.Name,
+		URL:         webhook.URL,
+		Events:      events,
+		Enabled:     webhook.Enabled,
+		CreatedBy:   webhook.CreatedBy,
+		UpdatedBy:   webhook.UpdatedBy,
+		CreatedAt:   webhook.CreatedAt,
+		UpdatedAt:   webhook.UpdatedAt,
+	}
+}
+
+// ToVOList 批量转换为VO
+func (c *WebhookConverter) ToVOList(webhooks []*entity.Webhook) []*vo.WebhookVO {
+	result := make([]*vo.WebhookVO, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		result = append(result, c.ToVO(webhook))
+	}
+	return result
+}
+
+// ToDeliveryVO 将投递记录转换为VO
+func (c *WebhookConverter) ToDeliveryVO(delivery *entity.WebhookDelivery) *vo.WebhookDeliveryVO {
+	if delivery == nil {
+		return nil
+	}
+
+	deliveryVO := &vo.WebhookDeliveryVO{
+		ID:             delivery.ID,
+		WebhookID:      delivery.WebhookID,
+		NamespaceID:    delivery.NamespaceID,
+		EventType:      delivery.EventType,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		ResponseBody:   delivery.ResponseBody,
+		LastError:      delivery.LastError,
+		DurationMs:     delivery.DurationMs,
+		RedeliveryOf:   delivery.RedeliveryOf,
+		CreatedAt:      delivery.CreatedAt,
+		DeliveredAt:    delivery.DeliveredAt,
+	}
+	if delivery.Status == entity.WebhookDeliveryPending {
+		nextAttemptAt := delivery.NextAttemptAt
+		deliveryVO.NextAttemptAt = &nextAttemptAt
+	}
+	return deliveryVO
+}
+
+// ToDeliveryVOList 批量转换投递记录为VO
+func (c *WebhookConverter) ToDeliveryVOList(deliveries []*entity.WebhookDelivery) []*vo.WebhookDeliveryVO {
+	result := make([]*vo.WebhookDeliveryVO, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		result = append(result, c.ToDeliveryVO(delivery))
+	}
+	return result
+}
@@ -0,0 +1,25 @@
+package request
+
+import "time"
+
+// CreateAPIKeyRequest 创建 API Key 请求
+type CreateAPIKeyRequest struct {
+	Name        string     `json:"name" binding:"required,max=100"`             // 名称（唯一）
+	Role        string     `json:"role" binding:"omitempty,oneof=admin reader"` // 角色（默认 admin，reader 为只读令牌）
+	NamespaceID int        `json:"namespace_id" binding:"omitempty,min=1"`      // 只读令牌绑定的命名空间ID（reader 必填）
+	Environment string     `json:"environment" binding:"max=50"`                // 只读令牌绑定的环境，默认"default"
+	KeyPrefixes []string   `json:"key_prefixes" binding:"max=20"`               // 只读令牌允许读取的配置键前缀（为空时允许命名空间下的全部配置）
+	Description string     `json:"description" binding:"max=500"`               // 描述
+	ExpiresAt   *time.Time `json:"expires_at"`                                  // 过期时间（RFC3339，为空表示永不过期）
+	CreatedBy   string     `json:"created_by" binding:"required,max=100"`       // 创建人
+}
+
+// RevokeAPIKeyRequest 吊销 API Key 请求
+type RevokeAPIKeyRequest struct {
+	RevokedBy string `json:"revoked_by" binding:"required,max=100"` // 吊销人
+}
+
+// ListAPIKeysRequest 查询 API Key 请求
+type ListAPIKeysRequest struct {
+	IncludeRevoked bool `json:"include_revoked" form:"include_revoked"` // 是否包含已吊销的 API Key
+}
@@ -1,16 +1,23 @@
 package request
 
+import shareRepo "config-client/share/repository"
+
 // QueryHistoryRequest 查询变更历史请求 DTO
 type QueryHistoryRequest struct {
-	ConfigID    *int    `json:"config_id" form:"config_id"`               // 配置ID
-	NamespaceID *int    `json:"namespace_id" form:"namespace_id"`         // 命名空间ID
-	ConfigKey   *string `json:"config_key" form:"config_key"`             // 配置键（支持模糊查询）
-	Operation   *string `json:"operation" form:"operation"`               // 操作类型：CREATE/UPDATE/DELETE/ROLLBACK
-	StartTime   *string `json:"start_time" form:"start_time"`             // 开始时间，格式：2006-01-02 15:04:05
-	EndTime     *string `json:"end_time" form:"end_time"`                 // 结束时间
-	Operator    *string `json:"operator" form:"operator"`                 // 操作人（支持模糊查询）
-	Page        int     `json:"page" form:"page" binding:"min=1"`         // 页码，默认1
-	Size        int     `json:"size" form:"size" binding:"min=1,max=100"` // 每页数量，默认20，最大100
+	ConfigID    *int    `json:"config_id" form:"config_id"`                         // 配置ID
+	NamespaceID *int    `json:"namespace_id" form:"namespace_id"`                   // 命名空间ID
+	ConfigKey   *string `json:"config_key" form:"config_key"`                       // 配置键（支持模糊查询）
+	Operation   *string `json:"operation" form:"operation"`                         // 操作类型：CREATE/UPDATE/DELETE/ROLLBACK
+	StartTime   *string `json:"start_time" form:"start_time"`                       // 开始时间，格式：2006-01-02 15:04:05
+	EndTime     *string `json:"end_time" form:"end_time"`                           // 结束时间
+	Operator    *string `json:"operator" form:"operator"`                           // 操作人（支持模糊查询）
+	Page        int     `json:"page" form:"page" binding:"omitempty,min=1"`         // 页码，默认1
+	Size        int     `json:"size" form:"size" binding:"omitempty,min=1,max=100"` // 每页数量，默认20，最大100
+	OrderBy     string  `json:"order_by" form:"order_by"`                           // 排序字段，例如：created_at desc
+
+	// 游标分页：pagination=cursor 或携带 cursor 时生效，按创建时间倒序返回且不统计总数（忽略 page 和 order_by）
+	Pagination string `json:"pagination" form:"pagination" binding:"omitempty,oneof=offset cursor"` // 分页方式：offset（默认）/cursor
+	Cursor     string `json:"cursor" form:"cursor"`                                                 // 上一页返回的 next_cursor，为空表示第一页
 }
 
 // SetDefaults 设置默认值
@@ -23,6 +30,11 @@ func (q *QueryHistoryRequest) SetDefaults() {
 	}
 }
 
+// PageCursor 解析游标分页位置，返回 nil 表示使用页码分页
+func (q *QueryHistoryRequest) PageCursor() (*shareRepo.Cursor, error) {
+	return parseCursor(q.Pagination, q.Cursor)
+}
+
 // GetHistoryByIDRequest 根据ID查询变更历史请求 DTO
 type GetHistoryByIDRequest struct {
 	HistoryID int `json:"history_id" binding:"required,min=1"` // 历史记录ID
@@ -55,3 +67,22 @@ func (g *GetConfigHistoryRequest) SetDefaults() {
 		g.Limit = 100
 	}
 }
+
+// ExportHistoryRequest 导出变更历史请求 DTO
+type ExportHistoryRequest struct {
+	NamespaceID *int    `json:"namespace_id" form:"namespace_id"`                        // 命名空间ID
+	ConfigID    *int    `json:"config_id" form:"config_id"`                              // 配置ID
+	ConfigKey   *string `json:"config_key" form:"config_key"`                            // 配置键（支持模糊查询）
+	Operation   *string `json:"operation" form:"operation"`                              // 操作类型：CREATE/UPDATE/DELETE/ROLLBACK/RESTORE
+	Operator    *string `json:"operator" form:"operator"`                                // 操作人（支持模糊查询）
+	StartTime   *string `json:"start_time" form:"start_time"`                            // 开始时间，格式：2006-01-02 15:04:05
+	EndTime     *string `json:"end_time" form:"end_time"`                                // 结束时间
+	Format      string  `json:"format" form:"format" binding:"omitempty,oneof=csv json"` // 导出格式：csv/json，默认csv
+}
+
+// SetDefaults 设置默认值
+func (e *ExportHistoryRequest) SetDefaults() {
+	if e.Format == "" {
+		e.Format = "csv"
+	}
+}
@@ -0,0 +1,24 @@
+package request
+
+// SetConfigDependenciesRequest 设置配置依赖请求（全量替换显式声明的依赖，列表为空时清空）
+type SetConfigDependenciesRequest struct {
+	ConfigID  int    `json:"config_id" binding:"required,min=1"`        // 配置ID
+	DependsOn []int  `json:"depends_on" binding:"max=100,dive,min=1"`   // 依赖的配置ID列表
+	Operator  string `json:"operator" binding:"required,min=1,max=100"` // 操作人
+}
+
+// GetConfigDependenciesRequest 查询配置依赖请求
+type GetConfigDependenciesRequest struct {
+	ConfigID int `json:"config_id" form:"config_id" binding:"required,min=1"` // 配置ID
+}
+
+// ConfigDependencyGraphRequest 查询依赖图请求
+type ConfigDependencyGraphRequest struct {
+	NamespaceID int    `json:"namespace_id" form:"namespace_id" binding:"required,min=1"` // 命名空间ID
+	Environment string `json:"environment" form:"environment"`                            // 环境（为空时包含所有环境）
+}
+
+// ConfigImpactAnalysisRequest 变更影响分析请求
+type ConfigImpactAnalysisRequest struct {
+	ConfigID int `json:"config_id" binding:"required,min=1"` // 待变更的配置ID
+}
@@ -0,0 +1,21 @@
+package request
+
+// UploadConfigFileRequest 上传文件配置请求（参数通过查询字符串传递，请求体为文件原始内容）
+type UploadConfigFileRequest struct {
+	NamespaceID int    `query:"namespace_id" binding:"required,min=1"`     // 命名空间ID
+	Key         string `query:"key" binding:"required,max=500"`            // 配置键
+	Environment string `query:"environment" binding:"max=50"`              // 环境，默认"default"
+	GroupName   string `query:"group_name" binding:"max=100"`              // 配置分组（为空时新建使用默认分组，已有配置保持原值）
+	Description string `query:"description"`                               // 配置描述（为空时已有配置保持原值）
+	FileName    string `query:"file_name" binding:"max=255"`               // 文件名（下载时作为附件名）
+	Operator    string `query:"operator" binding:"required,min=1,max=100"` // 操作人
+}
+
+// DownloadConfigFileRequest 下载文件配置请求
+// 指定 config_id 时按ID下载（不限发布状态），否则按命名空间、配置键和环境下载已发布的文件
+type DownloadConfigFileRequest struct {
+	ConfigID    int    `json:"config_id" form:"config_id"`                      // 配置ID
+	NamespaceID int    `json:"namespace_id" form:"namespace_id"`                // 命名空间ID
+	Key         string `json:"key" form:"key" binding:"max=500"`                // 配置键
+	Environment string `json:"environment" form:"environment" binding:"max=50"` // 环境，默认"default"
+}
@@ -0,0 +1,49 @@
+package request
+
+// CreateConfigGroupRequest 创建配置分组请求 DTO
+type CreateConfigGroupRequest struct {
+	NamespaceID int    `json:"namespace_id" binding:"required,min=1"` // 命名空间ID
+	Name        string `json:"name" binding:"required,max=255"`       // 分组名称（对应配置的 group_name）
+	Description string `json:"description"`                           // 描述
+	SortOrder   int    `json:"sort_order"`                            // 展示顺序（升序）
+	CreatedBy   string `json:"created_by" binding:"max=100"`          // 创建人
+}
+
+// UpdateConfigGroupRequest 更新配置分组请求 DTO（分组名称不可修改）
+type UpdateConfigGroupRequest struct {
+	ID          int    `json:"id" binding:"required,min=1"`  // 分组ID
+	Description string `json:"description"`                  // 描述
+	SortOrder   int    `json:"sort_order"`                   // 展示顺序（升序）
+	UpdatedBy   string `json:"updated_by" binding:"max=100"` // 更新人
+}
+
+// GetConfigGroupRequest 根据ID获取配置分组请求 DTO
+type GetConfigGroupRequest struct {
+	ID          int    `json:"id" binding:"required,min=1"`  // 分组ID
+	Environment string `json:"environment" binding:"max=50"` // 统计的环境（为空时统计所有环境）
+}
+
+// DeleteConfigGroupRequest 删除配置分组请求 DTO
+type DeleteConfigGroupRequest struct {
+	ID int `json:"id" binding:"required,min=1"` // 分组ID
+}
+
+// ListConfigGroupRequest 查询命名空间下的配置分组请求 DTO
+type ListConfigGroupRequest struct {
+	NamespaceID int    `json:"namespace_id" form:"namespace_id" binding:"required,min=1"` // 命名空间ID
+	Environment string `json:"environment" form:"environment" binding:"max=50"`           // 统计的环境（为空时统计所有环境）
+}
+
+// ReleaseConfigGroupRequest 发布整个分组请求 DTO
+type ReleaseConfigGroupRequest struct {
+	ID          int    `json:"id" binding:"required,min=1"`  // 分组ID
+	Environment string `json:"environment" binding:"max=50"` // 环境，默认"default"
+}
+
+// ExportConfigGroupRequest 导出分组配置请求 DTO
+type ExportConfigGroupRequest struct {
+	ID             int    `json:"id" form:"id" binding:"required,min=1"`           // 分组ID
+	Environment    string `json:"environment" form:"environment" binding:"max=50"` // 环境，默认"default"
+	Format         string `json:"format" form:"format"`                            // 导出格式：yaml/json/properties/env，默认yaml
+	IncludeSecrets bool   `json:"include_secrets" form:"include_secrets"`          // 是否导出敏感配置明文
+}
@@ -1,5 +1,11 @@
 package request
 
+import (
+	"time"
+
+	shareRepo "config-client/share/repository"
+)
+
 // CreateConfigRequest 创建配置请求 DTO
 type CreateConfigRequest struct {
 	NamespaceID int        `json:"namespace_id" binding:"required,min=1"` // 命名空间ID
@@ -11,6 +17,7 @@ type CreateConfigRequest struct {
 	Description string     `json:"description"`                           // 配置描述
 	Metadata    string     `json:"metadata"`                              // 扩展元数据（JSON格式）
 	Tags        []TagInput `json:"tags,omitempty" binding:"dive"`         // 配置标签（可选）
+	ExpiresAt   *time.Time `json:"expires_at"`                            // 过期时间（RFC3339，可选，到期后自动停用或删除）
 	CreatedBy   string     `json:"created_by" binding:"max=100"`          // 创建人
 }
 
@@ -25,20 +32,34 @@ type UpdateConfigRequest struct {
 	IsActive    *bool  `json:"is_active"`                    // 是否激活（指针类型，允许null）
 	IsReleased  *bool  `json:"is_released"`                  // 是否已发布（指针类型，允许null）
 	UpdatedBy   string `json:"updated_by" binding:"max=100"` // 更新人
+
+	// 过期时间（RFC3339），为空时保持原值；clear_expires_at 为 true 时取消过期
+	ExpiresAt      *time.Time `json:"expires_at"`
+	ClearExpiresAt bool       `json:"clear_expires_at"`
+
+	// 期望的当前版本号（乐观锁），与存储中的版本号不一致时返回 409，为空时不校验
+	ExpectedVersion *int `json:"expected_version" binding:"omitempty,min=1"`
 }
 
 // QueryConfigRequest 查询配置请求 DTO（多条件查询）
 type QueryConfigRequest struct {
-	NamespaceID *int    `json:"namespace_id" form:"namespace_id"`         // 命名空间ID（指针类型，允许null）
-	Key         *string `json:"key" form:"key"`                           // 配置键（支持模糊查询）
-	GroupName   *string `json:"group_name" form:"group_name"`             // 配置分组
-	Environment *string `json:"environment" form:"environment"`           // 环境
-	IsActive    *bool   `json:"is_active" form:"is_active"`               // 是否激活
-	IsReleased  *bool   `json:"is_released" form:"is_released"`           // 是否已发布
-	ValueType   *string `json:"value_type" form:"value_type"`             // 值类型
-	Page        int     `json:"page" form:"page" binding:"min=1"`         // 页码，默认1
-	Size        int     `json:"size" form:"size" binding:"min=1,max=100"` // 每页数量，默认10，最大100
-	OrderBy     string  `json:"order_by" form:"order_by"`                 // 排序字段，例如：created_at desc
+	NamespaceID *int    `json:"namespace_id" form:"namespace_id"`                   // 命名空间ID（指针类型，允许null）
+	Key         *string `json:"key" form:"key"`                                     // 配置键（支持模糊查询）
+	GroupName   *string `json:"group_name" form:"group_name"`                       // 配置分组
+	Environment *string `json:"environment" form:"environment"`                     // 环境
+	IsActive    *bool   `json:"is_active" form:"is_active"`                         // 是否激活
+	IsReleased  *bool   `json:"is_released" form:"is_released"`                     // 是否已发布
+	ValueType   *string `json:"value_type" form:"value_type"`                       // 值类型
+	Page        int     `json:"page" form:"page" binding:"omitempty,min=1"`         // 页码，默认1
+	Size        int     `json:"size" form:"size" binding:"omitempty,min=1,max=100"` // 每页数量，默认10，最大100
+	OrderBy     string  `json:"order_by" form:"order_by"`                           // 排序字段，例如：created_at desc
+
+	// 游标分页：pagination=cursor 或携带 cursor 时生效，按创建时间倒序返回且不统计总数（忽略 page 和 order_by）
+	Pagination string `json:"pagination" form:"pagination" binding:"omitempty,oneof=offset cursor"` // 分页方式：offset（默认）/cursor
+	Cursor     string `json:"cursor" form:"cursor"`                                                 // 上一页返回的 next_cursor，为空表示第一页
+
+	// 只查询在指定秒数内过期的配置（包括已过期但尚未处理的配置），未指定排序时按过期时间升序
+	ExpiringWithin *int `json:"expiring_within" form:"expiring_within" binding:"omitempty,min=0"`
 }
 
 // SetDefaults 设置默认值
@@ -51,11 +72,124 @@ func (q *QueryConfigRequest) SetDefaults() {
 	}
 }
 
+// PageCursor 解析游标分页位置，返回 nil 表示使用页码分页
+func (q *QueryConfigRequest) PageCursor() (*shareRepo.Cursor, error) {
+	return parseCursor(q.Pagination, q.Cursor)
+}
+
+// SearchConfigRequest 搜索配置请求 DTO
+type SearchConfigRequest struct {
+	Query       string  `json:"q" form:"q" binding:"required,max=200"`              // 搜索关键字（不区分大小写的子串匹配）
+	Fields      string  `json:"fields" form:"fields"`                               // 搜索字段，逗号分隔：key,description,group,value，默认全部
+	NamespaceID *int    `json:"namespace_id" form:"namespace_id"`                   // 命名空间ID
+	Environment *string `json:"environment" form:"environment"`                     // 环境
+	Page        int     `json:"page" form:"page" binding:"omitempty,min=1"`         // 页码，默认1
+	Size        int     `json:"size" form:"size" binding:"omitempty,min=1,max=100"` // 每页数量，默认20，最大100
+}
+
+// SetDefaults 设置默认值
+func (q *SearchConfigRequest) SetDefaults() {
+	if q.Page == 0 {
+		q.Page = 1
+	}
+	if q.Size == 0 {
+		q.Size = 20
+	}
+}
+
+// QueryTrashRequest 查询回收站请求 DTO
+type QueryTrashRequest struct {
+	NamespaceID *int    `json:"namespace_id" form:"namespace_id"`                   // 命名空间ID
+	Key         *string `json:"key" form:"key"`                                     // 配置键（支持模糊查询）
+	Environment *string `json:"environment" form:"environment"`                     // 环境
+	Page        int     `json:"page" form:"page" binding:"omitempty,min=1"`         // 页码，默认1
+	Size        int     `json:"size" form:"size" binding:"omitempty,min=1,max=100"` // 每页数量，默认10，最大100
+}
+
+// SetDefaults 设置默认值
+func (q *QueryTrashRequest) SetDefaults() {
+	if q.Page == 0 {
+		q.Page = 1
+	}
+	if q.Size == 0 {
+		q.Size = 10
+	}
+}
+
+// RestoreConfigRequest 从回收站恢复配置请求 DTO
+type RestoreConfigRequest struct {
+	ID       int    `json:"id" binding:"required,min=1"` // 配置ID
+	Operator string `json:"operator" binding:"max=100"`  // 操作人
+	Reason   string `json:"reason" binding:"max=500"`    // 恢复原因（记录到变更历史）
+}
+
+// MigrateContentHashRequest 迁移配置内容哈希算法请求 DTO
+type MigrateContentHashRequest struct {
+	NamespaceID int  `json:"namespace_id" binding:"required,min=1"` // 命名空间ID
+	DryRun      bool `json:"dry_run"`                               // 试运行（只统计待迁移的配置，不写入）
+}
+
 // GetConfigByIDRequest 根据ID获取配置请求 DTO
 type GetConfigByIDRequest struct {
 	ID int `json:"id" binding:"required,min=1"` // 配置ID
 }
 
+// GetConfigByKeyRequest 根据配置键获取配置请求 DTO
+type GetConfigByKeyRequest struct {
+	NamespaceID int    `json:"namespace_id" form:"namespace_id" binding:"required,min=1"` // 命名空间ID
+	Key         string `json:"key" form:"key" binding:"required,max=500"`                 // 配置键
+	Environment string `json:"environment" form:"environment" binding:"max=50"`           // 环境，默认"default"
+	ClientID    string `json:"client_id" form:"client_id"`                                // 客户端ID（用于灰度判断，可选）
+	ClientIP    string `json:"client_ip" form:"client_ip"`                                // 客户端IP（用于灰度判断，可选，传入 client_id 时默认取请求来源IP）
+}
+
+// GetEffectiveConfigRequest 获取生效配置请求 DTO
+type GetEffectiveConfigRequest struct {
+	NamespaceID int    `json:"namespace_id" form:"namespace_id" binding:"required,min=1"` // 命名空间ID
+	Environment string `json:"environment" form:"environment" binding:"max=50"`           // 环境，默认"default"
+	GroupName   string `json:"group_name" form:"group_name" binding:"max=255"`            // 配置分组（可选）
+}
+
+// BulkGetConfigRequest 批量获取配置请求 DTO
+type BulkGetConfigRequest struct {
+	NamespaceID int      `json:"namespace_id" binding:"required,min=1"`                       // 命名空间ID
+	Keys        []string `json:"keys" binding:"required,min=1,max=200,dive,required,max=500"` // 配置键列表（最多200个）
+	Environment string   `json:"environment" binding:"max=50"`                                // 环境，默认"default"
+	ClientID    string   `json:"client_id"`                                                   // 客户端ID（用于灰度判断，可选）
+	ClientIP    string   `json:"client_ip"`                                                   // 客户端IP（用于灰度判断，可选，传入 client_id 时默认取请求来源IP）
+}
+
+// ValidateConfigRequest 配置校验请求 DTO（仅校验，不保存）
+type ValidateConfigRequest struct {
+	NamespaceID int    `json:"namespace_id" binding:"required,min=1"` // 命名空间ID
+	Key         string `json:"key" binding:"required,max=500"`        // 配置键
+	Value       string `json:"value"`                                 // 配置值
+	GroupName   string `json:"group_name" binding:"max=255"`          // 配置分组
+	ValueType   string `json:"value_type" binding:"max=50"`           // 值类型，默认"string"
+	Environment string `json:"environment" binding:"max=50"`          // 环境，默认"default"
+	Metadata    string `json:"metadata"`                              // 扩展元数据（可在 validators 中声明校验规则）
+}
+
+// BatchMutateConfigRequest 批量变更配置请求 DTO（单个事务内执行）
+type BatchMutateConfigRequest struct {
+	NamespaceID int                `json:"namespace_id" binding:"required,min=1"`       // 命名空间ID
+	Environment string             `json:"environment" binding:"max=50"`                // 环境，默认"default"
+	Items       []*BatchConfigItem `json:"items" binding:"required,min=1,max=500,dive"` // 变更列表
+	Operator    string             `json:"operator" binding:"max=100"`                  // 操作人
+	Reason      string             `json:"reason" binding:"max=500"`                    // 变更原因（记录到变更历史）
+}
+
+// BatchConfigItem 单条批量变更
+type BatchConfigItem struct {
+	Action      string `json:"action" binding:"required,oneof=create update delete"` // 操作类型：create/update/delete
+	Key         string `json:"key" binding:"required,max=500"`                       // 配置键
+	Value       string `json:"value"`                                                // 配置值（create/update）
+	GroupName   string `json:"group_name" binding:"max=255"`                         // 配置分组（update 时为空表示不修改）
+	ValueType   string `json:"value_type" binding:"max=50"`                          // 值类型（update 时为空表示不修改）
+	Description string `json:"description"`                                          // 配置描述（update 时为空表示不修改）
+	Metadata    string `json:"metadata"`                                             // 扩展元数据（update 时为空表示不修改）
+}
+
 // DeleteConfigRequest 删除配置请求 DTO
 type DeleteConfigRequest struct {
 	ID int `json:"id" binding:"required,min=1"` // 配置ID
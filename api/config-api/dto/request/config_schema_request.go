@@ -0,0 +1,35 @@
+package request
+
+// CreateConfigSchemaRequest 创建配置 Schema 绑定请求 DTO
+type CreateConfigSchemaRequest struct {
+	NamespaceID int    `json:"namespace_id" binding:"required,min=1"` // 命名空间ID
+	ConfigKey   string `json:"config_key" binding:"max=500"`          // 绑定的配置键（与 group_name 二选一）
+	GroupName   string `json:"group_name" binding:"max=255"`          // 绑定的配置分组（与 config_key 二选一）
+	Schema      string `json:"schema" binding:"required"`             // JSON Schema 文档
+	Description string `json:"description"`                           // 描述
+	CreatedBy   string `json:"created_by" binding:"max=100"`          // 创建人
+}
+
+// UpdateConfigSchemaRequest 更新配置 Schema 绑定请求 DTO
+type UpdateConfigSchemaRequest struct {
+	ID          int    `json:"id" binding:"required,min=1"`  // Schema 绑定ID
+	Schema      string `json:"schema" binding:"required"`    // JSON Schema 文档
+	Description string `json:"description"`                  // 描述
+	IsActive    *bool  `json:"is_active"`                    // 是否启用（指针类型，允许null）
+	UpdatedBy   string `json:"updated_by" binding:"max=100"` // 更新人
+}
+
+// GetConfigSchemaRequest 根据ID获取配置 Schema 绑定请求 DTO
+type GetConfigSchemaRequest struct {
+	ID int `json:"id" binding:"required,min=1"` // Schema 绑定ID
+}
+
+// DeleteConfigSchemaRequest 删除配置 Schema 绑定请求 DTO
+type DeleteConfigSchemaRequest struct {
+	ID int `json:"id" binding:"required,min=1"` // Schema 绑定ID
+}
+
+// ListConfigSchemaRequest 查询命名空间下的配置 Schema 绑定请求 DTO
+type ListConfigSchemaRequest struct {
+	NamespaceID int `json:"namespace_id" form:"namespace_id" binding:"required,min=1"` // 命名空间ID
+}
@@ -18,7 +18,39 @@ type RemoveTagsRequest struct {
 	TagKeys  []string `json:"tag_keys" binding:"required,min=1"`  // 要删除的标签键列表
 }
 
+// ReplaceTagsRequest 替换标签请求（全量替换，标签列表为空时清空所有标签）
+type ReplaceTagsRequest struct {
+	ConfigID int        `json:"config_id" binding:"required,min=1"` // 配置ID
+	Tags     []TagInput `json:"tags" binding:"dive"`                // 新的标签列表
+}
+
+// GetTagsRequest 查询配置标签请求
+type GetTagsRequest struct {
+	ConfigID int `json:"config_id" form:"config_id" binding:"required,min=1"` // 配置ID
+}
+
+// RegenerateTagsRequest 重新生成自动标签请求
+type RegenerateTagsRequest struct {
+	ConfigID int `json:"config_id" binding:"required,min=1"` // 配置ID
+}
+
 // QueryByTagsRequest 根据标签查询配置请求
 type QueryByTagsRequest struct {
-	Tags []TagInput `json:"tags" form:"tags" binding:"required,min=1,dive"` // 标签列表（AND查询）
+	Tags        []TagInput `json:"tags" form:"tags" binding:"required,min=1,dive"` // 标签列表（AND查询）
+	NamespaceID *int       `json:"namespace_id"`                                   // 命名空间ID
+	Environment *string    `json:"environment"`                                    // 环境
+	IsActive    *bool      `json:"is_active"`                                      // 是否激活
+	Page        int        `json:"page" binding:"omitempty,min=1"`                 // 页码，默认1
+	Size        int        `json:"size" binding:"omitempty,min=1,max=100"`         // 每页数量，默认10，最大100
+	OrderBy     string     `json:"order_by"`                                       // 排序字段，例如：created_at desc
+}
+
+// SetDefaults 设置默认值
+func (q *QueryByTagsRequest) SetDefaults() {
+	if q.Page == 0 {
+		q.Page = 1
+	}
+	if q.Size == 0 {
+		q.Size = 10
+	}
 }
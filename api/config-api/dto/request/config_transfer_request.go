@@ -0,0 +1,61 @@
+package request
+
+// ImportConfigRequest 导入配置请求 DTO
+// 支持 JSON 请求体（content 字段）或 multipart/form-data 上传文件（file 字段）
+type ImportConfigRequest struct {
+	NamespaceID int    `json:"namespace_id" form:"namespace_id" binding:"required,min=1"` // 命名空间ID
+	Environment string `json:"environment" form:"environment" binding:"max=50"`           // 环境，默认"default"
+	GroupName   string `json:"group_name" form:"group_name" binding:"max=255"`            // 导入后的配置分组，默认"default"
+	Format      string `json:"format" form:"format"`                                      // 内容格式：yaml/json/properties/env，上传文件时可按扩展名推断
+	Content     string `json:"content" form:"content"`                                    // 配置内容（未上传文件时必填）
+	Strategy    string `json:"strategy" form:"strategy"`                                  // 冲突策略：skip/overwrite/fail，默认skip
+	DryRun      bool   `json:"dry_run" form:"dry_run"`                                    // 是否仅预览，不写入
+	Operator    string `json:"operator" form:"operator" binding:"max=100"`                // 操作人
+}
+
+// ExportNamespaceRequest 导出命名空间配置请求 DTO
+type ExportNamespaceRequest struct {
+	NamespaceID    int    `json:"namespace_id" form:"namespace_id" binding:"required,min=1"` // 命名空间ID
+	Environment    string `json:"environment" form:"environment" binding:"max=50"`           // 环境，默认"default"
+	GroupName      string `json:"group_name" form:"group_name" binding:"max=255"`            // 仅导出指定分组（可选）
+	Format         string `json:"format" form:"format"`                                      // 导出格式：yaml/json/properties/env，默认yaml
+	IncludeSecrets bool   `json:"include_secrets" form:"include_secrets"`                    // 是否导出敏感配置明文
+}
+
+// RenderNamespaceRequest 渲染命名空间配置请求 DTO（命名空间ID取自路径参数）
+type RenderNamespaceRequest struct {
+	NamespaceID    int    `json:"-"`                                               // 命名空间ID
+	Environment    string `json:"environment" form:"environment" binding:"max=50"` // 环境，默认"default"
+	Format         string `json:"format" form:"format"`                            // 渲染格式：yaml/json/properties/env，默认yaml
+	IncludeSecrets bool   `json:"include_secrets" form:"include_secrets"`          // 是否输出敏感配置明文
+}
+
+// PromotionPreviewRequest 环境晋升预览请求 DTO
+type PromotionPreviewRequest struct {
+	NamespaceID       int      `json:"namespace_id" binding:"required,min=1"`        // 命名空间ID
+	SourceEnvironment string   `json:"source_environment" binding:"required,max=50"` // 源环境，如 dev
+	TargetEnvironment string   `json:"target_environment" binding:"required,max=50"` // 目标环境，如 test
+	GroupName         string   `json:"group_name" binding:"max=255"`                 // 仅晋升指定分组（可选）
+	Keys              []string `json:"keys"`                                         // 仅晋升指定配置键（可选）
+	IncludeUnreleased bool     `json:"include_unreleased"`                           // 是否包含源环境未发布的配置
+}
+
+// ApplyPromotionRequest 执行环境晋升请求 DTO
+type ApplyPromotionRequest struct {
+	PromotionPreviewRequest
+	DiffToken      string `json:"diff_token" binding:"required"` // 预览时返回的差异摘要
+	ApplyDeletions bool   `json:"apply_deletions"`               // 是否删除目标环境中源环境不存在的配置
+	Operator       string `json:"operator" binding:"max=100"`    // 操作人
+}
+
+// CloneNamespaceRequest 克隆命名空间请求 DTO
+type CloneNamespaceRequest struct {
+	SourceNamespaceID int    `json:"source_namespace_id" binding:"required,min=1"` // 源命名空间ID
+	Name              string `json:"name" binding:"required"`                      // 新命名空间名称
+	DisplayName       string `json:"display_name" binding:"max=255"`               // 新命名空间显示名称，默认同名称
+	Description       string `json:"description"`                                  // 新命名空间描述
+	Environment       string `json:"environment" binding:"max=50"`                 // 仅克隆指定环境（可选）
+	GroupName         string `json:"group_name" binding:"max=255"`                 // 仅克隆指定分组（可选）
+	IncludeUnreleased bool   `json:"include_unreleased"`                           // 是否包含未发布的配置
+	Operator          string `json:"operator" binding:"max=100"`                   // 操作人
+}
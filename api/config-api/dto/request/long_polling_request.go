@@ -2,16 +2,34 @@ package request
 
 // LongPollingRequest 长轮询请求
 type LongPollingRequest struct {
-	ClientID       string             `json:"client_id" binding:"required"`              // 客户端唯一标识
-	ClientIP       string             `json:"client_ip"`                                 // 客户端IP地址 (可选,服务端可自动获取)
-	ClientHostname string             `json:"client_hostname"`                           // 客户端主机名 (可选)
-	ConfigKeys     []ConfigKeyVersion `json:"config_keys" binding:"required,min=1,dive"` // 配置键列表
+	ClientID       string             `json:"client_id" binding:"required"` // 客户端唯一标识
+	ClientIP       string             `json:"client_ip"`                    // 客户端IP地址 (可选,服务端可自动获取)
+	ClientHostname string             `json:"client_hostname"`              // 客户端主机名 (可选)
+	ConfigKeys     []ConfigKeyVersion `json:"config_keys" binding:"dive"`   // 配置键列表（可包含多个命名空间和环境的配置，与 prefixes 至少指定一项）
+	LastSequence   *int64             `json:"last_sequence"`                // 上次响应返回的事件序号 (可选,兼容旧客户端,仅作用于第一个配置的命名空间)
+	LastSequences  map[int]int64      `json:"last_sequences"`               // 各命名空间上次响应返回的事件序号 (可选,命名空间ID -> 序号,优先于 last_sequence)
+
+	Prefixes []PrefixWatch `json:"prefixes" binding:"dive"` // 按前缀监听的配置（前缀下新建的配置也会通知）
+}
+
+// WatchMessage WebSocket 监听时客户端发送的消息
+type WatchMessage struct {
+	Type string              `json:"type"` // 消息类型（watch）
+	Data *LongPollingRequest `json:"data"` // 监听请求（与长轮询请求一致）
 }
 
 // ConfigKeyVersion 配置键及其版本
 type ConfigKeyVersion struct {
 	NamespaceID int    `json:"namespace_id" binding:"required,min=1"` // 命名空间ID
 	ConfigKey   string `json:"config_key" binding:"required"`         // 配置键
-	Version     string `json:"version" binding:"required"`            // 当前客户端持有的版本号（MD5）
-	Environment string `json:"environment" binding:"required"`        // 环境
+	Version     string `json:"version"`                               // 当前客户端持有的版本号（MD5，为空表示客户端尚未持有该配置）
+	Environment string `json:"environment"`                           // 环境，默认"default"
+}
+
+// PrefixWatch 按前缀监听的配置
+type PrefixWatch struct {
+	NamespaceID int               `json:"namespace_id" binding:"required,min=1"` // 命名空间ID
+	Prefix      string            `json:"prefix"`                                // 配置键前缀（为空时监听命名空间下的全部配置）
+	Environment string            `json:"environment"`                           // 环境，默认"default"
+	Versions    map[string]string `json:"versions"`                              // 客户端持有的前缀下配置的版本（配置键 -> 版本号，未上报的配置视为客户端尚未持有，不以前缀开头的配置键忽略）
 }
@@ -43,3 +43,12 @@ type ActivateNamespaceRequest struct {
 type DeactivateNamespaceRequest struct {
 	ID int `json:"id" binding:"required,min=1"` // 命名空间ID
 }
+
+// UpdateNamespaceQuotaRequest 设置命名空间配额请求（各项为 0 时继承全局默认配额）
+type UpdateNamespaceQuotaRequest struct {
+	MaxConfigs          int    `json:"max_configs" binding:"min=0"`           // 配置数量上限
+	MaxValueSize        int    `json:"max_value_size" binding:"min=0"`        // 单个配置值大小上限（字节）
+	MaxSubscribers      int    `json:"max_subscribers" binding:"min=0"`       // 活跃订阅数量上限
+	MaxReleasesRetained int    `json:"max_releases_retained" binding:"min=0"` // 每个环境保留的发布版本数量
+	UpdatedBy           string `json:"updated_by" binding:"required,max=100"` // 操作人
+}
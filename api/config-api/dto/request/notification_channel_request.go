@@ -0,0 +1,41 @@
+package request
+
+// CreateNotificationChannelRequest 创建通知渠道请求
+type CreateNotificationChannelRequest struct {
+	NamespaceID int      `json:"namespace_id" binding:"required,min=1"`              // 命名空间ID
+	Name        string   `json:"name" binding:"required,max=100"`                    // 名称
+	Type        string   `json:"type" binding:"required,oneof=dingtalk slack email"` // 渠道类型: dingtalk, slack, email
+	Target      string   `json:"target" binding:"required,max=1000"`                 // 发送目标：钉钉/Slack 为机器人 Webhook 地址，邮件为收件人（逗号分隔）
+	Secret      string   `json:"secret" binding:"max=255"`                           // 钉钉机器人加签密钥（可选）
+	Events      []string `json:"events" binding:"max=10"`                            // 事件过滤（为空时订阅全部事件）: publish, rollback, canary_failed
+	Template    string   `json:"template" binding:"max=4000"`                        // 自定义消息模板（Go text/template，为空时使用默认模板）
+	Enabled     *bool    `json:"enabled"`                                            // 是否启用（默认启用）
+	CreatedBy   string   `json:"created_by" binding:"required,max=100"`              // 创建人
+}
+
+// UpdateNotificationChannelRequest 更新通知渠道请求（渠道类型不可修改）
+type UpdateNotificationChannelRequest struct {
+	ID        int      `json:"id" binding:"required,min=1"`           // 通知渠道ID
+	Name      string   `json:"name" binding:"required,max=100"`       // 名称
+	Target    string   `json:"target" binding:"required,max=1000"`    // 发送目标
+	Secret    string   `json:"secret" binding:"max=255"`              // 钉钉机器人加签密钥（为空时保留原密钥）
+	Events    []string `json:"events" binding:"max=10"`               // 事件过滤（为空时订阅全部事件）
+	Template  string   `json:"template" binding:"max=4000"`           // 自定义消息模板（为空时使用默认模板）
+	Enabled   bool     `json:"enabled"`                               // 是否启用
+	UpdatedBy string   `json:"updated_by" binding:"required,max=100"` // 更新人
+}
+
+// DeleteNotificationChannelRequest 删除通知渠道请求
+type DeleteNotificationChannelRequest struct {
+	ID int `json:"id" binding:"required,min=1"` // 通知渠道ID
+}
+
+// ListNotificationChannelsRequest 查询命名空间通知渠道请求
+type ListNotificationChannelsRequest struct {
+	NamespaceID int `json:"namespace_id" form:"namespace_id" binding:"required,min=1"` // 命名空间ID
+}
+
+// TestNotificationChannelRequest 发送测试消息请求
+type TestNotificationChannelRequest struct {
+	Operator string `json:"operator" binding:"required,max=100"` // 操作人
+}
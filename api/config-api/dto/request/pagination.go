@@ -0,0 +1,24 @@
+package request
+
+import (
+	shareRepo "config-client/share/repository"
+)
+
+// 分页方式
+const (
+	PaginationOffset = "offset" // 页码分页（默认）
+	PaginationCursor = "cursor" // 游标分页（按创建时间倒序，不统计总数，适合大表翻页）
+)
+
+// parseCursor 解析分页参数，返回 nil 表示使用页码分页
+// pagination=cursor 或携带 cursor 时使用游标分页，cursor 为空表示第一页
+func parseCursor(pagination, cursor string) (*shareRepo.Cursor, error) {
+	if pagination != PaginationCursor && cursor == "" {
+		return nil, nil
+	}
+	c, err := shareRepo.DecodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
@@ -1,18 +1,23 @@
 package request
 
+import shareRepo "config-client/share/repository"
+
 // CreateReleaseRequest 创建发布版本请求
 type CreateReleaseRequest struct {
-	NamespaceID int    `json:"namespace_id" binding:"required"`                               // 命名空间ID
-	Environment string `json:"environment" binding:"required"`                                // 发布环境
-	VersionName string `json:"version_name" binding:"required"`                               // 版本名称
-	ReleaseType string `json:"release_type" binding:"required,oneof=full incremental canary"` // 发布类型
-	CreatedBy   string `json:"created_by" binding:"required"`                                 // 创建人
+	NamespaceID  int    `json:"namespace_id" binding:"required"`                               // 命名空间ID
+	Environment  string `json:"environment" binding:"required"`                                // 发布环境
+	VersionName  string `json:"version_name" binding:"required"`                               // 版本名称
+	ReleaseType  string `json:"release_type" binding:"required,oneof=full incremental canary"` // 发布类型
+	ReleaseNotes string `json:"release_notes"`                                                 // 发布说明（可选，也可在发布时填写，发布前必须填写）
+	CreatedBy    string `json:"created_by"`                                                    // 创建人（启用认证时取自 API Key 名称，填写时须与之一致）
 }
 
 // PublishFullRequest 全量发布请求
 type PublishFullRequest struct {
-	ReleaseID   int    `json:"release_id" binding:"required"`   // 发布版本ID
-	PublishedBy string `json:"published_by" binding:"required"` // 发布人
+	ReleaseID    int    `json:"release_id" binding:"required"`   // 发布版本ID
+	PublishedBy  string `json:"published_by" binding:"required"` // 发布人
+	ReleaseNotes string `json:"release_notes"`                   // 发布说明（可选，非空时覆盖创建版本时填写的说明）
+	FreezeOverrideFields
 }
 
 // PublishCanaryRequest 灰度发布请求
@@ -22,6 +27,14 @@ type PublishCanaryRequest struct {
 	IPRanges         []string `json:"ip_ranges"`                                 // IP段白名单
 	CanaryPercentage int      `json:"canary_percentage" binding:"min=0,max=100"` // 灰度百分比
 	PublishedBy      string   `json:"published_by" binding:"required"`           // 发布人
+	ReleaseNotes     string   `json:"release_notes"`                             // 发布说明（可选，非空时覆盖创建版本时填写的说明）
+	FreezeOverrideFields
+}
+
+// ReviewReleaseRequest 发布版本审批请求（审批通过或驳回）
+type ReviewReleaseRequest struct {
+	Operator string `json:"operator"` // 审批人（不能是版本创建人；启用认证时取自 API Key 名称，填写时须与之一致）
+	Comment  string `json:"comment"`  // 审批意见（驳回时必填）
 }
 
 // ReleaseRollbackRequest 版本回滚请求
@@ -30,6 +43,36 @@ type ReleaseRollbackRequest struct {
 	TargetReleaseID  int    `json:"target_release_id" binding:"required"`  // 目标版本ID
 	RollbackBy       string `json:"rollback_by" binding:"required"`        // 回滚人
 	Reason           string `json:"reason" binding:"required"`             // 回滚原因
+	FreezeOverrideFields
+}
+
+// FreezeOverrideFields 冻结窗口覆盖字段（发布和回滚请求共用）
+type FreezeOverrideFields struct {
+	FreezeOverride bool   `json:"freeze_override"` // 是否覆盖发布冻结窗口（冻结窗口内执行时必须为 true）
+	OverrideReason string `json:"override_reason"` // 覆盖原因（覆盖冻结窗口时必填，记录审计）
+}
+
+// FreezeStatusRequest 查询发布冻结状态请求
+type FreezeStatusRequest struct {
+	NamespaceID int    `json:"namespace_id" form:"namespace_id" binding:"required,min=1"` // 命名空间ID
+	Environment string `json:"environment" form:"environment" binding:"required"`         // 环境
+}
+
+// ListFreezeOverridesRequest 查询冻结窗口覆盖记录请求
+type ListFreezeOverridesRequest struct {
+	NamespaceID int    `json:"namespace_id" form:"namespace_id" binding:"required,min=1"` // 命名空间ID
+	Environment string `json:"environment" form:"environment"`                            // 环境（为空时不限环境）
+	Limit       int    `json:"limit" form:"limit"`                                        // 返回条数（默认 50，最大 200）
+}
+
+// SetDefaults 设置默认值
+func (r *ListFreezeOverridesRequest) SetDefaults() {
+	if r.Limit <= 0 {
+		r.Limit = 50
+	}
+	if r.Limit > 200 {
+		r.Limit = 200
+	}
 }
 
 // QueryReleaseRequest 查询发布版本请求
@@ -42,6 +85,10 @@ type QueryReleaseRequest struct {
 	Page        int     `json:"page" form:"page"`                 // 页码
 	Size        int     `json:"size" form:"size"`                 // 每页数量
 	OrderBy     string  `json:"order_by" form:"order_by"`         // 排序字段
+
+	// 游标分页：pagination=cursor 或携带 cursor 时生效，按创建时间倒序返回且不统计总数（忽略 page 和 order_by）
+	Pagination string `json:"pagination" form:"pagination" binding:"omitempty,oneof=offset cursor"` // 分页方式：offset（默认）/cursor
+	Cursor     string `json:"cursor" form:"cursor"`                                                 // 上一页返回的 next_cursor，为空表示第一页
 }
 
 // SetDefaults 设置默认值
@@ -60,8 +107,20 @@ func (r *QueryReleaseRequest) SetDefaults() {
 	}
 }
 
+// PageCursor 解析游标分页位置，返回 nil 表示使用页码分页
+func (r *QueryReleaseRequest) PageCursor() (*shareRepo.Cursor, error) {
+	return parseCursor(r.Pagination, r.Cursor)
+}
+
+// 版本对比模式
+const (
+	CompareModeRelease = "release" // 两个版本之间对比（默认）
+	CompareModeLive    = "live"    // 目标版本与当前线上配置对比
+)
+
 // CompareReleasesRequest 对比版本请求
 type CompareReleasesRequest struct {
-	FromReleaseID int `json:"from_release_id" binding:"required"` // 源版本ID
-	ToReleaseID   int `json:"to_release_id" binding:"required"`   // 目标版本ID
+	Mode          string `json:"mode" binding:"omitempty,oneof=release live"` // 对比模式：release（默认）/ live
+	FromReleaseID int    `json:"from_release_id"`                             // 源版本ID（mode=release 时必填）
+	ToReleaseID   int    `json:"to_release_id" binding:"required"`            // 目标版本ID
 }
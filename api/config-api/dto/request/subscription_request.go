@@ -2,13 +2,13 @@ package request
 
 // QuerySubscriptionRequest 订阅查询请求 DTO
 type QuerySubscriptionRequest struct {
-	NamespaceID *int    `json:"namespace_id" form:"namespace_id"`         // 命名空间ID
-	Environment *string `json:"environment" form:"environment"`           // 环境
-	ClientID    *string `json:"client_id" form:"client_id"`               // 客户端ID（模糊查询）
-	IsActive    *bool   `json:"is_active" form:"is_active"`               // 是否激活
-	Page        int     `json:"page" form:"page" binding:"min=1"`         // 页码，默认1
-	Size        int     `json:"size" form:"size" binding:"min=1,max=200"` // 每页数量，默认20
-	OrderBy     string  `json:"order_by" form:"order_by"`                 // 排序字段
+	NamespaceID *int    `json:"namespace_id" form:"namespace_id"`                   // 命名空间ID
+	Environment *string `json:"environment" form:"environment"`                     // 环境
+	ClientID    *string `json:"client_id" form:"client_id"`                         // 客户端ID（模糊查询）
+	IsActive    *bool   `json:"is_active" form:"is_active"`                         // 是否激活
+	Page        int     `json:"page" form:"page" binding:"omitempty,min=1"`         // 页码，默认1
+	Size        int     `json:"size" form:"size" binding:"omitempty,min=1,max=200"` // 每页数量，默认20
+	OrderBy     string  `json:"order_by" form:"order_by"`                           // 排序字段
 }
 
 // SetDefaults 设置默认值
@@ -25,3 +25,62 @@ func (q *QuerySubscriptionRequest) SetDefaults() {
 type DeactivateSubscriptionRequest struct {
 	ID int `json:"id" binding:"required,min=1"` // 订阅ID
 }
+
+// GetClientSubscriptionsRequest 查询客户端订阅请求 DTO
+type GetClientSubscriptionsRequest struct {
+	ClientID string `json:"client_id" form:"client_id" binding:"required,max=255"` // 客户端ID（精确匹配）
+}
+
+// DeactivateClientRequest 停用客户端订阅请求 DTO
+type DeactivateClientRequest struct {
+	ClientID    string  `json:"client_id" binding:"required,max=255"` // 客户端ID（精确匹配）
+	NamespaceID *int    `json:"namespace_id"`                         // 仅停用指定命名空间的订阅（可选）
+	Environment *string `json:"environment"`                          // 仅停用指定环境的订阅（可选）
+}
+
+// SubscriptionHeartbeatRequest 订阅心跳请求 DTO
+// 命名空间和环境与长轮询请求中第一个配置键保持一致（服务端按此记录订阅）
+type SubscriptionHeartbeatRequest struct {
+	ClientID    string `json:"client_id" binding:"required,max=255"`  // 客户端ID
+	NamespaceID int    `json:"namespace_id" binding:"required,min=1"` // 命名空间ID
+	Environment string `json:"environment" binding:"max=50"`          // 环境，默认"default"
+}
+
+// UnsubscribeRequest 客户端取消订阅请求 DTO
+type UnsubscribeRequest struct {
+	ClientID    string `json:"client_id" binding:"required,max=255"`  // 客户端ID
+	NamespaceID int    `json:"namespace_id" binding:"required,min=1"` // 命名空间ID
+	Environment string `json:"environment" binding:"max=50"`          // 环境，默认"default"
+}
+
+// GetListenerStatusRequest 查询配置键监听状态请求 DTO
+type GetListenerStatusRequest struct {
+	NamespaceID int    `json:"namespace_id" form:"namespace_id" binding:"required,min=1"` // 命名空间ID
+	Key         string `json:"key" form:"key" binding:"required,max=255"`                 // 配置键
+	Environment string `json:"environment" form:"environment" binding:"max=50"`           // 环境，默认"default"
+}
+
+// QueryPushTraceRequest 查询变更通知下发记录请求 DTO
+type QueryPushTraceRequest struct {
+	ReleaseID   *int    `json:"release_id" form:"release_id"`                       // 发布版本ID
+	ClientID    *string `json:"client_id" form:"client_id"`                         // 客户端ID（精确匹配）
+	NamespaceID *int    `json:"namespace_id" form:"namespace_id"`                   // 命名空间ID
+	Key         *string `json:"key" form:"key"`                                     // 配置键
+	Environment *string `json:"environment" form:"environment"`                     // 环境
+	Acked       *bool   `json:"acked" form:"acked"`                                 // 客户端是否已确认
+	Page        int     `json:"page" form:"page" binding:"omitempty,min=1"`         // 页码，默认1
+	Size        int     `json:"size" form:"size" binding:"omitempty,min=1,max=200"` // 每页数量，默认20
+}
+
+// SetDefaults 设置默认值
+func (q *QueryPushTraceRequest) SetDefaults() {
+	if q.Page <= 0 {
+		q.Page = 1
+	}
+	if q.Size <= 0 {
+		q.Size = 20
+	}
+	if q.Size > 200 {
+		q.Size = 200
+	}
+}
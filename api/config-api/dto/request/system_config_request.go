@@ -0,0 +1,7 @@
+package request
+
+// SetSystemConfigRequest 设置系统配置请求（配置键在路径中）
+type SetSystemConfigRequest struct {
+	Value       string `json:"value" binding:"max=10000"`      // 配置值
+	Description string `json:"description" binding:"max=1000"` // 描述（为空时清空原有描述）
+}
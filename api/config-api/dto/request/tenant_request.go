@@ -0,0 +1,18 @@
+package request
+
+// CreateTenantRequest 创建租户请求
+type CreateTenantRequest struct {
+	Code        string `json:"code" binding:"required,min=2,max=64"`  // 租户编码（小写字母、数字、下划线、中划线，创建后不可修改）
+	Name        string `json:"name" binding:"required,max=255"`       // 租户名称
+	Description string `json:"description" binding:"max=1000"`        // 描述
+	CreatedBy   string `json:"created_by" binding:"required,max=100"` // 创建人
+}
+
+// UpdateTenantRequest 更新租户请求
+type UpdateTenantRequest struct {
+	ID          int    `json:"id" binding:"required,min=1"`           // 租户ID
+	Name        string `json:"name" binding:"required,max=255"`       // 租户名称
+	Description string `json:"description" binding:"max=1000"`        // 描述
+	IsActive    bool   `json:"is_active"`                             // 是否启用（默认租户不能停用）
+	UpdatedBy   string `json:"updated_by" binding:"required,max=100"` // 更新人
+}
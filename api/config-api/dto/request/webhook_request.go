@@ -0,0 +1,54 @@
+package request
+
+// CreateWebhookRequest 创建 Webhook 请求
+type CreateWebhookRequest struct {
+	NamespaceID int      `json:"namespace_id" binding:"required,min=1"`    // 命名空间ID
+	Name        string   `json:"name" binding:"required,max=100"`          // 名称
+	URL         string   `json:"url" binding:"required,max=1000"`          // 投递地址（http/https）
+	Secret      string   `json:"secret" binding:"required,min=16,max=255"` // 签名密钥（至少16位）
+	Events      []string `json:"events" binding:"max=20"`                  // 事件过滤（为空时订阅全部事件，支持 config.*、release.* 通配）
+	Enabled     *bool    `json:"enabled"`                                  // 是否启用（默认启用）
+	CreatedBy   string   `json:"created_by" binding:"required,max=100"`    // 创建人
+}
+
+// UpdateWebhookRequest 更新 Webhook 请求
+type UpdateWebhookRequest struct {
+	ID        int      `json:"id" binding:"required,min=1"`               // Webhook ID
+	Name      string   `json:"name" binding:"required,max=100"`           // 名称
+	URL       string   `json:"url" binding:"required,max=1000"`           // 投递地址（http/https）
+	Secret    string   `json:"secret" binding:"omitempty,min=16,max=255"` // 签名密钥（为空时保留原密钥）
+	Events    []string `json:"events" binding:"max=20"`                   // 事件过滤（为空时订阅全部事件）
+	Enabled   bool     `json:"enabled"`                                   // 是否启用
+	UpdatedBy string   `json:"updated_by" binding:"required,max=100"`     // 更新人
+}
+
+// DeleteWebhookRequest 删除 Webhook 请求
+type DeleteWebhookRequest struct {
+	ID int `json:"id" binding:"required,min=1"` // Webhook ID
+}
+
+// ListWebhooksRequest 查询命名空间 Webhook 请求
+type ListWebhooksRequest struct {
+	NamespaceID int `json:"namespace_id" form:"namespace_id" binding:"required,min=1"` // 命名空间ID
+}
+
+// QueryWebhookDeliveriesRequest 查询 Webhook 投递记录请求
+type QueryWebhookDeliveriesRequest struct {
+	Status    *string `json:"status" form:"status"`               // 状态: pending, processing, succeeded, failed
+	EventType *string `json:"event_type" form:"event_type"`       // 事件类型
+	Page      int     `json:"page" form:"page"`                   // 页码，默认1
+	Size      int     `json:"size" form:"size" binding:"max=200"` // 每页数量，默认20，最大200
+}
+
+// SetDefaults 设置默认值
+func (q *QueryWebhookDeliveriesRequest) SetDefaults() {
+	if q.Page <= 0 {
+		q.Page = 1
+	}
+	if q.Size <= 0 {
+		q.Size = 20
+	}
+	if q.Size > 200 {
+		q.Size = 200
+	}
+}
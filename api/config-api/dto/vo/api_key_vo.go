@@ -0,0 +1,29 @@
+package vo
+
+import "time"
+
+// APIKeyVO API Key 视图对象（不返回密钥）
+type APIKeyVO struct {
+	ID          int        `json:"id"`           // API Key ID
+	TenantID    int        `json:"tenant_id"`    // 所属租户ID
+	Name        string     `json:"name"`         // 名称
+	Prefix      string     `json:"prefix"`       // 密钥前缀（用于识别密钥）
+	Role        string     `json:"role"`         // 角色
+	NamespaceID int        `json:"namespace_id"` // 只读令牌绑定的命名空间ID
+	Environment string     `json:"environment"`  // 只读令牌绑定的环境
+	KeyPrefixes []string   `json:"key_prefixes"` // 只读令牌允许读取的配置键前缀
+	Description string     `json:"description"`  // 描述
+	ExpiresAt   *time.Time `json:"expires_at"`   // 过期时间
+	LastUsedAt  *time.Time `json:"last_used_at"` // 最近使用时间
+	Revoked     bool       `json:"revoked"`      // 是否已吊销
+	RevokedAt   *time.Time `json:"revoked_at"`   // 吊销时间
+	RevokedBy   string     `json:"revoked_by"`   // 吊销人
+	CreatedBy   string     `json:"created_by"`   // 创建人
+	CreatedAt   time.Time  `json:"created_at"`   // 创建时间
+}
+
+// APIKeyCreatedVO 新建 API Key 视图对象（密钥明文仅在创建时返回一次）
+type APIKeyCreatedVO struct {
+	APIKeyVO
+	Key string `json:"key"` // 密钥明文
+}
@@ -35,21 +35,39 @@ type ChangeHistoryListVO struct {
 	Size       int                `json:"size"`        // 每页数量
 	TotalPages int                `json:"total_pages"` // 总页数
 	Items      []*ChangeHistoryVO `json:"items"`       // 变更历史列表
+
+	// NextCursor 下一页游标（仅游标分页返回，为空表示没有下一页）
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // VersionCompareVO 版本对比视图对象
 type VersionCompareVO struct {
-	FromHistoryID int       `json:"from_history_id"` // 源版本历史ID
-	ToHistoryID   int       `json:"to_history_id"`   // 目标版本历史ID
-	FromVersion   int       `json:"from_version"`    // 源版本号
-	ToVersion     int       `json:"to_version"`      // 目标版本号
-	FromValue     string    `json:"from_value"`      // 源版本值
-	ToValue       string    `json:"to_value"`        // 目标版本值
-	ValueChanged  bool      `json:"value_changed"`   // 值是否变化
-	FromOperation string    `json:"from_operation"`  // 源操作类型
-	ToOperation   string    `json:"to_operation"`    // 目标操作类型
-	FromChangedAt time.Time `json:"from_changed_at"` // 源变更时间
-	ToChangedAt   time.Time `json:"to_changed_at"`   // 目标变更时间
+	FromHistoryID int          `json:"from_history_id"` // 源版本历史ID
+	ToHistoryID   int          `json:"to_history_id"`   // 目标版本历史ID
+	FromVersion   int          `json:"from_version"`    // 源版本号
+	ToVersion     int          `json:"to_version"`      // 目标版本号
+	FromValue     string       `json:"from_value"`      // 源版本值
+	ToValue       string       `json:"to_value"`        // 目标版本值
+	ValueChanged  bool         `json:"value_changed"`   // 值是否变化
+	FromOperation string       `json:"from_operation"`  // 源操作类型
+	ToOperation   string       `json:"to_operation"`    // 目标操作类型
+	FromChangedAt time.Time    `json:"from_changed_at"` // 源变更时间
+	ToChangedAt   time.Time    `json:"to_changed_at"`   // 目标变更时间
+	Diff          *ValueDiffVO `json:"diff,omitempty"`  // 值差异（加密配置不返回）
+}
+
+// ValueDiffVO 配置值差异视图对象
+type ValueDiffVO struct {
+	UnifiedDiff string          `json:"unified_diff"`      // 行级差异（unified diff 格式，可直接渲染），值相同时为空
+	Changes     []FieldChangeVO `json:"changes,omitempty"` // 字段级差异（仅 JSON/YAML 值）
+}
+
+// FieldChangeVO 字段级变更视图对象
+type FieldChangeVO struct {
+	Path     string      `json:"path"`      // 字段路径，如 db.hosts[0]
+	Type     string      `json:"type"`      // 变更类型：added/removed/modified
+	OldValue interface{} `json:"old_value"` // 变更前的值
+	NewValue interface{} `json:"new_value"` // 变更后的值
 }
 
 // ChangeStatisticsVO 变更统计视图对象
@@ -0,0 +1,59 @@
+package vo
+
+// ConfigDependencyNodeVO 依赖关系中的配置节点视图对象
+type ConfigDependencyNodeVO struct {
+	ConfigID      int    `json:"config_id"`                // 配置ID
+	NamespaceID   int    `json:"namespace_id"`             // 命名空间ID
+	NamespaceName string `json:"namespace_name,omitempty"` // 命名空间名称
+	Key           string `json:"key"`                      // 配置键
+	Environment   string `json:"environment"`              // 环境
+	GroupName     string `json:"group_name"`               // 配置分组
+	IsReleased    bool   `json:"is_released"`              // 是否已发布
+	External      bool   `json:"external,omitempty"`       // 是否为依赖图范围之外的配置
+}
+
+// ConfigDependenciesVO 配置显式依赖视图对象
+type ConfigDependenciesVO struct {
+	Config     *ConfigDependencyNodeVO   `json:"config"`      // 当前配置
+	DependsOn  []*ConfigDependencyNodeVO `json:"depends_on"`  // 依赖的上游配置
+	DependedBy []*ConfigDependencyNodeVO `json:"depended_by"` // 依赖它的下游配置
+}
+
+// ConfigDependencyEdgeVO 依赖图边视图对象（from 依赖 to）
+type ConfigDependencyEdgeVO struct {
+	FromConfigID int    `json:"from_config_id"` // 依赖方配置ID
+	ToConfigID   int    `json:"to_config_id"`   // 被依赖的配置ID
+	Type         string `json:"type"`           // 依赖类型：declared（显式声明）/ reference（值引用）
+}
+
+// ConfigDependencyGraphVO 依赖图视图对象
+type ConfigDependencyGraphVO struct {
+	Nodes []*ConfigDependencyNodeVO `json:"nodes"` // 节点
+	Edges []*ConfigDependencyEdgeVO `json:"edges"` // 边
+}
+
+// ImpactedConfigVO 受影响的下游配置视图对象
+type ImpactedConfigVO struct {
+	*ConfigDependencyNodeVO
+	Depth          int    `json:"depth"`           // 与变更配置的距离（直接依赖为 1）
+	DependencyType string `json:"dependency_type"` // 与上一跳之间的依赖类型
+	ViaConfigID    int    `json:"via_config_id"`   // 上一跳配置ID
+}
+
+// ImpactedSubscribersVO 受影响的订阅客户端视图对象
+type ImpactedSubscribersVO struct {
+	NamespaceID   int      `json:"namespace_id"`   // 命名空间ID
+	NamespaceName string   `json:"namespace_name"` // 命名空间名称
+	Environment   string   `json:"environment"`    // 环境
+	ClientIDs     []string `json:"client_ids"`     // 客户端ID列表
+}
+
+// ConfigImpactAnalysisVO 变更影响分析视图对象
+type ConfigImpactAnalysisVO struct {
+	Config          *ConfigDependencyNodeVO  `json:"config"`           // 待变更的配置
+	Impacted        []*ImpactedConfigVO      `json:"impacted"`         // 受影响的下游配置（按距离排序）
+	Subscribers     []*ImpactedSubscribersVO `json:"subscribers"`      // 受影响的订阅客户端
+	ImpactedCount   int                      `json:"impacted_count"`   // 受影响的下游配置数
+	SubscriberCount int                      `json:"subscriber_count"` // 受影响的客户端数
+	Truncated       bool                     `json:"truncated"`        // 下游配置过多时为 true，结果不完整
+}
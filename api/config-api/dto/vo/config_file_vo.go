@@ -0,0 +1,22 @@
+package vo
+
+import (
+	"time"
+)
+
+// ConfigFileVO 文件配置视图对象（不含文件内容）
+type ConfigFileVO struct {
+	ConfigID    int       `json:"config_id"`         // 配置ID
+	NamespaceID int       `json:"namespace_id"`      // 命名空间ID
+	Key         string    `json:"key"`               // 配置键
+	Environment string    `json:"environment"`       // 环境
+	Version     int       `json:"version"`           // 配置版本号
+	IsReleased  bool      `json:"is_released"`       // 是否已发布
+	FileName    string    `json:"file_name"`         // 文件名
+	ContentType string    `json:"content_type"`      // 内容类型
+	Size        int64     `json:"size"`              // 文件大小（字节）
+	SHA256      string    `json:"sha256"`            // 内容 SHA-256 哈希（十六进制）
+	Changed     *bool     `json:"changed,omitempty"` // 上传时内容是否发生变化（仅上传接口返回）
+	UploadedBy  string    `json:"uploaded_by"`       // 上传人
+	UpdatedAt   time.Time `json:"updated_at"`        // 配置更新时间
+}
@@ -0,0 +1,35 @@
+package vo
+
+import "time"
+
+// ConfigGroupVO 配置分组视图对象
+type ConfigGroupVO struct {
+	ID          int                 `json:"id"`                    // 分组ID（未登记的分组为 0）
+	NamespaceID int                 `json:"namespace_id"`          // 命名空间ID
+	Name        string              `json:"name"`                  // 分组名称
+	Description string              `json:"description,omitempty"` // 描述
+	SortOrder   int                 `json:"sort_order"`            // 展示顺序
+	Registered  bool                `json:"registered"`            // 是否已登记为分组（否则仅被配置的 group_name 引用）
+	Stats       *ConfigGroupStatsVO `json:"stats"`                 // 分组统计
+	CreatedBy   string              `json:"created_by,omitempty"`  // 创建人
+	UpdatedBy   string              `json:"updated_by,omitempty"`  // 更新人
+	CreatedAt   *time.Time          `json:"created_at,omitempty"`  // 创建时间
+	UpdatedAt   *time.Time          `json:"updated_at,omitempty"`  // 更新时间
+}
+
+// ConfigGroupStatsVO 配置分组统计视图对象
+type ConfigGroupStatsVO struct {
+	Total         int64      `json:"total"`                     // 配置总数
+	Released      int64      `json:"released"`                  // 已发布配置数
+	Active        int64      `json:"active"`                    // 已激活配置数
+	LastUpdatedAt *time.Time `json:"last_updated_at,omitempty"` // 分组内配置的最近更新时间
+}
+
+// ConfigGroupReleaseVO 分组发布结果视图对象
+type ConfigGroupReleaseVO struct {
+	GroupID           int    `json:"group_id"`            // 分组ID
+	GroupName         string `json:"group_name"`          // 分组名称
+	Environment       string `json:"environment"`         // 环境
+	ReleasedCount     int    `json:"released_count"`      // 本次发布的配置数
+	ReleasedConfigIDs []int  `json:"released_config_ids"` // 本次发布的配置ID
+}
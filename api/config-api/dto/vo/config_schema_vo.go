@@ -0,0 +1,18 @@
+package vo
+
+import "time"
+
+// ConfigSchemaVO 配置 Schema 绑定视图对象
+type ConfigSchemaVO struct {
+	ID          int       `json:"id"`                    // Schema 绑定ID
+	NamespaceID int       `json:"namespace_id"`          // 命名空间ID
+	ConfigKey   string    `json:"config_key,omitempty"`  // 绑定的配置键
+	GroupName   string    `json:"group_name,omitempty"`  // 绑定的配置分组
+	Schema      string    `json:"schema"`                // JSON Schema 文档
+	Description string    `json:"description,omitempty"` // 描述
+	IsActive    bool      `json:"is_active"`             // 是否启用
+	CreatedBy   string    `json:"created_by"`            // 创建人
+	UpdatedBy   string    `json:"updated_by"`            // 更新人
+	CreatedAt   time.Time `json:"created_at"`            // 创建时间
+	UpdatedAt   time.Time `json:"updated_at"`            // 更新时间
+}
@@ -0,0 +1,82 @@
+package vo
+
+// ConfigImportResultVO 配置导入结果视图对象
+type ConfigImportResultVO struct {
+	DryRun    bool                  `json:"dry_run"`   // 是否为预览
+	Total     int                   `json:"total"`     // 解析出的配置总数
+	Created   int                   `json:"created"`   // 新建数量
+	Updated   int                   `json:"updated"`   // 覆盖数量
+	Skipped   int                   `json:"skipped"`   // 跳过数量
+	Unchanged int                   `json:"unchanged"` // 未变化数量
+	Failed    int                   `json:"failed"`    // 失败数量
+	Items     []*ConfigImportItemVO `json:"items"`     // 逐项结果
+}
+
+// ConfigImportItemVO 单个配置的导入结果视图对象
+type ConfigImportItemVO struct {
+	Key       string `json:"key"`              // 配置键
+	ValueType string `json:"value_type"`       // 值类型
+	Action    string `json:"action"`           // 动作：create/update/skip/unchanged/failed
+	Reason    string `json:"reason,omitempty"` // 跳过或失败原因
+}
+
+// ConfigExportVO 配置导出视图对象
+type ConfigExportVO struct {
+	Filename    string `json:"filename"`     // 建议的下载文件名
+	ContentType string `json:"content_type"` // 文档类型
+	Content     string `json:"content"`      // 文档内容
+	Count       int    `json:"count"`        // 导出的配置数量
+}
+
+// PromotionDiffVO 环境晋升差异视图对象
+type PromotionDiffVO struct {
+	NamespaceID       int                    `json:"namespace_id"`       // 命名空间ID
+	SourceEnvironment string                 `json:"source_environment"` // 源环境
+	TargetEnvironment string                 `json:"target_environment"` // 目标环境
+	Added             []*PromotionDiffItemVO `json:"added"`              // 新增的配置
+	Modified          []*PromotionDiffItemVO `json:"modified"`           // 修改的配置
+	Deleted           []*PromotionDiffItemVO `json:"deleted"`            // 目标环境多余的配置
+	DiffToken         string                 `json:"diff_token"`         // 差异摘要，确认执行时回传
+}
+
+// PromotionDiffItemVO 环境晋升差异项视图对象
+type PromotionDiffItemVO struct {
+	Key            string `json:"key"`             // 配置键
+	ValueType      string `json:"value_type"`      // 值类型
+	OldValue       string `json:"old_value"`       // 目标环境当前值（敏感配置已脱敏）
+	NewValue       string `json:"new_value"`       // 源环境值（敏感配置已脱敏）
+	IsSensitive    bool   `json:"is_sensitive"`    // 是否敏感配置
+	TargetReleased bool   `json:"target_released"` // 目标配置是否已发布（已发布需先取消发布）
+}
+
+// PromotionResultVO 环境晋升结果视图对象
+type PromotionResultVO struct {
+	Diff    *PromotionDiffVO         `json:"diff"`    // 执行时的差异
+	Applied int                      `json:"applied"` // 成功数量
+	Skipped int                      `json:"skipped"` // 跳过数量
+	Failed  int                      `json:"failed"`  // 失败数量
+	Items   []*PromotionItemResultVO `json:"items"`   // 逐项结果
+}
+
+// PromotionItemResultVO 单个配置的晋升结果视图对象
+type PromotionItemResultVO struct {
+	Key    string `json:"key"`              // 配置键
+	Action string `json:"action"`           // 动作：create/update/delete/skip/failed
+	Reason string `json:"reason,omitempty"` // 跳过或失败原因
+}
+
+// NamespaceCloneVO 命名空间克隆结果视图对象
+type NamespaceCloneVO struct {
+	Namespace *NamespaceVO            `json:"namespace"` // 新建的命名空间
+	Cloned    int                     `json:"cloned"`    // 成功复制的配置数量
+	Failed    int                     `json:"failed"`    // 复制失败的配置数量
+	Items     []*NamespaceCloneItemVO `json:"items"`     // 逐项结果
+}
+
+// NamespaceCloneItemVO 单个配置的克隆结果视图对象
+type NamespaceCloneItemVO struct {
+	Key         string `json:"key"`              // 配置键
+	Environment string `json:"environment"`      // 环境
+	Success     bool   `json:"success"`          // 是否复制成功
+	Reason      string `json:"reason,omitempty"` // 失败原因
+}
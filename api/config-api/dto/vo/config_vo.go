@@ -23,10 +23,21 @@ type ConfigVO struct {
 	ContentHash          string         `json:"content_hash,omitempty"`           // 内容哈希
 	ContentHashAlgorithm string         `json:"content_hash_algorithm,omitempty"` // 哈希算法
 	Tags                 []*ConfigTagVO `json:"tags,omitempty"`                   // 配置标签
+	ResolvedValue        string         `json:"resolved_value,omitempty"`         // 解析 ${namespace:key} 引用后的值（可能已脱敏）
+	References           []string       `json:"references,omitempty"`             // 引用的配置（namespace:key）
+	ReferenceError       string         `json:"reference_error,omitempty"`        // 引用解析失败原因
+	IsCanary             bool           `json:"is_canary,omitempty"`              // 是否为灰度版本中的值
+	ReleaseVersion       int            `json:"release_version,omitempty"`        // 灰度发布版本号
+	ExpiresAt            *time.Time     `json:"expires_at,omitempty"`             // 过期时间
 	CreatedBy            string         `json:"created_by"`                       // 创建人
 	UpdatedBy            string         `json:"updated_by"`                       // 更新人
 	CreatedAt            time.Time      `json:"created_at"`                       // 创建时间
 	UpdatedAt            time.Time      `json:"updated_at"`                       // 更新时间
+	DeletedAt            *time.Time     `json:"deleted_at,omitempty"`             // 删除时间（仅回收站中的配置）
+
+	// Signature 配置值签名（启用配置签名时返回，base64 编码的 Ed25519 签名，客户端使用公钥校验）
+	Signature      string `json:"signature,omitempty"`
+	SignatureKeyID string `json:"signature_key_id,omitempty"` // 签名密钥ID
 }
 
 // ConfigListVO 配置列表视图对象（分页响应）
@@ -36,4 +47,85 @@ type ConfigListVO struct {
 	Size       int         `json:"size"`        // 每页数量
 	TotalPages int         `json:"total_pages"` // 总页数
 	Items      []*ConfigVO `json:"items"`       // 配置列表
+
+	// NextCursor 下一页游标（仅游标分页返回，为空表示没有下一页）
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// ConfigSearchListVO 配置搜索结果列表视图对象
+type ConfigSearchListVO struct {
+	Total      int64                 `json:"total"`       // 总数
+	Page       int                   `json:"page"`        // 当前页码
+	Size       int                   `json:"size"`        // 每页数量
+	TotalPages int                   `json:"total_pages"` // 总页数
+	Items      []*ConfigSearchItemVO `json:"items"`       // 搜索结果（按匹配度排序）
+}
+
+// ConfigSearchItemVO 配置搜索结果视图对象
+type ConfigSearchItemVO struct {
+	Config        *ConfigVO         `json:"config"`         // 配置（敏感配置的值已脱敏）
+	MatchedFields []string          `json:"matched_fields"` // 命中的字段：key/description/group/value
+	Highlights    map[string]string `json:"highlights"`     // 命中字段的高亮片段（已做 HTML 转义，命中部分以 <mark></mark> 包裹）
+}
+
+// ConfigBulkGetVO 批量获取配置视图对象
+type ConfigBulkGetVO struct {
+	Items   []*ConfigVO `json:"items"`   // 已发布且已激活的配置（按配置键升序）
+	Missing []string    `json:"missing"` // 不存在、未发布、未激活或已过期的配置键
+}
+
+// EffectiveConfigVO 生效配置视图对象
+type EffectiveConfigVO struct {
+	NamespaceID int                      `json:"namespace_id"` // 命名空间ID
+	Environment string                   `json:"environment"`  // 环境
+	Items       []*EffectiveConfigItemVO `json:"items"`        // 生效配置列表
+}
+
+// EffectiveConfigItemVO 单个生效配置视图对象
+type EffectiveConfigItemVO struct {
+	Key         string   `json:"key"`                  // 配置键
+	Value       string   `json:"value"`                // 解析引用后的配置值（敏感配置已脱敏）
+	ValueType   string   `json:"value_type"`           // 值类型
+	GroupName   string   `json:"group_name"`           // 配置分组
+	Environment string   `json:"environment"`          // 配置来源环境
+	Version     int      `json:"version"`              // 版本号
+	IsSensitive bool     `json:"is_sensitive"`         // 是否敏感（含引用了敏感配置）
+	References  []string `json:"references,omitempty"` // 引用的配置（namespace:key）
+	Error       string   `json:"error,omitempty"`      // 引用解析失败原因（此时 value 为原始值）
+}
+
+// ConfigValidationVO 配置校验结果视图对象
+type ConfigValidationVO struct {
+	Valid      bool     `json:"valid"`                // 是否通过校验
+	Code       int      `json:"code,omitempty"`       // 未通过时的错误码
+	Message    string   `json:"message,omitempty"`    // 未通过时的原因
+	Validators []string `json:"validators,omitempty"` // 元数据中声明的校验器
+}
+
+// BatchMutationVO 批量变更结果视图对象
+type BatchMutationVO struct {
+	Committed bool                   `json:"committed"` // 是否已提交（任一条目失败时整批回滚）
+	Total     int                    `json:"total"`     // 条目总数
+	Items     []*BatchMutationItemVO `json:"items"`     // 每个条目的执行结果
+}
+
+// BatchMutationItemVO 单条批量变更结果视图对象
+type BatchMutationItemVO struct {
+	Index    int    `json:"index"`               // 条目序号（从0开始）
+	Action   string `json:"action"`              // 操作类型
+	Key      string `json:"key"`                 // 配置键
+	ConfigID int    `json:"config_id,omitempty"` // 配置ID
+	Version  int    `json:"version,omitempty"`   // 变更后的版本号
+	Status   string `json:"status"`              // 状态：succeeded/failed/rolled_back/skipped
+	Error    string `json:"error,omitempty"`     // 失败原因
+}
+
+// ContentHashMigrationVO 内容哈希迁移结果视图对象
+type ContentHashMigrationVO struct {
+	Algorithm  string   `json:"algorithm"`  // 目标算法（服务端当前的默认算法）
+	DryRun     bool     `json:"dry_run"`    // 是否为试运行
+	Scanned    int      `json:"scanned"`    // 扫描的配置数量
+	Migrated   int      `json:"migrated"`   // 已迁移的配置数量（试运行时为待迁移数量）
+	Skipped    int      `json:"skipped"`    // 迁移期间配置被修改而跳过的数量
+	Mismatched []string `json:"mismatched"` // 原哈希校验失败而未迁移的配置（键@环境）
 }
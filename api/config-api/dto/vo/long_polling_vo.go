@@ -5,13 +5,34 @@ type LongPollingResponse struct {
 	Changed    bool                 `json:"changed"`     // 是否有配置变更
 	ConfigKeys []string             `json:"config_keys"` // 变更的配置键列表（格式: "namespaceID:configKey"）
 	Configs    []ConfigChangeDetail `json:"configs"`     // 变更的配置详情
+	Sequence   int64                `json:"sequence"`    // 第一个配置所在命名空间的事件序号（兼容旧客户端，保存后在下次请求的 last_sequence 中携带）
+	Sequences  map[int]int64        `json:"sequences"`   // 各命名空间的事件序号（命名空间ID -> 序号，保存后在下次请求的 last_sequences 中携带）
+
+	// NextPollDelayMs 建议客户端发起下一次请求前等待的时间（毫秒，服务端负载较高时延长；未返回时由客户端决定）
+	NextPollDelayMs int64 `json:"next_poll_delay_ms,omitempty"`
+}
+
+// WatchMessage WebSocket 监听时服务端发送的消息
+type WatchMessage struct {
+	Type       string               `json:"type"`                  // 消息类型（result 监听结果，error 请求失败）
+	Data       *LongPollingResponse `json:"data,omitempty"`        // 监听结果（与长轮询响应一致）
+	Code       int                  `json:"code,omitempty"`        // 错误码
+	Message    string               `json:"message,omitempty"`     // 错误信息
+	RetryAfter int                  `json:"retry_after,omitempty"` // 建议客户端重新发送请求前等待的时间（秒）
 }
 
 // ConfigChangeDetail 配置变更详情
 type ConfigChangeDetail struct {
 	NamespaceID int    `json:"namespace_id"` // 命名空间ID
+	Environment string `json:"environment"`  // 环境
 	ConfigKey   string `json:"config_key"`   // 配置键
-	Version     string `json:"version"`      // 最新版本号（MD5）
+	Version     string `json:"version"`      // 最新版本号（MD5，按返回的值计算；配置已删除时为空）
 	Value       string `json:"value"`        // 配置值
 	ValueType   string `json:"value_type"`   // 值类型
+	IsCanary    bool   `json:"is_canary"`    // 是否为灰度版本中的值
+	Deleted     bool   `json:"deleted"`      // 配置是否已删除或不可用（未发布、未激活、已过期）
+
+	// Signature 配置值签名（启用配置签名时返回，已删除的配置按空值签名）
+	Signature      string `json:"signature,omitempty"`
+	SignatureKeyID string `json:"signature_key_id,omitempty"` // 签名密钥ID
 }
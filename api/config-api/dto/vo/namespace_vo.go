@@ -4,16 +4,18 @@ import "time"
 
 // NamespaceVO 命名空间视图对象
 type NamespaceVO struct {
-	ID          int       `json:"id"`           // ID
-	Name        string    `json:"name"`         // 命名空间名称
-	DisplayName string    `json:"display_name"` // 显示名称
-	Description string    `json:"description"`  // 描述信息
-	IsActive    bool      `json:"is_active"`    // 是否激活
-	Metadata    string    `json:"metadata"`     // 扩展元数据
-	CreatedBy   string    `json:"created_by"`   // 创建人
-	UpdatedBy   string    `json:"updated_by"`   // 更新人
-	CreatedAt   time.Time `json:"created_at"`   // 创建时间
-	UpdatedAt   time.Time `json:"updated_at"`   // 更新时间
+	ID          int              `json:"id"`           // ID
+	TenantID    int              `json:"tenant_id"`    // 所属租户ID
+	Name        string           `json:"name"`         // 命名空间名称
+	DisplayName string           `json:"display_name"` // 显示名称
+	Description string           `json:"description"`  // 描述信息
+	IsActive    bool             `json:"is_active"`    // 是否激活
+	Metadata    string           `json:"metadata"`     // 扩展元数据
+	Quota       NamespaceQuotaVO `json:"quota"`        // 命名空间上设置的配额（0 表示继承全局默认配额）
+	CreatedBy   string           `json:"created_by"`   // 创建人
+	UpdatedBy   string           `json:"updated_by"`   // 更新人
+	CreatedAt   time.Time        `json:"created_at"`   // 创建时间
+	UpdatedAt   time.Time        `json:"updated_at"`   // 更新时间
 }
 
 // NamespaceListVO 命名空间列表视图对象
@@ -23,3 +25,33 @@ type NamespaceListVO struct {
 	PageSize   int            `json:"page_size"`  // 每页数量
 	Namespaces []*NamespaceVO `json:"namespaces"` // 命名空间列表
 }
+
+// NamespaceQuotaVO 命名空间配额视图对象
+type NamespaceQuotaVO struct {
+	MaxConfigs          int `json:"max_configs"`           // 配置数量上限
+	MaxValueSize        int `json:"max_value_size"`        // 单个配置值大小上限（字节）
+	MaxSubscribers      int `json:"max_subscribers"`       // 活跃订阅数量上限
+	MaxReleasesRetained int `json:"max_releases_retained"` // 每个环境保留的发布版本数量
+}
+
+// NamespaceStatsVO 命名空间用量视图对象（limit 为生效配额，0 表示不限制）
+type NamespaceStatsVO struct {
+	NamespaceID int                    `json:"namespace_id"` // 命名空间ID
+	Configs     *QuotaUsageVO          `json:"configs"`      // 配置数量
+	ValueSize   *QuotaUsageVO          `json:"value_size"`   // 最大的配置值大小（字节）
+	Subscribers *QuotaUsageVO          `json:"subscribers"`  // 活跃订阅数量
+	Releases    []*ReleaseQuotaUsageVO `json:"releases"`     // 各环境的发布版本数量
+}
+
+// QuotaUsageVO 配额用量
+type QuotaUsageVO struct {
+	Used  int64 `json:"used"`  // 当前用量
+	Limit int   `json:"limit"` // 生效配额（0 表示不限制）
+}
+
+// ReleaseQuotaUsageVO 环境发布版本数量
+type ReleaseQuotaUsageVO struct {
+	Environment string `json:"environment"` // 环境
+	Used        int64  `json:"used"`        // 发布版本数量
+	Limit       int    `json:"limit"`       // 保留数量（0 表示不限制）
+}
@@ -0,0 +1,20 @@
+package vo
+
+import "time"
+
+// NotificationChannelVO 通知渠道视图对象（不返回加签密钥）
+type NotificationChannelVO struct {
+	ID          int       `json:"id"`           // 通知渠道ID
+	NamespaceID int       `json:"namespace_id"` // 命名空间ID
+	Name        string    `json:"name"`         // 名称
+	Type        string    `json:"type"`         // 渠道类型: dingtalk, slack, email
+	Target      string    `json:"target"`       // 发送目标
+	HasSecret   bool      `json:"has_secret"`   // 是否配置了加签密钥
+	Events      []string  `json:"events"`       // 事件过滤（为空时订阅全部事件）
+	Template    string    `json:"template"`     // 自定义消息模板（为空时使用默认模板）
+	Enabled     bool      `json:"enabled"`      // 是否启用
+	CreatedBy   string    `json:"created_by"`   // 创建人
+	UpdatedBy   string    `json:"updated_by"`   // 更新人
+	CreatedAt   time.Time `json:"created_at"`   // 创建时间
+	UpdatedAt   time.Time `json:"updated_at"`   // 更新时间
+}
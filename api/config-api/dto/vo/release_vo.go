@@ -12,6 +12,11 @@ type ReleaseVO struct {
 	ConfigCount         int                    `json:"config_count"`
 	Status              string                 `json:"status"`
 	ReleaseType         string                 `json:"release_type"`
+	BaseReleaseID       int                    `json:"base_release_id"`
+	ReleaseNotes        string                 `json:"release_notes"`
+	ApprovalStatus      string                 `json:"approval_status"`
+	ApprovedBy          string                 `json:"approved_by"`
+	ApprovedAt          *time.Time             `json:"approved_at"`
 	CanaryPercentage    int                    `json:"canary_percentage"`
 	CanaryRule          *CanaryRuleVO          `json:"canary_rule,omitempty"`
 	ConfigSnapshot      []ConfigSnapshotItemVO `json:"config_snapshot,omitempty"`
@@ -44,6 +49,57 @@ type ConfigSnapshotItemVO struct {
 	ContentHashAlgorithm string `json:"content_hash_algorithm"`
 	Description          string `json:"description"`
 	Version              int    `json:"version"`
+	Deleted              bool   `json:"deleted,omitempty"`
+}
+
+// ReleaseChangelogVO 发布版本变更日志值对象
+type ReleaseChangelogVO struct {
+	ReleaseID     int      `json:"release_id"`      // 发布版本ID
+	Version       int      `json:"version"`         // 版本号
+	VersionName   string   `json:"version_name"`    // 版本名称
+	Status        string   `json:"status"`          // 状态
+	ReleaseNotes  string   `json:"release_notes"`   // 发布说明
+	BaseReleaseID int      `json:"base_release_id"` // 对比的上一已发布版本ID（0 表示首个发布版本）
+	BaseVersion   int      `json:"base_version"`    // 对比的上一已发布版本号
+	Added         []string `json:"added"`           // 新增的配置键
+	Modified      []string `json:"modified"`        // 修改的配置键
+	Deleted       []string `json:"deleted"`         // 删除的配置键
+	Text          string   `json:"text"`            // 变更日志文本（发布说明后追加键级变更明细）
+}
+
+// ReleaseApprovalVO 发布审批记录值对象
+type ReleaseApprovalVO struct {
+	ID          int       `json:"id"`
+	ReleaseID   int       `json:"release_id"`
+	NamespaceID int       `json:"namespace_id"`
+	Environment string    `json:"environment"`
+	Version     int       `json:"version"`
+	Action      string    `json:"action"` // 审批动作：approve（通过）/reject（驳回）
+	Operator    string    `json:"operator"`
+	Comment     string    `json:"comment"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// FreezeStatusVO 发布冻结状态值对象
+type FreezeStatusVO struct {
+	NamespaceID int        `json:"namespace_id"`
+	Environment string     `json:"environment"`
+	Frozen      bool       `json:"frozen"`                // 当前是否处于冻结窗口
+	WindowName  string     `json:"window_name,omitempty"` // 生效的冻结窗口名称
+	EndsAt      *time.Time `json:"ends_at,omitempty"`     // 冻结窗口结束时间
+}
+
+// ReleaseFreezeOverrideVO 冻结窗口覆盖记录值对象
+type ReleaseFreezeOverrideVO struct {
+	ID          int       `json:"id"`
+	ReleaseID   int       `json:"release_id"`
+	NamespaceID int       `json:"namespace_id"`
+	Environment string    `json:"environment"`
+	WindowName  string    `json:"window_name"`
+	Operation   string    `json:"operation"` // 操作类型：publish_full/publish_canary/rollback
+	Operator    string    `json:"operator"`
+	Reason      string    `json:"reason"`
+	CreatedAt   time.Time `json:"created_at"`
 }
 
 // ReleaseListVO 发布版本列表值对象
@@ -52,10 +108,14 @@ type ReleaseListVO struct {
 	Total int64        `json:"total"`
 	Page  int          `json:"page"`
 	Size  int          `json:"size"`
+
+	// NextCursor 下一页游标（仅游标分页返回，为空表示没有下一页）
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // ReleaseCompareVO 版本对比值对象
 type ReleaseCompareVO struct {
+	FromLive      bool                   `json:"from_live"` // 源为当前线上配置
 	FromReleaseID int                    `json:"from_release_id"`
 	ToReleaseID   int                    `json:"to_release_id"`
 	FromVersion   int                    `json:"from_version"`
@@ -67,7 +127,9 @@ type ReleaseCompareVO struct {
 
 // ConfigDiffVO 配置差异值对象
 type ConfigDiffVO struct {
-	Key      string `json:"key"`
-	OldValue string `json:"old_value"`
-	NewValue string `json:"new_value"`
+	Key       string       `json:"key"`
+	ValueType string       `json:"value_type"`
+	OldValue  string       `json:"old_value"`
+	NewValue  string       `json:"new_value"`
+	Diff      *ValueDiffVO `json:"diff,omitempty"` // 值差异（加密配置不返回）
 }
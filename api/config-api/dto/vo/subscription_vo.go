@@ -40,3 +40,98 @@ type SubscriptionStatisticsVO struct {
 	ActiveInMemory          int   `json:"active_in_memory"`          // 内存活跃订阅数
 	HeartbeatTimeoutSeconds int   `json:"heartbeat_timeout_seconds"` // 心跳超时阈值（秒）
 }
+
+// SubscriptionDetailVO 订阅详情视图对象（数据库统计 + 当前实例内存中的长轮询状态）
+type SubscriptionDetailVO struct {
+	Subscription     *SubscriptionVO   `json:"subscription"`               // 订阅记录（含轮询次数、变更次数、最后心跳）
+	HeartbeatExpired bool              `json:"heartbeat_expired"`          // 心跳是否已超时
+	Online           bool              `json:"online"`                     // 当前实例是否持有该客户端的长轮询连接
+	RegisteredAt     *time.Time        `json:"registered_at,omitempty"`    // 本次长轮询注册时间
+	WatchedKeys      []string          `json:"watched_keys"`               // 正在监听的配置键
+	CurrentVersions  map[string]string `json:"current_versions,omitempty"` // 客户端上报的配置版本（配置键 -> 版本）
+}
+
+// ClientSubscriptionsVO 客户端订阅视图对象
+type ClientSubscriptionsVO struct {
+	ClientID      string                  `json:"client_id"`     // 客户端ID
+	Subscriptions []*SubscriptionDetailVO `json:"subscriptions"` // 客户端在各命名空间和环境下的订阅
+}
+
+// DeactivateClientVO 停用客户端订阅结果视图对象
+type DeactivateClientVO struct {
+	ClientID      string            `json:"client_id"`     // 客户端ID
+	Deactivated   int               `json:"deactivated"`   // 本次停用的订阅数量
+	Subscriptions []*SubscriptionVO `json:"subscriptions"` // 本次停用的订阅
+}
+
+// SubscriptionHeartbeatVO 订阅心跳结果视图对象
+type SubscriptionHeartbeatVO struct {
+	SubscriptionID          int        `json:"subscription_id"`           // 订阅ID
+	IsActive                bool       `json:"is_active"`                 // 是否激活
+	LastHeartbeatAt         *time.Time `json:"last_heartbeat_at"`         // 最后心跳时间
+	HeartbeatCount          int        `json:"heartbeat_count"`           // 心跳次数
+	HeartbeatTimeoutSeconds int        `json:"heartbeat_timeout_seconds"` // 心跳超时阈值（秒），客户端应以更短的间隔发送心跳
+}
+
+// ConfigListenerVO 监听配置键的客户端视图对象
+type ConfigListenerVO struct {
+	SubscriptionID   int        `json:"subscription_id"`   // 订阅ID
+	ClientID         string     `json:"client_id"`         // 客户端ID
+	ClientIP         string     `json:"client_ip"`         // 客户端IP
+	ClientHostname   string     `json:"client_hostname"`   // 客户端主机名
+	ReportedVersion  string     `json:"reported_version"`  // 客户端上报的版本（为空表示客户端尚未持有该配置）
+	ExpectedVersion  string     `json:"expected_version"`  // 客户端应持有的版本
+	Canary           bool       `json:"canary"`            // 是否命中灰度发布
+	Status           string     `json:"status"`            // 监听状态：synced-已同步，outdated-未同步
+	HeartbeatExpired bool       `json:"heartbeat_expired"` // 心跳是否已超时（客户端可能已下线）
+	LastHeartbeatAt  *time.Time `json:"last_heartbeat_at"` // 最后心跳时间
+	ReportedAt       time.Time  `json:"reported_at"`       // 版本上报时间
+}
+
+// ListenerStatusVO 配置键监听状态视图对象
+type ListenerStatusVO struct {
+	NamespaceID   int                 `json:"namespace_id"`   // 命名空间ID
+	Key           string              `json:"key"`            // 配置键
+	Environment   string              `json:"environment"`    // 环境
+	ServerVersion string              `json:"server_version"` // 服务端当前版本（配置不存在时为空）
+	Total         int                 `json:"total"`          // 监听该配置键的客户端数量
+	Synced        int                 `json:"synced"`         // 已同步的客户端数量
+	Outdated      int                 `json:"outdated"`       // 未同步的客户端数量
+	Expired       int                 `json:"expired"`        // 心跳已超时的客户端数量
+	AllSynced     bool                `json:"all_synced"`     // 全部客户端是否均已同步
+	Listeners     []*ConfigListenerVO `json:"listeners"`      // 客户端列表
+}
+
+// PushTraceVO 变更通知下发记录视图对象
+type PushTraceVO struct {
+	ID             int64      `json:"id"`              // 记录ID
+	SubscriptionID int        `json:"subscription_id"` // 订阅ID
+	ClientID       string     `json:"client_id"`       // 客户端ID
+	NamespaceID    int        `json:"namespace_id"`    // 命名空间ID
+	Environment    string     `json:"environment"`     // 环境
+	Key            string     `json:"key"`             // 配置键
+	Version        string     `json:"version"`         // 通知的配置版本
+	ReleaseID      int        `json:"release_id"`      // 触发通知的发布版本ID（非发布触发时为0）
+	Sequence       int64      `json:"sequence"`        // 触发通知的事件序号
+	TraceID        string     `json:"trace_id"`        // 触发变更的请求链路ID
+	Source         string     `json:"source"`          // 通知来源：event-变更事件，compare-版本比较，replay-断线补发
+	PushedAt       time.Time  `json:"pushed_at"`       // 下发时间
+	Acked          bool       `json:"acked"`           // 客户端是否已确认（随后的长轮询上报了该版本）
+	AckedAt        *time.Time `json:"acked_at"`        // 确认时间
+}
+
+// PushTraceSummaryVO 变更通知下发统计（不受 acked 过滤和分页影响）
+type PushTraceSummaryVO struct {
+	Pushed  int64 `json:"pushed"`  // 下发记录数
+	Acked   int64 `json:"acked"`   // 已确认数
+	Pending int64 `json:"pending"` // 未确认数
+}
+
+// PushTraceListVO 变更通知下发记录列表视图对象
+type PushTraceListVO struct {
+	Total    int64               `json:"total"`     // 总数
+	Page     int                 `json:"page"`      // 当前页
+	PageSize int                 `json:"page_size"` // 每页数量
+	Summary  *PushTraceSummaryVO `json:"summary"`   // 下发统计
+	Traces   []*PushTraceVO      `json:"traces"`    // 下发记录
+}
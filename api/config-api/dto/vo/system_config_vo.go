@@ -0,0 +1,14 @@
+package vo
+
+import "time"
+
+// SystemConfigVO 系统配置视图对象
+type SystemConfigVO struct {
+	ID          int       `json:"id"`           // 主键ID
+	ConfigKey   string    `json:"config_key"`   // 配置键
+	ConfigValue string    `json:"config_value"` // 配置值
+	Description string    `json:"description"`  // 描述
+	IsActive    bool      `json:"is_active"`    // 是否启用（禁用后读取方使用默认值）
+	CreatedAt   time.Time `json:"created_at"`   // 创建时间
+	UpdatedAt   time.Time `json:"updated_at"`   // 更新时间
+}
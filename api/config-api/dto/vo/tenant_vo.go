@@ -0,0 +1,16 @@
+package vo
+
+import "time"
+
+// TenantVO 租户视图对象
+type TenantVO struct {
+	ID          int       `json:"id"`          // 租户ID
+	Code        string    `json:"code"`        // 租户编码
+	Name        string    `json:"name"`        // 租户名称
+	Description string    `json:"description"` // 描述
+	IsActive    bool      `json:"is_active"`   // 是否启用
+	CreatedBy   string    `json:"created_by"`  // 创建人
+	UpdatedBy   string    `json:"updated_by"`  // 更新人
+	CreatedAt   time.Time `json:"created_at"`  // 创建时间
+	UpdatedAt   time.Time `json:"updated_at"`  // 更新时间
+}
@@ -0,0 +1,44 @@
+package vo
+
+import "time"
+
+// WebhookVO Webhook 视图对象（不返回签名密钥）
+type WebhookVO struct {
+	ID          int       `json:"id"`           // Webhook ID
+	NamespaceID int       `json:"namespace_id"` // 命名空间ID
+	Name        string    `json:"name"`         // 名称
+	URL         string    `json:"url"`          // 投递地址
+	Events      []string  `json:"events"`       // 事件过滤（为空时订阅全部事件）
+	Enabled     bool      `json:"enabled"`      // 是否启用
+	CreatedBy   string    `json:"created_by"`   // 创建人
+	UpdatedBy   string    `json:"updated_by"`   // 更新人
+	CreatedAt   time.Time `json:"created_at"`   // 创建时间
+	UpdatedAt   time.Time `json:"updated_at"`   // 更新时间
+}
+
+// WebhookDeliveryVO Webhook 投递记录视图对象
+type WebhookDeliveryVO struct {
+	ID             int64      `json:"id"`              // 投递记录ID（即请求头 X-Webhook-Delivery）
+	WebhookID      int        `json:"webhook_id"`      // Webhook ID
+	NamespaceID    int        `json:"namespace_id"`    // 命名空间ID
+	EventType      string     `json:"event_type"`      // 事件类型
+	Payload        string     `json:"payload"`         // 请求体（JSON）
+	Status         string     `json:"status"`          // 状态: pending, processing, succeeded, failed
+	Attempts       int        `json:"attempts"`        // 已尝试次数
+	ResponseStatus int        `json:"response_status"` // 最近一次响应状态码（未收到响应时为0）
+	ResponseBody   string     `json:"response_body"`   // 最近一次响应内容（截断）
+	LastError      string     `json:"last_error"`      // 最近一次失败原因
+	DurationMs     int64      `json:"duration_ms"`     // 最近一次请求耗时（毫秒）
+	NextAttemptAt  *time.Time `json:"next_attempt_at"` // 下次重试时间（待投递时）
+	RedeliveryOf   *int64     `json:"redelivery_of"`   // 重新投递的原投递记录ID
+	CreatedAt      time.Time  `json:"created_at"`      // 创建时间
+	DeliveredAt    *time.Time `json:"delivered_at"`    // 投递成功时间
+}
+
+// WebhookDeliveryListVO Webhook 投递记录列表视图对象
+type WebhookDeliveryListVO struct {
+	Total      int64                `json:"total"`      // 总数
+	Page       int                  `json:"page"`       // 当前页
+	PageSize   int                  `json:"page_size"`  // 每页数量
+	Deliveries []*WebhookDeliveryVO `json:"deliveries"` // 投递记录
+}
@@ -0,0 +1,106 @@
+package http
+
+import (
+	"context"
+
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/service"
+	"config-client/share/types"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// APIKeyHandler API Key HTTP处理器
+type APIKeyHandler struct {
+	apiKeyAppService *service.APIKeyAppService
+}
+
+// NewAPIKeyHandler 创建 API Key HTTP处理器
+func NewAPIKeyHandler(apiKeyAppService *service.APIKeyAppService) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyAppService: apiKeyAppService,
+	}
+}
+
+// CreateAPIKey 创建 API Key
+// @Summary 创建 API Key
+// @Description 生成随机密钥，响应中的 key 为密钥明文，仅在创建时返回一次，服务端只保存摘要。
+// @Description 启用接口认证（auth.enabled）后，请求通过 Authorization: Bearer <key> 或 X-API-Key 请求头携带密钥
+// @Description API Key 归属当前请求的租户；默认租户的管理员可以携带 X-Tenant-ID 请求头为其他租户签发
+// @Description role=reader 时签发只读令牌（cfr_ 前缀）：绑定 namespace_id + environment，可选 key_prefixes 限制配置键，
+// @Description 只能调用 SDK 读取配置、长轮询监听和心跳接口，读取绑定范围以外的配置返回 403
+// @Tags API Key管理
+// @Accept json
+// @Produce json
+// @Param request body request.CreateAPIKeyRequest true "创建 API Key 请求"
+// @Success 200 {object} types.Response{data=vo.APIKeyCreatedVO}
+// @Router /api/v1/api-keys [post]
+func (h *APIKeyHandler) CreateAPIKey(ctx context.Context, c *app.RequestContext) {
+	var req request.CreateAPIKeyRequest
+	bindAndValidate(c, &req)
+
+	keyVO, err := h.apiKeyAppService.CreateAPIKey(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("API Key 创建成功，请妥善保存密钥", keyVO))
+}
+
+// RevokeAPIKey 吊销 API Key
+// @Summary 吊销 API Key
+// @Description 吊销后立即在当前实例失效，其他实例在认证缓存过期（auth.cache_ttl）后失效
+// @Tags API Key管理
+// @Accept json
+// @Produce json
+// @Param id path int true "API Key ID"
+// @Param request body request.RevokeAPIKeyRequest true "吊销 API Key 请求"
+// @Success 200 {object} types.Response{data=vo.APIKeyVO}
+// @Router /api/v1/api-keys/{id}/revoke [post]
+func (h *APIKeyHandler) RevokeAPIKey(ctx context.Context, c *app.RequestContext) {
+	var req request.RevokeAPIKeyRequest
+	bindAndValidate(c, &req)
+
+	keyVO, err := h.apiKeyAppService.RevokeAPIKey(ctx, pathID(c, "id"), &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("API Key 已吊销", keyVO))
+}
+
+// ListAPIKeys 查询 API Key 列表
+// @Summary 查询 API Key 列表
+// @Tags API Key管理
+// @Produce json
+// @Param include_revoked query bool false "是否包含已吊销的 API Key"
+// @Success 200 {object} types.Response{data=[]vo.APIKeyVO}
+// @Router /api/v1/api-keys [get]
+func (h *APIKeyHandler) ListAPIKeys(ctx context.Context, c *app.RequestContext) {
+	var req request.ListAPIKeysRequest
+	bindAndValidate(c, &req)
+
+	keyVOs, err := h.apiKeyAppService.ListAPIKeys(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(keyVOs))
+}
+
+// GetAPIKey 根据ID查询 API Key
+// @Summary 根据ID查询 API Key
+// @Tags API Key管理
+// @Produce json
+// @Param id path int true "API Key ID"
+// @Success 200 {object} types.Response{data=vo.APIKeyVO}
+// @Router /api/v1/api-keys/{id} [get]
+func (h *APIKeyHandler) GetAPIKey(ctx context.Context, c *app.RequestContext) {
+	keyVO, err := h.apiKeyAppService.GetAPIKey(ctx, pathID(c, "id"))
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(keyVO))
+}
@@ -0,0 +1,25 @@
+package http
+
+import (
+	"config-client/share/errors"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// bindAndValidate 绑定并校验请求参数，失败时抛出 400 异常
+// 校验失败的异常携带字段明细（errors.ErrValidation）；请求体格式错误、参数类型不匹配等绑定错误返回参数格式错误
+func bindAndValidate(c *app.RequestContext, req interface{}) {
+	if err := c.BindAndValidate(req); err != nil {
+		panic(bindError(err))
+	}
+}
+
+// bindError 转换参数绑定或校验错误（校验错误已是 AppError，原样返回）
+func bindError(err error) error {
+	if errors.IsAppError(err) {
+		return err
+	}
+	return errors.ErrBadRequest("请求参数格式错误: " + err.Error()).
+		WithVariant("malformed_request").
+		WithParams(errors.Params{"error": err.Error()})
+}
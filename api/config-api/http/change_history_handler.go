@@ -2,12 +2,15 @@ package http
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"config-client/api/config-api/dto/request"
 	"config-client/api/config-api/service"
 	"config-client/share/types"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 )
 
@@ -39,13 +42,14 @@ func NewChangeHistoryHandler(
 // @Param operator query string false "操作人（模糊查询）"
 // @Param page query int false "页码" default(1)
 // @Param size query int false "每页数量" default(20)
+// @Param order_by query string false "排序，格式为 字段 [asc|desc]，多个用逗号分隔，例如：created_at desc。可选字段：id, config_id, namespace_id, config_key, environment, operation, old_version, new_version, operator, created_at"
+// @Param pagination query string false "分页方式：offset（默认）/cursor，游标分页按创建时间倒序且不统计总数"
+// @Param cursor query string false "游标分页的游标（上一页返回的 next_cursor）"
 // @Success 200 {object} types.Response{data=vo.ChangeHistoryListVO}
 // @Router /api/v1/history [get]
 func (h *ChangeHistoryHandler) QueryHistory(ctx context.Context, c *app.RequestContext) {
 	var req request.QueryHistoryRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	result, err := h.changeHistoryAppService.QueryHistory(ctx, &req)
 	if err != nil {
@@ -55,6 +59,45 @@ func (h *ChangeHistoryHandler) QueryHistory(ctx context.Context, c *app.RequestC
 	c.JSON(consts.StatusOK, types.Success(result))
 }
 
+// ExportHistory 导出变更历史
+// @Summary 导出变更历史
+// @Description 按条件导出变更历史（按ID升序），以分块传输方式流式返回，适用于大量历史记录的离线审计
+// @Tags 变更管理
+// @Produce plain,json
+// @Param namespace_id query int false "命名空间ID"
+// @Param config_id query int false "配置ID"
+// @Param config_key query string false "配置键（模糊查询）"
+// @Param operation query string false "操作类型：CREATE/UPDATE/DELETE/ROLLBACK/RESTORE"
+// @Param operator query string false "操作人（模糊查询）"
+// @Param start_time query string false "开始时间，格式：2006-01-02 15:04:05"
+// @Param end_time query string false "结束时间，格式：2006-01-02 15:04:05"
+// @Param format query string false "导出格式：csv/json" default(csv)
+// @Success 200 {string} string "变更历史文件"
+// @Router /api/v1/history/export [get]
+func (h *ChangeHistoryHandler) ExportHistory(ctx context.Context, c *app.RequestContext) {
+	var req request.ExportHistoryRequest
+	bindAndValidate(c, &req)
+	req.SetDefaults()
+
+	contentType := "text/csv; charset=utf-8"
+	if req.Format == "json" {
+		contentType = "application/json; charset=utf-8"
+	}
+	filename := fmt.Sprintf("change_history_%s.%s", time.Now().Format("20060102150405"), req.Format)
+	w := newStreamWriter(c, map[string]string{
+		"Content-Type":        contentType,
+		"Content-Disposition": fmt.Sprintf("attachment; filename=%q", filename),
+	})
+
+	if err := h.changeHistoryAppService.ExportHistory(ctx, &req, w); err != nil {
+		if !w.started {
+			panic(err)
+		}
+		// 响应已开始输出，无法再返回错误响应，客户端收到的内容不完整
+		hlog.CtxErrorf(ctx, "导出变更历史中断: %v", err)
+	}
+}
+
 // GetHistoryByID 根据ID查询变更记录
 // @Summary 根据ID查询变更记录
 // @Tags 变更管理
@@ -65,9 +108,7 @@ func (h *ChangeHistoryHandler) QueryHistory(ctx context.Context, c *app.RequestC
 // @Router /api/v1/history/get [post]
 func (h *ChangeHistoryHandler) GetHistoryByID(ctx context.Context, c *app.RequestContext) {
 	var req request.GetHistoryByIDRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	result, err := h.changeHistoryAppService.GetHistoryByID(ctx, req.HistoryID)
 	if err != nil {
@@ -88,9 +129,7 @@ func (h *ChangeHistoryHandler) GetHistoryByID(ctx context.Context, c *app.Reques
 // @Router /api/v1/history/config [get]
 func (h *ChangeHistoryHandler) GetConfigHistory(ctx context.Context, c *app.RequestContext) {
 	var req request.GetConfigHistoryRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	result, err := h.changeHistoryAppService.GetConfigHistory(ctx, &req)
 	if err != nil {
@@ -110,9 +149,7 @@ func (h *ChangeHistoryHandler) GetConfigHistory(ctx context.Context, c *app.Requ
 // @Router /api/v1/history/compare [post]
 func (h *ChangeHistoryHandler) CompareVersions(ctx context.Context, c *app.RequestContext) {
 	var req request.CompareVersionsRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	result, err := h.changeHistoryAppService.CompareVersions(ctx, &req)
 	if err != nil {
@@ -128,13 +165,12 @@ func (h *ChangeHistoryHandler) CompareVersions(ctx context.Context, c *app.Reque
 // @Accept json
 // @Produce json
 // @Param request body request.RollbackRequest true "回滚请求"
+// @Param Idempotency-Key header string false "幂等键（重试时携带相同的值，有效期内返回首次请求的响应）"
 // @Success 200 {object} types.Response
 // @Router /api/v1/history/rollback [post]
 func (h *ChangeHistoryHandler) Rollback(ctx context.Context, c *app.RequestContext) {
 	var req request.RollbackRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	if err := h.changeHistoryAppService.Rollback(ctx, &req); err != nil {
 		panic(err)
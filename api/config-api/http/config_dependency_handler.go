@@ -0,0 +1,107 @@
+package http
+
+import (
+	"context"
+
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/service"
+	"config-client/share/types"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// ConfigDependencyHandler 配置依赖HTTP处理器
+type ConfigDependencyHandler struct {
+	dependencyAppService *service.ConfigDependencyAppService
+}
+
+// NewConfigDependencyHandler 创建配置依赖HTTP处理器
+func NewConfigDependencyHandler(dependencyAppService *service.ConfigDependencyAppService) *ConfigDependencyHandler {
+	return &ConfigDependencyHandler{
+		dependencyAppService: dependencyAppService,
+	}
+}
+
+// GetDependencies 查询配置依赖
+// @Summary 查询配置依赖
+// @Description 查询配置显式声明的上游依赖和依赖它的下游配置
+// @Tags 配置依赖
+// @Produce json
+// @Param config_id query int true "配置ID"
+// @Success 200 {object} types.Response{data=vo.ConfigDependenciesVO}
+// @Router /api/v1/configs/dependencies [get]
+func (h *ConfigDependencyHandler) GetDependencies(ctx context.Context, c *app.RequestContext) {
+	var req request.GetConfigDependenciesRequest
+	bindAndValidate(c, &req)
+
+	dependenciesVO, err := h.dependencyAppService.GetDependencies(ctx, req.ConfigID)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(dependenciesVO))
+}
+
+// SetDependencies 设置配置依赖
+// @Summary 设置配置依赖
+// @Description 全量替换配置显式声明的依赖（depends_on），不能依赖自身或形成循环，列表为空时清空
+// @Tags 配置依赖
+// @Accept json
+// @Produce json
+// @Param request body request.SetConfigDependenciesRequest true "设置依赖请求"
+// @Success 200 {object} types.Response{data=vo.ConfigDependenciesVO}
+// @Router /api/v1/configs/dependencies [put]
+func (h *ConfigDependencyHandler) SetDependencies(ctx context.Context, c *app.RequestContext) {
+	var req request.SetConfigDependenciesRequest
+	bindAndValidate(c, &req)
+
+	dependenciesVO, err := h.dependencyAppService.SetDependencies(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("依赖设置成功", dependenciesVO))
+}
+
+// GetGraph 查询依赖图
+// @Summary 查询依赖图
+// @Description 返回命名空间下配置的依赖图，包含显式依赖和 ${namespace:key} 引用依赖，其他命名空间或环境的被依赖配置作为外部节点
+// @Tags 配置依赖
+// @Produce json
+// @Param namespace_id query int true "命名空间ID"
+// @Param environment query string false "环境（为空时包含所有环境）"
+// @Success 200 {object} types.Response{data=vo.ConfigDependencyGraphVO}
+// @Router /api/v1/configs/dependencies/graph [get]
+func (h *ConfigDependencyHandler) GetGraph(ctx context.Context, c *app.RequestContext) {
+	var req request.ConfigDependencyGraphRequest
+	bindAndValidate(c, &req)
+
+	graphVO, err := h.dependencyAppService.GetGraph(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(graphVO))
+}
+
+// AnalyzeImpact 变更影响分析
+// @Summary 变更影响分析
+// @Description 发布前分析配置变更会影响的下游配置（显式依赖和引用依赖，逐层展开）以及相关命名空间和环境的活跃订阅客户端
+// @Tags 配置依赖
+// @Accept json
+// @Produce json
+// @Param request body request.ConfigImpactAnalysisRequest true "影响分析请求"
+// @Success 200 {object} types.Response{data=vo.ConfigImpactAnalysisVO}
+// @Router /api/v1/configs/impact [post]
+func (h *ConfigDependencyHandler) AnalyzeImpact(ctx context.Context, c *app.RequestContext) {
+	var req request.ConfigImpactAnalysisRequest
+	bindAndValidate(c, &req)
+
+	impactVO, err := h.dependencyAppService.AnalyzeImpact(ctx, req.ConfigID)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(impactVO))
+}
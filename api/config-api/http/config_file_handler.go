@@ -0,0 +1,142 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/service"
+	"config-client/share/errors"
+	"config-client/share/types"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// ConfigFileHandler 文件配置HTTP处理器
+type ConfigFileHandler struct {
+	fileAppService *service.ConfigFileAppService
+}
+
+// NewConfigFileHandler 创建文件配置HTTP处理器
+func NewConfigFileHandler(fileAppService *service.ConfigFileAppService) *ConfigFileHandler {
+	return &ConfigFileHandler{
+		fileAppService: fileAppService,
+	}
+}
+
+// UploadFile 上传文件配置
+// @Summary 上传文件配置
+// @Description 以请求体原始内容流式上传文件（证书、logback.xml 等），内容类型取自 Content-Type 请求头；配置不存在时创建 value_type=file 的配置，内容哈希未变化时不产生新版本
+// @Tags 文件配置
+// @Accept octet-stream
+// @Produce json
+// @Param namespace_id query int true "命名空间ID"
+// @Param key query string true "配置键"
+// @Param environment query string false "环境" default(default)
+// @Param group_name query string false "配置分组"
+// @Param description query string false "配置描述"
+// @Param file_name query string false "文件名"
+// @Param operator query string true "操作人"
+// @Param content body string true "文件原始内容"
+// @Success 200 {object} types.Response{data=vo.ConfigFileVO}
+// @Router /api/v1/configs/files [put]
+func (h *ConfigFileHandler) UploadFile(ctx context.Context, c *app.RequestContext) {
+	var req request.UploadConfigFileRequest
+	if err := c.BindQuery(&req); err != nil {
+		panic(bindError(err))
+	}
+	if req.NamespaceID <= 0 || req.Key == "" || req.Operator == "" {
+		panic(errors.ErrBadRequest("namespace_id、key 和 operator 不能为空"))
+	}
+
+	// 启用流式请求体时直接读取连接，请求体已被前置中间件读取时从缓冲区读取
+	var content io.Reader
+	if c.Request.IsBodyStream() {
+		content = c.Request.BodyStream()
+	} else {
+		content = bytes.NewReader(c.Request.Body())
+	}
+
+	fileVO, err := h.fileAppService.UploadFile(ctx, &req, string(c.ContentType()), content)
+	if err != nil {
+		panic(err)
+	}
+
+	message := "文件上传成功"
+	if fileVO.Changed != nil && !*fileVO.Changed {
+		message = "文件内容未变化"
+	}
+	c.JSON(consts.StatusOK, types.SuccessWithMessage(message, fileVO))
+}
+
+// DownloadFile 下载文件配置
+// @Summary 下载文件配置
+// @Description 指定 config_id 时按ID下载（不限发布状态），否则下载指定命名空间和环境下已发布的文件；响应 ETag 为内容 SHA-256，If-None-Match 命中时返回 304
+// @Tags 文件配置
+// @Produce octet-stream
+// @Param config_id query int false "配置ID"
+// @Param namespace_id query int false "命名空间ID"
+// @Param key query string false "配置键"
+// @Param environment query string false "环境" default(default)
+// @Success 200 {file} file "文件内容"
+// @Router /api/v1/configs/files/download [get]
+func (h *ConfigFileHandler) DownloadFile(ctx context.Context, c *app.RequestContext) {
+	req := h.bindFileRequest(c)
+
+	fileVO, content, err := h.fileAppService.DownloadFile(ctx, req)
+	if err != nil {
+		panic(err)
+	}
+
+	etag := strconv.Quote(fileVO.SHA256)
+	c.Header("ETag", etag)
+	if string(c.GetHeader("If-None-Match")) == etag {
+		c.Status(consts.StatusNotModified)
+		return
+	}
+
+	filename := fileVO.FileName
+	if filename == "" {
+		filename = fileVO.Key
+	}
+	c.Header("Content-Type", fileVO.ContentType)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Header("X-Config-Version", strconv.Itoa(fileVO.Version))
+	c.SetBodyStream(bytes.NewReader(content), len(content))
+}
+
+// GetFileInfo 查询文件配置信息
+// @Summary 查询文件配置信息
+// @Description 返回文件名、内容类型、大小和 SHA-256 哈希，不返回文件内容，客户端可据此判断是否需要重新下载
+// @Tags 文件配置
+// @Produce json
+// @Param config_id query int false "配置ID"
+// @Param namespace_id query int false "命名空间ID"
+// @Param key query string false "配置键"
+// @Param environment query string false "环境" default(default)
+// @Success 200 {object} types.Response{data=vo.ConfigFileVO}
+// @Router /api/v1/configs/files/info [get]
+func (h *ConfigFileHandler) GetFileInfo(ctx context.Context, c *app.RequestContext) {
+	req := h.bindFileRequest(c)
+
+	fileVO, err := h.fileAppService.GetFileInfo(ctx, req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(fileVO))
+}
+
+// bindFileRequest 绑定文件查询参数，必须指定 config_id 或 namespace_id 和 key
+func (h *ConfigFileHandler) bindFileRequest(c *app.RequestContext) *request.DownloadConfigFileRequest {
+	var req request.DownloadConfigFileRequest
+	bindAndValidate(c, &req)
+	if req.ConfigID <= 0 && (req.NamespaceID <= 0 || req.Key == "") {
+		panic(errors.ErrBadRequest("必须指定 config_id，或同时指定 namespace_id 和 key"))
+	}
+	return &req
+}
@@ -0,0 +1,175 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/service"
+	"config-client/share/types"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// ConfigGroupHandler 配置分组HTTP处理器
+type ConfigGroupHandler struct {
+	groupAppService *service.ConfigGroupAppService
+}
+
+// NewConfigGroupHandler 创建配置分组HTTP处理器
+func NewConfigGroupHandler(groupAppService *service.ConfigGroupAppService) *ConfigGroupHandler {
+	return &ConfigGroupHandler{
+		groupAppService: groupAppService,
+	}
+}
+
+// CreateGroup 创建配置分组
+// @Summary 创建配置分组
+// @Description 在命名空间下登记配置分组，分组名称对应配置的 group_name，创建后不可修改
+// @Tags 配置分组管理
+// @Accept json
+// @Produce json
+// @Param request body request.CreateConfigGroupRequest true "创建配置分组请求"
+// @Success 200 {object} types.Response{data=vo.ConfigGroupVO}
+// @Router /api/v1/groups [post]
+func (h *ConfigGroupHandler) CreateGroup(ctx context.Context, c *app.RequestContext) {
+	var req request.CreateConfigGroupRequest
+	bindAndValidate(c, &req)
+
+	groupVO, err := h.groupAppService.CreateGroup(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("配置分组创建成功", groupVO))
+}
+
+// UpdateGroup 更新配置分组
+// @Summary 更新配置分组
+// @Description 更新分组的描述和展示顺序
+// @Tags 配置分组管理
+// @Accept json
+// @Produce json
+// @Param request body request.UpdateConfigGroupRequest true "更新配置分组请求"
+// @Success 200 {object} types.Response{data=vo.ConfigGroupVO}
+// @Router /api/v1/groups [put]
+func (h *ConfigGroupHandler) UpdateGroup(ctx context.Context, c *app.RequestContext) {
+	var req request.UpdateConfigGroupRequest
+	bindAndValidate(c, &req)
+
+	groupVO, err := h.groupAppService.UpdateGroup(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("配置分组更新成功", groupVO))
+}
+
+// DeleteGroup 删除配置分组
+// @Summary 删除配置分组
+// @Description 删除配置分组，分组下仍有配置时不允许删除
+// @Tags 配置分组管理
+// @Accept json
+// @Produce json
+// @Param request body request.DeleteConfigGroupRequest true "删除配置分组请求"
+// @Success 200 {object} types.Response
+// @Router /api/v1/groups [delete]
+func (h *ConfigGroupHandler) DeleteGroup(ctx context.Context, c *app.RequestContext) {
+	var req request.DeleteConfigGroupRequest
+	bindAndValidate(c, &req)
+
+	if err := h.groupAppService.DeleteGroup(ctx, req.ID); err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("配置分组删除成功", nil))
+}
+
+// GetGroup 根据ID获取配置分组
+// @Summary 根据ID获取配置分组
+// @Tags 配置分组管理
+// @Accept json
+// @Produce json
+// @Param request body request.GetConfigGroupRequest true "获取配置分组请求"
+// @Success 200 {object} types.Response{data=vo.ConfigGroupVO}
+// @Router /api/v1/groups/get [post]
+func (h *ConfigGroupHandler) GetGroup(ctx context.Context, c *app.RequestContext) {
+	var req request.GetConfigGroupRequest
+	bindAndValidate(c, &req)
+
+	groupVO, err := h.groupAppService.GetGroup(ctx, req.ID, req.Environment)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(groupVO))
+}
+
+// ListGroups 查询命名空间下的配置分组
+// @Summary 查询命名空间下的配置分组
+// @Description 返回已登记的分组（按展示顺序）及仅被配置引用、尚未登记的分组，附带各分组的配置统计
+// @Tags 配置分组管理
+// @Produce json
+// @Param namespace_id query int true "命名空间ID"
+// @Param environment query string false "统计的环境，默认统计所有环境"
+// @Success 200 {object} types.Response{data=[]vo.ConfigGroupVO}
+// @Router /api/v1/groups [get]
+func (h *ConfigGroupHandler) ListGroups(ctx context.Context, c *app.RequestContext) {
+	var req request.ListConfigGroupRequest
+	bindAndValidate(c, &req)
+
+	groupVOs, err := h.groupAppService.ListGroups(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(groupVOs))
+}
+
+// ReleaseGroup 发布整个分组
+// @Summary 发布整个分组
+// @Description 发布分组在指定环境下所有未发布的已激活配置
+// @Tags 配置分组管理
+// @Accept json
+// @Produce json
+// @Param request body request.ReleaseConfigGroupRequest true "发布分组请求"
+// @Success 200 {object} types.Response{data=vo.ConfigGroupReleaseVO}
+// @Router /api/v1/groups/release [post]
+func (h *ConfigGroupHandler) ReleaseGroup(ctx context.Context, c *app.RequestContext) {
+	var req request.ReleaseConfigGroupRequest
+	bindAndValidate(c, &req)
+
+	releaseVO, err := h.groupAppService.ReleaseGroup(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("分组发布成功", releaseVO))
+}
+
+// ExportGroup 导出分组配置
+// @Summary 导出分组配置
+// @Description 将分组在指定环境下已发布的配置导出为单个文档
+// @Tags 配置分组管理
+// @Produce json,plain
+// @Param id query int true "分组ID"
+// @Param environment query string false "环境" default(default)
+// @Param format query string false "导出格式：yaml/json/properties/env" default(yaml)
+// @Param include_secrets query bool false "是否导出敏感配置明文" default(false)
+// @Success 200 {string} string "配置文档"
+// @Router /api/v1/groups/export [get]
+func (h *ConfigGroupHandler) ExportGroup(ctx context.Context, c *app.RequestContext) {
+	var req request.ExportConfigGroupRequest
+	bindAndValidate(c, &req)
+
+	exportVO, err := h.groupAppService.ExportGroup(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", exportVO.Filename))
+	c.Header("X-Config-Count", strconv.Itoa(exportVO.Count))
+	c.Data(consts.StatusOK, exportVO.ContentType, []byte(exportVO.Content))
+}
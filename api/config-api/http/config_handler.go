@@ -29,13 +29,12 @@ func NewConfigHandler(configAppService *service.ConfigAppService) *ConfigHandler
 // @Accept json
 // @Produce json
 // @Param request body request.CreateConfigRequest true "创建配置请求"
+// @Param Idempotency-Key header string false "幂等键（重试时携带相同的值，有效期内返回首次请求的响应）"
 // @Success 200 {object} types.Response{data=vo.ConfigVO}
 // @Router /api/v1/configs [post]
 func (h *ConfigHandler) CreateConfig(ctx context.Context, c *app.RequestContext) {
 	var req request.CreateConfigRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	configVO, err := h.configAppService.CreateConfig(ctx, &req)
 	if err != nil {
@@ -51,13 +50,12 @@ func (h *ConfigHandler) CreateConfig(ctx context.Context, c *app.RequestContext)
 // @Accept json
 // @Produce json
 // @Param request body request.UpdateConfigRequest true "更新配置请求"
+// @Param Idempotency-Key header string false "幂等键（重试时携带相同的值，有效期内返回首次请求的响应）"
 // @Success 200 {object} types.Response{data=vo.ConfigVO}
 // @Router /api/v1/configs [put]
 func (h *ConfigHandler) UpdateConfig(ctx context.Context, c *app.RequestContext) {
 	var req request.UpdateConfigRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	configVO, err := h.configAppService.UpdateConfig(ctx, req.ID, &req)
 	if err != nil {
@@ -69,6 +67,7 @@ func (h *ConfigHandler) UpdateConfig(ctx context.Context, c *app.RequestContext)
 
 // QueryConfigs 分页查询配置
 // @Summary 分页查询配置
+// @Description 只读令牌只能查询绑定的命名空间和环境下已发布且已激活的配置（未指定时自动限定），限制了配置键前缀的只读令牌返回 403
 // @Tags 配置管理
 // @Accept json
 // @Produce json
@@ -81,14 +80,16 @@ func (h *ConfigHandler) UpdateConfig(ctx context.Context, c *app.RequestContext)
 // @Param value_type query string false "值类型"
 // @Param page query int false "页码" default(1)
 // @Param size query int false "每页数量" default(10)
-// @Param order_by query string false "排序字段"
+// @Param order_by query string false "排序，格式为 字段 [asc|desc]，多个用逗号分隔，例如：created_at desc。可选字段：id, namespace_id, key, group_name, environment, value_type, version, is_released, is_active, expires_at, created_at, updated_at, deleted_at"
+// @Param expiring_within query int false "只查询在指定秒数内过期的配置（包括已过期但尚未处理的配置）"
+// @Param pagination query string false "分页方式：offset（默认）/cursor，游标分页按创建时间倒序且不统计总数"
+// @Param cursor query string false "游标分页的游标（上一页返回的 next_cursor）"
 // @Success 200 {object} types.Response{data=vo.ConfigListVO}
 // @Router /api/v1/configs [get]
 func (h *ConfigHandler) QueryConfigs(ctx context.Context, c *app.RequestContext) {
 	var req request.QueryConfigRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
+	scopeConfigQuery(ctx, &req)
 
 	configListVO, err := h.configAppService.QueryConfigs(ctx, &req)
 	if err != nil {
@@ -108,11 +109,25 @@ func (h *ConfigHandler) QueryConfigs(ctx context.Context, c *app.RequestContext)
 // @Router /api/v1/configs/get [post]
 func (h *ConfigHandler) GetConfigByID(ctx context.Context, c *app.RequestContext) {
 	var req request.GetConfigByIDRequest
-	if err := c.BindAndValidate(&req); err != nil {
+	bindAndValidate(c, &req)
+
+	configVO, err := h.configAppService.GetConfigByID(ctx, req.ID)
+	if err != nil {
 		panic(err)
 	}
 
-	configVO, err := h.configAppService.GetConfigByID(ctx, req.ID)
+	c.JSON(consts.StatusOK, types.Success(configVO))
+}
+
+// GetConfig 根据路径ID获取配置
+// @Summary 根据ID获取配置（RESTful）
+// @Tags 配置管理
+// @Produce json
+// @Param id path int true "配置ID"
+// @Success 200 {object} types.Response{data=vo.ConfigVO}
+// @Router /api/v1/configs/{id} [get]
+func (h *ConfigHandler) GetConfig(ctx context.Context, c *app.RequestContext) {
+	configVO, err := h.configAppService.GetConfigByID(ctx, pathID(c, "id"))
 	if err != nil {
 		panic(err)
 	}
@@ -120,6 +135,131 @@ func (h *ConfigHandler) GetConfigByID(ctx context.Context, c *app.RequestContext
 	c.JSON(consts.StatusOK, types.Success(configVO))
 }
 
+// GetConfigByKey 根据配置键获取配置
+// @Summary 根据配置键获取配置
+// @Description 返回指定命名空间和环境下已发布且已激活的配置，不存在时返回 404；客户端命中灰度规则时返回灰度版本中的值（is_canary=true）
+// @Description 只读令牌读取绑定范围以外的配置时返回 403
+// @Tags 配置管理
+// @Produce json
+// @Param namespace_id query int true "命名空间ID"
+// @Param key query string true "配置键"
+// @Param environment query string false "环境" default(default)
+// @Param client_id query string false "客户端ID（用于灰度判断）"
+// @Param client_ip query string false "客户端IP（用于灰度判断，传入 client_id 时默认取请求来源IP）"
+// @Success 200 {object} types.Response{data=vo.ConfigVO}
+// @Router /api/v1/configs/key [get]
+func (h *ConfigHandler) GetConfigByKey(ctx context.Context, c *app.RequestContext) {
+	var req request.GetConfigByKeyRequest
+	bindAndValidate(c, &req)
+	checkReadScope(ctx, req.NamespaceID, req.Environment, req.Key)
+	if req.ClientIP == "" && req.ClientID != "" {
+		req.ClientIP = c.ClientIP()
+	}
+
+	configVO, err := h.configAppService.GetConfigByKey(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(configVO))
+}
+
+// BulkGetConfigs 批量获取配置
+// @Summary 批量获取配置
+// @Description 一次请求返回指定命名空间和环境下一组配置键的已发布且已激活的配置（最多200个），客户端命中灰度规则时返回灰度版本中的值（is_canary=true）
+// @Description 不存在、未发布、未激活或已过期的配置键放入 missing，不返回 404；只读令牌请求了绑定范围以外的配置键时返回 403
+// @Tags 配置管理
+// @Accept json
+// @Produce json
+// @Param request body request.BulkGetConfigRequest true "批量获取配置请求"
+// @Success 200 {object} types.Response{data=vo.ConfigBulkGetVO}
+// @Router /api/v1/configs/bulk-get [post]
+func (h *ConfigHandler) BulkGetConfigs(ctx context.Context, c *app.RequestContext) {
+	var req request.BulkGetConfigRequest
+	bindAndValidate(c, &req)
+	for _, key := range req.Keys {
+		checkReadScope(ctx, req.NamespaceID, req.Environment, key)
+	}
+	if req.ClientIP == "" && req.ClientID != "" {
+		req.ClientIP = c.ClientIP()
+	}
+
+	bulkVO, err := h.configAppService.BulkGetConfigs(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(bulkVO))
+}
+
+// GetEffectiveConfigs 获取生效配置
+// @Summary 获取生效配置
+// @Description 返回命名空间在指定环境下已发布的配置（指定环境覆盖默认环境），配置值中的 ${namespace:key} 引用已递归解析
+// @Description 只读令牌只能读取绑定的命名空间和环境，限制了配置键前缀时只返回匹配的配置
+// @Tags 配置管理
+// @Produce json
+// @Param namespace_id query int true "命名空间ID"
+// @Param environment query string false "环境" default(default)
+// @Param group_name query string false "配置分组"
+// @Success 200 {object} types.Response{data=vo.EffectiveConfigVO}
+// @Router /api/v1/configs/effective [get]
+func (h *ConfigHandler) GetEffectiveConfigs(ctx context.Context, c *app.RequestContext) {
+	var req request.GetEffectiveConfigRequest
+	bindAndValidate(c, &req)
+	checkReadScope(ctx, req.NamespaceID, req.Environment, "")
+
+	effectiveVO, err := h.configAppService.GetEffectiveConfigs(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+	filterEffectiveConfigs(ctx, effectiveVO)
+
+	c.JSON(consts.StatusOK, types.Success(effectiveVO))
+}
+
+// ValidateConfig 校验配置（仅校验，不保存）
+// @Summary 校验配置
+// @Description 按创建配置的规则校验配置值（类型、元数据 validators 中声明的校验规则、绑定的 JSON Schema），不会保存配置
+// @Tags 配置管理
+// @Accept json
+// @Produce json
+// @Param request body request.ValidateConfigRequest true "校验配置请求"
+// @Success 200 {object} types.Response{data=vo.ConfigValidationVO}
+// @Router /api/v1/configs/validate [post]
+func (h *ConfigHandler) ValidateConfig(ctx context.Context, c *app.RequestContext) {
+	var req request.ValidateConfigRequest
+	bindAndValidate(c, &req)
+
+	validationVO, err := h.configAppService.ValidateConfig(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(validationVO))
+}
+
+// BatchMutateConfigs 批量变更配置
+// @Summary 批量变更配置
+// @Description 在单个事务中批量创建、更新、删除同一命名空间和环境下的配置；任一条目失败时整批回滚（committed=false），并返回每个条目的执行状态
+// @Tags 配置管理
+// @Accept json
+// @Produce json
+// @Param request body request.BatchMutateConfigRequest true "批量变更配置请求"
+// @Param Idempotency-Key header string false "幂等键（重试时携带相同的值，有效期内返回首次请求的响应）"
+// @Success 200 {object} types.Response{data=vo.BatchMutationVO}
+// @Router /api/v1/configs/batch [post]
+func (h *ConfigHandler) BatchMutateConfigs(ctx context.Context, c *app.RequestContext) {
+	var req request.BatchMutateConfigRequest
+	bindAndValidate(c, &req)
+
+	batchVO, err := h.configAppService.BatchMutateConfigs(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(batchVO))
+}
+
 // DeleteConfig 删除配置（逻辑删除）
 // @Summary 删除配置
 // @Tags 配置管理
@@ -130,11 +270,138 @@ func (h *ConfigHandler) GetConfigByID(ctx context.Context, c *app.RequestContext
 // @Router /api/v1/configs [delete]
 func (h *ConfigHandler) DeleteConfig(ctx context.Context, c *app.RequestContext) {
 	var req request.DeleteConfigRequest
-	if err := c.BindAndValidate(&req); err != nil {
+	bindAndValidate(c, &req)
+
+	if err := h.configAppService.DeleteConfig(ctx, req.ID); err != nil {
 		panic(err)
 	}
 
-	if err := h.configAppService.DeleteConfig(ctx, req.ID); err != nil {
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("配置删除成功", nil))
+}
+
+// SearchConfigs 搜索配置
+// @Summary 搜索配置
+// @Description 按关键字（不区分大小写的子串匹配）搜索配置的键、描述、分组和值，结果按匹配度排序并返回命中字段的高亮片段；敏感配置和加密配置不按值匹配
+// @Tags 配置管理
+// @Produce json
+// @Param q query string true "搜索关键字"
+// @Param fields query string false "搜索字段，逗号分隔：key,description,group,value，默认全部"
+// @Param namespace_id query int false "命名空间ID"
+// @Param environment query string false "环境"
+// @Param page query int false "页码" default(1)
+// @Param size query int false "每页数量" default(20)
+// @Success 200 {object} types.Response{data=vo.ConfigSearchListVO}
+// @Router /api/v1/configs/search [get]
+func (h *ConfigHandler) SearchConfigs(ctx context.Context, c *app.RequestContext) {
+	var req request.SearchConfigRequest
+	bindAndValidate(c, &req)
+
+	result, err := h.configAppService.SearchConfigs(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(result))
+}
+
+// QueryConfigsByTags 按标签查询配置
+// @Summary 按标签查询配置
+// @Description 分页查询同时包含所有指定标签（标签键和值均匹配）的配置，可叠加命名空间、环境等条件
+// @Tags 配置管理
+// @Accept json
+// @Produce json
+// @Param request body request.QueryByTagsRequest true "按标签查询配置请求"
+// @Success 200 {object} types.Response{data=vo.ConfigListVO}
+// @Router /api/v1/configs/query-by-tags [post]
+func (h *ConfigHandler) QueryConfigsByTags(ctx context.Context, c *app.RequestContext) {
+	var req request.QueryByTagsRequest
+	bindAndValidate(c, &req)
+
+	configListVO, err := h.configAppService.QueryConfigsByTags(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(configListVO))
+}
+
+// QueryTrash 查询回收站
+// @Summary 查询回收站
+// @Description 分页查询已删除（软删除）的配置，按删除时间倒序
+// @Tags 配置管理
+// @Produce json
+// @Param namespace_id query int false "命名空间ID"
+// @Param key query string false "配置键（模糊查询）"
+// @Param environment query string false "环境"
+// @Param page query int false "页码" default(1)
+// @Param size query int false "每页数量" default(10)
+// @Success 200 {object} types.Response{data=vo.ConfigListVO}
+// @Router /api/v1/configs/trash [get]
+func (h *ConfigHandler) QueryTrash(ctx context.Context, c *app.RequestContext) {
+	var req request.QueryTrashRequest
+	bindAndValidate(c, &req)
+
+	configListVO, err := h.configAppService.QueryTrash(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(configListVO))
+}
+
+// RestoreConfig 从回收站恢复配置
+// @Summary 恢复已删除的配置
+// @Description 恢复回收站中的配置及其标签，并记录 RESTORE 变更历史；已存在同名配置时返回 409
+// @Tags 配置管理
+// @Accept json
+// @Produce json
+// @Param request body request.RestoreConfigRequest true "恢复配置请求"
+// @Param Idempotency-Key header string false "幂等键（重试时携带相同的值，有效期内返回首次请求的响应）"
+// @Success 200 {object} types.Response{data=vo.ConfigVO}
+// @Router /api/v1/configs/restore [post]
+func (h *ConfigHandler) RestoreConfig(ctx context.Context, c *app.RequestContext) {
+	var req request.RestoreConfigRequest
+	bindAndValidate(c, &req)
+
+	configVO, err := h.configAppService.RestoreConfig(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("配置恢复成功", configVO))
+}
+
+// MigrateContentHashes 迁移配置内容哈希算法
+// @Summary 迁移配置内容哈希算法
+// @Description 将命名空间下配置的内容哈希迁移到服务端默认算法（security.content_hash_algorithm，支持 md5、sha256）
+// @Description 先按原算法校验哈希，校验失败的配置不迁移并在 mismatched 中返回；只更新哈希，不修改配置值和版本号
+// @Tags 配置管理
+// @Accept json
+// @Produce json
+// @Param request body request.MigrateContentHashRequest true "迁移请求"
+// @Success 200 {object} types.Response{data=vo.ContentHashMigrationVO}
+// @Router /api/v1/configs/content-hash/migrate [post]
+func (h *ConfigHandler) MigrateContentHashes(ctx context.Context, c *app.RequestContext) {
+	var req request.MigrateContentHashRequest
+	bindAndValidate(c, &req)
+
+	result, err := h.configAppService.MigrateContentHashes(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(result))
+}
+
+// RemoveConfig 根据路径ID删除配置（逻辑删除）
+// @Summary 删除配置（RESTful）
+// @Tags 配置管理
+// @Produce json
+// @Param id path int true "配置ID"
+// @Success 200 {object} types.Response
+// @Router /api/v1/configs/{id} [delete]
+func (h *ConfigHandler) RemoveConfig(ctx context.Context, c *app.RequestContext) {
+	if err := h.configAppService.DeleteConfig(ctx, pathID(c, "id")); err != nil {
 		panic(err)
 	}
 
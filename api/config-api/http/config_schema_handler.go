@@ -0,0 +1,123 @@
+package http
+
+import (
+	"context"
+
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/service"
+	"config-client/share/types"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// ConfigSchemaHandler 配置 Schema HTTP处理器
+type ConfigSchemaHandler struct {
+	schemaAppService *service.ConfigSchemaAppService
+}
+
+// NewConfigSchemaHandler 创建配置 Schema HTTP处理器
+func NewConfigSchemaHandler(schemaAppService *service.ConfigSchemaAppService) *ConfigSchemaHandler {
+	return &ConfigSchemaHandler{
+		schemaAppService: schemaAppService,
+	}
+}
+
+// CreateSchema 创建 Schema 绑定
+// @Summary 创建 Schema 绑定
+// @Description 将 JSON Schema 绑定到配置键或配置分组，之后创建/更新 json/yaml 类型的配置时会按 Schema 校验
+// @Tags 配置Schema管理
+// @Accept json
+// @Produce json
+// @Param request body request.CreateConfigSchemaRequest true "创建 Schema 绑定请求"
+// @Success 200 {object} types.Response{data=vo.ConfigSchemaVO}
+// @Router /api/v1/schemas [post]
+func (h *ConfigSchemaHandler) CreateSchema(ctx context.Context, c *app.RequestContext) {
+	var req request.CreateConfigSchemaRequest
+	bindAndValidate(c, &req)
+
+	schemaVO, err := h.schemaAppService.CreateSchema(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("Schema 绑定创建成功", schemaVO))
+}
+
+// UpdateSchema 更新 Schema 绑定
+// @Summary 更新 Schema 绑定
+// @Tags 配置Schema管理
+// @Accept json
+// @Produce json
+// @Param request body request.UpdateConfigSchemaRequest true "更新 Schema 绑定请求"
+// @Success 200 {object} types.Response{data=vo.ConfigSchemaVO}
+// @Router /api/v1/schemas [put]
+func (h *ConfigSchemaHandler) UpdateSchema(ctx context.Context, c *app.RequestContext) {
+	var req request.UpdateConfigSchemaRequest
+	bindAndValidate(c, &req)
+
+	schemaVO, err := h.schemaAppService.UpdateSchema(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("Schema 绑定更新成功", schemaVO))
+}
+
+// DeleteSchema 删除 Schema 绑定
+// @Summary 删除 Schema 绑定
+// @Tags 配置Schema管理
+// @Accept json
+// @Produce json
+// @Param request body request.DeleteConfigSchemaRequest true "删除 Schema 绑定请求"
+// @Success 200 {object} types.Response
+// @Router /api/v1/schemas [delete]
+func (h *ConfigSchemaHandler) DeleteSchema(ctx context.Context, c *app.RequestContext) {
+	var req request.DeleteConfigSchemaRequest
+	bindAndValidate(c, &req)
+
+	if err := h.schemaAppService.DeleteSchema(ctx, req.ID); err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("Schema 绑定删除成功", nil))
+}
+
+// GetSchemaByID 根据ID获取 Schema 绑定
+// @Summary 根据ID获取 Schema 绑定
+// @Tags 配置Schema管理
+// @Accept json
+// @Produce json
+// @Param request body request.GetConfigSchemaRequest true "获取 Schema 绑定请求"
+// @Success 200 {object} types.Response{data=vo.ConfigSchemaVO}
+// @Router /api/v1/schemas/get [post]
+func (h *ConfigSchemaHandler) GetSchemaByID(ctx context.Context, c *app.RequestContext) {
+	var req request.GetConfigSchemaRequest
+	bindAndValidate(c, &req)
+
+	schemaVO, err := h.schemaAppService.GetSchemaByID(ctx, req.ID)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(schemaVO))
+}
+
+// ListSchemas 查询命名空间下的 Schema 绑定
+// @Summary 查询命名空间下的 Schema 绑定
+// @Tags 配置Schema管理
+// @Produce json
+// @Param namespace_id query int true "命名空间ID"
+// @Success 200 {object} types.Response{data=[]vo.ConfigSchemaVO}
+// @Router /api/v1/schemas [get]
+func (h *ConfigSchemaHandler) ListSchemas(ctx context.Context, c *app.RequestContext) {
+	var req request.ListConfigSchemaRequest
+	bindAndValidate(c, &req)
+
+	schemaVOs, err := h.schemaAppService.ListSchemas(ctx, req.NamespaceID)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(schemaVOs))
+}
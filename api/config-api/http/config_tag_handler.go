@@ -0,0 +1,127 @@
+package http
+
+import (
+	"context"
+
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/service"
+	"config-client/share/types"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// ConfigTagHandler 配置标签HTTP处理器
+type ConfigTagHandler struct {
+	tagAppService *service.ConfigTagAppService
+}
+
+// NewConfigTagHandler 创建配置标签HTTP处理器
+func NewConfigTagHandler(tagAppService *service.ConfigTagAppService) *ConfigTagHandler {
+	return &ConfigTagHandler{
+		tagAppService: tagAppService,
+	}
+}
+
+// GetTags 查询配置标签
+// @Summary 查询配置标签
+// @Tags 配置标签
+// @Produce json
+// @Param config_id query int true "配置ID"
+// @Success 200 {object} types.Response{data=vo.ConfigTagListVO}
+// @Router /api/v1/configs/tags [get]
+func (h *ConfigTagHandler) GetTags(ctx context.Context, c *app.RequestContext) {
+	var req request.GetTagsRequest
+	bindAndValidate(c, &req)
+
+	tagListVO, err := h.tagAppService.GetTags(ctx, req.ConfigID)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(tagListVO))
+}
+
+// AddTags 添加配置标签
+// @Summary 添加配置标签
+// @Description 为配置添加标签，已存在的标签（键和值均相同）自动跳过，返回添加后的全部标签
+// @Tags 配置标签
+// @Accept json
+// @Produce json
+// @Param request body request.AddTagsRequest true "添加标签请求"
+// @Success 200 {object} types.Response{data=vo.ConfigTagListVO}
+// @Router /api/v1/configs/tags [post]
+func (h *ConfigTagHandler) AddTags(ctx context.Context, c *app.RequestContext) {
+	var req request.AddTagsRequest
+	bindAndValidate(c, &req)
+
+	tagListVO, err := h.tagAppService.AddTags(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("标签添加成功", tagListVO))
+}
+
+// ReplaceTags 替换配置标签
+// @Summary 替换配置标签
+// @Description 全量替换配置的标签（包括自动生成的标签），标签列表为空时清空所有标签
+// @Tags 配置标签
+// @Accept json
+// @Produce json
+// @Param request body request.ReplaceTagsRequest true "替换标签请求"
+// @Success 200 {object} types.Response{data=vo.ConfigTagListVO}
+// @Router /api/v1/configs/tags [put]
+func (h *ConfigTagHandler) ReplaceTags(ctx context.Context, c *app.RequestContext) {
+	var req request.ReplaceTagsRequest
+	bindAndValidate(c, &req)
+
+	tagListVO, err := h.tagAppService.ReplaceTags(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("标签替换成功", tagListVO))
+}
+
+// RemoveTags 删除配置标签
+// @Summary 删除配置标签
+// @Description 按标签键删除配置的标签（同一标签键下的所有值一并删除）
+// @Tags 配置标签
+// @Accept json
+// @Produce json
+// @Param request body request.RemoveTagsRequest true "删除标签请求"
+// @Success 200 {object} types.Response{data=vo.ConfigTagListVO}
+// @Router /api/v1/configs/tags [delete]
+func (h *ConfigTagHandler) RemoveTags(ctx context.Context, c *app.RequestContext) {
+	var req request.RemoveTagsRequest
+	bindAndValidate(c, &req)
+
+	tagListVO, err := h.tagAppService.RemoveTags(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("标签删除成功", tagListVO))
+}
+
+// RegenerateTags 重新生成自动标签
+// @Summary 重新生成自动标签
+// @Description 根据配置当前的键、分组、类型和环境重新生成自动标签（sensitive、category、type、environment、importance），其他标签保持不变
+// @Tags 配置标签
+// @Accept json
+// @Produce json
+// @Param request body request.RegenerateTagsRequest true "重新生成自动标签请求"
+// @Success 200 {object} types.Response{data=vo.ConfigTagListVO}
+// @Router /api/v1/configs/tags/regenerate [post]
+func (h *ConfigTagHandler) RegenerateTags(ctx context.Context, c *app.RequestContext) {
+	var req request.RegenerateTagsRequest
+	bindAndValidate(c, &req)
+
+	tagListVO, err := h.tagAppService.RegenerateTags(ctx, req.ConfigID)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("自动标签已重新生成", tagListVO))
+}
@@ -0,0 +1,203 @@
+package http
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/service"
+	"config-client/share/errors"
+	"config-client/share/types"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// maxImportFileSize 导入文件大小上限（10MB）
+const maxImportFileSize = 10 << 20
+
+// ConfigTransferHandler 配置导入导出HTTP处理器
+type ConfigTransferHandler struct {
+	transferAppService *service.ConfigTransferAppService
+}
+
+// NewConfigTransferHandler 创建配置导入导出HTTP处理器
+func NewConfigTransferHandler(transferAppService *service.ConfigTransferAppService) *ConfigTransferHandler {
+	return &ConfigTransferHandler{
+		transferAppService: transferAppService,
+	}
+}
+
+// ImportConfigs 批量导入配置
+// @Summary 批量导入配置
+// @Description 支持 YAML、JSON、properties、dotenv 格式，可通过 JSON 请求体传入 content 或以 multipart/form-data 上传 file
+// @Tags 配置导入导出
+// @Accept json,mpfd
+// @Produce json
+// @Param request body request.ImportConfigRequest true "导入配置请求"
+// @Param file formData file false "配置文件"
+// @Success 200 {object} types.Response{data=vo.ConfigImportResultVO}
+// @Router /api/v1/configs/import [post]
+func (h *ConfigTransferHandler) ImportConfigs(ctx context.Context, c *app.RequestContext) {
+	var req request.ImportConfigRequest
+	bindAndValidate(c, &req)
+
+	// 如果上传了文件，以文件内容为准
+	filename := ""
+	if fileHeader, err := c.FormFile("file"); err == nil && fileHeader != nil {
+		if fileHeader.Size > maxImportFileSize {
+			panic(errors.ErrBadRequest("导入文件不能超过 10MB"))
+		}
+
+		file, err := fileHeader.Open()
+		if err != nil {
+			panic(errors.ErrBadRequest("读取上传文件失败: " + err.Error()))
+		}
+		defer file.Close()
+
+		data, err := io.ReadAll(io.LimitReader(file, maxImportFileSize))
+		if err != nil {
+			panic(errors.ErrBadRequest("读取上传文件失败: " + err.Error()))
+		}
+		req.Content = string(data)
+		filename = fileHeader.Filename
+	}
+
+	resultVO, err := h.transferAppService.ImportConfigs(ctx, &req, filename)
+	if err != nil {
+		panic(err)
+	}
+
+	message := "配置导入成功"
+	if req.DryRun {
+		message = "配置导入预览成功"
+	}
+	c.JSON(consts.StatusOK, types.SuccessWithMessage(message, resultVO))
+}
+
+// ExportNamespace 导出命名空间配置
+// @Summary 导出命名空间配置
+// @Description 将命名空间指定环境下已发布的配置导出为单个文档，可用于备份或初始化其他环境
+// @Tags 配置导入导出
+// @Produce json,plain
+// @Param namespace_id query int true "命名空间ID"
+// @Param environment query string false "环境" default(default)
+// @Param group_name query string false "仅导出指定分组"
+// @Param format query string false "导出格式：yaml/json/properties/env" default(yaml)
+// @Param include_secrets query bool false "是否导出敏感配置明文" default(false)
+// @Success 200 {string} string "配置文档"
+// @Router /api/v1/namespaces/export [get]
+func (h *ConfigTransferHandler) ExportNamespace(ctx context.Context, c *app.RequestContext) {
+	var req request.ExportNamespaceRequest
+	bindAndValidate(c, &req)
+
+	exportVO, err := h.transferAppService.ExportNamespace(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", exportVO.Filename))
+	c.Header("X-Config-Count", strconv.Itoa(exportVO.Count))
+	c.Data(consts.StatusOK, exportVO.ContentType, []byte(exportVO.Content))
+}
+
+// RenderNamespace 渲染命名空间配置
+// @Summary 渲染命名空间配置
+// @Description 返回命名空间合并后的生效配置（指定环境覆盖默认环境，已解析 ${namespace:key} 引用）作为单个文档，供 sidecar 一次拉取完整配置文件；文件类型配置不输出；响应 ETag 为文档内容哈希，If-None-Match 命中时返回 304
+// @Tags 配置导入导出
+// @Produce json,plain
+// @Param id path int true "命名空间ID"
+// @Param environment query string false "环境" default(default)
+// @Param format query string false "渲染格式：yaml/json/properties/env" default(yaml)
+// @Param include_secrets query bool false "是否输出敏感配置明文" default(false)
+// @Success 200 {string} string "配置文档"
+// @Router /api/v1/namespaces/{id}/render [get]
+func (h *ConfigTransferHandler) RenderNamespace(ctx context.Context, c *app.RequestContext) {
+	var req request.RenderNamespaceRequest
+	bindAndValidate(c, &req)
+	req.NamespaceID = pathID(c, "id")
+
+	renderVO, err := h.transferAppService.RenderNamespace(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	sum := sha256.Sum256([]byte(renderVO.Content))
+	etag := strconv.Quote(hex.EncodeToString(sum[:]))
+	c.Header("ETag", etag)
+	c.Header("X-Config-Count", strconv.Itoa(renderVO.Count))
+	if string(c.GetHeader("If-None-Match")) == etag {
+		c.Status(consts.StatusNotModified)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("inline; filename=%q", renderVO.Filename))
+	c.Data(consts.StatusOK, renderVO.ContentType, []byte(renderVO.Content))
+}
+
+// PreviewPromotion 预览环境晋升差异
+// @Summary 预览环境晋升差异
+// @Description 对比命名空间下源环境与目标环境的配置，返回新增、修改、删除的差异及差异摘要 diff_token
+// @Tags 环境晋升
+// @Accept json
+// @Produce json
+// @Param request body request.PromotionPreviewRequest true "环境晋升预览请求"
+// @Success 200 {object} types.Response{data=vo.PromotionDiffVO}
+// @Router /api/v1/configs/promote/preview [post]
+func (h *ConfigTransferHandler) PreviewPromotion(ctx context.Context, c *app.RequestContext) {
+	var req request.PromotionPreviewRequest
+	bindAndValidate(c, &req)
+
+	diffVO, err := h.transferAppService.PreviewPromotion(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(diffVO))
+}
+
+// ApplyPromotion 执行环境晋升
+// @Summary 执行环境晋升
+// @Description 回传预览得到的 diff_token 确认执行；差异在预览后发生变化时拒绝执行，每个配置的变更都会记录变更历史
+// @Tags 环境晋升
+// @Accept json
+// @Produce json
+// @Param request body request.ApplyPromotionRequest true "执行环境晋升请求"
+// @Success 200 {object} types.Response{data=vo.PromotionResultVO}
+// @Router /api/v1/configs/promote [post]
+func (h *ConfigTransferHandler) ApplyPromotion(ctx context.Context, c *app.RequestContext) {
+	var req request.ApplyPromotionRequest
+	bindAndValidate(c, &req)
+
+	resultVO, err := h.transferAppService.ApplyPromotion(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("环境晋升完成", resultVO))
+}
+
+// CloneNamespace 克隆命名空间
+// @Summary 克隆命名空间
+// @Description 创建新命名空间并复制源命名空间的配置（可限定环境和分组），保留配置的元数据和标签；复制后的配置为未发布状态
+// @Tags 命名空间管理
+// @Accept json
+// @Produce json
+// @Param request body request.CloneNamespaceRequest true "克隆命名空间请求"
+// @Success 200 {object} types.Response{data=vo.NamespaceCloneVO}
+// @Router /api/v1/namespaces/clone [post]
+func (h *ConfigTransferHandler) CloneNamespace(ctx context.Context, c *app.RequestContext) {
+	var req request.CloneNamespaceRequest
+	bindAndValidate(c, &req)
+
+	cloneVO, err := h.transferAppService.CloneNamespace(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("命名空间克隆成功", cloneVO))
+}
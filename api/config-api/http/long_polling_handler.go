@@ -5,6 +5,7 @@ import (
 
 	"config-client/api/config-api/dto/request"
 	"config-client/api/config-api/service"
+	"config-client/share/errors"
 	"config-client/share/types"
 
 	"github.com/cloudwego/hertz/pkg/app"
@@ -25,6 +26,13 @@ func NewLongPollingHandler(longPollingAppService *service.LongPollingAppService)
 
 // Watch 长轮询监听配置变更
 // @Summary 长轮询监听配置变更
+// @Description 有变更时在 configs 中直接返回变更配置的最新值、值类型和版本（命中灰度时为灰度版本中的值，配置已删除时 deleted=true），客户端无需再查询配置
+// @Description 一次请求可同时监听多个命名空间和环境的配置，按（命名空间, 环境）分别订阅；响应的 sequences 返回各命名空间的事件序号，下次请求在 last_sequences 中携带
+// @Description 并发长轮询数超过系统配置 long.polling.max.waiters 时立即返回 429，并通过 Retry-After 头告知建议的重试等待秒数
+// @Description 响应的 next_poll_delay_ms 为建议客户端发起下一次长轮询前等待的毫秒数（系统配置 long.polling.poll.delay，并发数接近上限时逐步延长）
+// @Description 通过 prefixes 按前缀监听：前缀下已有配置变更或新建配置时均返回变更，客户端在 versions 中上报已持有的前缀下配置的版本
+// @Description prefix 为空时监听命名空间下的全部配置（包括新建和删除的配置）
+// @Description 只读令牌监听绑定范围以外的配置键或前缀时返回 403，限制了配置键前缀的只读令牌不能监听整个命名空间
 // @Tags 配置管理
 // @Accept json
 // @Produce json
@@ -33,9 +41,8 @@ func NewLongPollingHandler(longPollingAppService *service.LongPollingAppService)
 // @Router /api/v1/configs/watch [post]
 func (h *LongPollingHandler) Watch(ctx context.Context, c *app.RequestContext) {
 	var req request.LongPollingRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
+	checkWatchRequest(ctx, &req)
 
 	resp, err := h.longPollingAppService.WaitForChanges(ctx, &req)
 	if err != nil {
@@ -50,3 +57,16 @@ func (h *LongPollingHandler) Watch(ctx context.Context, c *app.RequestContext) {
 
 	c.JSON(consts.StatusOK, types.SuccessWithMessage("配置已变更", resp))
 }
+
+// checkWatchRequest 校验监听请求：配置键和前缀不能同时为空，只读令牌只能监听绑定范围内的配置
+func checkWatchRequest(ctx context.Context, req *request.LongPollingRequest) {
+	if len(req.ConfigKeys) == 0 && len(req.Prefixes) == 0 {
+		panic(errors.ErrBadRequest("config_keys 和 prefixes 不能同时为空"))
+	}
+	for _, item := range req.ConfigKeys {
+		checkReadScope(ctx, item.NamespaceID, item.Environment, item.ConfigKey)
+	}
+	for _, item := range req.Prefixes {
+		checkReadScopePrefix(ctx, item.NamespaceID, item.Environment, item.Prefix)
+	}
+}
@@ -34,9 +34,7 @@ func NewNamespaceHandler(namespaceAppService *service.NamespaceAppService) *Name
 // @Router /api/v1/namespaces [post]
 func (h *NamespaceHandler) CreateNamespace(ctx context.Context, c *app.RequestContext) {
 	var req request.CreateNamespaceRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	namespaceVO, err := h.namespaceAppService.CreateNamespace(ctx, &req)
 	if err != nil {
@@ -56,9 +54,7 @@ func (h *NamespaceHandler) CreateNamespace(ctx context.Context, c *app.RequestCo
 // @Router /api/v1/namespaces [put]
 func (h *NamespaceHandler) UpdateNamespace(ctx context.Context, c *app.RequestContext) {
 	var req request.UpdateNamespaceRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	namespaceVO, err := h.namespaceAppService.UpdateNamespace(ctx, req.ID, &req)
 	if err != nil {
@@ -78,9 +74,7 @@ func (h *NamespaceHandler) UpdateNamespace(ctx context.Context, c *app.RequestCo
 // @Router /api/v1/namespaces [delete]
 func (h *NamespaceHandler) DeleteNamespace(ctx context.Context, c *app.RequestContext) {
 	var req request.DeleteNamespaceRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	if err := h.namespaceAppService.DeleteNamespace(ctx, req.ID); err != nil {
 		panic(err)
@@ -99,9 +93,7 @@ func (h *NamespaceHandler) DeleteNamespace(ctx context.Context, c *app.RequestCo
 // @Router /api/v1/namespaces/activate [put]
 func (h *NamespaceHandler) ActivateNamespace(ctx context.Context, c *app.RequestContext) {
 	var req request.ActivateNamespaceRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	namespaceVO, err := h.namespaceAppService.ActivateNamespace(ctx, req.ID)
 	if err != nil {
@@ -121,9 +113,7 @@ func (h *NamespaceHandler) ActivateNamespace(ctx context.Context, c *app.Request
 // @Router /api/v1/namespaces/deactivate [put]
 func (h *NamespaceHandler) DeactivateNamespace(ctx context.Context, c *app.RequestContext) {
 	var req request.DeactivateNamespaceRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	namespaceVO, err := h.namespaceAppService.DeactivateNamespace(ctx, req.ID)
 	if err != nil {
@@ -146,9 +136,7 @@ func (h *NamespaceHandler) DeactivateNamespace(ctx context.Context, c *app.Reque
 // @Router /api/v1/namespaces [get]
 func (h *NamespaceHandler) QueryNamespaces(ctx context.Context, c *app.RequestContext) {
 	var req request.QueryNamespaceRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	namespaceListVO, err := h.namespaceAppService.QueryNamespaces(ctx, &req)
 	if err != nil {
@@ -168,11 +156,25 @@ func (h *NamespaceHandler) QueryNamespaces(ctx context.Context, c *app.RequestCo
 // @Router /api/v1/namespaces/get [post]
 func (h *NamespaceHandler) GetNamespaceByID(ctx context.Context, c *app.RequestContext) {
 	var req request.GetNamespaceByIDRequest
-	if err := c.BindAndValidate(&req); err != nil {
+	bindAndValidate(c, &req)
+
+	namespaceVO, err := h.namespaceAppService.GetNamespaceByID(ctx, req.ID)
+	if err != nil {
 		panic(err)
 	}
 
-	namespaceVO, err := h.namespaceAppService.GetNamespaceByID(ctx, req.ID)
+	c.JSON(consts.StatusOK, types.Success(namespaceVO))
+}
+
+// GetNamespace 根据路径ID获取命名空间
+// @Summary 根据ID获取命名空间（RESTful）
+// @Tags 命名空间管理
+// @Produce json
+// @Param id path int true "命名空间ID"
+// @Success 200 {object} types.Response{data=vo.NamespaceVO}
+// @Router /api/v1/namespaces/{id} [get]
+func (h *NamespaceHandler) GetNamespace(ctx context.Context, c *app.RequestContext) {
+	namespaceVO, err := h.namespaceAppService.GetNamespaceByID(ctx, pathID(c, "id"))
 	if err != nil {
 		panic(err)
 	}
@@ -180,6 +182,47 @@ func (h *NamespaceHandler) GetNamespaceByID(ctx context.Context, c *app.RequestC
 	c.JSON(consts.StatusOK, types.Success(namespaceVO))
 }
 
+// UpdateNamespaceQuota 设置命名空间配额
+// @Summary 设置命名空间配额
+// @Description 设置配置数量、配置值大小、活跃订阅数量上限和每个环境保留的发布版本数量，各项为 0 时继承全局默认配额（quota 配置）
+// @Description 超出配额的写入返回 403（配置值过大返回 413）；已有数据不受影响，超出保留数量的发布版本在下次创建版本时清理
+// @Description 仅默认租户可以设置，其他租户的命名空间需携带 X-Tenant-ID 请求头
+// @Tags 命名空间管理
+// @Accept json
+// @Produce json
+// @Param id path int true "命名空间ID"
+// @Param request body request.UpdateNamespaceQuotaRequest true "设置命名空间配额请求"
+// @Success 200 {object} types.Response{data=vo.NamespaceVO}
+// @Router /api/v1/namespaces/{id}/quota [put]
+func (h *NamespaceHandler) UpdateNamespaceQuota(ctx context.Context, c *app.RequestContext) {
+	var req request.UpdateNamespaceQuotaRequest
+	bindAndValidate(c, &req)
+
+	namespaceVO, err := h.namespaceAppService.UpdateNamespaceQuota(ctx, pathID(c, "id"), &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("命名空间配额已更新", namespaceVO))
+}
+
+// GetNamespaceStats 查询命名空间用量
+// @Summary 查询命名空间用量
+// @Description 返回配置数量、最大的配置值大小、活跃订阅数量和各环境的发布版本数量，以及对应的生效配额（limit 为 0 表示不限制）
+// @Tags 命名空间管理
+// @Produce json
+// @Param id path int true "命名空间ID"
+// @Success 200 {object} types.Response{data=vo.NamespaceStatsVO}
+// @Router /api/v1/namespaces/{id}/stats [get]
+func (h *NamespaceHandler) GetNamespaceStats(ctx context.Context, c *app.RequestContext) {
+	statsVO, err := h.namespaceAppService.GetNamespaceStats(ctx, pathID(c, "id"))
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(statsVO))
+}
+
 // GetNamespaceByName 根据名称获取命名空间
 // @Summary 根据名称获取命名空间
 // @Tags 命名空间管理
@@ -0,0 +1,144 @@
+package http
+
+import (
+	"context"
+
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/service"
+	"config-client/share/types"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// NotificationHandler 通知渠道HTTP处理器
+type NotificationHandler struct {
+	notificationAppService *service.NotificationAppService
+}
+
+// NewNotificationHandler 创建通知渠道HTTP处理器
+func NewNotificationHandler(notificationAppService *service.NotificationAppService) *NotificationHandler {
+	return &NotificationHandler{
+		notificationAppService: notificationAppService,
+	}
+}
+
+// CreateChannel 创建通知渠道
+// @Summary 创建通知渠道
+// @Description 为命名空间配置钉钉群机器人（dingtalk）、Slack Incoming Webhook（slack）或邮件（email）通知渠道。
+// @Description 命名空间内的发布（publish，含灰度发布）、回滚（rollback）和灰度失败（canary_failed，灰度版本被回滚）按 events 过滤后，
+// @Description 以模板渲染的消息发送，消息包含变更摘要和详情链接。template 为 Go text/template，可用字段见 NotificationTemplateData，
+// @Description 如 {{.Namespace}}、{{.Version}}、{{.DiffSummary}}、{{keys .Added}}；邮件渠道需配置 notification.smtp
+// @Tags 通知渠道管理
+// @Accept json
+// @Produce json
+// @Param request body request.CreateNotificationChannelRequest true "创建通知渠道请求"
+// @Success 200 {object} types.Response{data=vo.NotificationChannelVO}
+// @Router /api/v1/notification-channels [post]
+func (h *NotificationHandler) CreateChannel(ctx context.Context, c *app.RequestContext) {
+	var req request.CreateNotificationChannelRequest
+	bindAndValidate(c, &req)
+
+	channelVO, err := h.notificationAppService.CreateChannel(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("通知渠道创建成功", channelVO))
+}
+
+// UpdateChannel 更新通知渠道
+// @Summary 更新通知渠道
+// @Description 渠道类型不可修改；secret 为空时保留原加签密钥
+// @Tags 通知渠道管理
+// @Accept json
+// @Produce json
+// @Param request body request.UpdateNotificationChannelRequest true "更新通知渠道请求"
+// @Success 200 {object} types.Response{data=vo.NotificationChannelVO}
+// @Router /api/v1/notification-channels [put]
+func (h *NotificationHandler) UpdateChannel(ctx context.Context, c *app.RequestContext) {
+	var req request.UpdateNotificationChannelRequest
+	bindAndValidate(c, &req)
+
+	channelVO, err := h.notificationAppService.UpdateChannel(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("通知渠道更新成功", channelVO))
+}
+
+// DeleteChannel 删除通知渠道
+// @Summary 删除通知渠道
+// @Tags 通知渠道管理
+// @Accept json
+// @Produce json
+// @Param request body request.DeleteNotificationChannelRequest true "删除通知渠道请求"
+// @Success 200 {object} types.Response
+// @Router /api/v1/notification-channels [delete]
+func (h *NotificationHandler) DeleteChannel(ctx context.Context, c *app.RequestContext) {
+	var req request.DeleteNotificationChannelRequest
+	bindAndValidate(c, &req)
+
+	if err := h.notificationAppService.DeleteChannel(ctx, req.ID); err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("通知渠道删除成功", nil))
+}
+
+// ListChannels 查询命名空间下的通知渠道
+// @Summary 查询命名空间下的通知渠道
+// @Tags 通知渠道管理
+// @Produce json
+// @Param namespace_id query int true "命名空间ID"
+// @Success 200 {object} types.Response{data=[]vo.NotificationChannelVO}
+// @Router /api/v1/notification-channels [get]
+func (h *NotificationHandler) ListChannels(ctx context.Context, c *app.RequestContext) {
+	var req request.ListNotificationChannelsRequest
+	bindAndValidate(c, &req)
+
+	channelVOs, err := h.notificationAppService.ListChannels(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(channelVOs))
+}
+
+// GetChannel 根据ID查询通知渠道
+// @Summary 根据ID查询通知渠道
+// @Tags 通知渠道管理
+// @Produce json
+// @Param id path int true "通知渠道ID"
+// @Success 200 {object} types.Response{data=vo.NotificationChannelVO}
+// @Router /api/v1/notification-channels/{id} [get]
+func (h *NotificationHandler) GetChannel(ctx context.Context, c *app.RequestContext) {
+	channelVO, err := h.notificationAppService.GetChannel(ctx, pathID(c, "id"))
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(channelVO))
+}
+
+// TestChannel 发送测试消息
+// @Summary 发送测试消息
+// @Description 以示例发布数据渲染渠道模板并同步发送（不重试），用于验证机器人地址、加签密钥、收件人和模板
+// @Tags 通知渠道管理
+// @Accept json
+// @Produce json
+// @Param id path int true "通知渠道ID"
+// @Param request body request.TestNotificationChannelRequest true "发送测试消息请求"
+// @Success 200 {object} types.Response
+// @Router /api/v1/notification-channels/{id}/test [post]
+func (h *NotificationHandler) TestChannel(ctx context.Context, c *app.RequestContext) {
+	var req request.TestNotificationChannelRequest
+	bindAndValidate(c, &req)
+
+	if err := h.notificationAppService.TestChannel(ctx, pathID(c, "id"), &req); err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("测试消息发送成功", nil))
+}
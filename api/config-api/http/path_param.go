@@ -0,0 +1,18 @@
+package http
+
+import (
+	"strconv"
+
+	"config-client/share/errors"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// pathID 解析路径参数中的正整数ID，格式错误时抛出 400 异常
+func pathID(c *app.RequestContext, name string) int {
+	id, err := strconv.Atoi(c.Param(name))
+	if err != nil || id <= 0 {
+		panic(errors.ErrBadRequest("无效的路径参数 " + name + ": " + c.Param(name)))
+	}
+	return id
+}
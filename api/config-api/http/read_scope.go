@@ -0,0 +1,82 @@
+package http
+
+import (
+	"context"
+
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/dto/vo"
+	domainErrors "config-client/config/domain/errors"
+	"config-client/share/middleware"
+)
+
+// defaultEnvironment 未指定环境时使用的环境
+const defaultEnvironment = "default"
+
+// checkReadScope 校验只读令牌是否可以读取指定命名空间、环境下的配置键（key 为空时只校验命名空间和环境），越权时抛出 403 异常
+// 管理员密钥和未启用认证时不做限制
+func checkReadScope(ctx context.Context, namespaceID int, environment, key string) {
+	scope := middleware.ReadScopeFromContext(ctx)
+	if scope == nil {
+		return
+	}
+	if environment == "" {
+		environment = defaultEnvironment
+	}
+	if !scope.Allows(namespaceID, environment, key) {
+		if key == "" {
+			panic(domainErrors.ErrAPIKeyForbidden("只读令牌不能读取该命名空间或环境的配置"))
+		}
+		panic(domainErrors.ErrAPIKeyForbidden("只读令牌不能读取配置: " + key))
+	}
+}
+
+// checkReadScopePrefix 校验只读令牌是否可以监听指定前缀下的配置（prefix 为空表示整个命名空间），越权时抛出 403 异常
+// 限制了配置键前缀的令牌只能监听允许范围内的前缀，不能监听整个命名空间
+func checkReadScopePrefix(ctx context.Context, namespaceID int, environment, prefix string) {
+	if scope := middleware.ReadScopeFromContext(ctx); prefix == "" && scope != nil && len(scope.KeyPrefixes) > 0 {
+		panic(domainErrors.ErrAPIKeyForbidden("限制了配置键前缀的只读令牌不能监听整个命名空间"))
+	}
+	checkReadScope(ctx, namespaceID, environment, prefix)
+}
+
+// scopeConfigQuery 将只读令牌的分页查询限定在绑定的命名空间和环境下，且只返回已发布、已激活的配置
+// 限制了配置键前缀的令牌无法在分页查询中过滤，需通过 /configs/key 或 /configs/effective 读取
+func scopeConfigQuery(ctx context.Context, req *request.QueryConfigRequest) {
+	scope := middleware.ReadScopeFromContext(ctx)
+	if scope == nil {
+		return
+	}
+	if len(scope.KeyPrefixes) > 0 {
+		panic(domainErrors.ErrAPIKeyForbidden("限制了配置键前缀的只读令牌不能分页查询配置"))
+	}
+
+	namespaceID, environment := scope.NamespaceID, scope.Environment
+	if req.NamespaceID != nil {
+		namespaceID = *req.NamespaceID
+	}
+	if req.Environment != nil && *req.Environment != "" {
+		environment = *req.Environment
+	}
+	checkReadScope(ctx, namespaceID, environment, "")
+
+	released, active := true, true
+	req.NamespaceID = &namespaceID
+	req.Environment = &environment
+	req.IsReleased = &released
+	req.IsActive = &active
+}
+
+// filterEffectiveConfigs 过滤只读令牌无权读取的生效配置
+func filterEffectiveConfigs(ctx context.Context, effective *vo.EffectiveConfigVO) {
+	scope := middleware.ReadScopeFromContext(ctx)
+	if scope == nil || len(scope.KeyPrefixes) == 0 {
+		return
+	}
+	items := effective.Items[:0]
+	for _, item := range effective.Items {
+		if scope.Allows(scope.NamespaceID, scope.Environment, item.Key) {
+			items = append(items, item)
+		}
+	}
+	effective.Items = items
+}
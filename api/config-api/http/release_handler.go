@@ -26,17 +26,17 @@ func NewReleaseHandler(releaseAppService *service.ReleaseAppService) *ReleaseHan
 
 // CreateRelease 创建发布版本
 // @Summary 创建发布版本
+// @Description release_type=incremental 时只保存相对最新已发布版本变更的配置（删除的配置带 deleted 标记），生效配置为基线版本合并增量快照；没有已发布版本时退化为全量快照
 // @Tags 发布管理
 // @Accept json
 // @Produce json
 // @Param request body request.CreateReleaseRequest true "创建发布版本请求"
+// @Param Idempotency-Key header string false "幂等键（重试时携带相同的值，有效期内返回首次请求的响应）"
 // @Success 200 {object} types.Response{data=vo.ReleaseVO}
 // @Router /api/v1/releases [post]
 func (h *ReleaseHandler) CreateRelease(ctx context.Context, c *app.RequestContext) {
 	var req request.CreateReleaseRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	releaseVO, err := h.releaseAppService.CreateRelease(ctx, &req)
 	if err != nil {
@@ -48,17 +48,19 @@ func (h *ReleaseHandler) CreateRelease(ctx context.Context, c *app.RequestContex
 
 // PublishFull 全量发布
 // @Summary 全量发布
+// @Description 发布说明可在创建版本或发布时填写（发布时填写的覆盖创建时的说明），为空时不允许发布
+// @Description 发布到受保护环境（release.approval_environments）前版本必须审批通过，否则返回 403
+// @Description 处于发布冻结窗口时返回 403，携带 freeze_override=true 和 override_reason 时放行并记录审计
 // @Tags 发布管理
 // @Accept json
 // @Produce json
 // @Param request body request.PublishFullRequest true "全量发布请求"
+// @Param Idempotency-Key header string false "幂等键（重试时携带相同的值，有效期内返回首次请求的响应）"
 // @Success 200 {object} types.Response
 // @Router /api/v1/releases/publish-full [post]
 func (h *ReleaseHandler) PublishFull(ctx context.Context, c *app.RequestContext) {
 	var req request.PublishFullRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	err := h.releaseAppService.PublishFull(ctx, &req)
 	if err != nil {
@@ -70,17 +72,19 @@ func (h *ReleaseHandler) PublishFull(ctx context.Context, c *app.RequestContext)
 
 // PublishCanary 灰度发布
 // @Summary 灰度发布
+// @Description 发布说明可在创建版本或发布时填写（发布时填写的覆盖创建时的说明），为空时不允许发布
+// @Description 发布到受保护环境（release.approval_environments）前版本必须审批通过，否则返回 403
+// @Description 处于发布冻结窗口时返回 403，携带 freeze_override=true 和 override_reason 时放行并记录审计
 // @Tags 发布管理
 // @Accept json
 // @Produce json
 // @Param request body request.PublishCanaryRequest true "灰度发布请求"
+// @Param Idempotency-Key header string false "幂等键（重试时携带相同的值，有效期内返回首次请求的响应）"
 // @Success 200 {object} types.Response
 // @Router /api/v1/releases/publish-canary [post]
 func (h *ReleaseHandler) PublishCanary(ctx context.Context, c *app.RequestContext) {
 	var req request.PublishCanaryRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	err := h.releaseAppService.PublishCanary(ctx, &req)
 	if err != nil {
@@ -92,17 +96,17 @@ func (h *ReleaseHandler) PublishCanary(ctx context.Context, c *app.RequestContex
 
 // Rollback 回滚到指定版本
 // @Summary 回滚版本
+// @Description 处于发布冻结窗口时返回 403，携带 freeze_override=true 和 override_reason 时放行并记录审计
 // @Tags 发布管理
 // @Accept json
 // @Produce json
 // @Param request body request.ReleaseRollbackRequest true "回滚请求"
+// @Param Idempotency-Key header string false "幂等键（重试时携带相同的值，有效期内返回首次请求的响应）"
 // @Success 200 {object} types.Response
 // @Router /api/v1/releases/rollback [post]
 func (h *ReleaseHandler) Rollback(ctx context.Context, c *app.RequestContext) {
 	var req request.ReleaseRollbackRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	err := h.releaseAppService.Rollback(ctx, &req)
 	if err != nil {
@@ -112,6 +116,125 @@ func (h *ReleaseHandler) Rollback(ctx context.Context, c *app.RequestContext) {
 	c.JSON(consts.StatusOK, types.SuccessWithMessage("回滚成功", nil))
 }
 
+// ApproveRelease 审批通过发布版本
+// @Summary 审批通过发布版本
+// @Description 发布到受保护环境（release.approval_environments）的版本必须先审批通过；审批人不能是版本创建人，仅测试中的版本可以审批。启用认证时审批人和创建人取自 API Key 名称，请求中填写的 operator 须与之一致
+// @Tags 发布管理
+// @Accept json
+// @Produce json
+// @Param id path int true "发布版本ID"
+// @Param request body request.ReviewReleaseRequest true "审批请求"
+// @Success 200 {object} types.Response{data=vo.ReleaseVO}
+// @Router /api/v1/releases/{id}/approve [post]
+func (h *ReleaseHandler) ApproveRelease(ctx context.Context, c *app.RequestContext) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(consts.StatusBadRequest, types.SuccessWithMessage("无效的发布版本ID", nil))
+		return
+	}
+
+	var req request.ReviewReleaseRequest
+	bindAndValidate(c, &req)
+
+	releaseVO, err := h.releaseAppService.ApproveRelease(ctx, id, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("审批通过", releaseVO))
+}
+
+// RejectRelease 驳回发布版本
+// @Summary 驳回发布版本
+// @Description 驳回后需重新审批通过才能发布；驳回必须填写审批意见，审批人不能是版本创建人（启用认证时取自 API Key 名称）
+// @Tags 发布管理
+// @Accept json
+// @Produce json
+// @Param id path int true "发布版本ID"
+// @Param request body request.ReviewReleaseRequest true "审批请求"
+// @Success 200 {object} types.Response{data=vo.ReleaseVO}
+// @Router /api/v1/releases/{id}/reject [post]
+func (h *ReleaseHandler) RejectRelease(ctx context.Context, c *app.RequestContext) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(consts.StatusBadRequest, types.SuccessWithMessage("无效的发布版本ID", nil))
+		return
+	}
+
+	var req request.ReviewReleaseRequest
+	bindAndValidate(c, &req)
+
+	releaseVO, err := h.releaseAppService.RejectRelease(ctx, id, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("已驳回", releaseVO))
+}
+
+// ListApprovals 查询发布版本审批记录
+// @Summary 查询发布版本审批记录
+// @Tags 发布管理
+// @Accept json
+// @Produce json
+// @Param id path int true "发布版本ID"
+// @Success 200 {object} types.Response{data=[]vo.ReleaseApprovalVO}
+// @Router /api/v1/releases/{id}/approvals [get]
+func (h *ReleaseHandler) ListApprovals(ctx context.Context, c *app.RequestContext) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(consts.StatusBadRequest, types.SuccessWithMessage("无效的发布版本ID", nil))
+		return
+	}
+
+	approvals, err := h.releaseAppService.ListApprovals(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(approvals))
+}
+
+// GetFreezeStatus 查询当前是否处于发布冻结窗口
+// @Summary 查询发布冻结状态
+// @Description 冻结窗口由 release.freeze_windows 配置（按周循环），窗口内发布和回滚需携带 freeze_override=true 及 override_reason
+// @Tags 发布管理
+// @Accept json
+// @Produce json
+// @Param namespace_id query int true "命名空间ID"
+// @Param environment query string true "环境"
+// @Success 200 {object} types.Response{data=vo.FreezeStatusVO}
+// @Router /api/v1/releases/freeze-status [get]
+func (h *ReleaseHandler) GetFreezeStatus(ctx context.Context, c *app.RequestContext) {
+	var req request.FreezeStatusRequest
+	bindAndValidate(c, &req)
+
+	c.JSON(consts.StatusOK, types.Success(h.releaseAppService.GetFreezeStatus(ctx, &req)))
+}
+
+// ListFreezeOverrides 查询冻结窗口覆盖记录
+// @Summary 查询冻结窗口覆盖记录
+// @Description 返回冻结窗口内显式覆盖执行的发布和回滚审计记录（按时间倒序）
+// @Tags 发布管理
+// @Accept json
+// @Produce json
+// @Param namespace_id query int true "命名空间ID"
+// @Param environment query string false "环境（为空时不限环境）"
+// @Param limit query int false "返回条数（默认 50，最大 200）"
+// @Success 200 {object} types.Response{data=[]vo.ReleaseFreezeOverrideVO}
+// @Router /api/v1/releases/freeze-overrides [get]
+func (h *ReleaseHandler) ListFreezeOverrides(ctx context.Context, c *app.RequestContext) {
+	var req request.ListFreezeOverridesRequest
+	bindAndValidate(c, &req)
+
+	overrides, err := h.releaseAppService.ListFreezeOverrides(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(overrides))
+}
+
 // GetReleaseByID 根据ID查询发布版本
 // @Summary 根据ID查询发布版本
 // @Tags 发布管理
@@ -139,6 +262,31 @@ func (h *ReleaseHandler) GetReleaseByID(ctx context.Context, c *app.RequestConte
 	c.JSON(consts.StatusOK, types.Success(releaseVO))
 }
 
+// GetChangelog 获取发布版本变更日志
+// @Summary 获取发布版本变更日志
+// @Description 返回发布说明及创建版本时生成的相对上一已发布版本的键级变更明细（新增、修改、删除的配置键），text 为发布说明后追加变更明细的文本
+// @Tags 发布管理
+// @Accept json
+// @Produce json
+// @Param id path int true "发布版本ID"
+// @Success 200 {object} types.Response{data=vo.ReleaseChangelogVO}
+// @Router /api/v1/releases/{id}/changelog [get]
+func (h *ReleaseHandler) GetChangelog(ctx context.Context, c *app.RequestContext) {
+	idStr := c.Param("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		c.JSON(consts.StatusBadRequest, types.SuccessWithMessage("无效的发布版本ID", nil))
+		return
+	}
+
+	changelogVO, err := h.releaseAppService.GetChangelog(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(changelogVO))
+}
+
 // GetLatestPublishedRelease 获取最新已发布版本
 // @Summary 获取最新已发布版本
 // @Tags 发布管理
@@ -213,14 +361,14 @@ func (h *ReleaseHandler) ListReleasesByNamespace(ctx context.Context, c *app.Req
 // @Param version_name query string false "版本名称"
 // @Param page query int false "页码"
 // @Param size query int false "每页数量"
-// @Param order_by query string false "排序字段"
+// @Param order_by query string false "排序，格式为 字段 [asc|desc]，多个用逗号分隔，默认 version desc。可选字段：id, namespace_id, environment, version, version_name, status, release_type, approval_status, released_at, created_at, updated_at"
+// @Param pagination query string false "分页方式：offset（默认）/cursor，游标分页按创建时间倒序且不统计总数"
+// @Param cursor query string false "游标分页的游标（上一页返回的 next_cursor）"
 // @Success 200 {object} types.Response{data=vo.ReleaseListVO}
 // @Router /api/v1/releases [get]
 func (h *ReleaseHandler) QueryReleases(ctx context.Context, c *app.RequestContext) {
 	var req request.QueryReleaseRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	releaseListVO, err := h.releaseAppService.QueryReleases(ctx, &req)
 	if err != nil {
@@ -232,6 +380,8 @@ func (h *ReleaseHandler) QueryReleases(ctx context.Context, c *app.RequestContex
 
 // CompareReleases 对比两个版本
 // @Summary 对比两个版本
+// @Description mode=release（默认）对比 from_release_id 与 to_release_id 两个版本；
+// @Description mode=live 将 to_release_id 与当前命名空间/环境的线上已发布配置对比，预览立即发布该版本会带来的变更
 // @Tags 发布管理
 // @Accept json
 // @Produce json
@@ -240,9 +390,7 @@ func (h *ReleaseHandler) QueryReleases(ctx context.Context, c *app.RequestContex
 // @Router /api/v1/releases/compare [post]
 func (h *ReleaseHandler) CompareReleases(ctx context.Context, c *app.RequestContext) {
 	var req request.CompareReleasesRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	compareVO, err := h.releaseAppService.CompareReleases(ctx, &req)
 	if err != nil {
@@ -0,0 +1,36 @@
+package http
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/http1/resp"
+)
+
+// streamWriter 分块传输（chunked）响应输出，用于流式返回大结果集
+// 响应头在首次写入时才发送，首次写入前仍可通过 panic 返回错误响应
+type streamWriter struct {
+	c       *app.RequestContext
+	headers map[string]string
+	started bool
+}
+
+// newStreamWriter 创建分块传输响应输出，headers 在首次写入时设置
+func newStreamWriter(c *app.RequestContext, headers map[string]string) *streamWriter {
+	c.Response.HijackWriter(resp.NewChunkedBodyWriter(&c.Response, c.GetWriter()))
+	return &streamWriter{c: c, headers: headers}
+}
+
+// Write 写入响应体
+func (w *streamWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		for key, value := range w.headers {
+			w.c.Header(key, value)
+		}
+		w.started = true
+	}
+	return w.c.Write(p)
+}
+
+// Flush 将已写入的内容发送给客户端
+func (w *streamWriter) Flush() error {
+	return w.c.Flush()
+}
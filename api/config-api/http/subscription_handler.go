@@ -5,6 +5,7 @@ import (
 
 	"config-client/api/config-api/dto/request"
 	"config-client/api/config-api/service"
+	"config-client/share/errors"
 	"config-client/share/types"
 
 	"github.com/cloudwego/hertz/pkg/app"
@@ -34,14 +35,12 @@ func NewSubscriptionHandler(subscriptionAppService *service.SubscriptionAppServi
 // @Param is_active query bool false "是否激活"
 // @Param page query int false "页码" default(1)
 // @Param size query int false "每页数量" default(20)
-// @Param order_by query string false "排序字段"
+// @Param order_by query string false "排序，格式为 字段 [asc|desc]，多个用逗号分隔，例如：subscribed_at desc。可选字段：id, namespace_id, client_id, client_ip, client_hostname, environment, is_active, last_heartbeat_at, heartbeat_count, poll_count, change_count, subscribed_at, created_at, updated_at"
 // @Success 200 {object} types.Response{data=vo.SubscriptionListVO}
 // @Router /api/v1/subscriptions [get]
 func (h *SubscriptionHandler) QuerySubscriptions(ctx context.Context, c *app.RequestContext) {
 	var req request.QuerySubscriptionRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	result, err := h.subscriptionAppService.QuerySubscriptions(ctx, &req)
 	if err != nil {
@@ -61,9 +60,7 @@ func (h *SubscriptionHandler) QuerySubscriptions(ctx context.Context, c *app.Req
 // @Router /api/v1/subscriptions/deactivate [post]
 func (h *SubscriptionHandler) DeactivateSubscription(ctx context.Context, c *app.RequestContext) {
 	var req request.DeactivateSubscriptionRequest
-	if err := c.BindAndValidate(&req); err != nil {
-		panic(err)
-	}
+	bindAndValidate(c, &req)
 
 	subscription, err := h.subscriptionAppService.DeactivateSubscription(ctx, req.ID)
 	if err != nil {
@@ -73,6 +70,160 @@ func (h *SubscriptionHandler) DeactivateSubscription(ctx context.Context, c *app
 	c.JSON(consts.StatusOK, types.SuccessWithMessage("订阅已停用", subscription))
 }
 
+// GetSubscription 获取订阅详情
+// @Summary 获取订阅详情
+// @Description 返回订阅的轮询次数、变更次数、最后心跳，以及当前实例上该客户端是否在线和正在监听的配置键（多实例部署时仅包含处理该请求的实例的内存状态）
+// @Tags 订阅管理
+// @Produce json
+// @Param id path int true "订阅ID"
+// @Success 200 {object} types.Response{data=vo.SubscriptionDetailVO}
+// @Router /api/v1/subscriptions/{id} [get]
+func (h *SubscriptionHandler) GetSubscription(ctx context.Context, c *app.RequestContext) {
+	result, err := h.subscriptionAppService.GetSubscription(ctx, pathID(c, "id"))
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(result))
+}
+
+// GetClientSubscriptions 获取客户端订阅
+// @Summary 获取客户端订阅
+// @Description 返回客户端在各命名空间和环境下的订阅，以及当前实例上正在监听的配置键
+// @Tags 订阅管理
+// @Produce json
+// @Param client_id query string true "客户端ID（精确匹配）"
+// @Success 200 {object} types.Response{data=vo.ClientSubscriptionsVO}
+// @Router /api/v1/subscriptions/client [get]
+func (h *SubscriptionHandler) GetClientSubscriptions(ctx context.Context, c *app.RequestContext) {
+	var req request.GetClientSubscriptionsRequest
+	bindAndValidate(c, &req)
+
+	result, err := h.subscriptionAppService.GetClientSubscriptions(ctx, req.ClientID)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(result))
+}
+
+// DeactivateClient 停用客户端订阅
+// @Summary 停用客户端订阅
+// @Description 停用客户端的全部激活订阅（可按命名空间和环境过滤），并断开当前实例上该客户端的长轮询；客户端重新轮询时订阅恢复激活
+// @Tags 订阅管理
+// @Accept json
+// @Produce json
+// @Param request body request.DeactivateClientRequest true "停用客户端订阅请求"
+// @Success 200 {object} types.Response{data=vo.DeactivateClientVO}
+// @Router /api/v1/subscriptions/deactivate-client [post]
+func (h *SubscriptionHandler) DeactivateClient(ctx context.Context, c *app.RequestContext) {
+	var req request.DeactivateClientRequest
+	bindAndValidate(c, &req)
+
+	result, err := h.subscriptionAppService.DeactivateClient(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("客户端订阅已停用", result))
+}
+
+// Heartbeat 订阅心跳
+// @Summary 订阅心跳
+// @Description SDK 在两次长轮询之间定期调用以保持订阅活跃，避免被判定为心跳超时；订阅需已通过长轮询创建，已停用的订阅收到心跳后恢复激活；只读令牌只能为绑定的命名空间和环境发送心跳
+// @Tags 订阅管理
+// @Accept json
+// @Produce json
+// @Param request body request.SubscriptionHeartbeatRequest true "订阅心跳请求"
+// @Success 200 {object} types.Response{data=vo.SubscriptionHeartbeatVO}
+// @Router /api/v1/subscriptions/heartbeat [post]
+func (h *SubscriptionHandler) Heartbeat(ctx context.Context, c *app.RequestContext) {
+	var req request.SubscriptionHeartbeatRequest
+	bindAndValidate(c, &req)
+	checkReadScope(ctx, req.NamespaceID, req.Environment, "")
+
+	result, err := h.subscriptionAppService.Heartbeat(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(result))
+}
+
+// Unsubscribe 取消订阅
+// @Summary 取消订阅
+// @Description SDK 关闭时调用，立即停用订阅记录并移除当前实例上的活跃订阅，不必等待心跳超时；客户端重新轮询时订阅恢复激活；只读令牌只能取消绑定的命名空间和环境下的订阅
+// @Tags 订阅管理
+// @Accept json
+// @Produce json
+// @Param request body request.UnsubscribeRequest true "取消订阅请求"
+// @Success 200 {object} types.Response{data=vo.SubscriptionVO}
+// @Router /api/v1/subscriptions/unsubscribe [post]
+func (h *SubscriptionHandler) Unsubscribe(ctx context.Context, c *app.RequestContext) {
+	var req request.UnsubscribeRequest
+	bindAndValidate(c, &req)
+	checkReadScope(ctx, req.NamespaceID, req.Environment, "")
+
+	result, err := h.subscriptionAppService.Unsubscribe(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("订阅已取消", result))
+}
+
+// GetListenerStatus 查询配置键监听状态
+// @Summary 查询配置键监听状态
+// @Description 返回监听指定配置键的全部客户端，以及各客户端最近一次长轮询上报的版本是否与服务端当前版本（命中灰度时为灰度版本）一致，用于确认变更已下发到所有客户端
+// @Tags 订阅管理
+// @Produce json
+// @Param namespace_id query int true "命名空间ID"
+// @Param key query string true "配置键"
+// @Param environment query string false "环境，默认default"
+// @Success 200 {object} types.Response{data=vo.ListenerStatusVO}
+// @Router /api/v1/subscriptions/listeners [get]
+func (h *SubscriptionHandler) GetListenerStatus(ctx context.Context, c *app.RequestContext) {
+	var req request.GetListenerStatusRequest
+	bindAndValidate(c, &req)
+	if req.NamespaceID <= 0 || req.Key == "" {
+		panic(errors.ErrBadRequest("namespace_id 和 key 不能为空"))
+	}
+
+	result, err := h.subscriptionAppService.GetListenerStatus(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(result))
+}
+
+// QueryPushTraces 查询变更通知下发记录
+// @Summary 查询变更通知下发记录
+// @Description 分页查询长轮询返回给客户端的变更通知（客户端、配置键、版本、下发时间、是否已确认），可按发布版本查询某次发布的下发情况；客户端随后的长轮询上报了通知中的版本即视为已确认
+// @Tags 订阅管理
+// @Produce json
+// @Param release_id query int false "发布版本ID"
+// @Param client_id query string false "客户端ID（精确匹配）"
+// @Param namespace_id query int false "命名空间ID"
+// @Param key query string false "配置键"
+// @Param environment query string false "环境"
+// @Param acked query bool false "客户端是否已确认"
+// @Param page query int false "页码，默认1"
+// @Param size query int false "每页数量，默认20，最大200"
+// @Success 200 {object} types.Response{data=vo.PushTraceListVO}
+// @Router /api/v1/subscriptions/push-traces [get]
+func (h *SubscriptionHandler) QueryPushTraces(ctx context.Context, c *app.RequestContext) {
+	var req request.QueryPushTraceRequest
+	bindAndValidate(c, &req)
+
+	result, err := h.subscriptionAppService.QueryPushTraces(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(result))
+}
+
 // GetStatistics 获取订阅统计
 // @Summary 获取订阅统计
 // @Tags 订阅管理
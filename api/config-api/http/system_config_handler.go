@@ -0,0 +1,127 @@
+package http
+
+import (
+	"context"
+
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/service"
+	"config-client/share/types"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// SystemConfigHandler 系统配置HTTP处理器
+// 系统配置是配置中心自身的运行时参数（长轮询超时、心跳超时等），仅默认租户的管理员密钥可以访问
+type SystemConfigHandler struct {
+	systemConfigAppService *service.SystemConfigAppService
+}
+
+// NewSystemConfigHandler 创建系统配置HTTP处理器
+func NewSystemConfigHandler(systemConfigAppService *service.SystemConfigAppService) *SystemConfigHandler {
+	return &SystemConfigHandler{
+		systemConfigAppService: systemConfigAppService,
+	}
+}
+
+// ListSystemConfigs 查询全部系统配置
+// @Summary 查询全部系统配置
+// @Description 包括禁用的配置（从数据库查询）
+// @Tags 系统配置
+// @Produce json
+// @Success 200 {object} types.Response{data=[]vo.SystemConfigVO}
+// @Router /api/v1/system-configs [get]
+func (h *SystemConfigHandler) ListSystemConfigs(ctx context.Context, c *app.RequestContext) {
+	configVOs, err := h.systemConfigAppService.ListSystemConfigs(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(configVOs))
+}
+
+// GetSystemConfig 根据配置键查询系统配置
+// @Summary 根据配置键查询系统配置
+// @Tags 系统配置
+// @Produce json
+// @Param key path string true "配置键（如 long.polling.timeout）"
+// @Success 200 {object} types.Response{data=vo.SystemConfigVO}
+// @Router /api/v1/system-configs/{key} [get]
+func (h *SystemConfigHandler) GetSystemConfig(ctx context.Context, c *app.RequestContext) {
+	configVO, err := h.systemConfigAppService.GetSystemConfig(ctx, c.Param("key"))
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(configVO))
+}
+
+// SetSystemConfig 设置系统配置
+// @Summary 设置系统配置
+// @Description 配置不存在时创建（默认启用），已存在时更新值和描述并保留启用状态。预定义的整数类型配置（如 long.polling.timeout）要求值为整数
+// @Tags 系统配置
+// @Accept json
+// @Produce json
+// @Param key path string true "配置键（小写字母、数字，以 . _ - 分段）"
+// @Param request body request.SetSystemConfigRequest true "设置系统配置请求"
+// @Success 200 {object} types.Response{data=vo.SystemConfigVO}
+// @Router /api/v1/system-configs/{key} [put]
+func (h *SystemConfigHandler) SetSystemConfig(ctx context.Context, c *app.RequestContext) {
+	var req request.SetSystemConfigRequest
+	bindAndValidate(c, &req)
+
+	configVO, err := h.systemConfigAppService.SetSystemConfig(ctx, c.Param("key"), &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("系统配置设置成功", configVO))
+}
+
+// ActivateSystemConfig 启用系统配置
+// @Summary 启用系统配置
+// @Tags 系统配置
+// @Produce json
+// @Param key path string true "配置键"
+// @Success 200 {object} types.Response{data=vo.SystemConfigVO}
+// @Router /api/v1/system-configs/{key}/activate [post]
+func (h *SystemConfigHandler) ActivateSystemConfig(ctx context.Context, c *app.RequestContext) {
+	configVO, err := h.systemConfigAppService.SetSystemConfigActive(ctx, c.Param("key"), true)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("系统配置已启用", configVO))
+}
+
+// DeactivateSystemConfig 禁用系统配置
+// @Summary 禁用系统配置
+// @Description 禁用后读取方使用内置默认值
+// @Tags 系统配置
+// @Produce json
+// @Param key path string true "配置键"
+// @Success 200 {object} types.Response{data=vo.SystemConfigVO}
+// @Router /api/v1/system-configs/{key}/deactivate [post]
+func (h *SystemConfigHandler) DeactivateSystemConfig(ctx context.Context, c *app.RequestContext) {
+	configVO, err := h.systemConfigAppService.SetSystemConfigActive(ctx, c.Param("key"), false)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("系统配置已禁用", configVO))
+}
+
+// RefreshSystemConfigCache 刷新系统配置缓存
+// @Summary 刷新系统配置缓存
+// @Description 从数据库重新加载系统配置并推送变更。各实例按 system.config.refresh.interval 定期加载，直接修改数据库后可调用该接口使处理请求的实例立即生效
+// @Tags 系统配置
+// @Produce json
+// @Success 200 {object} types.Response
+// @Router /api/v1/system-configs/refresh [post]
+func (h *SystemConfigHandler) RefreshSystemConfigCache(ctx context.Context, c *app.RequestContext) {
+	if err := h.systemConfigAppService.RefreshCache(ctx); err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("系统配置缓存已刷新", nil))
+}
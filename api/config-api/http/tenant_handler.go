@@ -0,0 +1,98 @@
+package http
+
+import (
+	"context"
+
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/service"
+	"config-client/share/types"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// TenantHandler 租户HTTP处理器
+// 租户管理接口仅默认租户可以访问
+type TenantHandler struct {
+	tenantAppService *service.TenantAppService
+}
+
+// NewTenantHandler 创建租户HTTP处理器
+func NewTenantHandler(tenantAppService *service.TenantAppService) *TenantHandler {
+	return &TenantHandler{
+		tenantAppService: tenantAppService,
+	}
+}
+
+// CreateTenant 创建租户
+// @Summary 创建租户
+// @Description 仅默认租户可以调用。创建后可通过 X-Tenant-ID 请求头代新租户签发 API Key（POST /api/v1/api-keys）
+// @Tags 租户管理
+// @Accept json
+// @Produce json
+// @Param request body request.CreateTenantRequest true "创建租户请求"
+// @Success 200 {object} types.Response{data=vo.TenantVO}
+// @Router /api/v1/tenants [post]
+func (h *TenantHandler) CreateTenant(ctx context.Context, c *app.RequestContext) {
+	var req request.CreateTenantRequest
+	bindAndValidate(c, &req)
+
+	tenantVO, err := h.tenantAppService.CreateTenant(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("租户创建成功", tenantVO))
+}
+
+// UpdateTenant 更新租户
+// @Summary 更新租户
+// @Description 修改名称、描述和启用状态；停用后该租户的 API Key 无法访问接口，默认租户不能停用
+// @Tags 租户管理
+// @Accept json
+// @Produce json
+// @Param request body request.UpdateTenantRequest true "更新租户请求"
+// @Success 200 {object} types.Response{data=vo.TenantVO}
+// @Router /api/v1/tenants [put]
+func (h *TenantHandler) UpdateTenant(ctx context.Context, c *app.RequestContext) {
+	var req request.UpdateTenantRequest
+	bindAndValidate(c, &req)
+
+	tenantVO, err := h.tenantAppService.UpdateTenant(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("租户更新成功", tenantVO))
+}
+
+// ListTenants 查询全部租户
+// @Summary 查询全部租户
+// @Tags 租户管理
+// @Produce json
+// @Success 200 {object} types.Response{data=[]vo.TenantVO}
+// @Router /api/v1/tenants [get]
+func (h *TenantHandler) ListTenants(ctx context.Context, c *app.RequestContext) {
+	tenantVOs, err := h.tenantAppService.ListTenants(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(tenantVOs))
+}
+
+// GetTenant 根据ID查询租户
+// @Summary 根据ID查询租户
+// @Tags 租户管理
+// @Produce json
+// @Param id path int true "租户ID"
+// @Success 200 {object} types.Response{data=vo.TenantVO}
+// @Router /api/v1/tenants/{id} [get]
+func (h *TenantHandler) GetTenant(ctx context.Context, c *app.RequestContext) {
+	tenantVO, err := h.tenantAppService.GetTenant(ctx, pathID(c, "id"))
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(tenantVO))
+}
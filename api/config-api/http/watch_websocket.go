@@ -0,0 +1,213 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/dto/vo"
+	"config-client/share/errors"
+	"config-client/share/validation"
+	"config-client/share/websocket"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/network"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// WebSocket 监听的消息类型
+const (
+	watchMessageWatch  = "watch"  // 客户端发送的监听请求
+	watchMessageResult = "result" // 服务端返回的监听结果
+	watchMessageError  = "error"  // 服务端返回的错误
+)
+
+// watchPingInterval 服务端发送 ping 的间隔，超过 3 倍间隔未收到客户端的任何帧时断开连接
+const watchPingInterval = 30 * time.Second
+
+// watchValidator WebSocket 监听请求的参数校验器（消息不经过 Hertz 绑定，按 binding 标签单独校验）
+var watchValidator = validation.NewValidator()
+
+// watchResult 一次等待的结果，seq 为对应监听请求的序号
+type watchResult struct {
+	seq  int
+	resp *vo.LongPollingResponse
+	err  error
+}
+
+// WatchWebSocket 通过 WebSocket 监听配置变更
+// @Summary 通过 WebSocket 监听配置变更
+// @Description 握手成功后在同一连接上持续监听，避免长轮询每次请求的连接和认证开销；认证和限流在握手时进行
+// @Description 客户端发送 {"type":"watch","data":<长轮询请求>}，服务端等待变更后返回 {"type":"result","data":<长轮询响应>}，客户端处理结果后发送下一个监听请求
+// @Description 等待期间收到新的监听请求（如增减了监听的配置）时取消当前等待，按新请求重新等待
+// @Description 请求校验失败、无权限或并发监听数超限时返回 {"type":"error","code":...,"message":...,"retry_after":...}，连接保持，客户端修正或等待后重新发送请求
+// @Description 服务端每 30 秒发送 ping，超过 90 秒未收到客户端的任何帧时断开连接
+// @Tags 配置管理
+// @Produce json
+// @Success 101 {object} vo.WatchMessage "切换到 WebSocket 协议"
+// @Router /api/v1/configs/watch/ws [get]
+func (h *LongPollingHandler) WatchWebSocket(ctx context.Context, c *app.RequestContext) {
+	language := errors.NegotiateLanguage(string(c.Request.Header.Peek("Accept-Language")))
+	upgradeWebSocket(c, func(conn *websocket.Conn) {
+		// 会话在握手响应发送后进行，不受请求上下文取消的影响（保留认证信息和请求ID）
+		h.serveWatch(context.WithoutCancel(ctx), conn, language)
+	})
+}
+
+// upgradeWebSocket 校验 WebSocket 握手请求并返回 101，握手响应发送后由 handler 处理连接（handler 返回后关闭连接）
+func upgradeWebSocket(c *app.RequestContext, handler func(conn *websocket.Conn)) {
+	if !strings.EqualFold(string(c.Request.Header.Peek("Upgrade")), "websocket") ||
+		!websocket.HeaderContainsToken(string(c.Request.Header.Peek("Connection")), "upgrade") {
+		panic(errors.ErrBadRequest("请求不是 WebSocket 握手请求"))
+	}
+	if string(c.Request.Header.Peek("Sec-WebSocket-Version")) != "13" {
+		c.Response.Header.Set("Sec-WebSocket-Version", "13")
+		panic(errors.ErrBadRequest("不支持的 WebSocket 版本，仅支持 13"))
+	}
+	key := string(c.Request.Header.Peek("Sec-WebSocket-Key"))
+	if key == "" {
+		panic(errors.ErrBadRequest("缺少 Sec-WebSocket-Key 请求头"))
+	}
+
+	c.SetStatusCode(consts.StatusSwitchingProtocols)
+	c.Response.Header.Set("Upgrade", "websocket")
+	c.Response.Header.Set("Connection", "Upgrade")
+	c.Response.Header.Set("Sec-WebSocket-Accept", websocket.AcceptKey(key))
+	c.Hijack(func(conn network.Conn) {
+		handler(websocket.NewConn(conn, nil, false))
+	})
+}
+
+// serveWatch 处理 WebSocket 监听会话，连接断开或读取超时时结束
+func (h *LongPollingHandler) serveWatch(ctx context.Context, conn *websocket.Conn, language string) {
+	defer conn.Close()
+	conn.SetReadTimeout(3 * watchPingInterval)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// 1. 读取客户端的监听请求（连接断开时结束会话）
+	requests := make(chan *request.LongPollingRequest)
+	go func() {
+		defer cancel()
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var msg request.WatchMessage
+			if err := json.Unmarshal(data, &msg); err != nil || msg.Type != watchMessageWatch || msg.Data == nil {
+				writeWatchMessage(conn, watchErrorMessage(errors.ErrBadRequest("无效的监听消息"), language))
+				continue
+			}
+			select {
+			case requests <- msg.Data:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// 2. 定期发送 ping 保活（客户端回复 pong 刷新读取超时）
+	go func() {
+		ticker := time.NewTicker(watchPingInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := conn.WritePing(); err != nil {
+					cancel()
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// 3. 按最新的监听请求等待变更，新请求到达时取消当前等待，被取代的等待结果直接丢弃
+	results := make(chan watchResult, 1)
+	seq := 0
+	stopWait := func() {}
+	for {
+		select {
+		case <-ctx.Done():
+			stopWait()
+			return
+		case req := <-requests:
+			stopWait()
+			seq++
+			var waitCtx context.Context
+			waitCtx, stopWait = context.WithCancel(ctx)
+			go func(seq int) {
+				resp, err := h.waitForWatch(waitCtx, req)
+				select {
+				case results <- watchResult{seq: seq, resp: resp, err: err}:
+				case <-ctx.Done():
+				}
+			}(seq)
+		case result := <-results:
+			if result.seq != seq {
+				continue
+			}
+			msg := &vo.WatchMessage{Type: watchMessageResult, Data: result.resp}
+			if result.err != nil {
+				if !errors.IsAppError(result.err) {
+					hlog.CtxErrorf(ctx, "WebSocket 监听等待变更失败: %v", result.err)
+				}
+				msg = watchErrorMessage(result.err, language)
+			}
+			if err := writeWatchMessage(conn, msg); err != nil {
+				stopWait()
+				return
+			}
+		}
+	}
+}
+
+// waitForWatch 校验监听请求（与长轮询接口一致）并等待变更，校验失败时返回错误
+func (h *LongPollingHandler) waitForWatch(ctx context.Context, req *request.LongPollingRequest) (resp *vo.LongPollingResponse, err error) {
+	// 校验失败以 panic 抛出 AppError（与 HTTP 处理器一致），会话中转换为错误消息
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok && errors.IsAppError(e) {
+				err = e
+				return
+			}
+			err = fmt.Errorf("处理监听请求失败: %v", r)
+		}
+	}()
+
+	if err := watchValidator.ValidateStruct(req); err != nil {
+		return nil, err
+	}
+	checkWatchRequest(ctx, req)
+	return h.longPollingAppService.WaitForChanges(ctx, req)
+}
+
+// watchErrorMessage 构建错误消息（错误信息按握手请求的 Accept-Language 选择语言，非 AppError 返回内部服务错误）
+func watchErrorMessage(err error, language string) *vo.WatchMessage {
+	appErr, ok := errors.AsAppError(err)
+	if !ok {
+		appErr = errors.New(errors.InternalError, "内部服务错误")
+	}
+	return &vo.WatchMessage{
+		Type:       watchMessageError,
+		Code:       appErr.Code,
+		Message:    appErr.LocalizedMessage(language),
+		RetryAfter: appErr.RetryAfter,
+	}
+}
+
+// writeWatchMessage 以文本消息发送 JSON
+func writeWatchMessage(conn *websocket.Conn, msg *vo.WatchMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return conn.WriteMessage(websocket.OpText, data)
+}
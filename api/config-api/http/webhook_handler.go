@@ -0,0 +1,180 @@
+package http
+
+import (
+	"context"
+
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/service"
+	"config-client/share/types"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// WebhookHandler Webhook HTTP处理器
+type WebhookHandler struct {
+	webhookAppService *service.WebhookAppService
+}
+
+// NewWebhookHandler 创建 Webhook HTTP处理器
+func NewWebhookHandler(webhookAppService *service.WebhookAppService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookAppService: webhookAppService,
+	}
+}
+
+// CreateWebhook 创建 Webhook
+// @Summary 创建 Webhook
+// @Description 为命名空间注册 Webhook。命名空间内的配置变更（config.create/update/delete/restore）和发布事件（release.publish/canary/rollback）
+// @Description 按 events 过滤后以 POST 请求投递到 url，请求头携带 X-Webhook-Event、X-Webhook-Delivery、X-Webhook-Timestamp 和
+// @Description X-Webhook-Signature（sha256=hex(HMAC-SHA256(secret, timestamp + "." + body))）；非 2xx 响应按指数退避重试
+// @Tags Webhook管理
+// @Accept json
+// @Produce json
+// @Param request body request.CreateWebhookRequest true "创建 Webhook 请求"
+// @Success 200 {object} types.Response{data=vo.WebhookVO}
+// @Router /api/v1/webhooks [post]
+func (h *WebhookHandler) CreateWebhook(ctx context.Context, c *app.RequestContext) {
+	var req request.CreateWebhookRequest
+	bindAndValidate(c, &req)
+
+	webhookVO, err := h.webhookAppService.CreateWebhook(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("Webhook 创建成功", webhookVO))
+}
+
+// UpdateWebhook 更新 Webhook
+// @Summary 更新 Webhook
+// @Description secret 为空时保留原签名密钥
+// @Tags Webhook管理
+// @Accept json
+// @Produce json
+// @Param request body request.UpdateWebhookRequest true "更新 Webhook 请求"
+// @Success 200 {object} types.Response{data=vo.WebhookVO}
+// @Router /api/v1/webhooks [put]
+func (h *WebhookHandler) UpdateWebhook(ctx context.Context, c *app.RequestContext) {
+	var req request.UpdateWebhookRequest
+	bindAndValidate(c, &req)
+
+	webhookVO, err := h.webhookAppService.UpdateWebhook(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("Webhook 更新成功", webhookVO))
+}
+
+// DeleteWebhook 删除 Webhook
+// @Summary 删除 Webhook
+// @Description 投递记录保留为日志，尚未完成的投递将标记为失败
+// @Tags Webhook管理
+// @Accept json
+// @Produce json
+// @Param request body request.DeleteWebhookRequest true "删除 Webhook 请求"
+// @Success 200 {object} types.Response
+// @Router /api/v1/webhooks [delete]
+func (h *WebhookHandler) DeleteWebhook(ctx context.Context, c *app.RequestContext) {
+	var req request.DeleteWebhookRequest
+	bindAndValidate(c, &req)
+
+	if err := h.webhookAppService.DeleteWebhook(ctx, req.ID); err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("Webhook 删除成功", nil))
+}
+
+// ListWebhooks 查询命名空间下的 Webhook
+// @Summary 查询命名空间下的 Webhook
+// @Tags Webhook管理
+// @Produce json
+// @Param namespace_id query int true "命名空间ID"
+// @Success 200 {object} types.Response{data=[]vo.WebhookVO}
+// @Router /api/v1/webhooks [get]
+func (h *WebhookHandler) ListWebhooks(ctx context.Context, c *app.RequestContext) {
+	var req request.ListWebhooksRequest
+	bindAndValidate(c, &req)
+
+	webhookVOs, err := h.webhookAppService.ListWebhooks(ctx, &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(webhookVOs))
+}
+
+// GetWebhook 根据ID查询 Webhook
+// @Summary 根据ID查询 Webhook
+// @Tags Webhook管理
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} types.Response{data=vo.WebhookVO}
+// @Router /api/v1/webhooks/{id} [get]
+func (h *WebhookHandler) GetWebhook(ctx context.Context, c *app.RequestContext) {
+	webhookVO, err := h.webhookAppService.GetWebhook(ctx, pathID(c, "id"))
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(webhookVO))
+}
+
+// QueryDeliveries 查询 Webhook 投递记录
+// @Summary 查询 Webhook 投递记录
+// @Description 分页查询投递日志（按时间倒序），包含每条记录的尝试次数、最近一次响应状态码和内容、失败原因
+// @Tags Webhook管理
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param status query string false "状态: pending, processing, succeeded, failed"
+// @Param event_type query string false "事件类型"
+// @Param page query int false "页码，默认1"
+// @Param size query int false "每页数量，默认20，最大200"
+// @Success 200 {object} types.Response{data=vo.WebhookDeliveryListVO}
+// @Router /api/v1/webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) QueryDeliveries(ctx context.Context, c *app.RequestContext) {
+	var req request.QueryWebhookDeliveriesRequest
+	bindAndValidate(c, &req)
+
+	result, err := h.webhookAppService.QueryDeliveries(ctx, pathID(c, "id"), &req)
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(result))
+}
+
+// GetDelivery 查询投递记录详情
+// @Summary 查询 Webhook 投递记录详情
+// @Tags Webhook管理
+// @Produce json
+// @Param id path int true "投递记录ID"
+// @Success 200 {object} types.Response{data=vo.WebhookDeliveryVO}
+// @Router /api/v1/webhooks/deliveries/{id} [get]
+func (h *WebhookHandler) GetDelivery(ctx context.Context, c *app.RequestContext) {
+	deliveryVO, err := h.webhookAppService.GetDelivery(ctx, int64(pathID(c, "id")))
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.Success(deliveryVO))
+}
+
+// Redeliver 重新投递
+// @Summary 重新投递 Webhook 事件
+// @Description 以原投递记录的请求体创建新的投递记录并立即投递，原记录必须已结束投递（succeeded 或 failed）
+// @Tags Webhook管理
+// @Produce json
+// @Param id path int true "投递记录ID"
+// @Success 200 {object} types.Response{data=vo.WebhookDeliveryVO}
+// @Router /api/v1/webhooks/deliveries/{id}/redeliver [post]
+func (h *WebhookHandler) Redeliver(ctx context.Context, c *app.RequestContext) {
+	deliveryVO, err := h.webhookAppService.Redeliver(ctx, int64(pathID(c, "id")))
+	if err != nil {
+		panic(err)
+	}
+
+	c.JSON(consts.StatusOK, types.SuccessWithMessage("已重新投递", deliveryVO))
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+
+	"config-client/api/config-api/converter"
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/dto/vo"
+	"config-client/config/domain/entity"
+	domainService "config-client/config/domain/service"
+)
+
+// APIKeyAppService API Key 应用服务
+// 负责协调 API Key 领域服务和数据转换
+type APIKeyAppService struct {
+	apiKeyDomainService *domainService.APIKeyService
+	converter           *converter.APIKeyConverter
+}
+
+// NewAPIKeyAppService 创建 API Key 应用服务
+func NewAPIKeyAppService(
+	apiKeyDomainService *domainService.APIKeyService,
+	converter *converter.APIKeyConverter,
+) *APIKeyAppService {
+	return &APIKeyAppService{
+		apiKeyDomainService: apiKeyDomainService,
+		converter:           converter,
+	}
+}
+
+// CreateAPIKey 创建 API Key（返回的密钥明文仅此一次）
+func (s *APIKeyAppService) CreateAPIKey(ctx context.Context, req *request.CreateAPIKeyRequest) (*vo.APIKeyCreatedVO, error) {
+	key := &entity.APIKey{
+		Name:        req.Name,
+		Role:        req.Role,
+		NamespaceID: req.NamespaceID,
+		Environment: req.Environment,
+		KeyPrefixes: req.KeyPrefixes,
+		Description: req.Description,
+		ExpiresAt:   req.ExpiresAt,
+		CreatedBy:   req.CreatedBy,
+	}
+
+	plaintext, err := s.apiKeyDomainService.CreateAPIKey(ctx, key, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &vo.APIKeyCreatedVO{APIKeyVO: *s.converter.ToVO(key), Key: plaintext}, nil
+}
+
+// RevokeAPIKey 吊销 API Key
+func (s *APIKeyAppService) RevokeAPIKey(ctx context.Context, id int, req *request.RevokeAPIKeyRequest) (*vo.APIKeyVO, error) {
+	key, err := s.apiKeyDomainService.RevokeAPIKey(ctx, id, req.RevokedBy)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToVO(key), nil
+}
+
+// GetAPIKey 根据ID查询 API Key
+func (s *APIKeyAppService) GetAPIKey(ctx context.Context, id int) (*vo.APIKeyVO, error) {
+	key, err := s.apiKeyDomainService.GetAPIKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToVO(key), nil
+}
+
+// ListAPIKeys 查询 API Key 列表
+func (s *APIKeyAppService) ListAPIKeys(ctx context.Context, req *request.ListAPIKeysRequest) ([]*vo.APIKeyVO, error) {
+	keys, err := s.apiKeyDomainService.ListAPIKeys(ctx, req.IncludeRevoked)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToVOList(keys), nil
+}
@@ -2,16 +2,39 @@ package service
 
 import (
 	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
 
 	"config-client/api/config-api/converter"
 	"config-client/api/config-api/dto/request"
 	"config-client/api/config-api/dto/vo"
 	"config-client/config/domain/entity"
+	domainErrors "config-client/config/domain/errors"
 	"config-client/config/domain/repository"
 	domainService "config-client/config/domain/service"
 	"config-client/share/constants"
+	"config-client/share/errors"
 )
 
+// historyExportBatchSize 导出变更历史时每批读取的记录数（每批写出后刷新到客户端）
+const historyExportBatchSize = 1000
+
+// historyExportCSVHeader 变更历史 CSV 导出列
+var historyExportCSVHeader = []string{
+	"id", "config_id", "namespace_id", "config_key", "environment", "operation",
+	"old_value", "new_value", "old_version", "new_version",
+	"operator", "operator_ip", "change_reason", "created_at",
+}
+
+// HistoryExportWriter 变更历史导出输出（由 HTTP 层提供，Flush 将已写入的内容发送给客户端）
+type HistoryExportWriter interface {
+	io.Writer
+	Flush() error
+}
+
 // ChangeHistoryAppService 变更历史应用服务
 type ChangeHistoryAppService struct {
 	changeHistoryService *domainService.ChangeHistoryService
@@ -31,6 +54,10 @@ func NewChangeHistoryAppService(
 // QueryHistory 分页查询变更历史
 func (s *ChangeHistoryAppService) QueryHistory(ctx context.Context, req *request.QueryHistoryRequest) (*vo.ChangeHistoryListVO, error) {
 	req.SetDefaults()
+	cursor, err := req.PageCursor()
+	if err != nil {
+		return nil, domainErrors.ErrPageCursorInvalid()
+	}
 
 	params := &repository.ChangeHistoryQueryParams{
 		ConfigID:    req.ConfigID,
@@ -42,6 +69,8 @@ func (s *ChangeHistoryAppService) QueryHistory(ctx context.Context, req *request
 		Operator:    req.Operator,
 		Page:        req.Page,
 		Size:        req.Size,
+		OrderBy:     req.OrderBy,
+		Cursor:      cursor,
 	}
 
 	pageResult, err := s.changeHistoryService.QueryHistory(ctx, params)
@@ -101,6 +130,7 @@ func (s *ChangeHistoryAppService) CompareVersions(ctx context.Context, req *requ
 		ToOperation:   result.ToOperation,
 		FromChangedAt: result.FromChangedAt,
 		ToChangedAt:   result.ToChangedAt,
+		Diff:          s.converter.ToValueDiffVO(result.Diff),
 	}, nil
 }
 
@@ -137,6 +167,122 @@ func (s *ChangeHistoryAppService) GetStatistics(ctx context.Context) (*vo.Change
 	}, nil
 }
 
+// ExportHistory 按查询条件导出变更历史（CSV 或 JSON 数组，按ID升序）
+// 分批读取并逐批写出，内存占用与历史记录总数无关
+func (s *ChangeHistoryAppService) ExportHistory(ctx context.Context, req *request.ExportHistoryRequest, w HistoryExportWriter) error {
+	req.SetDefaults()
+
+	// 1. 校验参数（写出任何内容前完成，校验失败时仍可返回错误响应）
+	if req.Format != "csv" && req.Format != "json" {
+		return errors.ErrBadRequest("不支持的导出格式: " + req.Format + "（可选值: csv/json）")
+	}
+	for _, t := range []*string{req.StartTime, req.EndTime} {
+		if t == nil {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02 15:04:05", *t); err != nil {
+			return errors.ErrBadRequest("时间格式错误，应为 2006-01-02 15:04:05: " + *t)
+		}
+	}
+
+	params := &repository.ChangeHistoryQueryParams{
+		ConfigID:    req.ConfigID,
+		NamespaceID: req.NamespaceID,
+		ConfigKey:   req.ConfigKey,
+		Operation:   req.Operation,
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		Operator:    req.Operator,
+	}
+
+	// 2. 分批写出
+	if req.Format == "json" {
+		return s.exportHistoryJSON(ctx, params, w)
+	}
+	return s.exportHistoryCSV(ctx, params, w)
+}
+
+// exportHistoryCSV 以 CSV 格式导出变更历史
+func (s *ChangeHistoryAppService) exportHistoryCSV(ctx context.Context, params *repository.ChangeHistoryQueryParams, w HistoryExportWriter) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(historyExportCSVHeader); err != nil {
+		return err
+	}
+
+	err := s.changeHistoryService.ExportHistory(ctx, params, historyExportBatchSize, func(histories []*entity.ChangeHistory) error {
+		for _, h := range histories {
+			record := []string{
+				strconv.Itoa(h.ID),
+				strconv.Itoa(h.ConfigID),
+				strconv.Itoa(h.NamespaceID),
+				h.ConfigKey,
+				h.Environment,
+				string(h.Operation),
+				h.OldValue,
+				h.NewValue,
+				strconv.Itoa(h.OldVersion),
+				strconv.Itoa(h.NewVersion),
+				h.Operator,
+				h.OperatorIP,
+				h.ChangeReason,
+				h.CreatedAt.Format(time.RFC3339),
+			}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return err
+		}
+		return w.Flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// exportHistoryJSON 以 JSON 数组格式导出变更历史
+func (s *ChangeHistoryAppService) exportHistoryJSON(ctx context.Context, params *repository.ChangeHistoryQueryParams, w HistoryExportWriter) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+
+	first := true
+	err := s.changeHistoryService.ExportHistory(ctx, params, historyExportBatchSize, func(histories []*entity.ChangeHistory) error {
+		for _, item := range s.converter.ToVOList(histories) {
+			data, err := json.Marshal(item)
+			if err != nil {
+				return err
+			}
+			if !first {
+				if _, err := io.WriteString(w, ","); err != nil {
+					return err
+				}
+			}
+			first = false
+			if _, err := w.Write(data); err != nil {
+				return err
+			}
+		}
+		return w.Flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, "]"); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
 // ==================== 辅助方法 ====================
 
 // getOperator 从 context 中获取操作人
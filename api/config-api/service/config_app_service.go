@@ -2,13 +2,20 @@ package service
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"config-client/api/config-api/converter"
 	"config-client/api/config-api/dto/request"
 	"config-client/api/config-api/dto/vo"
+	"config-client/config/domain/constants"
 	"config-client/config/domain/entity"
+	domainErrors "config-client/config/domain/errors"
 	"config-client/config/domain/repository"
 	domainService "config-client/config/domain/service"
+	shareConstants "config-client/share/constants"
+	"config-client/share/errors"
+	"config-client/share/tracing"
 )
 
 // ConfigAppService 配置应用服务
@@ -16,22 +23,37 @@ import (
 // 异常由领域服务捕获并向上传递，最终由统一异常处理器处理
 type ConfigAppService struct {
 	configDomainService *domainService.ConfigService
+	referenceResolver   *domainService.ConfigReferenceResolver // 配置引用解析（可选）
+	releaseSvc          *domainService.ReleaseService          // 发布服务（可选，用于灰度判断）
+	signer              *domainService.ConfigSigner            // 配置值签名（可选，对客户端读取的配置值签名）
 	converter           *converter.ConfigConverter
 }
 
 // NewConfigAppService 创建配置应用服务实例
 func NewConfigAppService(
 	configDomainService *domainService.ConfigService,
+	referenceResolver *domainService.ConfigReferenceResolver,
+	releaseSvc *domainService.ReleaseService,
 	converter *converter.ConfigConverter,
 ) *ConfigAppService {
 	return &ConfigAppService{
 		configDomainService: configDomainService,
+		referenceResolver:   referenceResolver,
+		releaseSvc:          releaseSvc,
 		converter:           converter,
 	}
 }
 
+// SetConfigSigner 设置配置值签名服务
+func (s *ConfigAppService) SetConfigSigner(signer *domainService.ConfigSigner) {
+	s.signer = signer
+}
+
 // CreateConfig 创建配置
-func (s *ConfigAppService) CreateConfig(ctx context.Context, req *request.CreateConfigRequest) (*vo.ConfigVO, error) {
+func (s *ConfigAppService) CreateConfig(ctx context.Context, req *request.CreateConfigRequest) (_ *vo.ConfigVO, err error) {
+	ctx, span := tracing.Start(ctx, "ConfigAppService.CreateConfig")
+	defer func() { tracing.End(span, err) }()
+
 	// 1. 将请求DTO转换为领域实体
 	// 处理 Metadata：如果为空字符串，使用默认值 "{}"
 	metadata := req.Metadata
@@ -48,6 +70,7 @@ func (s *ConfigAppService) CreateConfig(ctx context.Context, req *request.Create
 		Environment: req.Environment,
 		Description: req.Description,
 		Metadata:    metadata,
+		ExpiresAt:   req.ExpiresAt,
 	}
 	// 设置审计字段
 	config.CreatedBy = req.CreatedBy
@@ -63,7 +86,10 @@ func (s *ConfigAppService) CreateConfig(ctx context.Context, req *request.Create
 }
 
 // UpdateConfig 更新配置
-func (s *ConfigAppService) UpdateConfig(ctx context.Context, configID int, req *request.UpdateConfigRequest) (*vo.ConfigVO, error) {
+func (s *ConfigAppService) UpdateConfig(ctx context.Context, configID int, req *request.UpdateConfigRequest) (_ *vo.ConfigVO, err error) {
+	ctx, span := tracing.Start(ctx, "ConfigAppService.UpdateConfig")
+	defer func() { tracing.End(span, err) }()
+
 	// 1. 先查询现有配置以获取完整信息
 	existingConfig, err := s.configDomainService.GetByID(ctx, configID)
 	if err != nil {
@@ -92,9 +118,18 @@ func (s *ConfigAppService) UpdateConfig(ctx context.Context, configID int, req *
 		Metadata:    metadata,
 		IsActive:    boolValue(req.IsActive, existingConfig.IsActive),
 		IsReleased:  boolValue(req.IsReleased, existingConfig.IsReleased),
+		ExpiresAt:   existingConfig.ExpiresAt,
+	}
+	if req.ClearExpiresAt {
+		config.ExpiresAt = nil
+	} else if req.ExpiresAt != nil {
+		config.ExpiresAt = req.ExpiresAt
 	}
 	config.ID = configID
 	config.UpdatedBy = req.UpdatedBy
+	if req.ExpectedVersion != nil {
+		config.Version = *req.ExpectedVersion // 领域服务按期望版本号做乐观锁校验
+	}
 
 	// 3. 调用领域服务更新配置（错误直接向上传递）
 	if err := s.configDomainService.UpdateConfig(ctx, config); err != nil {
@@ -114,6 +149,10 @@ func (s *ConfigAppService) UpdateConfig(ctx context.Context, configID int, req *
 func (s *ConfigAppService) QueryConfigs(ctx context.Context, req *request.QueryConfigRequest) (*vo.ConfigListVO, error) {
 	// 1. 设置默认值
 	req.SetDefaults()
+	cursor, err := req.PageCursor()
+	if err != nil {
+		return nil, domainErrors.ErrPageCursorInvalid()
+	}
 
 	// 2. 将 DTO 转换为仓储层查询参数
 	params := &repository.ConfigQueryParams{
@@ -127,6 +166,11 @@ func (s *ConfigAppService) QueryConfigs(ctx context.Context, req *request.QueryC
 		Page:        req.Page,
 		Size:        req.Size,
 		OrderBy:     req.OrderBy,
+		Cursor:      cursor,
+	}
+	if req.ExpiringWithin != nil {
+		expiresBefore := time.Now().Add(time.Duration(*req.ExpiringWithin) * time.Second)
+		params.ExpiresBefore = &expiresBefore
 	}
 
 	// 3. 调用领域服务查询配置（错误直接向上传递）
@@ -136,7 +180,12 @@ func (s *ConfigAppService) QueryConfigs(ctx context.Context, req *request.QueryC
 	}
 
 	// 4. 转换为VO返回
-	return s.converter.ToListVO(pageResult.Items, pageResult.Total, pageResult.Page, pageResult.Size), nil
+	listVO := s.converter.ToListVO(pageResult.Items, pageResult.Total, pageResult.Page, pageResult.Size)
+	listVO.NextCursor = pageResult.NextCursor
+	for _, configVO := range listVO.Items {
+		s.sign(configVO)
+	}
+	return listVO, nil
 }
 
 // GetConfigByID 根据ID获取配置
@@ -147,18 +196,392 @@ func (s *ConfigAppService) GetConfigByID(ctx context.Context, configID int) (*vo
 		return nil, err
 	}
 
-	// 2. 转换为VO返回
-	return s.converter.ToVO(config), nil
+	// 2. 转换为VO，包含引用时附带解析结果
+	configVO := s.converter.ToVO(config)
+	if s.referenceResolver != nil && domainService.HasConfigReference(config.Value) {
+		resolved, err := s.referenceResolver.Resolve(ctx, config)
+		s.converter.ApplyResolved(configVO, resolved, err)
+	}
+
+	return configVO, nil
+}
+
+// GetConfigByKey 根据命名空间、配置键和环境获取生效配置
+// 仅返回已发布且已激活的配置；客户端命中灰度规则时返回灰度版本中的值
+func (s *ConfigAppService) GetConfigByKey(ctx context.Context, req *request.GetConfigByKeyRequest) (_ *vo.ConfigVO, err error) {
+	ctx, span := tracing.Start(ctx, "ConfigAppService.GetConfigByKey")
+	defer func() { tracing.End(span, err) }()
+
+	environment := req.Environment
+	if environment == "" {
+		environment = constants.EnvDefault
+	}
+
+	// 1. 查询已发布且已激活的配置（不存在、未发布、未激活均返回 404）
+	config, err := s.configDomainService.GetActiveConfig(ctx, req.NamespaceID, req.Key, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 客户端命中灰度规则时，使用灰度版本快照中的值
+	var canaryRelease *entity.Release
+	if s.releaseSvc != nil {
+		item, release, err := s.releaseSvc.GetCanaryConfigItem(ctx, req.NamespaceID, environment, req.Key, req.ClientID, req.ClientIP)
+		if err != nil {
+			return nil, err
+		}
+		if item != nil {
+			config = withCanaryItem(config, item)
+			canaryRelease = release
+		}
+	}
+
+	// 3. 转换为VO并解析引用
+	configVO := s.converter.ToVO(config)
+	if canaryRelease != nil {
+		configVO.IsCanary = true
+		configVO.ReleaseVersion = canaryRelease.Version
+	}
+	if s.referenceResolver != nil && domainService.HasConfigReference(config.Value) {
+		resolved, resolveErr := s.referenceResolver.Resolve(ctx, config)
+		s.converter.ApplyResolved(configVO, resolved, resolveErr)
+	}
+	s.sign(configVO)
+
+	return configVO, nil
+}
+
+// BulkGetConfigs 批量根据配置键获取已发布配置
+// 一次查询返回请求的全部配置，客户端命中灰度规则时使用灰度版本中的值，不可读取的配置键放入 missing
+func (s *ConfigAppService) BulkGetConfigs(ctx context.Context, req *request.BulkGetConfigRequest) (_ *vo.ConfigBulkGetVO, err error) {
+	ctx, span := tracing.Start(ctx, "ConfigAppService.BulkGetConfigs")
+	defer func() { tracing.End(span, err) }()
+
+	environment := req.Environment
+	if environment == "" {
+		environment = constants.EnvDefault
+	}
+
+	// 1. 批量查询已发布且已激活的配置
+	configs, missing, err := s.configDomainService.GetActiveConfigs(ctx, req.NamespaceID, req.Keys, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 客户端命中灰度规则时，使用灰度版本快照中的值（灰度规则只判断一次）
+	var canaryItems map[string]*entity.ConfigSnapshotItem
+	var canaryRelease *entity.Release
+	if s.releaseSvc != nil && len(configs) > 0 {
+		keys := make([]string, 0, len(configs))
+		for _, config := range configs {
+			keys = append(keys, config.Key)
+		}
+		canaryItems, canaryRelease, err = s.releaseSvc.GetCanaryConfigItems(ctx, req.NamespaceID, environment, keys, req.ClientID, req.ClientIP)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 3. 转换为VO并解析引用
+	items := make([]*vo.ConfigVO, 0, len(configs))
+	for _, config := range configs {
+		item := canaryItems[config.Key]
+		if item != nil {
+			config = withCanaryItem(config, item)
+		}
+		configVO := s.converter.ToVO(config)
+		if item != nil {
+			configVO.IsCanary = true
+			configVO.ReleaseVersion = canaryRelease.Version
+		}
+		if s.referenceResolver != nil && domainService.HasConfigReference(config.Value) {
+			resolved, resolveErr := s.referenceResolver.Resolve(ctx, config)
+			s.converter.ApplyResolved(configVO, resolved, resolveErr)
+		}
+		s.sign(configVO)
+		items = append(items, configVO)
+	}
+
+	return &vo.ConfigBulkGetVO{Items: items, Missing: missing}, nil
+}
+
+// withCanaryItem 返回使用灰度版本快照值的配置副本
+func withCanaryItem(config *entity.Config, item *entity.ConfigSnapshotItem) *entity.Config {
+	canaryConfig := *config
+	canaryConfig.Value = item.Value
+	canaryConfig.ValueType = item.ValueType
+	canaryConfig.Version = item.Version
+	canaryConfig.ContentHash = item.ContentHash
+	canaryConfig.ContentHashAlgorithm = item.ContentHashAlgorithm
+	return &canaryConfig
+}
+
+// GetEffectiveConfigs 获取生效配置（已解析引用）
+// 单个配置的引用解析失败不影响其他配置，失败原因在对应条目中返回
+func (s *ConfigAppService) GetEffectiveConfigs(ctx context.Context, req *request.GetEffectiveConfigRequest) (*vo.EffectiveConfigVO, error) {
+	// 1. 调用领域服务获取生效配置（错误直接向上传递）
+	configs, err := s.configDomainService.GetEffectiveConfigs(ctx, req.NamespaceID, req.Environment, req.GroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	environment := req.Environment
+	if environment == "" {
+		environment = constants.EnvDefault
+	}
+
+	// 2. 逐个解析引用并转换为VO
+	items := make([]*vo.EffectiveConfigItemVO, 0, len(configs))
+	for _, config := range configs {
+		var resolved *domainService.ResolvedConfig
+		var resolveErr error
+		if s.referenceResolver != nil && domainService.HasConfigReference(config.Value) {
+			// 引用按请求的环境解析，而不是配置的来源环境
+			target := *config
+			target.Environment = environment
+			resolved, resolveErr = s.referenceResolver.Resolve(ctx, &target)
+		}
+		items = append(items, s.converter.ToEffectiveItemVO(config, resolved, resolveErr))
+	}
+
+	return &vo.EffectiveConfigVO{
+		NamespaceID: req.NamespaceID,
+		Environment: environment,
+		Items:       items,
+	}, nil
+}
+
+// ValidateConfig 校验配置（仅校验，不保存）
+// 校验不通过属于正常结果，以 valid=false 返回；其他错误（如数据库异常）直接向上传递
+func (s *ConfigAppService) ValidateConfig(ctx context.Context, req *request.ValidateConfigRequest) (*vo.ConfigValidationVO, error) {
+	// 1. 构造待校验的配置实体（与创建配置保持一致）
+	metadata := req.Metadata
+	if metadata == "" {
+		metadata = "{}"
+	}
+	config := &entity.Config{
+		NamespaceID: req.NamespaceID,
+		Key:         req.Key,
+		Value:       req.Value,
+		GroupName:   req.GroupName,
+		ValueType:   req.ValueType,
+		Environment: req.Environment,
+		Metadata:    metadata,
+	}
+
+	result := &vo.ConfigValidationVO{Valid: true}
+	if rules, err := domainService.ParseValidatorRules(metadata); err == nil {
+		for _, rule := range rules {
+			result.Validators = append(result.Validators, rule.Type())
+		}
+	}
+
+	// 2. 调用领域服务执行完整校验
+	if err := s.configDomainService.ValidateConfig(ctx, config); err != nil {
+		appErr, ok := errors.AsAppError(err)
+		if !ok {
+			return nil, err
+		}
+		result.Valid = false
+		result.Code = appErr.Code
+		result.Message = appErr.Message
+	}
+
+	return result, nil
+}
+
+// BatchMutateConfigs 批量变更配置（单个事务内执行）
+func (s *ConfigAppService) BatchMutateConfigs(ctx context.Context, req *request.BatchMutateConfigRequest) (_ *vo.BatchMutationVO, err error) {
+	ctx, span := tracing.Start(ctx, "ConfigAppService.BatchMutateConfigs")
+	defer func() { tracing.End(span, err) }()
+
+	// 1. 设置操作人和变更原因（用于变更历史）
+	if req.Operator != "" {
+		ctx = context.WithValue(ctx, shareConstants.OperatorKey, req.Operator)
+	}
+	if req.Reason != "" {
+		ctx = context.WithValue(ctx, shareConstants.ChangeReasonKey, req.Reason)
+	}
+
+	// 2. 将请求DTO转换为领域变更列表
+	mutations := make([]*domainService.BatchConfigMutation, 0, len(req.Items))
+	for _, item := range req.Items {
+		metadata := item.Metadata
+		if metadata == "" && item.Action == string(domainService.BatchActionCreate) {
+			metadata = "{}"
+		}
+		config := &entity.Config{
+			NamespaceID: req.NamespaceID,
+			Environment: req.Environment,
+			Key:         item.Key,
+			Value:       item.Value,
+			GroupName:   item.GroupName,
+			ValueType:   item.ValueType,
+			Description: item.Description,
+			Metadata:    metadata,
+		}
+		config.CreatedBy = req.Operator
+		config.UpdatedBy = req.Operator
+		mutations = append(mutations, &domainService.BatchConfigMutation{
+			Action: domainService.BatchAction(item.Action),
+			Config: config,
+		})
+	}
+
+	// 3. 调用领域服务执行批量变更（错误直接向上传递）
+	result, err := s.configDomainService.BatchMutateConfigs(ctx, mutations)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. 转换为VO返回
+	return s.converter.ToBatchMutationVO(result), nil
 }
 
 // DeleteConfig 删除配置（逻辑删除）
-func (s *ConfigAppService) DeleteConfig(ctx context.Context, configID int) error {
+func (s *ConfigAppService) DeleteConfig(ctx context.Context, configID int) (err error) {
+	ctx, span := tracing.Start(ctx, "ConfigAppService.DeleteConfig")
+	defer func() { tracing.End(span, err) }()
+
 	// 直接调用领域服务删除配置（错误直接向上传递）
 	return s.configDomainService.DeleteConfig(ctx, configID)
 }
 
+// SearchConfigs 按关键字搜索配置（匹配键、描述、分组和值），返回命中字段的高亮片段
+func (s *ConfigAppService) SearchConfigs(ctx context.Context, req *request.SearchConfigRequest) (*vo.ConfigSearchListVO, error) {
+	// 1. 设置默认值
+	req.SetDefaults()
+
+	// 2. 解析搜索字段
+	var fields []string
+	for _, field := range strings.Split(req.Fields, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			fields = append(fields, field)
+		}
+	}
+
+	// 3. 调用领域服务搜索（错误直接向上传递）
+	params := &repository.ConfigSearchParams{
+		Query:       req.Query,
+		Fields:      fields,
+		NamespaceID: req.NamespaceID,
+		Environment: req.Environment,
+		Page:        req.Page,
+		Size:        req.Size,
+	}
+	pageResult, err := s.configDomainService.SearchConfigs(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. 转换为VO并生成高亮片段
+	return s.converter.ToSearchListVO(pageResult, params.Query, fields), nil
+}
+
+// QueryConfigsByTags 按标签分页查询配置（多个标签为 AND 关系）
+func (s *ConfigAppService) QueryConfigsByTags(ctx context.Context, req *request.QueryByTagsRequest) (*vo.ConfigListVO, error) {
+	// 1. 设置默认值
+	req.SetDefaults()
+
+	// 2. 将 DTO 转换为领域标签输入和仓储层查询参数
+	tags := make([]entity.TagInput, 0, len(req.Tags))
+	for _, tag := range req.Tags {
+		tags = append(tags, entity.TagInput{TagKey: tag.TagKey, TagValue: tag.TagValue})
+	}
+	params := &repository.ConfigQueryParams{
+		NamespaceID: req.NamespaceID,
+		Environment: req.Environment,
+		IsActive:    req.IsActive,
+		Page:        req.Page,
+		Size:        req.Size,
+		OrderBy:     req.OrderBy,
+	}
+
+	// 3. 调用领域服务查询配置（错误直接向上传递）
+	pageResult, err := s.configDomainService.QueryConfigsByTags(ctx, tags, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. 转换为VO返回
+	return s.converter.ToListVO(pageResult.Items, pageResult.Total, pageResult.Page, pageResult.Size), nil
+}
+
+// QueryTrash 分页查询回收站中已删除的配置
+func (s *ConfigAppService) QueryTrash(ctx context.Context, req *request.QueryTrashRequest) (*vo.ConfigListVO, error) {
+	// 1. 设置默认值
+	req.SetDefaults()
+
+	// 2. 调用领域服务查询已删除配置（错误直接向上传递）
+	pageResult, err := s.configDomainService.QueryDeletedConfigs(ctx, &repository.ConfigQueryParams{
+		NamespaceID: req.NamespaceID,
+		Key:         req.Key,
+		Environment: req.Environment,
+		Page:        req.Page,
+		Size:        req.Size,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 转换为VO返回
+	return s.converter.ToListVO(pageResult.Items, pageResult.Total, pageResult.Page, pageResult.Size), nil
+}
+
+// RestoreConfig 从回收站恢复配置
+func (s *ConfigAppService) RestoreConfig(ctx context.Context, req *request.RestoreConfigRequest) (_ *vo.ConfigVO, err error) {
+	ctx, span := tracing.Start(ctx, "ConfigAppService.RestoreConfig")
+	defer func() { tracing.End(span, err) }()
+
+	// 1. 设置操作人和恢复原因（用于变更历史）
+	if req.Operator != "" {
+		ctx = context.WithValue(ctx, shareConstants.OperatorKey, req.Operator)
+	}
+	if req.Reason != "" {
+		ctx = context.WithValue(ctx, shareConstants.ChangeReasonKey, req.Reason)
+	}
+
+	// 2. 调用领域服务恢复配置（错误直接向上传递）
+	config, err := s.configDomainService.RestoreConfig(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 转换为VO返回
+	return s.converter.ToVO(config), nil
+}
+
+// MigrateContentHashes 将命名空间下配置的内容哈希迁移到服务端当前的默认算法
+func (s *ConfigAppService) MigrateContentHashes(ctx context.Context, req *request.MigrateContentHashRequest) (_ *vo.ContentHashMigrationVO, err error) {
+	ctx, span := tracing.Start(ctx, "ConfigAppService.MigrateContentHashes")
+	defer func() { tracing.End(span, err) }()
+
+	result, err := s.configDomainService.MigrateContentHashes(ctx, req.NamespaceID, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vo.ContentHashMigrationVO{
+		Algorithm:  result.Algorithm,
+		DryRun:     req.DryRun,
+		Scanned:    result.Scanned,
+		Migrated:   result.Migrated,
+		Skipped:    result.Skipped,
+		Mismatched: result.Mismatched,
+	}, nil
+}
+
 // ==================== 辅助函数 ====================
 
+// sign 启用配置签名时对配置值签名（签名返回给客户端的值，脱敏后的值按脱敏值签名）
+func (s *ConfigAppService) sign(configVO *vo.ConfigVO) {
+	if s.signer == nil {
+		return
+	}
+	configVO.Signature = s.signer.Sign(configVO.NamespaceID, configVO.Environment, configVO.Key, configVO.Value)
+	configVO.SignatureKeyID = s.signer.KeyID()
+}
+
 // boolValue 获取布尔指针的值，如果为nil则返回默认值
 func boolValue(ptr *bool, defaultValue bool) bool {
 	if ptr != nil {
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+
+	"config-client/api/config-api/converter"
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/dto/vo"
+	domainService "config-client/config/domain/service"
+)
+
+// ConfigDependencyAppService 配置依赖应用服务
+// 负责协调领域服务和数据转换，不包含业务逻辑和异常处理
+type ConfigDependencyAppService struct {
+	dependencyDomainService *domainService.ConfigDependencyService
+	converter               *converter.ConfigDependencyConverter
+}
+
+// NewConfigDependencyAppService 创建配置依赖应用服务实例
+func NewConfigDependencyAppService(
+	dependencyDomainService *domainService.ConfigDependencyService,
+	converter *converter.ConfigDependencyConverter,
+) *ConfigDependencyAppService {
+	return &ConfigDependencyAppService{
+		dependencyDomainService: dependencyDomainService,
+		converter:               converter,
+	}
+}
+
+// GetDependencies 查询配置的显式依赖
+func (s *ConfigDependencyAppService) GetDependencies(ctx context.Context, configID int) (*vo.ConfigDependenciesVO, error) {
+	dependencies, err := s.dependencyDomainService.GetDependencies(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToDependenciesVO(dependencies), nil
+}
+
+// SetDependencies 全量替换配置显式声明的依赖
+func (s *ConfigDependencyAppService) SetDependencies(ctx context.Context, req *request.SetConfigDependenciesRequest) (*vo.ConfigDependenciesVO, error) {
+	dependencies, err := s.dependencyDomainService.SetDependencies(ctx, req.ConfigID, req.DependsOn, req.Operator)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToDependenciesVO(dependencies), nil
+}
+
+// GetGraph 查询命名空间的依赖图
+func (s *ConfigDependencyAppService) GetGraph(ctx context.Context, req *request.ConfigDependencyGraphRequest) (*vo.ConfigDependencyGraphVO, error) {
+	graph, err := s.dependencyDomainService.GetGraph(ctx, req.NamespaceID, req.Environment)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToGraphVO(graph), nil
+}
+
+// AnalyzeImpact 分析配置变更的影响范围
+func (s *ConfigDependencyAppService) AnalyzeImpact(ctx context.Context, configID int) (*vo.ConfigImpactAnalysisVO, error) {
+	analysis, err := s.dependencyDomainService.AnalyzeImpact(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToImpactVO(analysis), nil
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"config-client/api/config-api/converter"
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/dto/vo"
+	domainService "config-client/config/domain/service"
+)
+
+// ConfigFileAppService 文件配置应用服务
+// 负责协调领域服务和数据转换，不包含业务逻辑和异常处理
+type ConfigFileAppService struct {
+	fileDomainService *domainService.ConfigFileService
+	converter         *converter.ConfigFileConverter
+}
+
+// NewConfigFileAppService 创建文件配置应用服务实例
+func NewConfigFileAppService(
+	fileDomainService *domainService.ConfigFileService,
+	converter *converter.ConfigFileConverter,
+) *ConfigFileAppService {
+	return &ConfigFileAppService{
+		fileDomainService: fileDomainService,
+		converter:         converter,
+	}
+}
+
+// UploadFile 上传文件内容（流式读取请求体）
+func (s *ConfigFileAppService) UploadFile(ctx context.Context, req *request.UploadConfigFileRequest, contentType string, content io.Reader) (*vo.ConfigFileVO, error) {
+	result, err := s.fileDomainService.UploadFile(ctx, &domainService.ConfigFileUpload{
+		NamespaceID: req.NamespaceID,
+		Key:         req.Key,
+		Environment: req.Environment,
+		GroupName:   req.GroupName,
+		Description: req.Description,
+		FileName:    req.FileName,
+		ContentType: contentType,
+		Operator:    req.Operator,
+		Content:     content,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToUploadVO(result), nil
+}
+
+// GetFileInfo 查询文件信息（不含内容）
+func (s *ConfigFileAppService) GetFileInfo(ctx context.Context, req *request.DownloadConfigFileRequest) (*vo.ConfigFileVO, error) {
+	result, err := s.getFile(ctx, req, false)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToVO(result), nil
+}
+
+// DownloadFile 下载文件，返回文件信息和内容
+func (s *ConfigFileAppService) DownloadFile(ctx context.Context, req *request.DownloadConfigFileRequest) (*vo.ConfigFileVO, []byte, error) {
+	result, err := s.getFile(ctx, req, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.converter.ToVO(result), result.File.Content, nil
+}
+
+// getFile 按配置ID或配置键查询文件
+func (s *ConfigFileAppService) getFile(ctx context.Context, req *request.DownloadConfigFileRequest, withContent bool) (*domainService.ConfigFileResult, error) {
+	if req.ConfigID > 0 {
+		return s.fileDomainService.GetFile(ctx, req.ConfigID, withContent)
+	}
+	return s.fileDomainService.GetActiveFile(ctx, req.NamespaceID, req.Key, req.Environment, withContent)
+}
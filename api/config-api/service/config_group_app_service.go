@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+
+	"config-client/api/config-api/converter"
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/dto/vo"
+	"config-client/config/domain/entity"
+	domainService "config-client/config/domain/service"
+)
+
+// ConfigGroupAppService 配置分组应用服务
+// 负责协调领域服务和数据转换，不包含业务逻辑和异常处理
+type ConfigGroupAppService struct {
+	groupDomainService    *domainService.ConfigGroupService
+	transferDomainService *domainService.ConfigTransferService
+	converter             *converter.ConfigGroupConverter
+	transferConverter     *converter.ConfigTransferConverter
+}
+
+// NewConfigGroupAppService 创建配置分组应用服务实例
+func NewConfigGroupAppService(
+	groupDomainService *domainService.ConfigGroupService,
+	transferDomainService *domainService.ConfigTransferService,
+	converter *converter.ConfigGroupConverter,
+	transferConverter *converter.ConfigTransferConverter,
+) *ConfigGroupAppService {
+	return &ConfigGroupAppService{
+		groupDomainService:    groupDomainService,
+		transferDomainService: transferDomainService,
+		converter:             converter,
+		transferConverter:     transferConverter,
+	}
+}
+
+// CreateGroup 创建配置分组
+func (s *ConfigGroupAppService) CreateGroup(ctx context.Context, req *request.CreateConfigGroupRequest) (*vo.ConfigGroupVO, error) {
+	// 1. 将请求DTO转换为领域实体
+	group := s.converter.ToEntity(req)
+
+	// 2. 调用领域服务创建（错误直接向上传递）
+	if err := s.groupDomainService.CreateGroup(ctx, group); err != nil {
+		return nil, err
+	}
+
+	// 3. 返回分组及统计（分组可能已被存量配置引用）
+	return s.GetGroup(ctx, group.ID, "")
+}
+
+// UpdateGroup 更新配置分组
+func (s *ConfigGroupAppService) UpdateGroup(ctx context.Context, req *request.UpdateConfigGroupRequest) (*vo.ConfigGroupVO, error) {
+	// 1. 将请求DTO转换为领域实体
+	group := &entity.ConfigGroup{
+		Description: req.Description,
+		SortOrder:   req.SortOrder,
+	}
+	group.ID = req.ID
+	group.UpdatedBy = req.UpdatedBy
+
+	// 2. 调用领域服务更新（错误直接向上传递）
+	if err := s.groupDomainService.UpdateGroup(ctx, group); err != nil {
+		return nil, err
+	}
+
+	// 3. 返回分组及统计
+	return s.GetGroup(ctx, group.ID, "")
+}
+
+// DeleteGroup 删除配置分组
+func (s *ConfigGroupAppService) DeleteGroup(ctx context.Context, id int) error {
+	// 直接调用领域服务删除（错误直接向上传递）
+	return s.groupDomainService.DeleteGroup(ctx, id)
+}
+
+// GetGroup 根据ID获取配置分组及统计
+func (s *ConfigGroupAppService) GetGroup(ctx context.Context, id int, environment string) (*vo.ConfigGroupVO, error) {
+	summary, err := s.groupDomainService.GetGroupSummary(ctx, id, environment)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToVO(summary), nil
+}
+
+// ListGroups 查询命名空间下的配置分组及统计
+func (s *ConfigGroupAppService) ListGroups(ctx context.Context, req *request.ListConfigGroupRequest) ([]*vo.ConfigGroupVO, error) {
+	summaries, err := s.groupDomainService.ListGroups(ctx, req.NamespaceID, req.Environment)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToVOList(summaries), nil
+}
+
+// ReleaseGroup 发布整个分组
+func (s *ConfigGroupAppService) ReleaseGroup(ctx context.Context, req *request.ReleaseConfigGroupRequest) (*vo.ConfigGroupReleaseVO, error) {
+	result, err := s.groupDomainService.ReleaseGroup(ctx, req.ID, req.Environment)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToReleaseVO(result), nil
+}
+
+// ExportGroup 导出分组下已发布的配置
+func (s *ConfigGroupAppService) ExportGroup(ctx context.Context, req *request.ExportConfigGroupRequest) (*vo.ConfigExportVO, error) {
+	// 1. 查询分组
+	group, err := s.groupDomainService.GetByID(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 调用导入导出领域服务按分组导出（错误直接向上传递）
+	result, err := s.transferDomainService.ExportConfigs(ctx, &domainService.ExportConfigsRequest{
+		NamespaceID:    group.NamespaceID,
+		Environment:    req.Environment,
+		GroupName:      group.Name,
+		Format:         req.Format,
+		IncludeSecrets: req.IncludeSecrets,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 转换为VO返回
+	return s.transferConverter.ToExportVO(result), nil
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+
+	"config-client/api/config-api/converter"
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/dto/vo"
+	"config-client/config/domain/entity"
+	domainService "config-client/config/domain/service"
+)
+
+// ConfigSchemaAppService 配置 Schema 应用服务
+// 负责协调领域服务和数据转换，不包含业务逻辑和异常处理
+type ConfigSchemaAppService struct {
+	schemaDomainService *domainService.ConfigSchemaService
+	converter           *converter.ConfigSchemaConverter
+}
+
+// NewConfigSchemaAppService 创建配置 Schema 应用服务实例
+func NewConfigSchemaAppService(
+	schemaDomainService *domainService.ConfigSchemaService,
+	converter *converter.ConfigSchemaConverter,
+) *ConfigSchemaAppService {
+	return &ConfigSchemaAppService{
+		schemaDomainService: schemaDomainService,
+		converter:           converter,
+	}
+}
+
+// CreateSchema 创建 Schema 绑定
+func (s *ConfigSchemaAppService) CreateSchema(ctx context.Context, req *request.CreateConfigSchemaRequest) (*vo.ConfigSchemaVO, error) {
+	// 1. 将请求DTO转换为领域实体
+	schema := s.converter.ToEntity(req)
+
+	// 2. 调用领域服务创建（错误直接向上传递）
+	if err := s.schemaDomainService.CreateSchema(ctx, schema); err != nil {
+		return nil, err
+	}
+
+	// 3. 转换为VO返回
+	return s.converter.ToVO(schema), nil
+}
+
+// UpdateSchema 更新 Schema 绑定
+func (s *ConfigSchemaAppService) UpdateSchema(ctx context.Context, req *request.UpdateConfigSchemaRequest) (*vo.ConfigSchemaVO, error) {
+	// 1. 将请求DTO转换为领域实体
+	schema := &entity.ConfigSchema{
+		Schema:      req.Schema,
+		Description: req.Description,
+		IsActive:    boolValue(req.IsActive, true),
+	}
+	schema.ID = req.ID
+	schema.UpdatedBy = req.UpdatedBy
+
+	// 2. 调用领域服务更新（错误直接向上传递）
+	if err := s.schemaDomainService.UpdateSchema(ctx, schema); err != nil {
+		return nil, err
+	}
+
+	// 3. 转换为VO返回
+	return s.converter.ToVO(schema), nil
+}
+
+// DeleteSchema 删除 Schema 绑定
+func (s *ConfigSchemaAppService) DeleteSchema(ctx context.Context, id int) error {
+	// 直接调用领域服务删除（错误直接向上传递）
+	return s.schemaDomainService.DeleteSchema(ctx, id)
+}
+
+// GetSchemaByID 根据ID获取 Schema 绑定
+func (s *ConfigSchemaAppService) GetSchemaByID(ctx context.Context, id int) (*vo.ConfigSchemaVO, error) {
+	schema, err := s.schemaDomainService.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToVO(schema), nil
+}
+
+// ListSchemas 查询命名空间下的所有 Schema 绑定
+func (s *ConfigSchemaAppService) ListSchemas(ctx context.Context, namespaceID int) ([]*vo.ConfigSchemaVO, error) {
+	schemas, err := s.schemaDomainService.ListSchemas(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToVOList(schemas), nil
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+
+	"config-client/api/config-api/converter"
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/dto/vo"
+	domainService "config-client/config/domain/service"
+)
+
+// ConfigTagAppService 配置标签应用服务
+// 负责协调领域服务和数据转换，不包含业务逻辑和异常处理
+type ConfigTagAppService struct {
+	tagDomainService    *domainService.ConfigTagService
+	configDomainService *domainService.ConfigService
+	converter           *converter.ConfigTagConverter
+}
+
+// NewConfigTagAppService 创建配置标签应用服务实例
+func NewConfigTagAppService(
+	tagDomainService *domainService.ConfigTagService,
+	configDomainService *domainService.ConfigService,
+	converter *converter.ConfigTagConverter,
+) *ConfigTagAppService {
+	return &ConfigTagAppService{
+		tagDomainService:    tagDomainService,
+		configDomainService: configDomainService,
+		converter:           converter,
+	}
+}
+
+// GetTags 查询配置的所有标签
+func (s *ConfigTagAppService) GetTags(ctx context.Context, configID int) (*vo.ConfigTagListVO, error) {
+	// 1. 校验配置存在（不存在时返回配置不存在错误）
+	if _, err := s.configDomainService.GetByID(ctx, configID); err != nil {
+		return nil, err
+	}
+
+	// 2. 查询标签并转换为VO返回
+	return s.listTags(ctx, configID)
+}
+
+// AddTags 为配置添加标签（已存在的标签自动跳过）
+func (s *ConfigTagAppService) AddTags(ctx context.Context, req *request.AddTagsRequest) (*vo.ConfigTagListVO, error) {
+	// 1. 校验配置存在
+	if _, err := s.configDomainService.GetByID(ctx, req.ConfigID); err != nil {
+		return nil, err
+	}
+
+	// 2. 调用领域服务添加标签（错误直接向上传递）
+	if err := s.tagDomainService.AddTags(ctx, req.ConfigID, s.converter.ToDomainInputs(req.Tags)); err != nil {
+		return nil, err
+	}
+
+	// 3. 返回最新标签列表
+	return s.listTags(ctx, req.ConfigID)
+}
+
+// RemoveTags 按标签键删除配置的标签
+func (s *ConfigTagAppService) RemoveTags(ctx context.Context, req *request.RemoveTagsRequest) (*vo.ConfigTagListVO, error) {
+	// 1. 校验配置存在
+	if _, err := s.configDomainService.GetByID(ctx, req.ConfigID); err != nil {
+		return nil, err
+	}
+
+	// 2. 调用领域服务删除标签
+	if err := s.tagDomainService.RemoveTags(ctx, req.ConfigID, req.TagKeys); err != nil {
+		return nil, err
+	}
+
+	// 3. 返回最新标签列表
+	return s.listTags(ctx, req.ConfigID)
+}
+
+// ReplaceTags 全量替换配置的标签
+func (s *ConfigTagAppService) ReplaceTags(ctx context.Context, req *request.ReplaceTagsRequest) (*vo.ConfigTagListVO, error) {
+	// 1. 校验配置存在
+	if _, err := s.configDomainService.GetByID(ctx, req.ConfigID); err != nil {
+		return nil, err
+	}
+
+	// 2. 调用领域服务替换标签
+	if err := s.tagDomainService.UpdateTags(ctx, req.ConfigID, s.converter.ToDomainInputs(req.Tags)); err != nil {
+		return nil, err
+	}
+
+	// 3. 返回最新标签列表
+	return s.listTags(ctx, req.ConfigID)
+}
+
+// RegenerateTags 根据配置当前属性重新生成自动标签（手动添加的标签保持不变）
+func (s *ConfigTagAppService) RegenerateTags(ctx context.Context, configID int) (*vo.ConfigTagListVO, error) {
+	// 1. 查询配置（自动标签依据配置键、分组、类型和环境生成）
+	config, err := s.configDomainService.GetByID(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 调用领域服务重新生成自动标签
+	if err := s.tagDomainService.RegenerateAutoTags(ctx, config); err != nil {
+		return nil, err
+	}
+
+	// 3. 返回最新标签列表
+	return s.listTags(ctx, configID)
+}
+
+// listTags 查询配置标签并转换为标签列表VO
+func (s *ConfigTagAppService) listTags(ctx context.Context, configID int) (*vo.ConfigTagListVO, error) {
+	tags, err := s.tagDomainService.GetTags(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToTagListVO(configID, tags), nil
+}
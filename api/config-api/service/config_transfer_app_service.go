@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"config-client/api/config-api/converter"
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/dto/vo"
+	domainService "config-client/config/domain/service"
+	"config-client/share/errors"
+)
+
+// ConfigTransferAppService 配置导入导出应用服务
+// 负责协调领域服务和数据转换
+type ConfigTransferAppService struct {
+	transferDomainService *domainService.ConfigTransferService
+	converter             *converter.ConfigTransferConverter
+}
+
+// NewConfigTransferAppService 创建配置导入导出应用服务实例
+func NewConfigTransferAppService(
+	transferDomainService *domainService.ConfigTransferService,
+	converter *converter.ConfigTransferConverter,
+) *ConfigTransferAppService {
+	return &ConfigTransferAppService{
+		transferDomainService: transferDomainService,
+		converter:             converter,
+	}
+}
+
+// ImportConfigs 导入配置
+// filename 为上传文件名（未上传文件时为空），用于在未指定格式时按扩展名推断
+func (s *ConfigTransferAppService) ImportConfigs(ctx context.Context, req *request.ImportConfigRequest, filename string) (*vo.ConfigImportResultVO, error) {
+	// 1. 校验内容和格式
+	if strings.TrimSpace(req.Content) == "" {
+		return nil, errors.ErrBadRequest("导入内容不能为空，请提供 content 或上传文件")
+	}
+
+	format := req.Format
+	if format == "" && filename != "" {
+		format = formatFromFilename(filename)
+	}
+	if format == "" {
+		return nil, errors.ErrBadRequest("无法确定导入格式，请指定 format")
+	}
+
+	// 2. 转换为领域服务请求
+	domainReq := &domainService.ImportConfigsRequest{
+		NamespaceID: req.NamespaceID,
+		Environment: req.Environment,
+		GroupName:   req.GroupName,
+		Format:      format,
+		Content:     req.Content,
+		Strategy:    req.Strategy,
+		DryRun:      req.DryRun,
+		Operator:    req.Operator,
+	}
+
+	// 3. 调用领域服务导入（错误直接向上传递）
+	result, err := s.transferDomainService.ImportConfigs(ctx, domainReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. 转换为VO返回
+	return s.converter.ToImportResultVO(result), nil
+}
+
+// formatFromFilename 根据文件扩展名推断格式
+func formatFromFilename(filename string) string {
+	base := strings.ToLower(filepath.Base(filename))
+	if base == ".env" || strings.HasPrefix(base, ".env.") {
+		return "env"
+	}
+	return strings.TrimPrefix(filepath.Ext(base), ".")
+}
+
+// ExportNamespace 导出命名空间配置
+func (s *ConfigTransferAppService) ExportNamespace(ctx context.Context, req *request.ExportNamespaceRequest) (*vo.ConfigExportVO, error) {
+	// 1. 转换为领域服务请求
+	domainReq := &domainService.ExportConfigsRequest{
+		NamespaceID:    req.NamespaceID,
+		Environment:    req.Environment,
+		GroupName:      req.GroupName,
+		Format:         req.Format,
+		IncludeSecrets: req.IncludeSecrets,
+	}
+
+	// 2. 调用领域服务导出（错误直接向上传递）
+	result, err := s.transferDomainService.ExportConfigs(ctx, domainReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 转换为VO返回
+	return s.converter.ToExportVO(result), nil
+}
+
+// RenderNamespace 渲染命名空间合并后的生效配置
+func (s *ConfigTransferAppService) RenderNamespace(ctx context.Context, req *request.RenderNamespaceRequest) (*vo.ConfigExportVO, error) {
+	// 1. 调用领域服务渲染（错误直接向上传递）
+	result, err := s.transferDomainService.RenderNamespace(ctx, &domainService.RenderNamespaceRequest{
+		NamespaceID:    req.NamespaceID,
+		Environment:    req.Environment,
+		Format:         req.Format,
+		IncludeSecrets: req.IncludeSecrets,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 转换为VO返回
+	return s.converter.ToExportVO(result), nil
+}
+
+// PreviewPromotion 预览环境晋升差异
+func (s *ConfigTransferAppService) PreviewPromotion(ctx context.Context, req *request.PromotionPreviewRequest) (*vo.PromotionDiffVO, error) {
+	// 1. 调用领域服务计算差异（错误直接向上传递）
+	diff, err := s.transferDomainService.PreviewPromotion(ctx, toPromotionRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 转换为VO返回
+	return s.converter.ToPromotionDiffVO(diff), nil
+}
+
+// ApplyPromotion 执行环境晋升
+func (s *ConfigTransferAppService) ApplyPromotion(ctx context.Context, req *request.ApplyPromotionRequest) (*vo.PromotionResultVO, error) {
+	// 1. 转换为领域服务请求
+	domainReq := &domainService.ApplyPromotionRequest{
+		PromotionRequest: *toPromotionRequest(&req.PromotionPreviewRequest),
+		DiffToken:        req.DiffToken,
+		ApplyDeletions:   req.ApplyDeletions,
+		Operator:         req.Operator,
+	}
+
+	// 2. 调用领域服务执行晋升（错误直接向上传递）
+	result, err := s.transferDomainService.ApplyPromotion(ctx, domainReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 转换为VO返回
+	return s.converter.ToPromotionResultVO(result), nil
+}
+
+// toPromotionRequest 将晋升预览请求转换为领域服务请求
+func toPromotionRequest(req *request.PromotionPreviewRequest) *domainService.PromotionRequest {
+	return &domainService.PromotionRequest{
+		NamespaceID:       req.NamespaceID,
+		SourceEnvironment: req.SourceEnvironment,
+		TargetEnvironment: req.TargetEnvironment,
+		GroupName:         req.GroupName,
+		Keys:              req.Keys,
+		IncludeUnreleased: req.IncludeUnreleased,
+	}
+}
+
+// CloneNamespace 克隆命名空间
+func (s *ConfigTransferAppService) CloneNamespace(ctx context.Context, req *request.CloneNamespaceRequest) (*vo.NamespaceCloneVO, error) {
+	// 1. 转换为领域服务请求
+	domainReq := &domainService.CloneNamespaceRequest{
+		SourceNamespaceID: req.SourceNamespaceID,
+		Name:              req.Name,
+		DisplayName:       req.DisplayName,
+		Description:       req.Description,
+		Environment:       req.Environment,
+		GroupName:         req.GroupName,
+		IncludeUnreleased: req.IncludeUnreleased,
+		Operator:          req.Operator,
+	}
+
+	// 2. 调用领域服务克隆（错误直接向上传递）
+	result, err := s.transferDomainService.CloneNamespace(ctx, domainReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 转换为VO返回
+	return s.converter.ToCloneVO(result), nil
+}
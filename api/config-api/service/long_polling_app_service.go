@@ -3,61 +3,61 @@ package service
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 
 	"config-client/api/config-api/dto/request"
 	"config-client/api/config-api/dto/vo"
-	"config-client/config/domain/repository"
+	"config-client/config/domain/constants"
+	domainErrors "config-client/config/domain/errors"
 	domainService "config-client/config/domain/service"
+	"config-client/share/errors"
+	"config-client/share/tracing"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 )
 
 // LongPollingAppService 长轮询应用服务
 type LongPollingAppService struct {
-	longPollingService *domainService.LongPollingService
-	configRepo         repository.ConfigRepository
+	longPollingService  *domainService.LongPollingService
+	configDomainService *domainService.ConfigService
+	releaseSvc          *domainService.ReleaseService // 发布管理服务（可选，用于返回灰度版本中的值）
+	signer              *domainService.ConfigSigner   // 配置值签名（可选，对返回的配置值签名）
 }
 
 // NewLongPollingAppService 创建长轮询应用服务
 func NewLongPollingAppService(
 	longPollingService *domainService.LongPollingService,
-	configRepo repository.ConfigRepository,
+	configDomainService *domainService.ConfigService,
+	releaseSvc *domainService.ReleaseService,
 ) *LongPollingAppService {
 	return &LongPollingAppService{
-		longPollingService: longPollingService,
-		configRepo:         configRepo,
+		longPollingService:  longPollingService,
+		configDomainService: configDomainService,
+		releaseSvc:          releaseSvc,
 	}
 }
 
+// SetConfigSigner 设置配置值签名服务
+func (s *LongPollingAppService) SetConfigSigner(signer *domainService.ConfigSigner) {
+	s.signer = signer
+}
+
 // WaitForChanges 等待配置变更
-func (s *LongPollingAppService) WaitForChanges(ctx context.Context, req *request.LongPollingRequest) (*vo.LongPollingResponse, error) {
-	// 1. 转换请求参数
-	configKeys := make([]string, len(req.ConfigKeys))
-	versions := make(map[string]string)
-	var namespaceID int
-	var environment string
-
-	for i, item := range req.ConfigKeys {
-		configKey := fmt.Sprintf("%d:%s", item.NamespaceID, item.ConfigKey)
-		configKeys[i] = configKey
-		versions[configKey] = item.Version
+func (s *LongPollingAppService) WaitForChanges(ctx context.Context, req *request.LongPollingRequest) (_ *vo.LongPollingResponse, err error) {
+	ctx, span := tracing.Start(ctx, "LongPollingAppService.WaitForChanges")
+	defer func() { tracing.End(span, err) }()
 
-		// 记录第一个配置的命名空间和环境 (假设同一批配置在同一命名空间)
-		if i == 0 {
-			namespaceID = item.NamespaceID
-			environment = item.Environment
-		}
-	}
+	// 1. 按（命名空间, 环境）分组转换请求参数
+	groups := buildWatchGroups(req)
 
 	// 2. 构建等待请求
 	waitReq := &domainService.WaitRequest{
 		ClientID:       req.ClientID,
 		ClientIP:       req.ClientIP,
 		ClientHostname: req.ClientHostname,
-		NamespaceID:    namespaceID,
-		Environment:    environment,
-		ConfigKeys:     configKeys,
-		Versions:       versions,
+		Groups:         groups,
 	}
 
 	// 3. 调用领域服务等待变更（传递 context）
@@ -66,81 +66,249 @@ func (s *LongPollingAppService) WaitForChanges(ctx context.Context, req *request
 		return nil, err
 	}
 
+	// 第一个配置所在命名空间的序号，兼容只识别 sequence 的旧客户端
+	sequence := result.Sequences[firstNamespaceID(req)]
+
 	// 4. 如果没有变更，返回未变更响应
 	if !result.Changed {
 		return &vo.LongPollingResponse{
 			Changed:    false,
 			ConfigKeys: []string{},
 			Configs:    []vo.ConfigChangeDetail{},
+			Sequence:   sequence,
+			Sequences:  result.Sequences,
+
+			NextPollDelayMs: s.longPollingService.NextPollDelay().Milliseconds(),
 		}, nil
 	}
 
 	// 5. 如果有变更，获取最新的配置详情
-	configs, err := s.getConfigDetails(req.ConfigKeys, result.Versions)
-	if err != nil {
-		return nil, err
-	}
+	configs := s.getConfigDetails(ctx, req, findWatchGroup(groups, result.NamespaceID, result.Environment), result)
 
 	return &vo.LongPollingResponse{
 		Changed:    true,
 		ConfigKeys: result.ConfigKeys,
 		Configs:    configs,
+		Sequence:   sequence,
+		Sequences:  result.Sequences,
+
+		NextPollDelayMs: s.longPollingService.NextPollDelay().Milliseconds(),
 	}, nil
 }
 
-// getConfigDetails 获取配置详情
-func (s *LongPollingAppService) getConfigDetails(
-	requestKeys []request.ConfigKeyVersion,
-	latestVersions map[string]string,
-) ([]vo.ConfigChangeDetail, error) {
-	var details []vo.ConfigChangeDetail
+// buildWatchGroups 将请求中的配置和前缀按（命名空间, 环境）分组
+// 业务规则：
+// 1. 环境为空时使用默认环境
+// 2. 分组顺序与配置在请求中首次出现的顺序一致（按键监听的配置在前，前缀在后）
+// 3. last_sequences 优先；未携带时 last_sequence 仅作用于第一个配置的命名空间（兼容旧客户端）
+// 4. 客户端上报的前缀下配置按键加入监听（版本比较、事件补发与按键监听一致），已按键监听的配置以按键监听的版本为准
+func buildWatchGroups(req *request.LongPollingRequest) []*domainService.WatchGroup {
+	type groupKey struct {
+		namespaceID int
+		environment string
+	}
+
+	groups := make([]*domainService.WatchGroup, 0)
+	index := make(map[groupKey]*domainService.WatchGroup)
+	groupOf := func(namespaceID int, environment string) *domainService.WatchGroup {
+		if environment == "" {
+			environment = constants.EnvDefault
+		}
+		key := groupKey{namespaceID: namespaceID, environment: environment}
+		group, exists := index[key]
+		if !exists {
+			group = &domainService.WatchGroup{
+				NamespaceID:  namespaceID,
+				Environment:  environment,
+				ConfigKeys:   []string{},
+				Versions:     make(map[string]string),
+				LastSequence: lastSequenceOf(req, namespaceID),
+			}
+			index[key] = group
+			groups = append(groups, group)
+		}
+		return group
+	}
 
-	for _, item := range requestKeys {
+	for _, item := range req.ConfigKeys {
+		group := groupOf(item.NamespaceID, item.Environment)
 		configKey := fmt.Sprintf("%d:%s", item.NamespaceID, item.ConfigKey)
+		group.ConfigKeys = append(group.ConfigKeys, configKey)
+		group.Versions[configKey] = item.Version
+	}
+
+	for _, item := range req.Prefixes {
+		group := groupOf(item.NamespaceID, item.Environment)
+		if !slices.Contains(group.Prefixes, item.Prefix) {
+			group.Prefixes = append(group.Prefixes, item.Prefix)
+		}
+
+		keys := make([]string, 0, len(item.Versions))
+		for key := range item.Versions {
+			if strings.HasPrefix(key, item.Prefix) {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			configKey := fmt.Sprintf("%d:%s", item.NamespaceID, key)
+			if _, exists := group.Versions[configKey]; exists {
+				continue
+			}
+			group.ConfigKeys = append(group.ConfigKeys, configKey)
+			group.Versions[configKey] = item.Versions[key]
+		}
+	}
+
+	return groups
+}
+
+// findWatchGroup 查找指定命名空间和环境的监听分组
+func findWatchGroup(groups []*domainService.WatchGroup, namespaceID int, environment string) *domainService.WatchGroup {
+	for _, group := range groups {
+		if group.NamespaceID == namespaceID && group.Environment == environment {
+			return group
+		}
+	}
+	return nil
+}
+
+// firstNamespaceID 请求中第一个配置（没有按键监听的配置时为第一个前缀）所在的命名空间
+func firstNamespaceID(req *request.LongPollingRequest) int {
+	if len(req.ConfigKeys) > 0 {
+		return req.ConfigKeys[0].NamespaceID
+	}
+	if len(req.Prefixes) > 0 {
+		return req.Prefixes[0].NamespaceID
+	}
+	return 0
+}
+
+// lastSequenceOf 获取客户端在指定命名空间最后收到的事件序号
+func lastSequenceOf(req *request.LongPollingRequest, namespaceID int) *int64 {
+	if sequence, exists := req.LastSequences[namespaceID]; exists {
+		return &sequence
+	}
+	if req.LastSequences == nil && req.LastSequence != nil && namespaceID == firstNamespaceID(req) {
+		sequence := *req.LastSequence
+		return &sequence
+	}
+	return nil
+}
+
+// getConfigDetails 获取变更配置的最新值，客户端无需再单独查询配置
+// 业务规则：
+// 1. 返回值与按配置键查询接口一致：仅返回已发布、已激活且未过期的配置，命中灰度规则时返回灰度版本中的值
+// 2. 配置已删除或不可用时返回 deleted=true，版本为空
+// 3. 版本按返回的值重新计算，客户端下次长轮询携带该版本即可与服务端正确比较
+// 4. 只返回发生变更的分组（命名空间和环境）下的配置，同一配置键在其他环境的监听不受影响
+// 5. 按前缀监听时，前缀下新建的配置不在客户端上报的版本中，同样返回详情
+func (s *LongPollingAppService) getConfigDetails(
+	ctx context.Context,
+	req *request.LongPollingRequest,
+	group *domainService.WatchGroup,
+	result *domainService.WaitResult,
+) []vo.ConfigChangeDetail {
+	details := make([]vo.ConfigChangeDetail, 0)
+	if group == nil {
+		return details
+	}
 
+	namespacePrefix := fmt.Sprintf("%d:", group.NamespaceID)
+	for _, configKey := range result.ConfigKeys {
 		// 检查这个配置是否有变更
-		latestVersion := latestVersions[configKey]
-		if latestVersion == item.Version {
-			// 没有变更，跳过
+		latestVersion, changed := result.Versions[configKey]
+		if !changed || latestVersion == group.Versions[configKey] {
 			continue
 		}
 
-		// 从数据库获取完整配置信息
-		// 使用配置项中的environment，如果为空则使用默认值
-		environment := item.Environment
-		if environment == "" {
-			environment = "default"
+		key, ok := strings.CutPrefix(configKey, namespacePrefix)
+		if !ok {
+			continue
 		}
-
-		config, err := s.configRepo.FindByNamespaceAndKey(context.Background(), item.NamespaceID, item.ConfigKey, environment)
+		detail, err := s.getConfigDetail(ctx, req, group.NamespaceID, key, group.Environment)
 		if err != nil {
-			hlog.Errorf("获取配置详情失败: namespaceID=%d, key=%s, environment=%s, error=%v", item.NamespaceID, item.ConfigKey, environment, err)
-			// 即使获取失败，也返回基础信息
+			hlog.CtxErrorf(ctx, "获取配置详情失败: namespaceID=%d, key=%s, environment=%s, error=%v", group.NamespaceID, key, group.Environment, err)
+			// 获取失败时返回基础信息，客户端可按配置键重新查询
 			details = append(details, vo.ConfigChangeDetail{
-				NamespaceID: item.NamespaceID,
-				ConfigKey:   item.ConfigKey,
+				NamespaceID: group.NamespaceID,
+				Environment: group.Environment,
+				ConfigKey:   key,
 				Version:     latestVersion,
-				Value:       "",
-				ValueType:   "",
 			})
 			continue
 		}
 
-		if config == nil {
-			hlog.Warnf("配置不存在: namespaceID=%d, key=%s", item.NamespaceID, item.ConfigKey)
-			continue
+		details = append(details, *detail)
+		hlog.CtxInfof(ctx, "配置变更: namespaceID=%d, key=%s, newVersion=%s, deleted=%v", group.NamespaceID, key, detail.Version, detail.Deleted)
+	}
+
+	return details
+}
+
+// getConfigDetail 获取单个配置对客户端生效的值
+func (s *LongPollingAppService) getConfigDetail(
+	ctx context.Context,
+	req *request.LongPollingRequest,
+	namespaceID int,
+	key string,
+	environment string,
+) (*vo.ConfigChangeDetail, error) {
+	detail := &vo.ConfigChangeDetail{
+		NamespaceID: namespaceID,
+		Environment: environment,
+		ConfigKey:   key,
+	}
+
+	// 1. 查询已发布且已激活的配置（不可用时视为已删除）
+	config, err := s.configDomainService.GetActiveConfig(ctx, namespaceID, key, environment)
+	if err != nil {
+		if isConfigUnavailable(err) {
+			detail.Deleted = true
+			s.sign(detail)
+			return detail, nil
+		}
+		return nil, err
+	}
+	detail.Value = config.Value
+	detail.ValueType = config.ValueType
+
+	// 2. 客户端命中灰度规则时，使用灰度版本快照中的值
+	if s.releaseSvc != nil {
+		item, _, err := s.releaseSvc.GetCanaryConfigItem(ctx, namespaceID, environment, key, req.ClientID, req.ClientIP)
+		if err != nil {
+			return nil, err
 		}
+		if item != nil {
+			detail.Value = item.Value
+			detail.ValueType = item.ValueType
+			detail.IsCanary = true
+		}
+	}
 
-		details = append(details, vo.ConfigChangeDetail{
-			NamespaceID: item.NamespaceID,
-			ConfigKey:   item.ConfigKey,
-			Version:     latestVersion,
-			Value:       config.Value,
-			ValueType:   config.ValueType,
-		})
+	detail.Version = domainService.ComputeVersion(detail.Value)
+	s.sign(detail)
+	return detail, nil
+}
 
-		hlog.Infof("配置变更: namespaceID=%d, key=%s, newVersion=%s", item.NamespaceID, item.ConfigKey, latestVersion)
+// sign 启用配置签名时对返回的配置值签名
+func (s *LongPollingAppService) sign(detail *vo.ConfigChangeDetail) {
+	if s.signer == nil {
+		return
 	}
+	detail.Signature = s.signer.Sign(detail.NamespaceID, detail.Environment, detail.ConfigKey, detail.Value)
+	detail.SignatureKeyID = s.signer.KeyID()
+}
 
-	return details, nil
+// isConfigUnavailable 判断错误是否表示配置对客户端不可用（不存在、未发布、未激活或已过期）
+func isConfigUnavailable(err error) bool {
+	appErr, ok := errors.AsAppError(err)
+	if !ok {
+		return false
+	}
+	switch appErr.Code {
+	case domainErrors.ConfigNotFound, domainErrors.ConfigNotReleased, domainErrors.ConfigNotActive, domainErrors.ConfigExpired:
+		return true
+	}
+	return false
 }
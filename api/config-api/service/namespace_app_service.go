@@ -17,6 +17,7 @@ type NamespaceAppService struct {
 	namespaceDomainService *domainService.NamespaceService
 	converter              *converter.NamespaceConverter
 	namespaceRepo          domainRepo.NamespaceRepository
+	quotaService           *domainService.NamespaceQuotaService
 }
 
 // NewNamespaceAppService 创建命名空间应用服务实例
@@ -24,11 +25,13 @@ func NewNamespaceAppService(
 	namespaceDomainService *domainService.NamespaceService,
 	converter *converter.NamespaceConverter,
 	namespaceRepo domainRepo.NamespaceRepository,
+	quotaService *domainService.NamespaceQuotaService,
 ) *NamespaceAppService {
 	return &NamespaceAppService{
 		namespaceDomainService: namespaceDomainService,
 		converter:              converter,
 		namespaceRepo:          namespaceRepo,
+		quotaService:           quotaService,
 	}
 }
 
@@ -206,3 +209,21 @@ func (s *NamespaceAppService) ListActiveNamespaces(ctx context.Context) ([]*vo.N
 	// 2. 转换为VO返回
 	return s.converter.ToVOList(namespaces), nil
 }
+
+// UpdateNamespaceQuota 设置命名空间配额
+func (s *NamespaceAppService) UpdateNamespaceQuota(ctx context.Context, id int, req *request.UpdateNamespaceQuotaRequest) (*vo.NamespaceVO, error) {
+	namespace, err := s.quotaService.UpdateQuota(ctx, id, s.converter.ToQuota(req), req.UpdatedBy)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToVO(namespace), nil
+}
+
+// GetNamespaceStats 查询命名空间用量及生效配额
+func (s *NamespaceAppService) GetNamespaceStats(ctx context.Context, id int) (*vo.NamespaceStatsVO, error) {
+	stats, err := s.quotaService.GetStats(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToStatsVO(stats), nil
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+
+	"config-client/api/config-api/converter"
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/dto/vo"
+	"config-client/config/domain/entity"
+	domainService "config-client/config/domain/service"
+)
+
+// NotificationAppService 通知渠道应用服务
+// 负责协调通知领域服务和数据转换
+type NotificationAppService struct {
+	notificationDomainService *domainService.NotificationService
+	converter                 *converter.NotificationChannelConverter
+}
+
+// NewNotificationAppService 创建通知渠道应用服务
+func NewNotificationAppService(
+	notificationDomainService *domainService.NotificationService,
+	converter *converter.NotificationChannelConverter,
+) *NotificationAppService {
+	return &NotificationAppService{
+		notificationDomainService: notificationDomainService,
+		converter:                 converter,
+	}
+}
+
+// CreateChannel 创建通知渠道
+func (s *NotificationAppService) CreateChannel(ctx context.Context, req *request.CreateNotificationChannelRequest) (*vo.NotificationChannelVO, error) {
+	// 1. 构建领域实体（未指定时默认启用）
+	channel := &entity.NotificationChannel{
+		NamespaceID: req.NamespaceID,
+		Name:        req.Name,
+		Type:        req.Type,
+		Target:      req.Target,
+		Secret:      req.Secret,
+		Events:      req.Events,
+		Template:    req.Template,
+		Enabled:     req.Enabled == nil || *req.Enabled,
+		CreatedBy:   req.CreatedBy,
+	}
+
+	// 2. 调用领域服务创建
+	if err := s.notificationDomainService.CreateChannel(ctx, channel); err != nil {
+		return nil, err
+	}
+
+	return s.converter.ToVO(channel), nil
+}
+
+// UpdateChannel 更新通知渠道
+func (s *NotificationAppService) UpdateChannel(ctx context.Context, req *request.UpdateNotificationChannelRequest) (*vo.NotificationChannelVO, error) {
+	channel := &entity.NotificationChannel{
+		ID:        req.ID,
+		Name:      req.Name,
+		Target:    req.Target,
+		Secret:    req.Secret,
+		Events:    req.Events,
+		Template:  req.Template,
+		Enabled:   req.Enabled,
+		UpdatedBy: req.UpdatedBy,
+	}
+	if err := s.notificationDomainService.UpdateChannel(ctx, channel); err != nil {
+		return nil, err
+	}
+
+	return s.converter.ToVO(channel), nil
+}
+
+// DeleteChannel 删除通知渠道
+func (s *NotificationAppService) DeleteChannel(ctx context.Context, id int) error {
+	return s.notificationDomainService.DeleteChannel(ctx, id)
+}
+
+// GetChannel 根据ID查询通知渠道
+func (s *NotificationAppService) GetChannel(ctx context.Context, id int) (*vo.NotificationChannelVO, error) {
+	channel, err := s.notificationDomainService.GetChannel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToVO(channel), nil
+}
+
+// ListChannels 查询命名空间下的通知渠道
+func (s *NotificationAppService) ListChannels(ctx context.Context, req *request.ListNotificationChannelsRequest) ([]*vo.NotificationChannelVO, error) {
+	channels, err := s.notificationDomainService.ListChannels(ctx, req.NamespaceID)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToVOList(channels), nil
+}
+
+// TestChannel 发送测试消息
+func (s *NotificationAppService) TestChannel(ctx context.Context, id int, req *request.TestNotificationChannelRequest) error {
+	return s.notificationDomainService.TestChannel(ctx, id, req.Operator)
+}
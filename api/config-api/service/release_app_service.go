@@ -2,13 +2,18 @@ package service
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"config-client/api/config-api/converter"
 	"config-client/api/config-api/dto/request"
 	"config-client/api/config-api/dto/vo"
 	"config-client/config/domain/entity"
+	domainErrors "config-client/config/domain/errors"
 	"config-client/config/domain/repository"
 	domainService "config-client/config/domain/service"
+	"config-client/share/errors"
+	"config-client/share/middleware"
 )
 
 // ReleaseAppService 发布管理应用服务
@@ -31,22 +36,29 @@ func NewReleaseAppService(
 
 // CreateRelease 创建发布版本
 func (s *ReleaseAppService) CreateRelease(ctx context.Context, req *request.CreateReleaseRequest) (*vo.ReleaseVO, error) {
-	// 1. 转换请求为领域服务请求
+	// 1. 确定创建人（启用认证时取自认证主体，审批时据此判断审批人是否为创建人）
+	createdBy, err := resolveOperator(ctx, req.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 转换请求为领域服务请求
 	domainReq := &domainService.CreateReleaseRequest{
-		NamespaceID: req.NamespaceID,
-		Environment: req.Environment,
-		VersionName: req.VersionName,
-		ReleaseType: entity.ReleaseType(req.ReleaseType),
-		CreatedBy:   req.CreatedBy,
+		NamespaceID:  req.NamespaceID,
+		Environment:  req.Environment,
+		VersionName:  req.VersionName,
+		ReleaseType:  entity.ReleaseType(req.ReleaseType),
+		ReleaseNotes: req.ReleaseNotes,
+		CreatedBy:    createdBy,
 	}
 
-	// 2. 调用领域服务创建发布版本
+	// 3. 调用领域服务创建发布版本
 	release, err := s.releaseDomainService.CreateRelease(ctx, domainReq)
 	if err != nil {
 		return nil, err
 	}
 
-	// 3. 转换为VO返回（包含配置快照）
+	// 4. 转换为VO返回（包含配置快照）
 	return s.converter.ToVO(release, true), nil
 }
 
@@ -54,8 +66,10 @@ func (s *ReleaseAppService) CreateRelease(ctx context.Context, req *request.Crea
 func (s *ReleaseAppService) PublishFull(ctx context.Context, req *request.PublishFullRequest) error {
 	// 转换请求并调用领域服务
 	domainReq := &domainService.PublishRequest{
-		ReleaseID:   req.ReleaseID,
-		PublishedBy: req.PublishedBy,
+		ReleaseID:    req.ReleaseID,
+		PublishedBy:  req.PublishedBy,
+		ReleaseNotes: req.ReleaseNotes,
+		Override:     toFreezeOverride(&req.FreezeOverrideFields),
 	}
 
 	return s.releaseDomainService.PublishFull(ctx, domainReq)
@@ -72,14 +86,64 @@ func (s *ReleaseAppService) PublishCanary(ctx context.Context, req *request.Publ
 
 	// 转换请求并调用领域服务
 	domainReq := &domainService.PublishCanaryRequest{
-		ReleaseID:   req.ReleaseID,
-		CanaryRule:  canaryRule,
-		PublishedBy: req.PublishedBy,
+		ReleaseID:    req.ReleaseID,
+		CanaryRule:   canaryRule,
+		PublishedBy:  req.PublishedBy,
+		ReleaseNotes: req.ReleaseNotes,
+		Override:     toFreezeOverride(&req.FreezeOverrideFields),
 	}
 
 	return s.releaseDomainService.PublishCanary(ctx, domainReq)
 }
 
+// ApproveRelease 审批通过发布版本
+func (s *ReleaseAppService) ApproveRelease(ctx context.Context, id int, req *request.ReviewReleaseRequest) (*vo.ReleaseVO, error) {
+	operator, err := resolveOperator(ctx, req.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := s.releaseDomainService.ApproveRelease(ctx, &domainService.ReviewReleaseRequest{
+		ReleaseID: id,
+		Operator:  operator,
+		Comment:   req.Comment,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ToVO(release, false), nil
+}
+
+// RejectRelease 驳回发布版本
+func (s *ReleaseAppService) RejectRelease(ctx context.Context, id int, req *request.ReviewReleaseRequest) (*vo.ReleaseVO, error) {
+	operator, err := resolveOperator(ctx, req.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := s.releaseDomainService.RejectRelease(ctx, &domainService.ReviewReleaseRequest{
+		ReleaseID: id,
+		Operator:  operator,
+		Comment:   req.Comment,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ToVO(release, false), nil
+}
+
+// ListApprovals 查询发布版本审批记录
+func (s *ReleaseAppService) ListApprovals(ctx context.Context, id int) ([]*vo.ReleaseApprovalVO, error) {
+	approvals, err := s.releaseDomainService.ListApprovals(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ToApprovalVOList(approvals), nil
+}
+
 // Rollback 回滚到指定版本
 func (s *ReleaseAppService) Rollback(ctx context.Context, req *request.ReleaseRollbackRequest) error {
 	// 转换请求并调用领域服务
@@ -88,11 +152,52 @@ func (s *ReleaseAppService) Rollback(ctx context.Context, req *request.ReleaseRo
 		TargetReleaseID:  req.TargetReleaseID,
 		RollbackBy:       req.RollbackBy,
 		Reason:           req.Reason,
+		Override:         toFreezeOverride(&req.FreezeOverrideFields),
 	}
 
 	return s.releaseDomainService.Rollback(ctx, domainReq)
 }
 
+// GetFreezeStatus 查询当前是否处于发布冻结窗口
+func (s *ReleaseAppService) GetFreezeStatus(ctx context.Context, req *request.FreezeStatusRequest) *vo.FreezeStatusVO {
+	status := &vo.FreezeStatusVO{
+		NamespaceID: req.NamespaceID,
+		Environment: req.Environment,
+	}
+
+	now := time.Now()
+	if window := s.releaseDomainService.FindActiveFreezeWindow(req.NamespaceID, req.Environment, now); window != nil {
+		endsAt := window.EndsAt(now)
+		status.Frozen = true
+		status.WindowName = window.Name
+		status.EndsAt = &endsAt
+	}
+	return status
+}
+
+// ListFreezeOverrides 查询冻结窗口覆盖记录
+func (s *ReleaseAppService) ListFreezeOverrides(ctx context.Context, req *request.ListFreezeOverridesRequest) ([]*vo.ReleaseFreezeOverrideVO, error) {
+	req.SetDefaults()
+
+	overrides, err := s.releaseDomainService.ListFreezeOverrides(ctx, req.NamespaceID, req.Environment, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ToFreezeOverrideVOList(overrides), nil
+}
+
+// toFreezeOverride 转换冻结窗口覆盖字段（未覆盖时返回 nil）
+func toFreezeOverride(fields *request.FreezeOverrideFields) *domainService.FreezeOverride {
+	if !fields.FreezeOverride {
+		return nil
+	}
+	return &domainService.FreezeOverride{
+		Enabled: true,
+		Reason:  fields.OverrideReason,
+	}
+}
+
 // GetReleaseByID 根据ID查询发布版本
 func (s *ReleaseAppService) GetReleaseByID(ctx context.Context, id int, includeSnapshot bool) (*vo.ReleaseVO, error) {
 	release, err := s.releaseDomainService.GetReleaseByID(ctx, id)
@@ -103,6 +208,16 @@ func (s *ReleaseAppService) GetReleaseByID(ctx context.Context, id int, includeS
 	return s.converter.ToVO(release, includeSnapshot), nil
 }
 
+// GetChangelog 获取发布版本变更日志
+func (s *ReleaseAppService) GetChangelog(ctx context.Context, id int) (*vo.ReleaseChangelogVO, error) {
+	release, changelog, err := s.releaseDomainService.GetChangelog(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ToChangelogVO(release, changelog), nil
+}
+
 // GetLatestPublishedRelease 获取最新已发布版本
 func (s *ReleaseAppService) GetLatestPublishedRelease(ctx context.Context, namespaceID int, environment string) (*vo.ReleaseVO, error) {
 	release, err := s.releaseDomainService.GetLatestPublishedRelease(ctx, namespaceID, environment)
@@ -131,6 +246,10 @@ func (s *ReleaseAppService) ListReleasesByNamespace(ctx context.Context, namespa
 func (s *ReleaseAppService) QueryReleases(ctx context.Context, req *request.QueryReleaseRequest) (*vo.ReleaseListVO, error) {
 	// 1. 设置默认值
 	req.SetDefaults()
+	cursor, err := req.PageCursor()
+	if err != nil {
+		return nil, domainErrors.ErrPageCursorInvalid()
+	}
 
 	// 2. 构建领域查询参数
 	params := &repository.ReleaseQueryParams{
@@ -142,6 +261,7 @@ func (s *ReleaseAppService) QueryReleases(ctx context.Context, req *request.Quer
 		Page:        req.Page,
 		Size:        req.Size,
 		OrderBy:     req.OrderBy,
+		Cursor:      cursor,
 	}
 
 	// 3. 调用领域服务查询
@@ -152,16 +272,29 @@ func (s *ReleaseAppService) QueryReleases(ctx context.Context, req *request.Quer
 
 	// 4. 转换为VO返回
 	return &vo.ReleaseListVO{
-		Items: s.converter.ToVOList(result.Items),
-		Total: result.Total,
-		Page:  result.Page,
-		Size:  result.Size,
+		Items:      s.converter.ToVOList(result.Items),
+		Total:      result.Total,
+		Page:       result.Page,
+		Size:       result.Size,
+		NextCursor: result.NextCursor,
 	}, nil
 }
 
 // CompareReleases 对比两个版本
 func (s *ReleaseAppService) CompareReleases(ctx context.Context, req *request.CompareReleasesRequest) (*vo.ReleaseCompareVO, error) {
-	// 调用领域服务对比版本
+	// 1. 与当前线上配置对比：预览发布目标版本会带来的变更
+	if req.Mode == request.CompareModeLive {
+		result, err := s.releaseDomainService.CompareWithLive(ctx, req.ToReleaseID)
+		if err != nil {
+			return nil, err
+		}
+		return s.converter.ToCompareVO(result), nil
+	}
+
+	// 2. 两个版本之间对比，必须指定源版本
+	if req.FromReleaseID <= 0 {
+		return nil, errors.ErrBadRequest("from_release_id 不能为空（mode=live 时可省略）")
+	}
 	result, err := s.releaseDomainService.CompareReleases(ctx, req.FromReleaseID, req.ToReleaseID)
 	if err != nil {
 		return nil, err
@@ -170,3 +303,21 @@ func (s *ReleaseAppService) CompareReleases(ctx context.Context, req *request.Co
 	// 转换为VO返回
 	return s.converter.ToCompareVO(result), nil
 }
+
+// resolveOperator 确定操作人
+// 启用认证时操作人取自认证主体（API Key 名称），请求中填写的操作人与之不一致时拒绝，避免冒用他人身份（如创建人自行审批）；
+// 未启用认证时使用请求中填写的操作人
+func resolveOperator(ctx context.Context, operator string) (string, error) {
+	operator = strings.TrimSpace(operator)
+	principal := middleware.PrincipalFromContext(ctx)
+	if principal == nil {
+		if operator == "" {
+			return "", errors.ErrBadRequest("未启用认证时必须填写操作人")
+		}
+		return operator, nil
+	}
+	if operator != "" && operator != principal.Name {
+		return "", domainErrors.ErrAPIKeyForbidden("操作人 " + operator + " 与 API Key " + principal.Name + " 不一致")
+	}
+	return principal.Name, nil
+}
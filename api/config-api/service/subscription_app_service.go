@@ -7,6 +7,8 @@ import (
 	"config-client/api/config-api/converter"
 	"config-client/api/config-api/dto/request"
 	"config-client/api/config-api/dto/vo"
+	"config-client/config/domain/constants"
+	"config-client/config/domain/entity"
 	"config-client/config/domain/repository"
 	domainService "config-client/config/domain/service"
 	"config-client/share/errors"
@@ -17,6 +19,7 @@ import (
 type SubscriptionAppService struct {
 	subscriptionRepo repository.SubscriptionRepository
 	subscriptionMgr  *domainService.SubscriptionManager
+	pushTraceSvc     *domainService.PushTraceService
 	systemConfigSvc  *domainService.SystemConfigService
 	converter        *converter.SubscriptionConverter
 }
@@ -25,12 +28,14 @@ type SubscriptionAppService struct {
 func NewSubscriptionAppService(
 	subscriptionRepo repository.SubscriptionRepository,
 	subscriptionMgr *domainService.SubscriptionManager,
+	pushTraceSvc *domainService.PushTraceService,
 	systemConfigSvc *domainService.SystemConfigService,
 	converter *converter.SubscriptionConverter,
 ) *SubscriptionAppService {
 	return &SubscriptionAppService{
 		subscriptionRepo: subscriptionRepo,
 		subscriptionMgr:  subscriptionMgr,
+		pushTraceSvc:     pushTraceSvc,
 		systemConfigSvc:  systemConfigSvc,
 		converter:        converter,
 	}
@@ -89,24 +94,196 @@ func (s *SubscriptionAppService) DeactivateSubscription(ctx context.Context, id
 	return s.converter.ToVO(updated), nil
 }
 
-// GetStatistics 获取订阅统计信息
-func (s *SubscriptionAppService) GetStatistics(ctx context.Context) (*vo.SubscriptionStatisticsVO, error) {
-	total, err := s.subscriptionRepo.CountAll(ctx)
+// GetSubscription 获取订阅详情（轮询次数、变更次数、最后心跳和当前实例上监听的配置键）
+func (s *SubscriptionAppService) GetSubscription(ctx context.Context, id int) (*vo.SubscriptionDetailVO, error) {
+	subscription, err := s.subscriptionRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if subscription == nil {
+		return nil, errors.ErrNotFound("订阅不存在")
+	}
 
-	active, err := s.subscriptionRepo.CountByActive(ctx, true)
+	return s.toDetailVO(subscription), nil
+}
+
+// GetClientSubscriptions 获取客户端在各命名空间和环境下的订阅及其监听的配置键
+func (s *SubscriptionAppService) GetClientSubscriptions(ctx context.Context, clientID string) (*vo.ClientSubscriptionsVO, error) {
+	subscriptions, err := s.subscriptionRepo.FindByClientID(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &vo.ClientSubscriptionsVO{
+		ClientID:      clientID,
+		Subscriptions: make([]*vo.SubscriptionDetailVO, 0, len(subscriptions)),
+	}
+	for _, subscription := range subscriptions {
+		result.Subscriptions = append(result.Subscriptions, s.toDetailVO(subscription))
+	}
+	return result, nil
+}
+
+// DeactivateClient 停用客户端的全部激活订阅（可按命名空间和环境过滤）
+// 停用后会断开当前实例上该客户端的长轮询，客户端重新轮询时订阅恢复激活
+func (s *SubscriptionAppService) DeactivateClient(ctx context.Context, req *request.DeactivateClientRequest) (*vo.DeactivateClientVO, error) {
+	subscriptions, err := s.subscriptionRepo.FindByClientID(ctx, req.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	deactivated := make([]*vo.SubscriptionVO, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		if !subscription.IsActive ||
+			(req.NamespaceID != nil && subscription.NamespaceID != *req.NamespaceID) ||
+			(req.Environment != nil && subscription.Environment != *req.Environment) {
+			continue
+		}
+
+		if err := s.subscriptionRepo.Deactivate(ctx, subscription.ID); err != nil {
+			return nil, err
+		}
+		if s.subscriptionMgr != nil {
+			_ = s.subscriptionMgr.Unsubscribe(subscription.ClientID, subscription.NamespaceID, subscription.Environment)
+		}
+		subscription.Deactivate()
+		deactivated = append(deactivated, s.converter.ToVO(subscription))
+	}
+
+	return &vo.DeactivateClientVO{
+		ClientID:      req.ClientID,
+		Deactivated:   len(deactivated),
+		Subscriptions: deactivated,
+	}, nil
+}
+
+// Heartbeat 更新客户端订阅心跳（两次长轮询之间保持订阅活跃）
+func (s *SubscriptionAppService) Heartbeat(ctx context.Context, req *request.SubscriptionHeartbeatRequest) (*vo.SubscriptionHeartbeatVO, error) {
+	if s.subscriptionMgr == nil {
+		return nil, errors.ErrInternal("订阅管理器未初始化", nil)
+	}
+	environment := req.Environment
+	if environment == "" {
+		environment = constants.EnvDefault
+	}
+
+	subscription, err := s.subscriptionMgr.UpdateHeartbeat(ctx, req.ClientID, req.NamespaceID, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vo.SubscriptionHeartbeatVO{
+		SubscriptionID:          subscription.ID,
+		IsActive:                subscription.IsActive,
+		LastHeartbeatAt:         subscription.LastHeartbeatAt,
+		HeartbeatCount:          subscription.HeartbeatCount,
+		HeartbeatTimeoutSeconds: int(s.heartbeatTimeout() / time.Second),
+	}, nil
+}
+
+// Unsubscribe 客户端主动取消订阅（SDK 关闭时调用），立即停用订阅记录，不必等待心跳超时
+func (s *SubscriptionAppService) Unsubscribe(ctx context.Context, req *request.UnsubscribeRequest) (*vo.SubscriptionVO, error) {
+	if s.subscriptionMgr == nil {
+		return nil, errors.ErrInternal("订阅管理器未初始化", nil)
+	}
+	environment := req.Environment
+	if environment == "" {
+		environment = constants.EnvDefault
+	}
+
+	subscription, err := s.subscriptionMgr.Deactivate(ctx, req.ClientID, req.NamespaceID, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ToVO(subscription), nil
+}
+
+// GetListenerStatus 查询监听指定配置键的客户端及其版本是否与服务端一致
+// 用于确认配置变更已下发到全部客户端
+func (s *SubscriptionAppService) GetListenerStatus(ctx context.Context, req *request.GetListenerStatusRequest) (*vo.ListenerStatusVO, error) {
+	if s.subscriptionMgr == nil {
+		return nil, errors.ErrInternal("订阅管理器未初始化", nil)
+	}
+	environment := req.Environment
+	if environment == "" {
+		environment = constants.EnvDefault
+	}
+
+	status, err := s.subscriptionMgr.GetListenerStatus(ctx, req.NamespaceID, req.Key, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ToListenerStatusVO(status), nil
+}
+
+// QueryPushTraces 分页查询变更通知下发记录，并统计客户端确认情况
+func (s *SubscriptionAppService) QueryPushTraces(ctx context.Context, req *request.QueryPushTraceRequest) (*vo.PushTraceListVO, error) {
+	if s.pushTraceSvc == nil {
+		return nil, errors.ErrInternal("通知下发追踪未启用", nil)
+	}
+	req.SetDefaults()
+
+	result, err := s.pushTraceSvc.Query(ctx, &repository.PushTraceQueryParams{
+		ReleaseID:   req.ReleaseID,
+		ClientID:    req.ClientID,
+		NamespaceID: req.NamespaceID,
+		ConfigKey:   req.Key,
+		Environment: req.Environment,
+		Acked:       req.Acked,
+		Page:        req.Page,
+		Size:        req.Size,
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	return &vo.PushTraceListVO{
+		Total:    result.Page.Total,
+		Page:     result.Page.Page,
+		PageSize: result.Page.Size,
+		Summary: &vo.PushTraceSummaryVO{
+			Pushed:  result.All,
+			Acked:   result.Acked,
+			Pending: result.All - result.Acked,
+		},
+		Traces: s.converter.ToPushTraceVOList(result.Page.Items),
+	}, nil
+}
+
+// toDetailVO 组装订阅详情（合并当前实例内存中的活跃订阅者状态）
+func (s *SubscriptionAppService) toDetailVO(subscription *entity.Subscription) *vo.SubscriptionDetailVO {
+	var active *domainService.ActiveSubscriberInfo
+	if s.subscriptionMgr != nil {
+		active = s.subscriptionMgr.GetActiveSubscriber(subscription.ClientID, subscription.NamespaceID, subscription.Environment)
+	}
+	return s.converter.ToDetailVO(subscription, active, s.heartbeatTimeout())
+}
+
+// heartbeatTimeout 获取心跳超时阈值
+func (s *SubscriptionAppService) heartbeatTimeout() time.Duration {
 	heartbeatTimeout := domainService.DefaultHeartbeatTimeout
 	if s.systemConfigSvc != nil {
 		heartbeatTimeout = s.systemConfigSvc.GetHeartbeatTimeout()
 	}
+	return time.Duration(heartbeatTimeout) * time.Second
+}
+
+// GetStatistics 获取订阅统计信息
+func (s *SubscriptionAppService) GetStatistics(ctx context.Context) (*vo.SubscriptionStatisticsVO, error) {
+	total, err := s.subscriptionRepo.CountAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	active, err := s.subscriptionRepo.CountByActive(ctx, true)
+	if err != nil {
+		return nil, err
+	}
 
-	expireTime := time.Now().Add(-time.Duration(heartbeatTimeout) * time.Second)
+	heartbeatTimeout := s.heartbeatTimeout()
+	expireTime := time.Now().Add(-heartbeatTimeout)
 	expired, err := s.subscriptionRepo.CountExpired(ctx, expireTime)
 	if err != nil {
 		return nil, err
@@ -123,6 +300,6 @@ func (s *SubscriptionAppService) GetStatistics(ctx context.Context) (*vo.Subscri
 		Inactive:                total - active,
 		Expired:                 expired,
 		ActiveInMemory:          activeInMemory,
-		HeartbeatTimeoutSeconds: heartbeatTimeout,
+		HeartbeatTimeoutSeconds: int(heartbeatTimeout / time.Second),
 	}, nil
 }
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+
+	"config-client/api/config-api/converter"
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/dto/vo"
+	domainService "config-client/config/domain/service"
+)
+
+// SystemConfigAppService 系统配置应用服务
+// 负责协调系统配置领域服务和数据转换
+type SystemConfigAppService struct {
+	systemConfigDomainService *domainService.SystemConfigService
+	converter                 *converter.SystemConfigConverter
+}
+
+// NewSystemConfigAppService 创建系统配置应用服务
+func NewSystemConfigAppService(
+	systemConfigDomainService *domainService.SystemConfigService,
+	converter *converter.SystemConfigConverter,
+) *SystemConfigAppService {
+	return &SystemConfigAppService{
+		systemConfigDomainService: systemConfigDomainService,
+		converter:                 converter,
+	}
+}
+
+// ListSystemConfigs 查询全部系统配置（包括禁用的）
+func (s *SystemConfigAppService) ListSystemConfigs(ctx context.Context) ([]*vo.SystemConfigVO, error) {
+	configs, err := s.systemConfigDomainService.GetAllConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToVOList(configs), nil
+}
+
+// GetSystemConfig 根据配置键查询系统配置
+func (s *SystemConfigAppService) GetSystemConfig(ctx context.Context, key string) (*vo.SystemConfigVO, error) {
+	config, err := s.systemConfigDomainService.GetConfigByKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToVO(config), nil
+}
+
+// SetSystemConfig 设置系统配置（不存在时创建）
+func (s *SystemConfigAppService) SetSystemConfig(ctx context.Context, key string, req *request.SetSystemConfigRequest) (*vo.SystemConfigVO, error) {
+	if err := s.systemConfigDomainService.SetConfig(ctx, key, req.Value, req.Description); err != nil {
+		return nil, err
+	}
+	return s.GetSystemConfig(ctx, key)
+}
+
+// SetSystemConfigActive 启用或禁用系统配置
+func (s *SystemConfigAppService) SetSystemConfigActive(ctx context.Context, key string, active bool) (*vo.SystemConfigVO, error) {
+	var err error
+	if active {
+		err = s.systemConfigDomainService.ActivateConfig(ctx, key)
+	} else {
+		err = s.systemConfigDomainService.DeactivateConfig(ctx, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.GetSystemConfig(ctx, key)
+}
+
+// RefreshCache 从数据库重新加载系统配置缓存（直接修改数据库后调用）
+func (s *SystemConfigAppService) RefreshCache(ctx context.Context) error {
+	return s.systemConfigDomainService.RefreshCache(ctx)
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+
+	"config-client/api/config-api/converter"
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/dto/vo"
+	"config-client/config/domain/entity"
+	domainService "config-client/config/domain/service"
+)
+
+// TenantAppService 租户应用服务
+// 负责协调租户领域服务和数据转换
+type TenantAppService struct {
+	tenantDomainService *domainService.TenantService
+	converter           *converter.TenantConverter
+}
+
+// NewTenantAppService 创建租户应用服务
+func NewTenantAppService(
+	tenantDomainService *domainService.TenantService,
+	converter *converter.TenantConverter,
+) *TenantAppService {
+	return &TenantAppService{
+		tenantDomainService: tenantDomainService,
+		converter:           converter,
+	}
+}
+
+// CreateTenant 创建租户
+func (s *TenantAppService) CreateTenant(ctx context.Context, req *request.CreateTenantRequest) (*vo.TenantVO, error) {
+	t := &entity.Tenant{
+		Code:        req.Code,
+		Name:        req.Name,
+		Description: req.Description,
+		CreatedBy:   req.CreatedBy,
+	}
+	if err := s.tenantDomainService.CreateTenant(ctx, t); err != nil {
+		return nil, err
+	}
+	return s.converter.ToVO(t), nil
+}
+
+// UpdateTenant 更新租户
+func (s *TenantAppService) UpdateTenant(ctx context.Context, req *request.UpdateTenantRequest) (*vo.TenantVO, error) {
+	t, err := s.tenantDomainService.UpdateTenant(ctx, &entity.Tenant{
+		ID:          req.ID,
+		Name:        req.Name,
+		Description: req.Description,
+		IsActive:    req.IsActive,
+		UpdatedBy:   req.UpdatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToVO(t), nil
+}
+
+// GetTenant 根据ID查询租户
+func (s *TenantAppService) GetTenant(ctx context.Context, id int) (*vo.TenantVO, error) {
+	t, err := s.tenantDomainService.GetTenant(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToVO(t), nil
+}
+
+// ListTenants 查询全部租户
+func (s *TenantAppService) ListTenants(ctx context.Context) ([]*vo.TenantVO, error) {
+	tenants, err := s.tenantDomainService.ListTenants(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToVOList(tenants), nil
+}
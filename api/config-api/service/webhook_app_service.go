@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+
+	"config-client/api/config-api/converter"
+	"config-client/api/config-api/dto/request"
+	"config-client/api/config-api/dto/vo"
+	"config-client/config/domain/entity"
+	"config-client/config/domain/repository"
+	domainService "config-client/config/domain/service"
+)
+
+// WebhookAppService Webhook 应用服务
+// 负责协调 Webhook 领域服务和数据转换
+type WebhookAppService struct {
+	webhookDomainService *domainService.WebhookService
+	converter            *converter.WebhookConverter
+}
+
+// NewWebhookAppService 创建 Webhook 应用服务
+func NewWebhookAppService(
+	webhookDomainService *domainService.WebhookService,
+	converter *converter.WebhookConverter,
+) *WebhookAppService {
+	return &WebhookAppService{
+		webhookDomainService: webhookDomainService,
+		converter:            converter,
+	}
+}
+
+// CreateWebhook 创建 Webhook
+func (s *WebhookAppService) CreateWebhook(ctx context.Context, req *request.CreateWebhookRequest) (*vo.WebhookVO, error) {
+	// 1. 构建领域实体（未指定时默认启用）
+	webhook := &entity.Webhook{
+		NamespaceID: req.NamespaceID,
+		Name:        req.Name,
+		URL:         req.URL,
+		Secret:      req.Secret,
+		Events:      req.Events,
+		Enabled:     req.Enabled == nil || *req.Enabled,
+		CreatedBy:   req.CreatedBy,
+	}
+
+	// 2. 调用领域服务创建
+	if err := s.webhookDomainService.CreateWebhook(ctx, webhook); err != nil {
+		return nil, err
+	}
+
+	return s.converter.ToVO(webhook), nil
+}
+
+// UpdateWebhook 更新 Webhook
+func (s *WebhookAppService) UpdateWebhook(ctx context.Context, req *request.UpdateWebhookRequest) (*vo.WebhookVO, error) {
+	webhook := &entity.Webhook{
+		ID:        req.ID,
+		Name:      req.Name,
+		URL:       req.URL,
+		Secret:    req.Secret,
+		Events:    req.Events,
+		Enabled:   req.Enabled,
+		UpdatedBy: req.UpdatedBy,
+	}
+	if err := s.webhookDomainService.UpdateWebhook(ctx, webhook); err != nil {
+		return nil, err
+	}
+
+	return s.converter.ToVO(webhook), nil
+}
+
+// DeleteWebhook 删除 Webhook
+func (s *WebhookAppService) DeleteWebhook(ctx context.Context, id int) error {
+	return s.webhookDomainService.DeleteWebhook(ctx, id)
+}
+
+// GetWebhook 根据ID查询 Webhook
+func (s *WebhookAppService) GetWebhook(ctx context.Context, id int) (*vo.WebhookVO, error) {
+	webhook, err := s.webhookDomainService.GetWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToVO(webhook), nil
+}
+
+// ListWebhooks 查询命名空间下的 Webhook
+func (s *WebhookAppService) ListWebhooks(ctx context.Context, req *request.ListWebhooksRequest) ([]*vo.WebhookVO, error) {
+	webhooks, err := s.webhookDomainService.ListWebhooks(ctx, req.NamespaceID)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToVOList(webhooks), nil
+}
+
+// QueryDeliveries 分页查询 Webhook 投递记录
+func (s *WebhookAppService) QueryDeliveries(ctx context.Context, webhookID int, req *request.QueryWebhookDeliveriesRequest) (*vo.WebhookDeliveryListVO, error) {
+	req.SetDefaults()
+
+	result, err := s.webhookDomainService.QueryDeliveries(ctx, &repository.WebhookDeliveryQueryParams{
+		WebhookID: webhookID,
+		Status:    req.Status,
+		EventType: req.EventType,
+		Page:      req.Page,
+		Size:      req.Size,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vo.WebhookDeliveryListVO{
+		Total:      result.Total,
+		Page:       result.Page,
+		PageSize:   result.Size,
+		Deliveries: s.converter.ToDeliveryVOList(result.Items),
+	}, nil
+}
+
+// GetDelivery 根据ID查询投递记录
+func (s *WebhookAppService) GetDelivery(ctx context.Context, id int64) (*vo.WebhookDeliveryVO, error) {
+	delivery, err := s.webhookDomainService.GetDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToDeliveryVO(delivery), nil
+}
+
+// Redeliver 重新投递
+func (s *WebhookAppService) Redeliver(ctx context.Context, id int64) (*vo.WebhookDeliveryVO, error) {
+	delivery, err := s.webhookDomainService.Redeliver(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.converter.ToDeliveryVO(delivery), nil
+}
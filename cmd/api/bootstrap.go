@@ -0,0 +1,253 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	domainEntity "config-client/config/domain/entity"
+	domainErrors "config-client/config/domain/errors"
+	infraRepository "config-client/config/infrastructure/repository"
+	shareErrors "config-client/share/errors"
+	"config-client/share/tenant"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gopkg.in/yaml.v3"
+)
+
+// bootstrapOperator 种子数据的创建人
+const bootstrapOperator = "bootstrap"
+
+// seedFile 种子文件（bootstrap.seed_file）
+// 首次启动时按种子文件创建租户、命名空间、系统配置和初始 API Key，已存在的数据不会被覆盖，重复启动是幂等的
+// 命名空间和 API Key 创建在默认租户下
+type seedFile struct {
+	Tenants       []seedTenant       `yaml:"tenants"`
+	Namespaces    []seedNamespace    `yaml:"namespaces"`
+	SystemConfigs []seedSystemConfig `yaml:"system_configs"`
+	APIKeys       []seedAPIKey       `yaml:"api_keys"`
+}
+
+// seedTenant 种子租户
+type seedTenant struct {
+	Code        string `yaml:"code"`
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+}
+
+// seedNamespace 种子命名空间
+type seedNamespace struct {
+	Name        string `yaml:"name"`
+	DisplayName string `yaml:"display_name"`
+	Description string `yaml:"description"`
+}
+
+// seedSystemConfig 种子系统配置（如长轮询超时、心跳间隔）
+type seedSystemConfig struct {
+	Key         string `yaml:"key"`
+	Value       string `yaml:"value"`
+	Description string `yaml:"description"`
+}
+
+// seedAPIKey 种子 API Key
+// 密钥优先取 key_env 指定的环境变量，其次取 key，都为空时生成随机密钥并在日志中输出一次
+// reader 角色（只读令牌）通过 namespace、environment、key_prefixes 指定读取范围
+type seedAPIKey struct {
+	Name        string     `yaml:"name"`
+	Role        string     `yaml:"role"`
+	Namespace   string     `yaml:"namespace"`
+	Environment string     `yaml:"environment"`
+	KeyPrefixes []string   `yaml:"key_prefixes"`
+	Description string     `yaml:"description"`
+	Key         string     `yaml:"key"`
+	KeyEnv      string     `yaml:"key_env"`
+	ExpiresAt   *time.Time `yaml:"expires_at"`
+}
+
+// initBootstrap 加载种子文件并创建初始数据（未配置 bootstrap.seed_file 时跳过）
+// 需在 initTenants、initAPIKeys 之后、initSystemConfig 之前执行，种子文件中的系统配置优先于内置默认值
+func initBootstrap() error {
+	path := cfg.Bootstrap.SeedFile
+	if path == "" {
+		return nil
+	}
+
+	// 1. 读取种子文件
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("读取种子文件失败: %w", err)
+	}
+	var seed seedFile
+	if err := yaml.Unmarshal(data, &seed); err != nil {
+		return fmt.Errorf("解析种子文件失败: %w", err)
+	}
+
+	// 2. 依次创建租户、命名空间、系统配置、API Key
+	ctx := tenant.WithTenant(context.Background(), tenant.DefaultTenantID)
+	if err := seedTenants(ctx, seed.Tenants); err != nil {
+		return err
+	}
+	if err := seedNamespaces(ctx, seed.Namespaces); err != nil {
+		return err
+	}
+	if err := seedSystemConfigs(ctx, seed.SystemConfigs); err != nil {
+		return err
+	}
+	if err := seedAPIKeys(ctx, seed.APIKeys); err != nil {
+		return err
+	}
+
+	hlog.Infof("种子文件加载完成: %s", path)
+	return nil
+}
+
+// seedTenants 创建不存在的租户
+func seedTenants(ctx context.Context, tenants []seedTenant) error {
+	for _, item := range tenants {
+		t := &domainEntity.Tenant{
+			Code:        item.Code,
+			Name:        item.Name,
+			Description: item.Description,
+			CreatedBy:   bootstrapOperator,
+		}
+		if err := tenantService.CreateTenant(ctx, t); err != nil {
+			if appErr, ok := shareErrors.AsAppError(err); ok && appErr.Code == domainErrors.TenantConflict {
+				continue // 同编码租户已存在
+			}
+			return fmt.Errorf("创建租户失败: code=%s, err=%w", item.Code, err)
+		}
+	}
+	return nil
+}
+
+// seedNamespaces 创建不存在的命名空间
+func seedNamespaces(ctx context.Context, namespaces []seedNamespace) error {
+	if len(namespaces) == 0 {
+		return nil
+	}
+
+	namespaceRepo := infraRepository.NewNamespaceRepository(db)
+	for _, item := range namespaces {
+		exists, err := namespaceRepo.ExistsByName(ctx, item.Name)
+		if err != nil {
+			return fmt.Errorf("检查命名空间是否存在失败: name=%s, err=%w", item.Name, err)
+		}
+		if exists {
+			continue
+		}
+
+		namespace := &domainEntity.Namespace{
+			Name:        item.Name,
+			DisplayName: item.DisplayName,
+			Description: item.Description,
+			IsActive:    true,
+			Metadata:    "{}",
+		}
+		if namespace.DisplayName == "" {
+			namespace.DisplayName = namespace.Name
+		}
+		namespace.CreatedBy = bootstrapOperator
+		namespace.UpdatedBy = bootstrapOperator
+		if err := namespaceRepo.Create(ctx, namespace); err != nil {
+			return fmt.Errorf("创建命名空间失败: name=%s, err=%w", item.Name, err)
+		}
+		hlog.Infof("已创建种子命名空间: id=%d, name=%s", namespace.ID, namespace.Name)
+	}
+	return nil
+}
+
+// seedSystemConfigs 创建不存在的系统配置
+func seedSystemConfigs(ctx context.Context, configs []seedSystemConfig) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	systemConfigRepo := infraRepository.NewSystemConfigRepository(db)
+	for _, item := range configs {
+		if item.Key == "" {
+			return fmt.Errorf("种子系统配置缺少 key")
+		}
+		exists, err := systemConfigRepo.ExistsByKey(ctx, item.Key)
+		if err != nil {
+			return fmt.Errorf("检查系统配置是否存在失败: key=%s, err=%w", item.Key, err)
+		}
+		if exists {
+			continue
+		}
+
+		systemConfig := &domainEntity.SystemConfig{
+			ConfigKey:   item.Key,
+			ConfigValue: item.Value,
+			Description: item.Description,
+			IsActive:    true,
+		}
+		if err := systemConfigRepo.Create(ctx, systemConfig); err != nil {
+			return fmt.Errorf("创建系统配置失败: key=%s, err=%w", item.Key, err)
+		}
+		hlog.Infof("已创建种子系统配置: key=%s, value=%s", item.Key, item.Value)
+	}
+	return nil
+}
+
+// seedAPIKeys 创建不存在的 API Key
+// 已存在同名 API Key 时跳过；管理员 API Key 仅在没有可用的管理员 API Key 时创建，吊销全部管理员密钥后重启可重新签发
+func seedAPIKeys(ctx context.Context, keys []seedAPIKey) error {
+	namespaceRepo := infraRepository.NewNamespaceRepository(db)
+	for _, item := range keys {
+		role := item.Role
+		if role == "" {
+			role = domainEntity.APIKeyRoleAdmin
+		}
+		if role == domainEntity.APIKeyRoleAdmin {
+			hasAdmin, err := apiKeyService.HasUsableKey(ctx, role)
+			if err != nil {
+				return fmt.Errorf("检查管理员 API Key 失败: %w", err)
+			}
+			if hasAdmin {
+				continue
+			}
+		}
+
+		plaintext := item.Key
+		if item.KeyEnv != "" {
+			plaintext = os.Getenv(item.KeyEnv)
+		}
+
+		key := &domainEntity.APIKey{
+			Name:        item.Name,
+			Role:        role,
+			Environment: item.Environment,
+			KeyPrefixes: item.KeyPrefixes,
+			Description: item.Description,
+			ExpiresAt:   item.ExpiresAt,
+			CreatedBy:   bootstrapOperator,
+		}
+		if item.Namespace != "" {
+			namespace, err := namespaceRepo.FindByName(ctx, item.Namespace)
+			if err != nil {
+				return fmt.Errorf("查询命名空间失败: name=%s, err=%w", item.Namespace, err)
+			}
+			if namespace == nil {
+				return fmt.Errorf("种子 API Key 引用的命名空间不存在: key=%s, namespace=%s", item.Name, item.Namespace)
+			}
+			key.NamespaceID = namespace.ID
+		}
+		generated := plaintext == ""
+		plaintext, err := apiKeyService.CreateAPIKey(ctx, key, plaintext)
+		if err != nil {
+			if appErr, ok := shareErrors.AsAppError(err); ok && appErr.Code == domainErrors.APIKeyConflict {
+				continue // 同名 API Key 已存在
+			}
+			return fmt.Errorf("创建 API Key 失败: name=%s, err=%w", item.Name, err)
+		}
+
+		if generated {
+			// 生成的密钥只输出这一次，服务端不保存明文
+			hlog.Warnf("已生成初始 API Key（仅显示一次，请妥善保存）: name=%s, role=%s, key=%s", key.Name, key.Role, plaintext)
+		} else {
+			hlog.Infof("已创建种子 API Key: name=%s, role=%s, prefix=%s", key.Name, key.Role, key.Prefix)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"embed"
+	"io/fs"
+	"mime"
+	"path"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// consoleAssets 管理控制台静态资源（单页应用，无需构建步骤）
+//
+//go:embed console
+var consoleAssets embed.FS
+
+// registerConsoleRoutes 注册 Web 管理控制台路由
+// 仅在 server.console.enabled 为 true 时注册；页面使用 hash 路由，直接调用 /api/v1 接口
+func registerConsoleRoutes() {
+	if !cfg.Server.Console.Enabled {
+		return
+	}
+
+	assets, err := fs.Sub(consoleAssets, "console")
+	if err != nil {
+		panic(err)
+	}
+
+	hertzH.GET("/console", func(c context.Context, ctx *app.RequestContext) {
+		ctx.Redirect(consts.StatusMovedPermanently, []byte("/console/"))
+	})
+	hertzH.GET("/console/*filepath", serveConsoleAsset(assets))
+
+	hlog.Info("管理控制台已开启: /console/")
+}
+
+// serveConsoleAsset 返回控制台静态资源，未知路径回退到 index.html
+func serveConsoleAsset(assets fs.FS) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		name := strings.TrimPrefix(path.Clean("/"+ctx.Param("filepath")), "/")
+		if name == "" {
+			name = "index.html"
+		}
+
+		data, err := fs.ReadFile(assets, name)
+		if err != nil {
+			name = "index.html"
+			if data, err = fs.ReadFile(assets, name); err != nil {
+				ctx.String(consts.StatusInternalServerError, err.Error())
+				return
+			}
+		}
+
+		contentType := mime.TypeByExtension(path.Ext(name))
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		// 静态资源随服务端二进制发布，禁止缓存以免升级后页面与接口不一致
+		ctx.Response.Header.Set("Cache-Control", "no-cache")
+		ctx.Data(consts.StatusOK, contentType, data)
+	}
+}
@@ -0,0 +1,522 @@
+// 配置中心管理控制台
+// 单页应用，使用 hash 路由：
+//   #/                      命名空间列表
+//   #/ns/{id}/configs       配置列表与编辑
+//   #/ns/{id}/releases      发布版本列表与对比
+//   #/ns/{id}/subscribers   订阅客户端
+(function () {
+  'use strict';
+
+  const view = document.getElementById('view');
+  const tabs = document.getElementById('tabs');
+  const message = document.getElementById('message');
+  const envSelect = document.getElementById('environment');
+  const operatorInput = document.getElementById('operator');
+  const apiKeyInput = document.getElementById('api-key');
+  const editor = document.getElementById('editor');
+  const editorForm = document.getElementById('editor-form');
+
+  const state = {
+    namespaces: null, // 命名空间缓存（id -> 命名空间）
+    routeSeq: 0       // 路由序号，丢弃过期页面的异步结果
+  };
+
+  // ==================== 通用工具 ====================
+
+  function escapeHTML(value) {
+    return String(value === undefined || value === null ? '' : value)
+      .replace(/&/g, '&amp;')
+      .replace(/</g, '&lt;')
+      .replace(/>/g, '&gt;')
+      .replace(/"/g, '&quot;')
+      .replace(/'/g, '&#39;');
+  }
+
+  function formatTime(value) {
+    if (!value) {
+      return '-';
+    }
+    const d = new Date(value);
+    return isNaN(d.getTime()) ? value : d.toLocaleString('zh-CN', { hour12: false });
+  }
+
+  function showMessage(text, info) {
+    message.textContent = text;
+    message.className = info ? 'info' : '';
+    message.hidden = false;
+    if (info) {
+      setTimeout(function () { message.hidden = true; }, 3000);
+    }
+  }
+
+  function clearMessage() {
+    message.hidden = true;
+  }
+
+  function environment() {
+    return envSelect.value;
+  }
+
+  function operator() {
+    return operatorInput.value.trim();
+  }
+
+  function requireOperator() {
+    if (!operator()) {
+      showMessage('请先在右上角填写操作人（记录到变更历史）');
+      operatorInput.focus();
+      return false;
+    }
+    return true;
+  }
+
+  // api 调用配置中心接口，返回响应中的 data；业务错误（code 非 0）抛出异常
+  async function api(method, url, body) {
+    const init = { method: method, headers: { 'Accept': 'application/json' } };
+    if (body !== undefined) {
+      init.headers['Content-Type'] = 'application/json';
+      init.body = JSON.stringify(body);
+    }
+    const apiKey = apiKeyInput.value.trim();
+    if (apiKey) {
+      init.headers['Authorization'] = 'Bearer ' + apiKey;
+    }
+    const resp = await fetch(url, init);
+    let payload;
+    try {
+      payload = await resp.json();
+    } catch (e) {
+      throw new Error('HTTP ' + resp.status);
+    }
+    if (payload.code !== 0) {
+      throw new Error(payload.message || ('HTTP ' + resp.status));
+    }
+    return payload.data;
+  }
+
+  function query(params) {
+    const search = new URLSearchParams();
+    Object.keys(params).forEach(function (k) {
+      if (params[k] !== undefined && params[k] !== null && params[k] !== '') {
+        search.set(k, params[k]);
+      }
+    });
+    return search.toString();
+  }
+
+  async function loadNamespaces(force) {
+    if (!state.namespaces || force) {
+      const list = await api('GET', '/api/v1/namespaces/all');
+      state.namespaces = {};
+      (list || []).forEach(function (ns) { state.namespaces[ns.id] = ns; });
+    }
+    return state.namespaces;
+  }
+
+  function renderTabs(nsID, active) {
+    if (!nsID) {
+      tabs.innerHTML = '';
+      return;
+    }
+    const items = [
+      ['configs', '配置'],
+      ['releases', '发布版本'],
+      ['subscribers', '订阅客户端']
+    ];
+    tabs.innerHTML = items.map(function (item) {
+      return '<a href="#/ns/' + nsID + '/' + item[0] + '"' +
+        (item[0] === active ? ' class="active"' : '') + '>' + item[1] + '</a>';
+    }).join('');
+  }
+
+  function badge(ok, yes, no) {
+    return ok ? '<span class="badge ok">' + yes + '</span>' : '<span class="badge off">' + no + '</span>';
+  }
+
+  // ==================== 路由 ====================
+
+  async function route() {
+    const seq = ++state.routeSeq;
+    clearMessage();
+
+    const hash = location.hash.replace(/^#/, '') || '/';
+    const m = hash.match(/^\/ns\/(\d+)\/(configs|releases|subscribers)(?:\?(.*))?$/);
+
+    try {
+      if (!m) {
+        renderTabs(null);
+        await renderNamespaces(seq);
+        return;
+      }
+      const nsID = parseInt(m[1], 10);
+      const params = new URLSearchParams(m[3] || '');
+      renderTabs(nsID, m[2]);
+      if (m[2] === 'configs') {
+        await renderConfigs(seq, nsID, params);
+      } else if (m[2] === 'releases') {
+        await renderReleases(seq, nsID);
+      } else {
+        await renderSubscribers(seq, nsID);
+      }
+    } catch (e) {
+      if (seq === state.routeSeq) {
+        showMessage(e.message);
+      }
+    }
+  }
+
+  function stale(seq) {
+    return seq !== state.routeSeq;
+  }
+
+  async function namespaceTitle(nsID) {
+    const namespaces = await loadNamespaces();
+    const ns = namespaces[nsID];
+    return ns ? escapeHTML(ns.display_name || ns.name) + ' <small>(' + escapeHTML(ns.name) + ')</small>' : '命名空间 #' + nsID;
+  }
+
+  // ==================== 命名空间 ====================
+
+  async function renderNamespaces(seq) {
+    const namespaces = await loadNamespaces(true);
+    if (stale(seq)) {
+      return;
+    }
+
+    const rows = Object.keys(namespaces).map(function (id) {
+      const ns = namespaces[id];
+      return '<tr class="clickable" data-id="' + ns.id + '">' +
+        '<td>' + ns.id + '</td>' +
+        '<td>' + escapeHTML(ns.name) + '</td>' +
+        '<td>' + escapeHTML(ns.display_name) + '</td>' +
+        '<td>' + escapeHTML(ns.description) + '</td>' +
+        '<td>' + badge(ns.is_active, '激活', '停用') + '</td>' +
+        '<td>' + formatTime(ns.updated_at) + '</td>' +
+        '</tr>';
+    });
+
+    view.innerHTML = '<h2>命名空间</h2>' +
+      '<table><thead><tr><th>ID</th><th>名称</th><th>显示名称</th><th>描述</th><th>状态</th><th>更新时间</th></tr></thead>' +
+      '<tbody>' + (rows.join('') || '<tr><td colspan="6" class="empty">暂无命名空间</td></tr>') + '</tbody></table>';
+
+    view.querySelectorAll('tr[data-id]').forEach(function (tr) {
+      tr.addEventListener('click', function () {
+        location.hash = '#/ns/' + tr.dataset.id + '/configs';
+      });
+    });
+  }
+
+  // ==================== 配置 ====================
+
+  async function renderConfigs(seq, nsID, params) {
+    const key = params.get('key') || '';
+    const page = parseInt(params.get('page') || '1', 10);
+    const data = await api('GET', '/api/v1/configs?' + query({
+      namespace_id: nsID,
+      environment: environment(),
+      key: key,
+      page: page,
+      size: 50,
+      order_by: 'key asc'
+    }));
+    const title = await namespaceTitle(nsID);
+    if (stale(seq)) {
+      return;
+    }
+
+    const items = data.items || [];
+    const rows = items.map(function (c, i) {
+      return '<tr class="clickable" data-index="' + i + '">' +
+        '<td>' + escapeHTML(c.key) + '</td>' +
+        '<td class="value" title="' + escapeHTML(c.value) + '">' + escapeHTML(c.value) + '</td>' +
+        '<td>' + escapeHTML(c.value_type) + '</td>' +
+        '<td>' + escapeHTML(c.group_name) + '</td>' +
+        '<td>' + c.version + '</td>' +
+        '<td>' + (c.is_released ? '<span class="badge ok">已发布</span>' : '<span class="badge warn">未发布</span>') + '</td>' +
+        '<td>' + escapeHTML(c.updated_by) + '</td>' +
+        '<td>' + formatTime(c.updated_at) + '</td>' +
+        '<td><button class="danger" data-delete="' + i + '">删除</button></td>' +
+        '</tr>';
+    });
+
+    view.innerHTML = '<h2>' + title + ' / 配置</h2>' +
+      '<div class="toolbar">' +
+      '<input id="key-filter" placeholder="按配置键过滤" value="' + escapeHTML(key) + '">' +
+      '<button id="key-search">查询</button>' +
+      '<span class="spacer"></span>' +
+      '<span>共 ' + data.total + ' 项</span>' +
+      '<button id="prev-page"' + (page <= 1 ? ' disabled' : '') + '>上一页</button>' +
+      '<span>' + page + ' / ' + Math.max(data.total_pages, 1) + '</span>' +
+      '<button id="next-page"' + (page >= data.total_pages ? ' disabled' : '') + '>下一页</button>' +
+      '<button id="new-config" class="primary">新建配置</button>' +
+      '</div>' +
+      '<table><thead><tr><th>配置键</th><th>值</th><th>类型</th><th>分组</th><th>版本</th><th>状态</th><th>更新人</th><th>更新时间</th><th></th></tr></thead>' +
+      '<tbody>' + (rows.join('') || '<tr><td colspan="9" class="empty">暂无配置</td></tr>') + '</tbody></table>';
+
+    function go(nextPage, nextKey) {
+      location.hash = '#/ns/' + nsID + '/configs?' + query({ key: nextKey, page: nextPage > 1 ? nextPage : '' });
+    }
+
+    const filter = document.getElementById('key-filter');
+    document.getElementById('key-search').addEventListener('click', function () { go(1, filter.value.trim()); });
+    filter.addEventListener('keydown', function (e) {
+      if (e.key === 'Enter') {
+        go(1, filter.value.trim());
+      }
+    });
+    document.getElementById('prev-page').addEventListener('click', function () { go(page - 1, key); });
+    document.getElementById('next-page').addEventListener('click', function () { go(page + 1, key); });
+    document.getElementById('new-config').addEventListener('click', function () { openEditor(nsID, null); });
+
+    view.querySelectorAll('tr[data-index]').forEach(function (tr) {
+      tr.addEventListener('click', function () { openEditor(nsID, items[tr.dataset.index]); });
+    });
+    view.querySelectorAll('button[data-delete]').forEach(function (btn) {
+      btn.addEventListener('click', function (e) {
+        e.stopPropagation();
+        deleteConfig(items[btn.dataset.delete]);
+      });
+    });
+  }
+
+  // openEditor 打开配置编辑对话框（config 为空时新建）
+  function openEditor(nsID, config) {
+    const f = editorForm.elements;
+    editorForm.reset();
+    editorForm.dataset.namespaceId = nsID;
+    editorForm.dataset.configId = config ? config.id : '';
+    editorForm.dataset.version = config ? config.version : '';
+
+    document.getElementById('editor-title').textContent = config ? '编辑配置 ' + config.key : '新建配置（环境：' + environment() + '）';
+    f.key.value = config ? config.key : '';
+    f.key.readOnly = !!config;
+    f.value_type.value = config ? config.value_type : 'string';
+    f.group_name.value = config ? config.group_name : '';
+    f.description.value = config ? (config.description || '') : '';
+    f.value.value = config ? config.value : '';
+
+    let hint = '保存后配置处于未发布状态，需要创建发布版本并发布后客户端才能获取到新值。';
+    if (config && config.is_masked) {
+      hint = '该配置值已脱敏显示，保存将以输入框中的内容覆盖原值。' + hint;
+    }
+    if (config && config.value_type === 'file') {
+      hint = '文件配置请通过文件上传接口修改内容。';
+    }
+    document.getElementById('editor-hint').textContent = hint;
+    editor.showModal();
+  }
+
+  editor.addEventListener('close', async function () {
+    if (editor.returnValue !== 'save') {
+      return;
+    }
+    if (!requireOperator()) {
+      return;
+    }
+
+    const f = editorForm.elements;
+    const configID = parseInt(editorForm.dataset.configId || '0', 10);
+    try {
+      if (configID) {
+        await api('PUT', '/api/v1/configs', {
+          id: configID,
+          value: f.value.value,
+          value_type: f.value_type.value,
+          group_name: f.group_name.value.trim(),
+          description: f.description.value,
+          updated_by: operator(),
+          expected_version: parseInt(editorForm.dataset.version, 10)
+        });
+      } else {
+        await api('POST', '/api/v1/configs', {
+          namespace_id: parseInt(editorForm.dataset.namespaceId, 10),
+          key: f.key.value.trim(),
+          value: f.value.value,
+          value_type: f.value_type.value,
+          group_name: f.group_name.value.trim(),
+          environment: environment(),
+          description: f.description.value,
+          created_by: operator()
+        });
+      }
+      await route();
+      showMessage('配置已保存', true);
+    } catch (e) {
+      showMessage('保存失败：' + e.message);
+    }
+  });
+
+  async function deleteConfig(config) {
+    if (!confirm('确定删除配置 ' + config.key + '？删除后可在回收站恢复。')) {
+      return;
+    }
+    try {
+      await api('DELETE', '/api/v1/configs/' + config.id);
+      await route();
+      showMessage('配置已删除', true);
+    } catch (e) {
+      showMessage('删除失败：' + e.message);
+    }
+  }
+
+  // ==================== 发布版本 ====================
+
+  async function renderReleases(seq, nsID) {
+    const releases = await api('GET', '/api/v1/releases/list?' + query({
+      namespace_id: nsID,
+      environment: environment()
+    }));
+    const title = await namespaceTitle(nsID);
+    if (stale(seq)) {
+      return;
+    }
+
+    const items = (releases || []).slice().sort(function (a, b) { return b.version - a.version; });
+    const options = items.map(function (r) {
+      return '<option value="' + r.id + '">v' + r.version + ' ' + escapeHTML(r.version_name) + '（' + escapeHTML(r.status) + '）</option>';
+    }).join('');
+    const rows = items.map(function (r) {
+      const status = r.status === 'published' ? '<span class="badge ok">已发布</span>'
+        : r.status === 'rollback' ? '<span class="badge off">已回滚</span>'
+          : '<span class="badge warn">' + escapeHTML(r.status) + '</span>';
+      return '<tr>' +
+        '<td>v' + r.version + '</td>' +
+        '<td>' + escapeHTML(r.version_name) + '</td>' +
+        '<td>' + escapeHTML(r.release_type) + (r.release_type === 'canary' ? ' ' + r.canary_percentage + '%' : '') + '</td>' +
+        '<td>' + status + '</td>' +
+        '<td>' + r.config_count + '</td>' +
+        '<td>' + escapeHTML(r.release_notes) + '</td>' +
+        '<td>' + escapeHTML(r.released_by || r.created_by) + '</td>' +
+        '<td>' + formatTime(r.released_at || r.created_at) + '</td>' +
+        '</tr>';
+    });
+
+    view.innerHTML = '<h2>' + title + ' / 发布版本</h2>' +
+      '<div class="toolbar">' +
+      '<label>源版本 <select id="from-release"><option value="live">当前线上配置</option>' + options + '</select></label>' +
+      '<label>目标版本 <select id="to-release">' + options + '</select></label>' +
+      '<button id="compare" class="primary"' + (items.length ? '' : ' disabled') + '>对比</button>' +
+      '</div>' +
+      '<div id="compare-result"></div>' +
+      '<table><thead><tr><th>版本</th><th>名称</th><th>类型</th><th>状态</th><th>配置数</th><th>发布说明</th><th>发布人</th><th>时间</th></tr></thead>' +
+      '<tbody>' + (rows.join('') || '<tr><td colspan="8" class="empty">暂无发布版本</td></tr>') + '</tbody></table>';
+
+    document.getElementById('compare').addEventListener('click', async function () {
+      const from = document.getElementById('from-release').value;
+      const to = parseInt(document.getElementById('to-release').value, 10);
+      const body = from === 'live'
+        ? { mode: 'live', to_release_id: to }
+        : { mode: 'release', from_release_id: parseInt(from, 10), to_release_id: to };
+      try {
+        const result = await api('POST', '/api/v1/releases/compare', body);
+        renderCompare(result);
+      } catch (e) {
+        showMessage('对比失败：' + e.message);
+      }
+    });
+  }
+
+  function renderDiffText(text) {
+    return text.split('\n').map(function (line) {
+      const escaped = escapeHTML(line);
+      if (line.startsWith('+') && !line.startsWith('+++')) {
+        return '<span class="add">' + escaped + '</span>';
+      }
+      if (line.startsWith('-') && !line.startsWith('---')) {
+        return '<span class="del">' + escaped + '</span>';
+      }
+      return escaped + '\n';
+    }).join('');
+  }
+
+  function renderCompare(result) {
+    const from = result.from_live ? '当前线上配置' : 'v' + result.from_version;
+    const added = result.added || [];
+    const deleted = result.deleted || [];
+    const modified = result.modified || [];
+
+    let html = '<div class="diff-section"><h3>' + escapeHTML(from) + ' → v' + result.to_version +
+      '：新增 ' + added.length + '，删除 ' + deleted.length + '，修改 ' + modified.length + '</h3>';
+    if (!added.length && !deleted.length && !modified.length) {
+      html += '<p class="hint">两个版本的配置完全相同。</p>';
+    }
+    added.forEach(function (item) {
+      html += '<div class="diff-section"><strong>+ ' + escapeHTML(item.key) + '</strong>' +
+        '<pre class="diff"><span class="add">' + escapeHTML(item.value) + '</span></pre></div>';
+    });
+    deleted.forEach(function (item) {
+      html += '<div class="diff-section"><strong>- ' + escapeHTML(item.key) + '</strong>' +
+        '<pre class="diff"><span class="del">' + escapeHTML(item.value) + '</span></pre></div>';
+    });
+    modified.forEach(function (item) {
+      const diff = item.diff && item.diff.unified_diff
+        ? renderDiffText(item.diff.unified_diff)
+        : '<span class="del">' + escapeHTML(item.old_value) + '</span><span class="add">' + escapeHTML(item.new_value) + '</span>';
+      html += '<div class="diff-section"><strong>~ ' + escapeHTML(item.key) + '</strong><pre class="diff">' + diff + '</pre></div>';
+    });
+    html += '</div>';
+
+    document.getElementById('compare-result').innerHTML = html;
+  }
+
+  // ==================== 订阅客户端 ====================
+
+  async function renderSubscribers(seq, nsID) {
+    const data = await api('GET', '/api/v1/subscriptions?' + query({
+      namespace_id: nsID,
+      environment: environment(),
+      is_active: true,
+      page: 1,
+      size: 200
+    }));
+    const title = await namespaceTitle(nsID);
+    if (stale(seq)) {
+      return;
+    }
+
+    const items = data.subscriptions || [];
+    const rows = items.map(function (s) {
+      return '<tr>' +
+        '<td>' + escapeHTML(s.client_id) + '</td>' +
+        '<td>' + escapeHTML(s.client_ip) + '</td>' +
+        '<td>' + escapeHTML(s.client_hostname) + '</td>' +
+        '<td>' + s.last_version + '</td>' +
+        '<td>' + s.poll_count + '</td>' +
+        '<td>' + s.change_count + '</td>' +
+        '<td>' + formatTime(s.last_heartbeat_at) + '</td>' +
+        '<td>' + formatTime(s.subscribed_at) + '</td>' +
+        '</tr>';
+    });
+
+    view.innerHTML = '<h2>' + title + ' / 订阅客户端</h2>' +
+      '<div class="toolbar"><span>活跃客户端 ' + data.total + ' 个</span>' +
+      (data.total > items.length ? '<span class="hint">（仅显示前 ' + items.length + ' 个）</span>' : '') +
+      '<span class="spacer"></span><button id="refresh">刷新</button></div>' +
+      '<table><thead><tr><th>客户端ID</th><th>IP</th><th>主机名</th><th>版本</th><th>长轮询次数</th><th>变更次数</th><th>最后心跳</th><th>订阅时间</th></tr></thead>' +
+      '<tbody>' + (rows.join('') || '<tr><td colspan="8" class="empty">暂无活跃客户端</td></tr>') + '</tbody></table>';
+
+    document.getElementById('refresh').addEventListener('click', route);
+  }
+
+  // ==================== 初始化 ====================
+
+  envSelect.value = localStorage.getItem('console.environment') || 'default';
+  operatorInput.value = localStorage.getItem('console.operator') || '';
+  envSelect.addEventListener('change', function () {
+    localStorage.setItem('console.environment', envSelect.value);
+    route();
+  });
+  operatorInput.addEventListener('change', function () {
+    localStorage.setItem('console.operator', operator());
+  });
+  // API Key 仅保存在当前会话，关闭浏览器后需重新输入
+  apiKeyInput.value = sessionStorage.getItem('console.apiKey') || '';
+  apiKeyInput.addEventListener('change', function () {
+    sessionStorage.setItem('console.apiKey', apiKeyInput.value.trim());
+    state.namespaces = null;
+    route();
+  });
+
+  window.addEventListener('hashchange', route);
+  route();
+})();
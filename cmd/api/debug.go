@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/adaptor"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// registerDebugRoutes 注册调试路由（pprof 性能分析和运行时统计）
+// 仅在 server.debug.enabled 为 true 时注册，用于排查长轮询 goroutine 泄漏等线上问题
+func registerDebugRoutes() {
+	if !cfg.Server.Debug.Enabled {
+		return
+	}
+
+	debug := hertzH.Group("/debug", debugAuth(cfg.Server.Debug.Token))
+	{
+		// pprof 性能分析（go tool pprof http://host/debug/pprof/heap）
+		debug.GET("/pprof/", wrapHTTPHandler(pprof.Index))
+		debug.GET("/pprof/cmdline", wrapHTTPHandler(pprof.Cmdline))
+		debug.GET("/pprof/profile", wrapHTTPHandler(pprof.Profile))
+		debug.GET("/pprof/symbol", wrapHTTPHandler(pprof.Symbol))
+		debug.POST("/pprof/symbol", wrapHTTPHandler(pprof.Symbol))
+		debug.GET("/pprof/trace", wrapHTTPHandler(pprof.Trace))
+		debug.GET("/pprof/:name", wrapHTTPHandler(pprof.Index)) // heap、goroutine、allocs、block、mutex、threadcreate
+
+		// 运行时统计
+		debug.GET("/runtime", getRuntimeStats)
+	}
+
+	hlog.Warn("调试接口已开启: /debug/pprof, /debug/runtime（排查完成后请关闭）")
+}
+
+// debugAuth 调试接口访问令牌校验
+func debugAuth(token string) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		if token != "" && subtle.ConstantTimeCompare(ctx.Request.Header.Peek("X-Debug-Token"), []byte(token)) != 1 {
+			ctx.AbortWithStatusJSON(consts.StatusForbidden, map[string]interface{}{
+				"status":  "forbidden",
+				"message": "调试接口访问令牌无效",
+			})
+			return
+		}
+		ctx.Next(c)
+	}
+}
+
+// wrapHTTPHandler 将标准库 http.HandlerFunc 适配为 Hertz 处理器
+func wrapHTTPHandler(handler http.HandlerFunc) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		req, err := adaptor.GetCompatRequest(&ctx.Request)
+		if err != nil {
+			ctx.String(consts.StatusInternalServerError, err.Error())
+			return
+		}
+		handler(adaptor.GetCompatResponseWriter(&ctx.Response), req.WithContext(c))
+	}
+}
+
+// getRuntimeStats 获取运行时统计（goroutine、内存、长轮询等待数、订阅者映射大小）
+func getRuntimeStats(c context.Context, ctx *app.RequestContext) {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	stats := map[string]interface{}{
+		"time":       time.Now().Format("2006-01-02 15:04:05"),
+		"uptime":     time.Since(startTime).Round(time.Second).String(),
+		"go_version": runtime.Version(),
+		"num_cpu":    runtime.NumCPU(),
+		"goroutines": runtime.NumGoroutine(),
+		"memory": map[string]interface{}{
+			"alloc_bytes":      mem.Alloc,
+			"heap_inuse_bytes": mem.HeapInuse,
+			"heap_objects":     mem.HeapObjects,
+			"sys_bytes":        mem.Sys,
+			"num_gc":           mem.NumGC,
+			"gc_pause_total":   time.Duration(mem.PauseTotalNs).String(),
+		},
+	}
+
+	if longPollingService != nil {
+		stats["long_polling"] = map[string]interface{}{
+			"active_waiters": longPollingService.GetActiveWaiterCount(),
+		}
+	}
+
+	if subscriptionManager != nil {
+		subscriptionStats := subscriptionManager.GetStats()
+		stats["subscriptions"] = map[string]interface{}{
+			"active_subscribers":    subscriptionStats.ActiveSubscribers,
+			"watched_config_keys":   subscriptionStats.WatchedConfigKeys,
+			"watched_prefixes":      subscriptionStats.WatchedPrefixes,
+			"subscriber_refs":       subscriptionStats.SubscriberRefs,
+			"pending_notifications": subscriptionStats.PendingNotifications,
+		}
+	}
+
+	if configReadCache != nil {
+		cacheStats := configReadCache.GetStats()
+		stats["config_cache"] = map[string]interface{}{
+			"hits":          cacheStats.Hits,
+			"misses":        cacheStats.Misses,
+			"errors":        cacheStats.Errors,
+			"invalidations": cacheStats.Invalidations,
+			"hit_ratio":     cacheStats.HitRatio,
+		}
+	}
+
+	if leaderElector != nil {
+		stats["leader"] = map[string]interface{}{
+			"identity":  leaderElector.Identity(),
+			"is_leader": leaderElector.IsLeader(),
+		}
+	}
+
+	if db != nil {
+		if sqlDB, err := db.DB(); err == nil {
+			dbStats := sqlDB.Stats()
+			stats["database_pool"] = map[string]interface{}{
+				"open_connections": dbStats.OpenConnections,
+				"in_use":           dbStats.InUse,
+				"idle":             dbStats.Idle,
+				"wait_count":       dbStats.WaitCount,
+				"wait_duration":    dbStats.WaitDuration.String(),
+			}
+		}
+	}
+
+	if rdb != nil {
+		poolStats := rdb.PoolStats()
+		stats["redis_pool"] = map[string]interface{}{
+			"total_conns": poolStats.TotalConns,
+			"idle_conns":  poolStats.IdleConns,
+			"stale_conns": poolStats.StaleConns,
+			"timeouts":    poolStats.Timeouts,
+		}
+	}
+
+	ctx.JSON(consts.StatusOK, stats)
+}
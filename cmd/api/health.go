@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"config-client/share/health"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// shuttingDown 服务是否正在关闭（关闭期间就绪检查失败，负载均衡不再转发新请求）
+var shuttingDown atomic.Bool
+
+// registerHealthRoutes 注册健康检查路由（适用于 Kubernetes 探针）
+// /health/live  存活探针：进程可响应即返回 200，不检查外部依赖，避免依赖抖动导致容器被重启
+// /health/ready 就绪探针：检查数据库、Redis（未禁用时）和配置变更订阅状态，任一不可用返回 503
+func registerHealthRoutes() {
+	checker := health.NewChecker(2 * time.Second)
+	checker.Register("database", func(ctx context.Context) error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+		return sqlDB.PingContext(ctx)
+	})
+	if rdb != nil {
+		checker.Register("redis", func(ctx context.Context) error {
+			return rdb.Ping(ctx).Err()
+		})
+	}
+	checker.Register("listener", func(ctx context.Context) error {
+		if subscriptionManager == nil {
+			return errors.New("订阅管理器未初始化")
+		}
+		return subscriptionManager.CheckListenerHealth(ctx)
+	})
+
+	// 存活探针
+	hertzH.GET("/health/live", func(c context.Context, ctx *app.RequestContext) {
+		ctx.JSON(consts.StatusOK, map[string]interface{}{
+			"status": health.StatusUp,
+			"time":   time.Now().Format("2006-01-02 15:04:05"),
+			"uptime": time.Since(startTime).Round(time.Second).String(),
+		})
+	})
+
+	// 就绪探针
+	hertzH.GET("/health/ready", func(c context.Context, ctx *app.RequestContext) {
+		if shuttingDown.Load() {
+			ctx.JSON(consts.StatusServiceUnavailable, map[string]interface{}{
+				"status":  health.StatusDown,
+				"message": "服务正在关闭",
+			})
+			return
+		}
+
+		report := checker.Check(c)
+		status := consts.StatusOK
+		if report.Status != health.StatusUp {
+			status = consts.StatusServiceUnavailable
+		}
+		ctx.JSON(status, report)
+	})
+}
@@ -2,6 +2,9 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"flag"
 	"fmt"
 	"log"
 	"os"
@@ -13,18 +16,32 @@ import (
 	configHttp "config-client/api/config-api/http"
 	"config-client/api/config-api/service"
 	domainEntity "config-client/config/domain/entity"
+	domainListener "config-client/config/domain/listener"
 	"config-client/config/domain/repository"
 	domainService "config-client/config/domain/service"
+	infraArchive "config-client/config/infrastructure/archive"
+	infraCache "config-client/config/infrastructure/cache"
 	infraListener "config-client/config/infrastructure/listener"
+	infraNotify "config-client/config/infrastructure/notify"
 	infraRepository "config-client/config/infrastructure/repository"
+	infraWebhook "config-client/config/infrastructure/webhook"
 	"config-client/share/config"
+	"config-client/share/config-client/signing"
+	shareErrors "config-client/share/errors"
+	"config-client/share/leader"
+	appLogger "config-client/share/logger"
 	"config-client/share/middleware"
+	gormRepo "config-client/share/repository/gorm"
+	"config-client/share/tracing"
+	"config-client/share/validation"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/app/server"
+	hertzConfig "github.com/cloudwego/hertz/pkg/common/config"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 	"github.com/redis/go-redis/v9"
+	"github.com/uptrace/opentelemetry-go-extra/otelgorm"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -33,33 +50,78 @@ import (
 var (
 	cfg                 *config.Config
 	db                  *gorm.DB
-	rdb                 *redis.Client
+	rdb                 redis.UniversalClient
 	ctx                 = context.Background()
 	hertzH              *server.Hertz
 	subscriptionManager *domainService.SubscriptionManager
 	longPollingService  *domainService.LongPollingService
-	configListener      *infraListener.RedisConfigListener
-	systemConfigService *domainService.SystemConfigService // 系统配置服务
+	configListener      domainListener.ConfigListener
+	eventOutbox         *domainService.EventOutbox             // 事务性发件箱（listener.outbox.enabled 时启用）
+	systemConfigService *domainService.SystemConfigService     // 系统配置服务
+	tracingShutdown     tracing.ShutdownFunc                   // 链路追踪关闭函数
+	startTime           = time.Now()                           // 服务启动时间
+	leaderElector       *leader.RedisElector                   // 后台任务主节点选举器
+	historyRetention    *domainService.HistoryRetentionService // 变更历史清理任务（history.retention.enabled 时启用）
+	configExpiry        *domainService.ConfigExpiryService     // 配置过期处理任务（expiry.enabled 时启用）
+	pushTraceService    *domainService.PushTraceService        // 变更通知下发追踪
+	webhookService      *domainService.WebhookService          // Webhook 投递（webhook.enabled 时启用）
+	notificationService *domainService.NotificationService     // 发布通知（notification.enabled 时启用）
+	apiKeyService       *domainService.APIKeyService           // API Key 签发与认证
+	tenantService       *domainService.TenantService           // 租户管理与请求租户校验
+	quotaService        *domainService.NamespaceQuotaService   // 命名空间配额校验与用量统计
+	configReadCache     *domainService.ConfigReadCache         // 长轮询版本比较使用的配置读取缓存
+	configSigner        *domainService.ConfigSigner            // 配置值签名（security.signing_private_key 配置时启用）
 )
 
 func main() {
+	flag.Parse()
+
 	// 1. 加载配置
 	if err := loadConfig(); err != nil {
 		log.Fatalf("加载配置失败: %v", err)
 	}
+	appLogger.Init(cfg.Log.Level, cfg.Log.Output)
 	hlog.Infof("配置加载成功")
 
+	// 初始化链路追踪（需在数据库、HTTP服务器之前，以便注册埋点）
+	if err := initTracing(); err != nil {
+		log.Fatalf("初始化链路追踪失败: %v", err)
+	}
+
 	// 2. 初始化数据库
 	if err := initDatabase(); err != nil {
 		log.Fatalf("初始化数据库失败: %v", err)
 	}
 	hlog.Infof("数据库连接成功")
 
+	// 仅执行数据库迁移（-migrate）
+	if *migrateCommand != "" {
+		if err := runMigrateCommand(*migrateCommand, flag.Args()); err != nil {
+			log.Fatalf("数据库迁移失败: %v", err)
+		}
+		return
+	}
+
 	// 3. 初始化Redis
 	if err := initRedis(); err != nil {
 		log.Fatalf("初始化Redis失败: %v", err)
 	}
-	hlog.Infof("Redis连接成功")
+	if rdb != nil {
+		hlog.Infof("Redis连接成功")
+	} else {
+		hlog.Warn("Redis 已禁用，仅依赖数据库运行")
+	}
+
+	// 初始化租户、API Key 服务并加载种子文件（种子文件中的系统配置优先于内置默认值）
+	initTenants()
+	initAPIKeys()
+	initQuotas()
+	if err := initConfigSigner(); err != nil {
+		log.Fatalf("初始化配置签名失败: %v", err)
+	}
+	if err := initBootstrap(); err != nil {
+		log.Fatalf("加载种子文件失败: %v", err)
+	}
 
 	// 4. 初始化系统配置
 	if err := initSystemConfig(); err != nil {
@@ -73,6 +135,17 @@ func main() {
 	}
 	hlog.Infof("长轮询管理器初始化成功")
 
+	// 初始化变更历史清理任务（依赖长轮询初始化时创建的主节点选举器）
+	if err := initHistoryRetention(); err != nil {
+		log.Fatalf("初始化变更历史清理任务失败: %v", err)
+	}
+
+	// 初始化 Webhook 投递任务（需在注册路由前创建，配置和发布服务据此写入投递记录）
+	initWebhooks()
+
+	// 初始化发布通知（需在注册路由前创建，发布服务据此发送通知）
+	initNotifications()
+
 	// 6. 初始化HTTP服务器
 	initServer()
 	hlog.Infof("HTTP服务器初始化完成，监听端口: %d", cfg.Server.Port)
@@ -97,6 +170,20 @@ func main() {
 	hlog.Info("服务器已关闭")
 }
 
+// initTracing 初始化链路追踪
+func initTracing() error {
+	shutdown, err := tracing.Init(ctx, cfg.Tracing)
+	if err != nil {
+		return err
+	}
+	tracingShutdown = shutdown
+	if cfg.Tracing.Enabled {
+		hlog.Infof("链路追踪已启用: exporter=%s, endpoint=%s, sample_ratio=%.2f",
+			cfg.Tracing.Exporter, cfg.Tracing.Endpoint, cfg.Tracing.SampleRatio)
+	}
+	return nil
+}
+
 // loadConfig 加载配置文件
 func loadConfig() error {
 	// 尝试多个可能的配置文件路径
@@ -147,6 +234,31 @@ func initDatabase() error {
 		return fmt.Errorf("连接数据库失败: %w", err)
 	}
 
+	// 注册多租户回调（按请求上下文中的租户过滤和填充 tenant_id）
+	if err := gormRepo.RegisterTenantCallbacks(db); err != nil {
+		return fmt.Errorf("注册多租户回调失败: %w", err)
+	}
+
+	// 注册 GORM 链路追踪插件（每条 SQL 生成一个子 span）
+	if cfg.Tracing.Enabled {
+		if err := db.Use(otelgorm.NewPlugin(otelgorm.WithDBName(cfg.Database.Database))); err != nil {
+			return fmt.Errorf("注册数据库链路追踪插件失败: %w", err)
+		}
+	}
+
+	// 注册只读副本：GET 查询接口和长轮询版本比较读取副本，写操作、事务和其余查询使用主库
+	if len(cfg.Database.Replicas) > 0 {
+		replicas := make([]gorm.Dialector, 0, len(cfg.Database.Replicas))
+		for _, dsn := range cfg.Database.Replicas {
+			replicas = append(replicas, postgres.Open(dsn))
+		}
+		if err := gormRepo.RegisterReadReplicas(db, replicas, cfg.Database.MaxOpenConns,
+			cfg.Database.MaxIdleConns, cfg.Database.GetConnMaxLifetime()); err != nil {
+			return fmt.Errorf("注册只读副本失败: %w", err)
+		}
+		hlog.Infof("读写分离已启用: replicas=%d", len(replicas))
+	}
+
 	// 获取底层的 sql.DB
 	sqlDB, err := db.DB()
 	if err != nil {
@@ -163,33 +275,52 @@ func initDatabase() error {
 		return fmt.Errorf("数据库连接测试失败: %w", err)
 	}
 
-	// 自动迁移（如果配置启用）
-	if cfg.Database.AutoMigrate {
-		hlog.Info("执行数据库自动迁移...")
-		// 在这里添加需要迁移的模型
-		// if err := db.AutoMigrate(&models.YourModel{}); err != nil {
-		// 	return fmt.Errorf("数据库迁移失败: %w", err)
-		// }
+	// 数据库迁移（单独执行迁移命令时跳过，由命令决定迁移方向）
+	if *migrateCommand == "" {
+		if err := migrateDatabase(); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-// initRedis 初始化Redis连接
+// initRedis 初始化Redis连接（redis.disabled 为 true 时跳过，rdb 保持为空）
 func initRedis() error {
-	rdb = redis.NewClient(&redis.Options{
-		Addr:            cfg.Redis.GetAddr(),
-		Password:        cfg.Redis.Password,
-		DB:              cfg.Redis.DB,
-		PoolSize:        cfg.Redis.PoolSize,
-		MinIdleConns:    cfg.Redis.MinIdleConns,
-		DialTimeout:     cfg.Redis.GetDialTimeout(),
-		ReadTimeout:     cfg.Redis.GetReadTimeout(),
-		WriteTimeout:    cfg.Redis.GetWriteTimeout(),
-		PoolTimeout:     cfg.Redis.GetPoolTimeout(),
-		ConnMaxIdleTime: cfg.Redis.GetIdleTimeout(),
-		ConnMaxLifetime: cfg.Redis.GetMaxConnAge(),
-	})
+	if cfg.Redis.Disabled {
+		return nil
+	}
+
+	opts := &redis.UniversalOptions{
+		Addrs:            cfg.Redis.GetAddrs(),
+		MasterName:       cfg.Redis.MasterName,
+		Password:         cfg.Redis.Password,
+		SentinelPassword: cfg.Redis.SentinelPassword,
+		DB:               cfg.Redis.DB,
+		PoolSize:         cfg.Redis.PoolSize,
+		MinIdleConns:     cfg.Redis.MinIdleConns,
+		DialTimeout:      cfg.Redis.GetDialTimeout(),
+		ReadTimeout:      cfg.Redis.GetReadTimeout(),
+		WriteTimeout:     cfg.Redis.GetWriteTimeout(),
+		PoolTimeout:      cfg.Redis.GetPoolTimeout(),
+		ConnMaxIdleTime:  cfg.Redis.GetIdleTimeout(),
+		ConnMaxLifetime:  cfg.Redis.GetMaxConnAge(),
+	}
+
+	// 按部署模式创建客户端（哨兵模式自动跟随主从切换，集群模式按槽位路由）
+	switch cfg.Redis.Mode {
+	case "standalone":
+		rdb = redis.NewClient(opts.Simple())
+	case "sentinel":
+		if cfg.Redis.MasterName == "" {
+			return fmt.Errorf("哨兵模式需要配置 master_name")
+		}
+		rdb = redis.NewFailoverClient(opts.Failover())
+	case "cluster":
+		rdb = redis.NewClusterClient(opts.Cluster())
+	default:
+		return fmt.Errorf("不支持的Redis部署模式: %s（可选值: standalone/sentinel/cluster）", cfg.Redis.Mode)
+	}
 
 	// 测试连接
 	if err := rdb.Ping(ctx).Err(); err != nil {
@@ -207,10 +338,16 @@ func initSystemConfig() error {
 	// 2. 创建系统配置服务
 	systemConfigService = domainService.NewSystemConfigService(systemConfigRepo)
 
-	// 3. 初始化默认配置（如果数据库中不存在）
+	// 3. 初始化默认配置（如果数据库中不存在），并重新加载缓存
 	if err := initDefaultSystemConfigs(systemConfigRepo); err != nil {
 		return fmt.Errorf("初始化默认系统配置失败: %w", err)
 	}
+	if err := systemConfigService.RefreshCache(context.Background()); err != nil {
+		hlog.Warnf("加载系统配置失败: %v", err)
+	}
+
+	// 4. 启动定期加载任务（其他实例修改或直接修改数据库的配置无需重启即可生效，变更推送给已注册的消费方）
+	systemConfigService.Start()
 
 	return nil
 }
@@ -250,6 +387,16 @@ func initDefaultSystemConfigs(repo repository.SystemConfigRepository) error {
 			Value:       "300",
 			Description: "心跳超时时间（秒）",
 		},
+		{
+			Key:         domainService.ConfigKeySubscriptionCleanupInterval,
+			Value:       "300",
+			Description: "清理过期订阅的间隔（秒）",
+		},
+		{
+			Key:         domainService.ConfigKeySystemConfigRefreshInterval,
+			Value:       "30",
+			Description: "从数据库重新加载系统配置的间隔（秒）",
+		},
 	}
 
 	// 插入不存在的配置
@@ -279,10 +426,201 @@ func initDefaultSystemConfigs(repo repository.SystemConfigRepository) error {
 	return nil
 }
 
+// initHistoryRetention 初始化变更历史保留策略清理任务
+func initHistoryRetention() error {
+	retentionCfg := cfg.History.Retention
+	if !retentionCfg.Enabled {
+		return nil
+	}
+	if retentionCfg.MaxAgeDays <= 0 && retentionCfg.MaxPerConfig <= 0 {
+		hlog.Warn("变更历史保留策略已启用，但未配置 max_age_days 或 max_per_config，跳过清理任务")
+		return nil
+	}
+
+	archiver, err := infraArchive.NewChangeHistoryArchiver(retentionCfg.Archive, db)
+	if err != nil {
+		return err
+	}
+
+	historyRetention = domainService.NewHistoryRetentionService(
+		infraRepository.NewChangeHistoryRepository(db),
+		infraRepository.NewConfigRepository(db),
+		archiver,
+		domainService.HistoryRetentionPolicy{
+			MaxAge:       retentionCfg.GetMaxAge(),
+			MaxPerConfig: retentionCfg.MaxPerConfig,
+			Interval:     retentionCfg.GetInterval(),
+			BatchSize:    retentionCfg.BatchSize,
+		},
+	)
+	if leaderElector != nil {
+		historyRetention.SetLeaderElector(leaderElector)
+	}
+	historyRetention.Start()
+	return nil
+}
+
+// initWebhooks 初始化 Webhook 服务并启动投递任务
+func initWebhooks() {
+	webhookCfg := cfg.Webhook
+	if !webhookCfg.Enabled {
+		return
+	}
+
+	webhookService = domainService.NewWebhookService(
+		infraRepository.NewWebhookRepository(db),
+		infraRepository.NewWebhookDeliveryRepository(db),
+		infraRepository.NewNamespaceRepository(db),
+		infraWebhook.NewHTTPSender(),
+		domainService.WebhookOptions{
+			PollInterval: webhookCfg.GetPollInterval(),
+			BatchSize:    webhookCfg.BatchSize,
+			MaxAttempts:  webhookCfg.MaxAttempts,
+			Timeout:      webhookCfg.GetTimeout(),
+			Retention:    webhookCfg.GetRetention(),
+		},
+	)
+	webhookService.Start()
+}
+
+// initTenants 初始化租户服务（租户状态缓存时长与 API Key 认证缓存一致）
+func initTenants() {
+	tenantService = domainService.NewTenantService(
+		infraRepository.NewTenantRepository(db),
+		cfg.Auth.GetCacheTTL(),
+	)
+}
+
+// initAPIKeys 初始化 API Key 服务（接口认证、API Key 管理路由和种子文件共用）
+func initAPIKeys() {
+	apiKeyService = domainService.NewAPIKeyService(
+		infraRepository.NewAPIKeyRepository(db),
+		infraRepository.NewNamespaceRepository(db),
+		cfg.Auth.GetCacheTTL(),
+	)
+}
+
+// initQuotas 初始化命名空间配额服务（命名空间未设置的配额项使用 quota 配置中的默认值）
+func initQuotas() {
+	quotaService = domainService.NewNamespaceQuotaService(
+		infraRepository.NewNamespaceRepository(db),
+		infraRepository.NewConfigRepository(db),
+		infraRepository.NewSubscriptionRepository(db),
+		infraRepository.NewReleaseRepository(db),
+		domainEntity.NamespaceQuota{
+			MaxConfigs:          cfg.Quota.MaxConfigs,
+			MaxValueSize:        cfg.Quota.MaxValueSize,
+			MaxSubscribers:      cfg.Quota.MaxSubscribers,
+			MaxReleasesRetained: cfg.Quota.MaxReleasesRetained,
+		},
+	)
+}
+
+// initConfigSigner 初始化配置值签名（未配置签名私钥时不签名）
+// 启动日志输出签名公钥，分发给 SDK 用于校验
+func initConfigSigner() error {
+	if cfg.Security.SigningPrivateKey == "" {
+		return nil
+	}
+	privateKey, err := signing.ParsePrivateKey(cfg.Security.SigningPrivateKey)
+	if err != nil {
+		return err
+	}
+	configSigner = domainService.NewConfigSigner(privateKey, cfg.Security.SigningKeyID)
+	hlog.Infof("配置值签名已启用: algorithm=%s, key_id=%s, public_key=%s",
+		signing.Algorithm, cfg.Security.SigningKeyID, base64.StdEncoding.EncodeToString(configSigner.PublicKey()))
+	return nil
+}
+
+// initNotifications 初始化发布通知服务并注册各渠道发送器
+func initNotifications() {
+	notificationCfg := cfg.Notification
+	if !notificationCfg.Enabled {
+		return
+	}
+
+	notificationService = domainService.NewNotificationService(
+		infraRepository.NewNotificationChannelRepository(db),
+		infraRepository.NewNamespaceRepository(db),
+		domainService.NotificationOptions{
+			ReleaseURL: notificationCfg.ReleaseURL,
+			Timeout:    notificationCfg.GetTimeout(),
+		},
+	)
+	notificationService.RegisterNotifier(infraNotify.NewDingTalkNotifier())
+	notificationService.RegisterNotifier(infraNotify.NewSlackNotifier())
+
+	// 未配置 SMTP 服务器时不支持邮件渠道
+	if smtpCfg := notificationCfg.SMTP; smtpCfg.Host != "" {
+		notificationService.RegisterNotifier(infraNotify.NewSMTPNotifier(infraNotify.SMTPOptions{
+			Host:        smtpCfg.Host,
+			Port:        smtpCfg.Port,
+			Username:    smtpCfg.Username,
+			Password:    smtpCfg.Password,
+			From:        smtpCfg.From,
+			ImplicitTLS: smtpCfg.ImplicitTLS,
+		}))
+	}
+	hlog.Infof("发布通知已启用: smtp=%t", notificationCfg.SMTP.Host != "")
+}
+
+// initConfigExpiry 初始化配置过期处理任务
+func initConfigExpiry(configRepo repository.ConfigRepository, configDomainService *domainService.ConfigService) error {
+	expiryCfg := cfg.Expiry
+	if !expiryCfg.Enabled {
+		return nil
+	}
+
+	expiryService, err := domainService.NewConfigExpiryService(
+		configRepo,
+		configDomainService,
+		domainService.ConfigExpiryPolicy{
+			Action:    domainService.ExpiryAction(expiryCfg.Action),
+			Interval:  expiryCfg.GetInterval(),
+			BatchSize: expiryCfg.BatchSize,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if leaderElector != nil {
+		expiryService.SetLeaderElector(leaderElector)
+	}
+	expiryService.Start()
+	configExpiry = expiryService
+	return nil
+}
+
 // initLongPolling 初始化长轮询服务
 func initLongPolling() error {
-	// 1. 创建Redis配置监听器
-	configListener = infraListener.NewRedisConfigListener(rdb)
+	// 1. 创建配置变更监听器（按 listener.type 选择实现）
+	var err error
+	configListener, err = infraListener.NewConfigListener(cfg, rdb, db)
+	if err != nil {
+		return err
+	}
+	hlog.Infof("配置变更监听器: %s", cfg.Listener.Type)
+
+	// 发布前记录事件日志并分配序号，长轮询客户端断线重连时据此补发遗漏的变更
+	changeEventSvc := domainService.NewChangeEventService(
+		infraRepository.NewChangeEventRepository(db),
+		cfg.Listener.GetEventRetention(),
+	)
+	configListener = changeEventSvc.WrapListener(configListener)
+
+	// 启用事务性发件箱时，变更事件随配置写入同事务保存，由后台任务投递到监听器
+	if cfg.Listener.Outbox.Enabled {
+		eventOutbox = domainService.NewEventOutbox(
+			infraRepository.NewOutboxRepository(db),
+			configListener,
+			domainService.EventOutboxOptions{
+				PollInterval: cfg.Listener.Outbox.GetPollInterval(),
+				BatchSize:    cfg.Listener.Outbox.BatchSize,
+				Retention:    cfg.Listener.Outbox.GetRetention(),
+			},
+		)
+		eventOutbox.Start()
+	}
 
 	// 2. 创建配置仓储（用于版本查询）
 	configRepo := infraRepository.NewConfigRepository(db)
@@ -290,24 +628,54 @@ func initLongPolling() error {
 	// 3. 创建订阅仓储
 	subscriptionRepo := infraRepository.NewSubscriptionRepository(db)
 
-	// 4. 创建订阅管理器（心跳超时时间5分钟）
+	// 4. 创建订阅管理器（心跳超时和清理间隔从系统配置读取，系统配置无效时心跳超时为默认的 5 分钟）
 	subscriptionManager = domainService.NewSubscriptionManager(
 		subscriptionRepo,
 		configRepo,
 		configListener,
-		5*time.Minute,
+		time.Duration(domainService.DefaultHeartbeatTimeout)*time.Second,
 	)
+	subscriptionManager.SetSystemConfigService(systemConfigService)
+	subscriptionManager.SetChangeEventService(changeEventSvc)
+	subscriptionManager.SetSubscriptionKeyRepository(infraRepository.NewSubscriptionKeyRepository(db))
+	subscriptionManager.SetQuotaService(quotaService)
+
+	// 长轮询比较版本时优先读取缓存，收到配置变更事件时失效
+	if cfg.ConfigCache.Enabled {
+		store, err := infraCache.NewConfigCache(cfg.ConfigCache, rdb)
+		if err != nil {
+			return fmt.Errorf("创建配置读取缓存失败: %w", err)
+		}
+		configReadCache = domainService.NewConfigReadCache(store, configRepo)
+		subscriptionManager.SetConfigReadCache(configReadCache)
+		hlog.Infof("配置读取缓存已启用: backend=%s, ttl=%ds", cfg.ConfigCache.Backend, cfg.ConfigCache.TTL)
+	}
+
+	// 记录每次返回给客户端的变更通知，客户端上报新版本后标记为已确认
+	pushTraceService = domainService.NewPushTraceService(
+		infraRepository.NewPushTraceRepository(db),
+		cfg.Listener.GetPushTraceRetention(),
+	)
+	subscriptionManager.SetPushTraceService(pushTraceService)
+
+	// 多实例部署时通过 Redis 选举主节点，过期订阅清理等后台任务仅在主节点执行
+	// Redis 禁用时不选举，各实例各自执行清理（清理操作幂等）
+	if rdb != nil {
+		leaderElector = leader.NewRedisElector(rdb, "maintenance", leader.DefaultLeaseTTL)
+		leaderElector.Start()
+		subscriptionManager.SetLeaderElector(leaderElector)
+	}
 
 	// 5. 启动订阅管理器
 	if err := subscriptionManager.Start(); err != nil {
 		return fmt.Errorf("启动订阅管理器失败: %w", err)
 	}
 
-	// 6. 创建长轮询领域服务（注入系统配置服务）
+	// 6. 创建长轮询领域服务（每次请求从系统配置读取超时时间，修改 long.polling.timeout 后无需重启）
 	longPollingService = domainService.NewLongPollingService(
 		subscriptionManager,
-		60*time.Second,      // 默认超时（向后兼容）
-		systemConfigService, // 系统配置服务
+		time.Duration(domainService.DefaultLongPollingTimeout)*time.Second, // 系统配置无效时的超时时间
+		systemConfigService,
 	)
 
 	// 7. 启动长轮询服务
@@ -321,12 +689,56 @@ func initLongPolling() error {
 // initServer 初始化HTTP服务器
 func initServer() {
 	// 创建Hertz实例
-	hertzH = server.Default(
+	// 启用流式请求体：文件配置上传直接从连接读取，不受默认请求体大小限制
+	options := []hertzConfig.Option{
 		server.WithHostPorts(fmt.Sprintf(":%d", cfg.Server.Port)),
-	)
+		server.WithStreamBody(true),
+		server.WithCustomValidator(validation.NewValidator()), // 按请求 DTO 的 binding 标签校验参数
+	}
 
-	// 注册全局中间件
+	// 启用 HTTPS（配置客户端 CA 时为双向 TLS）
+	if cfg.Server.TLS.Enabled {
+		tlsConfig, err := buildTLSConfig(&cfg.Server.TLS)
+		if err != nil {
+			log.Fatalf("加载 TLS 配置失败: %v", err)
+		}
+		options = append(options, server.WithTLS(tlsConfig))
+		hlog.Infof("HTTPS 已启用: min_version=%s, mutual_tls=%v", tls.VersionName(tlsConfig.MinVersion), tlsConfig.ClientCAs != nil)
+	}
+	hertzH = server.Default(options...)
+
+	// RFC 7807 错误响应的问题类型 URI 前缀
+	if cfg.Server.ProblemTypeBaseURI != "" {
+		shareErrors.SetProblemTypeBaseURI(cfg.Server.ProblemTypeBaseURI)
+	}
+
+	// 注册全局中间件（请求ID、访问日志在最外层，记录最终响应；压缩中间件对错误响应同样生效）
+	hertzH.Use(middleware.RequestID())
+	if cfg.Tracing.Enabled {
+		hertzH.Use(middleware.Tracing())
+	}
+	if cfg.Server.AccessLog.Enabled {
+		hertzH.Use(middleware.AccessLog(cfg.Server.AccessLog))
+	}
+	if cfg.Server.Compression.Enabled {
+		hertzH.Use(middleware.Compression(cfg.Server.Compression))
+		hlog.Infof("响应压缩已启用: level=%d, min_length=%d", cfg.Server.Compression.Level, cfg.Server.Compression.MinLength)
+	}
 	hertzH.Use(middleware.Recovery())
+	if cfg.Auth.Enabled {
+		hertzH.Use(middleware.Auth(newAPIKeyAuthenticator(), cfg.Auth))
+		// 只读令牌只能访问 SDK 读取和监听配置的接口
+		hertzH.Use(middleware.RestrictReadTokens(readTokenRoutes...))
+		hlog.Infof("接口认证已启用: public_paths=%v", cfg.Auth.PublicPaths)
+	} else {
+		hlog.Warn("接口认证未启用，所有管理接口无需 API Key 即可访问")
+	}
+	// 解析请求租户（取自认证主体，未启用认证时为默认租户）
+	hertzH.Use(middleware.Tenant(tenantService))
+	// 配置了只读副本时，GET 查询接口读取副本
+	if len(cfg.Database.Replicas) > 0 {
+		hertzH.Use(middleware.ReadReplica())
+	}
 
 	// 注册路由
 	registerRoutes()
@@ -334,58 +746,8 @@ func initServer() {
 
 // registerRoutes 注册路由
 func registerRoutes() {
-	// 健康检查
-	hertzH.GET("/health", func(c context.Context, ctx *app.RequestContext) {
-		ctx.JSON(consts.StatusOK, map[string]interface{}{
-			"status":  "ok",
-			"message": "服务运行正常",
-			"time":    time.Now().Format("2006-01-02 15:04:05"),
-		})
-	})
-
-	// 数据库健康检查
-	hertzH.GET("/health/db", func(c context.Context, ctx *app.RequestContext) {
-		sqlDB, err := db.DB()
-		if err != nil {
-			ctx.JSON(consts.StatusInternalServerError, map[string]interface{}{
-				"status":  "error",
-				"message": "获取数据库连接失败",
-				"error":   err.Error(),
-			})
-			return
-		}
-
-		if err := sqlDB.Ping(); err != nil {
-			ctx.JSON(consts.StatusInternalServerError, map[string]interface{}{
-				"status":  "error",
-				"message": "数据库连接失败",
-				"error":   err.Error(),
-			})
-			return
-		}
-
-		ctx.JSON(consts.StatusOK, map[string]interface{}{
-			"status":  "ok",
-			"message": "数据库连接正常",
-		})
-	})
-
-	// Redis健康检查
-	hertzH.GET("/health/redis", func(c context.Context, ctx *app.RequestContext) {
-		if err := rdb.Ping(c).Err(); err != nil {
-			ctx.JSON(consts.StatusInternalServerError, map[string]interface{}{
-				"status":  "error",
-				"message": "Redis连接失败",
-				"error":   err.Error(),
-			})
-			return
-		}
-
-		ctx.JSON(consts.StatusOK, map[string]interface{}{
-			"status":  "ok",
-			"message": "Redis连接正常",
-		})
-	})
+	// 健康检查（存活探针、就绪探针）
+	registerHealthRoutes()
 
 	// 根路径
 	hertzH.GET("/", func(c context.Context, ctx *app.RequestContext) {
@@ -410,6 +772,40 @@ func registerRoutes() {
 	// 注册订阅管理路由
 	registerSubscriptionRoutes()
 	hlog.Info("订阅管理路由注册成功")
+
+	// 注册 API Key 管理路由
+	registerAPIKeyRoutes()
+	hlog.Info("API Key 管理路由注册成功")
+
+	// 注册租户管理路由（仅默认租户可以访问）
+	registerTenantRoutes()
+	hlog.Info("租户管理路由注册成功")
+
+	// 注册系统配置管理路由（仅默认租户可以访问）
+	registerSystemConfigRoutes()
+	hlog.Info("系统配置管理路由注册成功")
+
+	// 注册 Webhook 管理路由（webhook.enabled 时启用）
+	if webhookService != nil {
+		registerWebhookRoutes()
+		hlog.Info("Webhook 管理路由注册成功")
+	}
+
+	// 注册通知渠道管理路由（notification.enabled 时启用）
+	if notificationService != nil {
+		registerNotificationRoutes()
+		hlog.Info("通知渠道管理路由注册成功")
+	}
+
+	// 注册接口文档路由
+	registerDocRoutes()
+	hlog.Info("接口文档路由注册成功: /api/v1/openapi.json, /api/v1/docs")
+
+	// 注册调试路由（按配置开启）
+	registerDebugRoutes()
+
+	// 注册管理控制台路由（按配置开启）
+	registerConsoleRoutes()
 }
 
 // registerConfigRoutes 注册配置管理路由
@@ -420,29 +816,45 @@ func registerConfigRoutes() {
 	configRepo := infraRepository.NewConfigRepository(db)
 	changeHistoryRepo := infraRepository.NewChangeHistoryRepository(db)
 	tagRepo := infraRepository.NewConfigTagRepository(db) // 新增：标签仓储
+	namespaceRepo := infraRepository.NewNamespaceRepository(db)
+	schemaRepo := infraRepository.NewConfigSchemaRepository(db)
 
 	// 2. 创建脱敏服务（使用配置文件中的加密密钥）
 	maskingSvc := domainService.NewMaskingService(
 		cfg.Security.EncryptionKey,
 		cfg.Security.MaskingEnabled,
 	)
+	maskingSvc.SetSystemConfigService(systemConfigService)
 	hlog.Infof("脱敏服务已创建，启用状态: %v", cfg.Security.MaskingEnabled)
 
-	// 3. 创建标签服务
+	// 3. 创建标签服务和 Schema 校验服务
 	tagSvc := domainService.NewConfigTagService(tagRepo, maskingSvc)
 	hlog.Info("标签服务已创建")
+	schemaSvc := domainService.NewConfigSchemaService(schemaRepo, namespaceRepo)
 
 	// 4. 创建变更历史领域服务（传入脱敏服务）
 	changeHistoryService := domainService.NewChangeHistoryService(changeHistoryRepo, configRepo, nil, maskingSvc)
 
-	// 5. 创建配置领域服务实例（传入配置监听器、变更历史服务、脱敏服务和标签服务）
+	// 5. 创建配置领域服务实例（传入配置监听器、变更历史服务、脱敏服务、标签服务和 Schema 校验服务）
 	configDomainService := domainService.NewConfigService(
 		configRepo,
 		configListener,
 		changeHistoryService,
 		maskingSvc, // 新增：脱敏服务
 		tagSvc,     // 新增：标签服务
+		schemaSvc,
 	)
+	if eventOutbox != nil {
+		configDomainService.SetEventOutbox(eventOutbox)
+	}
+	if webhookService != nil {
+		configDomainService.SetWebhookService(webhookService)
+	}
+	configDomainService.SetQuotaService(quotaService)
+	configDomainService.SetTransactionManager(gormRepo.NewTransactionManager(db))
+	if err := configDomainService.SetHashAlgorithm(cfg.Security.ContentHashAlgorithm); err != nil {
+		log.Fatalf("初始化内容哈希算法失败: %v", err)
+	}
 
 	// 6. 更新变更历史服务的配置服务引用（用于回滚）
 	changeHistoryService = domainService.NewChangeHistoryService(changeHistoryRepo, configRepo, configDomainService, maskingSvc)
@@ -450,8 +862,26 @@ func registerConfigRoutes() {
 	// 7. 创建转换器实例（传入脱敏服务和标签服务）
 	configConverter := converter.NewConfigConverter(maskingSvc, tagSvc)
 
-	// 8. 创建应用服务实例
-	configAppService := service.NewConfigAppService(configDomainService, configConverter)
+	// 8. 创建应用服务实例（传入配置引用解析服务和发布服务，发布服务用于按键读取时的灰度判断）
+	referenceResolver := domainService.NewConfigReferenceResolver(configRepo, namespaceRepo, maskingSvc)
+	releaseDomainService := domainService.NewReleaseService(
+		infraRepository.NewReleaseRepository(db),
+		configRepo,
+		configDomainService,
+		configListener,
+		domainService.NewCanaryRuleEngine(),
+	)
+	if eventOutbox != nil {
+		releaseDomainService.SetEventOutbox(eventOutbox)
+	}
+	if webhookService != nil {
+		releaseDomainService.SetWebhookService(webhookService)
+	}
+	if notificationService != nil {
+		releaseDomainService.SetNotificationService(notificationService)
+	}
+	releaseDomainService.SetQuotaService(quotaService)
+	configAppService := service.NewConfigAppService(configDomainService, referenceResolver, releaseDomainService, configConverter)
 	changeHistoryAppService := service.NewChangeHistoryAppService(changeHistoryService)
 
 	// 9. 创建HTTP处理器实例
@@ -459,34 +889,168 @@ func registerConfigRoutes() {
 	changeHistoryHandler := configHttp.NewChangeHistoryHandler(changeHistoryAppService)
 
 	// 10. 创建长轮询应用服务
-	longPollingAppService := service.NewLongPollingAppService(longPollingService, configRepo)
+	longPollingAppService := service.NewLongPollingAppService(longPollingService, configDomainService, releaseDomainService)
+	if configSigner != nil {
+		configAppService.SetConfigSigner(configSigner)
+		longPollingAppService.SetConfigSigner(configSigner)
+	}
 	longPollingHandler := configHttp.NewLongPollingHandler(longPollingAppService)
 
-	// 11. 注册路由
+	// 11. 创建配置导入导出服务（渲染命名空间配置时复用引用解析服务）
+	namespaceDomainService := domainService.NewNamespaceService(namespaceRepo, configRepo)
+	transferDomainService := domainService.NewConfigTransferService(configRepo, namespaceRepo, configDomainService, namespaceDomainService, tagSvc, maskingSvc, referenceResolver)
+	transferAppService := service.NewConfigTransferAppService(transferDomainService, converter.NewConfigTransferConverter())
+	transferHandler := configHttp.NewConfigTransferHandler(transferAppService)
+
+	// 12. 创建配置 Schema 管理服务
+	schemaAppService := service.NewConfigSchemaAppService(schemaSvc, converter.NewConfigSchemaConverter())
+	schemaHandler := configHttp.NewConfigSchemaHandler(schemaAppService)
+
+	// 13. 创建配置分组管理服务
+	groupDomainService := domainService.NewConfigGroupService(infraRepository.NewConfigGroupRepository(db), configRepo, namespaceRepo, configDomainService)
+	groupAppService := service.NewConfigGroupAppService(groupDomainService, transferDomainService, converter.NewConfigGroupConverter(), converter.NewConfigTransferConverter())
+	groupHandler := configHttp.NewConfigGroupHandler(groupAppService)
+
+	// 14. 创建配置标签管理服务
+	tagAppService := service.NewConfigTagAppService(tagSvc, configDomainService, converter.NewConfigTagConverter())
+	tagHandler := configHttp.NewConfigTagHandler(tagAppService)
+
+	// 15. 创建配置依赖管理服务（影响分析需要查询订阅）
+	dependencyDomainService := domainService.NewConfigDependencyService(
+		infraRepository.NewConfigDependencyRepository(db),
+		configRepo,
+		namespaceRepo,
+		infraRepository.NewSubscriptionRepository(db),
+	)
+	dependencyAppService := service.NewConfigDependencyAppService(dependencyDomainService, converter.NewConfigDependencyConverter())
+	dependencyHandler := configHttp.NewConfigDependencyHandler(dependencyAppService)
+
+	// 16. 创建文件配置服务（文件内容单独存储，配置值保存内容哈希引用）
+	fileDomainService := domainService.NewConfigFileService(
+		infraRepository.NewConfigFileRepository(db),
+		configRepo,
+		configDomainService,
+		cfg.File.MaxSize,
+	)
+	fileAppService := service.NewConfigFileAppService(fileDomainService, converter.NewConfigFileConverter())
+	fileHandler := configHttp.NewConfigFileHandler(fileAppService)
+
+	// 17. 启动配置过期处理任务（过期时通过配置领域服务发布变更事件）
+	if err := initConfigExpiry(configRepo, configDomainService); err != nil {
+		log.Fatalf("初始化配置过期处理任务失败: %v", err)
+	}
+
+	// 18. 创建限流中间件（作用于长轮询和查询接口）和幂等中间件（作用于写接口）
+	rateLimit := newRateLimitMiddleware()
+	idempotent := newIdempotencyMiddleware()
+
+	// 19. 注册路由（文件上传流式读取请求体，不经过需要读取请求体的幂等中间件）
 	api := hertzH.Group("/api/v1")
 	{
 		configs := api.Group("/configs")
 		{
-			configs.POST("", configHandler.CreateConfig)      // 创建配置
-			configs.PUT("", configHandler.UpdateConfig)       // 更新配置（ID在请求体中）
-			configs.GET("", configHandler.QueryConfigs)       // 分页查询配置
-			configs.POST("/get", configHandler.GetConfigByID) // 根据ID获取配置（ID在请求体中）
-			configs.DELETE("", configHandler.DeleteConfig)    // 删除配置（ID在请求体中）
-			configs.POST("/watch", longPollingHandler.Watch)  // 长轮询监听配置变更
+			configs.POST("", idempotent, configHandler.CreateConfig)                    // 创建配置
+			configs.PUT("", idempotent, configHandler.UpdateConfig)                     // 更新配置（ID在请求体中）
+			configs.GET("", rateLimit, configHandler.QueryConfigs)                      // 分页查询配置
+			configs.POST("/get", rateLimit, configHandler.GetConfigByID)                // 根据ID获取配置（ID在请求体中）
+			configs.GET("/key", rateLimit, configHandler.GetConfigByKey)                // 根据配置键获取已发布配置（支持灰度）
+			configs.POST("/bulk-get", rateLimit, configHandler.BulkGetConfigs)          // 批量根据配置键获取已发布配置（支持灰度）
+			configs.GET("/effective", rateLimit, configHandler.GetEffectiveConfigs)     // 获取生效配置（已解析引用）
+			configs.POST("/validate", configHandler.ValidateConfig)                     // 校验配置（仅校验，不保存）
+			configs.POST("/batch", idempotent, configHandler.BatchMutateConfigs)        // 批量变更配置（单个事务）
+			configs.DELETE("", configHandler.DeleteConfig)                              // 删除配置（ID在请求体中）
+			configs.GET("/search", rateLimit, configHandler.SearchConfigs)              // 搜索配置（键、描述、分组、值）
+			configs.POST("/query-by-tags", rateLimit, configHandler.QueryConfigsByTags) // 按标签查询配置（AND 语义）
+			configs.GET("/trash", configHandler.QueryTrash)                             // 查询回收站（已删除配置）
+			configs.POST("/restore", idempotent, configHandler.RestoreConfig)           // 从回收站恢复配置
+			configs.POST("/content-hash/migrate", configHandler.MigrateContentHashes)   // 迁移配置内容哈希算法
+			configs.GET("/tags", rateLimit, tagHandler.GetTags)                         // 查询配置标签
+			configs.POST("/tags", idempotent, tagHandler.AddTags)                       // 添加配置标签
+			configs.PUT("/tags", idempotent, tagHandler.ReplaceTags)                    // 全量替换配置标签
+			configs.DELETE("/tags", tagHandler.RemoveTags)                              // 按标签键删除配置标签
+			configs.POST("/tags/regenerate", tagHandler.RegenerateTags)                 // 重新生成自动标签
+			configs.GET("/dependencies", rateLimit, dependencyHandler.GetDependencies)  // 查询配置显式依赖
+			configs.PUT("/dependencies", idempotent, dependencyHandler.SetDependencies) // 全量替换配置显式依赖
+			configs.GET("/dependencies/graph", rateLimit, dependencyHandler.GetGraph)   // 查询命名空间依赖图
+			configs.POST("/impact", dependencyHandler.AnalyzeImpact)                    // 发布前变更影响分析
+			configs.PUT("/files", fileHandler.UploadFile)                               // 上传文件配置（请求体为文件原始内容）
+			configs.GET("/files/download", rateLimit, fileHandler.DownloadFile)         // 下载文件配置
+			configs.GET("/files/info", rateLimit, fileHandler.GetFileInfo)              // 查询文件配置信息
+			configs.GET("/:id", rateLimit, configHandler.GetConfig)                     // 根据ID获取配置（RESTful）
+			configs.DELETE("/:id", configHandler.RemoveConfig)                          // 删除配置（RESTful）
+			configs.POST("/watch", rateLimit, longPollingHandler.Watch)                 // 长轮询监听配置变更
+			configs.GET("/watch/ws", rateLimit, longPollingHandler.WatchWebSocket)      // 通过 WebSocket 监听配置变更
+			configs.POST("/import", transferHandler.ImportConfigs)                      // 批量导入配置
+			configs.POST("/promote/preview", transferHandler.PreviewPromotion)          // 预览环境晋升差异
+			configs.POST("/promote", transferHandler.ApplyPromotion)                    // 执行环境晋升
 		}
 
 		history := api.Group("/history")
 		{
-			history.GET("", changeHistoryHandler.QueryHistory)             // 分页查询变更历史
-			history.POST("/get", changeHistoryHandler.GetHistoryByID)      // 根据ID查询变更记录（ID在请求体中）
-			history.GET("/statistics", changeHistoryHandler.GetStatistics) // 获取变更统计
-			history.GET("/config", changeHistoryHandler.GetConfigHistory)  // 获取配置变更历史
-			history.POST("/compare", changeHistoryHandler.CompareVersions) // 对比版本
-			history.POST("/rollback", changeHistoryHandler.Rollback)       // 回滚配置
+			history.GET("", changeHistoryHandler.QueryHistory)                   // 分页查询变更历史
+			history.POST("/get", changeHistoryHandler.GetHistoryByID)            // 根据ID查询变更记录（ID在请求体中）
+			history.GET("/statistics", changeHistoryHandler.GetStatistics)       // 获取变更统计
+			history.GET("/export", changeHistoryHandler.ExportHistory)           // 导出变更历史（CSV/JSON 流式输出）
+			history.GET("/config", changeHistoryHandler.GetConfigHistory)        // 获取配置变更历史
+			history.POST("/compare", changeHistoryHandler.CompareVersions)       // 对比版本
+			history.POST("/rollback", idempotent, changeHistoryHandler.Rollback) // 回滚配置
+		}
+
+		namespaces := api.Group("/namespaces")
+		{
+			namespaces.GET("/export", transferHandler.ExportNamespace)                // 导出命名空间配置
+			namespaces.POST("/clone", transferHandler.CloneNamespace)                 // 克隆命名空间
+			namespaces.GET("/:id/render", rateLimit, transferHandler.RenderNamespace) // 渲染命名空间生效配置（sidecar 拉取完整配置文件）
+		}
+
+		groups := api.Group("/groups")
+		{
+			groups.POST("", groupHandler.CreateGroup)                      // 创建配置分组
+			groups.PUT("", groupHandler.UpdateGroup)                       // 更新配置分组（ID在请求体中）
+			groups.DELETE("", groupHandler.DeleteGroup)                    // 删除配置分组（ID在请求体中）
+			groups.GET("", groupHandler.ListGroups)                        // 查询命名空间下的配置分组及统计
+			groups.POST("/get", groupHandler.GetGroup)                     // 根据ID获取配置分组（ID在请求体中）
+			groups.POST("/release", idempotent, groupHandler.ReleaseGroup) // 发布整个分组
+			groups.GET("/export", groupHandler.ExportGroup)                // 导出分组配置
+		}
+
+		schemas := api.Group("/schemas")
+		{
+			schemas.POST("", schemaHandler.CreateSchema)      // 创建 Schema 绑定
+			schemas.PUT("", schemaHandler.UpdateSchema)       // 更新 Schema 绑定（ID在请求体中）
+			schemas.DELETE("", schemaHandler.DeleteSchema)    // 删除 Schema 绑定（ID在请求体中）
+			schemas.GET("", schemaHandler.ListSchemas)        // 查询命名空间下的 Schema 绑定
+			schemas.POST("/get", schemaHandler.GetSchemaByID) // 根据ID获取 Schema 绑定（ID在请求体中）
 		}
 	}
 }
 
+// newRateLimitMiddleware 创建限流中间件，未启用时返回直接放行的中间件
+func newRateLimitMiddleware() app.HandlerFunc {
+	if !cfg.Server.RateLimit.Enabled {
+		return func(c context.Context, ctx *app.RequestContext) {
+			ctx.Next(c)
+		}
+	}
+
+	limiter := middleware.NewRateLimiter(cfg.Server.RateLimit, rdb)
+	hlog.Infof("限流已启用: backend=%s, per_ip=%.1f/s, per_client=%.1f/s",
+		cfg.Server.RateLimit.Backend, cfg.Server.RateLimit.PerIP.Rate, cfg.Server.RateLimit.PerClient.Rate)
+	return middleware.RateLimit(limiter, cfg.Server.RateLimit)
+}
+
+// newIdempotencyMiddleware 创建幂等中间件，未启用时返回直接放行的中间件
+func newIdempotencyMiddleware() app.HandlerFunc {
+	if !cfg.Server.Idempotency.Enabled {
+		return func(c context.Context, ctx *app.RequestContext) {
+			ctx.Next(c)
+		}
+	}
+
+	store := middleware.NewIdempotencyStore(cfg.Server.Idempotency, rdb)
+	return middleware.Idempotency(store, cfg.Server.Idempotency)
+}
+
 // registerNamespaceRoutes 注册命名空间管理路由
 func registerNamespaceRoutes() {
 	// 初始化依赖层级：Repository -> DomainService -> AppService -> Handler
@@ -502,7 +1066,7 @@ func registerNamespaceRoutes() {
 	namespaceConverter := converter.NewNamespaceConverter()
 
 	// 4. 创建应用服务实例
-	namespaceAppService := service.NewNamespaceAppService(namespaceDomainService, namespaceConverter, namespaceRepo)
+	namespaceAppService := service.NewNamespaceAppService(namespaceDomainService, namespaceConverter, namespaceRepo, quotaService)
 
 	// 5. 创建HTTP处理器实例
 	namespaceHandler := configHttp.NewNamespaceHandler(namespaceAppService)
@@ -512,17 +1076,20 @@ func registerNamespaceRoutes() {
 	{
 		namespaces := api.Group("/namespaces")
 		{
-			namespaces.POST("", namespaceHandler.CreateNamespace)                // 创建命名空间
-			namespaces.PUT("", namespaceHandler.UpdateNamespace)                 // 更新命名空间（ID在请求体中）
-			namespaces.DELETE("", namespaceHandler.DeleteNamespace)              // 删除命名空间（ID在请求体中）
-			namespaces.PUT("/activate", namespaceHandler.ActivateNamespace)      // 激活命名空间（ID在请求体中）
-			namespaces.PUT("/deactivate", namespaceHandler.DeactivateNamespace)  // 停用命名空间（ID在请求体中）
-			namespaces.GET("", namespaceHandler.QueryNamespaces)                 // 分页查询命名空间
-			namespaces.POST("/get", namespaceHandler.GetNamespaceByID)           // 根据ID获取命名空间（ID在请求体中）
-			namespaces.GET("/name", namespaceHandler.GetNamespaceByName)         // 根据名称获取命名空间
-			namespaces.GET("/active", namespaceHandler.GetActiveNamespace)       // 获取激活的命名空间
-			namespaces.GET("/all", namespaceHandler.ListAllNamespaces)           // 获取所有命名空间（不分页）
-			namespaces.GET("/active/all", namespaceHandler.ListActiveNamespaces) // 获取所有激活的命名空间（不分页）
+			namespaces.POST("", namespaceHandler.CreateNamespace)                                                 // 创建命名空间
+			namespaces.PUT("", namespaceHandler.UpdateNamespace)                                                  // 更新命名空间（ID在请求体中）
+			namespaces.DELETE("", namespaceHandler.DeleteNamespace)                                               // 删除命名空间（ID在请求体中）
+			namespaces.PUT("/activate", namespaceHandler.ActivateNamespace)                                       // 激活命名空间（ID在请求体中）
+			namespaces.PUT("/deactivate", namespaceHandler.DeactivateNamespace)                                   // 停用命名空间（ID在请求体中）
+			namespaces.GET("", namespaceHandler.QueryNamespaces)                                                  // 分页查询命名空间
+			namespaces.POST("/get", namespaceHandler.GetNamespaceByID)                                            // 根据ID获取命名空间（ID在请求体中）
+			namespaces.GET("/name", namespaceHandler.GetNamespaceByName)                                          // 根据名称获取命名空间
+			namespaces.GET("/active", namespaceHandler.GetActiveNamespace)                                        // 获取激活的命名空间
+			namespaces.GET("/all", namespaceHandler.ListAllNamespaces)                                            // 获取所有命名空间（不分页）
+			namespaces.GET("/active/all", namespaceHandler.ListActiveNamespaces)                                  // 获取所有激活的命名空间（不分页）
+			namespaces.GET("/:id", namespaceHandler.GetNamespace)                                                 // 根据ID获取命名空间（RESTful）
+			namespaces.GET("/:id/stats", namespaceHandler.GetNamespaceStats)                                      // 命名空间用量及生效配额
+			namespaces.PUT("/:id/quota", middleware.RequireSystemTenant(), namespaceHandler.UpdateNamespaceQuota) // 设置命名空间配额（仅默认租户）
 		}
 	}
 }
@@ -548,7 +1115,19 @@ func registerReleaseRoutes() {
 		nil, // 暂时不需要变更历史服务
 		maskingSvc,
 		nil, // 暂时不需要标签服务
+		nil, // 发布流程不修改配置值，无需 Schema 校验
 	)
+	if eventOutbox != nil {
+		configDomainService.SetEventOutbox(eventOutbox)
+	}
+	if webhookService != nil {
+		configDomainService.SetWebhookService(webhookService)
+	}
+	configDomainService.SetQuotaService(quotaService)
+	configDomainService.SetTransactionManager(gormRepo.NewTransactionManager(db))
+	if err := configDomainService.SetHashAlgorithm(cfg.Security.ContentHashAlgorithm); err != nil {
+		log.Fatalf("初始化内容哈希算法失败: %v", err)
+	}
 
 	// 4. 创建灰度规则引擎
 	canaryEngine := domainService.NewCanaryRuleEngine()
@@ -561,6 +1140,35 @@ func registerReleaseRoutes() {
 		configListener,
 		canaryEngine,
 	)
+	if eventOutbox != nil {
+		releaseDomainService.SetEventOutbox(eventOutbox)
+	}
+	if webhookService != nil {
+		releaseDomainService.SetWebhookService(webhookService)
+	}
+	if notificationService != nil {
+		releaseDomainService.SetNotificationService(notificationService)
+	}
+	releaseDomainService.SetQuotaService(quotaService)
+
+	if len(cfg.Release.ApprovalEnvironments) > 0 {
+		releaseDomainService.SetApprovalGate(infraRepository.NewReleaseApprovalRepository(db), cfg.Release.ApprovalEnvironments)
+		hlog.Infof("发布审批已启用，受保护环境: %v", cfg.Release.ApprovalEnvironments)
+	}
+
+	if len(cfg.Release.FreezeWindows) > 0 {
+		windows := make([]*domainEntity.FreezeWindow, 0, len(cfg.Release.FreezeWindows))
+		for _, windowCfg := range cfg.Release.FreezeWindows {
+			window, err := domainService.NewFreezeWindow(windowCfg.Name, windowCfg.NamespaceIDs, windowCfg.Environments,
+				windowCfg.Start, windowCfg.End, windowCfg.Timezone)
+			if err != nil {
+				log.Fatalf("发布冻结窗口配置无效: %v", err)
+			}
+			windows = append(windows, window)
+		}
+		releaseDomainService.SetFreezeWindows(windows, infraRepository.NewReleaseFreezeOverrideRepository(db))
+		hlog.Infof("发布冻结窗口已启用: count=%d", len(windows))
+	}
 
 	// 6. 设置订阅管理器的发布服务引用（用于灰度发布）
 	subscriptionManager.SetReleaseService(releaseDomainService)
@@ -575,20 +1183,27 @@ func registerReleaseRoutes() {
 	// 9. 创建HTTP处理器实例
 	releaseHandler := configHttp.NewReleaseHandler(releaseAppService)
 
-	// 10. 注册路由
+	// 10. 注册路由（创建、发布、回滚支持 Idempotency-Key）
+	idempotent := newIdempotencyMiddleware()
 	api := hertzH.Group("/api/v1")
 	{
 		releases := api.Group("/releases")
 		{
-			releases.POST("", releaseHandler.CreateRelease)                   // 创建发布版本
-			releases.POST("/publish-full", releaseHandler.PublishFull)        // 全量发布
-			releases.POST("/publish-canary", releaseHandler.PublishCanary)    // 灰度发布
-			releases.POST("/rollback", releaseHandler.Rollback)               // 回滚版本
-			releases.GET("", releaseHandler.QueryReleases)                    // 分页查询发布版本
-			releases.GET("/:id", releaseHandler.GetReleaseByID)               // 根据ID查询发布版本
-			releases.GET("/latest", releaseHandler.GetLatestPublishedRelease) // 获取最新已发布版本
-			releases.GET("/list", releaseHandler.ListReleasesByNamespace)     // 查询命名空间下的所有版本
-			releases.POST("/compare", releaseHandler.CompareReleases)         // 对比两个版本
+			releases.POST("", idempotent, releaseHandler.CreateRelease)                // 创建发布版本
+			releases.POST("/publish-full", idempotent, releaseHandler.PublishFull)     // 全量发布
+			releases.POST("/publish-canary", idempotent, releaseHandler.PublishCanary) // 灰度发布
+			releases.POST("/rollback", idempotent, releaseHandler.Rollback)            // 回滚版本
+			releases.GET("", releaseHandler.QueryReleases)                             // 分页查询发布版本
+			releases.GET("/:id", releaseHandler.GetReleaseByID)                        // 根据ID查询发布版本
+			releases.GET("/:id/changelog", releaseHandler.GetChangelog)                // 获取发布版本变更日志
+			releases.POST("/:id/approve", releaseHandler.ApproveRelease)               // 审批通过发布版本
+			releases.POST("/:id/reject", releaseHandler.RejectRelease)                 // 驳回发布版本
+			releases.GET("/:id/approvals", releaseHandler.ListApprovals)               // 查询发布版本审批记录
+			releases.GET("/latest", releaseHandler.GetLatestPublishedRelease)          // 获取最新已发布版本
+			releases.GET("/list", releaseHandler.ListReleasesByNamespace)              // 查询命名空间下的所有版本
+			releases.GET("/freeze-status", releaseHandler.GetFreezeStatus)             // 查询当前是否处于发布冻结窗口
+			releases.GET("/freeze-overrides", releaseHandler.ListFreezeOverrides)      // 查询冻结窗口覆盖记录
+			releases.POST("/compare", releaseHandler.CompareReleases)                  // 对比两个版本
 		}
 	}
 }
@@ -607,6 +1222,7 @@ func registerSubscriptionRoutes() {
 	subscriptionAppService := service.NewSubscriptionAppService(
 		subscriptionRepo,
 		subscriptionManager,
+		pushTraceService,
 		systemConfigService,
 		subscriptionConverter,
 	)
@@ -619,15 +1235,154 @@ func registerSubscriptionRoutes() {
 	{
 		subscriptions := api.Group("/subscriptions")
 		{
-			subscriptions.GET("", subscriptionHandler.QuerySubscriptions)                 // 分页查询订阅
-			subscriptions.POST("/deactivate", subscriptionHandler.DeactivateSubscription) // 停用订阅
-			subscriptions.GET("/statistics", subscriptionHandler.GetStatistics)           // 订阅统计
+			subscriptions.GET("", subscriptionHandler.QuerySubscriptions)                  // 分页查询订阅
+			subscriptions.POST("/deactivate", subscriptionHandler.DeactivateSubscription)  // 停用订阅
+			subscriptions.GET("/statistics", subscriptionHandler.GetStatistics)            // 订阅统计
+			subscriptions.POST("/heartbeat", subscriptionHandler.Heartbeat)                // 客户端心跳（两次长轮询之间保持订阅活跃）
+			subscriptions.POST("/unsubscribe", subscriptionHandler.Unsubscribe)            // 客户端取消订阅（SDK 关闭时调用）
+			subscriptions.GET("/client", subscriptionHandler.GetClientSubscriptions)       // 查询客户端订阅及监听的配置键
+			subscriptions.POST("/deactivate-client", subscriptionHandler.DeactivateClient) // 停用客户端的全部订阅
+			subscriptions.GET("/listeners", subscriptionHandler.GetListenerStatus)         // 配置键监听状态（客户端版本是否已同步）
+			subscriptions.GET("/push-traces", subscriptionHandler.QueryPushTraces)         // 变更通知下发记录（可按发布版本查询）
+			subscriptions.GET("/:id", subscriptionHandler.GetSubscription)                 // 订阅详情（含内存中的长轮询状态）
+		}
+	}
+}
+
+// readTokenRoutes 只读令牌可以访问的接口（SDK 读取配置、长轮询和 WebSocket 监听、心跳和取消订阅）
+var readTokenRoutes = []string{
+	"GET /api/v1/configs",
+	"GET /api/v1/configs/key",
+	"POST /api/v1/configs/bulk-get",
+	"GET /api/v1/configs/effective",
+	"POST /api/v1/configs/watch",
+	"GET /api/v1/configs/watch/ws",
+	"POST /api/v1/subscriptions/heartbeat",
+	"POST /api/v1/subscriptions/unsubscribe",
+}
+
+// newAPIKeyAuthenticator 创建基于 API Key 的请求认证器（只读令牌携带绑定的读取范围）
+func newAPIKeyAuthenticator() middleware.Authenticator {
+	return middleware.AuthenticatorFunc(func(ctx context.Context, token string) (*middleware.Principal, error) {
+		key, err := apiKeyService.Authenticate(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		principal := &middleware.Principal{ID: key.ID, Name: key.Name, Role: key.Role, TenantID: key.TenantID}
+		if key.IsReadToken() {
+			principal.Scope = &middleware.ReadScope{
+				NamespaceID: key.NamespaceID,
+				Environment: key.Environment,
+				KeyPrefixes: key.KeyPrefixes,
+			}
+		}
+		return principal, nil
+	})
+}
+
+// registerAPIKeyRoutes 注册 API Key 管理路由
+func registerAPIKeyRoutes() {
+	// 初始化依赖层级：DomainService -> AppService -> Handler
+	apiKeyAppService := service.NewAPIKeyAppService(apiKeyService, converter.NewAPIKeyConverter())
+	apiKeyHandler := configHttp.NewAPIKeyHandler(apiKeyAppService)
+
+	api := hertzH.Group("/api/v1")
+	{
+		apiKeys := api.Group("/api-keys")
+		{
+			apiKeys.POST("", apiKeyHandler.CreateAPIKey)            // 创建 API Key（密钥明文仅返回一次）
+			apiKeys.GET("", apiKeyHandler.ListAPIKeys)              // 查询 API Key 列表
+			apiKeys.GET("/:id", apiKeyHandler.GetAPIKey)            // 根据ID查询 API Key
+			apiKeys.POST("/:id/revoke", apiKeyHandler.RevokeAPIKey) // 吊销 API Key
+		}
+	}
+}
+
+// registerTenantRoutes 注册租户管理路由
+func registerTenantRoutes() {
+	// 初始化依赖层级：DomainService -> AppService -> Handler
+	tenantAppService := service.NewTenantAppService(tenantService, converter.NewTenantConverter())
+	tenantHandler := configHttp.NewTenantHandler(tenantAppService)
+
+	api := hertzH.Group("/api/v1")
+	{
+		tenants := api.Group("/tenants", middleware.RequireSystemTenant())
+		{
+			tenants.POST("", tenantHandler.CreateTenant) // 创建租户
+			tenants.PUT("", tenantHandler.UpdateTenant)  // 更新租户（ID在请求体中）
+			tenants.GET("", tenantHandler.ListTenants)   // 查询全部租户
+			tenants.GET("/:id", tenantHandler.GetTenant) // 根据ID查询租户
+		}
+	}
+}
+
+// registerSystemConfigRoutes 注册系统配置管理路由
+func registerSystemConfigRoutes() {
+	// 初始化依赖层级：DomainService -> AppService -> Handler
+	systemConfigAppService := service.NewSystemConfigAppService(systemConfigService, converter.NewSystemConfigConverter())
+	systemConfigHandler := configHttp.NewSystemConfigHandler(systemConfigAppService)
+
+	api := hertzH.Group("/api/v1")
+	{
+		systemConfigs := api.Group("/system-configs", middleware.RequireSystemTenant())
+		{
+			systemConfigs.GET("", systemConfigHandler.ListSystemConfigs)                       // 查询全部系统配置
+			systemConfigs.POST("/refresh", systemConfigHandler.RefreshSystemConfigCache)       // 刷新系统配置缓存
+			systemConfigs.GET("/:key", systemConfigHandler.GetSystemConfig)                    // 根据配置键查询系统配置
+			systemConfigs.PUT("/:key", systemConfigHandler.SetSystemConfig)                    // 设置系统配置（不存在时创建）
+			systemConfigs.POST("/:key/activate", systemConfigHandler.ActivateSystemConfig)     // 启用系统配置
+			systemConfigs.POST("/:key/deactivate", systemConfigHandler.DeactivateSystemConfig) // 禁用系统配置
+		}
+	}
+}
+
+// registerWebhookRoutes 注册 Webhook 管理路由
+func registerWebhookRoutes() {
+	// 初始化依赖层级：DomainService -> AppService -> Handler
+	webhookAppService := service.NewWebhookAppService(webhookService, converter.NewWebhookConverter())
+	webhookHandler := configHttp.NewWebhookHandler(webhookAppService)
+
+	api := hertzH.Group("/api/v1")
+	{
+		webhooks := api.Group("/webhooks")
+		{
+			webhooks.POST("", webhookHandler.CreateWebhook)                      // 创建 Webhook
+			webhooks.PUT("", webhookHandler.UpdateWebhook)                       // 更新 Webhook（ID在请求体中）
+			webhooks.DELETE("", webhookHandler.DeleteWebhook)                    // 删除 Webhook（ID在请求体中）
+			webhooks.GET("", webhookHandler.ListWebhooks)                        // 查询命名空间下的 Webhook
+			webhooks.GET("/deliveries/:id", webhookHandler.GetDelivery)          // 查询投递记录详情
+			webhooks.POST("/deliveries/:id/redeliver", webhookHandler.Redeliver) // 重新投递
+			webhooks.GET("/:id", webhookHandler.GetWebhook)                      // 根据ID查询 Webhook
+			webhooks.GET("/:id/deliveries", webhookHandler.QueryDeliveries)      // 查询投递记录
+		}
+	}
+}
+
+// registerNotificationRoutes 注册通知渠道管理路由
+func registerNotificationRoutes() {
+	// 初始化依赖层级：DomainService -> AppService -> Handler
+	notificationAppService := service.NewNotificationAppService(notificationService, converter.NewNotificationChannelConverter())
+	notificationHandler := configHttp.NewNotificationHandler(notificationAppService)
+
+	api := hertzH.Group("/api/v1")
+	{
+		channels := api.Group("/notification-channels")
+		{
+			channels.POST("", notificationHandler.CreateChannel)        // 创建通知渠道
+			channels.PUT("", notificationHandler.UpdateChannel)         // 更新通知渠道（ID在请求体中）
+			channels.DELETE("", notificationHandler.DeleteChannel)      // 删除通知渠道（ID在请求体中）
+			channels.GET("", notificationHandler.ListChannels)          // 查询命名空间下的通知渠道
+			channels.GET("/:id", notificationHandler.GetChannel)        // 根据ID查询通知渠道
+			channels.POST("/:id/test", notificationHandler.TestChannel) // 发送测试消息
 		}
 	}
 }
 
 // gracefulShutdown 优雅关闭
 func gracefulShutdown() {
+	// 标记为关闭中，就绪探针返回 503
+	shuttingDown.Store(true)
+
 	// 关闭长轮询服务
 	if longPollingService != nil {
 		hlog.Info("正在关闭长轮询服务...")
@@ -636,6 +1391,46 @@ func gracefulShutdown() {
 		}
 	}
 
+	// 停止系统配置定期加载任务
+	if systemConfigService != nil {
+		systemConfigService.Stop()
+	}
+
+	// 停止变更历史清理任务（需在释放主节点身份前停止）
+	if historyRetention != nil {
+		hlog.Info("正在停止变更历史清理任务...")
+		historyRetention.Stop()
+	}
+
+	// 停止配置过期处理任务（需在释放主节点身份和停止发件箱前停止）
+	if configExpiry != nil {
+		hlog.Info("正在停止配置过期处理任务...")
+		configExpiry.Stop()
+	}
+
+	// 释放主节点身份，便于其他实例立即接管后台任务
+	if leaderElector != nil {
+		leaderElector.Stop()
+	}
+
+	// 停止发件箱投递任务（需在监听器关闭前停止，未投递的事件在下次启动后继续投递）
+	if eventOutbox != nil {
+		hlog.Info("正在停止事务性发件箱投递任务...")
+		eventOutbox.Stop()
+	}
+
+	// 停止 Webhook 投递任务（未投递的记录在下次启动后继续投递）
+	if webhookService != nil {
+		hlog.Info("正在停止 Webhook 投递任务...")
+		webhookService.Stop()
+	}
+
+	// 停止发布通知（等待发送中的通知完成）
+	if notificationService != nil {
+		hlog.Info("正在停止发布通知...")
+		notificationService.Stop()
+	}
+
 	// 关闭订阅管理器
 	if subscriptionManager != nil {
 		hlog.Info("正在关闭订阅管理器...")
@@ -669,4 +1464,13 @@ func gracefulShutdown() {
 			hlog.Errorf("关闭Redis连接失败: %v", err)
 		}
 	}
+
+	// 关闭链路追踪（导出剩余 span）
+	if tracingShutdown != nil {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := tracingShutdown(shutdownCtx); err != nil {
+			hlog.Errorf("关闭链路追踪失败: %v", err)
+		}
+	}
 }
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+
+	infraEntity "config-client/config/infrastructure/entity"
+	"config-client/config/infrastructure/migration"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// migrateCommand 单独执行数据库迁移后退出（部署流水线或 init 容器中使用），为空时正常启动服务
+var migrateCommand = flag.String("migrate", "", "执行数据库迁移后退出: up, down [N], version")
+
+// migrateDatabase 启动时执行数据库迁移
+// 1. database.migrate：执行内嵌的版本化迁移脚本
+// 2. database.auto_migrate：按持久化对象执行 GORM AutoMigrate，补齐缺失的表和列
+func migrateDatabase() error {
+	if cfg.Database.Migrate {
+		migrator, err := migration.NewMigrator(db)
+		if err != nil {
+			return err
+		}
+		applied, err := migrator.Up(context.Background())
+		if err != nil {
+			return err
+		}
+		hlog.Infof("数据库版本化迁移完成: 执行 %d 个迁移，当前版本 %d", applied, migrator.LatestVersion())
+	}
+
+	if cfg.Database.AutoMigrate {
+		hlog.Info("执行数据库自动迁移...")
+		if err := db.AutoMigrate(infraEntity.Models()...); err != nil {
+			return fmt.Errorf("数据库自动迁移失败: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// runMigrateCommand 执行 -migrate 指定的迁移命令
+func runMigrateCommand(command string, args []string) error {
+	migrator, err := migration.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	switch command {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			return err
+		}
+		hlog.Infof("执行 %d 个迁移，当前版本 %d", applied, migrator.LatestVersion())
+	case "down":
+		steps := 1
+		if len(args) > 0 {
+			if steps, err = strconv.Atoi(args[0]); err != nil || steps <= 0 {
+				return fmt.Errorf("回退步数无效: %s", args[0])
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		if err != nil {
+			return err
+		}
+		hlog.Infof("回退 %d 个迁移", reverted)
+	case "version":
+		version, dirty, err := migrator.Version(ctx)
+		if err != nil {
+			return err
+		}
+		hlog.Infof("当前迁移版本: %d（最新版本 %d，dirty=%t）", version, migrator.LatestVersion(), dirty)
+	default:
+		return fmt.Errorf("不支持的迁移命令: %s（可选值: up, down, version）", command)
+	}
+	return nil
+}
@@ -0,0 +1,650 @@
+// openapi-gen 根据 HTTP 处理器上的 swagger 注释生成 OpenAPI 3 文档
+//
+// 注释格式与 swag 保持一致（@Summary/@Description/@Tags/@Accept/@Produce/@Param/@Success/@Router），
+// 请求和响应结构从 DTO 源码中解析，生成结果由 cmd/api 内嵌并通过 /api/v1/openapi.json 提供
+//
+// 用法（在仓库根目录执行）：
+//
+//	go run ./cmd/api/openapi-gen -root . -out cmd/api/openapi.json
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// handlerDir HTTP 处理器源码目录
+const handlerDir = "api/config-api/http"
+
+// typePackages 注释中引用的类型包前缀 -> 源码目录
+var typePackages = map[string]string{
+	"request": "api/config-api/dto/request",
+	"vo":      "api/config-api/dto/vo",
+	"types":   "share/types",
+}
+
+var (
+	paramPattern   = regexp.MustCompile(`^(\S+)\s+(\S+)\s+(\S+)\s+(true|false)\s+"([^"]*)"(?:\s+default\(([^)]*)\))?`)
+	successPattern = regexp.MustCompile(`^(\d+)\s+\{(\w+)\}\s+(\S+)(?:\s+"([^"]*)")?`)
+	routerPattern  = regexp.MustCompile(`^(\S+)\s+\[(\w+)\]`)
+	dataPattern    = regexp.MustCompile(`^([\w.]+)\{data=(\S+)\}$`)
+)
+
+// schema OpenAPI Schema 对象（仅包含本项目用到的字段）
+type schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Default              interface{}        `json:"default,omitempty"`
+	Properties           map[string]*schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *schema            `json:"items,omitempty"`
+	AdditionalProperties *schema            `json:"additionalProperties,omitempty"`
+	AllOf                []*schema          `json:"allOf,omitempty"`
+}
+
+type parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required"`
+	Schema      *schema `json:"schema"`
+}
+
+type mediaType struct {
+	Schema *schema `json:"schema"`
+}
+
+type requestBody struct {
+	Description string                `json:"description,omitempty"`
+	Required    bool                  `json:"required"`
+	Content     map[string]*mediaType `json:"content"`
+}
+
+type response struct {
+	Description string                `json:"description"`
+	Content     map[string]*mediaType `json:"content,omitempty"`
+}
+
+type operation struct {
+	Tags        []string             `json:"tags,omitempty"`
+	Summary     string               `json:"summary,omitempty"`
+	Description string               `json:"description,omitempty"`
+	OperationID string               `json:"operationId"`
+	Parameters  []*parameter         `json:"parameters,omitempty"`
+	RequestBody *requestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*response `json:"responses"`
+}
+
+type document struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       map[string]string                `json:"info"`
+	Servers    []map[string]string              `json:"servers"`
+	Tags       []map[string]string              `json:"tags"`
+	Paths      map[string]map[string]*operation `json:"paths"`
+	Components map[string]map[string]*schema    `json:"components"`
+}
+
+// generator 文档生成器
+type generator struct {
+	types   map[string]*ast.TypeSpec // 包前缀.类型名 -> 类型定义
+	docs    map[string]string        // 包前缀.类型名 -> 类型注释
+	schemas map[string]*schema       // 已生成的组件 Schema
+	pending []string                 // 待生成的组件 Schema
+}
+
+func main() {
+	root := flag.String("root", ".", "仓库根目录")
+	out := flag.String("out", "cmd/api/openapi.json", "输出文件（相对仓库根目录）")
+	flag.Parse()
+
+	g := &generator{
+		types:   make(map[string]*ast.TypeSpec),
+		docs:    make(map[string]string),
+		schemas: make(map[string]*schema),
+	}
+	for prefix, dir := range typePackages {
+		if err := g.loadTypes(prefix, filepath.Join(*root, dir)); err != nil {
+			fail(err)
+		}
+	}
+
+	doc, err := g.build(filepath.Join(*root, handlerDir))
+	if err != nil {
+		fail(err)
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		fail(err)
+	}
+	if err := os.WriteFile(filepath.Join(*root, *out), append(data, '\n'), 0o644); err != nil {
+		fail(err)
+	}
+	fmt.Printf("OpenAPI 文档已生成: %s（%d 个路径，%d 个 Schema）\n", *out, len(doc.Paths), len(g.schemas))
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "openapi-gen:", err)
+	os.Exit(1)
+}
+
+// parseDir 解析目录下的非测试 Go 源文件（按文件名排序，保证输出稳定）
+func parseDir(dir string) ([]*ast.File, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	fset := token.NewFileSet()
+	files := make([]*ast.File, 0, len(names))
+	for _, name := range names {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, name, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// loadTypes 加载目录下的类型定义
+func (g *generator) loadTypes(prefix string, dir string) error {
+	files, err := parseDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				name := prefix + "." + typeSpec.Name.Name
+				g.types[name] = typeSpec
+				doc := typeSpec.Doc
+				if doc == nil {
+					doc = gen.Doc
+				}
+				if doc != nil {
+					text := strings.TrimSpace(strings.SplitN(doc.Text(), "\n", 2)[0])
+					g.docs[name] = strings.TrimSpace(strings.TrimPrefix(text, typeSpec.Name.Name))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// build 解析处理器注释并生成文档
+func (g *generator) build(dir string) (*document, error) {
+	files, err := parseDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &document{
+		OpenAPI: "3.0.3",
+		Info: map[string]string{
+			"title":       "配置中心 API",
+			"description": "配置中心服务端接口，统一响应结构为 {code, message, data}，code=0 表示成功",
+			"version":     "1.0.0",
+		},
+		Servers:    []map[string]string{{"url": "/"}},
+		Paths:      make(map[string]map[string]*operation),
+		Components: map[string]map[string]*schema{"schemas": g.schemas},
+	}
+
+	tags := make(map[string]bool)
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Doc == nil {
+				continue
+			}
+			path, method, op, err := g.parseOperation(fn)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", fn.Name.Name, err)
+			}
+			if op == nil {
+				continue
+			}
+			if doc.Paths[path] == nil {
+				doc.Paths[path] = make(map[string]*operation)
+			}
+			if _, exists := doc.Paths[path][method]; exists {
+				return nil, fmt.Errorf("%s: 重复的路由 %s %s", fn.Name.Name, strings.ToUpper(method), path)
+			}
+			doc.Paths[path][method] = op
+			for _, tag := range op.Tags {
+				tags[tag] = true
+			}
+		}
+	}
+
+	// 生成被引用到的组件 Schema（含间接引用）
+	for len(g.pending) > 0 {
+		name := g.pending[0]
+		g.pending = g.pending[1:]
+		if err := g.buildComponent(name); err != nil {
+			return nil, err
+		}
+	}
+
+	names := make([]string, 0, len(tags))
+	for tag := range tags {
+		names = append(names, tag)
+	}
+	sort.Strings(names)
+	for _, tag := range names {
+		doc.Tags = append(doc.Tags, map[string]string{"name": tag})
+	}
+
+	return doc, nil
+}
+
+// parseOperation 解析单个处理器函数的注释，没有 @Router 注释时返回 nil
+func (g *generator) parseOperation(fn *ast.FuncDecl) (string, string, *operation, error) {
+	op := &operation{
+		OperationID: fn.Name.Name,
+		Responses:   make(map[string]*response),
+	}
+	var (
+		path, method string
+		consumes     []string
+		produces     = []string{"application/json"}
+		formFields   = &schema{Type: "object", Properties: make(map[string]*schema)}
+	)
+
+	for _, line := range strings.Split(fn.Doc.Text(), "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "@") {
+			continue
+		}
+		keyword, value, _ := strings.Cut(line, " ")
+		value = strings.TrimSpace(value)
+
+		switch keyword {
+		case "@Summary":
+			op.Summary = value
+		case "@Description":
+			op.Description = value
+		case "@Tags":
+			op.Tags = splitList(value)
+		case "@Accept":
+			consumes = mimeTypes(value)
+		case "@Produce":
+			produces = mimeTypes(value)
+		case "@Param":
+			match := paramPattern.FindStringSubmatch(value)
+			if match == nil {
+				return "", "", nil, fmt.Errorf("无法解析 @Param: %s", value)
+			}
+			name, in, typ, required, desc, def := match[1], match[2], match[3], match[4] == "true", match[5], match[6]
+			switch in {
+			case "body":
+				op.RequestBody = &requestBody{
+					Description: desc,
+					Required:    required,
+					Content:     map[string]*mediaType{"application/json": {Schema: g.typeRef(typ)}},
+				}
+			case "formData":
+				field := primitiveSchema(typ)
+				field.Description = desc
+				formFields.Properties[name] = field
+				if required {
+					formFields.Required = append(formFields.Required, name)
+				}
+			default:
+				field := primitiveSchema(typ)
+				if def != "" {
+					field.Default = defaultValue(field.Type, def)
+				}
+				op.Parameters = append(op.Parameters, &parameter{
+					Name:        name,
+					In:          in,
+					Description: desc,
+					Required:    required || in == "path",
+					Schema:      field,
+				})
+			}
+		case "@Success", "@Failure":
+			match := successPattern.FindStringSubmatch(value)
+			if match == nil {
+				return "", "", nil, fmt.Errorf("无法解析 %s: %s", keyword, value)
+			}
+			resp := &response{Description: match[4], Content: make(map[string]*mediaType)}
+			if resp.Description == "" {
+				resp.Description = "成功"
+			}
+			body := g.responseSchema(match[2], match[3])
+			for _, mime := range produces {
+				resp.Content[mime] = &mediaType{Schema: body}
+			}
+			op.Responses[match[1]] = resp
+		case "@Router":
+			match := routerPattern.FindStringSubmatch(value)
+			if match == nil {
+				return "", "", nil, fmt.Errorf("无法解析 @Router: %s", value)
+			}
+			path, method = match[1], strings.ToLower(match[2])
+		}
+	}
+
+	if path == "" {
+		return "", "", nil, nil
+	}
+	if len(formFields.Properties) > 0 {
+		if op.RequestBody == nil {
+			op.RequestBody = &requestBody{Content: make(map[string]*mediaType)}
+		}
+		op.RequestBody.Content["multipart/form-data"] = &mediaType{Schema: formFields}
+	}
+	if op.RequestBody != nil && len(consumes) > 0 {
+		for mime := range op.RequestBody.Content {
+			if !contains(consumes, mime) {
+				delete(op.RequestBody.Content, mime)
+			}
+		}
+		// 请求体为原始二进制内容（如文件上传）
+		if len(op.RequestBody.Content) == 0 && contains(consumes, "application/octet-stream") {
+			op.RequestBody.Content["application/octet-stream"] = &mediaType{Schema: &schema{Type: "string", Format: "binary"}}
+		}
+	}
+	if len(op.Responses) == 0 {
+		op.Responses["200"] = &response{Description: "成功"}
+	}
+	// 错误响应：默认为统一响应结构，Accept 优先接受 application/problem+json 时为 RFC 7807 格式
+	if _, ok := op.Responses["default"]; !ok {
+		op.Responses["default"] = &response{Description: "错误", Content: map[string]*mediaType{
+			"application/json":         {Schema: g.typeRef("types.Response")},
+			"application/problem+json": {Schema: g.typeRef("types.Problem")},
+		}}
+	}
+	return path, method, op, nil
+}
+
+// responseSchema 解析 @Success 中的响应类型，支持 types.Response{data=vo.X} 写法
+func (g *generator) responseSchema(kind string, typ string) *schema {
+	if kind != "object" && kind != "array" {
+		return primitiveSchema(typ)
+	}
+	if match := dataPattern.FindStringSubmatch(typ); match != nil {
+		return &schema{AllOf: []*schema{
+			g.typeRef(match[1]),
+			{Type: "object", Properties: map[string]*schema{"data": g.typeRef(match[2])}},
+		}}
+	}
+	if kind == "array" {
+		return &schema{Type: "array", Items: g.typeRef(typ)}
+	}
+	return g.typeRef(typ)
+}
+
+// typeRef 引用注释中的类型（支持 []pkg.Type）
+func (g *generator) typeRef(typ string) *schema {
+	if strings.HasPrefix(typ, "[]") {
+		return &schema{Type: "array", Items: g.typeRef(typ[2:])}
+	}
+	if _, ok := g.types[typ]; !ok {
+		return primitiveSchema(typ)
+	}
+	if _, ok := g.schemas[typ]; !ok {
+		g.schemas[typ] = nil // 占位，避免重复入队
+		g.pending = append(g.pending, typ)
+	}
+	return &schema{Ref: "#/components/schemas/" + typ}
+}
+
+// buildComponent 生成组件 Schema
+func (g *generator) buildComponent(name string) error {
+	prefix := strings.SplitN(name, ".", 2)[0]
+	typeSpec := g.types[name]
+
+	s := g.exprSchema(prefix, typeSpec.Type)
+	if s.Description == "" {
+		s.Description = g.docs[name]
+	}
+	g.schemas[name] = s
+	return nil
+}
+
+// exprSchema 将 Go 类型表达式转换为 Schema
+func (g *generator) exprSchema(prefix string, expr ast.Expr) *schema {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if _, ok := g.types[prefix+"."+t.Name]; ok {
+			return g.typeRef(prefix + "." + t.Name)
+		}
+		return primitiveSchema(t.Name)
+	case *ast.StarExpr:
+		return g.exprSchema(prefix, t.X)
+	case *ast.ArrayType:
+		if ident, ok := t.Elt.(*ast.Ident); ok && ident.Name == "byte" {
+			return &schema{Type: "string", Format: "byte"}
+		}
+		return &schema{Type: "array", Items: g.exprSchema(prefix, t.Elt)}
+	case *ast.MapType:
+		return &schema{Type: "object", AdditionalProperties: g.exprSchema(prefix, t.Value)}
+	case *ast.SelectorExpr:
+		pkg := t.X.(*ast.Ident).Name
+		switch pkg + "." + t.Sel.Name {
+		case "time.Time":
+			return &schema{Type: "string", Format: "date-time"}
+		case "time.Duration":
+			return &schema{Type: "integer", Format: "int64"}
+		}
+		if _, ok := g.types[pkg+"."+t.Sel.Name]; ok {
+			return g.typeRef(pkg + "." + t.Sel.Name)
+		}
+		return &schema{Type: "object"}
+	case *ast.InterfaceType:
+		return &schema{}
+	case *ast.StructType:
+		return g.structSchema(prefix, t)
+	}
+	return &schema{}
+}
+
+// structSchema 将结构体转换为 object Schema（匿名嵌入字段展开为属性）
+func (g *generator) structSchema(prefix string, st *ast.StructType) *schema {
+	s := &schema{Type: "object", Properties: make(map[string]*schema)}
+	for _, field := range st.Fields.List {
+		tag := ""
+		if field.Tag != nil {
+			tag = strings.Trim(field.Tag.Value, "`")
+		}
+		jsonName, omitEmpty := jsonTag(tag)
+		if jsonName == "-" {
+			continue
+		}
+
+		// 匿名嵌入：同包结构体展开属性
+		if len(field.Names) == 0 && jsonName == "" {
+			if embedded := g.embeddedStruct(prefix, field.Type); embedded != nil {
+				inner := g.structSchema(prefix, embedded)
+				for name, prop := range inner.Properties {
+					s.Properties[name] = prop
+				}
+				s.Required = append(s.Required, inner.Required...)
+			}
+			continue
+		}
+
+		names := make([]string, 0, len(field.Names))
+		for _, ident := range field.Names {
+			if ident.IsExported() {
+				names = append(names, ident.Name)
+			}
+		}
+		if len(field.Names) == 0 {
+			names = append(names, jsonName)
+		}
+		for _, name := range names {
+			propName := name
+			if jsonName != "" {
+				propName = jsonName
+			}
+			prop := g.exprSchema(prefix, field.Type)
+			if comment := fieldComment(field); comment != "" {
+				if prop.Ref != "" {
+					prop = &schema{AllOf: []*schema{prop}, Description: comment}
+				} else {
+					prop.Description = comment
+				}
+			}
+			s.Properties[propName] = prop
+			if strings.Contains(reflectTag(tag, "binding"), "required") && !omitEmpty {
+				s.Required = append(s.Required, propName)
+			}
+		}
+	}
+	sort.Strings(s.Required)
+	return s
+}
+
+// embeddedStruct 获取同包嵌入结构体的定义
+func (g *generator) embeddedStruct(prefix string, expr ast.Expr) *ast.StructType {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	typeSpec, ok := g.types[prefix+"."+ident.Name]
+	if !ok {
+		return nil
+	}
+	st, _ := typeSpec.Type.(*ast.StructType)
+	return st
+}
+
+// ==================== 辅助函数 ====================
+
+// primitiveSchema Go/swag 基础类型 -> Schema
+func primitiveSchema(typ string) *schema {
+	switch typ {
+	case "string":
+		return &schema{Type: "string"}
+	case "int", "int32", "uint", "uint32", "int8", "int16", "uint8", "uint16", "integer":
+		return &schema{Type: "integer"}
+	case "int64", "uint64":
+		return &schema{Type: "integer", Format: "int64"}
+	case "float32", "float64", "number":
+		return &schema{Type: "number"}
+	case "bool", "boolean":
+		return &schema{Type: "boolean"}
+	case "file":
+		return &schema{Type: "string", Format: "binary"}
+	}
+	return &schema{Type: "object"}
+}
+
+// defaultValue 按参数类型转换默认值
+func defaultValue(typ string, value string) interface{} {
+	switch typ {
+	case "boolean":
+		return value == "true"
+	case "integer", "number":
+		var number json.Number = json.Number(value)
+		if _, err := number.Float64(); err == nil {
+			return number
+		}
+	}
+	return value
+}
+
+// mimeTypes 将 swag 的 MIME 简写转换为完整类型
+func mimeTypes(value string) []string {
+	aliases := map[string]string{
+		"json":                  "application/json",
+		"xml":                   "application/xml",
+		"plain":                 "text/plain",
+		"html":                  "text/html",
+		"mpfd":                  "multipart/form-data",
+		"octet-stream":          "application/octet-stream",
+		"x-www-form-urlencoded": "application/x-www-form-urlencoded",
+	}
+	var result []string
+	for _, item := range splitList(value) {
+		if mime, ok := aliases[item]; ok {
+			item = mime
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func splitList(value string) []string {
+	var result []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func contains(list []string, target string) bool {
+	for _, item := range list {
+		if item == target {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonTag 解析 json 标签，返回字段名和是否 omitempty
+func jsonTag(tag string) (string, bool) {
+	value := reflectTag(tag, "json")
+	name, options, _ := strings.Cut(value, ",")
+	return name, strings.Contains(options, "omitempty")
+}
+
+// reflectTag 读取结构体标签中的指定键
+func reflectTag(tag string, key string) string {
+	for tag != "" {
+		tag = strings.TrimLeft(tag, " ")
+		name, rest, ok := strings.Cut(tag, ":\"")
+		if !ok {
+			return ""
+		}
+		value, remain, ok := strings.Cut(rest, "\"")
+		if !ok {
+			return ""
+		}
+		if name == key {
+			return value
+		}
+		tag = remain
+	}
+	return ""
+}
+
+// fieldComment 获取字段注释（优先行尾注释）
+func fieldComment(field *ast.Field) string {
+	if field.Comment != nil {
+		return strings.TrimSpace(field.Comment.Text())
+	}
+	if field.Doc != nil {
+		return strings.TrimSpace(field.Doc.Text())
+	}
+	return ""
+}
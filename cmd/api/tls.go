@@ -0,0 +1,67 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"config-client/share/config"
+)
+
+// tlsVersions 支持配置的最低 TLS 版本
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// buildTLSConfig 按 server.tls 配置构建服务端 TLS 配置
+// 配置客户端 CA 时启用双向 TLS：require 要求客户端必须提供有效证书，verify_if_given 仅在客户端提供证书时校验
+func buildTLSConfig(c *config.TLSConfig) (*tls.Config, error) {
+	if c.CertFile == "" || c.KeyFile == "" {
+		return nil, fmt.Errorf("启用 HTTPS 需要配置 cert_file 和 key_file")
+	}
+	certificate, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("加载服务端证书失败: %w", err)
+	}
+
+	minVersion := uint16(tls.VersionTLS12)
+	if c.MinVersion != "" {
+		version, ok := tlsVersions[c.MinVersion]
+		if !ok {
+			return nil, fmt.Errorf("不支持的最低 TLS 版本: %s（可选值: 1.2, 1.3）", c.MinVersion)
+		}
+		minVersion = version
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   minVersion,
+	}
+	if c.ClientCAFile == "" {
+		return tlsConfig, nil
+	}
+
+	// 双向 TLS：校验客户端证书
+	caPEM, err := os.ReadFile(c.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("读取客户端 CA 证书失败: %w", err)
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("客户端 CA 证书文件中没有有效的 PEM 证书: %s", c.ClientCAFile)
+	}
+	tlsConfig.ClientCAs = clientCAs
+
+	switch c.ClientAuth {
+	case "", config.TLSClientAuthRequire:
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	case config.TLSClientAuthVerifyIfGiven:
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	default:
+		return nil, fmt.Errorf("不支持的客户端证书校验方式: %s（可选值: %s, %s）",
+			c.ClientAuth, config.TLSClientAuthRequire, config.TLSClientAuthVerifyIfGiven)
+	}
+	return tlsConfig, nil
+}
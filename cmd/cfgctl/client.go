@@ -0,0 +1,233 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// apiResponse 统一响应结构
+type apiResponse struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+	TraceID string          `json:"trace_id"`
+}
+
+// apiError 接口返回的业务错误
+type apiError struct {
+	Status  int    // HTTP 状态码
+	Code    int    // 业务错误码
+	Message string // 错误信息
+	TraceID string // 链路ID
+}
+
+func (e *apiError) Error() string {
+	msg := fmt.Sprintf("%s (code=%d, status=%d)", e.Message, e.Code, e.Status)
+	if e.TraceID != "" {
+		msg += ", trace_id=" + e.TraceID
+	}
+	return msg
+}
+
+// apiClient 配置中心 HTTP API 客户端
+type apiClient struct {
+	baseURL string
+	token   string
+	tenant  string
+	http    *http.Client
+}
+
+// newAPIClient 创建 API 客户端（超时由调用方通过 context 控制）
+func newAPIClient() *apiClient {
+	return &apiClient{
+		baseURL: strings.TrimRight(opts.server, "/"),
+		token:   opts.token,
+		tenant:  opts.tenant,
+		http:    &http.Client{},
+	}
+}
+
+// call 调用 JSON 接口并将 data 解析到 out（out 为 nil 时忽略 data），返回响应消息
+func (c *apiClient) call(ctx context.Context, method, path string, query url.Values, body, out any) (string, error) {
+	return c.do(ctx, method, path, query, body, nil, out)
+}
+
+// callIdempotent 调用写接口并携带随机 Idempotency-Key，请求被重放时服务端不会重复执行
+func (c *apiClient) callIdempotent(ctx context.Context, method, path string, body, out any) (string, error) {
+	key := make([]byte, 16)
+	_, _ = rand.Read(key)
+	return c.do(ctx, method, path, nil, body, http.Header{"Idempotency-Key": {hex.EncodeToString(key)}}, out)
+}
+
+// do 发送请求并解析统一响应结构，业务错误返回 *apiError
+func (c *apiClient) do(ctx context.Context, method, path string, query url.Values, body any, header http.Header, out any) (string, error) {
+	resp, err := c.send(ctx, method, path, query, body, header)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var result apiResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("解析响应失败: status=%d, err=%w", resp.StatusCode, err)
+	}
+	if resp.StatusCode >= 300 || result.Code != 0 {
+		return "", &apiError{Status: resp.StatusCode, Code: result.Code, Message: result.Message, TraceID: result.TraceID}
+	}
+	if out != nil && len(result.Data) > 0 {
+		if err := json.Unmarshal(result.Data, out); err != nil {
+			return "", fmt.Errorf("解析响应数据失败: %w", err)
+		}
+	}
+	return result.Message, nil
+}
+
+// raw 调用返回原始内容的接口（如导出），非 2xx 时按统一响应结构解析错误
+func (c *apiClient) raw(ctx context.Context, path string, query url.Values) ([]byte, error) {
+	resp, err := c.send(ctx, http.MethodGet, path, query, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		var result apiResponse
+		if json.Unmarshal(data, &result) == nil && result.Message != "" {
+			return nil, &apiError{Status: resp.StatusCode, Code: result.Code, Message: result.Message, TraceID: result.TraceID}
+		}
+		return nil, fmt.Errorf("请求失败: status=%d, body=%s", resp.StatusCode, data)
+	}
+	return data, nil
+}
+
+// send 发送请求
+func (c *apiClient) send(ctx context.Context, method, path string, query url.Values, body any, header http.Header) (*http.Response, error) {
+	target := c.baseURL + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("User-Agent", "cfgctl/1.0")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	if c.tenant != "" {
+		req.Header.Set("X-Tenant-ID", c.tenant)
+	}
+	for name, values := range header {
+		for _, v := range values {
+			req.Header.Add(name, v)
+		}
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("请求 %s %s 失败: %w", method, path, err)
+	}
+	return resp, nil
+}
+
+// ==================== 公共查询 ====================
+
+// namespaceVO 命名空间（仅包含命令行用到的字段）
+type namespaceVO struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// resolveNamespace 解析 --namespace 参数（数字按ID，否则按名称查询）
+func (c *apiClient) resolveNamespace(ctx context.Context) (int, error) {
+	if opts.namespace == "" {
+		return 0, fmt.Errorf("请通过 --namespace 或环境变量 CFGCTL_NAMESPACE 指定命名空间")
+	}
+	if id, err := strconv.Atoi(opts.namespace); err == nil {
+		return id, nil
+	}
+
+	var ns namespaceVO
+	if _, err := c.call(ctx, http.MethodGet, "/api/v1/namespaces/name", url.Values{"name": {opts.namespace}}, nil, &ns); err != nil {
+		return 0, fmt.Errorf("查询命名空间 %s 失败: %w", opts.namespace, err)
+	}
+	return ns.ID, nil
+}
+
+// configVO 配置（仅包含命令行用到的字段）
+type configVO struct {
+	ID          int    `json:"id"`
+	NamespaceID int    `json:"namespace_id"`
+	Key         string `json:"key"`
+	Value       string `json:"value"`
+	GroupName   string `json:"group_name"`
+	ValueType   string `json:"value_type"`
+	Environment string `json:"environment"`
+	Version     int    `json:"version"`
+	IsReleased  bool   `json:"is_released"`
+	IsActive    bool   `json:"is_active"`
+	IsMasked    bool   `json:"is_masked"`
+	Description string `json:"description,omitempty"`
+	UpdatedBy   string `json:"updated_by"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// configListVO 配置分页结果
+type configListVO struct {
+	Total      int64       `json:"total"`
+	Page       int         `json:"page"`
+	TotalPages int         `json:"total_pages"`
+	Items      []*configVO `json:"items"`
+}
+
+// findConfig 按配置键精确查找当前环境下的配置（含未发布的），不存在时返回 nil
+// 查询接口的 key 为模糊匹配，逐页查找完全相同的键
+func (c *apiClient) findConfig(ctx context.Context, namespaceID int, key string) (*configVO, error) {
+	for page := 1; ; page++ {
+		query := url.Values{
+			"namespace_id": {strconv.Itoa(namespaceID)},
+			"environment":  {opts.env},
+			"key":          {key},
+			"page":         {strconv.Itoa(page)},
+			"size":         {"100"},
+		}
+		var list configListVO
+		if _, err := c.call(ctx, http.MethodGet, "/api/v1/configs", query, nil, &list); err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			if item.Key == key {
+				return item, nil
+			}
+		}
+		if page >= list.TotalPages || len(list.Items) == 0 {
+			return nil, nil
+		}
+	}
+}
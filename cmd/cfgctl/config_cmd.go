@@ -0,0 +1,280 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// batchItem 批量变更条目
+type batchItem struct {
+	Action      string `json:"action"`
+	Key         string `json:"key"`
+	Value       string `json:"value,omitempty"`
+	GroupName   string `json:"group_name,omitempty"`
+	ValueType   string `json:"value_type,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// batchResult 批量变更结果
+type batchResult struct {
+	Committed bool `json:"committed"`
+	Total     int  `json:"total"`
+	Items     []struct {
+		Index   int    `json:"index"`
+		Action  string `json:"action"`
+		Key     string `json:"key"`
+		Version int    `json:"version,omitempty"`
+		Status  string `json:"status"`
+		Error   string `json:"error,omitempty"`
+	} `json:"items"`
+}
+
+// newGetCmd 获取配置
+func newGetCmd() *cobra.Command {
+	var published bool
+	cmd := &cobra.Command{
+		Use:   "get <key>",
+		Short: "获取配置值",
+		Long:  "默认返回当前环境下的最新值（含未发布的修改）；--published 返回客户端实际拉取到的已发布值。",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := requestContext()
+			defer cancel()
+			client := newAPIClient()
+			namespaceID, err := client.resolveNamespace(ctx)
+			if err != nil {
+				return err
+			}
+
+			var config *configVO
+			if published {
+				config = &configVO{}
+				query := url.Values{
+					"namespace_id": {strconv.Itoa(namespaceID)},
+					"key":          {args[0]},
+					"environment":  {opts.env},
+				}
+				if _, err := client.call(ctx, http.MethodGet, "/api/v1/configs/key", query, nil, config); err != nil {
+					return err
+				}
+			} else {
+				if config, err = client.findConfig(ctx, namespaceID, args[0]); err != nil {
+					return err
+				}
+				if config == nil {
+					return fmt.Errorf("配置不存在: %s（环境 %s）", args[0], opts.env)
+				}
+			}
+
+			if opts.output == "json" {
+				return printJSON(config)
+			}
+			fmt.Println(config.Value)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&published, "published", false, "获取已发布的值（灰度规则按本机匹配）")
+	return cmd
+}
+
+// newSetCmd 设置配置
+func newSetCmd() *cobra.Command {
+	var item batchItem
+	var file, reason string
+	cmd := &cobra.Command{
+		Use:   "set <key> [value]",
+		Short: "创建或更新配置",
+		Long:  "配置不存在时创建，存在时更新。值可作为参数传入，或通过 --file 从文件读取（- 表示标准输入）。\n修改后需创建并发布版本才会下发到客户端。",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// 1. 读取配置值
+			item.Key = args[0]
+			switch {
+			case len(args) == 2 && file != "":
+				return errors.New("配置值参数和 --file 不能同时使用")
+			case len(args) == 2:
+				item.Value = args[1]
+			case file != "":
+				value, err := readValueFile(file)
+				if err != nil {
+					return err
+				}
+				item.Value = value
+			default:
+				return errors.New("请传入配置值或通过 --file 指定")
+			}
+
+			ctx, cancel := requestContext()
+			defer cancel()
+			client := newAPIClient()
+			namespaceID, err := client.resolveNamespace(ctx)
+			if err != nil {
+				return err
+			}
+
+			// 2. 判断创建还是更新
+			existing, err := client.findConfig(ctx, namespaceID, item.Key)
+			if err != nil {
+				return err
+			}
+			item.Action = "create"
+			if existing != nil {
+				item.Action = "update"
+			}
+
+			// 3. 执行变更
+			result, err := mutateConfigs(client, namespaceID, []batchItem{item}, reason)
+			if err != nil {
+				return err
+			}
+			action := "已创建"
+			if item.Action == "update" {
+				action = "已更新"
+			}
+			return printResult(fmt.Sprintf("%s %s（版本 %d）", action, item.Key, result.Items[0].Version), result)
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVarP(&file, "file", "f", "", "从文件读取配置值（- 表示标准输入）")
+	flags.StringVarP(&item.ValueType, "type", "t", "", "值类型（创建时默认 string，更新时为空表示不修改）")
+	flags.StringVarP(&item.GroupName, "group", "g", "", "配置分组（创建时默认 default，更新时为空表示不修改）")
+	flags.StringVar(&item.Description, "description", "", "配置描述")
+	flags.StringVar(&reason, "reason", "", "变更原因（记录到变更历史）")
+	return cmd
+}
+
+// newDeleteCmd 删除配置
+func newDeleteCmd() *cobra.Command {
+	var reason string
+	cmd := &cobra.Command{
+		Use:   "delete <key>...",
+		Short: "删除配置（进入回收站，可恢复）",
+		Long:  "在单个事务中删除一个或多个配置，任一配置删除失败时全部回滚。",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := requestContext()
+			defer cancel()
+			client := newAPIClient()
+			namespaceID, err := client.resolveNamespace(ctx)
+			if err != nil {
+				return err
+			}
+
+			items := make([]batchItem, 0, len(args))
+			for _, key := range args {
+				items = append(items, batchItem{Action: "delete", Key: key})
+			}
+			result, err := mutateConfigs(client, namespaceID, items, reason)
+			if err != nil {
+				return err
+			}
+			return printResult(fmt.Sprintf("已删除 %d 个配置: %s", len(args), strings.Join(args, ", ")), result)
+		},
+	}
+	cmd.Flags().StringVar(&reason, "reason", "", "删除原因（记录到变更历史）")
+	return cmd
+}
+
+// newListCmd 列出配置
+func newListCmd() *cobra.Command {
+	var match string
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "列出命名空间当前环境下的配置",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := requestContext()
+			defer cancel()
+			client := newAPIClient()
+			namespaceID, err := client.resolveNamespace(ctx)
+			if err != nil {
+				return err
+			}
+
+			// 逐页拉取全部配置
+			var configs []*configVO
+			for page := 1; ; page++ {
+				query := url.Values{
+					"namespace_id": {strconv.Itoa(namespaceID)},
+					"environment":  {opts.env},
+					"page":         {strconv.Itoa(page)},
+					"size":         {"100"},
+					"order_by":     {"key asc"},
+				}
+				if match != "" {
+					query.Set("key", match)
+				}
+				var list configListVO
+				if _, err := client.call(ctx, http.MethodGet, "/api/v1/configs", query, nil, &list); err != nil {
+					return err
+				}
+				configs = append(configs, list.Items...)
+				if page >= list.TotalPages || len(list.Items) == 0 {
+					break
+				}
+			}
+
+			if opts.output == "json" {
+				return printJSON(configs)
+			}
+			table := newTable()
+			fmt.Fprintln(table, "KEY\tVALUE\tTYPE\tGROUP\tVERSION\tRELEASED")
+			for _, c := range configs {
+				fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%d\t%t\n",
+					c.Key, truncate(c.Value, 40), c.ValueType, c.GroupName, c.Version, c.IsReleased)
+			}
+			return table.Flush()
+		},
+	}
+	cmd.Flags().StringVar(&match, "match", "", "仅列出键包含该字符串的配置")
+	return cmd
+}
+
+// mutateConfigs 调用批量变更接口（单个事务），未提交时返回各条目的失败原因
+func mutateConfigs(client *apiClient, namespaceID int, items []batchItem, reason string) (*batchResult, error) {
+	ctx, cancel := requestContext()
+	defer cancel()
+
+	body := map[string]any{
+		"namespace_id": namespaceID,
+		"environment":  opts.env,
+		"items":        items,
+		"operator":     opts.operator,
+		"reason":       reason,
+	}
+	var result batchResult
+	if _, err := client.callIdempotent(ctx, http.MethodPost, "/api/v1/configs/batch", body, &result); err != nil {
+		return nil, err
+	}
+	if !result.Committed {
+		var failures []string
+		for _, item := range result.Items {
+			if item.Error != "" {
+				failures = append(failures, fmt.Sprintf("%s: %s", item.Key, item.Error))
+			}
+		}
+		return nil, fmt.Errorf("变更未提交: %s", strings.Join(failures, "; "))
+	}
+	return &result, nil
+}
+
+// readValueFile 从文件读取内容（- 表示标准输入）
+func readValueFile(path string) (string, error) {
+	if path == "-" {
+		data, err := io.ReadAll(os.Stdin)
+		return string(data), err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("读取文件失败: %w", err)
+	}
+	return string(data), nil
+}
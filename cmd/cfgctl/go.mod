@@ -0,0 +1,11 @@
+module config-client/cmd/cfgctl
+
+go 1.24.11
+
+// 命令行框架
+require github.com/spf13/cobra v1.8.1
+
+require (
+	github.com/inconshreveable/mousetrap v1.1.0 // indirect
+	github.com/spf13/pflag v1.0.5 // indirect
+)
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// historyVO 变更历史（仅包含命令行用到的字段）
+type historyVO struct {
+	ID           int       `json:"id"`
+	ConfigKey    string    `json:"config_key"`
+	Environment  string    `json:"environment"`
+	Operation    string    `json:"operation"`
+	OldVersion   int       `json:"old_version"`
+	NewVersion   int       `json:"new_version"`
+	Operator     string    `json:"operator"`
+	ChangeReason string    `json:"change_reason,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// newHistoryCmd 查看变更历史
+func newHistoryCmd() *cobra.Command {
+	var limit int
+	var key string
+	var follow bool
+	var interval time.Duration
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "查看命名空间最近的配置变更（-f 持续跟踪新变更）",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer stop()
+			client := newAPIClient()
+
+			reqCtx, cancel := context.WithTimeout(ctx, opts.timeout)
+			namespaceID, err := client.resolveNamespace(reqCtx)
+			cancel()
+			if err != nil {
+				return err
+			}
+
+			// 1. 输出最近的变更（按时间正序）
+			items, err := fetchHistory(ctx, client, namespaceID, key, limit)
+			if err != nil {
+				return err
+			}
+			lastID := printHistory(items, 0)
+			if !follow {
+				return nil
+			}
+
+			// 2. 定时轮询，输出新增的变更（查询失败时下次重试）
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-ticker.C:
+				}
+				items, err := fetchHistory(ctx, client, namespaceID, key, 100)
+				if err != nil {
+					fmt.Fprintln(os.Stderr, "查询变更历史失败:", err)
+					continue
+				}
+				lastID = printHistory(items, lastID)
+			}
+		},
+	}
+	flags := cmd.Flags()
+	flags.IntVar(&limit, "limit", 20, "首次输出的最近变更条数（最大 100）")
+	flags.StringVarP(&key, "key", "k", "", "仅查看键包含该字符串的配置")
+	flags.BoolVarP(&follow, "follow", "f", false, "持续跟踪新变更（Ctrl+C 退出）")
+	flags.DurationVar(&interval, "interval", 2*time.Second, "跟踪时的轮询间隔")
+	return cmd
+}
+
+// fetchHistory 查询最近的变更历史，按ID升序返回
+func fetchHistory(ctx context.Context, client *apiClient, namespaceID int, key string, limit int) ([]*historyVO, error) {
+	ctx, cancel := context.WithTimeout(ctx, opts.timeout)
+	defer cancel()
+
+	query := url.Values{
+		"namespace_id": {strconv.Itoa(namespaceID)},
+		"page":         {"1"},
+		"size":         {strconv.Itoa(min(max(limit, 1), 100))},
+	}
+	if key != "" {
+		query.Set("config_key", key)
+	}
+	var list struct {
+		Items []*historyVO `json:"items"`
+	}
+	if _, err := client.call(ctx, http.MethodGet, "/api/v1/history", query, nil, &list); err != nil {
+		return nil, err
+	}
+	sort.Slice(list.Items, func(i, j int) bool { return list.Items[i].ID < list.Items[j].ID })
+	return list.Items, nil
+}
+
+// printHistory 输出ID大于 lastID 的变更，返回输出后的最大ID
+// 变更历史不按 --env 过滤，输出命名空间内全部环境的变更
+func printHistory(items []*historyVO, lastID int) int {
+	for _, h := range items {
+		if h.ID <= lastID {
+			continue
+		}
+		lastID = h.ID
+		if opts.output == "json" {
+			_ = printJSON(h)
+			continue
+		}
+		line := fmt.Sprintf("%s  %-8s %s [%s] v%d→v%d by %s",
+			h.CreatedAt.Local().Format("2006-01-02 15:04:05"), h.Operation, h.ConfigKey, h.Environment,
+			h.OldVersion, h.NewVersion, h.Operator)
+		if h.ChangeReason != "" {
+			line += "  (" + h.ChangeReason + ")"
+		}
+		fmt.Println(line)
+	}
+	return lastID
+}
@@ -0,0 +1,93 @@
+// cfgctl 配置中心命令行工具
+// 通过 HTTP API 管理配置：读写和删除配置键、导入导出命名空间、创建和发布版本、跟踪变更历史、实时监听配置键
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// globalOptions 全局参数（可通过环境变量设置默认值）
+type globalOptions struct {
+	server    string        // 配置中心地址
+	token     string        // API Key（服务端启用接口认证时必填）
+	tenant    string        // 租户ID（仅默认租户的 API Key 可以指定其他租户）
+	namespace string        // 命名空间（名称或ID）
+	env       string        // 环境
+	operator  string        // 操作人
+	output    string        // 输出格式: text, json
+	timeout   time.Duration // 单次请求超时（watch 长轮询不受此限制）
+}
+
+var opts globalOptions
+
+// operatorSet 是否显式指定了操作人（--operator 或 CFGCTL_OPERATOR）
+var operatorSet bool
+
+func main() {
+	if err := newRootCmd().Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, "错误:", err)
+		os.Exit(1)
+	}
+}
+
+// newRootCmd 创建根命令
+func newRootCmd() *cobra.Command {
+	root := &cobra.Command{
+		Use:           "cfgctl",
+		Short:         "配置中心命令行工具",
+		Long:          "cfgctl 通过 HTTP API 管理配置中心，无需手工拼装 curl 请求体。\n默认参数可通过环境变量 CFGCTL_SERVER、CFGCTL_TOKEN、CFGCTL_TENANT、CFGCTL_NAMESPACE、CFGCTL_ENV、CFGCTL_OPERATOR 设置。",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if opts.output != "text" && opts.output != "json" {
+				return fmt.Errorf("不支持的输出格式: %s（可选值: text/json）", opts.output)
+			}
+			operatorSet = cmd.Flags().Changed("operator") || os.Getenv("CFGCTL_OPERATOR") != ""
+			return nil
+		},
+	}
+
+	flags := root.PersistentFlags()
+	flags.StringVarP(&opts.server, "server", "s", envOr("CFGCTL_SERVER", "http://localhost:8080"), "配置中心地址")
+	flags.StringVar(&opts.token, "token", os.Getenv("CFGCTL_TOKEN"), "API Key（服务端启用接口认证时必填）")
+	flags.StringVar(&opts.tenant, "tenant", os.Getenv("CFGCTL_TENANT"), "租户ID（为空时使用 API Key 所属租户）")
+	flags.StringVarP(&opts.namespace, "namespace", "n", os.Getenv("CFGCTL_NAMESPACE"), "命名空间（名称或ID）")
+	flags.StringVarP(&opts.env, "env", "e", envOr("CFGCTL_ENV", "default"), "环境")
+	flags.StringVar(&opts.operator, "operator", envOr("CFGCTL_OPERATOR", currentUser()), "操作人（记录到变更历史）")
+	flags.StringVarP(&opts.output, "output", "o", "text", "输出格式: text, json")
+	flags.DurationVar(&opts.timeout, "timeout", 30*time.Second, "单次请求超时")
+
+	root.AddCommand(
+		newGetCmd(),
+		newSetCmd(),
+		newDeleteCmd(),
+		newListCmd(),
+		newImportCmd(),
+		newExportCmd(),
+		newReleaseCmd(),
+		newHistoryCmd(),
+		newWatchCmd(),
+	)
+	return root
+}
+
+// envOr 读取环境变量，未设置时返回默认值
+func envOr(name, def string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return def
+}
+
+// currentUser 当前系统用户名（作为默认操作人）
+func currentUser() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return "cfgctl"
+}
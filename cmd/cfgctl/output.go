@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"unicode/utf8"
+)
+
+// requestContext 创建带单次请求超时的 context
+func requestContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), opts.timeout)
+}
+
+// printJSON 以缩进 JSON 输出
+func printJSON(v any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(v)
+}
+
+// printResult -o json 时输出数据，否则输出提示信息
+func printResult(message string, data any) error {
+	if opts.output == "json" {
+		return printJSON(data)
+	}
+	fmt.Println(message)
+	return nil
+}
+
+// newTable 创建按列对齐的表格输出
+func newTable() *tabwriter.Writer {
+	return tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+}
+
+// truncate 截断过长的值用于表格展示（按字符截断，换行替换为空格）
+func truncate(s string, limit int) string {
+	out := make([]rune, 0, limit)
+	for _, r := range s {
+		if r == '\n' || r == '\r' || r == '\t' {
+			r = ' '
+		}
+		out = append(out, r)
+		if len(out) >= limit {
+			break
+		}
+	}
+	if utf8.RuneCountInString(s) > limit {
+		return string(out[:limit-1]) + "…"
+	}
+	return string(out)
+}
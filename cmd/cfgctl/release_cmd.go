@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// releaseVO 发布版本（仅包含命令行用到的字段）
+type releaseVO struct {
+	ID               int        `json:"id"`
+	NamespaceID      int        `json:"namespace_id"`
+	Environment      string     `json:"environment"`
+	Version          int        `json:"version"`
+	VersionName      string     `json:"version_name"`
+	ConfigCount      int        `json:"config_count"`
+	Status           string     `json:"status"`
+	ReleaseType      string     `json:"release_type"`
+	ReleaseNotes     string     `json:"release_notes"`
+	ApprovalStatus   string     `json:"approval_status"`
+	CanaryPercentage int        `json:"canary_percentage"`
+	ReleasedBy       string     `json:"released_by"`
+	ReleasedAt       *time.Time `json:"released_at"`
+	CreatedBy        string     `json:"created_by"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+// publishOptions 发布参数
+type publishOptions struct {
+	notes          string
+	canary         int      // 灰度百分比（>0 或指定白名单时灰度发布）
+	clientIDs      []string // 灰度客户端ID白名单
+	ipRanges       []string // 灰度IP段白名单
+	freezeOverride bool     // 覆盖发布冻结窗口
+	overrideReason string   // 覆盖原因
+}
+
+// isCanary 是否灰度发布
+func (p *publishOptions) isCanary() bool {
+	return p.canary > 0 || len(p.clientIDs) > 0 || len(p.ipRanges) > 0
+}
+
+// bindPublishFlags 绑定发布参数
+func bindPublishFlags(cmd *cobra.Command, p *publishOptions) {
+	flags := cmd.Flags()
+	flags.IntVar(&p.canary, "canary", 0, "灰度百分比（1-100，指定后灰度发布）")
+	flags.StringSliceVar(&p.clientIDs, "canary-client", nil, "灰度客户端ID白名单（可重复，指定后灰度发布）")
+	flags.StringSliceVar(&p.ipRanges, "canary-ip", nil, "灰度IP段白名单，如 10.0.0.0/24（可重复，指定后灰度发布）")
+	flags.BoolVar(&p.freezeOverride, "freeze-override", false, "覆盖发布冻结窗口（需同时填写 --override-reason）")
+	flags.StringVar(&p.overrideReason, "override-reason", "", "覆盖冻结窗口的原因（记录审计）")
+}
+
+// newReleaseCmd 发布版本管理
+func newReleaseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release",
+		Short: "创建、发布和查询发布版本",
+	}
+	cmd.AddCommand(newReleaseCreateCmd(), newReleasePublishCmd(), newReleaseListCmd())
+	return cmd
+}
+
+// newReleaseCreateCmd 创建发布版本
+func newReleaseCreateCmd() *cobra.Command {
+	var name, releaseType string
+	var publish bool
+	var p publishOptions
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "为命名空间当前环境创建发布版本（--publish 时创建后立即发布）",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := requestContext()
+			defer cancel()
+			client := newAPIClient()
+			namespaceID, err := client.resolveNamespace(ctx)
+			if err != nil {
+				return err
+			}
+
+			// 1. 创建版本（灰度发布时版本类型为 canary）
+			if name == "" {
+				name = time.Now().Format("20060102-150405")
+			}
+			if p.isCanary() {
+				releaseType = "canary"
+			}
+			body := map[string]any{
+				"namespace_id":  namespaceID,
+				"environment":   opts.env,
+				"version_name":  name,
+				"release_type":  releaseType,
+				"release_notes": p.notes,
+				"created_by":    releaseCreator(),
+			}
+			var release releaseVO
+			if _, err := client.callIdempotent(ctx, http.MethodPost, "/api/v1/releases", body, &release); err != nil {
+				return err
+			}
+			if !publish {
+				return printResult(fmt.Sprintf("已创建版本 v%d（ID %d，%d 项配置），发布: cfgctl release publish %d",
+					release.Version, release.ID, release.ConfigCount, release.ID), release)
+			}
+
+			// 2. 立即发布
+			if err := publishRelease(ctx, client, release.ID, &p); err != nil {
+				return fmt.Errorf("版本 v%d（ID %d）已创建但发布失败: %w", release.Version, release.ID, err)
+			}
+			return printResult(fmt.Sprintf("已创建并发布版本 v%d（ID %d，%d 项配置）",
+				release.Version, release.ID, release.ConfigCount), release)
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVar(&name, "name", "", "版本名称（默认使用当前时间）")
+	flags.StringVar(&releaseType, "type", "full", "版本类型: full, incremental")
+	flags.StringVarP(&p.notes, "notes", "m", "", "发布说明（发布前必须填写）")
+	flags.BoolVar(&publish, "publish", false, "创建后立即发布")
+	bindPublishFlags(cmd, &p)
+	return cmd
+}
+
+// newReleasePublishCmd 发布版本
+func newReleasePublishCmd() *cobra.Command {
+	var p publishOptions
+	cmd := &cobra.Command{
+		Use:   "publish <release-id>",
+		Short: "全量或灰度发布版本",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			releaseID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("版本ID无效: %s", args[0])
+			}
+
+			ctx, cancel := requestContext()
+			defer cancel()
+			if err := publishRelease(ctx, newAPIClient(), releaseID, &p); err != nil {
+				return err
+			}
+			mode := "全量发布"
+			if p.isCanary() {
+				mode = "灰度发布"
+			}
+			return printResult(fmt.Sprintf("版本 %d 已%s", releaseID, mode), map[string]any{"release_id": releaseID, "canary": p.isCanary()})
+		},
+	}
+	cmd.Flags().StringVarP(&p.notes, "notes", "m", "", "发布说明（非空时覆盖创建版本时填写的说明）")
+	bindPublishFlags(cmd, &p)
+	return cmd
+}
+
+// newReleaseListCmd 查询发布版本
+func newReleaseListCmd() *cobra.Command {
+	var limit int
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "列出命名空间当前环境下最近的发布版本",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := requestContext()
+			defer cancel()
+			client := newAPIClient()
+			namespaceID, err := client.resolveNamespace(ctx)
+			if err != nil {
+				return err
+			}
+
+			query := url.Values{
+				"namespace_id": {strconv.Itoa(namespaceID)},
+				"environment":  {opts.env},
+				"page":         {"1"},
+				"size":         {strconv.Itoa(limit)},
+			}
+			var list struct {
+				Items []*releaseVO `json:"items"`
+			}
+			if _, err := client.call(ctx, http.MethodGet, "/api/v1/releases", query, nil, &list); err != nil {
+				return err
+			}
+
+			if opts.output == "json" {
+				return printJSON(list.Items)
+			}
+			table := newTable()
+			fmt.Fprintln(table, "ID\tVERSION\tNAME\tTYPE\tSTATUS\tCONFIGS\tRELEASED BY\tRELEASED AT")
+			for _, r := range list.Items {
+				releasedAt := "-"
+				if r.ReleasedAt != nil {
+					releasedAt = r.ReleasedAt.Local().Format("2006-01-02 15:04:05")
+				}
+				fmt.Fprintf(table, "%d\tv%d\t%s\t%s\t%s\t%d\t%s\t%s\n",
+					r.ID, r.Version, r.VersionName, r.ReleaseType, r.Status, r.ConfigCount, r.ReleasedBy, releasedAt)
+			}
+			return table.Flush()
+		},
+	}
+	cmd.Flags().IntVar(&limit, "limit", 20, "返回条数")
+	return cmd
+}
+
+// publishRelease 全量或灰度发布版本
+func publishRelease(ctx context.Context, client *apiClient, releaseID int, p *publishOptions) error {
+	body := map[string]any{
+		"release_id":      releaseID,
+		"published_by":    opts.operator,
+		"release_notes":   p.notes,
+		"freeze_override": p.freezeOverride,
+		"override_reason": p.overrideReason,
+	}
+	path := "/api/v1/releases/publish-full"
+	if p.isCanary() {
+		path = "/api/v1/releases/publish-canary"
+		body["canary_percentage"] = p.canary
+		body["client_ids"] = p.clientIDs
+		body["ip_ranges"] = p.ipRanges
+	}
+	_, err := client.callIdempotent(ctx, http.MethodPost, path, body, nil)
+	return err
+}
+
+// releaseCreator 版本创建人
+// 服务端启用认证时创建人取自 API Key 名称，未显式指定操作人时不填写（默认的系统用户名通常与 API Key 名称不一致，会被拒绝）
+func releaseCreator() string {
+	if opts.token != "" && !operatorSet {
+		return ""
+	}
+	return opts.operator
+}
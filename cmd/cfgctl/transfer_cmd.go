@@ -0,0 +1,178 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// importResult 导入结果
+type importResult struct {
+	DryRun    bool `json:"dry_run"`
+	Total     int  `json:"total"`
+	Created   int  `json:"created"`
+	Updated   int  `json:"updated"`
+	Skipped   int  `json:"skipped"`
+	Unchanged int  `json:"unchanged"`
+	Failed    int  `json:"failed"`
+	Items     []struct {
+		Key    string `json:"key"`
+		Action string `json:"action"`
+		Reason string `json:"reason,omitempty"`
+	} `json:"items"`
+}
+
+// newImportCmd 导入配置
+func newImportCmd() *cobra.Command {
+	var format, group, strategy string
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "从 YAML/JSON/properties/dotenv 文件导入配置到命名空间",
+		Long:  "格式默认按文件扩展名推断（- 表示标准输入，需指定 --format）。导入的配置为未发布状态。",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// 1. 读取文件并推断格式
+			content, err := readValueFile(args[0])
+			if err != nil {
+				return err
+			}
+			if format == "" {
+				if format = formatFromExt(args[0]); format == "" {
+					return fmt.Errorf("无法从文件名推断格式，请通过 --format 指定（yaml/json/properties/env）")
+				}
+			}
+
+			ctx, cancel := requestContext()
+			defer cancel()
+			client := newAPIClient()
+			namespaceID, err := client.resolveNamespace(ctx)
+			if err != nil {
+				return err
+			}
+
+			// 2. 导入
+			body := map[string]any{
+				"namespace_id": namespaceID,
+				"environment":  opts.env,
+				"group_name":   group,
+				"format":       format,
+				"content":      content,
+				"strategy":     strategy,
+				"dry_run":      dryRun,
+				"operator":     opts.operator,
+			}
+			var result importResult
+			if _, err := client.call(ctx, http.MethodPost, "/api/v1/configs/import", nil, body, &result); err != nil {
+				return err
+			}
+
+			// 3. 输出结果
+			if opts.output == "json" {
+				return printJSON(result)
+			}
+			prefix := "导入完成"
+			if result.DryRun {
+				prefix = "预览（未写入）"
+			}
+			fmt.Printf("%s: 共 %d 项，新建 %d，覆盖 %d，跳过 %d，未变化 %d，失败 %d\n", prefix,
+				result.Total, result.Created, result.Updated, result.Skipped, result.Unchanged, result.Failed)
+			for _, item := range result.Items {
+				if item.Reason != "" {
+					fmt.Printf("  %s %s: %s\n", item.Action, item.Key, item.Reason)
+				}
+			}
+			if result.Failed > 0 {
+				return fmt.Errorf("%d 项导入失败", result.Failed)
+			}
+			return nil
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVar(&format, "format", "", "内容格式: yaml, json, properties, env（默认按扩展名推断）")
+	flags.StringVarP(&group, "group", "g", "", "导入后的配置分组（默认 default）")
+	flags.StringVar(&strategy, "strategy", "skip", "键已存在时的策略: skip, overwrite, fail")
+	flags.BoolVar(&dryRun, "dry-run", false, "仅预览，不写入")
+	return cmd
+}
+
+// newExportCmd 导出配置
+func newExportCmd() *cobra.Command {
+	var format, group, outFile string
+	var includeSecrets bool
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "导出命名空间当前环境下已发布的配置",
+		Long:  "导出为单个文档，默认输出到标准输出，可通过 --out 写入文件（未指定 --format 时按扩展名推断）。",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if format == "" {
+				if format = formatFromExt(outFile); format == "" {
+					format = "yaml"
+				}
+			}
+
+			ctx, cancel := requestContext()
+			defer cancel()
+			client := newAPIClient()
+			namespaceID, err := client.resolveNamespace(ctx)
+			if err != nil {
+				return err
+			}
+
+			query := url.Values{
+				"namespace_id":    {strconv.Itoa(namespaceID)},
+				"environment":     {opts.env},
+				"format":          {format},
+				"include_secrets": {strconv.FormatBool(includeSecrets)},
+			}
+			if group != "" {
+				query.Set("group_name", group)
+			}
+			content, err := client.raw(ctx, "/api/v1/namespaces/export", query)
+			if err != nil {
+				return err
+			}
+
+			if outFile == "" {
+				_, err = os.Stdout.Write(content)
+				return err
+			}
+			if err := os.WriteFile(outFile, content, 0o600); err != nil {
+				return fmt.Errorf("写入文件失败: %w", err)
+			}
+			fmt.Fprintf(os.Stderr, "已导出到 %s\n", outFile)
+			return nil
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVar(&format, "format", "", "导出格式: yaml, json, properties, env（默认 yaml）")
+	flags.StringVarP(&group, "group", "g", "", "仅导出指定分组")
+	flags.StringVar(&outFile, "out", "", "输出文件（默认标准输出）")
+	flags.BoolVar(&includeSecrets, "include-secrets", false, "导出敏感配置明文")
+	return cmd
+}
+
+// formatFromExt 按文件扩展名推断配置格式，无法推断时返回空
+func formatFromExt(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return "yaml"
+	case ".json":
+		return "json"
+	case ".properties":
+		return "properties"
+	case ".env":
+		return "env"
+	}
+	if filepath.Base(path) == ".env" {
+		return "env"
+	}
+	return ""
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// watchRetryInterval 长轮询失败后的重试间隔
+const watchRetryInterval = 5 * time.Second
+
+// watchChange 长轮询返回的配置变更
+type watchChange struct {
+	NamespaceID int    `json:"namespace_id"`
+	ConfigKey   string `json:"config_key"`
+	Version     string `json:"version"`
+	Value       string `json:"value"`
+	ValueType   string `json:"value_type"`
+	IsCanary    bool   `json:"is_canary"`
+	Deleted     bool   `json:"deleted"`
+}
+
+// watchResponse 长轮询响应
+type watchResponse struct {
+	Changed   bool           `json:"changed"`
+	Configs   []*watchChange `json:"configs"`
+	Sequences map[int]int64  `json:"sequences"`
+}
+
+// newWatchCmd 实时监听配置键
+func newWatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch <key>...",
+		Short: "实时监听配置键的已发布值（Ctrl+C 退出）",
+		Long:  "先输出各配置键当前的已发布值，之后通过长轮询在每次发布、回滚或删除时输出最新值。",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer stop()
+			client := newAPIClient()
+
+			reqCtx, cancel := context.WithTimeout(ctx, opts.timeout)
+			namespaceID, err := client.resolveNamespace(reqCtx)
+			cancel()
+			if err != nil {
+				return err
+			}
+
+			// 1. 获取当前已发布值作为初始版本
+			versions := make(map[string]string, len(args))
+			for _, key := range args {
+				versions[key] = ""
+				var config configVO
+				reqCtx, cancel := context.WithTimeout(ctx, opts.timeout)
+				_, err := client.call(reqCtx, http.MethodGet, "/api/v1/configs/key", url.Values{
+					"namespace_id": {strconv.Itoa(namespaceID)},
+					"key":          {key},
+					"environment":  {opts.env},
+				}, nil, &config)
+				cancel()
+				var apiErr *apiError
+				switch {
+				case err == nil:
+					versions[key] = valueVersion(config.Value)
+					printWatchChange(&watchChange{NamespaceID: namespaceID, ConfigKey: key, Value: config.Value, ValueType: config.ValueType})
+				case errors.As(err, &apiErr) && apiErr.Status == http.StatusNotFound:
+					printWatchChange(&watchChange{NamespaceID: namespaceID, ConfigKey: key, Deleted: true})
+				default:
+					return err
+				}
+			}
+
+			// 2. 长轮询监听变更
+			hostname, _ := os.Hostname()
+			clientID := fmt.Sprintf("cfgctl-%s-%d", hostname, os.Getpid())
+			var sequences map[int]int64
+			for ctx.Err() == nil {
+				keys := make([]map[string]any, 0, len(args))
+				for _, key := range args {
+					keys = append(keys, map[string]any{
+						"namespace_id": namespaceID,
+						"config_key":   key,
+						"version":      versionOrPlaceholder(versions[key]),
+						"environment":  opts.env,
+					})
+				}
+				body := map[string]any{
+					"client_id":       clientID,
+					"client_hostname": hostname,
+					"config_keys":     keys,
+					"last_sequences":  sequences,
+				}
+
+				var resp watchResponse
+				if _, err := client.call(ctx, http.MethodPost, "/api/v1/configs/watch", nil, body, &resp); err != nil {
+					if ctx.Err() != nil {
+						break
+					}
+					fmt.Fprintf(os.Stderr, "长轮询失败，%v 后重试: %v\n", watchRetryInterval, err)
+					select {
+					case <-ctx.Done():
+					case <-time.After(watchRetryInterval):
+					}
+					continue
+				}
+
+				if resp.Sequences != nil {
+					sequences = resp.Sequences
+				}
+				for _, change := range resp.Configs {
+					if _, watched := versions[change.ConfigKey]; !watched {
+						continue
+					}
+					versions[change.ConfigKey] = change.Version
+					printWatchChange(change)
+				}
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+// printWatchChange 输出一次配置值变化
+func printWatchChange(change *watchChange) {
+	if opts.output == "json" {
+		_ = printJSON(change)
+		return
+	}
+	now := time.Now().Format("2006-01-02 15:04:05")
+	switch {
+	case change.Deleted:
+		fmt.Printf("%s  %s (已删除或未发布)\n", now, change.ConfigKey)
+	case change.IsCanary:
+		fmt.Printf("%s  %s = %s (灰度)\n", now, change.ConfigKey, change.Value)
+	default:
+		fmt.Printf("%s  %s = %s\n", now, change.ConfigKey, change.Value)
+	}
+}
+
+// valueVersion 计算配置值的版本号（与服务端一致，为值的 MD5）
+func valueVersion(value string) string {
+	sum := md5.Sum([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// versionOrPlaceholder 配置不存在时以占位版本号监听（版本号为必填）
+func versionOrPlaceholder(version string) string {
+	if version == "" {
+		return "0"
+	}
+	return version
+}
@@ -0,0 +1,26 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// CachedConfig 缓存的配置值及版本
+type CachedConfig struct {
+	Value    string    `json:"value"`     // 配置值（与数据库中存储的值一致，批量查询版本时写入的缓存不含配置值）
+	Version  string    `json:"version"`   // 配置版本（配置值的 MD5）
+	CachedAt time.Time `json:"cached_at"` // 写入缓存的时间
+}
+
+// ConfigCache 配置读取缓存接口
+// 按 命名空间ID:配置键:环境 缓存配置值及版本，由订阅方收到配置变更事件时失效
+type ConfigCache interface {
+	// Get 查询缓存（未命中或已过期时返回 nil）
+	Get(ctx context.Context, namespaceID int, configKey string, environment string) (*CachedConfig, error)
+
+	// Set 写入缓存
+	Set(ctx context.Context, namespaceID int, configKey string, environment string, config *CachedConfig) error
+
+	// Invalidate 失效缓存（environment 为空时失效该配置键所有环境的缓存）
+	Invalidate(ctx context.Context, namespaceID int, configKey string, environment string) error
+}
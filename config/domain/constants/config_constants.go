@@ -6,7 +6,7 @@ const (
 	// HashAlgorithmMD5 MD5哈希算法
 	HashAlgorithmMD5 = "md5"
 
-	// HashAlgorithmSHA256 SHA256哈希算法（保留，未来可能使用）
+	// HashAlgorithmSHA256 SHA256哈希算法
 	HashAlgorithmSHA256 = "sha256"
 )
 
@@ -65,6 +65,9 @@ const (
 
 	// ValueTypeEncrypted 加密类型（敏感配置）
 	ValueTypeEncrypted = "encrypted"
+
+	// ValueTypeFile 文件类型（内容单独存储，配置值为内容引用，如证书、logback.xml）
+	ValueTypeFile = "file"
 )
 
 // ValidValueTypes 有效的值类型列表
@@ -76,6 +79,7 @@ var ValidValueTypes = []string{
 	ValueTypeJSON,
 	ValueTypeYAML,
 	ValueTypeEncrypted,
+	ValueTypeFile,
 }
 
 // ==================== 默认值常量 ====================
@@ -134,3 +138,45 @@ var ValidImportanceLevels = []string{
 	TagValueMedium,
 	TagValueLow,
 }
+
+// ==================== 导入导出相关常量 ====================
+
+const (
+	// ConfigFormatYAML YAML格式
+	ConfigFormatYAML = "yaml"
+
+	// ConfigFormatJSON JSON格式
+	ConfigFormatJSON = "json"
+
+	// ConfigFormatProperties Java properties格式
+	ConfigFormatProperties = "properties"
+
+	// ConfigFormatEnv dotenv格式
+	ConfigFormatEnv = "env"
+)
+
+// ValidConfigFormats 有效的导入导出格式列表
+var ValidConfigFormats = []string{
+	ConfigFormatYAML,
+	ConfigFormatJSON,
+	ConfigFormatProperties,
+	ConfigFormatEnv,
+}
+
+const (
+	// ImportStrategySkip 冲突时跳过已存在的配置
+	ImportStrategySkip = "skip"
+
+	// ImportStrategyOverwrite 冲突时覆盖已存在的配置
+	ImportStrategyOverwrite = "overwrite"
+
+	// ImportStrategyFail 存在冲突时整体失败，不写入任何配置
+	ImportStrategyFail = "fail"
+)
+
+// ValidImportStrategies 有效的导入冲突策略列表
+var ValidImportStrategies = []string{
+	ImportStrategySkip,
+	ImportStrategyOverwrite,
+	ImportStrategyFail,
+}
@@ -0,0 +1,67 @@
+package entity
+
+import "time"
+
+// API Key 角色
+const (
+	APIKeyRoleAdmin  = "admin"  // 管理员：可调用全部管理接口
+	APIKeyRoleReader = "reader" // 只读令牌：绑定一个命名空间和环境，只能读取和监听其中的已发布配置（SDK 使用）
+)
+
+// APIKeyRoles 支持的 API Key 角色
+var APIKeyRoles = []string{
+	APIKeyRoleAdmin,
+	APIKeyRoleReader,
+}
+
+// IsValidAPIKeyRole API Key 角色是否有效
+func IsValidAPIKeyRole(role string) bool {
+	for _, r := range APIKeyRoles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// APIKey API Key 领域实体
+// 调用方通过 Authorization: Bearer <key> 或 X-API-Key 请求头携带密钥，服务端只保存密钥的 SHA-256 摘要
+type APIKey struct {
+	ID          int        `json:"id"`           // 主键ID
+	TenantID    int        `json:"tenant_id"`    // 所属租户ID
+	Name        string     `json:"name"`         // 名称（租户内唯一）
+	Prefix      string     `json:"prefix"`       // 密钥前缀（用于识别密钥，不可用于认证）
+	KeyHash     string     `json:"-"`            // 密钥 SHA-256 摘要（十六进制）
+	Role        string     `json:"role"`         // 角色: admin, reader
+	NamespaceID int        `json:"namespace_id"` // 只读令牌绑定的命名空间ID
+	Environment string     `json:"environment"`  // 只读令牌绑定的环境
+	KeyPrefixes []string   `json:"key_prefixes"` // 只读令牌允许读取的配置键前缀（为空时允许命名空间下的全部配置）
+	Description string     `json:"description"`  // 描述
+	ExpiresAt   *time.Time `json:"expires_at"`   // 过期时间（为空表示永不过期）
+	LastUsedAt  *time.Time `json:"last_used_at"` // 最近使用时间
+	RevokedAt   *time.Time `json:"revoked_at"`   // 吊销时间
+	RevokedBy   string     `json:"revoked_by"`   // 吊销人
+	CreatedBy   string     `json:"created_by"`   // 创建人
+	CreatedAt   time.Time  `json:"created_at"`   // 创建时间
+	UpdatedAt   time.Time  `json:"updated_at"`   // 更新时间
+}
+
+// IsRevoked 是否已吊销
+func (k *APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+// IsExpired 是否已过期
+func (k *APIKey) IsExpired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+// IsUsable 是否可用于认证（未吊销且未过期）
+func (k *APIKey) IsUsable(now time.Time) bool {
+	return !k.IsRevoked() && !k.IsExpired(now)
+}
+
+// IsReadToken 是否为只读令牌
+func (k *APIKey) IsReadToken() bool {
+	return k.Role == APIKeyRoleReader
+}
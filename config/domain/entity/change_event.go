@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+// ChangeEvent 配置变更事件日志领域实体
+// 每个命名空间内的事件按序号单调递增，客户端断线重连后凭最后收到的序号补发遗漏的事件
+type ChangeEvent struct {
+	ID          int64     `json:"id"`           // 主键ID
+	NamespaceID int       `json:"namespace_id"` // 命名空间ID
+	Sequence    int64     `json:"sequence"`     // 命名空间内的事件序号（从1开始，单调递增）
+	ConfigKey   string    `json:"config_key"`   // 配置键
+	ConfigID    int       `json:"config_id"`    // 配置ID
+	Environment string    `json:"environment"`  // 配置所属环境（为空表示未知）
+	Action      string    `json:"action"`       // 操作类型: create, update, delete
+	CreatedAt   time.Time `json:"created_at"`   // 事件时间
+}
@@ -2,6 +2,8 @@ package entity
 
 import (
 	"time"
+
+	"config-client/share/repository"
 )
 
 // Operation 操作类型
@@ -12,6 +14,8 @@ const (
 	OperationUpdate   Operation = "UPDATE"   // 更新
 	OperationDelete   Operation = "DELETE"   // 删除
 	OperationRollback Operation = "ROLLBACK" // 回滚
+	OperationRestore  Operation = "RESTORE"  // 从回收站恢复
+	OperationExpire   Operation = "EXPIRE"   // 到期自动停用或删除
 )
 
 // ChangeHistory 配置变更历史领域实体
@@ -36,6 +40,11 @@ type ChangeHistory struct {
 
 // ==================== 领域行为方法 ====================
 
+// PageCursor 变更记录的游标分页位置
+func (h *ChangeHistory) PageCursor() repository.Cursor {
+	return repository.Cursor{CreatedAt: h.CreatedAt, ID: h.ID}
+}
+
 // CanRollback 是否可以回滚到该版本
 // CREATE 操作无法回滚（没有旧值）
 // DELETE 操作无法回滚（需要重新创建）
@@ -76,6 +85,10 @@ func (h *ChangeHistory) GetChangeSummary() string {
 		return "删除配置"
 	case OperationRollback:
 		return "回滚配置"
+	case OperationRestore:
+		return "恢复配置"
+	case OperationExpire:
+		return "配置过期"
 	default:
 		return "未知操作"
 	}
@@ -1,25 +1,29 @@
 package entity
 
 import (
+	"time"
+
 	baseGorm "config-client/share/repository/gorm"
 )
 
 // Config 配置领域实体（聚合根）
 // 纯粹的领域模型，不包含持久化相关的标签
 type Config struct {
-	baseGorm.BaseEntity         // 组合通用审计字段
-	NamespaceID          int    `json:"namespace_id"`           // 命名空间ID
-	Key                  string `json:"key"`                    // 配置键
-	Value                string `json:"value"`                  // 配置值
-	GroupName            string `json:"group_name"`             // 配置分组
-	ValueType            string `json:"value_type"`             // 值类型
-	Environment          string `json:"environment"`            // 环境
-	IsReleased           bool   `json:"is_released"`            // 是否已发布
-	IsActive             bool   `json:"is_active"`              // 是否激活
-	Description          string `json:"description"`            // 描述
-	Metadata             string `json:"metadata"`               // 元数据
-	ContentHash          string `json:"content_hash"`           // 内容哈希
-	ContentHashAlgorithm string `json:"content_hash_algorithm"` // 哈希算法
+	baseGorm.BaseEntity             // 组合通用审计字段
+	TenantID             int        `json:"tenant_id"`              // 所属租户ID
+	NamespaceID          int        `json:"namespace_id"`           // 命名空间ID
+	Key                  string     `json:"key"`                    // 配置键
+	Value                string     `json:"value"`                  // 配置值
+	GroupName            string     `json:"group_name"`             // 配置分组
+	ValueType            string     `json:"value_type"`             // 值类型
+	Environment          string     `json:"environment"`            // 环境
+	IsReleased           bool       `json:"is_released"`            // 是否已发布
+	IsActive             bool       `json:"is_active"`              // 是否激活
+	Description          string     `json:"description"`            // 描述
+	Metadata             string     `json:"metadata"`               // 元数据
+	ContentHash          string     `json:"content_hash"`           // 内容哈希
+	ContentHashAlgorithm string     `json:"content_hash_algorithm"` // 哈希算法
+	ExpiresAt            *time.Time `json:"expires_at"`             // 过期时间（nil 表示永不过期）
 }
 
 // ==================== 领域行为方法 ====================
@@ -67,6 +71,11 @@ func (c *Config) IsReleasedStatus() bool {
 	return c.IsReleased
 }
 
+// IsExpired 判断配置在指定时间是否已过期
+func (c *Config) IsExpired(now time.Time) bool {
+	return c.ExpiresAt != nil && !c.ExpiresAt.After(now)
+}
+
 // GetFullKey 获取完整的配置键（包含命名空间和环境）
 func (c *Config) GetFullKey() string {
 	return c.Key + ":" + c.Environment
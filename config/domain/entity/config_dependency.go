@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// 配置依赖关系类型
+const (
+	DependencyTypeDeclared  = "declared"  // 显式声明的依赖（depends_on）
+	DependencyTypeReference = "reference" // 配置值中 ${namespace:key} 引用产生的依赖
+)
+
+// ConfigDependency 配置依赖领域实体
+// 表示配置 ConfigID 依赖配置 DependsOnConfigID：上游配置变更时，下游配置可能需要同步调整或重新发布
+type ConfigDependency struct {
+	ID                int       `json:"id"`                   // 主键ID
+	ConfigID          int       `json:"config_id"`            // 下游配置ID（声明依赖的一方）
+	DependsOnConfigID int       `json:"depends_on_config_id"` // 上游配置ID（被依赖的一方）
+	CreatedBy         string    `json:"created_by"`           // 创建人
+	CreatedAt         time.Time `json:"created_at"`           // 创建时间
+}
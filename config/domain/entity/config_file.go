@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// FileReferencePrefix 文件类型配置的值前缀，配置值为 "sha256:<内容哈希>"
+const FileReferencePrefix = "sha256:"
+
+// fileReferencePattern 文件内容引用格式
+var fileReferencePattern = regexp.MustCompile(`^sha256:[0-9a-f]{64}$`)
+
+// ConfigFile 文件类型配置的内容
+// 按（配置ID, 内容哈希）存储，同一配置的历史内容保留，发布快照和变更历史中的引用始终可读
+type ConfigFile struct {
+	ID          int       `json:"id"`
+	ConfigID    int       `json:"config_id"`    // 配置ID
+	SHA256      string    `json:"sha256"`       // 内容 SHA-256 哈希（十六进制）
+	FileName    string    `json:"file_name"`    // 文件名
+	ContentType string    `json:"content_type"` // 内容类型
+	Size        int64     `json:"size"`         // 内容大小（字节）
+	Content     []byte    `json:"-"`            // 文件内容（仅查询内容时加载）
+	CreatedBy   string    `json:"created_by"`   // 上传人
+	CreatedAt   time.Time `json:"created_at"`   // 上传时间
+}
+
+// FileReference 生成文件内容引用（作为文件类型配置的值）
+func FileReference(sha256 string) string {
+	return FileReferencePrefix + sha256
+}
+
+// ParseFileReference 解析文件内容引用，返回内容哈希
+func ParseFileReference(value string) (string, bool) {
+	if !fileReferencePattern.MatchString(value) {
+		return "", false
+	}
+	return strings.TrimPrefix(value, FileReferencePrefix), true
+}
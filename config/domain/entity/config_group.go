@@ -0,0 +1,24 @@
+package entity
+
+import (
+	baseGorm "config-client/share/repository/gorm"
+)
+
+// ConfigGroup 配置分组领域实体
+// 将配置的 group_name 提升为独立实体，记录分组的描述和展示顺序
+// 分组名称在命名空间内唯一，创建后不可修改（配置通过 group_name 关联分组）
+type ConfigGroup struct {
+	baseGorm.BaseEntity        // 组合通用审计字段
+	NamespaceID         int    `json:"namespace_id"` // 命名空间ID
+	Name                string `json:"name"`         // 分组名称（对应配置的 group_name）
+	Description         string `json:"description"`  // 描述
+	SortOrder           int    `json:"sort_order"`   // 展示顺序（升序）
+}
+
+// ==================== 领域行为方法 ====================
+
+// UpdateInfo 更新分组描述和展示顺序
+func (g *ConfigGroup) UpdateInfo(description string, sortOrder int) {
+	g.Description = description
+	g.SortOrder = sortOrder
+}
@@ -0,0 +1,41 @@
+package entity
+
+import (
+	baseGorm "config-client/share/repository/gorm"
+)
+
+// ConfigSchema 配置 Schema 领域实体
+// 将 JSON Schema 绑定到命名空间下的某个配置键或配置分组，用于校验 JSON/YAML 类型的配置值
+// 同一配置同时匹配键绑定和分组绑定时，键绑定优先
+type ConfigSchema struct {
+	baseGorm.BaseEntity        // 组合通用审计字段
+	NamespaceID         int    `json:"namespace_id"` // 命名空间ID
+	ConfigKey           string `json:"config_key"`   // 绑定的配置键（与 GroupName 二选一）
+	GroupName           string `json:"group_name"`   // 绑定的配置分组（与 ConfigKey 二选一）
+	Schema              string `json:"schema"`       // JSON Schema 文档
+	Description         string `json:"description"`  // 描述
+	IsActive            bool   `json:"is_active"`    // 是否启用
+}
+
+// ==================== 领域行为方法 ====================
+
+// UpdateSchema 更新 Schema 文档和描述
+func (s *ConfigSchema) UpdateSchema(schema, description string) {
+	s.Schema = schema
+	s.Description = description
+}
+
+// ==================== 查询方法 ====================
+
+// IsKeyBinding 判断是否绑定到配置键
+func (s *ConfigSchema) IsKeyBinding() bool {
+	return s.ConfigKey != ""
+}
+
+// GetBindingTarget 获取绑定目标描述（key:xxx 或 group:xxx）
+func (s *ConfigSchema) GetBindingTarget() string {
+	if s.IsKeyBinding() {
+		return "key:" + s.ConfigKey
+	}
+	return "group:" + s.GroupName
+}
@@ -0,0 +1,90 @@
+package entity
+
+import "time"
+
+// minutesPerWeek 一周的分钟数
+const minutesPerWeek = 7 * 24 * 60
+
+// FreezeWindow 发布冻结窗口
+// 按周循环，例如周五 18:00 至周一 08:00；窗口内拒绝发布和回滚，除非显式覆盖并填写原因
+type FreezeWindow struct {
+	Name         string         // 窗口名称
+	NamespaceIDs []int          // 生效的命名空间（为空表示全部）
+	Environments []string       // 生效的环境（为空表示全部）
+	StartMinute  int            // 开始时间（自周日 00:00 起的分钟数）
+	EndMinute    int            // 结束时间（自周日 00:00 起的分钟数，小于开始时间表示跨周）
+	Location     *time.Location // 时区
+}
+
+// Applies 判断窗口是否作用于指定命名空间和环境
+func (w *FreezeWindow) Applies(namespaceID int, environment string) bool {
+	if len(w.NamespaceIDs) > 0 && !containsInt(w.NamespaceIDs, namespaceID) {
+		return false
+	}
+	if len(w.Environments) > 0 && !containsString(w.Environments, environment) {
+		return false
+	}
+	return true
+}
+
+// Contains 判断指定时间是否处于窗口内
+func (w *FreezeWindow) Contains(t time.Time) bool {
+	minute := w.minuteOfWeek(t)
+	if w.StartMinute < w.EndMinute {
+		return minute >= w.StartMinute && minute < w.EndMinute
+	}
+	// 跨周窗口，例如周五 18:00 至周一 08:00
+	return minute >= w.StartMinute || minute < w.EndMinute
+}
+
+// EndsAt 获取处于窗口内的时间所在窗口的结束时间
+func (w *FreezeWindow) EndsAt(t time.Time) time.Time {
+	local := t.In(w.Location)
+	remaining := (w.EndMinute - w.minuteOfWeek(t) + minutesPerWeek) % minutesPerWeek
+	return local.Truncate(time.Minute).Add(time.Duration(remaining) * time.Minute)
+}
+
+// minuteOfWeek 计算时间在窗口时区中自周日 00:00 起的分钟数
+func (w *FreezeWindow) minuteOfWeek(t time.Time) int {
+	local := t.In(w.Location)
+	return int(local.Weekday())*24*60 + local.Hour()*60 + local.Minute()
+}
+
+func containsInt(values []int, target int) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
+
+// 发布冻结覆盖的操作类型
+const (
+	FreezeOperationPublishFull   = "publish_full"   // 全量发布
+	FreezeOperationPublishCanary = "publish_canary" // 灰度发布
+	FreezeOperationRollback      = "rollback"       // 回滚
+)
+
+// ReleaseFreezeOverride 冻结窗口覆盖审计记录
+// 冻结窗口内显式覆盖执行发布或回滚时记录一条
+type ReleaseFreezeOverride struct {
+	ID          int
+	ReleaseID   int       // 发布版本ID（回滚时为当前版本ID）
+	NamespaceID int       // 命名空间ID
+	Environment string    // 环境
+	WindowName  string    // 被覆盖的冻结窗口名称
+	Operation   string    // 操作类型（publish_full/publish_canary/rollback）
+	Operator    string    // 操作人
+	Reason      string    // 覆盖原因
+	CreatedAt   time.Time // 操作时间
+}
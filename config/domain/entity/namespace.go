@@ -8,12 +8,40 @@ import (
 // 用于隔离不同应用的配置，例如：user-service、order-service、payment-app
 // 纯粹的领域模型，不包含持久化相关的标签
 type Namespace struct {
-	baseGorm.BaseEntity        // 组合通用审计字段
-	Name                string `json:"name"`         // 命名空间名称，唯一标识
-	DisplayName         string `json:"display_name"` // 显示名称
-	Description         string `json:"description"`  // 描述信息
-	IsActive            bool   `json:"is_active"`    // 是否启用
-	Metadata            string `json:"metadata"`     // 扩展元数据（JSON格式）
+	baseGorm.BaseEntity                // 组合通用审计字段
+	TenantID            int            `json:"tenant_id"`    // 所属租户ID
+	Name                string         `json:"name"`         // 命名空间名称，租户内唯一
+	DisplayName         string         `json:"display_name"` // 显示名称
+	Description         string         `json:"description"`  // 描述信息
+	IsActive            bool           `json:"is_active"`    // 是否启用
+	Metadata            string         `json:"metadata"`     // 扩展元数据（JSON格式）
+	Quota               NamespaceQuota `json:"quota"`        // 配额（未设置的项使用全局默认配额）
+}
+
+// NamespaceQuota 命名空间配额
+// 各项为 0 时表示未设置：命名空间上未设置的项继承全局默认配额，全局默认配额为 0 时不限制
+type NamespaceQuota struct {
+	MaxConfigs          int `json:"max_configs"`           // 配置数量上限（所有环境合计，不含回收站中的配置）
+	MaxValueSize        int `json:"max_value_size"`        // 单个配置值大小上限（字节，文件类型配置受 file.max_size 限制）
+	MaxSubscribers      int `json:"max_subscribers"`       // 活跃订阅数量上限（按客户端+环境计数）
+	MaxReleasesRetained int `json:"max_releases_retained"` // 每个环境保留的发布版本数量（超出后创建版本时清理最早的版本）
+}
+
+// WithDefaults 合并默认配额，返回生效配额（未设置的项使用默认值）
+func (q NamespaceQuota) WithDefaults(defaults NamespaceQuota) NamespaceQuota {
+	if q.MaxConfigs == 0 {
+		q.MaxConfigs = defaults.MaxConfigs
+	}
+	if q.MaxValueSize == 0 {
+		q.MaxValueSize = defaults.MaxValueSize
+	}
+	if q.MaxSubscribers == 0 {
+		q.MaxSubscribers = defaults.MaxSubscribers
+	}
+	if q.MaxReleasesRetained == 0 {
+		q.MaxReleasesRetained = defaults.MaxReleasesRetained
+	}
+	return q
 }
 
 // ==================== 领域行为方法 ====================
@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// 通知渠道类型
+const (
+	NotificationChannelDingTalk = "dingtalk" // 钉钉群机器人
+	NotificationChannelSlack    = "slack"    // Slack Incoming Webhook
+	NotificationChannelEmail    = "email"    // 邮件（SMTP）
+)
+
+// NotificationChannelTypes 支持的通知渠道类型
+var NotificationChannelTypes = []string{
+	NotificationChannelDingTalk,
+	NotificationChannelSlack,
+	NotificationChannelEmail,
+}
+
+// 通知事件
+const (
+	NotificationEventPublish      = "publish"       // 发布（全量发布和灰度发布）
+	NotificationEventRollback     = "rollback"      // 回滚（全量版本被回滚）
+	NotificationEventCanaryFailed = "canary_failed" // 灰度失败（灰度版本被回滚）
+)
+
+// NotificationEvents 可订阅的通知事件
+var NotificationEvents = []string{
+	NotificationEventPublish,
+	NotificationEventRollback,
+	NotificationEventCanaryFailed,
+}
+
+// IsValidNotificationEvent 通知事件是否有效
+func IsValidNotificationEvent(event string) bool {
+	for _, e := range NotificationEvents {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// NotificationChannel 命名空间通知渠道领域实体
+// 命名空间内的发布、回滚和灰度失败按事件过滤后，以模板渲染的消息发送到钉钉、Slack 或邮件
+type NotificationChannel struct {
+	ID          int       `json:"id"`           // 主键ID
+	NamespaceID int       `json:"namespace_id"` // 命名空间ID
+	Name        string    `json:"name"`         // 名称
+	Type        string    `json:"type"`         // 渠道类型: dingtalk, slack, email
+	Target      string    `json:"target"`       // 发送目标：钉钉/Slack 为机器人 Webhook 地址，邮件为收件人（逗号分隔）
+	Secret      string    `json:"-"`            // 钉钉机器人加签密钥（可选）
+	Events      []string  `json:"events"`       // 事件过滤（为空时订阅全部事件）
+	Template    string    `json:"template"`     // 自定义消息模板（Go text/template，为空时使用默认模板）
+	Enabled     bool      `json:"enabled"`      // 是否启用
+	CreatedBy   string    `json:"created_by"`   // 创建人
+	UpdatedBy   string    `json:"updated_by"`   // 更新人
+	CreatedAt   time.Time `json:"created_at"`   // 创建时间
+	UpdatedAt   time.Time `json:"updated_at"`   // 更新时间
+}
+
+// Matches 是否订阅了指定事件
+func (c *NotificationChannel) Matches(event string) bool {
+	if !c.Enabled {
+		return false
+	}
+	if len(c.Events) == 0 {
+		return true
+	}
+	for _, e := range c.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Recipients 邮件收件人列表（Target 按逗号分隔，忽略空项）
+func (c *NotificationChannel) Recipients() []string {
+	var recipients []string
+	for _, addr := range strings.Split(c.Target, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			recipients = append(recipients, addr)
+		}
+	}
+	return recipients
+}
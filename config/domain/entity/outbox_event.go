@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// 发件箱事件状态
+const (
+	OutboxStatusPending    = "pending"    // 待投递
+	OutboxStatusProcessing = "processing" // 投递中（已被某个实例领取，租约到期前其他实例不会重复领取）
+	OutboxStatusSent       = "sent"       // 已投递
+)
+
+// OutboxEvent 事务性发件箱事件领域实体
+// 与配置变更在同一事务内写入，由后台投递任务发布到配置变更监听器，保证变更提交后事件最终一定会发出
+type OutboxEvent struct {
+	ID            int64      `json:"id"`              // 主键ID
+	Payload       string     `json:"payload"`         // 事件内容（JSON 序列化的配置变更事件）
+	Status        string     `json:"status"`          // 状态: pending, processing, sent
+	Attempts      int        `json:"attempts"`        // 投递失败次数
+	LastError     string     `json:"last_error"`      // 最近一次投递失败原因
+	NextAttemptAt time.Time  `json:"next_attempt_at"` // 下次可投递时间（失败后按退避时间推迟）
+	LockedUntil   *time.Time `json:"locked_until"`    // 领取租约到期时间
+	CreatedAt     time.Time  `json:"created_at"`      // 创建时间
+	SentAt        *time.Time `json:"sent_at"`         // 投递成功时间
+}
+
+// IsSent 是否已投递
+func (e *OutboxEvent) IsSent() bool {
+	return e.Status == OutboxStatusSent
+}
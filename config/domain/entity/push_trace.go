@@ -0,0 +1,35 @@
+package entity
+
+import "time"
+
+// 通知来源
+const (
+	PushSourceEvent   = "event"   // 配置变更事件触发的推送
+	PushSourceCompare = "compare" // 长轮询开始时版本比较发现客户端版本落后
+	PushSourceReplay  = "replay"  // 客户端断线重连时补发遗漏的事件
+)
+
+// PushTrace 变更通知下发记录
+// 长轮询响应返回变更时记录一条，客户端随后的长轮询上报了通知中的版本即视为已确认
+type PushTrace struct {
+	ID             int64
+	SubscriptionID int        // 订阅ID
+	ClientID       string     // 客户端ID
+	NamespaceID    int        // 配置所属命名空间ID
+	Environment    string     // 环境
+	ConfigKey      string     // 配置键
+	Version        string     // 通知的配置版本
+	ReleaseID      int        // 触发通知的发布版本ID（非发布触发时为0）
+	Sequence       int64      // 触发通知的事件序号（未启用事件日志或非事件触发时为0）
+	TraceID        string     // 触发变更的请求链路ID
+	Source         string     // 通知来源（event/compare/replay）
+	PushedAt       time.Time  // 下发时间
+	Acked          bool       // 客户端是否已确认
+	AckedAt        *time.Time // 确认时间
+}
+
+// Ack 标记客户端已确认
+func (t *PushTrace) Ack(at time.Time) {
+	t.Acked = true
+	t.AckedAt = &at
+}
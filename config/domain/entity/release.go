@@ -2,6 +2,8 @@ package entity
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 
 	baseGorm "config-client/share/repository/gorm"
@@ -19,6 +21,18 @@ const (
 	ReleaseStatusRollback ReleaseStatus = "rollback"
 )
 
+// ReleaseApprovalStatus 发布审批状态
+type ReleaseApprovalStatus string
+
+const (
+	// ReleaseApprovalNone 未审批
+	ReleaseApprovalNone ReleaseApprovalStatus = ""
+	// ReleaseApprovalApproved 审批通过
+	ReleaseApprovalApproved ReleaseApprovalStatus = "approved"
+	// ReleaseApprovalRejected 审批驳回
+	ReleaseApprovalRejected ReleaseApprovalStatus = "rejected"
+)
+
 // ReleaseType 发布类型
 type ReleaseType string
 
@@ -34,23 +48,30 @@ const (
 // Release 发布版本领域实体（聚合根）
 // 用于支持配置的版本发布和灰度发布
 type Release struct {
-	baseGorm.BaseEntity               // 组合通用审计字段
-	NamespaceID         int           `json:"namespace_id"`          // 命名空间ID
-	Environment         string        `json:"environment"`           // 发布环境
-	Version             int           `json:"version"`               // 版本号
-	VersionName         string        `json:"version_name"`          // 版本名称，例如：v1.0.0
-	ConfigSnapshot      string        `json:"config_snapshot"`       // 配置快照（JSON格式）
-	ConfigCount         int           `json:"config_count"`          // 包含的配置项数量
-	Status              ReleaseStatus `json:"status"`                // 状态
-	ReleaseType         ReleaseType   `json:"release_type"`          // 发布类型
-	CanaryRule          string        `json:"canary_rule"`           // 灰度规则（JSON格式）
-	CanaryPercentage    int           `json:"canary_percentage"`     // 灰度比例（0-100）
-	ReleasedBy          string        `json:"released_by"`           // 发布人
-	ReleasedAt          *time.Time    `json:"released_at"`           // 发布时间
-	RollbackFromVersion int           `json:"rollback_from_version"` // 从哪个版本回滚
-	RollbackBy          string        `json:"rollback_by"`           // 回滚人
-	RollbackAt          *time.Time    `json:"rollback_at"`           // 回滚时间
-	RollbackReason      string        `json:"rollback_reason"`       // 回滚原因
+	baseGorm.BaseEntity                       // 组合通用审计字段
+	TenantID            int                   `json:"tenant_id"`             // 所属租户ID
+	NamespaceID         int                   `json:"namespace_id"`          // 命名空间ID
+	Environment         string                `json:"environment"`           // 发布环境
+	Version             int                   `json:"version"`               // 版本号
+	VersionName         string                `json:"version_name"`          // 版本名称，例如：v1.0.0
+	ConfigSnapshot      string                `json:"config_snapshot"`       // 配置快照（JSON格式）
+	ConfigCount         int                   `json:"config_count"`          // 包含的配置项数量
+	Status              ReleaseStatus         `json:"status"`                // 状态
+	ReleaseType         ReleaseType           `json:"release_type"`          // 发布类型
+	BaseReleaseID       int                   `json:"base_release_id"`       // 增量发布的基线版本ID（0 表示快照包含全部配置）
+	ReleaseNotes        string                `json:"release_notes"`         // 发布说明
+	Changelog           string                `json:"changelog"`             // 相对上一已发布版本的键级变更明细（JSON格式）
+	ApprovalStatus      ReleaseApprovalStatus `json:"approval_status"`       // 审批状态（受保护环境发布前必须审批通过）
+	ApprovedBy          string                `json:"approved_by"`           // 最近一次审批人
+	ApprovedAt          *time.Time            `json:"approved_at"`           // 最近一次审批时间
+	CanaryRule          string                `json:"canary_rule"`           // 灰度规则（JSON格式）
+	CanaryPercentage    int                   `json:"canary_percentage"`     // 灰度比例（0-100）
+	ReleasedBy          string                `json:"released_by"`           // 发布人
+	ReleasedAt          *time.Time            `json:"released_at"`           // 发布时间
+	RollbackFromVersion int                   `json:"rollback_from_version"` // 从哪个版本回滚
+	RollbackBy          string                `json:"rollback_by"`           // 回滚人
+	RollbackAt          *time.Time            `json:"rollback_at"`           // 回滚时间
+	RollbackReason      string                `json:"rollback_reason"`       // 回滚原因
 }
 
 // ConfigSnapshotItem 配置快照项
@@ -64,6 +85,16 @@ type ConfigSnapshotItem struct {
 	ContentHashAlgorithm string `json:"content_hash_algorithm"`
 	Description          string `json:"description"`
 	Version              int    `json:"version"`
+	Deleted              bool   `json:"deleted,omitempty"` // 增量快照中表示该配置相对基线版本已删除
+}
+
+// ReleaseChangelog 发布版本相对上一已发布版本的键级变更明细
+type ReleaseChangelog struct {
+	BaseReleaseID int      `json:"base_release_id"` // 对比的上一已发布版本ID（0 表示没有已发布版本）
+	BaseVersion   int      `json:"base_version"`    // 对比的上一已发布版本号
+	Added         []string `json:"added"`           // 新增的配置键
+	Modified      []string `json:"modified"`        // 修改的配置键
+	Deleted       []string `json:"deleted"`         // 删除的配置键
 }
 
 // CanaryRule 灰度规则
@@ -92,6 +123,32 @@ func (r *Release) Rollback(rollbackBy string, reason string) {
 	r.RollbackReason = reason
 }
 
+// Approve 审批通过
+func (r *Release) Approve(approvedBy string) {
+	r.ApprovalStatus = ReleaseApprovalApproved
+	now := time.Now()
+	r.ApprovedAt = &now
+	r.ApprovedBy = approvedBy
+}
+
+// Reject 审批驳回
+func (r *Release) Reject(rejectedBy string) {
+	r.ApprovalStatus = ReleaseApprovalRejected
+	now := time.Now()
+	r.ApprovedAt = &now
+	r.ApprovedBy = rejectedBy
+}
+
+// IsApproved 是否已审批通过
+func (r *Release) IsApproved() bool {
+	return r.ApprovalStatus == ReleaseApprovalApproved
+}
+
+// CanApprove 判断是否可以审批（仅测试中的版本可以审批）
+func (r *Release) CanApprove() bool {
+	return r.Status == ReleaseStatusTesting
+}
+
 // IsPublished 是否已发布
 func (r *Release) IsPublished() bool {
 	return r.Status == ReleaseStatusPublished
@@ -112,6 +169,12 @@ func (r *Release) IsCanaryRelease() bool {
 	return r.ReleaseType == ReleaseTypeCanary
 }
 
+// IsIncremental 是否增量快照（快照只包含相对基线版本变更的配置）
+// 增量版本灰度发布后发布类型变为 canary，因此以基线版本ID判断
+func (r *Release) IsIncremental() bool {
+	return r.BaseReleaseID > 0
+}
+
 // GetConfigSnapshot 获取配置快照
 func (r *Release) GetConfigSnapshot() ([]ConfigSnapshotItem, error) {
 	var snapshot []ConfigSnapshotItem
@@ -133,6 +196,91 @@ func (r *Release) SetConfigSnapshot(snapshot []ConfigSnapshotItem) error {
 	return nil
 }
 
+// GetChangelog 获取变更明细（历史版本没有记录时返回 nil）
+func (r *Release) GetChangelog() (*ReleaseChangelog, error) {
+	if r.Changelog == "" {
+		return nil, nil
+	}
+	var changelog ReleaseChangelog
+	err := json.Unmarshal([]byte(r.Changelog), &changelog)
+	return &changelog, err
+}
+
+// SetChangelog 设置变更明细
+func (r *Release) SetChangelog(changelog *ReleaseChangelog) error {
+	if changelog == nil {
+		r.Changelog = ""
+		return nil
+	}
+	data, err := json.Marshal(changelog)
+	if err != nil {
+		return err
+	}
+	r.Changelog = string(data)
+	return nil
+}
+
+// RenderChangelog 生成变更日志文本：发布说明后追加键级变更明细
+func (r *Release) RenderChangelog(changelog *ReleaseChangelog) string {
+	var builder strings.Builder
+	builder.WriteString(r.ReleaseNotes)
+	if changelog == nil {
+		return builder.String()
+	}
+
+	if builder.Len() > 0 {
+		builder.WriteString("\n\n")
+	}
+	if changelog.BaseReleaseID > 0 {
+		fmt.Fprintf(&builder, "变更明细（相对 v%d）：", changelog.BaseVersion)
+	} else {
+		builder.WriteString("变更明细（首个发布版本）：")
+	}
+	if len(changelog.Added)+len(changelog.Modified)+len(changelog.Deleted) == 0 {
+		builder.WriteString("\n无配置变更")
+		return builder.String()
+	}
+	for _, key := range changelog.Added {
+		builder.WriteString("\n+ " + key)
+	}
+	for _, key := range changelog.Modified {
+		builder.WriteString("\n~ " + key)
+	}
+	for _, key := range changelog.Deleted {
+		builder.WriteString("\n- " + key)
+	}
+	return builder.String()
+}
+
+// MergeConfigSnapshot 将增量快照合并到基线快照上，得到完整的生效配置
+// 业务规则：
+// 1. 增量快照中的配置覆盖基线中同名配置，基线中不存在的配置追加在末尾
+// 2. 标记为已删除的配置从结果中移除
+func MergeConfigSnapshot(base []ConfigSnapshotItem, increment []ConfigSnapshotItem) []ConfigSnapshotItem {
+	changes := make(map[string]ConfigSnapshotItem, len(increment))
+	for _, item := range increment {
+		changes[item.Key] = item
+	}
+
+	merged := make([]ConfigSnapshotItem, 0, len(base)+len(increment))
+	for _, item := range base {
+		if change, exists := changes[item.Key]; exists {
+			delete(changes, item.Key)
+			if change.Deleted {
+				continue
+			}
+			item = change
+		}
+		merged = append(merged, item)
+	}
+	for _, item := range increment {
+		if _, pending := changes[item.Key]; pending && !item.Deleted {
+			merged = append(merged, item)
+		}
+	}
+	return merged
+}
+
 // GetCanaryRule 获取灰度规则
 func (r *Release) GetCanaryRule() (*CanaryRule, error) {
 	if r.CanaryRule == "" {
@@ -0,0 +1,23 @@
+package entity
+
+import "time"
+
+// 审批动作
+const (
+	ReleaseApprovalActionApprove = "approve" // 审批通过
+	ReleaseApprovalActionReject  = "reject"  // 审批驳回
+)
+
+// ReleaseApproval 发布版本审批记录
+// 每次审批通过或驳回记录一条，用于审计受保护环境的发布
+type ReleaseApproval struct {
+	ID          int
+	ReleaseID   int       // 发布版本ID
+	NamespaceID int       // 命名空间ID
+	Environment string    // 发布环境
+	Version     int       // 发布版本号
+	Action      string    // 审批动作（approve/reject）
+	Operator    string    // 审批人
+	Comment     string    // 审批意见
+	CreatedAt   time.Time // 审批时间
+}
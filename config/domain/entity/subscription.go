@@ -9,6 +9,7 @@ type Subscription struct {
 	ID int
 
 	// 关联信息
+	TenantID    int // 所属租户ID
 	NamespaceID int // 订阅的命名空间ID
 
 	// 客户端信息
@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SubscriptionKey 订阅监听的配置键及客户端上报的版本
+// 每次长轮询请求携带客户端当前持有的配置版本，用于查询变更是否已下发到所有客户端
+type SubscriptionKey struct {
+	ID             int
+	SubscriptionID int       // 订阅ID
+	NamespaceID    int       // 配置所属命名空间ID
+	ConfigKey      string    // 配置键
+	Version        string    // 客户端上报的配置版本（MD5，为空表示客户端尚未持有该配置）
+	ReportedAt     time.Time // 上报时间
+}
+
+// ComputeVersionSnapshotHash 计算客户端上报版本的快照哈希（按配置键排序后的 MD5）
+// versions: 配置键（格式 "namespaceID:configKey"）到版本的映射
+func ComputeVersionSnapshotHash(versions map[string]string) string {
+	keys := make([]string, 0, len(versions))
+	for key := range versions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var buf strings.Builder
+	for _, key := range keys {
+		buf.WriteString(key)
+		buf.WriteString("=")
+		buf.WriteString(versions[key])
+		buf.WriteString("\n")
+	}
+	hash := md5.Sum([]byte(buf.String()))
+	return hex.EncodeToString(hash[:])
+}
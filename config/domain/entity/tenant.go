@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+// Tenant 租户领域实体
+// 一个集群可以同时服务多个组织：命名空间、配置、发布版本、订阅和 API Key 均归属于租户，
+// 请求只能访问认证主体所属租户的数据
+type Tenant struct {
+	ID          int       `json:"id"`          // 主键ID
+	Code        string    `json:"code"`        // 租户编码（唯一，创建后不可修改）
+	Name        string    `json:"name"`        // 租户名称
+	Description string    `json:"description"` // 描述
+	IsActive    bool      `json:"is_active"`   // 是否启用（停用后该租户的 API Key 无法访问接口）
+	CreatedBy   string    `json:"created_by"`  // 创建人
+	UpdatedBy   string    `json:"updated_by"`  // 更新人
+	CreatedAt   time.Time `json:"created_at"`  // 创建时间
+	UpdatedAt   time.Time `json:"updated_at"`  // 更新时间
+}
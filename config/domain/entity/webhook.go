@@ -0,0 +1,113 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// Webhook 事件类型
+const (
+	WebhookEventConfigCreate    = "config.create"    // 创建配置
+	WebhookEventConfigUpdate    = "config.update"    // 更新配置
+	WebhookEventConfigDelete    = "config.delete"    // 删除配置
+	WebhookEventConfigRestore   = "config.restore"   // 从回收站恢复配置
+	WebhookEventReleasePublish  = "release.publish"  // 全量发布
+	WebhookEventReleaseCanary   = "release.canary"   // 灰度发布
+	WebhookEventReleaseRollback = "release.rollback" // 回滚
+)
+
+// WebhookEventTypes 可订阅的事件类型
+var WebhookEventTypes = []string{
+	WebhookEventConfigCreate,
+	WebhookEventConfigUpdate,
+	WebhookEventConfigDelete,
+	WebhookEventConfigRestore,
+	WebhookEventReleasePublish,
+	WebhookEventReleaseCanary,
+	WebhookEventReleaseRollback,
+}
+
+// IsValidWebhookEventFilter 事件过滤项是否有效
+// 支持具体事件类型（如 config.update）和分类通配（如 release.*）
+func IsValidWebhookEventFilter(filter string) bool {
+	for _, eventType := range WebhookEventTypes {
+		if matchWebhookEvent(filter, eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchWebhookEvent 事件类型是否匹配过滤项
+func matchWebhookEvent(filter, eventType string) bool {
+	if category, ok := strings.CutSuffix(filter, ".*"); ok {
+		return strings.HasPrefix(eventType, category+".")
+	}
+	return filter == eventType
+}
+
+// Webhook 命名空间 Webhook 领域实体
+// 命名空间内的配置变更和发布事件按事件过滤投递到 URL，请求体使用 Secret 做 HMAC-SHA256 签名
+type Webhook struct {
+	ID          int       `json:"id"`           // 主键ID
+	NamespaceID int       `json:"namespace_id"` // 命名空间ID
+	Name        string    `json:"name"`         // 名称
+	URL         string    `json:"url"`          // 投递地址
+	Secret      string    `json:"-"`            // 签名密钥
+	Events      []string  `json:"events"`       // 事件过滤（为空时订阅全部事件）
+	Enabled     bool      `json:"enabled"`      // 是否启用
+	CreatedBy   string    `json:"created_by"`   // 创建人
+	UpdatedBy   string    `json:"updated_by"`   // 更新人
+	CreatedAt   time.Time `json:"created_at"`   // 创建时间
+	UpdatedAt   time.Time `json:"updated_at"`   // 更新时间
+}
+
+// Matches 是否订阅了指定事件
+func (w *Webhook) Matches(eventType string) bool {
+	if !w.Enabled {
+		return false
+	}
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, filter := range w.Events {
+		if matchWebhookEvent(filter, eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+// Webhook 投递状态
+const (
+	WebhookDeliveryPending    = "pending"    // 待投递（含失败后等待重试）
+	WebhookDeliveryProcessing = "processing" // 投递中（已被某个实例领取，租约到期前其他实例不会重复领取）
+	WebhookDeliverySucceeded  = "succeeded"  // 投递成功
+	WebhookDeliveryFailed     = "failed"     // 重试次数耗尽，投递失败
+)
+
+// WebhookDelivery Webhook 投递记录领域实体
+// 事件发生时为每个匹配的 Webhook 写入一条投递记录，由后台投递任务发送，同时作为投递日志保留
+type WebhookDelivery struct {
+	ID             int64      `json:"id"`              // 主键ID（同时作为投递ID随请求头发送）
+	WebhookID      int        `json:"webhook_id"`      // Webhook ID
+	NamespaceID    int        `json:"namespace_id"`    // 命名空间ID
+	EventType      string     `json:"event_type"`      // 事件类型
+	Payload        string     `json:"payload"`         // 请求体（JSON）
+	Status         string     `json:"status"`          // 状态: pending, processing, succeeded, failed
+	Attempts       int        `json:"attempts"`        // 已尝试次数
+	ResponseStatus int        `json:"response_status"` // 最近一次响应状态码（未收到响应时为0）
+	ResponseBody   string     `json:"response_body"`   // 最近一次响应内容（截断）
+	LastError      string     `json:"last_error"`      // 最近一次失败原因
+	DurationMs     int64      `json:"duration_ms"`     // 最近一次请求耗时（毫秒）
+	NextAttemptAt  time.Time  `json:"next_attempt_at"` // 下次可投递时间（失败后按退避时间推迟）
+	LockedUntil    *time.Time `json:"locked_until"`    // 领取租约到期时间
+	RedeliveryOf   *int64     `json:"redelivery_of"`   // 重新投递的原投递记录ID
+	CreatedAt      time.Time  `json:"created_at"`      // 创建时间
+	DeliveredAt    *time.Time `json:"delivered_at"`    // 投递成功时间
+}
+
+// IsFinished 是否已结束投递（成功或重试耗尽）
+func (d *WebhookDelivery) IsFinished() bool {
+	return d.Status == WebhookDeliverySucceeded || d.Status == WebhookDeliveryFailed
+}
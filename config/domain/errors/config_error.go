@@ -1,6 +1,12 @@
 package errors
 
-import "config-client/share/errors"
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"config-client/share/errors"
+)
 
 // ==================== 配置领域错误码 ====================
 // 错误码分段: 20000-20999
@@ -10,6 +16,9 @@ import "config-client/share/errors"
 //   - xx03: forbidden (403)
 //   - xx04: not_found (404)
 //   - xx05: conflict (409)
+//
+// 部分历史错误码的末两位与实际含义不符（如长轮询、订阅相关错误码），对应的构造函数通过 WithHTTPStatus 指定状态码
+// 错误码保持稳定，供调用方按错误码处理错误；错误消息按 Accept-Language 返回对应语言（翻译见 messages_en.go）
 const (
 	// 配置相关错误码 20000-20099
 	ConfigNotFound           = 20004 // 配置不存在 (404)
@@ -27,12 +36,13 @@ const (
 	ConfigCannotDelete       = 20903 // 配置无法删除 (403)
 
 	// 长轮询相关错误码 20500-20599
-	LongPollingServiceNotStarted = 20500 // 长轮询服务未启动
-	LongPollingServiceStopped    = 20501 // 长轮询服务已关闭
+	LongPollingServiceNotStarted = 20500 // 长轮询服务未启动 (503)
+	LongPollingServiceStopped    = 20501 // 长轮询服务已关闭 (503)
 	LongPollingTimeout           = 20502 // 长轮询超时
-	LongPollingSubscribeFailed   = 20503 // 长轮询订阅失败
-	LongPollingInvalidConfigKey  = 20504 // 长轮询配置键格式无效
-	LongPollingGetVersionFailed  = 20505 // 长轮询获取配置版本失败
+	LongPollingSubscribeFailed   = 20503 // 长轮询订阅失败 (500)
+	LongPollingInvalidConfigKey  = 20504 // 长轮询配置键格式无效 (400)
+	LongPollingGetVersionFailed  = 20505 // 长轮询获取配置版本失败 (500)
+	LongPollingTooManyWaiters    = 20529 // 并发长轮询数超出上限 (429)
 
 	// 订阅相关错误码 20600-20699
 	SubscriptionNotFound        = 20604 // 订阅不存在 (404)
@@ -50,118 +60,261 @@ const (
 	NamespaceNameInvalid    = 21201 // 命名空间名称无效 (400)
 	NamespaceCannotDelete   = 21303 // 命名空间无法删除 (403)
 	NamespaceMustDeactivate = 21401 // 命名空间必须先停用 (400)
+
+	// 导入导出相关错误码 22000-22399
+	ConfigFormatUnsupported     = 22001 // 不支持的配置格式 (400)
+	ConfigContentParseFailed    = 22101 // 配置内容解析失败 (400)
+	ConfigImportStrategyInvalid = 22201 // 导入冲突策略无效 (400)
+	ConfigImportConflict        = 22305 // 导入存在冲突 (409)
+
+	// 环境晋升相关错误码 22400-22599
+	PromotionEnvironmentInvalid = 22401 // 晋升源环境与目标环境无效 (400)
+	PromotionDiffChanged        = 22505 // 晋升差异已变化 (409)
+
+	// 配置引用相关错误码 22600-22799
+	ConfigReferenceCycle    = 22601 // 配置引用存在循环 (400)
+	ConfigReferenceNotFound = 22604 // 引用的配置不存在 (404)
+	ConfigReferenceTooDeep  = 22701 // 配置引用层级过深 (400)
+
+	// 配置Schema相关错误码 22800-22999
+	ConfigSchemaInvalid       = 22801 // Schema 或绑定目标无效 (400)
+	ConfigSchemaNotFound      = 22804 // Schema 绑定不存在 (404)
+	ConfigSchemaAlreadyExists = 22805 // Schema 绑定已存在 (409)
+	ConfigSchemaViolation     = 22901 // 配置值不符合 Schema (400)
+
+	// 配置值校验器相关错误码 23000-23199
+	ConfigValidatorInvalid   = 23001 // 校验规则声明无效 (400)
+	ConfigValueRuleViolation = 23101 // 配置值不符合校验规则 (400)
+
+	// 配置批量变更相关错误码 23200-23299
+	ConfigBatchInvalid = 23201 // 批量变更请求无效 (400)
+
+	// 配置搜索相关错误码 23300-23399
+	ConfigSearchInvalid = 23301 // 搜索参数无效 (400)
+
+	// 配置标签相关错误码 23400-23499
+	ConfigTagInvalid = 23401 // 标签参数无效 (400)
+
+	// 配置分组相关错误码 23500-23599
+	ConfigGroupNameInvalid   = 23501 // 分组名称无效 (400)
+	ConfigGroupNotEmpty      = 23503 // 分组下存在配置，无法删除 (403)
+	ConfigGroupAlreadyExists = 23505 // 分组已存在 (409)
+
+	// 配置依赖相关错误码 23600-23799
+	ConfigDependencyInvalid = 23601 // 依赖声明无效 (400)
+	ConfigDependencyCycle   = 23701 // 依赖关系存在循环 (400)
+
+	// 配置过期相关错误码 23800-23899
+	ConfigExpiresAtInvalid = 23801 // 过期时间无效 (400)
+	ConfigExpired          = 23803 // 配置已过期 (403)
+
+	// 文件类型配置相关错误码 23900-23999
+	ConfigFileInvalid  = 23901 // 文件配置无效 (400)
+	ConfigFileNotFound = 23904 // 文件内容不存在 (404)
+	ConfigFileTooLarge = 23913 // 文件超过大小限制 (413)
+
+	// 发布审批相关错误码 24000-24099
+	ReleaseApprovalInvalid  = 24001 // 审批请求无效 (400)
+	ReleaseNotApproved      = 24003 // 发布版本未审批通过 (403)
+	ReleaseApproverConflict = 24103 // 审批人不能是版本创建人 (403)
+
+	// 发布冻结窗口相关错误码 24200-24299
+	ReleaseFreezeOverrideInvalid = 24201 // 冻结窗口覆盖请求无效 (400)
+	ReleaseFrozen                = 24203 // 处于发布冻结窗口 (403)
+
+	// Webhook 相关错误码 24300-24499
+	WebhookInvalid          = 24301 // Webhook 参数无效 (400)
+	WebhookNotFound         = 24304 // Webhook 不存在 (404)
+	WebhookDeliveryNotFound = 24404 // Webhook 投递记录不存在 (404)
+
+	// 通知渠道相关错误码 24500-24599
+	NotificationChannelInvalid  = 24501 // 通知渠道参数无效 (400)
+	NotificationChannelNotFound = 24504 // 通知渠道不存在 (404)
+	NotificationSendFailed      = 24522 // 通知发送失败 (422)
+
+	// API Key 相关错误码 24600-24699
+	APIKeyInvalid      = 24601 // API Key 参数无效 (400)
+	APIKeyUnauthorized = 24602 // 未携带或携带了无效的 API Key (401)
+	APIKeyForbidden    = 24603 // API Key 无权访问该接口或配置 (403)
+	APIKeyNotFound     = 24604 // API Key 不存在 (404)
+	APIKeyConflict     = 24605 // API Key 名称已存在 (409)
+
+	// 租户相关错误码 24700-24799
+	TenantInvalid   = 24701 // 租户参数无效 (400)
+	TenantForbidden = 24703 // 无权访问租户或租户已停用 (403)
+	TenantNotFound  = 24704 // 租户不存在 (404)
+	TenantConflict  = 24705 // 租户编码已存在 (409)
+
+	// 命名空间配额相关错误码 24800-24899
+	NamespaceQuotaInvalid  = 24801 // 命名空间配额参数无效 (400)
+	NamespaceQuotaExceeded = 24803 // 超出命名空间配额 (403)
+	ConfigValueTooLarge    = 24813 // 配置值超过命名空间大小上限 (413)
+
+	// 分页相关错误码 24900-24999
+	PageCursorInvalid = 24901 // 分页游标无效 (400)
+
+	// 系统配置相关错误码 25000-25099
+	SystemConfigInvalid  = 25001 // 系统配置参数无效 (400)
+	SystemConfigNotFound = 25004 // 系统配置不存在 (404)
 )
 
 // ==================== 长轮询领域业务异常 ====================
 
 // ErrLongPollingServiceNotStarted 长轮询服务未启动
 func ErrLongPollingServiceNotStarted() *errors.AppError {
-	return errors.New(LongPollingServiceNotStarted, "长轮询服务未启动")
+	return errors.New(LongPollingServiceNotStarted, "长轮询服务未启动").
+		WithVariant("long_polling_not_started").
+		WithHTTPStatus(http.StatusServiceUnavailable)
 }
 
 // ErrLongPollingServiceStopped 长轮询服务已关闭
 func ErrLongPollingServiceStopped() *errors.AppError {
-	return errors.New(LongPollingServiceStopped, "长轮询服务已关闭")
+	return errors.New(LongPollingServiceStopped, "长轮询服务已关闭").
+		WithHTTPStatus(http.StatusServiceUnavailable)
 }
 
 // ErrLongPollingSubscribeFailed 长轮询订阅失败
 func ErrLongPollingSubscribeFailed(err error) *errors.AppError {
-	return errors.Wrap(LongPollingSubscribeFailed, "订阅配置变更事件失败", err)
+	return errors.Wrap(LongPollingSubscribeFailed, "订阅配置变更事件失败", err).
+		WithHTTPStatus(http.StatusInternalServerError)
 }
 
 // ErrLongPollingInvalidConfigKey 长轮询配置键格式无效
 func ErrLongPollingInvalidConfigKey(configKey string) *errors.AppError {
-	return errors.New(LongPollingInvalidConfigKey, "长轮询配置键格式无效: "+configKey+", 正确格式为 namespaceID:configKey")
+	return errors.New(LongPollingInvalidConfigKey, "长轮询配置键格式无效: "+configKey+", 正确格式为 namespaceID:configKey").
+		WithParams(errors.Params{"key": configKey}).
+		WithHTTPStatus(http.StatusBadRequest)
 }
 
 // ErrLongPollingGetVersionFailed 长轮询获取配置版本失败
 func ErrLongPollingGetVersionFailed(configKey string, err error) *errors.AppError {
-	return errors.Wrap(LongPollingGetVersionFailed, "获取配置版本失败: "+configKey, err)
+	return errors.Wrap(LongPollingGetVersionFailed, "获取配置版本失败: "+configKey, err).
+		WithParams(errors.Params{"key": configKey}).
+		WithHTTPStatus(http.StatusInternalServerError)
+}
+
+// ErrLongPollingTooManyWaiters 并发长轮询数超出上限
+func ErrLongPollingTooManyWaiters(retryAfter int) *errors.AppError {
+	return errors.New(LongPollingTooManyWaiters, "长轮询连接数已达上限，请 "+strconv.Itoa(retryAfter)+" 秒后重试").
+		WithParams(errors.Params{"seconds": strconv.Itoa(retryAfter)}).
+		WithRetryAfter(retryAfter)
 }
 
 // ErrConfigNotFound 配置不存在
 func ErrConfigNotFound(key string, environment string) *errors.AppError {
-	return errors.New(ConfigNotFound, "配置不存在: key="+key+", env="+environment)
+	return errors.New(ConfigNotFound, "配置不存在: key="+key+", env="+environment).
+		WithParams(errors.Params{"key": key, "env": environment})
 }
 
 // ErrConfigAlreadyExists 配置已存在
 func ErrConfigAlreadyExists(key string, environment string) *errors.AppError {
-	return errors.New(ConfigAlreadyExists, "配置已存在: key="+key+", env="+environment)
+	return errors.New(ConfigAlreadyExists, "配置已存在: key="+key+", env="+environment).
+		WithParams(errors.Params{"key": key, "env": environment})
 }
 
 // ErrConfigNotReleased 配置未发布
 func ErrConfigNotReleased(key string) *errors.AppError {
-	return errors.New(ConfigNotReleased, "配置未发布，无法使用: key="+key)
+	return errors.New(ConfigNotReleased, "配置未发布，无法使用: key="+key).
+		WithParams(errors.Params{"key": key})
 }
 
 // ErrConfigAlreadyReleased 配置已发布
 func ErrConfigAlreadyReleased(key string) *errors.AppError {
-	return errors.New(ConfigAlreadyReleased, "配置已发布，无法修改: key="+key)
+	return errors.New(ConfigAlreadyReleased, "配置已发布，无法修改: key="+key).
+		WithParams(errors.Params{"key": key})
 }
 
 // ErrConfigNotActive 配置未激活
 func ErrConfigNotActive(key string) *errors.AppError {
-	return errors.New(ConfigNotActive, "配置未激活: key="+key)
+	return errors.New(ConfigNotActive, "配置未激活: key="+key).
+		WithParams(errors.Params{"key": key})
 }
 
 // ErrConfigKeyInvalid 配置键无效
 func ErrConfigKeyInvalid(key string, reason string) *errors.AppError {
-	return errors.New(ConfigKeyInvalid, "配置键无效: key="+key+", 原因: "+reason)
+	return errors.New(ConfigKeyInvalid, "配置键无效: key="+key+", 原因: "+reason).
+		WithParams(errors.Params{"key": key, "reason": reason})
 }
 
 // ErrConfigKeyEmpty 配置键不能为空
 func ErrConfigKeyEmpty() *errors.AppError {
-	return errors.New(ConfigKeyInvalid, "配置键不能为空")
+	return errors.New(ConfigKeyInvalid, "配置键不能为空").
+		WithVariant("empty")
 }
 
 // ErrConfigKeyFormatInvalid 配置键格式无效
 func ErrConfigKeyFormatInvalid(key string) *errors.AppError {
-	return errors.New(ConfigKeyInvalid, "配置键格式无效: key="+key+", 只能包含字母、数字、下划线、中划线、点号")
+	return errors.New(ConfigKeyInvalid, "配置键格式无效: key="+key+", 只能包含字母、数字、下划线、中划线、点号").
+		WithVariant("format").
+		WithParams(errors.Params{"key": key})
 }
 
 // ErrConfigValueInvalid 配置值无效
 func ErrConfigValueInvalid(key string, reason string) *errors.AppError {
-	return errors.New(ConfigValueInvalid, "配置值无效: key="+key+", 原因: "+reason)
+	return errors.New(ConfigValueInvalid, "配置值无效: key="+key+", 原因: "+reason).
+		WithParams(errors.Params{"key": key, "reason": reason})
 }
 
 // ErrConfigValueEmpty 配置值不能为空
 func ErrConfigValueEmpty(key string) *errors.AppError {
-	return errors.New(ConfigValueInvalid, "配置值不能为空: key="+key)
+	return errors.New(ConfigValueInvalid, "配置值不能为空: key="+key).
+		WithVariant("empty").
+		WithParams(errors.Params{"key": key})
 }
 
 // ErrConfigValueTypeInvalid 配置值类型无效
 func ErrConfigValueTypeInvalid(valueType string, reason string) *errors.AppError {
-	return errors.New(ConfigValueTypeInvalid, "配置值类型验证失败: type="+valueType+", 原因: "+reason)
+	return errors.New(ConfigValueTypeInvalid, "配置值类型验证失败: type="+valueType+", 原因: "+reason).
+		WithParams(errors.Params{"type": valueType, "reason": reason}).
+		WithHTTPStatus(http.StatusBadRequest)
 }
 
 // ErrUnsupportedHashAlgorithm 不支持的哈希算法
 func ErrUnsupportedHashAlgorithm(algorithm string) *errors.AppError {
-	return errors.New(ConfigValueInvalid, "不支持的哈希算法: "+algorithm)
+	return errors.New(ConfigValueInvalid, "不支持的哈希算法: "+algorithm).
+		WithVariant("hash_algorithm").
+		WithParams(errors.Params{"algorithm": algorithm})
 }
 
 // ErrConfigHashMismatch 配置哈希不匹配
 func ErrConfigHashMismatch(key string, expected string, actual string) *errors.AppError {
-	return errors.New(ConfigHashMismatch, "配置哈希不匹配: key="+key)
+	return errors.New(ConfigHashMismatch, "配置哈希不匹配: key="+key).
+		WithVariant("hash_mismatch").
+		WithParams(errors.Params{"key": key})
 }
 
 // ErrConfigVersionConflict 配置版本冲突
 func ErrConfigVersionConflict(key string, expectedVersion int, actualVersion int) *errors.AppError {
-	return errors.New(ConfigVersionConflict, "配置版本冲突: key="+key)
+	return errors.New(ConfigVersionConflict, "配置版本冲突: key="+key+
+		", expected="+strconv.Itoa(expectedVersion)+", actual="+strconv.Itoa(actualVersion)+"，配置已被他人修改，请刷新后重试").
+		WithVariant("version_conflict").
+		WithParams(errors.Params{"key": key, "expected": strconv.Itoa(expectedVersion), "actual": strconv.Itoa(actualVersion)})
 }
 
 // ErrConfigGroupNotFound 配置分组不存在
 func ErrConfigGroupNotFound(groupName string) *errors.AppError {
-	return errors.New(ConfigGroupNotFound, "配置分组不存在: group="+groupName)
+	return errors.New(ConfigGroupNotFound, "配置分组不存在: group="+groupName).
+		WithVariant("group").
+		WithParams(errors.Params{"group": groupName})
+}
+
+// ErrConfigGroupNotFoundByID 配置分组不存在（按ID查询）
+func ErrConfigGroupNotFoundByID(id int) *errors.AppError {
+	return errors.New(ConfigGroupNotFound, "配置分组不存在: id="+strconv.Itoa(id)).
+		WithVariant("group_id").
+		WithParams(errors.Params{"id": strconv.Itoa(id)})
 }
 
 // ErrConfigEnvironmentInvalid 环境参数无效
 func ErrConfigEnvironmentInvalid(environment string) *errors.AppError {
-	return errors.New(ConfigEnvironmentInvalid, "环境参数无效: env="+environment)
+	return errors.New(ConfigEnvironmentInvalid, "环境参数无效: env="+environment).
+		WithParams(errors.Params{"env": environment})
 }
 
 // ErrConfigCannotDelete 配置无法删除（已发布的配置不能删除）
 func ErrConfigCannotDelete(key string) *errors.AppError {
-	return errors.New(ConfigCannotDelete, "配置已发布，无法删除，请先取消发布: key="+key)
+	return errors.New(ConfigCannotDelete, "配置已发布，无法删除，请先取消发布: key="+key).
+		WithParams(errors.Params{"key": key})
 }
 
 // ==================== 命名空间领域业务异常 ====================
@@ -171,47 +324,59 @@ func ErrNamespaceNotFound(name string) *errors.AppError {
 	if name == "" {
 		return errors.New(NamespaceNotFound, "命名空间不存在")
 	}
-	return errors.New(NamespaceNotFound, "命名空间不存在: name="+name)
+	return errors.New(NamespaceNotFound, "命名空间不存在: name="+name).
+		WithVariant("name").
+		WithParams(errors.Params{"name": name})
 }
 
 // ErrNamespaceAlreadyExists 命名空间已存在
 func ErrNamespaceAlreadyExists(name string) *errors.AppError {
-	return errors.New(NamespaceAlreadyExists, "命名空间已存在: name="+name)
+	return errors.New(NamespaceAlreadyExists, "命名空间已存在: name="+name).
+		WithParams(errors.Params{"name": name})
 }
 
 // ErrNamespaceNotActive 命名空间未激活
 func ErrNamespaceNotActive(name string) *errors.AppError {
-	return errors.New(NamespaceNotActive, "命名空间未激活: name="+name)
+	return errors.New(NamespaceNotActive, "命名空间未激活: name="+name).
+		WithParams(errors.Params{"name": name})
 }
 
 // ErrNamespaceNameInvalid 命名空间名称无效
 func ErrNamespaceNameInvalid(name string, reason string) *errors.AppError {
-	return errors.New(NamespaceNameInvalid, "命名空间名称无效: name="+name+", 原因: "+reason)
+	return errors.New(NamespaceNameInvalid, "命名空间名称无效: name="+name+", 原因: "+reason).
+		WithParams(errors.Params{"name": name, "reason": reason})
 }
 
 // ErrNamespaceNameEmpty 命名空间名称不能为空
 func ErrNamespaceNameEmpty() *errors.AppError {
-	return errors.New(NamespaceNameInvalid, "命名空间名称不能为空")
+	return errors.New(NamespaceNameInvalid, "命名空间名称不能为空").
+		WithVariant("empty")
 }
 
 // ErrNamespaceNameLengthInvalid 命名空间名称长度无效
 func ErrNamespaceNameLengthInvalid(name string) *errors.AppError {
-	return errors.New(NamespaceNameInvalid, "命名空间名称长度必须在 2-255 之间: name="+name)
+	return errors.New(NamespaceNameInvalid, "命名空间名称长度必须在 2-255 之间: name="+name).
+		WithVariant("length").
+		WithParams(errors.Params{"name": name})
 }
 
 // ErrNamespaceNameFormatInvalid 命名空间名称格式无效
 func ErrNamespaceNameFormatInvalid(name string) *errors.AppError {
-	return errors.New(NamespaceNameInvalid, "命名空间名称只能包含小写字母、数字、下划线、中划线: name="+name)
+	return errors.New(NamespaceNameInvalid, "命名空间名称只能包含小写字母、数字、下划线、中划线: name="+name).
+		WithVariant("format").
+		WithParams(errors.Params{"name": name})
 }
 
 // ErrNamespaceDisplayNameTooLong 命名空间显示名称过长
 func ErrNamespaceDisplayNameTooLong() *errors.AppError {
-	return errors.New(NamespaceNameInvalid, "显示名称长度不能超过 255")
+	return errors.New(NamespaceNameInvalid, "显示名称长度不能超过 255").
+		WithVariant("display_name_too_long")
 }
 
 // ErrNamespaceDisplayNameEmpty 命名空间显示名称不能为空
 func ErrNamespaceDisplayNameEmpty() *errors.AppError {
-	return errors.New(NamespaceNameInvalid, "显示名称不能为空")
+	return errors.New(NamespaceNameInvalid, "显示名称不能为空").
+		WithVariant("display_name_empty")
 }
 
 // ErrNamespaceCannotDelete 命名空间无法删除（存在关联配置）
@@ -221,42 +386,425 @@ func ErrNamespaceCannotDelete(name string, configCount int64) *errors.AppError {
 
 // ErrNamespaceMustDeactivate 命名空间必须先停用才能删除
 func ErrNamespaceMustDeactivate(name string) *errors.AppError {
-	return errors.New(NamespaceMustDeactivate, "命名空间必须先停用才能删除: name="+name)
+	return errors.New(NamespaceMustDeactivate, "命名空间必须先停用才能删除: name="+name).
+		WithParams(errors.Params{"name": name})
 }
 
 // ==================== 订阅领域业务异常 ====================
 
 // ErrSubscriptionNotFound 订阅不存在
 func ErrSubscriptionNotFound(clientID string, namespaceID int, environment string) *errors.AppError {
-	return errors.New(SubscriptionNotFound, "订阅不存在: clientID="+clientID)
+	return errors.New(SubscriptionNotFound, "订阅不存在: clientID="+clientID).
+		WithParams(errors.Params{"client_id": clientID})
 }
 
 // ErrSubscriptionAlreadyExists 订阅已存在
 func ErrSubscriptionAlreadyExists(clientID string, namespaceID int, environment string) *errors.AppError {
-	return errors.New(SubscriptionAlreadyExists, "订阅已存在: clientID="+clientID)
+	return errors.New(SubscriptionAlreadyExists, "订阅已存在: clientID="+clientID).
+		WithVariant("subscription_exists").
+		WithParams(errors.Params{"client_id": clientID})
 }
 
 // ErrSubscriptionInactive 订阅未激活
 func ErrSubscriptionInactive(clientID string) *errors.AppError {
-	return errors.New(SubscriptionInactive, "订阅未激活: clientID="+clientID)
+	return errors.New(SubscriptionInactive, "订阅未激活: clientID="+clientID).
+		WithParams(errors.Params{"client_id": clientID})
 }
 
 // ErrSubscriptionExpired 订阅已过期
 func ErrSubscriptionExpired(clientID string) *errors.AppError {
-	return errors.New(SubscriptionExpired, "订阅已过期: clientID="+clientID)
+	return errors.New(SubscriptionExpired, "订阅已过期: clientID="+clientID).
+		WithParams(errors.Params{"client_id": clientID}).
+		WithHTTPStatus(http.StatusBadRequest)
 }
 
 // ErrSubscriptionCreateFailed 创建订阅失败
 func ErrSubscriptionCreateFailed(err error) *errors.AppError {
-	return errors.Wrap(SubscriptionCreateFailed, "创建订阅失败", err)
+	return errors.Wrap(SubscriptionCreateFailed, "创建订阅失败", err).
+		WithHTTPStatus(http.StatusInternalServerError)
 }
 
 // ErrSubscriptionUpdateFailed 更新订阅失败
 func ErrSubscriptionUpdateFailed(err error) *errors.AppError {
-	return errors.Wrap(SubscriptionUpdateFailed, "更新订阅失败", err)
+	return errors.Wrap(SubscriptionUpdateFailed, "更新订阅失败", err).
+		WithVariant("subscription_update_failed").
+		WithHTTPStatus(http.StatusInternalServerError)
 }
 
 // ErrSubscriptionHeartbeatFailed 更新心跳失败
 func ErrSubscriptionHeartbeatFailed(err error) *errors.AppError {
-	return errors.Wrap(SubscriptionHeartbeatFailed, "更新心跳失败", err)
+	return errors.Wrap(SubscriptionHeartbeatFailed, "更新心跳失败", err).
+		WithHTTPStatus(http.StatusInternalServerError)
+}
+
+// ==================== 导入导出领域业务异常 ====================
+
+// ErrConfigFormatUnsupported 不支持的配置格式
+func ErrConfigFormatUnsupported(format string) *errors.AppError {
+	return errors.New(ConfigFormatUnsupported, "不支持的配置格式: format="+format+", 可选值: yaml/json/properties/env").
+		WithParams(errors.Params{"format": format})
+}
+
+// ErrConfigContentParseFailed 配置内容解析失败
+func ErrConfigContentParseFailed(format string, err error) *errors.AppError {
+	return errors.Wrap(ConfigContentParseFailed, "配置内容解析失败: format="+format, err).
+		WithParams(errors.Params{"format": format})
+}
+
+// ErrConfigImportStrategyInvalid 导入冲突策略无效
+func ErrConfigImportStrategyInvalid(strategy string) *errors.AppError {
+	return errors.New(ConfigImportStrategyInvalid, "导入冲突策略无效: strategy="+strategy+", 可选值: skip/overwrite/fail").
+		WithParams(errors.Params{"strategy": strategy})
+}
+
+// ErrConfigImportConflict 导入存在冲突
+func ErrConfigImportConflict(keys []string) *errors.AppError {
+	return errors.New(ConfigImportConflict, "导入存在冲突的配置: keys="+strings.Join(keys, ",")).
+		WithParams(errors.Params{"keys": strings.Join(keys, ",")})
+}
+
+// ==================== 环境晋升领域业务异常 ====================
+
+// ErrPromotionSameEnvironment 源环境与目标环境相同
+func ErrPromotionSameEnvironment(environment string) *errors.AppError {
+	return errors.New(PromotionEnvironmentInvalid, "源环境与目标环境不能相同: env="+environment).
+		WithParams(errors.Params{"env": environment})
+}
+
+// ErrPromotionDiffChanged 晋升差异已变化，需要重新预览确认
+func ErrPromotionDiffChanged() *errors.AppError {
+	return errors.New(PromotionDiffChanged, "配置差异在预览后已发生变化，请重新预览并确认")
+}
+
+// ==================== 配置引用领域业务异常 ====================
+
+// ErrConfigReferenceCycle 配置引用存在循环
+func ErrConfigReferenceCycle(chain []string) *errors.AppError {
+	return errors.New(ConfigReferenceCycle, "配置引用存在循环: "+strings.Join(chain, " -> ")).
+		WithParams(errors.Params{"chain": strings.Join(chain, " -> ")})
+}
+
+// ErrConfigReferenceNotFound 引用的配置不存在
+func ErrConfigReferenceNotFound(reference string, environment string) *errors.AppError {
+	return errors.New(ConfigReferenceNotFound, "引用的配置不存在或未发布: ${"+reference+"}, env="+environment).
+		WithParams(errors.Params{"reference": reference, "env": environment})
+}
+
+// ErrConfigReferenceTooDeep 配置引用层级过深
+func ErrConfigReferenceTooDeep(maxDepth int) *errors.AppError {
+	return errors.New(ConfigReferenceTooDeep, "配置引用层级超过上限: max="+strconv.Itoa(maxDepth)).
+		WithParams(errors.Params{"max": strconv.Itoa(maxDepth)})
+}
+
+// ==================== 配置Schema领域业务异常 ====================
+
+// ErrConfigSchemaInvalid Schema 文档无效
+func ErrConfigSchemaInvalid(err error) *errors.AppError {
+	return errors.Wrap(ConfigSchemaInvalid, "JSON Schema 无效: "+err.Error(), err).
+		WithParams(errors.Params{"error": err.Error()})
+}
+
+// ErrConfigSchemaBindingInvalid Schema 绑定目标无效
+func ErrConfigSchemaBindingInvalid() *errors.AppError {
+	return errors.New(ConfigSchemaInvalid, "Schema 必须且只能绑定到一个配置键或一个配置分组").
+		WithVariant("binding")
+}
+
+// ErrConfigSchemaNotFound Schema 绑定不存在
+func ErrConfigSchemaNotFound(id int) *errors.AppError {
+	return errors.New(ConfigSchemaNotFound, "Schema 绑定不存在: id="+strconv.Itoa(id)).
+		WithParams(errors.Params{"id": strconv.Itoa(id)})
+}
+
+// ErrConfigSchemaAlreadyExists Schema 绑定已存在
+func ErrConfigSchemaAlreadyExists(target string) *errors.AppError {
+	return errors.New(ConfigSchemaAlreadyExists, "Schema 绑定已存在: "+target).
+		WithParams(errors.Params{"target": target})
+}
+
+// ErrConfigSchemaViolation 配置值不符合 Schema
+func ErrConfigSchemaViolation(key string, violations []string) *errors.AppError {
+	return errors.New(ConfigSchemaViolation, "配置值不符合 Schema: key="+key+"; "+strings.Join(violations, "; ")).
+		WithParams(errors.Params{"key": key, "violations": strings.Join(violations, "; ")})
+}
+
+// ==================== 配置值校验器领域业务异常 ====================
+
+// ErrConfigValidatorInvalid 校验规则声明无效
+func ErrConfigValidatorInvalid(validator string, reason string) *errors.AppError {
+	return errors.New(ConfigValidatorInvalid, "校验规则无效: "+validator+", "+reason).
+		WithParams(errors.Params{"validator": validator, "reason": reason})
+}
+
+// ErrConfigValueRuleViolation 配置值不符合校验规则
+func ErrConfigValueRuleViolation(validator string, reason string) *errors.AppError {
+	return errors.New(ConfigValueRuleViolation, "配置值不符合校验规则: "+validator+", "+reason).
+		WithParams(errors.Params{"validator": validator, "reason": reason})
+}
+
+// ==================== 配置批量变更领域业务异常 ====================
+
+// ErrConfigBatchInvalid 批量变更请求无效
+func ErrConfigBatchInvalid(reason string) *errors.AppError {
+	return errors.New(ConfigBatchInvalid, "批量变更请求无效: "+reason).
+		WithParams(errors.Params{"reason": reason})
+}
+
+// ==================== 配置搜索领域业务异常 ====================
+
+// ErrConfigSearchInvalid 搜索参数无效
+func ErrConfigSearchInvalid(reason string) *errors.AppError {
+	return errors.New(ConfigSearchInvalid, "搜索参数无效: "+reason).
+		WithParams(errors.Params{"reason": reason})
+}
+
+// ==================== 配置标签领域业务异常 ====================
+
+// ErrConfigTagInvalid 标签参数无效
+func ErrConfigTagInvalid(reason string) *errors.AppError {
+	return errors.New(ConfigTagInvalid, "标签参数无效: "+reason).
+		WithParams(errors.Params{"reason": reason})
+}
+
+// ==================== 配置分组领域业务异常 ====================
+
+// ErrConfigGroupNameInvalid 分组名称无效
+func ErrConfigGroupNameInvalid(name string, reason string) *errors.AppError {
+	return errors.New(ConfigGroupNameInvalid, "分组名称无效: name="+name+", "+reason).
+		WithParams(errors.Params{"name": name, "reason": reason})
+}
+
+// ErrConfigGroupNotEmpty 分组下存在配置，无法删除
+func ErrConfigGroupNotEmpty(name string, configCount int) *errors.AppError {
+	return errors.New(ConfigGroupNotEmpty, "分组下存在 "+strconv.Itoa(configCount)+" 个配置，无法删除: group="+name).
+		WithParams(errors.Params{"group": name, "count": strconv.Itoa(configCount)})
+}
+
+// ErrConfigGroupAlreadyExists 分组已存在
+func ErrConfigGroupAlreadyExists(name string) *errors.AppError {
+	return errors.New(ConfigGroupAlreadyExists, "配置分组已存在: group="+name).
+		WithParams(errors.Params{"group": name})
+}
+
+// ==================== 配置依赖领域业务异常 ====================
+
+// ErrConfigDependencyInvalid 依赖声明无效
+func ErrConfigDependencyInvalid(reason string) *errors.AppError {
+	return errors.New(ConfigDependencyInvalid, "依赖声明无效: "+reason).
+		WithParams(errors.Params{"reason": reason})
+}
+
+// ErrConfigDependencyCycle 依赖关系存在循环
+func ErrConfigDependencyCycle(chain []string) *errors.AppError {
+	return errors.New(ConfigDependencyCycle, "依赖关系存在循环: "+strings.Join(chain, " -> ")).
+		WithParams(errors.Params{"chain": strings.Join(chain, " -> ")})
+}
+
+// ==================== 配置过期领域业务异常 ====================
+
+// ErrConfigExpiresAtInvalid 过期时间无效
+func ErrConfigExpiresAtInvalid(reason string) *errors.AppError {
+	return errors.New(ConfigExpiresAtInvalid, "过期时间无效: "+reason).
+		WithParams(errors.Params{"reason": reason})
+}
+
+// ErrConfigExpired 配置已过期
+func ErrConfigExpired(key string) *errors.AppError {
+	return errors.New(ConfigExpired, "配置已过期: key="+key).
+		WithParams(errors.Params{"key": key})
+}
+
+// ==================== 文件类型配置领域业务异常 ====================
+
+// ErrConfigFileInvalid 文件配置无效
+func ErrConfigFileInvalid(reason string) *errors.AppError {
+	return errors.New(ConfigFileInvalid, "文件配置无效: "+reason).
+		WithParams(errors.Params{"reason": reason})
+}
+
+// ErrConfigFileNotFound 文件内容不存在
+func ErrConfigFileNotFound(key string) *errors.AppError {
+	return errors.New(ConfigFileNotFound, "文件内容不存在: key="+key).
+		WithParams(errors.Params{"key": key})
+}
+
+// ErrConfigFileTooLarge 文件超过大小限制
+func ErrConfigFileTooLarge(maxSize int64) *errors.AppError {
+	return errors.New(ConfigFileTooLarge, "文件超过大小限制: max="+strconv.FormatInt(maxSize, 10)+" bytes").
+		WithParams(errors.Params{"max": strconv.FormatInt(maxSize, 10)})
+}
+
+// ==================== 发布审批领域业务异常 ====================
+
+// ErrReleaseApprovalInvalid 审批请求无效
+func ErrReleaseApprovalInvalid(reason string) *errors.AppError {
+	return errors.New(ReleaseApprovalInvalid, "审批请求无效: "+reason).
+		WithParams(errors.Params{"reason": reason})
+}
+
+// ErrReleaseNotApproved 发布版本未审批通过
+func ErrReleaseNotApproved(environment string, releaseID int) *errors.AppError {
+	return errors.New(ReleaseNotApproved, "发布到受保护环境 "+environment+" 前需审批通过: releaseID="+strconv.Itoa(releaseID)).
+		WithParams(errors.Params{"env": environment, "release_id": strconv.Itoa(releaseID)})
+}
+
+// ErrReleaseApproverConflict 审批人不能是版本创建人
+func ErrReleaseApproverConflict(operator string) *errors.AppError {
+	return errors.New(ReleaseApproverConflict, "审批人不能是版本创建人: "+operator).
+		WithParams(errors.Params{"operator": operator})
+}
+
+// ==================== 发布冻结窗口领域业务异常 ====================
+
+// ErrReleaseFreezeOverrideInvalid 冻结窗口覆盖请求无效
+func ErrReleaseFreezeOverrideInvalid(reason string) *errors.AppError {
+	return errors.New(ReleaseFreezeOverrideInvalid, "冻结窗口覆盖请求无效: "+reason).
+		WithParams(errors.Params{"reason": reason})
+}
+
+// ErrReleaseFrozen 处于发布冻结窗口
+func ErrReleaseFrozen(windowName string, endsAt string) *errors.AppError {
+	return errors.New(ReleaseFrozen, "处于发布冻结窗口 "+windowName+"（至 "+endsAt+"），如需执行请携带 freeze_override 并填写覆盖原因").
+		WithParams(errors.Params{"window": windowName, "ends_at": endsAt})
+}
+
+// ==================== Webhook 领域业务异常 ====================
+
+// ErrWebhookInvalid Webhook 参数无效
+func ErrWebhookInvalid(reason string) *errors.AppError {
+	return errors.New(WebhookInvalid, "Webhook 参数无效: "+reason).
+		WithParams(errors.Params{"reason": reason})
+}
+
+// ErrWebhookNotFound Webhook 不存在
+func ErrWebhookNotFound(id int) *errors.AppError {
+	return errors.New(WebhookNotFound, "Webhook 不存在: id="+strconv.Itoa(id)).
+		WithParams(errors.Params{"id": strconv.Itoa(id)})
+}
+
+// ErrWebhookDeliveryNotFound Webhook 投递记录不存在
+func ErrWebhookDeliveryNotFound(id int64) *errors.AppError {
+	return errors.New(WebhookDeliveryNotFound, "Webhook 投递记录不存在: id="+strconv.FormatInt(id, 10)).
+		WithParams(errors.Params{"id": strconv.FormatInt(id, 10)})
+}
+
+// ==================== 通知渠道领域业务异常 ====================
+
+// ErrNotificationChannelInvalid 通知渠道参数无效
+func ErrNotificationChannelInvalid(reason string) *errors.AppError {
+	return errors.New(NotificationChannelInvalid, "通知渠道参数无效: "+reason).
+		WithParams(errors.Params{"reason": reason})
+}
+
+// ErrNotificationChannelNotFound 通知渠道不存在
+func ErrNotificationChannelNotFound(id int) *errors.AppError {
+	return errors.New(NotificationChannelNotFound, "通知渠道不存在: id="+strconv.Itoa(id)).
+		WithParams(errors.Params{"id": strconv.Itoa(id)})
+}
+
+// ErrNotificationSendFailed 通知发送失败
+func ErrNotificationSendFailed(reason string) *errors.AppError {
+	return errors.New(NotificationSendFailed, "通知发送失败: "+reason).
+		WithParams(errors.Params{"reason": reason})
+}
+
+// ==================== API Key 领域业务异常 ====================
+
+// ErrAPIKeyInvalid API Key 参数无效
+func ErrAPIKeyInvalid(reason string) *errors.AppError {
+	return errors.New(APIKeyInvalid, "API Key 参数无效: "+reason).
+		WithParams(errors.Params{"reason": reason})
+}
+
+// ErrAPIKeyUnauthorized 未携带或携带了无效的 API Key
+func ErrAPIKeyUnauthorized(reason string) *errors.AppError {
+	return errors.New(APIKeyUnauthorized, "认证失败: "+reason).
+		WithParams(errors.Params{"reason": reason})
+}
+
+// ErrAPIKeyForbidden API Key 无权访问该接口或配置（如只读令牌超出绑定的读取范围）
+func ErrAPIKeyForbidden(reason string) *errors.AppError {
+	return errors.New(APIKeyForbidden, "无权访问: "+reason).
+		WithParams(errors.Params{"reason": reason})
+}
+
+// ErrAPIKeyNotFound API Key 不存在
+func ErrAPIKeyNotFound(id int) *errors.AppError {
+	return errors.New(APIKeyNotFound, "API Key 不存在: id="+strconv.Itoa(id)).
+		WithParams(errors.Params{"id": strconv.Itoa(id)})
+}
+
+// ErrAPIKeyConflict API Key 名称已存在
+func ErrAPIKeyConflict(name string) *errors.AppError {
+	return errors.New(APIKeyConflict, "API Key 名称已存在: "+name).
+		WithParams(errors.Params{"name": name})
+}
+
+// ==================== 租户领域业务异常 ====================
+
+// ErrTenantInvalid 租户参数无效
+func ErrTenantInvalid(reason string) *errors.AppError {
+	return errors.New(TenantInvalid, "租户参数无效: "+reason).
+		WithParams(errors.Params{"reason": reason})
+}
+
+// ErrTenantForbidden 无权访问租户或租户已停用
+func ErrTenantForbidden(reason string) *errors.AppError {
+	return errors.New(TenantForbidden, "无权访问租户: "+reason).
+		WithParams(errors.Params{"reason": reason})
+}
+
+// ErrTenantNotFound 租户不存在
+func ErrTenantNotFound(id int) *errors.AppError {
+	return errors.New(TenantNotFound, "租户不存在: id="+strconv.Itoa(id)).
+		WithParams(errors.Params{"id": strconv.Itoa(id)})
+}
+
+// ErrTenantConflict 租户编码已存在
+func ErrTenantConflict(code string) *errors.AppError {
+	return errors.New(TenantConflict, "租户编码已存在: "+code).
+		WithParams(errors.Params{"code": code})
+}
+
+// ==================== 命名空间配额领域业务异常 ====================
+
+// ErrNamespaceQuotaInvalid 命名空间配额参数无效
+func ErrNamespaceQuotaInvalid(reason string) *errors.AppError {
+	return errors.New(NamespaceQuotaInvalid, "命名空间配额参数无效: "+reason).
+		WithParams(errors.Params{"reason": reason})
+}
+
+// ErrNamespaceQuotaExceeded 超出命名空间配额（resource 为配额项名称，如 max_configs）
+func ErrNamespaceQuotaExceeded(namespaceID int, resource string, used int64, limit int) *errors.AppError {
+	return errors.New(NamespaceQuotaExceeded, "超出命名空间配额: namespace="+strconv.Itoa(namespaceID)+
+		", quota="+resource+", used="+strconv.FormatInt(used, 10)+", limit="+strconv.Itoa(limit)).
+		WithParams(errors.Params{"namespace_id": strconv.Itoa(namespaceID), "quota": resource, "used": strconv.FormatInt(used, 10), "limit": strconv.Itoa(limit)})
+}
+
+// ErrConfigValueTooLarge 配置值超过命名空间大小上限
+func ErrConfigValueTooLarge(key string, size int, limit int) *errors.AppError {
+	return errors.New(ConfigValueTooLarge, "配置值超过命名空间大小上限: key="+key+
+		", size="+strconv.Itoa(size)+" bytes, max_value_size="+strconv.Itoa(limit)+" bytes").
+		WithParams(errors.Params{"key": key, "size": strconv.Itoa(size), "limit": strconv.Itoa(limit)})
+}
+
+// ==================== 系统配置领域业务异常 ====================
+
+// ErrSystemConfigInvalid 系统配置参数无效
+func ErrSystemConfigInvalid(reason string) *errors.AppError {
+	return errors.New(SystemConfigInvalid, "系统配置参数无效: "+reason).
+		WithParams(errors.Params{"reason": reason})
+}
+
+// ErrSystemConfigNotFound 系统配置不存在
+func ErrSystemConfigNotFound(key string) *errors.AppError {
+	return errors.New(SystemConfigNotFound, "系统配置不存在: "+key).
+		WithParams(errors.Params{"key": key})
+}
+
+// ==================== 分页业务异常 ====================
+
+// ErrPageCursorInvalid 分页游标无效
+func ErrPageCursorInvalid() *errors.AppError {
+	return errors.New(PageCursorInvalid, "分页游标无效，请使用上一页返回的 next_cursor")
 }
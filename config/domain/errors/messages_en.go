@@ -0,0 +1,161 @@
+package errors
+
+import "config-client/share/errors"
+
+// 配置领域错误消息的英文翻译
+// 按错误码（及消息变体）登记消息模板，{name} 占位符对应错误构造函数设置的消息参数
+// 同一错误码对应多种消息时，每种消息都使用消息变体登记，错误码保持不变
+func init() {
+	errors.RegisterCatalog(errors.LanguageEN, messagesEN)
+}
+
+// messagesEN 英文消息目录
+var messagesEN = errors.Catalog{
+	// 长轮询领域
+	{Code: LongPollingServiceNotStarted, Variant: "long_polling_not_started"}: "long polling service is not started",
+	{Code: LongPollingServiceStopped}:                                         "long polling service is stopped",
+	{Code: LongPollingSubscribeFailed}:                                        "failed to subscribe to config change events",
+	{Code: LongPollingInvalidConfigKey}:                                       "invalid long polling config key: {key}, expected format is namespaceID:configKey",
+	{Code: LongPollingGetVersionFailed}:                                       "failed to get config version: {key}",
+	{Code: LongPollingTooManyWaiters}:                                         "too many long polling connections, please retry after {seconds} seconds",
+
+	// 配置领域
+	{Code: ConfigNotFound}:                                     "config not found: key={key}, env={env}",
+	{Code: ConfigAlreadyExists}:                                "config already exists: key={key}, env={env}",
+	{Code: ConfigNotReleased}:                                  "config is not released and cannot be used: key={key}",
+	{Code: ConfigAlreadyReleased}:                              "config is released and cannot be modified: key={key}",
+	{Code: ConfigNotActive}:                                    "config is not active: key={key}",
+	{Code: ConfigKeyInvalid}:                                   "invalid config key: key={key}, reason: {reason}",
+	{Code: ConfigKeyInvalid, Variant: "empty"}:                 "config key must not be empty",
+	{Code: ConfigKeyInvalid, Variant: "format"}:                "invalid config key format: key={key}, only letters, digits, underscores, hyphens and dots are allowed",
+	{Code: ConfigValueInvalid}:                                 "invalid config value: key={key}, reason: {reason}",
+	{Code: ConfigValueInvalid, Variant: "empty"}:               "config value must not be empty: key={key}",
+	{Code: ConfigValueTypeInvalid}:                             "config value type validation failed: type={type}, reason: {reason}",
+	{Code: ConfigValueInvalid, Variant: "hash_algorithm"}:      "unsupported hash algorithm: {algorithm}",
+	{Code: ConfigHashMismatch, Variant: "hash_mismatch"}:       "config hash mismatch: key={key}",
+	{Code: ConfigVersionConflict, Variant: "version_conflict"}: "config version conflict: key={key}, expected={expected}, actual={actual}; the config has been modified by someone else, please refresh and retry",
+	{Code: ConfigGroupNotFound, Variant: "group"}:              "config group not found: group={group}",
+	{Code: ConfigGroupNotFound, Variant: "group_id"}:           "config group not found: id={id}",
+	{Code: ConfigEnvironmentInvalid}:                           "invalid environment: env={env}",
+	{Code: ConfigCannotDelete}:                                 "config is released and cannot be deleted, unrelease it first: key={key}",
+
+	// 命名空间领域
+	{Code: NamespaceNotFound}:                                      "namespace not found",
+	{Code: NamespaceNotFound, Variant: "name"}:                     "namespace not found: name={name}",
+	{Code: NamespaceAlreadyExists}:                                 "namespace already exists: name={name}",
+	{Code: NamespaceNotActive}:                                     "namespace is not active: name={name}",
+	{Code: NamespaceNameInvalid}:                                   "invalid namespace name: name={name}, reason: {reason}",
+	{Code: NamespaceNameInvalid, Variant: "empty"}:                 "namespace name must not be empty",
+	{Code: NamespaceNameInvalid, Variant: "length"}:                "namespace name length must be between 2 and 255: name={name}",
+	{Code: NamespaceNameInvalid, Variant: "format"}:                "namespace name may only contain lowercase letters, digits, underscores and hyphens: name={name}",
+	{Code: NamespaceNameInvalid, Variant: "display_name_too_long"}: "display name must not exceed 255 characters",
+	{Code: NamespaceNameInvalid, Variant: "display_name_empty"}:    "display name must not be empty",
+	{Code: NamespaceCannotDelete}:                                  "namespace cannot be deleted because it still has configs",
+	{Code: NamespaceMustDeactivate}:                                "namespace must be deactivated before deletion: name={name}",
+
+	// 订阅领域
+	{Code: SubscriptionNotFound}:                                            "subscription not found: clientID={client_id}",
+	{Code: SubscriptionAlreadyExists, Variant: "subscription_exists"}:       "subscription already exists: clientID={client_id}",
+	{Code: SubscriptionInactive}:                                            "subscription is not active: clientID={client_id}",
+	{Code: SubscriptionExpired}:                                             "subscription has expired: clientID={client_id}",
+	{Code: SubscriptionCreateFailed}:                                        "failed to create subscription",
+	{Code: SubscriptionUpdateFailed, Variant: "subscription_update_failed"}: "failed to update subscription",
+	{Code: SubscriptionHeartbeatFailed}:                                     "failed to update heartbeat",
+
+	// 导入导出领域
+	{Code: ConfigFormatUnsupported}:     "unsupported config format: format={format}, supported: yaml/json/properties/env",
+	{Code: ConfigContentParseFailed}:    "failed to parse config content: format={format}",
+	{Code: ConfigImportStrategyInvalid}: "invalid import conflict strategy: strategy={strategy}, supported: skip/overwrite/fail",
+	{Code: ConfigImportConflict}:        "import has conflicting configs: keys={keys}",
+
+	// 环境晋升领域
+	{Code: PromotionEnvironmentInvalid}: "source and target environments must differ: env={env}",
+	{Code: PromotionDiffChanged}:        "config diff has changed since the preview, please preview and confirm again",
+
+	// 配置引用领域
+	{Code: ConfigReferenceCycle}:    "config reference cycle detected: {chain}",
+	{Code: ConfigReferenceNotFound}: "referenced config does not exist or is not released: ${{reference}}, env={env}",
+	{Code: ConfigReferenceTooDeep}:  "config reference depth exceeds the limit: max={max}",
+
+	// 配置Schema领域
+	{Code: ConfigSchemaInvalid}:                     "invalid JSON Schema: {error}",
+	{Code: ConfigSchemaInvalid, Variant: "binding"}: "a schema must be bound to exactly one config key or config group",
+	{Code: ConfigSchemaNotFound}:                    "schema binding not found: id={id}",
+	{Code: ConfigSchemaAlreadyExists}:               "schema binding already exists: {target}",
+	{Code: ConfigSchemaViolation}:                   "config value does not match the schema: key={key}; {violations}",
+
+	// 配置值校验器领域
+	{Code: ConfigValidatorInvalid}:   "invalid validation rule: {validator}, {reason}",
+	{Code: ConfigValueRuleViolation}: "config value violates the validation rule: {validator}, {reason}",
+
+	// 配置批量变更领域
+	{Code: ConfigBatchInvalid}: "invalid batch change request: {reason}",
+
+	// 配置搜索领域
+	{Code: ConfigSearchInvalid}: "invalid search parameters: {reason}",
+
+	// 配置标签领域
+	{Code: ConfigTagInvalid}: "invalid tag parameters: {reason}",
+
+	// 配置分组领域
+	{Code: ConfigGroupNameInvalid}:   "invalid group name: name={name}, {reason}",
+	{Code: ConfigGroupNotEmpty}:      "config group still has {count} configs and cannot be deleted: group={group}",
+	{Code: ConfigGroupAlreadyExists}: "config group already exists: group={group}",
+
+	// 配置依赖领域
+	{Code: ConfigDependencyInvalid}: "invalid dependency declaration: {reason}",
+	{Code: ConfigDependencyCycle}:   "dependency cycle detected: {chain}",
+
+	// 配置过期领域
+	{Code: ConfigExpiresAtInvalid}: "invalid expiration time: {reason}",
+	{Code: ConfigExpired}:          "config has expired: key={key}",
+
+	// 文件类型配置领域
+	{Code: ConfigFileInvalid}:  "invalid file config: {reason}",
+	{Code: ConfigFileNotFound}: "file content not found: key={key}",
+	{Code: ConfigFileTooLarge}: "file exceeds the size limit: max={max} bytes",
+
+	// 发布审批领域
+	{Code: ReleaseApprovalInvalid}:  "invalid approval request: {reason}",
+	{Code: ReleaseNotApproved}:      "the release must be approved before publishing to protected environment {env}: releaseID={release_id}",
+	{Code: ReleaseApproverConflict}: "the approver must not be the creator of the release: {operator}",
+
+	// 发布冻结窗口领域
+	{Code: ReleaseFreezeOverrideInvalid}: "invalid freeze override request: {reason}",
+	{Code: ReleaseFrozen}:                "release freeze window {window} is in effect (until {ends_at}); to proceed, provide freeze_override with a reason",
+
+	// Webhook 领域
+	{Code: WebhookInvalid}:          "invalid webhook parameters: {reason}",
+	{Code: WebhookNotFound}:         "webhook not found: id={id}",
+	{Code: WebhookDeliveryNotFound}: "webhook delivery not found: id={id}",
+
+	// 通知渠道领域
+	{Code: NotificationChannelInvalid}:  "invalid notification channel parameters: {reason}",
+	{Code: NotificationChannelNotFound}: "notification channel not found: id={id}",
+	{Code: NotificationSendFailed}:      "failed to send notification: {reason}",
+
+	// API Key 领域
+	{Code: APIKeyInvalid}:      "invalid API key parameters: {reason}",
+	{Code: APIKeyUnauthorized}: "authentication failed: {reason}",
+	{Code: APIKeyForbidden}:    "access denied: {reason}",
+	{Code: APIKeyNotFound}:     "API key not found: id={id}",
+	{Code: APIKeyConflict}:     "API key name already exists: {name}",
+
+	// 租户领域
+	{Code: TenantInvalid}:   "invalid tenant parameters: {reason}",
+	{Code: TenantForbidden}: "access to the tenant is denied: {reason}",
+	{Code: TenantNotFound}:  "tenant not found: id={id}",
+	{Code: TenantConflict}:  "tenant code already exists: {code}",
+
+	// 命名空间配额领域
+	{Code: NamespaceQuotaInvalid}:  "invalid namespace quota: {reason}",
+	{Code: NamespaceQuotaExceeded}: "namespace quota exceeded: namespace={namespace_id}, quota={quota}, used={used}, limit={limit}",
+	{Code: ConfigValueTooLarge}:    "config value exceeds the namespace size limit: key={key}, size={size} bytes, max_value_size={limit} bytes",
+
+	// 分页
+	{Code: PageCursorInvalid}: "invalid page cursor, please use the next_cursor returned by the previous page",
+
+	// 系统配置领域
+	{Code: SystemConfigInvalid}:  "invalid system config parameters: {reason}",
+	{Code: SystemConfigNotFound}: "system config not found: {key}",
+}
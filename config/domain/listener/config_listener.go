@@ -7,7 +7,17 @@ type ConfigChangeEvent struct {
 	NamespaceID int    `json:"namespace_id"` // 命名空间ID
 	ConfigKey   string `json:"config_key"`   // 配置键
 	ConfigID    int    `json:"config_id"`    // 配置ID
+	Environment string `json:"environment"`  // 配置所属环境（为空表示未知，由订阅方按各自环境比较版本）
 	Action      string `json:"action"`       // 操作类型: create, update, delete
+
+	// Sequence 命名空间内的事件序号（启用事件日志时由发布方分配，单调递增；为0表示未记录）
+	Sequence int64 `json:"sequence,omitempty"`
+
+	// ReleaseID 触发变更的发布版本ID（发布和回滚时设置，用于按发布查询通知下发记录）
+	ReleaseID int `json:"release_id,omitempty"`
+
+	// TraceContext 发布方的链路上下文（W3C traceparent 等），用于将通知下发关联到触发变更的请求
+	TraceContext map[string]string `json:"trace_context,omitempty"`
 }
 
 // ConfigListener 配置变更监听器接口
@@ -22,6 +32,18 @@ type ConfigListener interface {
 	// event: 配置变更事件
 	Publish(ctx context.Context, event *ConfigChangeEvent) error
 
+	// PublishBatch 批量发布配置变更事件（用于批量变更，减少网络往返）
+	// ctx: 上下文
+	// events: 配置变更事件列表
+	PublishBatch(ctx context.Context, events []*ConfigChangeEvent) error
+
 	// Close 关闭监听器
 	Close() error
 }
+
+// HealthChecker 监听器健康检查接口（可选）
+// 监听器实现该接口时，就绪检查会通过它探测订阅连接是否可用
+type HealthChecker interface {
+	// CheckHealth 检查订阅连接，不可用时返回错误
+	CheckHealth(ctx context.Context) error
+}
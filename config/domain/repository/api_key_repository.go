@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"config-client/config/domain/entity"
+)
+
+// APIKeyRepository API Key 仓储接口
+type APIKeyRepository interface {
+	// Create 创建 API Key
+	Create(ctx context.Context, key *entity.APIKey) error
+
+	// Revoke 吊销 API Key
+	Revoke(ctx context.Context, id int, revokedBy string, revokedAt time.Time) error
+
+	// TouchLastUsed 更新最近使用时间
+	TouchLastUsed(ctx context.Context, id int, usedAt time.Time) error
+
+	// GetByID 根据ID查询 API Key（不存在时返回 nil）
+	GetByID(ctx context.Context, id int) (*entity.APIKey, error)
+
+	// GetByName 根据名称查询 API Key（不存在时返回 nil）
+	GetByName(ctx context.Context, name string) (*entity.APIKey, error)
+
+	// GetByHash 根据密钥摘要查询 API Key（不存在时返回 nil）
+	GetByHash(ctx context.Context, keyHash string) (*entity.APIKey, error)
+
+	// FindAll 查询全部 API Key（按ID升序，includeRevoked 为 false 时不返回已吊销的）
+	FindAll(ctx context.Context, includeRevoked bool) ([]*entity.APIKey, error)
+
+	// CountUsableByRole 统计指定角色未吊销且未过期的 API Key 数量
+	CountUsableByRole(ctx context.Context, role string, now time.Time) (int64, error)
+}
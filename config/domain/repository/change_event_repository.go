@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"config-client/config/domain/entity"
+)
+
+// ChangeEventRepository 配置变更事件日志仓储接口
+// 事件日志只追加、按保留时长整体清理，不提供更新和删除单条记录的操作
+type ChangeEventRepository interface {
+	// Append 追加事件并分配命名空间内的序号（回填 event.Sequence）
+	// 同一命名空间的序号分配串行执行，保证序号与提交顺序一致
+	Append(ctx context.Context, event *entity.ChangeEvent) error
+
+	// FindAfterSequence 查询指定命名空间中序号大于 afterSequence 的事件（按序号升序）
+	FindAfterSequence(ctx context.Context, namespaceID int, afterSequence int64, limit int) ([]*entity.ChangeEvent, error)
+
+	// GetLatestSequence 获取命名空间的最新事件序号（无事件时返回0）
+	GetLatestSequence(ctx context.Context, namespaceID int) (int64, error)
+
+	// GetOldestSequence 获取命名空间中仍保留的最早事件序号（无事件时返回0）
+	GetOldestSequence(ctx context.Context, namespaceID int) (int64, error)
+
+	// DeleteBefore 删除指定时间之前的事件，返回删除数量
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
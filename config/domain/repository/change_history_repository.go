@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"config-client/config/domain/entity"
 	"config-client/share/repository"
@@ -18,6 +19,10 @@ type ChangeHistoryQueryParams struct {
 	Operator    *string // 操作人
 	Page        int     // 页码（从1开始）
 	Size        int     // 每页数量
+	OrderBy     string  // 排序表达式，例如：created_at desc（为空时按变更时间倒序）
+
+	// Cursor 游标分页位置，非 nil 时按 created_at DESC, id DESC 游标分页（忽略 Page，不统计总数）
+	Cursor *repository.Cursor
 }
 
 // ChangeHistoryRepository 变更历史仓储接口
@@ -33,6 +38,9 @@ type ChangeHistoryRepository interface {
 	// BatchSave 批量保存变更记录
 	BatchSave(ctx context.Context, histories []*entity.ChangeHistory) error
 
+	// DeleteByIDs 根据ID批量删除变更记录，返回删除数量
+	DeleteByIDs(ctx context.Context, ids []int) (int64, error)
+
 	// ==================== 读操作 ====================
 
 	// FindByConfigID 查询指定配置的所有变更历���（按时间倒序）
@@ -53,6 +61,15 @@ type ChangeHistoryRepository interface {
 	// QueryByParams 根据查询参数分页查询变更历史
 	QueryByParams(ctx context.Context, params *ChangeHistoryQueryParams) (*repository.PageResult[*entity.ChangeHistory], error)
 
+	// FindByParamsAfterID 按查询条件查询ID大于 afterID 的变更记录（按ID升序，忽略分页参数，用于游标式遍历导出）
+	FindByParamsAfterID(ctx context.Context, params *ChangeHistoryQueryParams, afterID int, limit int) ([]*entity.ChangeHistory, error)
+
+	// FindCreatedBefore 查询指定时间之前的变更记录（按ID升序，用于保留策略清理）
+	FindCreatedBefore(ctx context.Context, before time.Time, limit int) ([]*entity.ChangeHistory, error)
+
+	// FindBeyondLatestPerConfig 查询每个配置最新 keep 条之外的变更记录（按ID升序，用于保留策略清理）
+	FindBeyondLatestPerConfig(ctx context.Context, keep int, limit int) ([]*entity.ChangeHistory, error)
+
 	// ==================== 统计操作 ====================
 
 	// CountByConfigID 统计指定配置的变更次数
@@ -64,3 +81,10 @@ type ChangeHistoryRepository interface {
 	// CountByTimeRange 统计时间范围内的变更次数
 	CountByTimeRange(ctx context.Context, startTime, endTime string) (int64, error)
 }
+
+// ChangeHistoryArchiver 变更历史归档器
+// 保留策略清理变更记录前，先将其写入冷存储（归档表或归档文件）
+type ChangeHistoryArchiver interface {
+	// Archive 归档变更记录（上下文中存在事务时，归档表写入与删除在同一事务内完成）
+	Archive(ctx context.Context, histories []*entity.ChangeHistory) error
+}
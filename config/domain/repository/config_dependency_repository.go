@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"config-client/config/domain/entity"
+)
+
+// ConfigDependencyRepository 配置依赖仓储接口
+// 负责配置显式依赖关系（depends_on）的持久化操作
+type ConfigDependencyRepository interface {
+	// BatchCreate 批量创建依赖关系
+	BatchCreate(ctx context.Context, dependencies []*entity.ConfigDependency) error
+
+	// DeleteByConfigID 删除配置声明的所有依赖关系
+	DeleteByConfigID(ctx context.Context, configID int) error
+
+	// FindByConfigIDs 查询配置声明的依赖关系（上游）
+	FindByConfigIDs(ctx context.Context, configIDs []int) ([]*entity.ConfigDependency, error)
+
+	// FindByDependsOnIDs 查询依赖指定配置的依赖关系（下游）
+	FindByDependsOnIDs(ctx context.Context, dependsOnIDs []int) ([]*entity.ConfigDependency, error)
+}
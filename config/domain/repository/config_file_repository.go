@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"config-client/config/domain/entity"
+)
+
+// ConfigFileRepository 文件类型配置内容仓储接口
+type ConfigFileRepository interface {
+	// Save 保存文件内容，同一配置的相同内容只存储一份（已存在时更新文件名和内容类型）
+	Save(ctx context.Context, file *entity.ConfigFile) error
+
+	// FindByHash 根据配置ID和内容哈希查询文件，withContent 为 false 时不加载内容
+	FindByHash(ctx context.Context, configID int, sha256 string, withContent bool) (*entity.ConfigFile, error)
+}
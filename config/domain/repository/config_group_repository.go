@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"config-client/config/domain/entity"
+)
+
+// ConfigGroupRepository 配置分组仓储接口
+// 负责配置分组的持久化操作
+type ConfigGroupRepository interface {
+	// Create 创建配置分组
+	Create(ctx context.Context, group *entity.ConfigGroup) error
+
+	// Update 更新配置分组
+	Update(ctx context.Context, group *entity.ConfigGroup) error
+
+	// Delete 删除配置分组（软删除）
+	Delete(ctx context.Context, id int) error
+
+	// GetByID 根据ID查询配置分组，不存在时返回 nil
+	GetByID(ctx context.Context, id int) (*entity.ConfigGroup, error)
+
+	// FindByName 根据命名空间和分组名称查询配置分组，不存在时返回 nil
+	FindByName(ctx context.Context, namespaceID int, name string) (*entity.ConfigGroup, error)
+
+	// FindByNamespace 查询命名空间下的所有配置分组（按展示顺序、名称升序）
+	FindByNamespace(ctx context.Context, namespaceID int) ([]*entity.ConfigGroup, error)
+}
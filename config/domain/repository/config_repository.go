@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"config-client/config/domain/entity"
 	"config-client/share/repository"
@@ -17,9 +18,54 @@ type ConfigQueryParams struct {
 	IsActive    *bool
 	IsReleased  *bool
 	ValueType   *string
-	Page        int
-	Size        int
-	OrderBy     string
+	IDs         []int // 限定配置ID范围（nil 表示不限制）
+
+	// ExpiresBefore 只查询设置了过期时间且不晚于该时间的配置（包括已过期但尚未处理的配置）
+	// 未指定排序时按过期时间升序
+	ExpiresBefore *time.Time
+	Page          int
+	Size          int
+	OrderBy       string
+
+	// Cursor 游标分页位置，非 nil 时按 created_at DESC, id DESC 游标分页（忽略 Page 和 OrderBy，不统计总数）
+	Cursor *repository.Cursor
+}
+
+// ConfigGroupStat 配置分组统计
+type ConfigGroupStat struct {
+	GroupName     string     // 分组名称
+	Total         int64      // 配置总数
+	Released      int64      // 已发布配置数
+	Active        int64      // 已激活配置数
+	LastUpdatedAt *time.Time // 分组内配置的最近更新时间
+}
+
+// NamespaceKeyEnv 配置定位（命名空间ID、配置键、环境）
+type NamespaceKeyEnv struct {
+	NamespaceID int    // 命名空间ID
+	Key         string // 配置键
+	Environment string // 环境
+}
+
+// 配置搜索字段
+const (
+	ConfigSearchFieldKey         = "key"         // 配置键
+	ConfigSearchFieldDescription = "description" // 配置描述
+	ConfigSearchFieldGroup       = "group"       // 配置分组
+	ConfigSearchFieldValue       = "value"       // 配置值
+)
+
+// ConfigSearchParams 配置搜索参数
+type ConfigSearchParams struct {
+	Query       string   // 搜索关键字（不区分大小写的子串匹配）
+	Fields      []string // 搜索字段（为空时搜索全部字段）
+	NamespaceID *int     // 命名空间ID
+	Environment *string  // 环境
+	// SensitiveKeywords 键中包含这些关键字（不区分大小写）的配置不按值匹配，
+	// 避免通过搜索试探敏感配置的明文；加密配置始终不按值匹配
+	SensitiveKeywords []string
+	Page              int
+	Size              int
 }
 
 // ConfigRepository 配置仓储接口，定义配置管理的数据访问方法
@@ -33,6 +79,18 @@ type ConfigRepository interface {
 	// 参数：namespaceID - 命名空间ID，key - 配置键，environment - 环境
 	FindByNamespaceAndKey(ctx context.Context, namespaceID int, key string, environment string) (*entity.Config, error)
 
+	// FindByNamespaceAndKeys 根据命名空间ID和一组配置键批量查询配置（一次 IN 查询，按配置键升序）
+	// 不存在的配置键不在结果中
+	FindByNamespaceAndKeys(ctx context.Context, namespaceID int, keys []string, environment string) ([]*entity.Config, error)
+
+	// FindVersionsByKeys 批量查询配置版本（配置值的 MD5），一次 IN 查询返回全部结果
+	// 返回：配置定位 -> 版本，不存在的配置不在结果中
+	FindVersionsByKeys(ctx context.Context, keys []NamespaceKeyEnv) (map[NamespaceKeyEnv]string, error)
+
+	// FindVersionsByPrefix 查询命名空间和环境下配置键以 prefix 开头的配置版本（配置值的 MD5）
+	// 返回：配置键 -> 版本
+	FindVersionsByPrefix(ctx context.Context, namespaceID int, prefix string, environment string) (map[string]string, error)
+
 	// FindByNamespace 根据命名空间ID查询该命名空间下的所有配置
 	FindByNamespace(ctx context.Context, namespaceID int) ([]*entity.Config, error)
 
@@ -42,6 +100,13 @@ type ConfigRepository interface {
 	// FindByGroup 根据分组查询配置列表
 	FindByGroup(ctx context.Context, namespaceID int, groupName string) ([]*entity.Config, error)
 
+	// FindByValueContains 查询值中包含指定文本的配置（区分大小写的子串匹配）
+	FindByValueContains(ctx context.Context, text string) ([]*entity.Config, error)
+
+	// FindExpired 查询过期时间不晚于 before 的配置（按过期时间升序，最多 limit 条）
+	// onlyActive 为 true 时只返回仍处于激活状态的配置
+	FindExpired(ctx context.Context, before time.Time, onlyActive bool, limit int) ([]*entity.Config, error)
+
 	// FindReleasedConfigs 查询已发布的配置列表
 	FindReleasedConfigs(ctx context.Context, namespaceID int, environment string) ([]*entity.Config, error)
 
@@ -52,9 +117,39 @@ type ConfigRepository interface {
 	// 封装了查询条件的构建逻辑，由仓储层实现字段映射
 	QueryByParams(ctx context.Context, params *ConfigQueryParams) (*repository.PageResult[*entity.Config], error)
 
+	// QueryDeletedByParams 分页查询已删除的配置（回收站），默认按删除时间倒序
+	QueryDeletedByParams(ctx context.Context, params *ConfigQueryParams) (*repository.PageResult[*entity.Config], error)
+
+	// GetDeletedByID 根据ID查询已删除的配置，不存在或未删除时返回 nil
+	GetDeletedByID(ctx context.Context, id int) (*entity.Config, error)
+
+	// Restore 恢复已删除的配置（清除删除标记并保存版本号、更新人）
+	Restore(ctx context.Context, config *entity.Config) error
+
 	// ExistsByNamespaceAndKey 判断指定命名空间和键的配置是否存在
 	ExistsByNamespaceAndKey(ctx context.Context, namespaceID int, key string, environment string) (bool, error)
 
+	// UpdateWithVersion 按版本号条件更新配置（乐观锁）
+	// 仅当存储中的版本号等于 expectedVersion 时更新，返回是否更新成功
+	UpdateWithVersion(ctx context.Context, config *entity.Config, expectedVersion int) (bool, error)
+
+	// UpdateContentHash 更新配置的内容哈希及算法（不修改版本号）
+	// 仅当存储中的哈希值等于 expectedHash 时更新（期间配置值被修改则不更新），返回是否更新成功
+	UpdateContentHash(ctx context.Context, id int, expectedHash string, hash string, algorithm string) (bool, error)
+
+	// Search 按关键字搜索配置（匹配键、描述、分组和值），按匹配度排序分页返回
+	Search(ctx context.Context, params *ConfigSearchParams) (*repository.PageResult[*entity.Config], error)
+
 	// CountByNamespace 统计指定命名空间的配置数量
 	CountByNamespace(ctx context.Context, namespaceID int) (int64, error)
+
+	// MaxValueSizeByNamespace 查询命名空间下最大的配置值大小（字节，按存储值计算，没有配置时返回 0）
+	MaxValueSizeByNamespace(ctx context.Context, namespaceID int) (int64, error)
+
+	// CountByGroup 按分组统计命名空间下的配置数量（environment 为空时统计所有环境）
+	CountByGroup(ctx context.Context, namespaceID int, environment string) ([]*ConfigGroupStat, error)
+
+	// WithTx 在事务中执行操作
+	// fn 中使用传入 ctx 的仓储操作（配置、标签、变更历史）共享同一事务，fn 返回错误时整体回滚
+	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
 }
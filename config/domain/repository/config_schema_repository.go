@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"config-client/config/domain/entity"
+)
+
+// ConfigSchemaRepository 配置 Schema 仓储接口
+// 负责配置 Schema 绑定的持久化操作
+type ConfigSchemaRepository interface {
+	// Create 创建 Schema 绑定
+	Create(ctx context.Context, schema *entity.ConfigSchema) error
+
+	// Update 更新 Schema 绑定
+	Update(ctx context.Context, schema *entity.ConfigSchema) error
+
+	// Delete 删除 Schema 绑定（软删除）
+	Delete(ctx context.Context, id int) error
+
+	// GetByID 根据ID查询 Schema 绑定
+	GetByID(ctx context.Context, id int) (*entity.ConfigSchema, error)
+
+	// FindByNamespace 查询命名空间下的所有 Schema 绑定
+	FindByNamespace(ctx context.Context, namespaceID int) ([]*entity.ConfigSchema, error)
+
+	// FindByKey 查询绑定到指定配置键的 Schema
+	FindByKey(ctx context.Context, namespaceID int, configKey string) (*entity.ConfigSchema, error)
+
+	// FindByGroup 查询绑定到指定配置分组的 Schema
+	FindByGroup(ctx context.Context, namespaceID int, groupName string) (*entity.ConfigSchema, error)
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"config-client/config/domain/entity"
+)
+
+// NotificationChannelRepository 通知渠道仓储接口
+type NotificationChannelRepository interface {
+	// Create 创建通知渠道
+	Create(ctx context.Context, channel *entity.NotificationChannel) error
+
+	// Update 更新通知渠道
+	Update(ctx context.Context, channel *entity.NotificationChannel) error
+
+	// Delete 删除通知渠道
+	Delete(ctx context.Context, id int) error
+
+	// GetByID 根据ID查询通知渠道（不存在时返回 nil）
+	GetByID(ctx context.Context, id int) (*entity.NotificationChannel, error)
+
+	// FindByNamespace 查询命名空间下的通知渠道（按ID升序，enabledOnly 为 true 时仅返回已启用的）
+	FindByNamespace(ctx context.Context, namespaceID int, enabledOnly bool) ([]*entity.NotificationChannel, error)
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"config-client/config/domain/entity"
+)
+
+// OutboxRepository 事务性发件箱仓储接口
+type OutboxRepository interface {
+	// Save 保存待投递事件（上下文中存在事务时在同一事务内写入）
+	Save(ctx context.Context, events []*entity.OutboxEvent) error
+
+	// ClaimPending 领取一批可投递的事件（按ID升序）并设置租约
+	// 包括到达下次投递时间的待投递事件，以及租约已过期的投递中事件（领取实例异常退出）
+	// 多实例并发领取时互不重复
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*entity.OutboxEvent, error)
+
+	// MarkSent 标记事件已投递
+	MarkSent(ctx context.Context, ids []int64) error
+
+	// MarkFailed 标记事件投递失败：累加失败次数、记录原因，并推迟到 nextAttemptAt 后重新投递
+	MarkFailed(ctx context.Context, ids []int64, lastError string, nextAttemptAt time.Time) error
+
+	// DeleteSentBefore 删除指定时间之前已投递的事件，返回删除数量
+	DeleteSentBefore(ctx context.Context, before time.Time) (int64, error)
+
+	// CountPending 统计未投递的事件数量（用于监控投递积压）
+	CountPending(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"config-client/config/domain/entity"
+	"config-client/share/repository"
+)
+
+// PushTraceQueryParams 通知下发记录查询参数
+type PushTraceQueryParams struct {
+	ReleaseID   *int
+	ClientID    *string
+	NamespaceID *int
+	ConfigKey   *string
+	Environment *string
+	Acked       *bool
+	Page        int
+	Size        int
+}
+
+// PushTraceRepository 通知下发记录仓储接口
+type PushTraceRepository interface {
+	// BatchCreate 批量保存下发记录
+	BatchCreate(ctx context.Context, traces []*entity.PushTrace) error
+
+	// FindUnackedBySubscriptionID 查询订阅下尚未确认的下发记录
+	FindUnackedBySubscriptionID(ctx context.Context, subscriptionID int) ([]*entity.PushTrace, error)
+
+	// MarkAcked 将指定下发记录标记为已确认
+	MarkAcked(ctx context.Context, ids []int64, ackedAt time.Time) error
+
+	// Query 根据查询参数分页查询下发记录（按下发时间倒序）
+	Query(ctx context.Context, params *PushTraceQueryParams) (*repository.PageResult[*entity.PushTrace], error)
+
+	// Summarize 统计满足查询条件的记录总数和已确认数量（忽略 Acked 和分页参数）
+	Summarize(ctx context.Context, params *PushTraceQueryParams) (total int64, acked int64, err error)
+
+	// DeleteBefore 删除指定时间之前下发的记录，返回删除数量
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
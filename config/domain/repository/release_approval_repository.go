@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"config-client/config/domain/entity"
+)
+
+// ReleaseApprovalRepository 发布审批记录仓储接口
+type ReleaseApprovalRepository interface {
+	// Create 创建审批记录
+	Create(ctx context.Context, approval *entity.ReleaseApproval) error
+
+	// FindByReleaseID 查询发布版本的全部审批记录（按审批时间升序）
+	FindByReleaseID(ctx context.Context, releaseID int) ([]*entity.ReleaseApproval, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"config-client/config/domain/entity"
+)
+
+// ReleaseFreezeOverrideRepository 冻结窗口覆盖审计记录仓储接口
+type ReleaseFreezeOverrideRepository interface {
+	// Create 创建覆盖记录
+	Create(ctx context.Context, override *entity.ReleaseFreezeOverride) error
+
+	// FindRecent 查询命名空间和环境最近的覆盖记录（按时间倒序，environment 为空时不限环境）
+	FindRecent(ctx context.Context, namespaceID int, environment string, limit int) ([]*entity.ReleaseFreezeOverride, error)
+}
@@ -18,6 +18,15 @@ type ReleaseQueryParams struct {
 	Page        int
 	Size        int
 	OrderBy     string
+
+	// Cursor 游标分页位置，非 nil 时按 created_at DESC, id DESC 游标分页（忽略 Page 和 OrderBy，不统计总数）
+	Cursor *repository.Cursor
+}
+
+// ReleaseEnvironmentStat 发布版本环境统计
+type ReleaseEnvironmentStat struct {
+	Environment string // 环境
+	Total       int64  // 发布版本数量
 }
 
 // ReleaseRepository 发布版本仓储接口
@@ -52,6 +61,9 @@ type ReleaseRepository interface {
 	// CountByNamespace 统计指定命名空间的发布版本数量
 	CountByNamespace(ctx context.Context, namespaceID int, environment string) (int64, error)
 
+	// CountByEnvironment 按环境统计命名空间的发布版本数量（按环境名升序）
+	CountByEnvironment(ctx context.Context, namespaceID int) ([]*ReleaseEnvironmentStat, error)
+
 	// FindReleasesInTimeRange 查询指定时间范围内的发布版本
 	FindReleasesInTimeRange(ctx context.Context, namespaceID int, environment string, startTime, endTime time.Time) ([]*entity.Release, error)
 
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"config-client/config/domain/entity"
+)
+
+// SubscriptionKeyRepository 订阅配置键仓储接口
+// 负责客户端上报的配置版本的持久化操作
+type SubscriptionKeyRepository interface {
+	// ReplaceBySubscriptionID 全量替换订阅监听的配置键及上报版本（在同一事务内完成）
+	ReplaceBySubscriptionID(ctx context.Context, subscriptionID int, keys []*entity.SubscriptionKey) error
+
+	// FindByConfigKey 查询监听指定配置键的全部上报记录
+	FindByConfigKey(ctx context.Context, namespaceID int, configKey string) ([]*entity.SubscriptionKey, error)
+}
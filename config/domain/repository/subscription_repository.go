@@ -43,6 +43,12 @@ type SubscriptionRepository interface {
 	// 返回: 订阅列表, 错误
 	FindActiveSubscriptions(ctx context.Context, namespaceID int, environment string) ([]*entity.Subscription, error)
 
+	// FindByClientID 查询客户端的全部订阅（精确匹配客户端ID，包含已停用的订阅）
+	FindByClientID(ctx context.Context, clientID string) ([]*entity.Subscription, error)
+
+	// FindByIDs 根据ID批量查询订阅
+	FindByIDs(ctx context.Context, ids []int) ([]*entity.Subscription, error)
+
 	// FindAllActiveSubscriptions 查询所有活跃订阅
 	FindAllActiveSubscriptions(ctx context.Context) ([]*entity.Subscription, error)
 
@@ -55,6 +61,9 @@ type SubscriptionRepository interface {
 	// CountByActive 按是否激活统计订阅数量
 	CountByActive(ctx context.Context, isActive bool) (int64, error)
 
+	// CountActiveByNamespace 统计命名空间下的活跃订阅数量（所有环境合计）
+	CountActiveByNamespace(ctx context.Context, namespaceID int) (int64, error)
+
 	// CountExpired 统计过期订阅数量（仅统计激活状态）
 	CountExpired(ctx context.Context, expireTime time.Time) (int64, error)
 
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"config-client/config/domain/entity"
+)
+
+// TenantRepository 租户仓储接口
+type TenantRepository interface {
+	// Create 创建租户
+	Create(ctx context.Context, tenant *entity.Tenant) error
+
+	// Update 更新租户名称、描述和状态
+	Update(ctx context.Context, tenant *entity.Tenant) error
+
+	// GetByID 根据ID查询租户（不存在时返回 nil）
+	GetByID(ctx context.Context, id int) (*entity.Tenant, error)
+
+	// GetByCode 根据编码查询租户（不存在时返回 nil）
+	GetByCode(ctx context.Context, code string) (*entity.Tenant, error)
+
+	// FindAll 查询全部租户（按ID升序）
+	FindAll(ctx context.Context) ([]*entity.Tenant, error)
+}
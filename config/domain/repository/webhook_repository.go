@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"config-client/config/domain/entity"
+	"config-client/share/repository"
+)
+
+// WebhookRepository Webhook 仓储接口
+type WebhookRepository interface {
+	// Create 创建 Webhook
+	Create(ctx context.Context, webhook *entity.Webhook) error
+
+	// Update 更新 Webhook
+	Update(ctx context.Context, webhook *entity.Webhook) error
+
+	// Delete 删除 Webhook
+	Delete(ctx context.Context, id int) error
+
+	// GetByID 根据ID查询 Webhook（不存在时返回 nil）
+	GetByID(ctx context.Context, id int) (*entity.Webhook, error)
+
+	// FindByNamespace 查询命名空间下的 Webhook（按ID升序，enabledOnly 为 true 时仅返回已启用的）
+	FindByNamespace(ctx context.Context, namespaceID int, enabledOnly bool) ([]*entity.Webhook, error)
+}
+
+// WebhookDeliveryQueryParams Webhook 投递记录查询参数
+type WebhookDeliveryQueryParams struct {
+	WebhookID int
+	Status    *string
+	EventType *string
+	Page      int
+	Size      int
+}
+
+// WebhookDeliveryRepository Webhook 投递记录仓储接口
+type WebhookDeliveryRepository interface {
+	// Save 保存待投递记录（上下文中存在事务时在同一事务内写入）
+	Save(ctx context.Context, deliveries []*entity.WebhookDelivery) error
+
+	// GetByID 根据ID查询投递记录（不存在时返回 nil）
+	GetByID(ctx context.Context, id int64) (*entity.WebhookDelivery, error)
+
+	// ClaimPending 领取一批可投递的记录（按ID升序）并设置租约、累加尝试次数
+	// 包括到达下次投递时间的待投递记录，以及租约已过期的投递中记录（领取实例异常退出）
+	// 多实例并发领取时互不重复
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*entity.WebhookDelivery, error)
+
+	// SaveResult 保存一次投递尝试的结果（状态、响应、失败原因、下次投递时间等）
+	SaveResult(ctx context.Context, delivery *entity.WebhookDelivery) error
+
+	// Query 分页查询投递记录（按ID倒序）
+	Query(ctx context.Context, params *WebhookDeliveryQueryParams) (*repository.PageResult[*entity.WebhookDelivery], error)
+
+	// DeleteFinishedBefore 删除指定时间之前创建且已结束投递的记录，返回删除数量
+	DeleteFinishedBefore(ctx context.Context, before time.Time) (int64, error)
+}
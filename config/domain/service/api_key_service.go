@@ -0,0 +1,324 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"config-client/config/domain/entity"
+	domainErrors "config-client/config/domain/errors"
+	"config-client/config/domain/repository"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	// APIKeyTokenPrefix 生成的 API Key 统一前缀，便于在日志和代码仓库中识别泄漏的密钥
+	APIKeyTokenPrefix = "cfk_"
+	// ReadTokenPrefix 生成的只读令牌统一前缀（与管理密钥区分）
+	ReadTokenPrefix = "cfr_"
+	// DefaultAPIKeyCacheTTL 认证结果默认缓存时长（吊销后其他实例最长在该时长后失效）
+	DefaultAPIKeyCacheTTL = time.Minute
+
+	// apiKeyMinLength 自定义密钥（种子文件指定）的最小长度
+	apiKeyMinLength = 24
+	// apiKeyPrefixLength 保存的密钥前缀长度
+	apiKeyPrefixLength = 12
+	// apiKeyTouchInterval 最近使用时间的最小更新间隔
+	apiKeyTouchInterval = time.Minute
+	// readTokenMaxKeyPrefixes 只读令牌最多允许的配置键前缀数量
+	readTokenMaxKeyPrefixes = 20
+)
+
+// APIKeyService API Key 领域服务
+// 负责 API Key 的签发、吊销和请求认证；数据库只保存密钥摘要，明文仅在创建时返回一次
+type APIKeyService struct {
+	repo          repository.APIKeyRepository
+	namespaceRepo repository.NamespaceRepository
+	cacheTTL      time.Duration
+
+	mu          sync.Mutex
+	cache       map[string]*apiKeyCacheEntry // 密钥摘要 -> 认证缓存
+	lastTouched map[int]time.Time            // API Key ID -> 最近一次写入的使用时间
+}
+
+// apiKeyCacheEntry API Key 认证缓存项
+type apiKeyCacheEntry struct {
+	key       *entity.APIKey
+	expiresAt time.Time
+}
+
+// NewAPIKeyService 创建 API Key 领域服务（cacheTTL <= 0 时使用默认值）
+func NewAPIKeyService(repo repository.APIKeyRepository, namespaceRepo repository.NamespaceRepository, cacheTTL time.Duration) *APIKeyService {
+	if cacheTTL <= 0 {
+		cacheTTL = DefaultAPIKeyCacheTTL
+	}
+	return &APIKeyService{
+		repo:          repo,
+		namespaceRepo: namespaceRepo,
+		cacheTTL:      cacheTTL,
+		cache:         make(map[string]*apiKeyCacheEntry),
+		lastTouched:   make(map[int]time.Time),
+	}
+}
+
+// GenerateAPIKey 生成随机 API Key（cfk_ 前缀 + 32 字节随机数的 URL 安全 Base64 编码）
+func GenerateAPIKey() (string, error) {
+	return generateToken(APIKeyTokenPrefix)
+}
+
+// generateToken 生成指定前缀的随机密钥（32 字节随机数的 URL 安全 Base64 编码）
+func generateToken(prefix string) (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return prefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// HashAPIKey 计算 API Key 摘要（密钥为高熵随机串，SHA-256 即可防止数据库泄漏后还原明文）
+func HashAPIKey(plaintext string) string {
+	sum := sha256.Sum256([]byte(plaintext))
+	return hex.EncodeToString(sum[:])
+}
+
+// CreateAPIKey 创建 API Key，返回密钥明文（仅此一次）
+// 业务规则：
+// 1. 名称必填且唯一，角色必须有效，过期时间必须晚于当前时间
+// 2. 只读令牌必须绑定当前租户下存在的命名空间（环境默认为 default），管理员密钥不能设置读取范围
+// 3. plaintext 为空时生成随机密钥；指定时（如种子文件）长度不少于 24 位
+// 4. 只保存密钥摘要和前缀
+func (s *APIKeyService) CreateAPIKey(ctx context.Context, key *entity.APIKey, plaintext string) (string, error) {
+	// 1. 校验参数
+	key.Name = strings.TrimSpace(key.Name)
+	if key.Name == "" || utf8.RuneCountInString(key.Name) > 100 {
+		return "", domainErrors.ErrAPIKeyInvalid("名称不能为空且不超过100个字符")
+	}
+	if key.Role == "" {
+		key.Role = entity.APIKeyRoleAdmin
+	}
+	if !entity.IsValidAPIKeyRole(key.Role) {
+		return "", domainErrors.ErrAPIKeyInvalid("不支持的角色: " + key.Role)
+	}
+	if key.ExpiresAt != nil && !key.ExpiresAt.After(time.Now()) {
+		return "", domainErrors.ErrAPIKeyInvalid("过期时间必须晚于当前时间")
+	}
+
+	// 2. 校验读取范围
+	if err := s.validateScope(ctx, key); err != nil {
+		return "", err
+	}
+
+	// 3. 名称唯一
+	existing, err := s.repo.GetByName(ctx, key.Name)
+	if err != nil {
+		return "", err
+	}
+	if existing != nil {
+		return "", domainErrors.ErrAPIKeyConflict(key.Name)
+	}
+
+	// 4. 生成或校验密钥
+	if plaintext == "" {
+		prefix := APIKeyTokenPrefix
+		if key.IsReadToken() {
+			prefix = ReadTokenPrefix
+		}
+		if plaintext, err = generateToken(prefix); err != nil {
+			return "", err
+		}
+	} else if len(plaintext) < apiKeyMinLength {
+		return "", domainErrors.ErrAPIKeyInvalid("密钥长度不能少于24位")
+	}
+
+	// 5. 保存摘要
+	key.KeyHash = HashAPIKey(plaintext)
+	key.Prefix = plaintext[:apiKeyPrefixLength]
+	key.LastUsedAt = nil
+	key.RevokedAt = nil
+	if err := s.repo.Create(ctx, key); err != nil {
+		return "", err
+	}
+
+	hlog.CtxInfof(ctx, "API Key 已创建: id=%d, name=%s, role=%s, prefix=%s", key.ID, key.Name, key.Role, key.Prefix)
+	return plaintext, nil
+}
+
+// validateScope 校验只读令牌的读取范围（命名空间、环境、配置键前缀）
+func (s *APIKeyService) validateScope(ctx context.Context, key *entity.APIKey) error {
+	if !key.IsReadToken() {
+		if key.NamespaceID != 0 || key.Environment != "" || len(key.KeyPrefixes) > 0 {
+			return domainErrors.ErrAPIKeyInvalid("只有只读令牌可以设置命名空间、环境和配置键前缀")
+		}
+		return nil
+	}
+
+	// 1. 命名空间必须存在
+	if key.NamespaceID <= 0 {
+		return domainErrors.ErrAPIKeyInvalid("只读令牌必须指定命名空间")
+	}
+	namespace, err := s.namespaceRepo.GetByID(ctx, key.NamespaceID)
+	if err != nil {
+		return err
+	}
+	if namespace == nil {
+		return domainErrors.ErrNamespaceNotFound("")
+	}
+
+	// 2. 环境默认为 default
+	key.Environment = strings.TrimSpace(key.Environment)
+	if key.Environment == "" {
+		key.Environment = "default"
+	}
+	if len(key.Environment) > 50 {
+		return domainErrors.ErrAPIKeyInvalid("环境不能超过50个字符")
+	}
+
+	// 3. 配置键前缀去空去重（前缀按逗号分隔存储，不能包含逗号）
+	prefixes := make([]string, 0, len(key.KeyPrefixes))
+	seen := make(map[string]struct{}, len(key.KeyPrefixes))
+	for _, prefix := range key.KeyPrefixes {
+		prefix = strings.TrimSpace(prefix)
+		if prefix == "" {
+			continue
+		}
+		if strings.Contains(prefix, ",") {
+			return domainErrors.ErrAPIKeyInvalid("配置键前缀不能包含逗号: " + prefix)
+		}
+		if _, ok := seen[prefix]; ok {
+			continue
+		}
+		seen[prefix] = struct{}{}
+		prefixes = append(prefixes, prefix)
+	}
+	if len(prefixes) > readTokenMaxKeyPrefixes {
+		return domainErrors.ErrAPIKeyInvalid("配置键前缀不能超过20个")
+	}
+	if len(strings.Join(prefixes, ",")) > 1000 {
+		return domainErrors.ErrAPIKeyInvalid("配置键前缀总长度不能超过1000个字符")
+	}
+	key.KeyPrefixes = prefixes
+	return nil
+}
+
+// RevokeAPIKey 吊销 API Key（已吊销时直接返回）
+func (s *APIKeyService) RevokeAPIKey(ctx context.Context, id int, operator string) (*entity.APIKey, error) {
+	key, err := s.GetAPIKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if key.IsRevoked() {
+		return key, nil
+	}
+
+	now := time.Now()
+	if err := s.repo.Revoke(ctx, id, operator, now); err != nil {
+		return nil, err
+	}
+	key.RevokedAt = &now
+	key.RevokedBy = operator
+
+	// 本实例立即失效，其他实例在缓存过期后失效
+	s.mu.Lock()
+	delete(s.cache, key.KeyHash)
+	s.mu.Unlock()
+
+	hlog.CtxInfof(ctx, "API Key 已吊销: id=%d, name=%s, operator=%s", key.ID, key.Name, operator)
+	return key, nil
+}
+
+// GetAPIKey 根据ID查询 API Key
+func (s *APIKeyService) GetAPIKey(ctx context.Context, id int) (*entity.APIKey, error) {
+	key, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, domainErrors.ErrAPIKeyNotFound(id)
+	}
+	return key, nil
+}
+
+// ListAPIKeys 查询 API Key 列表
+func (s *APIKeyService) ListAPIKeys(ctx context.Context, includeRevoked bool) ([]*entity.APIKey, error) {
+	return s.repo.FindAll(ctx, includeRevoked)
+}
+
+// HasUsableKey 是否存在指定角色的可用 API Key
+func (s *APIKeyService) HasUsableKey(ctx context.Context, role string) (bool, error) {
+	count, err := s.repo.CountUsableByRole(ctx, role, time.Now())
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// Authenticate 校验请求携带的 API Key，返回对应的 API Key
+// 认证结果按摘要缓存 cacheTTL，避免每个请求都查询数据库；最近使用时间每分钟最多写入一次
+func (s *APIKeyService) Authenticate(ctx context.Context, plaintext string) (*entity.APIKey, error) {
+	if plaintext == "" {
+		return nil, domainErrors.ErrAPIKeyUnauthorized("缺少 API Key")
+	}
+
+	now := time.Now()
+	key, err := s.lookup(ctx, HashAPIKey(plaintext), now)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case key == nil:
+		return nil, domainErrors.ErrAPIKeyUnauthorized("API Key 无效")
+	case key.IsRevoked():
+		return nil, domainErrors.ErrAPIKeyUnauthorized("API Key 已吊销")
+	case key.IsExpired(now):
+		return nil, domainErrors.ErrAPIKeyUnauthorized("API Key 已过期")
+	}
+
+	s.touch(ctx, key, now)
+	return key, nil
+}
+
+// lookup 按摘要查询 API Key（优先使用缓存，不缓存不存在的摘要）
+func (s *APIKeyService) lookup(ctx context.Context, keyHash string, now time.Time) (*entity.APIKey, error) {
+	s.mu.Lock()
+	if entry, ok := s.cache[keyHash]; ok && now.Before(entry.expiresAt) {
+		s.mu.Unlock()
+		return entry.key, nil
+	}
+	s.mu.Unlock()
+
+	key, err := s.repo.GetByHash(ctx, keyHash)
+	if err != nil || key == nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.cache[keyHash] = &apiKeyCacheEntry{key: key, expiresAt: now.Add(s.cacheTTL)}
+	s.mu.Unlock()
+	return key, nil
+}
+
+// touch 更新最近使用时间（失败只记录日志，不影响认证）
+func (s *APIKeyService) touch(ctx context.Context, key *entity.APIKey, now time.Time) {
+	s.mu.Lock()
+	last, ok := s.lastTouched[key.ID]
+	if !ok && key.LastUsedAt != nil {
+		last = *key.LastUsedAt
+	}
+	if now.Sub(last) < apiKeyTouchInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.lastTouched[key.ID] = now
+	s.mu.Unlock()
+
+	if err := s.repo.TouchLastUsed(ctx, key.ID, now); err != nil {
+		hlog.CtxWarnf(ctx, "更新 API Key 最近使用时间失败: id=%d, err=%v", key.ID, err)
+	}
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"config-client/config/domain/entity"
+	"config-client/config/domain/listener"
+	"config-client/config/domain/repository"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	// DefaultChangeEventRetention 事件日志默认保留时长
+	DefaultChangeEventRetention = 24 * time.Hour
+
+	// maxReplayEvents 单次补发扫描的最大事件数，超过时说明客户端落后太多，退回版本比较
+	maxReplayEvents = 1000
+)
+
+// ChangeEventService 配置变更事件日志服务
+// 为每个命名空间的变更事件分配单调递增的序号并持久化一段时间，
+// 长轮询客户端携带最后收到的序号重连时，据此补发断线期间遗漏的事件
+type ChangeEventService struct {
+	eventRepo repository.ChangeEventRepository
+	retention time.Duration
+}
+
+// NewChangeEventService 创建配置变更事件日志服务
+// retention: 事件保留时长（<=0 时使用默认值 24 小时）
+func NewChangeEventService(eventRepo repository.ChangeEventRepository, retention time.Duration) *ChangeEventService {
+	if retention <= 0 {
+		retention = DefaultChangeEventRetention
+	}
+	return &ChangeEventService{
+		eventRepo: eventRepo,
+		retention: retention,
+	}
+}
+
+// Record 记录变更事件并回填序号
+func (s *ChangeEventService) Record(ctx context.Context, event *listener.ConfigChangeEvent) error {
+	record := &entity.ChangeEvent{
+		NamespaceID: event.NamespaceID,
+		ConfigKey:   event.ConfigKey,
+		ConfigID:    event.ConfigID,
+		Environment: event.Environment,
+		Action:      event.Action,
+	}
+	if err := s.eventRepo.Append(ctx, record); err != nil {
+		return fmt.Errorf("记录配置变更事件失败: %w", err)
+	}
+	event.Sequence = record.Sequence
+	return nil
+}
+
+// ReplayResult 事件补发结果
+type ReplayResult struct {
+	Complete bool                  // 事件日志是否完整覆盖客户端遗漏的区间（否则需要退回版本比较）
+	Events   []*entity.ChangeEvent // 序号大于客户端最后序号的事件（按序号升序）
+}
+
+// Replay 查询客户端最后收到的序号之后的事件
+// 业务规则：
+// 1. 客户端序号之后的事件已被清理（日志不连续）时，结果标记为不完整
+// 2. 遗漏事件超过单次补发上限时，结果标记为不完整
+func (s *ChangeEventService) Replay(ctx context.Context, namespaceID int, lastSequence int64) (*ReplayResult, error) {
+	// 1. 检查日志是否覆盖客户端遗漏的区间
+	oldest, err := s.eventRepo.GetOldestSequence(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if oldest > lastSequence+1 {
+		return &ReplayResult{Complete: false}, nil
+	}
+
+	// 2. 查询遗漏的事件
+	events, err := s.eventRepo.FindAfterSequence(ctx, namespaceID, lastSequence, maxReplayEvents+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) > maxReplayEvents {
+		return &ReplayResult{Complete: false}, nil
+	}
+
+	return &ReplayResult{Complete: true, Events: events}, nil
+}
+
+// GetLatestSequence 获取命名空间的最新事件序号
+func (s *ChangeEventService) GetLatestSequence(ctx context.Context, namespaceID int) (int64, error) {
+	return s.eventRepo.GetLatestSequence(ctx, namespaceID)
+}
+
+// CleanExpired 清理超过保留时长的事件
+func (s *ChangeEventService) CleanExpired(ctx context.Context) (int64, error) {
+	return s.eventRepo.DeleteBefore(ctx, time.Now().Add(-s.retention))
+}
+
+// WrapListener 包装配置变更监听器：发布前先记录事件日志并分配序号
+// 记录失败时仍然发布事件（不带序号），实时通知不受影响，仅该事件无法补发
+func (s *ChangeEventService) WrapListener(l listener.ConfigListener) listener.ConfigListener {
+	return &sequencedListener{ConfigListener: l, eventSvc: s}
+}
+
+// sequencedListener 记录事件日志的监听器装饰器
+type sequencedListener struct {
+	listener.ConfigListener
+	eventSvc *ChangeEventService
+}
+
+// Publish 记录事件日志后发布
+func (l *sequencedListener) Publish(ctx context.Context, event *listener.ConfigChangeEvent) error {
+	l.record(ctx, event)
+	return l.ConfigListener.Publish(ctx, event)
+}
+
+// PublishBatch 逐条记录事件日志后批量发布
+func (l *sequencedListener) PublishBatch(ctx context.Context, events []*listener.ConfigChangeEvent) error {
+	for _, event := range events {
+		l.record(ctx, event)
+	}
+	return l.ConfigListener.PublishBatch(ctx, events)
+}
+
+// CheckHealth 透传被包装监听器的健康检查
+func (l *sequencedListener) CheckHealth(ctx context.Context) error {
+	if checker, ok := l.ConfigListener.(listener.HealthChecker); ok {
+		return checker.CheckHealth(ctx)
+	}
+	return nil
+}
+
+// record 记录事件日志（与请求生命周期解耦，请求结束后仍可完成写入）
+func (l *sequencedListener) record(ctx context.Context, event *listener.ConfigChangeEvent) {
+	if err := l.eventSvc.Record(context.WithoutCancel(ctx), event); err != nil {
+		hlog.CtxErrorf(ctx, "%v, event: %+v", err, event)
+	}
+}
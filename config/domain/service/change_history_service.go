@@ -51,7 +51,7 @@ func (s *ChangeHistoryService) RecordChange(ctx context.Context, record *entity.
 	// 异步保存，不阻塞主流程
 	go func() {
 		if err := s.historyRepo.Save(context.Background(), history); err != nil {
-			hlog.Errorf("保存变更历史失败: %v, record: %+v", err, record)
+			hlog.CtxErrorf(ctx, "保存变更历史失败: %v, record: %+v", err, record)
 		}
 	}()
 
@@ -106,6 +106,37 @@ func (s *ChangeHistoryService) QueryHistory(ctx context.Context, params *reposit
 	return s.historyRepo.QueryByParams(ctx, params)
 }
 
+// ExportHistory 按查询条件分批遍历变更历史（按ID升序）
+// 使用ID游标代替偏移分页，历史记录很多时每批查询的开销保持不变；
+// fn 返回错误时停止遍历并返回该错误
+func (s *ChangeHistoryService) ExportHistory(ctx context.Context, params *repository.ChangeHistoryQueryParams, batchSize int, fn func(histories []*entity.ChangeHistory) error) error {
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+
+	afterID := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		histories, err := s.historyRepo.FindByParamsAfterID(ctx, params, afterID, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(histories) == 0 {
+			return nil
+		}
+		if err := fn(histories); err != nil {
+			return err
+		}
+		if len(histories) < batchSize {
+			return nil
+		}
+		afterID = histories[len(histories)-1].ID
+	}
+}
+
 // ==================== 变更对比 ====================
 
 // CompareVersions 对比两个版本
@@ -133,6 +164,12 @@ func (s *ChangeHistoryService) CompareVersions(ctx context.Context, historyID1,
 		historyID1, historyID2 = historyID2, historyID1
 	}
 
+	// 值类型以配置当前的类型为准，配置已删除时按值内容推断
+	valueType := ""
+	if config, err := s.configRepo.GetByID(ctx, history2.ConfigID); err == nil && config != nil {
+		valueType = config.ValueType
+	}
+
 	return &VersionCompareResult{
 		FromHistoryID: historyID1,
 		ToHistoryID:   historyID2,
@@ -145,22 +182,28 @@ func (s *ChangeHistoryService) CompareVersions(ctx context.Context, historyID1,
 		ToOperation:   string(history2.Operation),
 		FromChangedAt: history1.CreatedAt,
 		ToChangedAt:   history2.CreatedAt,
+		Diff: DiffValues(
+			fmt.Sprintf("%s@v%d", history1.ConfigKey, history1.NewVersion),
+			fmt.Sprintf("%s@v%d", history2.ConfigKey, history2.NewVersion),
+			history1.NewValue, history2.NewValue, valueType,
+		),
 	}, nil
 }
 
 // VersionCompareResult 版本对比结果
 type VersionCompareResult struct {
-	FromHistoryID int       // 源版本历史ID
-	ToHistoryID   int       // 目标版本历史ID
-	FromVersion   int       // 源版本号
-	ToVersion     int       // 目标版本号
-	FromValue     string    // 源版本值
-	ToValue       string    // 目标版本值
-	ValueChanged  bool      // 值是否变化
-	FromOperation string    // 源操作类型
-	ToOperation   string    // 目标操作类型
-	FromChangedAt time.Time // 源变更时间
-	ToChangedAt   time.Time // 目标变更时间
+	FromHistoryID int        // 源版本历史ID
+	ToHistoryID   int        // 目标版本历史ID
+	FromVersion   int        // 源版本号
+	ToVersion     int        // 目标版本号
+	FromValue     string     // 源版本值
+	ToValue       string     // 目标版本值
+	ValueChanged  bool       // 值是否变化
+	FromOperation string     // 源操作类型
+	ToOperation   string     // 目标操作类型
+	FromChangedAt time.Time  // 源变更时间
+	ToChangedAt   time.Time  // 目标变更时间
+	Diff          *ValueDiff // 值差异（加密配置为 nil）
 }
 
 // ==================== 变更回滚 ====================
@@ -212,23 +255,19 @@ func (s *ChangeHistoryService) RollbackToHistory(ctx context.Context, req *entit
 			return fmt.Errorf("回滚时加密配置值失败: %w", err)
 		}
 		actualValue = encryptedValue
-		hlog.Infof("回滚敏感配置，已重新加密: key=%s", config.Key)
+		hlog.CtxInfof(ctx, "回滚敏感配置，已重新加密: key=%s", config.Key)
 	}
 
 	// 6. 更新配置值
 	// 重新计算内容哈希（基于加密后的值）
-	newHash, err := s.configSrv.ComputeContentHash(actualValue, "md5")
+	newHash, err := s.configSrv.ComputeContentHash(actualValue, s.configSrv.HashAlgorithm())
 	if err != nil {
 		return fmt.Errorf("计算内容哈希失败: %w", err)
 	}
 	config.UpdateValue(actualValue, newHash)
+	config.ContentHashAlgorithm = s.configSrv.HashAlgorithm()
 
-	// 7. 保存配置更新
-	if err := s.configRepo.Update(ctx, config); err != nil {
-		return fmt.Errorf("回滚配置失败: %w", err)
-	}
-
-	// 8. 记录回滚操作的变更历史（使用明文值记录）
+	// 7. 记录回滚操作的变更历史（使用明文值记录）
 	rollbackRecord := &entity.ChangeRecord{
 		ConfigID:     config.ID,
 		NamespaceID:  config.NamespaceID,
@@ -244,11 +283,21 @@ func (s *ChangeHistoryService) RollbackToHistory(ctx context.Context, req *entit
 		ChangeReason: fmt.Sprintf("回滚到版本 %d (历史记录ID: %d). %s", targetHistory.NewVersion, req.TargetHistoryID, req.ChangeReason),
 	}
 
-	if err := s.RecordChangeWithTx(ctx, rollbackRecord); err != nil {
-		hlog.Errorf("记录回滚变更历史失败: %v", err)
+	// 8. 在同一事务中保存配置更新和回滚变更历史
+	err = s.configSrv.runInTx(ctx, func(txCtx context.Context) error {
+		if err := s.configRepo.Update(txCtx, config); err != nil {
+			return fmt.Errorf("回滚配置失败: %w", err)
+		}
+		if err := s.RecordChangeWithTx(txCtx, rollbackRecord); err != nil {
+			return fmt.Errorf("记录回滚变更历史失败: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
-	hlog.Infof("配置回滚成功: configID=%d, key=%s, 回滚到版本=%d", config.ID, config.Key, targetHistory.NewVersion)
+	hlog.CtxInfof(ctx, "配置回滚成功: configID=%d, key=%s, 回滚到版本=%d", config.ID, config.Key, targetHistory.NewVersion)
 
 	return nil
 }
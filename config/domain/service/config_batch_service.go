@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"config-client/config/domain/constants"
+	"config-client/config/domain/entity"
+	domainErrors "config-client/config/domain/errors"
+	"config-client/config/domain/listener"
+	"config-client/share/tracing"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// maxBatchMutationSize 单次批量变更的最大条目数
+const maxBatchMutationSize = 500
+
+// BatchAction 批量变更操作类型
+type BatchAction string
+
+const (
+	BatchActionCreate BatchAction = "create" // 创建配置
+	BatchActionUpdate BatchAction = "update" // 更新配置
+	BatchActionDelete BatchAction = "delete" // 删除配置
+)
+
+// BatchItemStatus 批量变更条目状态
+type BatchItemStatus string
+
+const (
+	BatchItemSucceeded  BatchItemStatus = "succeeded"   // 执行成功并已提交
+	BatchItemFailed     BatchItemStatus = "failed"      // 执行失败（导致整批回滚）
+	BatchItemRolledBack BatchItemStatus = "rolled_back" // 执行成功但因其他条目失败被回滚
+	BatchItemSkipped    BatchItemStatus = "skipped"     // 因前序条目失败未执行
+)
+
+// BatchConfigMutation 单条批量变更
+// Config 中 NamespaceID、Key、Environment 用于定位配置；更新时 Value 等字段为新值
+type BatchConfigMutation struct {
+	Action BatchAction
+	Config *entity.Config
+}
+
+// BatchItemResult 单条批量变更结果
+type BatchItemResult struct {
+	Index    int
+	Action   BatchAction
+	Key      string
+	ConfigID int
+	Version  int
+	Status   BatchItemStatus
+	Error    string
+}
+
+// BatchMutationResult 批量变更结果
+type BatchMutationResult struct {
+	Committed bool // 是否已提交（任一条目失败时整批回滚）
+	Items     []*BatchItemResult
+}
+
+// configChangeBatch 配置变更工作单元（单条变更或批量变更）中暂存的变更事件和变更记录
+type configChangeBatch struct {
+	events  []*listener.ConfigChangeEvent
+	records []*entity.ChangeRecord
+}
+
+// configChangeBatchKey 批量变更暂存区的上下文键
+type configChangeBatchKey struct{}
+
+// BatchMutateConfigs 在单个事务中批量创建、更新、删除配置
+// 业务规则：
+// 1. 每个条目按单条接口的规则校验和执行（校验规则、Schema、已发布配置不可修改等）
+// 2. 任一条目失败时整批回滚，返回每个条目的执行状态
+// 3. 同一批次内同一配置（命名空间+环境+键）只能出现一次
+// 4. 变更历史在同一事务内保存，变更事件在事务提交后批量发布
+func (s *ConfigService) BatchMutateConfigs(ctx context.Context, mutations []*BatchConfigMutation) (_ *BatchMutationResult, err error) {
+	ctx, span := tracing.Start(ctx, "ConfigService.BatchMutateConfigs", attribute.Int("batch.size", len(mutations)))
+	defer func() { tracing.End(span, err) }()
+
+	// 1. 校验批次
+	if err := validateBatchMutations(mutations); err != nil {
+		return nil, err
+	}
+
+	result := &BatchMutationResult{Items: make([]*BatchItemResult, len(mutations))}
+	for i, mutation := range mutations {
+		result.Items[i] = &BatchItemResult{
+			Index:  i,
+			Action: mutation.Action,
+			Key:    mutation.Config.Key,
+			Status: BatchItemSkipped,
+		}
+	}
+
+	// 2. 在事务中逐条执行，暂存事件和变更记录
+	batch := &configChangeBatch{}
+	var itemErr error
+	err = s.runInTx(context.WithValue(ctx, configChangeBatchKey{}, batch), func(txCtx context.Context) error {
+		for i, mutation := range mutations {
+			item := result.Items[i]
+			config, err := s.applyBatchMutation(txCtx, mutation)
+			if err != nil {
+				item.Status = BatchItemFailed
+				item.Error = err.Error()
+				itemErr = err
+				return err
+			}
+			item.Status = BatchItemSucceeded
+			item.ConfigID = config.ID
+			item.Version = config.Version
+		}
+
+		// 变更历史（及启用发件箱时的变更事件）与配置变更在同一事务内保存
+		return s.saveChangeBatch(txCtx, batch)
+	})
+
+	// 3. 处理执行结果
+	if err != nil {
+		for _, item := range result.Items {
+			if item.Status == BatchItemSucceeded {
+				item.Status = BatchItemRolledBack
+			}
+		}
+		if itemErr == nil {
+			return nil, err // 非条目错误（如数据库异常、提交失败）直接向上传递
+		}
+		hlog.CtxWarnf(ctx, "批量变更已回滚: %v", itemErr)
+		span.SetAttributes(attribute.Bool("batch.committed", false))
+		return result, nil
+	}
+	result.Committed = true
+	span.SetAttributes(attribute.Bool("batch.committed", true))
+
+	// 4. 事务提交后批量发布变更事件
+	s.publishConfigChangeEvents(ctx, batch.events)
+
+	return result, nil
+}
+
+// applyBatchMutation 执行单条批量变更，返回变更后的配置
+func (s *ConfigService) applyBatchMutation(ctx context.Context, mutation *BatchConfigMutation) (*entity.Config, error) {
+	config := mutation.Config
+	if config.Environment == "" {
+		config.Environment = constants.EnvDefault
+	}
+
+	if mutation.Action == BatchActionCreate {
+		if err := s.CreateConfig(ctx, config); err != nil {
+			return nil, err
+		}
+		return config, nil
+	}
+
+	// 更新和删除按 命名空间+环境+键 定位已有配置
+	existing, err := s.configRepo.FindByNamespaceAndKey(ctx, config.NamespaceID, config.Key, config.Environment)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, domainErrors.ErrConfigNotFound(config.Key, config.Environment)
+	}
+
+	if mutation.Action == BatchActionDelete {
+		if err := s.DeleteConfig(ctx, existing.ID); err != nil {
+			return nil, err
+		}
+		return existing, nil
+	}
+
+	// 未指定的字段保留原值
+	config.ID = existing.ID
+	if config.GroupName == "" {
+		config.GroupName = existing.GroupName
+	}
+	if config.ValueType == "" {
+		config.ValueType = existing.ValueType
+	}
+	if config.Description == "" {
+		config.Description = existing.Description
+	}
+	if config.Metadata == "" {
+		config.Metadata = existing.Metadata
+	}
+	if err := s.UpdateConfig(ctx, config); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.configRepo.GetByID(ctx, existing.ID)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return config, nil
+	}
+	return updated, nil
+}
+
+// publishConfigChangeEvents 批量发布配置变更事件
+// 启用发件箱时事件已随事务保存，仅唤醒投递任务
+func (s *ConfigService) publishConfigChangeEvents(ctx context.Context, events []*listener.ConfigChangeEvent) {
+	if len(events) == 0 {
+		return
+	}
+	if s.outbox != nil {
+		s.outbox.Wake()
+		return
+	}
+	if s.listener == nil {
+		return
+	}
+
+	// 异步发布事件，不阻塞主流程
+	go func() {
+		if err := s.listener.PublishBatch(context.WithoutCancel(ctx), events); err != nil {
+			hlog.CtxErrorf(ctx, "批量发布配置变更事件失败: %v, count: %d", err, len(events))
+		}
+	}()
+}
+
+// validateBatchMutations 校验批量变更请求
+func validateBatchMutations(mutations []*BatchConfigMutation) error {
+	if len(mutations) == 0 {
+		return domainErrors.ErrConfigBatchInvalid("变更列表不能为空")
+	}
+	if len(mutations) > maxBatchMutationSize {
+		return domainErrors.ErrConfigBatchInvalid(fmt.Sprintf("单次最多 %d 条，实际 %d 条", maxBatchMutationSize, len(mutations)))
+	}
+
+	seen := make(map[string]int, len(mutations))
+	for i, mutation := range mutations {
+		if mutation == nil || mutation.Config == nil {
+			return domainErrors.ErrConfigBatchInvalid(fmt.Sprintf("第 %d 条变更为空", i+1))
+		}
+		switch mutation.Action {
+		case BatchActionCreate, BatchActionUpdate, BatchActionDelete:
+		default:
+			return domainErrors.ErrConfigBatchInvalid(fmt.Sprintf("第 %d 条变更的操作类型无效: %s（可选值: create/update/delete）", i+1, mutation.Action))
+		}
+		if mutation.Config.Key == "" {
+			return domainErrors.ErrConfigBatchInvalid(fmt.Sprintf("第 %d 条变更缺少配置键", i+1))
+		}
+
+		environment := mutation.Config.Environment
+		if environment == "" {
+			environment = constants.EnvDefault
+		}
+		target := fmt.Sprintf("%d:%s:%s", mutation.Config.NamespaceID, environment, mutation.Config.Key)
+		if prev, ok := seen[target]; ok {
+			return domainErrors.ErrConfigBatchInvalid(fmt.Sprintf("第 %d 条与第 %d 条变更了同一配置: %s", i+1, prev+1, mutation.Config.Key))
+		}
+		seen[target] = i
+	}
+	return nil
+}
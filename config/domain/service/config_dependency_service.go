@@ -0,0 +1,664 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"config-client/config/domain/constants"
+	"config-client/config/domain/entity"
+	domainErrors "config-client/config/domain/errors"
+	"config-client/config/domain/repository"
+)
+
+const (
+	// maxDeclaredDependencies 单个配置最多声明的依赖数
+	maxDeclaredDependencies = 100
+	// maxImpactedConfigs 影响分析最多返回的下游配置数，超过时结果标记为截断
+	maxImpactedConfigs = 1000
+)
+
+// ConfigDependencyService 配置依赖领域服务
+// 依赖关系有两种来源：
+// 1. 显式声明（depends_on）：由使用方维护，表达引用表达式无法体现的业务依赖
+// 2. 引用依赖：配置值中的 ${namespace:key} 引用，按读取方环境查找，找不到时回退到默认环境
+// 发布前可通过影响分析查看变更会波及的下游配置和订阅客户端
+type ConfigDependencyService struct {
+	dependencyRepo   repository.ConfigDependencyRepository
+	configRepo       repository.ConfigRepository
+	namespaceRepo    repository.NamespaceRepository
+	subscriptionRepo repository.SubscriptionRepository
+}
+
+// NewConfigDependencyService 创建配置依赖服务实例
+func NewConfigDependencyService(
+	dependencyRepo repository.ConfigDependencyRepository,
+	configRepo repository.ConfigRepository,
+	namespaceRepo repository.NamespaceRepository,
+	subscriptionRepo repository.SubscriptionRepository,
+) *ConfigDependencyService {
+	return &ConfigDependencyService{
+		dependencyRepo:   dependencyRepo,
+		configRepo:       configRepo,
+		namespaceRepo:    namespaceRepo,
+		subscriptionRepo: subscriptionRepo,
+	}
+}
+
+// ConfigDependencies 配置的显式依赖关系
+type ConfigDependencies struct {
+	Config     *entity.Config
+	DependsOn  []*entity.Config // 该配置依赖的上游配置
+	DependedBy []*entity.Config // 依赖该配置的下游配置
+}
+
+// DependencyNode 依赖图节点
+type DependencyNode struct {
+	Config        *entity.Config
+	NamespaceName string
+	External      bool // 是否为图范围（命名空间、环境）之外被依赖的配置
+}
+
+// DependencyEdge 依赖图的边：FromConfigID 依赖 ToConfigID
+type DependencyEdge struct {
+	FromConfigID int
+	ToConfigID   int
+	Type         string // declared / reference
+}
+
+// DependencyGraph 依赖图
+type DependencyGraph struct {
+	Nodes []*DependencyNode
+	Edges []*DependencyEdge
+}
+
+// ImpactedConfig 受影响的下游配置
+type ImpactedConfig struct {
+	Config         *entity.Config
+	NamespaceName  string
+	Depth          int    // 与变更配置的距离（直接依赖为 1）
+	DependencyType string // 与上一跳之间的依赖类型：declared / reference
+	ViaConfigID    int    // 上一跳配置ID
+}
+
+// ImpactedSubscribers 受影响的订阅客户端（按命名空间和环境汇总）
+type ImpactedSubscribers struct {
+	NamespaceID   int
+	NamespaceName string
+	Environment   string
+	ClientIDs     []string
+}
+
+// ImpactAnalysis 变更影响分析结果
+type ImpactAnalysis struct {
+	Config      *entity.Config
+	Impacted    []*ImpactedConfig
+	Subscribers []*ImpactedSubscribers
+	Truncated   bool // 下游配置超过上限时为 true，结果不完整
+}
+
+// ==================== 依赖声明 ====================
+
+// SetDependencies 全量替换配置显式声明的依赖
+// 业务规则：
+// 1. 配置和被依赖的配置都必须存在，不能依赖自身
+// 2. 单个配置最多声明 100 个依赖，重复的依赖自动去重
+// 3. 显式依赖不能形成循环
+func (s *ConfigDependencyService) SetDependencies(ctx context.Context, configID int, dependsOn []int, operator string) (*ConfigDependencies, error) {
+	// 1. 检查配置
+	config, err := s.getConfig(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 校验被依赖的配置
+	targetIDs := make([]int, 0, len(dependsOn))
+	seen := make(map[int]bool, len(dependsOn))
+	for _, id := range dependsOn {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if id == configID {
+			return nil, domainErrors.ErrConfigDependencyInvalid("配置不能依赖自身")
+		}
+		target, err := s.configRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if target == nil {
+			return nil, domainErrors.ErrConfigDependencyInvalid("被依赖的配置不存在: id=" + strconv.Itoa(id))
+		}
+		targetIDs = append(targetIDs, id)
+	}
+	if len(targetIDs) > maxDeclaredDependencies {
+		return nil, domainErrors.ErrConfigDependencyInvalid("依赖数量不能超过 " + strconv.Itoa(maxDeclaredDependencies))
+	}
+
+	// 3. 检测循环依赖
+	if err := s.checkCycle(ctx, config, targetIDs); err != nil {
+		return nil, err
+	}
+
+	// 4. 在事务中替换依赖
+	dependencies := make([]*entity.ConfigDependency, 0, len(targetIDs))
+	for _, id := range targetIDs {
+		dependencies = append(dependencies, &entity.ConfigDependency{
+			ConfigID:          configID,
+			DependsOnConfigID: id,
+			CreatedBy:         operator,
+		})
+	}
+	err = s.configRepo.WithTx(ctx, func(txCtx context.Context) error {
+		if err := s.dependencyRepo.DeleteByConfigID(txCtx, configID); err != nil {
+			return err
+		}
+		return s.dependencyRepo.BatchCreate(txCtx, dependencies)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetDependencies(ctx, configID)
+}
+
+// GetDependencies 查询配置显式声明的上游依赖和依赖它的下游配置
+func (s *ConfigDependencyService) GetDependencies(ctx context.Context, configID int) (*ConfigDependencies, error) {
+	config, err := s.getConfig(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+
+	upstream, err := s.dependencyRepo.FindByConfigIDs(ctx, []int{configID})
+	if err != nil {
+		return nil, err
+	}
+	downstream, err := s.dependencyRepo.FindByDependsOnIDs(ctx, []int{configID})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ConfigDependencies{Config: config}
+	for _, dependency := range upstream {
+		if result.DependsOn, err = s.appendConfig(ctx, result.DependsOn, dependency.DependsOnConfigID); err != nil {
+			return nil, err
+		}
+	}
+	for _, dependency := range downstream {
+		if result.DependedBy, err = s.appendConfig(ctx, result.DependedBy, dependency.ConfigID); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// checkCycle 沿显式依赖从新的上游配置出发，能回到当前配置说明存在循环
+func (s *ConfigDependencyService) checkCycle(ctx context.Context, config *entity.Config, targetIDs []int) error {
+	parent := make(map[int]int, len(targetIDs))
+	queue := make([]int, 0, len(targetIDs))
+	for _, id := range targetIDs {
+		parent[id] = config.ID
+		queue = append(queue, id)
+	}
+
+	for len(queue) > 0 {
+		dependencies, err := s.dependencyRepo.FindByConfigIDs(ctx, queue)
+		if err != nil {
+			return err
+		}
+		queue = queue[:0]
+		for _, dependency := range dependencies {
+			next := dependency.DependsOnConfigID
+			if _, visited := parent[next]; visited && next != config.ID {
+				continue
+			}
+			parent[next] = dependency.ConfigID
+			if next == config.ID {
+				return domainErrors.ErrConfigDependencyCycle(s.cycleChain(ctx, config, parent))
+			}
+			queue = append(queue, next)
+		}
+	}
+	return nil
+}
+
+// cycleChain 根据遍历记录还原循环链路（配置键）
+func (s *ConfigDependencyService) cycleChain(ctx context.Context, config *entity.Config, parent map[int]int) []string {
+	ids := []int{config.ID}
+	for id := parent[config.ID]; id != config.ID && len(ids) <= len(parent); id = parent[id] {
+		ids = append(ids, id)
+	}
+	ids = append(ids, config.ID)
+
+	// 还原顺序：当前配置 -> 上游 -> ... -> 当前配置
+	chain := make([]string, 0, len(ids))
+	for i := len(ids) - 1; i >= 0; i-- {
+		label := "id=" + strconv.Itoa(ids[i])
+		if c, err := s.configRepo.GetByID(ctx, ids[i]); err == nil && c != nil {
+			label = c.Key
+		}
+		chain = append(chain, label)
+	}
+	return chain
+}
+
+// ==================== 依赖图 ====================
+
+// GetGraph 获取命名空间的依赖图
+// 业务规则：
+// 1. 节点为命名空间下的配置（environment 不为空时只包含该环境），被依赖的其他命名空间或环境的配置作为外部节点
+// 2. 边包含显式依赖和引用依赖，加密配置的值无法解析，不产生引用依赖
+func (s *ConfigDependencyService) GetGraph(ctx context.Context, namespaceID int, environment string) (*DependencyGraph, error) {
+	// 1. 校验参数
+	if environment != "" && !contains(constants.ValidEnvironments, environment) {
+		return nil, domainErrors.ErrConfigEnvironmentInvalid(environment)
+	}
+	namespace, err := s.namespaceRepo.GetByID(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if namespace == nil {
+		return nil, domainErrors.ErrNamespaceNotFound("")
+	}
+
+	// 2. 收集图范围内的配置
+	configs, err := s.configRepo.FindByNamespace(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	builder := newGraphBuilder(s, namespace)
+	ids := make([]int, 0, len(configs))
+	for _, config := range configs {
+		if environment != "" && config.Environment != environment {
+			continue
+		}
+		builder.addNode(config, false)
+		ids = append(ids, config.ID)
+	}
+
+	// 3. 显式依赖
+	dependencies, err := s.dependencyRepo.FindByConfigIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, dependency := range dependencies {
+		if err := builder.addEdge(ctx, dependency.ConfigID, dependency.DependsOnConfigID, entity.DependencyTypeDeclared); err != nil {
+			return nil, err
+		}
+	}
+
+	// 4. 引用依赖
+	for _, id := range ids {
+		config := builder.nodes[id].Config
+		targets, err := s.referenceTargets(ctx, builder.lookup, config)
+		if err != nil {
+			return nil, err
+		}
+		for _, target := range targets {
+			builder.addNode(target, true)
+			if err := builder.addEdge(ctx, config.ID, target.ID, entity.DependencyTypeReference); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return builder.build(ctx), nil
+}
+
+// graphBuilder 依赖图构建器
+type graphBuilder struct {
+	svc    *ConfigDependencyService
+	lookup *referenceLookup
+	nodes  map[int]*DependencyNode
+	edges  map[DependencyEdge]bool
+}
+
+// newGraphBuilder 创建依赖图构建器
+func newGraphBuilder(svc *ConfigDependencyService, namespace *entity.Namespace) *graphBuilder {
+	lookup := newReferenceLookup(svc)
+	lookup.namespacesByID[namespace.ID] = namespace
+	return &graphBuilder{
+		svc:    svc,
+		lookup: lookup,
+		nodes:  make(map[int]*DependencyNode),
+		edges:  make(map[DependencyEdge]bool),
+	}
+}
+
+// addNode 添加节点（已存在时忽略）
+func (b *graphBuilder) addNode(config *entity.Config, external bool) {
+	if _, ok := b.nodes[config.ID]; ok {
+		return
+	}
+	b.nodes[config.ID] = &DependencyNode{Config: config, External: external}
+}
+
+// addEdge 添加边，被依赖的配置不在图中时作为外部节点加入（已删除的配置忽略）
+func (b *graphBuilder) addEdge(ctx context.Context, from, to int, dependencyType string) error {
+	if _, ok := b.nodes[to]; !ok {
+		target, err := b.svc.configRepo.GetByID(ctx, to)
+		if err != nil {
+			return err
+		}
+		if target == nil {
+			return nil
+		}
+		b.addNode(target, true)
+	}
+	b.edges[DependencyEdge{FromConfigID: from, ToConfigID: to, Type: dependencyType}] = true
+	return nil
+}
+
+// build 生成依赖图（节点按外部标记、命名空间、键、环境排序，边按起止ID排序）
+func (b *graphBuilder) build(ctx context.Context) *DependencyGraph {
+	graph := &DependencyGraph{
+		Nodes: make([]*DependencyNode, 0, len(b.nodes)),
+		Edges: make([]*DependencyEdge, 0, len(b.edges)),
+	}
+	for _, node := range b.nodes {
+		node.NamespaceName = b.lookup.namespaceName(ctx, node.Config.NamespaceID)
+		graph.Nodes = append(graph.Nodes, node)
+	}
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		a, c := graph.Nodes[i], graph.Nodes[j]
+		if a.External != c.External {
+			return !a.External
+		}
+		if a.NamespaceName != c.NamespaceName {
+			return a.NamespaceName < c.NamespaceName
+		}
+		if a.Config.Key != c.Config.Key {
+			return a.Config.Key < c.Config.Key
+		}
+		return a.Config.Environment < c.Config.Environment
+	})
+
+	for edge := range b.edges {
+		e := edge
+		graph.Edges = append(graph.Edges, &e)
+	}
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		a, c := graph.Edges[i], graph.Edges[j]
+		if a.FromConfigID != c.FromConfigID {
+			return a.FromConfigID < c.FromConfigID
+		}
+		if a.ToConfigID != c.ToConfigID {
+			return a.ToConfigID < c.ToConfigID
+		}
+		return a.Type < c.Type
+	})
+	return graph
+}
+
+// ==================== 影响分析 ====================
+
+// AnalyzeImpact 分析配置变更的影响范围
+// 业务规则：
+// 1. 沿显式依赖和引用依赖逐层查找下游配置（广度优先，每个配置只出现一次）
+// 2. 默认环境的配置可能被其他环境通过回退读取，因此下游包含所有环境中引用它的配置
+// 3. 受影响的订阅客户端为变更配置及下游配置所在命名空间和环境的活跃订阅
+// 4. 下游配置超过上限时停止查找，结果标记为截断
+func (s *ConfigDependencyService) AnalyzeImpact(ctx context.Context, configID int) (*ImpactAnalysis, error) {
+	// 1. 检查配置
+	config, err := s.getConfig(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 逐层查找下游配置
+	lookup := newReferenceLookup(s)
+	analysis := &ImpactAnalysis{Config: config, Impacted: []*ImpactedConfig{}}
+	visited := map[int]bool{config.ID: true}
+	current := []*entity.Config{config}
+	for depth := 1; len(current) > 0 && !analysis.Truncated; depth++ {
+		var next []*entity.Config
+		for _, upstream := range current {
+			dependents, err := s.findDependents(ctx, lookup, upstream)
+			if err != nil {
+				return nil, err
+			}
+			for _, dependent := range dependents {
+				if visited[dependent.config.ID] {
+					continue
+				}
+				if len(analysis.Impacted) >= maxImpactedConfigs {
+					analysis.Truncated = true
+					break
+				}
+				visited[dependent.config.ID] = true
+				analysis.Impacted = append(analysis.Impacted, &ImpactedConfig{
+					Config:         dependent.config,
+					NamespaceName:  lookup.namespaceName(ctx, dependent.config.NamespaceID),
+					Depth:          depth,
+					DependencyType: dependent.dependencyType,
+					ViaConfigID:    upstream.ID,
+				})
+				next = append(next, dependent.config)
+			}
+		}
+		current = next
+	}
+
+	// 3. 汇总受影响的订阅客户端
+	subscribers, err := s.impactedSubscribers(ctx, lookup, analysis)
+	if err != nil {
+		return nil, err
+	}
+	analysis.Subscribers = subscribers
+
+	return analysis, nil
+}
+
+// dependent 下游配置及其依赖类型
+type dependent struct {
+	config         *entity.Config
+	dependencyType string
+}
+
+// findDependents 查找直接依赖指定配置的下游配置
+func (s *ConfigDependencyService) findDependents(ctx context.Context, lookup *referenceLookup, upstream *entity.Config) ([]dependent, error) {
+	var result []dependent
+
+	// 1. 显式依赖
+	dependencies, err := s.dependencyRepo.FindByDependsOnIDs(ctx, []int{upstream.ID})
+	if err != nil {
+		return nil, err
+	}
+	for _, dependency := range dependencies {
+		config, err := s.configRepo.GetByID(ctx, dependency.ConfigID)
+		if err != nil {
+			return nil, err
+		}
+		if config != nil {
+			result = append(result, dependent{config: config, dependencyType: entity.DependencyTypeDeclared})
+		}
+	}
+
+	// 2. 引用依赖：值中包含 ${namespace:key} 的配置
+	namespaceName := lookup.namespaceName(ctx, upstream.NamespaceID)
+	if namespaceName == "" {
+		return result, nil
+	}
+	expression := "${" + namespaceName + ":" + upstream.Key + "}"
+	candidates, err := s.configRepo.FindByValueContains(ctx, expression)
+	if err != nil {
+		return nil, err
+	}
+	for _, candidate := range candidates {
+		if candidate.ID == upstream.ID || !referencesConfig(candidate.Value, namespaceName, upstream.Key) {
+			continue
+		}
+		// 非默认环境的配置只影响同环境的读取方
+		if upstream.Environment != constants.EnvDefault && candidate.Environment != upstream.Environment {
+			continue
+		}
+		result = append(result, dependent{config: candidate, dependencyType: entity.DependencyTypeReference})
+	}
+
+	return result, nil
+}
+
+// impactedSubscribers 查询变更配置及下游配置所在命名空间和环境的活跃订阅
+func (s *ConfigDependencyService) impactedSubscribers(ctx context.Context, lookup *referenceLookup, analysis *ImpactAnalysis) ([]*ImpactedSubscribers, error) {
+	type scope struct {
+		namespaceID int
+		environment string
+	}
+	scopes := []scope{{analysis.Config.NamespaceID, analysis.Config.Environment}}
+	seen := map[scope]bool{scopes[0]: true}
+	for _, impacted := range analysis.Impacted {
+		sc := scope{impacted.Config.NamespaceID, impacted.Config.Environment}
+		if !seen[sc] {
+			seen[sc] = true
+			scopes = append(scopes, sc)
+		}
+	}
+
+	result := make([]*ImpactedSubscribers, 0, len(scopes))
+	for _, sc := range scopes {
+		subscriptions, err := s.subscriptionRepo.FindActiveSubscriptions(ctx, sc.namespaceID, sc.environment)
+		if err != nil {
+			return nil, err
+		}
+		if len(subscriptions) == 0 {
+			continue
+		}
+		clientIDs := make([]string, 0, len(subscriptions))
+		for _, subscription := range subscriptions {
+			clientIDs = append(clientIDs, subscription.ClientID)
+		}
+		sort.Strings(clientIDs)
+		result = append(result, &ImpactedSubscribers{
+			NamespaceID:   sc.namespaceID,
+			NamespaceName: lookup.namespaceName(ctx, sc.namespaceID),
+			Environment:   sc.environment,
+			ClientIDs:     clientIDs,
+		})
+	}
+	return result, nil
+}
+
+// ==================== 引用解析 ====================
+
+// referenceLookup 单次查询过程中的命名空间缓存
+type referenceLookup struct {
+	svc              *ConfigDependencyService
+	namespacesByID   map[int]*entity.Namespace
+	namespacesByName map[string]*entity.Namespace
+}
+
+// newReferenceLookup 创建引用查找缓存
+func newReferenceLookup(svc *ConfigDependencyService) *referenceLookup {
+	return &referenceLookup{
+		svc:              svc,
+		namespacesByID:   make(map[int]*entity.Namespace),
+		namespacesByName: make(map[string]*entity.Namespace),
+	}
+}
+
+// namespaceName 获取命名空间名称（查询失败或不存在时返回空字符串）
+func (l *referenceLookup) namespaceName(ctx context.Context, namespaceID int) string {
+	namespace, ok := l.namespacesByID[namespaceID]
+	if !ok {
+		namespace, _ = l.svc.namespaceRepo.GetByID(ctx, namespaceID)
+		l.namespacesByID[namespaceID] = namespace
+	}
+	if namespace == nil {
+		return ""
+	}
+	return namespace.Name
+}
+
+// namespaceByName 根据名称查找命名空间
+func (l *referenceLookup) namespaceByName(ctx context.Context, name string) (*entity.Namespace, error) {
+	if namespace, ok := l.namespacesByName[name]; ok {
+		return namespace, nil
+	}
+	namespace, err := l.svc.namespaceRepo.FindByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	l.namespacesByName[name] = namespace
+	if namespace != nil {
+		l.namespacesByID[namespace.ID] = namespace
+	}
+	return namespace, nil
+}
+
+// referenceTargets 解析配置值中引用的配置（按配置所在环境查找，找不到时回退到默认环境）
+func (s *ConfigDependencyService) referenceTargets(ctx context.Context, lookup *referenceLookup, config *entity.Config) ([]*entity.Config, error) {
+	if config.ValueType == constants.ValueTypeEncrypted || !HasConfigReference(config.Value) {
+		return nil, nil
+	}
+
+	var targets []*entity.Config
+	for _, match := range configReferencePattern.FindAllStringSubmatch(config.Value, -1) {
+		if match[0][0] == '$' && match[0][1] == '$' {
+			continue // 转义的字面量
+		}
+		namespace, err := lookup.namespaceByName(ctx, match[1])
+		if err != nil {
+			return nil, err
+		}
+		if namespace == nil {
+			continue
+		}
+
+		environments := []string{config.Environment}
+		if config.Environment != constants.EnvDefault {
+			environments = append(environments, constants.EnvDefault)
+		}
+		for _, environment := range environments {
+			target, err := s.configRepo.FindByNamespaceAndKey(ctx, namespace.ID, match[2], environment)
+			if err != nil {
+				return nil, err
+			}
+			if target != nil {
+				if target.ID != config.ID {
+					targets = append(targets, target)
+				}
+				break
+			}
+		}
+	}
+	return targets, nil
+}
+
+// referencesConfig 判断配置值中是否包含对指定配置的（未转义）引用
+func referencesConfig(value string, namespaceName string, key string) bool {
+	for _, match := range configReferencePattern.FindAllStringSubmatch(value, -1) {
+		if match[0][0] == '$' && match[0][1] == '$' {
+			continue
+		}
+		if match[1] == namespaceName && match[2] == key {
+			return true
+		}
+	}
+	return false
+}
+
+// ==================== 辅助方法 ====================
+
+// getConfig 查询配置，不存在时返回配置不存在错误
+func (s *ConfigDependencyService) getConfig(ctx context.Context, configID int) (*entity.Config, error) {
+	config, err := s.configRepo.GetByID(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, domainErrors.ErrConfigNotFound("", "")
+	}
+	return config, nil
+}
+
+// appendConfig 查询配置并追加到列表（已删除的配置忽略）
+func (s *ConfigDependencyService) appendConfig(ctx context.Context, configs []*entity.Config, configID int) ([]*entity.Config, error) {
+	config, err := s.configRepo.GetByID(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return configs, nil
+	}
+	return append(configs, config), nil
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"config-client/config/domain/entity"
+	domainErrors "config-client/config/domain/errors"
+	"config-client/config/domain/listener"
+	"config-client/config/domain/repository"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// ExpiryAction 配置过期处理方式
+type ExpiryAction string
+
+const (
+	ExpiryActionDeactivate ExpiryAction = "deactivate" // 停用配置（保留配置，可重新激活）
+	ExpiryActionDelete     ExpiryAction = "delete"     // 删除配置（软删除，可从回收站恢复）
+)
+
+const (
+	// DefaultConfigExpiryInterval 过期检查任务默认执行间隔
+	DefaultConfigExpiryInterval = time.Minute
+	// DefaultConfigExpiryBatchSize 过期配置单批默认处理条数
+	DefaultConfigExpiryBatchSize = 100
+)
+
+// ConfigExpiryPolicy 配置过期处理策略
+type ConfigExpiryPolicy struct {
+	Action    ExpiryAction  // 过期处理方式
+	Interval  time.Duration // 检查间隔（<=0 时使用默认值）
+	BatchSize int           // 单批处理条数（<=0 时使用默认值）
+}
+
+// ConfigExpiryService 配置过期处理服务
+// 定期查找到达过期时间的配置，按策略停用或删除，并发布配置变更事件通知订阅方
+type ConfigExpiryService struct {
+	configRepo repository.ConfigRepository
+	configSvc  *ConfigService
+	policy     ConfigExpiryPolicy
+
+	// 主节点选举器 (可选，多实例部署时仅主节点执行)
+	leaderElector LeaderElector
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewConfigExpiryService 创建配置过期处理服务
+func NewConfigExpiryService(
+	configRepo repository.ConfigRepository,
+	configSvc *ConfigService,
+	policy ConfigExpiryPolicy,
+) (*ConfigExpiryService, error) {
+	if policy.Action != ExpiryActionDeactivate && policy.Action != ExpiryActionDelete {
+		return nil, fmt.Errorf("不支持的过期处理方式: %s（可选 deactivate、delete）", policy.Action)
+	}
+	if policy.Interval <= 0 {
+		policy.Interval = DefaultConfigExpiryInterval
+	}
+	if policy.BatchSize <= 0 {
+		policy.BatchSize = DefaultConfigExpiryBatchSize
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &ConfigExpiryService{
+		configRepo: configRepo,
+		configSvc:  configSvc,
+		policy:     policy,
+		ctx:        ctx,
+		cancel:     cancel,
+	}, nil
+}
+
+// SetLeaderElector 设置主节点选举器（多实例部署时仅主节点执行）
+func (s *ConfigExpiryService) SetLeaderElector(elector LeaderElector) {
+	s.leaderElector = elector
+}
+
+// Start 启动后台过期处理任务
+func (s *ConfigExpiryService) Start() {
+	s.wg.Add(1)
+	go s.run()
+	hlog.Infof("配置过期处理任务已启动: action=%s, interval=%v", s.policy.Action, s.policy.Interval)
+}
+
+// Stop 停止后台过期处理任务（等待进行中的批次完成）
+func (s *ConfigExpiryService) Stop() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// run 处理循环
+func (s *ConfigExpiryService) run() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.policy.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			// 多实例部署时仅主节点执行
+			if s.leaderElector != nil && !s.leaderElector.IsLeader() {
+				continue
+			}
+			count, err := s.ExpireDue(s.ctx)
+			if err != nil {
+				hlog.Errorf("处理过期配置失败: expired=%d, err=%v", count, err)
+				continue
+			}
+			if count > 0 {
+				hlog.Infof("已处理 %d 个过期配置: action=%s", count, s.policy.Action)
+			}
+		}
+	}
+}
+
+// ExpireDue 处理当前已到过期时间的配置，返回处理数量
+// 单个配置处理失败时记录日志并继续处理其他配置，下次执行时重试
+func (s *ConfigExpiryService) ExpireDue(ctx context.Context) (int, error) {
+	onlyActive := s.policy.Action == ExpiryActionDeactivate
+	failed := make(map[int]bool)
+	total := 0
+
+	for ctx.Err() == nil {
+		configs, err := s.configRepo.FindExpired(ctx, time.Now(), onlyActive, s.policy.BatchSize+len(failed))
+		if err != nil {
+			return total, err
+		}
+
+		processed := 0
+		for _, config := range configs {
+			if failed[config.ID] {
+				continue
+			}
+			if err := s.configSvc.ExpireConfig(ctx, config.ID, s.policy.Action); err != nil {
+				hlog.CtxErrorf(ctx, "处理过期配置失败: id=%d, key=%s, err=%v", config.ID, config.Key, err)
+				failed[config.ID] = true
+				continue
+			}
+			processed++
+		}
+		total += processed
+
+		// 本批没有可处理的配置，或已取完全部到期配置
+		if processed == 0 || len(configs) < s.policy.BatchSize+len(failed) {
+			return total, nil
+		}
+	}
+	return total, ctx.Err()
+}
+
+// ExpireConfig 按过期处理方式停用或删除已过期的配置
+// 业务规则：
+// 1. 配置不存在、未到过期时间（过期时间已被延后）或已停用时不做处理
+// 2. 停用：配置保留并递增版本号，订阅方据此感知配置失效
+// 3. 删除：软删除进入回收站，已发布的配置同样删除（过期时间由使用方显式设置）
+// 4. 发布配置变更事件并记录 EXPIRE 变更历史
+func (s *ConfigService) ExpireConfig(ctx context.Context, configID int, action ExpiryAction) error {
+	// 1. 重新查询配置，避免处理期间过期时间被修改
+	config, err := s.configRepo.GetByID(ctx, configID)
+	if err != nil {
+		return err
+	}
+	if config == nil || !config.IsExpired(time.Now()) {
+		return nil
+	}
+	if action == ExpiryActionDeactivate && !config.IsActive {
+		return nil
+	}
+
+	oldVersion := config.Version
+	record := &entity.ChangeRecord{
+		ConfigID:    config.ID,
+		NamespaceID: config.NamespaceID,
+		ConfigKey:   config.Key,
+		Environment: config.Environment,
+		Operation:   entity.OperationExpire,
+		OldValue:    config.Value,
+		OldVersion:  oldVersion,
+		Operator:    s.getOperator(ctx),
+		OperatorIP:  s.getOperatorIP(ctx),
+	}
+
+	// 2. 停用或删除配置、记录变更历史并发布配置变更事件
+	event := &listener.ConfigChangeEvent{
+		NamespaceID: config.NamespaceID,
+		ConfigKey:   config.Key,
+		ConfigID:    config.ID,
+		Environment: config.Environment,
+	}
+	switch action {
+	case ExpiryActionDeactivate:
+		config.Deactivate()
+		config.IncrementVersion()
+		event.Action = "update"
+		record.NewValue = config.Value
+		record.NewVersion = config.Version
+		record.ChangeReason = s.getChangeReason(ctx, "配置已过期，自动停用")
+		err = s.withEventTx(ctx, func(txCtx context.Context) error {
+			if err := s.saveConfigUpdate(txCtx, config, oldVersion); err != nil {
+				return err
+			}
+			s.recordChangeHistory(txCtx, record)
+			return s.publishConfigChangeEvent(txCtx, event)
+		})
+	case ExpiryActionDelete:
+		event.Action = "delete"
+		record.ChangeReason = s.getChangeReason(ctx, "配置已过期，自动删除")
+		err = s.withEventTx(ctx, func(txCtx context.Context) error {
+			if err := s.configRepo.Delete(txCtx, config.ID); err != nil {
+				return err
+			}
+			s.recordChangeHistory(txCtx, record)
+			return s.publishConfigChangeEvent(txCtx, event)
+		})
+	default:
+		return fmt.Errorf("不支持的过期处理方式: %s", action)
+	}
+	return err
+}
+
+// validateExpiresAt 校验过期时间（nil 表示永不过期）
+func validateExpiresAt(expiresAt *time.Time, now time.Time) error {
+	if expiresAt != nil && !expiresAt.After(now) {
+		return domainErrors.ErrConfigExpiresAtInvalid("过期时间必须晚于当前时间: " + expiresAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// sameExpiresAt 判断两个过期时间是否相同
+func sameExpiresAt(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+
+	"config-client/config/domain/constants"
+	"config-client/config/domain/entity"
+	domainErrors "config-client/config/domain/errors"
+	"config-client/config/domain/repository"
+)
+
+const (
+	// DefaultConfigFileMaxSize 文件类型配置默认大小上限（10MB）
+	DefaultConfigFileMaxSize int64 = 10 << 20
+	// defaultConfigFileContentType 未指定内容类型时使用的默认值
+	defaultConfigFileContentType = "application/octet-stream"
+)
+
+// ConfigFileUpload 文件上传参数
+type ConfigFileUpload struct {
+	NamespaceID int
+	Key         string
+	Environment string
+	GroupName   string // 为空时新建配置使用默认分组，已有配置保持原值
+	Description string // 为空时已有配置保持原值
+	FileName    string
+	ContentType string
+	Operator    string
+	Content     io.Reader
+}
+
+// ConfigFileResult 文件类型配置及其内容
+type ConfigFileResult struct {
+	Config  *entity.Config
+	File    *entity.ConfigFile
+	Changed bool // 上传时内容是否发生变化（内容哈希相同时不产生新版本）
+}
+
+// ConfigFileService 文件类型配置领域服务
+// 文件内容（证书、logback.xml 等）单独存储，配置值保存内容引用 sha256:<哈希>：
+// 1. 配置版本、发布、变更事件、变更历史沿用普通配置的流程
+// 2. 按内容哈希检测变化，重复上传相同内容不产生新版本
+// 3. 同一配置的历史内容按哈希保留，发布快照和历史版本中的引用始终可下载
+type ConfigFileService struct {
+	fileRepo   repository.ConfigFileRepository
+	configRepo repository.ConfigRepository
+	configSvc  *ConfigService
+	maxSize    int64
+}
+
+// NewConfigFileService 创建文件类型配置服务实例
+// maxSize <= 0 时使用默认上限 10MB
+func NewConfigFileService(
+	fileRepo repository.ConfigFileRepository,
+	configRepo repository.ConfigRepository,
+	configSvc *ConfigService,
+	maxSize int64,
+) *ConfigFileService {
+	if maxSize <= 0 {
+		maxSize = DefaultConfigFileMaxSize
+	}
+	return &ConfigFileService{
+		fileRepo:   fileRepo,
+		configRepo: configRepo,
+		configSvc:  configSvc,
+		maxSize:    maxSize,
+	}
+}
+
+// MaxSize 文件大小上限（字节）
+func (s *ConfigFileService) MaxSize() int64 {
+	return s.maxSize
+}
+
+// UploadFile 上传文件内容，配置不存在时创建文件类型配置
+// 业务规则：
+// 1. 文件不能为空，且不能超过大小上限
+// 2. 同名配置已存在时必须为文件类型，且未发布（与普通配置的更新规则一致）
+// 3. 内容哈希与当前值相同时只更新文件名和内容类型，不产生新版本和变更事件
+// 4. 文件内容与配置写入在同一事务内完成
+func (s *ConfigFileService) UploadFile(ctx context.Context, upload *ConfigFileUpload) (*ConfigFileResult, error) {
+	// 1. 读取并校验文件内容
+	content, err := io.ReadAll(io.LimitReader(upload.Content, s.maxSize+1))
+	if err != nil {
+		return nil, domainErrors.ErrConfigFileInvalid("读取文件内容失败: " + err.Error())
+	}
+	if len(content) == 0 {
+		return nil, domainErrors.ErrConfigFileInvalid("文件内容不能为空")
+	}
+	if int64(len(content)) > s.maxSize {
+		return nil, domainErrors.ErrConfigFileTooLarge(s.maxSize)
+	}
+
+	environment := upload.Environment
+	if environment == "" {
+		environment = constants.EnvDefault
+	}
+	contentType := upload.ContentType
+	if contentType == "" {
+		contentType = defaultConfigFileContentType
+	}
+	sum := sha256.Sum256(content)
+	file := &entity.ConfigFile{
+		SHA256:      hex.EncodeToString(sum[:]),
+		FileName:    upload.FileName,
+		ContentType: contentType,
+		Size:        int64(len(content)),
+		Content:     content,
+		CreatedBy:   upload.Operator,
+	}
+	reference := entity.FileReference(file.SHA256)
+
+	// 2. 查询已有配置
+	existing, err := s.configRepo.FindByNamespaceAndKey(ctx, upload.NamespaceID, upload.Key, environment)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.ValueType != constants.ValueTypeFile {
+		return nil, domainErrors.ErrConfigFileInvalid("同名配置已存在且不是文件类型: key=" + upload.Key)
+	}
+
+	// 3. 内容未变化：只更新文件元信息
+	if existing != nil && existing.Value == reference {
+		file.ConfigID = existing.ID
+		if err := s.fileRepo.Save(ctx, file); err != nil {
+			return nil, err
+		}
+		return s.result(existing, file, false), nil
+	}
+
+	// 4. 在事务中保存文件内容并创建或更新配置
+	var config *entity.Config
+	err = s.configRepo.WithTx(ctx, func(txCtx context.Context) error {
+		if existing == nil {
+			config = &entity.Config{
+				NamespaceID: upload.NamespaceID,
+				Key:         upload.Key,
+				Value:       reference,
+				GroupName:   upload.GroupName,
+				ValueType:   constants.ValueTypeFile,
+				Environment: environment,
+				Description: upload.Description,
+				Metadata:    "{}",
+			}
+			config.CreatedBy = upload.Operator
+			config.UpdatedBy = upload.Operator
+			if err := s.configSvc.CreateConfig(txCtx, config); err != nil {
+				return err
+			}
+		} else {
+			config = &entity.Config{
+				NamespaceID: existing.NamespaceID,
+				Key:         existing.Key,
+				Environment: existing.Environment,
+				Value:       reference,
+				GroupName:   existing.GroupName,
+				ValueType:   constants.ValueTypeFile,
+				Description: existing.Description,
+				Metadata:    existing.Metadata,
+				ExpiresAt:   existing.ExpiresAt,
+			}
+			if upload.GroupName != "" {
+				config.GroupName = upload.GroupName
+			}
+			if upload.Description != "" {
+				config.Description = upload.Description
+			}
+			config.ID = existing.ID
+			config.Version = existing.Version // 乐观锁：避免覆盖并发上传
+			config.UpdatedBy = upload.Operator
+			if err := s.configSvc.UpdateConfig(txCtx, config); err != nil {
+				return err
+			}
+		}
+
+		file.ConfigID = config.ID
+		return s.fileRepo.Save(txCtx, file)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 5. 返回最新配置
+	latest, err := s.configRepo.GetByID(ctx, config.ID)
+	if err != nil {
+		return nil, err
+	}
+	if latest == nil {
+		latest = config
+	}
+	return s.result(latest, file, true), nil
+}
+
+// GetFile 根据配置ID获取文件（不限发布状态，用于管理端查看和下载）
+func (s *ConfigFileService) GetFile(ctx context.Context, configID int, withContent bool) (*ConfigFileResult, error) {
+	config, err := s.configRepo.GetByID(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, domainErrors.ErrConfigNotFound("", "")
+	}
+	return s.loadFile(ctx, config, withContent)
+}
+
+// GetActiveFile 根据配置键获取已发布且已激活的文件（用于客户端下载）
+func (s *ConfigFileService) GetActiveFile(ctx context.Context, namespaceID int, key string, environment string, withContent bool) (*ConfigFileResult, error) {
+	if environment == "" {
+		environment = constants.EnvDefault
+	}
+	config, err := s.configSvc.GetActiveConfig(ctx, namespaceID, key, environment)
+	if err != nil {
+		return nil, err
+	}
+	return s.loadFile(ctx, config, withContent)
+}
+
+// loadFile 按配置值中的内容引用加载文件
+func (s *ConfigFileService) loadFile(ctx context.Context, config *entity.Config, withContent bool) (*ConfigFileResult, error) {
+	if config.ValueType != constants.ValueTypeFile {
+		return nil, domainErrors.ErrConfigFileInvalid("配置不是文件类型: key=" + config.Key)
+	}
+	hash, ok := entity.ParseFileReference(config.Value)
+	if !ok {
+		return nil, domainErrors.ErrConfigFileInvalid("文件内容引用无效: key=" + config.Key)
+	}
+
+	file, err := s.fileRepo.FindByHash(ctx, config.ID, hash, withContent)
+	if err != nil {
+		return nil, err
+	}
+	if file == nil {
+		return nil, domainErrors.ErrConfigFileNotFound(config.Key)
+	}
+	return &ConfigFileResult{Config: config, File: file}, nil
+}
+
+// result 组装上传结果（不返回文件内容）
+func (s *ConfigFileService) result(config *entity.Config, file *entity.ConfigFile, changed bool) *ConfigFileResult {
+	info := *file
+	info.Content = nil
+	return &ConfigFileResult{Config: config, File: &info, Changed: changed}
+}
@@ -0,0 +1,493 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"config-client/config/domain/constants"
+	domainErrors "config-client/config/domain/errors"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigEntry 配置条目
+// 配置文件解析后的扁平化键值对，嵌套结构的键使用点号连接
+type ConfigEntry struct {
+	Key       string // 配置键
+	Value     string // 配置值
+	ValueType string // 推断出的值类型
+}
+
+// ParseConfigContent 解析配置内容
+// 支持 yaml、json、properties、env 四种格式，返回按键排序的配置条目列表
+// 规则：
+// 1. yaml/json 中的嵌套对象展开为点号连接的键，例如 db.host
+// 2. yaml/json 中的数组序列化为 JSON 字符串，值类型为 json
+// 3. properties/env 中的值一律按 string 处理
+func ParseConfigContent(format string, content string) ([]*ConfigEntry, error) {
+	var (
+		entries []*ConfigEntry
+		err     error
+	)
+
+	switch normalizeFormat(format) {
+	case constants.ConfigFormatYAML:
+		entries, err = parseYAMLContent(content)
+	case constants.ConfigFormatJSON:
+		entries, err = parseJSONContent(content)
+	case constants.ConfigFormatProperties:
+		entries, err = parsePropertiesContent(content)
+	case constants.ConfigFormatEnv:
+		entries, err = parseEnvContent(content)
+	default:
+		return nil, domainErrors.ErrConfigFormatUnsupported(format)
+	}
+
+	if err != nil {
+		return nil, domainErrors.ErrConfigContentParseFailed(format, err)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+	return entries, nil
+}
+
+// normalizeFormat 规范化格式名称，兼容 yml、dotenv 等别名
+func normalizeFormat(format string) string {
+	format = strings.ToLower(strings.TrimSpace(format))
+	switch format {
+	case "yml":
+		return constants.ConfigFormatYAML
+	case "dotenv":
+		return constants.ConfigFormatEnv
+	}
+	return format
+}
+
+// parseYAMLContent 解析YAML内容
+func parseYAMLContent(content string) ([]*ConfigEntry, error) {
+	var root map[string]interface{}
+	if err := yaml.Unmarshal([]byte(content), &root); err != nil {
+		return nil, err
+	}
+
+	entries := make([]*ConfigEntry, 0, len(root))
+	if err := flattenConfigValue("", root, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// parseJSONContent 解析JSON内容
+// 使用 UseNumber 保留数字的原始形式，以便区分整数和浮点数
+func parseJSONContent(content string) ([]*ConfigEntry, error) {
+	decoder := json.NewDecoder(strings.NewReader(content))
+	decoder.UseNumber()
+
+	var root map[string]interface{}
+	if err := decoder.Decode(&root); err != nil {
+		return nil, err
+	}
+
+	entries := make([]*ConfigEntry, 0, len(root))
+	if err := flattenConfigValue("", root, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// flattenConfigValue 将嵌套结构展开为扁平的配置条目
+func flattenConfigValue(prefix string, value interface{}, entries *[]*ConfigEntry) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if err := flattenConfigValue(joinConfigKey(prefix, key), child, entries); err != nil {
+				return err
+			}
+		}
+		return nil
+	case map[interface{}]interface{}:
+		for key, child := range v {
+			if err := flattenConfigValue(joinConfigKey(prefix, fmt.Sprint(key)), child, entries); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if prefix == "" {
+		return fmt.Errorf("顶层结构必须是对象")
+	}
+
+	entry := &ConfigEntry{Key: prefix}
+	switch v := value.(type) {
+	case nil:
+		entry.Value = ""
+		entry.ValueType = constants.ValueTypeString
+	case string:
+		entry.Value = v
+		entry.ValueType = constants.ValueTypeString
+	case bool:
+		entry.Value = strconv.FormatBool(v)
+		entry.ValueType = constants.ValueTypeBool
+	case int:
+		entry.Value = strconv.Itoa(v)
+		entry.ValueType = constants.ValueTypeInt
+	case int64:
+		entry.Value = strconv.FormatInt(v, 10)
+		entry.ValueType = constants.ValueTypeInt
+	case uint64:
+		entry.Value = strconv.FormatUint(v, 10)
+		entry.ValueType = constants.ValueTypeInt
+	case float64:
+		entry.Value = strconv.FormatFloat(v, 'f', -1, 64)
+		entry.ValueType = constants.ValueTypeFloat
+	case json.Number:
+		entry.Value = v.String()
+		if _, err := v.Int64(); err == nil {
+			entry.ValueType = constants.ValueTypeInt
+		} else {
+			entry.ValueType = constants.ValueTypeFloat
+		}
+	default:
+		// 数组等复杂结构序列化为JSON
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("无法序列化配置 %s: %w", prefix, err)
+		}
+		entry.Value = string(data)
+		entry.ValueType = constants.ValueTypeJSON
+	}
+
+	*entries = append(*entries, entry)
+	return nil
+}
+
+// joinConfigKey 使用点号连接配置键
+func joinConfigKey(prefix string, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+// parsePropertiesContent 解析Java properties内容
+// 支持 = 和 : 分隔符、# 和 ! 注释以及行尾反斜杠续行
+func parsePropertiesContent(content string) ([]*ConfigEntry, error) {
+	entries := make([]*ConfigEntry, 0)
+	seen := make(map[string]int)
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var logical bytes.Buffer
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimLeft(scanner.Text(), " \t\f")
+
+		// 续行：去掉行尾反斜杠后拼接下一行
+		if logical.Len() == 0 && (line == "" || line[0] == '#' || line[0] == '!') {
+			continue
+		}
+		if strings.HasSuffix(line, "\\") && !strings.HasSuffix(line, "\\\\") {
+			logical.WriteString(strings.TrimSuffix(line, "\\"))
+			continue
+		}
+		logical.WriteString(line)
+
+		key, value := splitPropertiesLine(logical.String())
+		logical.Reset()
+		if key == "" {
+			return nil, fmt.Errorf("第 %d 行缺少配置键", lineNo)
+		}
+
+		appendConfigEntry(&entries, seen, key, value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if logical.Len() > 0 {
+		key, value := splitPropertiesLine(logical.String())
+		appendConfigEntry(&entries, seen, key, value)
+	}
+
+	return entries, nil
+}
+
+// splitPropertiesLine 拆分properties的键和值
+func splitPropertiesLine(line string) (string, string) {
+	idx := strings.IndexAny(line, "=: \t")
+	if idx < 0 {
+		return unescapeProperties(line), ""
+	}
+
+	key := line[:idx]
+	rest := strings.TrimLeft(line[idx:], " \t")
+	if rest != "" && (rest[0] == '=' || rest[0] == ':') {
+		rest = strings.TrimLeft(rest[1:], " \t")
+	}
+	return unescapeProperties(key), unescapeProperties(rest)
+}
+
+// unescapeProperties 处理properties中的常见转义字符
+func unescapeProperties(s string) string {
+	if !strings.Contains(s, "\\") {
+		return s
+	}
+	replacer := strings.NewReplacer(`\n`, "\n", `\t`, "\t", `\r`, "\r", `\=`, "=", `\:`, ":", `\ `, " ", `\\`, `\`)
+	return replacer.Replace(s)
+}
+
+// parseEnvContent 解析dotenv内容
+// 支持 export 前缀、单双引号包裹的值以及 # 注释
+func parseEnvContent(content string) ([]*ConfigEntry, error) {
+	entries := make([]*ConfigEntry, 0)
+	seen := make(map[string]int)
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		idx := strings.Index(line, "=")
+		if idx <= 0 {
+			return nil, fmt.Errorf("第 %d 行格式无效，应为 KEY=VALUE", lineNo)
+		}
+
+		key := strings.TrimSpace(line[:idx])
+		value := strings.TrimSpace(line[idx+1:])
+		value = unquoteEnvValue(value)
+
+		appendConfigEntry(&entries, seen, key, value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// unquoteEnvValue 去除dotenv值的引号和行内注释
+func unquoteEnvValue(value string) string {
+	if len(value) >= 2 {
+		switch {
+		case value[0] == '"' && value[len(value)-1] == '"':
+			if unquoted, err := strconv.Unquote(value); err == nil {
+				return unquoted
+			}
+			return value[1 : len(value)-1]
+		case value[0] == '\'' && value[len(value)-1] == '\'':
+			return value[1 : len(value)-1]
+		}
+	}
+
+	// 未加引号的值，去掉 " #" 之后的行内注释
+	if idx := strings.Index(value, " #"); idx >= 0 {
+		value = strings.TrimSpace(value[:idx])
+	}
+	return value
+}
+
+// appendConfigEntry 追加字符串类型的配置条目，重复的键以后出现的为准
+func appendConfigEntry(entries *[]*ConfigEntry, seen map[string]int, key string, value string) {
+	if idx, ok := seen[key]; ok {
+		(*entries)[idx].Value = value
+		return
+	}
+	seen[key] = len(*entries)
+	*entries = append(*entries, &ConfigEntry{
+		Key:       key,
+		Value:     value,
+		ValueType: constants.ValueTypeString,
+	})
+}
+
+// ==================== 配置渲染 ====================
+
+// RenderConfigContent 将配置条目渲染为指定格式的文档
+// 规则：
+// 1. yaml/json 按点号还原嵌套结构，并按值类型输出数字、布尔值和JSON值
+// 2. 键冲突（同时作为叶子和前缀）时保留点号连接的扁平键
+// 3. properties/env 按键排序逐行输出
+func RenderConfigContent(format string, entries []*ConfigEntry) (string, error) {
+	sorted := make([]*ConfigEntry, len(entries))
+	copy(sorted, entries)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Key < sorted[j].Key
+	})
+
+	switch normalizeFormat(format) {
+	case constants.ConfigFormatYAML:
+		data, err := yaml.Marshal(buildNestedConfig(sorted))
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	case constants.ConfigFormatJSON:
+		data, err := json.MarshalIndent(buildNestedConfig(sorted), "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(data) + "\n", nil
+	case constants.ConfigFormatProperties:
+		var buf strings.Builder
+		for _, entry := range sorted {
+			buf.WriteString(escapeProperties(entry.Key, true))
+			buf.WriteString("=")
+			buf.WriteString(escapeProperties(entry.Value, false))
+			buf.WriteString("\n")
+		}
+		return buf.String(), nil
+	case constants.ConfigFormatEnv:
+		var buf strings.Builder
+		for _, entry := range sorted {
+			buf.WriteString(entry.Key)
+			buf.WriteString("=")
+			buf.WriteString(quoteEnvValue(entry.Value))
+			buf.WriteString("\n")
+		}
+		return buf.String(), nil
+	default:
+		return "", domainErrors.ErrConfigFormatUnsupported(format)
+	}
+}
+
+// ConfigFormatContentType 获取格式对应的 Content-Type
+func ConfigFormatContentType(format string) string {
+	switch normalizeFormat(format) {
+	case constants.ConfigFormatYAML:
+		return "application/yaml; charset=utf-8"
+	case constants.ConfigFormatJSON:
+		return "application/json; charset=utf-8"
+	default:
+		return "text/plain; charset=utf-8"
+	}
+}
+
+// buildNestedConfig 按点号还原嵌套结构
+func buildNestedConfig(entries []*ConfigEntry) map[string]interface{} {
+	root := make(map[string]interface{})
+	flat := make(map[string]interface{})
+
+	for _, entry := range entries {
+		value := typedConfigValue(entry)
+		parts := strings.Split(entry.Key, ".")
+
+		node := root
+		conflict := false
+		for i, part := range parts {
+			if i == len(parts)-1 {
+				if _, exists := node[part]; exists {
+					conflict = true
+				} else {
+					node[part] = value
+				}
+				break
+			}
+
+			child, exists := node[part]
+			if !exists {
+				next := make(map[string]interface{})
+				node[part] = next
+				node = next
+				continue
+			}
+			next, ok := child.(map[string]interface{})
+			if !ok {
+				conflict = true
+				break
+			}
+			node = next
+		}
+
+		if conflict {
+			flat[entry.Key] = value
+		}
+	}
+
+	for key, value := range flat {
+		root[key] = value
+	}
+	return root
+}
+
+// typedConfigValue 按值类型转换配置值，转换失败时按字符串输出
+func typedConfigValue(entry *ConfigEntry) interface{} {
+	value := strings.TrimSpace(entry.Value)
+
+	switch entry.ValueType {
+	case constants.ValueTypeInt:
+		if v, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return v
+		}
+	case constants.ValueTypeFloat:
+		if v, err := strconv.ParseFloat(value, 64); err == nil {
+			return v
+		}
+	case constants.ValueTypeBool:
+		if v, err := strconv.ParseBool(value); err == nil {
+			return v
+		}
+	case constants.ValueTypeJSON:
+		var v interface{}
+		if err := json.Unmarshal([]byte(value), &v); err == nil {
+			return v
+		}
+	}
+	return entry.Value
+}
+
+// escapeProperties 转义properties中的特殊字符
+func escapeProperties(s string, isKey bool) string {
+	var buf strings.Builder
+	for i, r := range s {
+		switch r {
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		case '=', ':':
+			if isKey {
+				buf.WriteByte('\\')
+			}
+			buf.WriteRune(r)
+		case ' ':
+			if isKey || i == 0 {
+				buf.WriteByte('\\')
+			}
+			buf.WriteRune(r)
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	return buf.String()
+}
+
+// quoteEnvValue 为dotenv值添加必要的引号
+func quoteEnvValue(value string) string {
+	if value == "" {
+		return ""
+	}
+	if strings.ContainsAny(value, " \t\n\r\"'#$\\=") {
+		return strconv.Quote(value)
+	}
+	return value
+}
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"unicode/utf8"
+
+	"config-client/config/domain/constants"
+	"config-client/config/domain/entity"
+	domainErrors "config-client/config/domain/errors"
+	"config-client/config/domain/repository"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// maxGroupNameLength 分组名称最大长度（与 t_configs.group_name 一致）
+const maxGroupNameLength = 255
+
+// ConfigGroupService 配置分组领域服务
+// 负责配置分组的管理、分组统计，以及按分组批量发布配置
+type ConfigGroupService struct {
+	groupRepo     repository.ConfigGroupRepository
+	configRepo    repository.ConfigRepository
+	namespaceRepo repository.NamespaceRepository
+	configSvc     *ConfigService
+}
+
+// NewConfigGroupService 创建配置分组服务实例
+func NewConfigGroupService(
+	groupRepo repository.ConfigGroupRepository,
+	configRepo repository.ConfigRepository,
+	namespaceRepo repository.NamespaceRepository,
+	configSvc *ConfigService,
+) *ConfigGroupService {
+	return &ConfigGroupService{
+		groupRepo:     groupRepo,
+		configRepo:    configRepo,
+		namespaceRepo: namespaceRepo,
+		configSvc:     configSvc,
+	}
+}
+
+// ConfigGroupSummary 配置分组概览
+type ConfigGroupSummary struct {
+	Group      *entity.ConfigGroup         // 分组（未登记的分组只有命名空间和名称）
+	Registered bool                        // 是否已登记为分组实体（否则仅被配置的 group_name 引用）
+	Stats      *repository.ConfigGroupStat // 分组统计
+}
+
+// ==================== 分组管理 ====================
+
+// CreateGroup 创建配置分组
+// 业务规则：
+// 1. 命名空间必须存在
+// 2. 分组名称与配置键命名规范一致（字母、数字、下划线、中划线、点号），长度不超过 255
+// 3. 同一命名空间下分组名称唯一
+func (s *ConfigGroupService) CreateGroup(ctx context.Context, group *entity.ConfigGroup) error {
+	// 1. 检查命名空间
+	namespace, err := s.namespaceRepo.GetByID(ctx, group.NamespaceID)
+	if err != nil {
+		return err
+	}
+	if namespace == nil {
+		return domainErrors.ErrNamespaceNotFound("")
+	}
+
+	// 2. 校验分组名称
+	if !isValidConfigKey(group.Name) {
+		return domainErrors.ErrConfigGroupNameInvalid(group.Name, "只允许字母、数字、下划线、中划线、点号")
+	}
+	if utf8.RuneCountInString(group.Name) > maxGroupNameLength {
+		return domainErrors.ErrConfigGroupNameInvalid(group.Name, "长度不能超过 255")
+	}
+
+	// 3. 检查分组是否已存在
+	existing, err := s.groupRepo.FindByName(ctx, group.NamespaceID, group.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return domainErrors.ErrConfigGroupAlreadyExists(group.Name)
+	}
+
+	// 4. 保存
+	return s.groupRepo.Create(ctx, group)
+}
+
+// UpdateGroup 更新配置分组的描述和展示顺序（分组名称不可修改）
+func (s *ConfigGroupService) UpdateGroup(ctx context.Context, group *entity.ConfigGroup) error {
+	// 1. 检查是否存在
+	existing, err := s.GetByID(ctx, group.ID)
+	if err != nil {
+		return err
+	}
+
+	// 2. 更新并保存
+	existing.UpdateInfo(group.Description, group.SortOrder)
+	existing.UpdatedBy = group.UpdatedBy
+	if err := s.groupRepo.Update(ctx, existing); err != nil {
+		return err
+	}
+
+	*group = *existing
+	return nil
+}
+
+// DeleteGroup 删除配置分组
+// 业务规则：分组下仍有配置（任意环境）时不允许删除，需先迁移或删除这些配置
+func (s *ConfigGroupService) DeleteGroup(ctx context.Context, id int) error {
+	// 1. 检查是否存在
+	group, err := s.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// 2. 检查分组下是否还有配置
+	configs, err := s.configRepo.FindByGroup(ctx, group.NamespaceID, group.Name)
+	if err != nil {
+		return err
+	}
+	if len(configs) > 0 {
+		return domainErrors.ErrConfigGroupNotEmpty(group.Name, len(configs))
+	}
+
+	// 3. 删除
+	return s.groupRepo.Delete(ctx, id)
+}
+
+// GetByID 根据ID获取配置分组
+func (s *ConfigGroupService) GetByID(ctx context.Context, id int) (*entity.ConfigGroup, error) {
+	group, err := s.groupRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, domainErrors.ErrConfigGroupNotFoundByID(id)
+	}
+	return group, nil
+}
+
+// ==================== 分组统计 ====================
+
+// ListGroups 查询命名空间下的配置分组及统计
+// 业务规则：
+// 1. 已登记的分组按展示顺序、名称排在前面
+// 2. 仅被配置 group_name 引用、尚未登记的分组按名称排在后面（便于逐步登记存量分组）
+// 3. environment 不为空时只统计该环境的配置
+func (s *ConfigGroupService) ListGroups(ctx context.Context, namespaceID int, environment string) ([]*ConfigGroupSummary, error) {
+	// 1. 查询已登记的分组和分组统计
+	groups, err := s.groupRepo.FindByNamespace(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	statsByName, err := s.groupStats(ctx, namespaceID, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 已登记的分组
+	summaries := make([]*ConfigGroupSummary, 0, len(groups)+len(statsByName))
+	for _, group := range groups {
+		summaries = append(summaries, &ConfigGroupSummary{
+			Group:      group,
+			Registered: true,
+			Stats:      statsOrEmpty(statsByName, group.Name),
+		})
+		delete(statsByName, group.Name)
+	}
+
+	// 3. 未登记的分组
+	names := make([]string, 0, len(statsByName))
+	for name := range statsByName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		summaries = append(summaries, &ConfigGroupSummary{
+			Group:      &entity.ConfigGroup{NamespaceID: namespaceID, Name: name},
+			Registered: false,
+			Stats:      statsByName[name],
+		})
+	}
+
+	return summaries, nil
+}
+
+// GetGroupSummary 获取单个配置分组及统计
+func (s *ConfigGroupService) GetGroupSummary(ctx context.Context, id int, environment string) (*ConfigGroupSummary, error) {
+	group, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	statsByName, err := s.groupStats(ctx, group.NamespaceID, environment)
+	if err != nil {
+		return nil, err
+	}
+	return &ConfigGroupSummary{
+		Group:      group,
+		Registered: true,
+		Stats:      statsOrEmpty(statsByName, group.Name),
+	}, nil
+}
+
+// groupStats 查询命名空间下的分组统计，按分组名称索引
+func (s *ConfigGroupService) groupStats(ctx context.Context, namespaceID int, environment string) (map[string]*repository.ConfigGroupStat, error) {
+	stats, err := s.configRepo.CountByGroup(ctx, namespaceID, environment)
+	if err != nil {
+		return nil, err
+	}
+	statsByName := make(map[string]*repository.ConfigGroupStat, len(stats))
+	for _, stat := range stats {
+		statsByName[stat.GroupName] = stat
+	}
+	return statsByName, nil
+}
+
+// statsOrEmpty 获取分组统计，分组下没有配置时返回空统计
+func statsOrEmpty(statsByName map[string]*repository.ConfigGroupStat, name string) *repository.ConfigGroupStat {
+	if stat, ok := statsByName[name]; ok {
+		return stat
+	}
+	return &repository.ConfigGroupStat{GroupName: name}
+}
+
+// ==================== 分组操作 ====================
+
+// ConfigGroupReleaseResult 分组发布结果
+type ConfigGroupReleaseResult struct {
+	Group             *entity.ConfigGroup
+	Environment       string
+	ReleasedConfigIDs []int // 本次发布的配置ID
+}
+
+// ReleaseGroup 发布整个分组
+// 业务规则：
+// 1. 环境为空时使用默认环境
+// 2. 发布分组在该环境下所有未发布的已激活配置，已发布和未激活的配置跳过
+// 3. 单个配置发布失败不影响其他配置，结果中只包含发布成功的配置
+func (s *ConfigGroupService) ReleaseGroup(ctx context.Context, id int, environment string) (*ConfigGroupReleaseResult, error) {
+	// 1. 校验环境
+	if environment == "" {
+		environment = constants.EnvDefault
+	}
+	if !contains(constants.ValidEnvironments, environment) {
+		return nil, domainErrors.ErrConfigEnvironmentInvalid(environment)
+	}
+
+	// 2. 查询分组
+	group, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 批量发布
+	releasedIDs, err := s.configSvc.BatchReleaseConfigs(ctx, group.NamespaceID, environment, group.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	hlog.CtxInfof(ctx, "分组发布完成: namespace=%d, group=%s, env=%s, released=%d",
+		group.NamespaceID, group.Name, environment, len(releasedIDs))
+
+	return &ConfigGroupReleaseResult{
+		Group:             group,
+		Environment:       environment,
+		ReleasedConfigIDs: releasedIDs,
+	}, nil
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"config-client/config/domain/cache"
+	"config-client/config/domain/listener"
+	"config-client/config/domain/repository"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// ConfigCacheStats 配置读取缓存统计
+type ConfigCacheStats struct {
+	Hits          int64   // 命中次数
+	Misses        int64   // 未命中次数（回源查询数据库）
+	Errors        int64   // 缓存读写失败次数（失败时直接查询数据库）
+	Invalidations int64   // 收到变更事件后的失效次数
+	HitRatio      float64 // 命中率
+}
+
+// ConfigReadCache 配置读取缓存服务
+// 长轮询和订阅管理器比较版本时优先读取缓存，未命中时查询数据库并写入缓存
+// 业务规则：
+// 1. 缓存按 命名空间ID:配置键:环境 存储配置值及版本
+// 2. 收到配置变更事件时失效对应缓存（事件未带环境时失效所有环境），有效期作为事件丢失时的兜底
+// 3. 不缓存不存在的配置，避免新建配置后在事件到达前持续返回旧结果
+// 4. 缓存不可用时退回数据库查询，不影响长轮询
+type ConfigReadCache struct {
+	store      cache.ConfigCache
+	configRepo repository.ConfigRepository
+
+	hits          atomic.Int64
+	misses        atomic.Int64
+	errors        atomic.Int64
+	invalidations atomic.Int64
+}
+
+// NewConfigReadCache 创建配置读取缓存服务
+func NewConfigReadCache(store cache.ConfigCache, configRepo repository.ConfigRepository) *ConfigReadCache {
+	return &ConfigReadCache{
+		store:      store,
+		configRepo: configRepo,
+	}
+}
+
+// Load 读取配置值及版本（配置不存在时返回 nil）
+func (c *ConfigReadCache) Load(ctx context.Context, namespaceID int, configKey string, environment string) (*cache.CachedConfig, error) {
+	environment = normalizeEnvironment(environment)
+
+	// 1. 查询缓存
+	cached, err := c.store.Get(ctx, namespaceID, configKey, environment)
+	if err != nil {
+		c.errors.Add(1)
+		hlog.CtxWarnf(ctx, "读取配置缓存失败，查询数据库: namespace=%d, key=%s, env=%s, err=%v",
+			namespaceID, configKey, environment, err)
+	}
+	if cached != nil {
+		c.hits.Add(1)
+		return cached, nil
+	}
+	c.misses.Add(1)
+
+	// 2. 查询数据库
+	config, err := c.configRepo.FindByNamespaceAndKey(ctx, namespaceID, configKey, environment)
+	if err != nil || config == nil {
+		return nil, err
+	}
+
+	// 3. 写入缓存
+	cached = &cache.CachedConfig{
+		Value:    config.Value,
+		Version:  ComputeVersion(config.Value),
+		CachedAt: time.Now(),
+	}
+	if err := c.store.Set(ctx, namespaceID, configKey, environment, cached); err != nil {
+		c.errors.Add(1)
+		hlog.CtxWarnf(ctx, "写入配置缓存失败: namespace=%d, key=%s, env=%s, err=%v",
+			namespaceID, configKey, environment, err)
+	}
+	return cached, nil
+}
+
+// LoadVersions 批量读取配置版本
+// 先逐个查询缓存，未命中的配置通过一次批量查询获取版本并写入缓存（只缓存版本）
+// 返回：配置定位 -> 版本，不存在的配置不在结果中
+func (c *ConfigReadCache) LoadVersions(ctx context.Context, keys []repository.NamespaceKeyEnv) (map[repository.NamespaceKeyEnv]string, error) {
+	versions := make(map[repository.NamespaceKeyEnv]string, len(keys))
+
+	// 1. 查询缓存
+	misses := make([]repository.NamespaceKeyEnv, 0, len(keys))
+	for _, key := range keys {
+		key.Environment = normalizeEnvironment(key.Environment)
+		cached, err := c.store.Get(ctx, key.NamespaceID, key.Key, key.Environment)
+		if err != nil {
+			c.errors.Add(1)
+			hlog.CtxWarnf(ctx, "读取配置缓存失败，查询数据库: namespace=%d, key=%s, env=%s, err=%v",
+				key.NamespaceID, key.Key, key.Environment, err)
+		}
+		if cached != nil {
+			c.hits.Add(1)
+			versions[key] = cached.Version
+			continue
+		}
+		c.misses.Add(1)
+		misses = append(misses, key)
+	}
+	if len(misses) == 0 {
+		return versions, nil
+	}
+
+	// 2. 批量查询未命中的配置
+	found, err := c.configRepo.FindVersionsByKeys(ctx, misses)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 写入缓存
+	now := time.Now()
+	for key, version := range found {
+		versions[key] = version
+		if err := c.store.Set(ctx, key.NamespaceID, key.Key, key.Environment, &cache.CachedConfig{Version: version, CachedAt: now}); err != nil {
+			c.errors.Add(1)
+			hlog.CtxWarnf(ctx, "写入配置缓存失败: namespace=%d, key=%s, env=%s, err=%v",
+				key.NamespaceID, key.Key, key.Environment, err)
+		}
+	}
+	return versions, nil
+}
+
+// Invalidate 按配置变更事件失效缓存
+func (c *ConfigReadCache) Invalidate(ctx context.Context, event *listener.ConfigChangeEvent) {
+	environment := ""
+	if event.Environment != "" {
+		environment = normalizeEnvironment(event.Environment)
+	}
+	if err := c.store.Invalidate(ctx, event.NamespaceID, event.ConfigKey, environment); err != nil {
+		c.errors.Add(1)
+		hlog.CtxErrorf(ctx, "失效配置缓存失败: namespace=%d, key=%s, env=%s, err=%v",
+			event.NamespaceID, event.ConfigKey, environment, err)
+		return
+	}
+	c.invalidations.Add(1)
+}
+
+// GetStats 获取缓存统计
+func (c *ConfigReadCache) GetStats() *ConfigCacheStats {
+	stats := &ConfigCacheStats{
+		Hits:          c.hits.Load(),
+		Misses:        c.misses.Load(),
+		Errors:        c.errors.Load(),
+		Invalidations: c.invalidations.Load(),
+	}
+	if total := stats.Hits + stats.Misses; total > 0 {
+		stats.HitRatio = float64(stats.Hits) / float64(total)
+	}
+	return stats
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+
+	"config-client/config/domain/constants"
+	"config-client/config/domain/entity"
+	domainErrors "config-client/config/domain/errors"
+	"config-client/config/domain/repository"
+)
+
+// maxReferenceDepth 配置引用的最大嵌套层级
+const maxReferenceDepth = 10
+
+// configReferencePattern 配置引用表达式：${namespace:key}
+// 以 $$ 开头的表达式视为转义，输出字面量 ${namespace:key}
+var configReferencePattern = regexp.MustCompile(`\$?\$\{([a-zA-Z0-9_-]+):([a-zA-Z0-9_.-]+)\}`)
+
+// HasConfigReference 判断配置值中是否包含引用表达式
+func HasConfigReference(value string) bool {
+	return strings.Contains(value, "${") && configReferencePattern.MatchString(value)
+}
+
+// ResolvedConfig 引用解析结果
+type ResolvedConfig struct {
+	Value      string   // 解析后的配置值
+	References []string // 直接或间接引用的配置（namespace:key），已排序
+	Sensitive  bool     // 是否引用了敏感配置
+}
+
+// ConfigReferenceResolver 配置引用解析服务
+// 负责在读取配置时解析值中的 ${namespace:key} 引用，便于多个命名空间共享公共配置（如服务地址）
+// 业务规则：
+// 1. 引用按读取方所在环境查找，找不到时回退到默认环境
+// 2. 仅解析已发布且已激活的配置
+// 3. 支持递归解析，检测循环引用并限制最大层级
+// 4. 引用了敏感配置的结果会被标记为敏感，由调用方决定是否脱敏
+type ConfigReferenceResolver struct {
+	configRepo    repository.ConfigRepository
+	namespaceRepo repository.NamespaceRepository
+	maskingSvc    *MaskingService // 脱敏服务（可选，用于解密被引用的加密配置）
+}
+
+// NewConfigReferenceResolver 创建配置引用解析服务实例
+func NewConfigReferenceResolver(
+	configRepo repository.ConfigRepository,
+	namespaceRepo repository.NamespaceRepository,
+	maskingSvc *MaskingService,
+) *ConfigReferenceResolver {
+	return &ConfigReferenceResolver{
+		configRepo:    configRepo,
+		namespaceRepo: namespaceRepo,
+		maskingSvc:    maskingSvc,
+	}
+}
+
+// resolveState 单次解析过程的状态（缓存命名空间和配置查询结果）
+type resolveState struct {
+	environment string
+	namespaces  map[string]*entity.Namespace
+	configs     map[string]*entity.Config
+	references  map[string]bool
+	sensitive   bool
+}
+
+// Resolve 解析配置值中的引用
+// 配置本身为加密类型时，先解密再解析
+func (r *ConfigReferenceResolver) Resolve(ctx context.Context, config *entity.Config) (*ResolvedConfig, error) {
+	state := &resolveState{
+		environment: config.Environment,
+		namespaces:  make(map[string]*entity.Namespace),
+		configs:     make(map[string]*entity.Config),
+		references:  make(map[string]bool),
+	}
+
+	// 1. 以配置自身作为引用链起点，用于检测自引用
+	namespace, err := r.namespaceRepo.GetByID(ctx, config.NamespaceID)
+	if err != nil {
+		return nil, err
+	}
+	chain := make([]string, 0, 1)
+	if namespace != nil {
+		chain = append(chain, namespace.Name+":"+config.Key)
+	}
+
+	// 2. 递归解析
+	value, err := r.plainValue(config)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := r.resolveValue(ctx, state, value, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 汇总结果
+	references := make([]string, 0, len(state.references))
+	for reference := range state.references {
+		references = append(references, reference)
+	}
+	sort.Strings(references)
+
+	return &ResolvedConfig{
+		Value:      resolved,
+		References: references,
+		Sensitive:  state.sensitive,
+	}, nil
+}
+
+// resolveValue 解析单个值中的全部引用
+func (r *ConfigReferenceResolver) resolveValue(ctx context.Context, state *resolveState, value string, chain []string) (string, error) {
+	if !strings.Contains(value, "${") {
+		return value, nil
+	}
+	if len(chain) > maxReferenceDepth {
+		return "", domainErrors.ErrConfigReferenceTooDeep(maxReferenceDepth)
+	}
+
+	var builder strings.Builder
+	last := 0
+	for _, match := range configReferencePattern.FindAllStringSubmatchIndex(value, -1) {
+		builder.WriteString(value[last:match[0]])
+		last = match[1]
+
+		expr := value[match[0]:match[1]]
+		if strings.HasPrefix(expr, "$$") {
+			builder.WriteString(expr[1:]) // 转义，输出字面量
+			continue
+		}
+
+		reference := value[match[2]:match[3]] + ":" + value[match[4]:match[5]]
+		for _, visited := range chain {
+			if visited == reference {
+				return "", domainErrors.ErrConfigReferenceCycle(append(chain, reference))
+			}
+		}
+
+		target, err := r.findReferencedConfig(ctx, state, value[match[2]:match[3]], value[match[4]:match[5]])
+		if err != nil {
+			return "", err
+		}
+		if target == nil {
+			return "", domainErrors.ErrConfigReferenceNotFound(reference, state.environment)
+		}
+
+		state.references[reference] = true
+		if target.ValueType == constants.ValueTypeEncrypted ||
+			(r.maskingSvc != nil && r.maskingSvc.IsSensitiveKey(target.Key)) {
+			state.sensitive = true
+		}
+
+		targetValue, err := r.plainValue(target)
+		if err != nil {
+			return "", err
+		}
+		resolved, err := r.resolveValue(ctx, state, targetValue, append(chain, reference))
+		if err != nil {
+			return "", err
+		}
+		builder.WriteString(resolved)
+	}
+	builder.WriteString(value[last:])
+
+	return builder.String(), nil
+}
+
+// findReferencedConfig 查找被引用的配置（当前环境优先，回退到默认环境）
+func (r *ConfigReferenceResolver) findReferencedConfig(ctx context.Context, state *resolveState, namespaceName string, key string) (*entity.Config, error) {
+	cacheKey := namespaceName + ":" + key
+	if config, ok := state.configs[cacheKey]; ok {
+		return config, nil
+	}
+
+	// 1. 查找命名空间
+	namespace, ok := state.namespaces[namespaceName]
+	if !ok {
+		var err error
+		namespace, err = r.namespaceRepo.FindByName(ctx, namespaceName)
+		if err != nil {
+			return nil, err
+		}
+		state.namespaces[namespaceName] = namespace
+	}
+	if namespace == nil || !namespace.IsActive {
+		state.configs[cacheKey] = nil
+		return nil, nil
+	}
+
+	// 2. 按环境查找已发布且已激活的配置
+	environments := []string{state.environment}
+	if state.environment != constants.EnvDefault {
+		environments = append(environments, constants.EnvDefault)
+	}
+
+	var found *entity.Config
+	for _, environment := range environments {
+		config, err := r.configRepo.FindByNamespaceAndKey(ctx, namespace.ID, key, environment)
+		if err != nil {
+			return nil, err
+		}
+		if config != nil && config.IsReleased && config.IsActive {
+			found = config
+			break
+		}
+	}
+
+	state.configs[cacheKey] = found
+	return found, nil
+}
+
+// plainValue 获取配置明文值
+func (r *ConfigReferenceResolver) plainValue(config *entity.Config) (string, error) {
+	if config.ValueType != constants.ValueTypeEncrypted || r.maskingSvc == nil {
+		return config.Value, nil
+	}
+	return r.maskingSvc.DecryptValue(config.Value)
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"config-client/config/domain/constants"
+	"config-client/config/domain/entity"
+	domainErrors "config-client/config/domain/errors"
+	"config-client/config/domain/repository"
+
+	"gopkg.in/yaml.v3"
+)
+
+// maxReportedViolations 单次校验最多返回的错误条数
+const maxReportedViolations = 20
+
+// ConfigSchemaService 配置 Schema 领域服务
+// 负责 Schema 绑定的管理，以及在配置创建/更新时按 Schema 校验 JSON/YAML 配置值
+type ConfigSchemaService struct {
+	schemaRepo    repository.ConfigSchemaRepository
+	namespaceRepo repository.NamespaceRepository
+}
+
+// NewConfigSchemaService 创建配置 Schema 服务实例
+func NewConfigSchemaService(
+	schemaRepo repository.ConfigSchemaRepository,
+	namespaceRepo repository.NamespaceRepository,
+) *ConfigSchemaService {
+	return &ConfigSchemaService{
+		schemaRepo:    schemaRepo,
+		namespaceRepo: namespaceRepo,
+	}
+}
+
+// ==================== Schema 管理 ====================
+
+// CreateSchema 创建 Schema 绑定
+// 业务规则：
+// 1. 命名空间必须存在
+// 2. 必须且只能绑定到一个配置键或一个配置分组
+// 3. Schema 文档必须能够编译
+// 4. 同一绑定目标只能存在一个 Schema
+func (s *ConfigSchemaService) CreateSchema(ctx context.Context, schema *entity.ConfigSchema) error {
+	// 1. 检查命名空间
+	namespace, err := s.namespaceRepo.GetByID(ctx, schema.NamespaceID)
+	if err != nil {
+		return err
+	}
+	if namespace == nil {
+		return domainErrors.ErrNamespaceNotFound("")
+	}
+
+	// 2. 校验绑定目标
+	if (schema.ConfigKey == "") == (schema.GroupName == "") {
+		return domainErrors.ErrConfigSchemaBindingInvalid()
+	}
+
+	// 3. 校验 Schema 文档
+	if _, err := CompileJSONSchema(schema.Schema); err != nil {
+		return domainErrors.ErrConfigSchemaInvalid(err)
+	}
+
+	// 4. 检查绑定是否已存在
+	var existing *entity.ConfigSchema
+	if schema.IsKeyBinding() {
+		existing, err = s.schemaRepo.FindByKey(ctx, schema.NamespaceID, schema.ConfigKey)
+	} else {
+		existing, err = s.schemaRepo.FindByGroup(ctx, schema.NamespaceID, schema.GroupName)
+	}
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return domainErrors.ErrConfigSchemaAlreadyExists(schema.GetBindingTarget())
+	}
+
+	// 5. 保存
+	schema.IsActive = true
+	return s.schemaRepo.Create(ctx, schema)
+}
+
+// UpdateSchema 更新 Schema 绑定（绑定目标不可修改）
+func (s *ConfigSchemaService) UpdateSchema(ctx context.Context, schema *entity.ConfigSchema) error {
+	// 1. 检查是否存在
+	existing, err := s.schemaRepo.GetByID(ctx, schema.ID)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return domainErrors.ErrConfigSchemaNotFound(schema.ID)
+	}
+
+	// 2. 校验 Schema 文档
+	if _, err := CompileJSONSchema(schema.Schema); err != nil {
+		return domainErrors.ErrConfigSchemaInvalid(err)
+	}
+
+	// 3. 更新并保存
+	existing.UpdateSchema(schema.Schema, schema.Description)
+	existing.IsActive = schema.IsActive
+	existing.UpdatedBy = schema.UpdatedBy
+	if err := s.schemaRepo.Update(ctx, existing); err != nil {
+		return err
+	}
+
+	*schema = *existing
+	return nil
+}
+
+// DeleteSchema 删除 Schema 绑定
+func (s *ConfigSchemaService) DeleteSchema(ctx context.Context, id int) error {
+	existing, err := s.schemaRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return domainErrors.ErrConfigSchemaNotFound(id)
+	}
+	return s.schemaRepo.Delete(ctx, id)
+}
+
+// GetByID 根据ID获取 Schema 绑定
+func (s *ConfigSchemaService) GetByID(ctx context.Context, id int) (*entity.ConfigSchema, error) {
+	schema, err := s.schemaRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if schema == nil {
+		return nil, domainErrors.ErrConfigSchemaNotFound(id)
+	}
+	return schema, nil
+}
+
+// ListSchemas 查询命名空间下的所有 Schema 绑定
+func (s *ConfigSchemaService) ListSchemas(ctx context.Context, namespaceID int) ([]*entity.ConfigSchema, error) {
+	return s.schemaRepo.FindByNamespace(ctx, namespaceID)
+}
+
+// ==================== 配置值校验 ====================
+
+// ValidateConfigValue 按绑定的 Schema 校验配置值
+// 业务规则：
+// 1. 仅校验 json/yaml 类型的配置
+// 2. 键绑定优先于分组绑定，未绑定或已停用时不校验
+// 3. 校验失败时返回全部错误位置（最多 20 条）
+func (s *ConfigSchemaService) ValidateConfigValue(ctx context.Context, config *entity.Config) error {
+	// 1. 仅校验结构化配置
+	if config.ValueType != constants.ValueTypeJSON && config.ValueType != constants.ValueTypeYAML {
+		return nil
+	}
+
+	// 2. 查找生效的 Schema
+	schema, err := s.findSchema(ctx, config)
+	if err != nil || schema == nil {
+		return err
+	}
+
+	compiled, err := CompileJSONSchema(schema.Schema)
+	if err != nil {
+		return domainErrors.ErrConfigSchemaInvalid(err)
+	}
+
+	// 3. 解析配置值并校验
+	instance, err := decodeStructuredValue(config.Value, config.ValueType)
+	if err != nil {
+		return domainErrors.ErrConfigValueInvalid(config.Key, err.Error())
+	}
+
+	violations := compiled.Validate(instance)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(violations))
+	for i, violation := range violations {
+		if i >= maxReportedViolations {
+			break
+		}
+		messages = append(messages, violation.String())
+	}
+	return domainErrors.ErrConfigSchemaViolation(config.Key, messages)
+}
+
+// findSchema 查找配置生效的 Schema（键绑定优先）
+func (s *ConfigSchemaService) findSchema(ctx context.Context, config *entity.Config) (*entity.ConfigSchema, error) {
+	schema, err := s.schemaRepo.FindByKey(ctx, config.NamespaceID, config.Key)
+	if err != nil {
+		return nil, err
+	}
+	if schema != nil && schema.IsActive {
+		return schema, nil
+	}
+
+	groupName := config.GroupName
+	if groupName == "" {
+		groupName = constants.DefaultGroupName
+	}
+	schema, err = s.schemaRepo.FindByGroup(ctx, config.NamespaceID, groupName)
+	if err != nil {
+		return nil, err
+	}
+	if schema != nil && schema.IsActive {
+		return schema, nil
+	}
+	return nil, nil
+}
+
+// decodeStructuredValue 将 JSON/YAML 配置值解析为 JSON 通用结构（数值统一为 json.Number）
+func decodeStructuredValue(value string, valueType string) (interface{}, error) {
+	data := []byte(value)
+	if valueType == constants.ValueTypeYAML {
+		var yamlValue interface{}
+		if err := yaml.Unmarshal(data, &yamlValue); err != nil {
+			return nil, err
+		}
+		var err error
+		if data, err = json.Marshal(yamlValue); err != nil {
+			return nil, err
+		}
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.UseNumber()
+	var instance interface{}
+	if err := decoder.Decode(&instance); err != nil {
+		return nil, err
+	}
+	return instance, nil
+}
@@ -0,0 +1,614 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxSchemaRefDepth $ref 的最大展开层级，防止循环引用导致无限递归
+const maxSchemaRefDepth = 32
+
+// JSONSchema 已编译的 JSON Schema
+// 支持 draft-07 常用关键字：
+// type/enum/const、properties/required/additionalProperties/patternProperties/minProperties/maxProperties、
+// items/minItems/maxItems/uniqueItems、minimum/maximum/exclusiveMinimum/exclusiveMaximum/multipleOf、
+// minLength/maxLength/pattern、allOf/anyOf/oneOf/not，以及文档内部的 $ref（#/definitions/...、#/$defs/...）
+type JSONSchema struct {
+	root    interface{}
+	regexps map[string]*regexp.Regexp
+}
+
+// SchemaViolation 单条 Schema 校验错误
+type SchemaViolation struct {
+	Path    string // 出错位置，如 $.server.port
+	Message string // 错误描述
+}
+
+// String 返回"位置: 描述"格式的错误信息
+func (v SchemaViolation) String() string {
+	return v.Path + ": " + v.Message
+}
+
+// CompileJSONSchema 解析并编译 JSON Schema
+// 编译阶段会检查关键字类型、正则表达式和 $ref 是否可解析
+func CompileJSONSchema(schema string) (*JSONSchema, error) {
+	decoder := json.NewDecoder(strings.NewReader(schema))
+	decoder.UseNumber()
+
+	var root interface{}
+	if err := decoder.Decode(&root); err != nil {
+		return nil, fmt.Errorf("schema 不是合法的 JSON: %w", err)
+	}
+
+	compiled := &JSONSchema{root: root, regexps: make(map[string]*regexp.Regexp)}
+	if err := compiled.compile(root, "#"); err != nil {
+		return nil, err
+	}
+	return compiled, nil
+}
+
+// Validate 校验实例，返回全部校验错误（无错误时返回空切片）
+// 实例应为 encoding/json（UseNumber）解码得到的通用结构
+func (s *JSONSchema) Validate(instance interface{}) []SchemaViolation {
+	violations := make([]SchemaViolation, 0)
+	s.validate(s.root, instance, "$", &violations, 0)
+	return violations
+}
+
+// ==================== 编译 ====================
+
+// compile 递归检查 schema 结构并预编译正则表达式
+func (s *JSONSchema) compile(node interface{}, location string) error {
+	switch schema := node.(type) {
+	case bool:
+		return nil
+	case map[string]interface{}:
+		if ref, ok := schema["$ref"]; ok {
+			refStr, ok := ref.(string)
+			if !ok {
+				return fmt.Errorf("%s/$ref 必须是字符串", location)
+			}
+			if _, err := s.resolveRef(refStr); err != nil {
+				return err
+			}
+		}
+
+		if t, ok := schema["type"]; ok {
+			if err := checkSchemaTypes(t, location); err != nil {
+				return err
+			}
+		}
+
+		if pattern, ok := schema["pattern"]; ok {
+			if err := s.compilePattern(pattern, location+"/pattern"); err != nil {
+				return err
+			}
+		}
+
+		// 子 schema（对象形式）
+		for _, keyword := range []string{"properties", "patternProperties", "definitions", "$defs"} {
+			children, ok := schema[keyword]
+			if !ok {
+				continue
+			}
+			childMap, ok := children.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%s/%s 必须是对象", location, keyword)
+			}
+			for name, child := range childMap {
+				if keyword == "patternProperties" {
+					if err := s.compilePattern(name, location+"/patternProperties"); err != nil {
+						return err
+					}
+				}
+				if err := s.compile(child, location+"/"+keyword+"/"+name); err != nil {
+					return err
+				}
+			}
+		}
+
+		// 子 schema（单个）
+		for _, keyword := range []string{"additionalProperties", "not"} {
+			if child, ok := schema[keyword]; ok {
+				if err := s.compile(child, location+"/"+keyword); err != nil {
+					return err
+				}
+			}
+		}
+
+		// items 支持单个 schema 或 schema 数组
+		if items, ok := schema["items"]; ok {
+			if list, ok := items.([]interface{}); ok {
+				for i, child := range list {
+					if err := s.compile(child, fmt.Sprintf("%s/items/%d", location, i)); err != nil {
+						return err
+					}
+				}
+			} else if err := s.compile(items, location+"/items"); err != nil {
+				return err
+			}
+		}
+
+		// 子 schema（数组）
+		for _, keyword := range []string{"allOf", "anyOf", "oneOf"} {
+			children, ok := schema[keyword]
+			if !ok {
+				continue
+			}
+			list, ok := children.([]interface{})
+			if !ok || len(list) == 0 {
+				return fmt.Errorf("%s/%s 必须是非空数组", location, keyword)
+			}
+			for i, child := range list {
+				if err := s.compile(child, fmt.Sprintf("%s/%s/%d", location, keyword, i)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("%s 必须是对象或布尔值", location)
+	}
+}
+
+// compilePattern 预编译正则表达式
+func (s *JSONSchema) compilePattern(pattern interface{}, location string) error {
+	patternStr, ok := pattern.(string)
+	if !ok {
+		return fmt.Errorf("%s 必须是字符串", location)
+	}
+	if _, exists := s.regexps[patternStr]; exists {
+		return nil
+	}
+	re, err := regexp.Compile(patternStr)
+	if err != nil {
+		return fmt.Errorf("%s 正则表达式无效: %w", location, err)
+	}
+	s.regexps[patternStr] = re
+	return nil
+}
+
+// checkSchemaTypes 检查 type 关键字的取值
+func checkSchemaTypes(t interface{}, location string) error {
+	valid := map[string]bool{
+		"null": true, "boolean": true, "object": true, "array": true,
+		"number": true, "integer": true, "string": true,
+	}
+
+	names := make([]interface{}, 0)
+	switch v := t.(type) {
+	case string:
+		names = append(names, v)
+	case []interface{}:
+		names = v
+	default:
+		return fmt.Errorf("%s/type 必须是字符串或字符串数组", location)
+	}
+
+	for _, name := range names {
+		nameStr, ok := name.(string)
+		if !ok || !valid[nameStr] {
+			return fmt.Errorf("%s/type 取值无效: %v", location, name)
+		}
+	}
+	return nil
+}
+
+// resolveRef 解析文档内部引用（JSON Pointer）
+func (s *JSONSchema) resolveRef(ref string) (interface{}, error) {
+	if ref == "#" {
+		return s.root, nil
+	}
+	if !strings.HasPrefix(ref, "#/") {
+		return nil, fmt.Errorf("仅支持文档内部引用: $ref=%s", ref)
+	}
+
+	node := s.root
+	for _, token := range strings.Split(ref[2:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch current := node.(type) {
+		case map[string]interface{}:
+			next, ok := current[token]
+			if !ok {
+				return nil, fmt.Errorf("引用无法解析: $ref=%s", ref)
+			}
+			node = next
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(current) {
+				return nil, fmt.Errorf("引用无法解析: $ref=%s", ref)
+			}
+			node = current[index]
+		default:
+			return nil, fmt.Errorf("引用无法解析: $ref=%s", ref)
+		}
+	}
+	return node, nil
+}
+
+// ==================== 校验 ====================
+
+// validate 递归校验实例
+func (s *JSONSchema) validate(node interface{}, instance interface{}, path string, out *[]SchemaViolation, depth int) {
+	report := func(format string, args ...interface{}) {
+		*out = append(*out, SchemaViolation{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	schema, ok := node.(map[string]interface{})
+	if !ok {
+		if allowed, isBool := node.(bool); isBool && !allowed {
+			report("不允许出现该值")
+		}
+		return
+	}
+
+	// 1. $ref
+	if ref, ok := schema["$ref"].(string); ok {
+		if depth >= maxSchemaRefDepth {
+			report("$ref 展开层级超过上限 %d", maxSchemaRefDepth)
+			return
+		}
+		target, err := s.resolveRef(ref)
+		if err != nil {
+			report("%v", err)
+			return
+		}
+		s.validate(target, instance, path, out, depth+1)
+	}
+
+	// 2. 通用关键字
+	if t, ok := schema["type"]; ok && !matchesSchemaType(t, instance) {
+		report("类型应为 %s，实际为 %s", formatSchemaTypes(t), jsonTypeOf(instance))
+		return // 类型不匹配时其余关键字无意义
+	}
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		matched := false
+		for _, candidate := range enum {
+			if jsonValuesEqual(candidate, instance) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			report("取值必须为 %s 之一", compactJSON(enum))
+		}
+	}
+	if constant, ok := schema["const"]; ok && !jsonValuesEqual(constant, instance) {
+		report("取值必须为 %s", compactJSON(constant))
+	}
+
+	// 3. 按实例类型校验
+	switch value := instance.(type) {
+	case string:
+		s.validateString(schema, value, report)
+	case json.Number:
+		validateNumber(schema, value, report)
+	case map[string]interface{}:
+		s.validateObject(schema, value, path, out, depth)
+	case []interface{}:
+		s.validateArray(schema, value, path, out, depth)
+	}
+
+	// 4. 组合关键字
+	if list, ok := schema["allOf"].([]interface{}); ok {
+		for _, child := range list {
+			s.validate(child, instance, path, out, depth+1)
+		}
+	}
+	if list, ok := schema["anyOf"].([]interface{}); ok {
+		if s.countMatches(list, instance, path, depth) == 0 {
+			report("不满足 anyOf 中的任何一个 schema")
+		}
+	}
+	if list, ok := schema["oneOf"].([]interface{}); ok {
+		if matched := s.countMatches(list, instance, path, depth); matched != 1 {
+			report("必须恰好满足 oneOf 中的一个 schema，实际满足 %d 个", matched)
+		}
+	}
+	if not, ok := schema["not"]; ok && s.matches(not, instance, path, depth) {
+		report("不能满足 not 中的 schema")
+	}
+}
+
+// validateString 校验字符串关键字
+func (s *JSONSchema) validateString(schema map[string]interface{}, value string, report func(string, ...interface{})) {
+	length := utf8.RuneCountInString(value)
+	if min, ok := schemaInt(schema, "minLength"); ok && length < min {
+		report("长度不能小于 %d", min)
+	}
+	if max, ok := schemaInt(schema, "maxLength"); ok && length > max {
+		report("长度不能大于 %d", max)
+	}
+	if pattern, ok := schema["pattern"].(string); ok {
+		if re := s.regexps[pattern]; re != nil && !re.MatchString(value) {
+			report("不匹配正则表达式 %s", pattern)
+		}
+	}
+}
+
+// validateNumber 校验数值关键字
+func validateNumber(schema map[string]interface{}, value json.Number, report func(string, ...interface{})) {
+	number, err := value.Float64()
+	if err != nil {
+		return
+	}
+
+	if min, ok := schemaFloat(schema, "minimum"); ok && number < min {
+		report("不能小于 %v", min)
+	}
+	if max, ok := schemaFloat(schema, "maximum"); ok && number > max {
+		report("不能大于 %v", max)
+	}
+	if min, ok := schemaFloat(schema, "exclusiveMinimum"); ok && number <= min {
+		report("必须大于 %v", min)
+	}
+	if max, ok := schemaFloat(schema, "exclusiveMaximum"); ok && number >= max {
+		report("必须小于 %v", max)
+	}
+	if multiple, ok := schemaFloat(schema, "multipleOf"); ok && multiple > 0 {
+		quotient := number / multiple
+		if math.Abs(quotient-math.Round(quotient)) > 1e-9 {
+			report("必须是 %v 的倍数", multiple)
+		}
+	}
+}
+
+// validateObject 校验对象关键字
+func (s *JSONSchema) validateObject(schema map[string]interface{}, value map[string]interface{}, path string, out *[]SchemaViolation, depth int) {
+	report := func(format string, args ...interface{}) {
+		*out = append(*out, SchemaViolation{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, name := range required {
+			if nameStr, ok := name.(string); ok {
+				if _, exists := value[nameStr]; !exists {
+					report("缺少必填字段 %s", nameStr)
+				}
+			}
+		}
+	}
+	if min, ok := schemaInt(schema, "minProperties"); ok && len(value) < min {
+		report("字段数量不能少于 %d", min)
+	}
+	if max, ok := schemaInt(schema, "maxProperties"); ok && len(value) > max {
+		report("字段数量不能多于 %d", max)
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	patternProperties, _ := schema["patternProperties"].(map[string]interface{})
+	additional, hasAdditional := schema["additionalProperties"]
+
+	// 按字段名排序，保证错误顺序稳定
+	names := make([]string, 0, len(value))
+	for name := range value {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		childPath := path + "." + name
+		matched := false
+
+		if child, ok := properties[name]; ok {
+			matched = true
+			s.validate(child, value[name], childPath, out, depth+1)
+		}
+		for pattern, child := range patternProperties {
+			if re := s.regexps[pattern]; re != nil && re.MatchString(name) {
+				matched = true
+				s.validate(child, value[name], childPath, out, depth+1)
+			}
+		}
+
+		if !matched && hasAdditional {
+			if allowed, isBool := additional.(bool); isBool {
+				if !allowed {
+					*out = append(*out, SchemaViolation{Path: childPath, Message: "不允许出现未定义的字段"})
+				}
+			} else {
+				s.validate(additional, value[name], childPath, out, depth+1)
+			}
+		}
+	}
+}
+
+// validateArray 校验数组关键字
+func (s *JSONSchema) validateArray(schema map[string]interface{}, value []interface{}, path string, out *[]SchemaViolation, depth int) {
+	report := func(format string, args ...interface{}) {
+		*out = append(*out, SchemaViolation{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if min, ok := schemaInt(schema, "minItems"); ok && len(value) < min {
+		report("元素数量不能少于 %d", min)
+	}
+	if max, ok := schemaInt(schema, "maxItems"); ok && len(value) > max {
+		report("元素数量不能多于 %d", max)
+	}
+	if unique, ok := schema["uniqueItems"].(bool); ok && unique {
+		for i := 0; i < len(value); i++ {
+			for j := i + 1; j < len(value); j++ {
+				if jsonValuesEqual(value[i], value[j]) {
+					report("元素不能重复: 第 %d 个与第 %d 个相同", i, j)
+				}
+			}
+		}
+	}
+
+	switch items := schema["items"].(type) {
+	case nil:
+	case []interface{}:
+		// 元组形式：按位置校验
+		for i := 0; i < len(items) && i < len(value); i++ {
+			s.validate(items[i], value[i], fmt.Sprintf("%s[%d]", path, i), out, depth+1)
+		}
+	default:
+		for i, element := range value {
+			s.validate(items, element, fmt.Sprintf("%s[%d]", path, i), out, depth+1)
+		}
+	}
+}
+
+// countMatches 统计实例满足的子 schema 数量
+func (s *JSONSchema) countMatches(list []interface{}, instance interface{}, path string, depth int) int {
+	count := 0
+	for _, child := range list {
+		if s.matches(child, instance, path, depth) {
+			count++
+		}
+	}
+	return count
+}
+
+// matches 判断实例是否满足 schema
+func (s *JSONSchema) matches(node interface{}, instance interface{}, path string, depth int) bool {
+	violations := make([]SchemaViolation, 0)
+	s.validate(node, instance, path, &violations, depth+1)
+	return len(violations) == 0
+}
+
+// ==================== 辅助函数 ====================
+
+// jsonTypeOf 获取实例的 JSON 类型名称
+func jsonTypeOf(instance interface{}) string {
+	switch v := instance.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		if isJSONInteger(v) {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", instance)
+	}
+}
+
+// isJSONInteger 判断数值是否为整数（1.0 也视为整数）
+func isJSONInteger(number json.Number) bool {
+	if _, err := number.Int64(); err == nil {
+		return true
+	}
+	f, err := number.Float64()
+	return err == nil && f == math.Trunc(f)
+}
+
+// matchesSchemaType 判断实例类型是否满足 type 关键字
+func matchesSchemaType(t interface{}, instance interface{}) bool {
+	actual := jsonTypeOf(instance)
+	check := func(expected string) bool {
+		return expected == actual || (expected == "number" && actual == "integer")
+	}
+
+	switch v := t.(type) {
+	case string:
+		return check(v)
+	case []interface{}:
+		for _, name := range v {
+			if nameStr, ok := name.(string); ok && check(nameStr) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// formatSchemaTypes 格式化 type 关键字用于错误提示
+func formatSchemaTypes(t interface{}) string {
+	if list, ok := t.([]interface{}); ok {
+		names := make([]string, 0, len(list))
+		for _, name := range list {
+			names = append(names, fmt.Sprint(name))
+		}
+		return strings.Join(names, "/")
+	}
+	return fmt.Sprint(t)
+}
+
+// jsonValuesEqual 按 JSON 语义比较两个值是否相等（数值按大小比较）
+func jsonValuesEqual(a interface{}, b interface{}) bool {
+	switch av := a.(type) {
+	case json.Number:
+		bv, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		af, errA := av.Float64()
+		bf, errB := bv.Float64()
+		return errA == nil && errB == nil && af == bf
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !jsonValuesEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for key, value := range av {
+			other, exists := bv[key]
+			if !exists || !jsonValuesEqual(value, other) {
+				return false
+			}
+		}
+		return true
+	default:
+		return a == b
+	}
+}
+
+// schemaInt 读取非负整数关键字
+func schemaInt(schema map[string]interface{}, keyword string) (int, bool) {
+	number, ok := schema[keyword].(json.Number)
+	if !ok {
+		return 0, false
+	}
+	value, err := number.Int64()
+	if err != nil {
+		return 0, false
+	}
+	return int(value), true
+}
+
+// schemaFloat 读取数值关键字（draft-04 中布尔形式的 exclusiveMinimum/exclusiveMaximum 不支持，将被忽略）
+func schemaFloat(schema map[string]interface{}, keyword string) (float64, bool) {
+	number, ok := schema[keyword].(json.Number)
+	if !ok {
+		return 0, false
+	}
+	value, err := number.Float64()
+	if err != nil {
+		return 0, false
+	}
+	return value, true
+}
+
+// compactJSON 将值序列化为紧凑的 JSON 字符串，用于错误提示
+func compactJSON(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
@@ -2,11 +2,12 @@ package service
 
 import (
 	"context"
-	"crypto/md5"
-	"encoding/hex"
 	"encoding/json"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"config-client/config/domain/constants"
 	"config-client/config/domain/entity"
@@ -15,8 +16,10 @@ import (
 	"config-client/config/domain/repository"
 	shareConstants "config-client/share/constants"
 	shareRepo "config-client/share/repository"
+	"config-client/share/tracing"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"go.opentelemetry.io/otel/attribute"
 	"gopkg.in/yaml.v3"
 )
 
@@ -30,10 +33,16 @@ import (
 // - 配置的脱敏和标签管理
 type ConfigService struct {
 	configRepo       repository.ConfigRepository
-	listener         listener.ConfigListener // 配置变更监听器（可选）
-	changeHistorySvc *ChangeHistoryService   // 变更历史服务（可选）
-	maskingSvc       *MaskingService         // 脱敏服务（可选）
-	tagSvc           *ConfigTagService       // 标签服务（可选）
+	listener         listener.ConfigListener      // 配置变更监听器（可选）
+	changeHistorySvc *ChangeHistoryService        // 变更历史服务（可选）
+	maskingSvc       *MaskingService              // 脱敏服务（可选）
+	tagSvc           *ConfigTagService            // 标签服务（可选）
+	schemaSvc        *ConfigSchemaService         // Schema校验服务（可选）
+	outbox           *EventOutbox                 // 事务性发件箱（可选，启用后变更事件与配置写入同事务保存）
+	webhooks         *WebhookService              // Webhook 服务（可选，变更事件投递到命名空间 Webhook）
+	quotas           *NamespaceQuotaService       // 命名空间配额服务（可选，校验配置数量和配置值大小）
+	txManager        shareRepo.TransactionManager // 事务管理器（可选，未设置时使用配置仓储的事务）
+	hashAlgorithm    string                       // 新写入配置使用的内容哈希算法（为空时使用 md5）
 }
 
 // NewConfigService 创建配置领域服务实例
@@ -43,6 +52,7 @@ func NewConfigService(
 	changeHistorySvc *ChangeHistoryService,
 	maskingSvc *MaskingService,
 	tagSvc *ConfigTagService,
+	schemaSvc *ConfigSchemaService,
 ) *ConfigService {
 	return &ConfigService{
 		configRepo:       configRepo,
@@ -50,9 +60,50 @@ func NewConfigService(
 		changeHistorySvc: changeHistorySvc,
 		maskingSvc:       maskingSvc,
 		tagSvc:           tagSvc,
+		schemaSvc:        schemaSvc,
 	}
 }
 
+// SetEventOutbox 设置事务性发件箱
+func (s *ConfigService) SetEventOutbox(outbox *EventOutbox) {
+	s.outbox = outbox
+}
+
+// SetWebhookService 设置 Webhook 服务
+func (s *ConfigService) SetWebhookService(webhooks *WebhookService) {
+	s.webhooks = webhooks
+}
+
+// SetQuotaService 设置命名空间配额服务
+func (s *ConfigService) SetQuotaService(quotas *NamespaceQuotaService) {
+	s.quotas = quotas
+}
+
+// SetTransactionManager 设置事务管理器
+func (s *ConfigService) SetTransactionManager(txManager shareRepo.TransactionManager) {
+	s.txManager = txManager
+}
+
+// SetHashAlgorithm 设置新写入配置使用的内容哈希算法（为空时使用 md5）
+// 已有配置保留原算法，更新配置值时切换为新算法，也可以通过 MigrateContentHashes 批量迁移
+func (s *ConfigService) SetHashAlgorithm(algorithm string) error {
+	if algorithm != "" {
+		if _, ok := LookupContentHasher(algorithm); !ok {
+			return domainErrors.ErrUnsupportedHashAlgorithm(algorithm)
+		}
+	}
+	s.hashAlgorithm = algorithm
+	return nil
+}
+
+// HashAlgorithm 新写入配置使用的内容哈希算法
+func (s *ConfigService) HashAlgorithm() string {
+	if s.hashAlgorithm == "" {
+		return constants.HashAlgorithmMD5
+	}
+	return s.hashAlgorithm
+}
+
 // CreateConfig 创建配置
 // 业务规则：
 // 1. 配置键不能为空，且必须符合命名规范
@@ -60,11 +111,19 @@ func NewConfigService(
 // 3. 自动计算并设置内容哈希
 // 4. 敏感配置自动加密存储
 // 5. 自动生成标签
-func (s *ConfigService) CreateConfig(ctx context.Context, config *entity.Config) error {
+// 6. 设置过期时间时必须晚于当前时间
+// 7. 命名空间配置数量不能超过配额
+func (s *ConfigService) CreateConfig(ctx context.Context, config *entity.Config) (err error) {
+	ctx, span := tracing.Start(ctx, "ConfigService.CreateConfig", configSpanAttributes(config)...)
+	defer func() { tracing.End(span, err) }()
+
 	// 1. 验证配置有效性
 	if err := s.ValidateConfig(ctx, config); err != nil {
 		return err
 	}
+	if err := validateExpiresAt(config.ExpiresAt, time.Now()); err != nil {
+		return err
+	}
 
 	// 2. 检查配置是否已存在
 	exists, err := s.configRepo.ExistsByNamespaceAndKey(ctx, config.NamespaceID, config.Key, config.Environment)
@@ -74,10 +133,15 @@ func (s *ConfigService) CreateConfig(ctx context.Context, config *entity.Config)
 	if exists {
 		return domainErrors.ErrConfigAlreadyExists(config.Key, config.Environment)
 	}
+	if s.quotas != nil {
+		if err := s.quotas.CheckConfigCount(ctx, config.NamespaceID); err != nil {
+			return err
+		}
+	}
 
-	// 3. 处理敏感配置：自动加密
+	// 3. 处理敏感配置：自动加密（文件类型配置的值为内容引用，不加密）
 	originalValue := config.Value // 保存原始值用于历史记录
-	if s.maskingSvc != nil && s.maskingSvc.IsSensitiveKey(config.Key) {
+	if s.maskingSvc != nil && s.maskingSvc.IsSensitiveKey(config.Key) && config.ValueType != constants.ValueTypeFile {
 		encryptedValue, err := s.maskingSvc.EncryptValue(config.Value)
 		if err != nil {
 			hlog.CtxErrorf(ctx, "加密配置值失败: %v", err)
@@ -89,12 +153,12 @@ func (s *ConfigService) CreateConfig(ctx context.Context, config *entity.Config)
 	}
 
 	// 4. 计算内容哈希
-	hash, err := s.ComputeContentHash(config.Value, constants.HashAlgorithmMD5)
+	hash, err := s.ComputeContentHash(config.Value, s.HashAlgorithm())
 	if err != nil {
 		return err
 	}
 	config.ContentHash = hash
-	config.ContentHashAlgorithm = constants.HashAlgorithmMD5
+	config.ContentHashAlgorithm = s.HashAlgorithm()
 
 	// 5. 设置默认值
 	if config.GroupName == "" {
@@ -103,12 +167,38 @@ func (s *ConfigService) CreateConfig(ctx context.Context, config *entity.Config)
 	config.IsReleased = false // 新创建的配置默认未发布
 	config.IsActive = true    // 新创建的配置默认激活
 
-	// 6. 保存配置
-	if err := s.configRepo.Create(ctx, config); err != nil {
+	// 6. 保存配置、记录变更历史（使用原始值，不记录加密后的值）并发布配置变更事件
+	err = s.withEventTx(ctx, func(txCtx context.Context) error {
+		if err := s.configRepo.Create(txCtx, config); err != nil {
+			return err
+		}
+		s.recordChangeHistory(txCtx, &entity.ChangeRecord{
+			ConfigID:     config.ID,
+			NamespaceID:  config.NamespaceID,
+			ConfigKey:    config.Key,
+			Environment:  config.Environment,
+			Operation:    entity.OperationCreate,
+			OldValue:     "",
+			NewValue:     originalValue,
+			OldVersion:   0,
+			NewVersion:   config.Version,
+			Operator:     s.getOperator(ctx),
+			OperatorIP:   s.getOperatorIP(ctx),
+			ChangeReason: s.getChangeReason(ctx, "创建配置"),
+		})
+		return s.publishConfigChangeEvent(txCtx, &listener.ConfigChangeEvent{
+			NamespaceID: config.NamespaceID,
+			ConfigKey:   config.Key,
+			ConfigID:    config.ID,
+			Environment: config.Environment,
+			Action:      "create",
+		})
+	})
+	if err != nil {
 		return err
 	}
 
-	// 7. 自动生成并保存标签
+	// 7. 自动生成并保存标签（在事务外执行，失败不影响配置创建）
 	if s.tagSvc != nil {
 		autoTags := s.tagSvc.AutoGenerateTags(ctx, config)
 		if len(autoTags) > 0 {
@@ -119,30 +209,6 @@ func (s *ConfigService) CreateConfig(ctx context.Context, config *entity.Config)
 		}
 	}
 
-	// 8. 发布配置变更事件
-	s.publishConfigChangeEvent(ctx, &listener.ConfigChangeEvent{
-		NamespaceID: config.NamespaceID,
-		ConfigKey:   config.Key,
-		ConfigID:    config.ID,
-		Action:      "create",
-	})
-
-	// 9. 记录变更历史（使用原始值，不记录加密后的值）
-	s.recordChangeHistory(ctx, &entity.ChangeRecord{
-		ConfigID:     config.ID,
-		NamespaceID:  config.NamespaceID,
-		ConfigKey:    config.Key,
-		Environment:  config.Environment,
-		Operation:    entity.OperationCreate,
-		OldValue:     "",
-		NewValue:     originalValue,
-		OldVersion:   0,
-		NewVersion:   config.Version,
-		Operator:     s.getOperator(ctx),
-		OperatorIP:   s.getOperatorIP(ctx),
-		ChangeReason: "创建配置",
-	})
-
 	return nil
 }
 
@@ -152,7 +218,12 @@ func (s *ConfigService) CreateConfig(ctx context.Context, config *entity.Config)
 // 2. 已发布的配置不能直接修改，需要先取消发布
 // 3. 更新时自动计算新的内容哈希
 // 4. 自动增加版本号
-func (s *ConfigService) UpdateConfig(ctx context.Context, config *entity.Config) error {
+// 5. config.Version > 0 时作为期望版本号（乐观锁），与当前版本不一致时返回版本冲突，避免覆盖他人的修改
+// 6. 修改过期时间时新的过期时间必须晚于当前时间，为 nil 时取消过期
+func (s *ConfigService) UpdateConfig(ctx context.Context, config *entity.Config) (err error) {
+	ctx, span := tracing.Start(ctx, "ConfigService.UpdateConfig", configSpanAttributes(config)...)
+	defer func() { tracing.End(span, err) }()
+
 	// 1. 检查配置是否存在
 	existingConfig, err := s.configRepo.GetByID(ctx, config.ID)
 	if err != nil {
@@ -167,13 +238,24 @@ func (s *ConfigService) UpdateConfig(ctx context.Context, config *entity.Config)
 		return domainErrors.ErrConfigAlreadyReleased(config.Key)
 	}
 
+	// 校验期望版本号
+	expectedVersion := config.Version
+	if expectedVersion > 0 && existingConfig.Version != expectedVersion {
+		return domainErrors.ErrConfigVersionConflict(existingConfig.Key, expectedVersion, existingConfig.Version)
+	}
+
 	// 3. 验证配置有效性
 	if err := s.ValidateConfig(ctx, config); err != nil {
 		return err
 	}
+	if !sameExpiresAt(existingConfig.ExpiresAt, config.ExpiresAt) {
+		if err := validateExpiresAt(config.ExpiresAt, time.Now()); err != nil {
+			return err
+		}
+	}
 
-	// 4. 重新计算内容哈希
-	hash, err := s.ComputeContentHash(config.Value, constants.HashAlgorithmMD5)
+	// 4. 重新计算内容哈希（使用当前的默认算法）
+	hash, err := s.ComputeContentHash(config.Value, s.HashAlgorithm())
 	if err != nil {
 		return err
 	}
@@ -184,46 +266,75 @@ func (s *ConfigService) UpdateConfig(ctx context.Context, config *entity.Config)
 
 	// 5. 使用领域实体的方法更新配置值
 	existingConfig.UpdateValue(config.Value, hash)
+	existingConfig.ContentHashAlgorithm = s.HashAlgorithm()
 	existingConfig.Description = config.Description
 	existingConfig.Metadata = config.Metadata
 	existingConfig.GroupName = config.GroupName
 	existingConfig.ValueType = config.ValueType
+	existingConfig.ExpiresAt = config.ExpiresAt
 
-	// 6. 保存更新
-	if err := s.configRepo.Update(ctx, existingConfig); err != nil {
+	// 6. 保存更新（版本号递增）、记录变更历史并发布配置变更事件
+	err = s.withEventTx(ctx, func(txCtx context.Context) error {
+		if err := s.saveConfigUpdate(txCtx, existingConfig, expectedVersion); err != nil {
+			return err
+		}
+		s.recordChangeHistory(txCtx, &entity.ChangeRecord{
+			ConfigID:     existingConfig.ID,
+			NamespaceID:  existingConfig.NamespaceID,
+			ConfigKey:    existingConfig.Key,
+			Environment:  existingConfig.Environment,
+			Operation:    entity.OperationUpdate,
+			OldValue:     oldValue,
+			NewValue:     existingConfig.Value,
+			OldVersion:   oldVersion,
+			NewVersion:   existingConfig.Version,
+			Operator:     s.getOperator(ctx),
+			OperatorIP:   s.getOperatorIP(ctx),
+			ChangeReason: s.getChangeReason(ctx, "更新配置"),
+		})
+		return s.publishConfigChangeEvent(txCtx, &listener.ConfigChangeEvent{
+			NamespaceID: existingConfig.NamespaceID,
+			ConfigKey:   existingConfig.Key,
+			ConfigID:    existingConfig.ID,
+			Environment: existingConfig.Environment,
+			Action:      "update",
+		})
+	})
+	if err != nil {
 		return err
 	}
 
-	// 7. 发布配置变更事件
-	s.publishConfigChangeEvent(ctx, &listener.ConfigChangeEvent{
-		NamespaceID: existingConfig.NamespaceID,
-		ConfigKey:   existingConfig.Key,
-		ConfigID:    existingConfig.ID,
-		Action:      "update",
-	})
+	return nil
+}
 
-	// 8. 记录变更历史
-	s.recordChangeHistory(ctx, &entity.ChangeRecord{
-		ConfigID:     existingConfig.ID,
-		NamespaceID:  existingConfig.NamespaceID,
-		ConfigKey:    existingConfig.Key,
-		Environment:  existingConfig.Environment,
-		Operation:    entity.OperationUpdate,
-		OldValue:     oldValue,
-		NewValue:     existingConfig.Value,
-		OldVersion:   oldVersion,
-		NewVersion:   existingConfig.Version,
-		Operator:     s.getOperator(ctx),
-		OperatorIP:   s.getOperatorIP(ctx),
-		ChangeReason: "更新配置",
-	})
+// saveConfigUpdate 保存配置更新
+// 指定期望版本号时按版本号条件更新，校验之后被并发修改时返回版本冲突
+func (s *ConfigService) saveConfigUpdate(ctx context.Context, config *entity.Config, expectedVersion int) error {
+	if expectedVersion <= 0 {
+		return s.configRepo.Update(ctx, config)
+	}
 
+	updated, err := s.configRepo.UpdateWithVersion(ctx, config, expectedVersion)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		current, err := s.configRepo.GetByID(ctx, config.ID)
+		if err != nil {
+			return err
+		}
+		actualVersion := 0
+		if current != nil {
+			actualVersion = current.Version
+		}
+		return domainErrors.ErrConfigVersionConflict(config.Key, expectedVersion, actualVersion)
+	}
 	return nil
 }
 
 // ReleaseConfig 发布配置
 // 业务规则：
-// 1. 配置必须存在且已激活
+// 1. 配置必须存在、已激活且未过期
 // 2. 配置值必须有效
 // 3. 发布后配置不可修改（需先取消发布）
 func (s *ConfigService) ReleaseConfig(ctx context.Context, configID int) error {
@@ -236,10 +347,13 @@ func (s *ConfigService) ReleaseConfig(ctx context.Context, configID int) error {
 		return domainErrors.ErrConfigNotFound("", "")
 	}
 
-	// 2. 检查配置是否已激活
+	// 2. 检查配置是否已激活且未过期
 	if !config.IsActive {
 		return domainErrors.ErrConfigNotActive(config.Key)
 	}
+	if config.IsExpired(time.Now()) {
+		return domainErrors.ErrConfigExpired(config.Key)
+	}
 
 	// 3. 验证配置有效性
 	if err := s.ValidateConfig(ctx, config); err != nil {
@@ -289,7 +403,10 @@ func (s *ConfigService) UnreleaseConfig(ctx context.Context, configID int) error
 // 1. 配置必须存在
 // 2. 已发布的配置不能删除，需要先取消发布
 // 3. 执行软删除
-func (s *ConfigService) DeleteConfig(ctx context.Context, configID int) error {
+func (s *ConfigService) DeleteConfig(ctx context.Context, configID int) (err error) {
+	ctx, span := tracing.Start(ctx, "ConfigService.DeleteConfig", attribute.Int("config.id", configID))
+	defer func() { tracing.End(span, err) }()
+
 	// 1. 检查配置是否存在
 	config, err := s.configRepo.GetByID(ctx, configID)
 	if err != nil {
@@ -308,36 +425,133 @@ func (s *ConfigService) DeleteConfig(ctx context.Context, configID int) error {
 	oldValue := config.Value
 	oldVersion := config.Version
 
-	// 3. 执行软删除
-	if err := s.configRepo.Delete(ctx, configID); err != nil {
+	// 3. 执行软删除、记录变更历史并发布配置变更事件
+	err = s.withEventTx(ctx, func(txCtx context.Context) error {
+		if err := s.configRepo.Delete(txCtx, configID); err != nil {
+			return err
+		}
+		s.recordChangeHistory(txCtx, &entity.ChangeRecord{
+			ConfigID:     config.ID,
+			NamespaceID:  config.NamespaceID,
+			ConfigKey:    config.Key,
+			Environment:  config.Environment,
+			Operation:    entity.OperationDelete,
+			OldValue:     oldValue,
+			NewValue:     "",
+			OldVersion:   oldVersion,
+			NewVersion:   0,
+			Operator:     s.getOperator(ctx),
+			OperatorIP:   s.getOperatorIP(ctx),
+			ChangeReason: s.getChangeReason(ctx, "删除配置"),
+		})
+		return s.publishConfigChangeEvent(txCtx, &listener.ConfigChangeEvent{
+			NamespaceID: config.NamespaceID,
+			ConfigKey:   config.Key,
+			ConfigID:    config.ID,
+			Environment: config.Environment,
+			Action:      "delete",
+		})
+	})
+	if err != nil {
 		return err
 	}
 
-	// 4. 发布配置变更事件
-	s.publishConfigChangeEvent(ctx, &listener.ConfigChangeEvent{
-		NamespaceID: config.NamespaceID,
-		ConfigKey:   config.Key,
-		ConfigID:    config.ID,
-		Action:      "delete",
-	})
+	return nil
+}
+
+// RestoreConfig 从回收站恢复已删除的配置
+// 业务规则：
+// 1. 配置必须处于已删除状态
+// 2. 同一命名空间+环境下已存在同名配置时不能恢复，命名空间配置数量不能超过配额
+// 3. 恢复后版本号递增，订阅方据此感知配置重新出现
+// 4. 标签随软删除保留，恢复后重新生效；标签缺失时重新生成自动标签
+func (s *ConfigService) RestoreConfig(ctx context.Context, configID int) (_ *entity.Config, err error) {
+	ctx, span := tracing.Start(ctx, "ConfigService.RestoreConfig", attribute.Int("config.id", configID))
+	defer func() { tracing.End(span, err) }()
+
+	// 1. 检查配置是否在回收站中
+	config, err := s.configRepo.GetDeletedByID(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, domainErrors.ErrConfigNotFound("", "")
+	}
 
-	// 5. 记录变更历史
-	s.recordChangeHistory(ctx, &entity.ChangeRecord{
-		ConfigID:     config.ID,
-		NamespaceID:  config.NamespaceID,
-		ConfigKey:    config.Key,
-		Environment:  config.Environment,
-		Operation:    entity.OperationDelete,
-		OldValue:     oldValue,
-		NewValue:     "",
-		OldVersion:   oldVersion,
-		NewVersion:   0,
-		Operator:     s.getOperator(ctx),
-		OperatorIP:   s.getOperatorIP(ctx),
-		ChangeReason: "删除配置",
+	// 2. 检查是否存在同名配置
+	exists, err := s.configRepo.ExistsByNamespaceAndKey(ctx, config.NamespaceID, config.Key, config.Environment)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, domainErrors.ErrConfigAlreadyExists(config.Key, config.Environment)
+	}
+	if s.quotas != nil {
+		if err := s.quotas.CheckConfigCount(ctx, config.NamespaceID); err != nil {
+			return nil, err
+		}
+	}
+
+	// 3. 恢复配置（版本号递增）、记录变更历史并发布配置变更事件
+	oldVersion := config.Version
+	config.IncrementVersion()
+	config.UpdatedBy = s.getOperator(ctx)
+	err = s.withEventTx(ctx, func(txCtx context.Context) error {
+		if err := s.configRepo.Restore(txCtx, config); err != nil {
+			return err
+		}
+		s.recordChangeHistory(txCtx, &entity.ChangeRecord{
+			ConfigID:     config.ID,
+			NamespaceID:  config.NamespaceID,
+			ConfigKey:    config.Key,
+			Environment:  config.Environment,
+			Operation:    entity.OperationRestore,
+			OldValue:     "",
+			NewValue:     config.Value,
+			OldVersion:   oldVersion,
+			NewVersion:   config.Version,
+			Operator:     s.getOperator(ctx),
+			OperatorIP:   s.getOperatorIP(ctx),
+			ChangeReason: s.getChangeReason(ctx, "从回收站恢复配置"),
+		})
+		return s.publishConfigChangeEvent(txCtx, &listener.ConfigChangeEvent{
+			NamespaceID: config.NamespaceID,
+			ConfigKey:   config.Key,
+			ConfigID:    config.ID,
+			Environment: config.Environment,
+			Action:      "restore",
+		})
 	})
+	if err != nil {
+		return nil, err
+	}
+	config.DeletedAt.Valid = false
 
-	return nil
+	// 4. 恢复标签（在事务外执行，失败不影响配置恢复）
+	s.restoreTags(ctx, config)
+
+	return config, nil
+}
+
+// restoreTags 恢复配置标签：保留的标签直接生效，没有标签时重新生成自动标签
+func (s *ConfigService) restoreTags(ctx context.Context, config *entity.Config) {
+	if s.tagSvc == nil {
+		return
+	}
+
+	tags, err := s.tagSvc.GetTags(ctx, config.ID)
+	if err != nil {
+		hlog.CtxWarnf(ctx, "查询配置标签失败: %v, configID=%d", err, config.ID)
+		return
+	}
+	if len(tags) > 0 {
+		return
+	}
+	if autoTags := s.tagSvc.AutoGenerateTags(ctx, config); len(autoTags) > 0 {
+		if err := s.tagSvc.AddTags(ctx, config.ID, autoTags); err != nil {
+			hlog.CtxWarnf(ctx, "恢复自动标签失败: %v, configID=%d", err, config.ID)
+		}
+	}
 }
 
 // ValidateConfig 验证配置的有效性
@@ -345,6 +559,9 @@ func (s *ConfigService) DeleteConfig(ctx context.Context, configID int) error {
 // 1. 配置键符合命名规范（字母、数字、下划线、中划线、点号）
 // 2. 配置值根据 ValueType 进行类型验证
 // 3. 环境参数必须有效（dev/test/uat/prod）
+// 4. JSON/YAML 配置值需符合绑定的 JSON Schema（如有）
+// 5. 配置值需满足元数据 validators 中声明的校验规则（如有）
+// 6. 配置值大小不能超过命名空间配额（文件类型配置除外）
 func (s *ConfigService) ValidateConfig(ctx context.Context, config *entity.Config) error {
 	// 1. 验证配置键
 	if config.Key == "" {
@@ -371,24 +588,36 @@ func (s *ConfigService) ValidateConfig(ctx context.Context, config *entity.Confi
 		return domainErrors.ErrConfigValueEmpty(config.Key)
 	}
 
-	// 5. 根据 ValueType 进行详细的类型验证
-	if err := validateValueByType(config.Value, config.ValueType); err != nil {
+	// 5. 根据 ValueType 进行详细的类型验证，并执行元数据中声明的校验规则
+	if err := validateValueByType(config.Value, config.ValueType, config.Metadata); err != nil {
 		return err
 	}
 
+	// 6. 按绑定的 JSON Schema 校验结构化配置值
+	if s.schemaSvc != nil {
+		if err := s.schemaSvc.ValidateConfigValue(ctx, config); err != nil {
+			return err
+		}
+	}
+
+	// 7. 按命名空间配额校验配置值大小
+	if s.quotas != nil && config.ValueType != constants.ValueTypeFile {
+		if err := s.quotas.CheckValueSize(ctx, config.NamespaceID, config.Key, config.Value); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 // ComputeContentHash 计算配置内容的哈希值
-// 用于检测配置内容是否被篡改
+// 用于检测配置内容是否被篡改，算法为空时使用 md5
 func (s *ConfigService) ComputeContentHash(value string, algorithm string) (string, error) {
-	switch algorithm {
-	case constants.HashAlgorithmMD5:
-		hash := md5.Sum([]byte(value))
-		return hex.EncodeToString(hash[:]), nil
-	default:
+	hasher, ok := LookupContentHasher(algorithm)
+	if !ok {
 		return "", domainErrors.ErrUnsupportedHashAlgorithm(algorithm)
 	}
+	return hasher.Hash(value), nil
 }
 
 // VerifyContentHash 验证配置内容哈希
@@ -408,6 +637,70 @@ func (s *ConfigService) VerifyContentHash(ctx context.Context, config *entity.Co
 	return nil
 }
 
+// ContentHashMigrationResult 内容哈希迁移结果
+type ContentHashMigrationResult struct {
+	Algorithm  string   // 目标算法
+	Scanned    int      // 扫描的配置数量
+	Migrated   int      // 已迁移的配置数量（试运行时为待迁移数量）
+	Skipped    int      // 迁移期间配置被修改而跳过的数量
+	Mismatched []string // 原哈希校验失败而未迁移的配置（键@环境）
+}
+
+// MigrateContentHashes 将命名空间下配置的内容哈希迁移到当前的默认算法
+// 业务规则：
+// 1. 已使用默认算法的配置不处理
+// 2. 先按原算法校验哈希，校验失败的配置（内容可能被篡改）不迁移，在结果中返回
+// 3. 只更新哈希及算法，不修改配置值和版本号，不发布配置变更事件
+// 4. dryRun 为 true 时只统计待迁移的配置，不写入
+func (s *ConfigService) MigrateContentHashes(ctx context.Context, namespaceID int, dryRun bool) (*ContentHashMigrationResult, error) {
+	algorithm := s.HashAlgorithm()
+	configs, err := s.configRepo.FindByNamespace(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ContentHashMigrationResult{Algorithm: algorithm, Mismatched: []string{}}
+	for _, config := range configs {
+		result.Scanned++
+		if config.ContentHashAlgorithm == algorithm {
+			continue
+		}
+
+		// 1. 按原算法校验（早期数据可能没有哈希值，直接迁移）
+		if config.ContentHash != "" {
+			if err := s.VerifyContentHash(ctx, config); err != nil {
+				hlog.CtxWarnf(ctx, "内容哈希校验失败，跳过迁移: key=%s, env=%s, algorithm=%s, err=%v",
+					config.Key, config.Environment, config.ContentHashAlgorithm, err)
+				result.Mismatched = append(result.Mismatched, config.Key+"@"+config.Environment)
+				continue
+			}
+		}
+		if dryRun {
+			result.Migrated++
+			continue
+		}
+
+		// 2. 按原哈希值条件更新，期间配置值被修改时跳过
+		hash, err := s.ComputeContentHash(config.Value, algorithm)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := s.configRepo.UpdateContentHash(ctx, config.ID, config.ContentHash, hash, algorithm)
+		if err != nil {
+			return nil, err
+		}
+		if !updated {
+			result.Skipped++
+			continue
+		}
+		result.Migrated++
+	}
+
+	hlog.CtxInfof(ctx, "内容哈希迁移完成: namespace=%d, algorithm=%s, dry_run=%v, scanned=%d, migrated=%d, skipped=%d, mismatched=%d",
+		namespaceID, algorithm, dryRun, result.Scanned, result.Migrated, result.Skipped, len(result.Mismatched))
+	return result, nil
+}
+
 // BatchReleaseConfigs 批量发布配置
 // 用于批量发布某个命名空间或分组下的多个配置
 func (s *ConfigService) BatchReleaseConfigs(ctx context.Context, namespaceID int, environment string, groupName string) ([]int, error) {
@@ -451,6 +744,7 @@ func (s *ConfigService) BatchReleaseConfigs(ctx context.Context, namespaceID int
 // 业务规则：
 // 1. 配置必须存在
 // 2. 配置必须已发布且已激活
+// 3. 已到过期时间的配置视为失效（后台任务可能尚未处理）
 func (s *ConfigService) GetActiveConfig(ctx context.Context, namespaceID int, key string, environment string) (*entity.Config, error) {
 	// 1. 查询配置
 	config, err := s.configRepo.FindByNamespaceAndKey(ctx, namespaceID, key, environment)
@@ -466,14 +760,102 @@ func (s *ConfigService) GetActiveConfig(ctx context.Context, namespaceID int, ke
 		return nil, domainErrors.ErrConfigNotReleased(key)
 	}
 
-	// 3. 检查配置是否已激活
+	// 3. 检查配置是否已激活且未过期
 	if !config.IsActive {
 		return nil, domainErrors.ErrConfigNotActive(key)
 	}
+	if config.IsExpired(time.Now()) {
+		return nil, domainErrors.ErrConfigExpired(key)
+	}
 
 	return config, nil
 }
 
+// GetActiveConfigs 批量获取已发布且已激活的配置
+// 业务规则：
+// 1. 重复的配置键只查询一次，结果按配置键升序
+// 2. 不存在、未发布、未激活或已过期的配置不返回，其配置键放入 missing（按请求顺序）
+func (s *ConfigService) GetActiveConfigs(ctx context.Context, namespaceID int, keys []string, environment string) (configs []*entity.Config, missing []string, err error) {
+	// 1. 去重后一次查询
+	unique := make([]string, 0, len(keys))
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if !seen[key] {
+			seen[key] = true
+			unique = append(unique, key)
+		}
+	}
+	found, err := s.configRepo.FindByNamespaceAndKeys(ctx, namespaceID, unique, environment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 2. 过滤未发布、未激活或已过期的配置
+	now := time.Now()
+	configs = make([]*entity.Config, 0, len(found))
+	available := make(map[string]bool, len(found))
+	for _, config := range found {
+		if config.IsReleased && config.IsActive && !config.IsExpired(now) {
+			configs = append(configs, config)
+			available[config.Key] = true
+		}
+	}
+	missing = make([]string, 0)
+	for _, key := range unique {
+		if !available[key] {
+			missing = append(missing, key)
+		}
+	}
+	return configs, missing, nil
+}
+
+// GetEffectiveConfigs 获取生效配置
+// 业务规则：
+// 1. 仅包含已发布、已激活且未过期的配置
+// 2. 指定环境的配置覆盖默认环境中的同名配置
+// 3. 可按分组过滤，结果按配置键排序
+func (s *ConfigService) GetEffectiveConfigs(ctx context.Context, namespaceID int, environment string, groupName string) ([]*entity.Config, error) {
+	// 1. 校验环境
+	if environment == "" {
+		environment = constants.EnvDefault
+	}
+	if !contains(constants.ValidEnvironments, environment) {
+		return nil, domainErrors.ErrConfigEnvironmentInvalid(environment)
+	}
+
+	// 2. 按环境优先级合并：默认环境在前，指定环境覆盖
+	environments := []string{constants.EnvDefault}
+	if environment != constants.EnvDefault {
+		environments = append(environments, environment)
+	}
+
+	now := time.Now()
+	effective := make(map[string]*entity.Config)
+	for _, env := range environments {
+		configs, err := s.configRepo.FindReleasedConfigs(ctx, namespaceID, env)
+		if err != nil {
+			return nil, err
+		}
+		for _, config := range configs {
+			if !config.IsActive || config.IsExpired(now) || (groupName != "" && config.GroupName != groupName) {
+				continue
+			}
+			effective[config.Key] = config
+		}
+	}
+
+	// 3. 按配置键排序返回
+	result := make([]*entity.Config, 0, len(effective))
+	for _, config := range effective {
+		result = append(result, config)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+
+	return result, nil
+}
+
 // GetByID 根据ID获取配置
 // 简单的查询方法，不做业务验证
 func (s *ConfigService) GetByID(ctx context.Context, id int) (*entity.Config, error) {
@@ -493,6 +875,80 @@ func (s *ConfigService) QueryConfigs(ctx context.Context, params *repository.Con
 	return s.configRepo.QueryByParams(ctx, params)
 }
 
+// QueryDeletedConfigs 分页查询回收站中已删除的配置
+func (s *ConfigService) QueryDeletedConfigs(ctx context.Context, params *repository.ConfigQueryParams) (*shareRepo.PageResult[*entity.Config], error) {
+	return s.configRepo.QueryDeletedByParams(ctx, params)
+}
+
+// maxSearchQueryLength 搜索关键字最大长度
+const maxSearchQueryLength = 200
+
+// QueryConfigsByTags 按标签分页查询配置
+// 业务规则：
+// 1. 多个标签为 AND 关系，配置须同时包含所有标签（标签键和值均匹配）
+// 2. 其余查询条件（命名空间、环境等）与普通分页查询一致
+// 3. 没有配置匹配全部标签时返回空分页结果
+func (s *ConfigService) QueryConfigsByTags(ctx context.Context, tags []entity.TagInput, params *repository.ConfigQueryParams) (*shareRepo.PageResult[*entity.Config], error) {
+	// 1. 校验标签
+	if len(tags) == 0 {
+		return nil, domainErrors.ErrConfigTagInvalid("查询标签不能为空")
+	}
+	for _, tag := range tags {
+		if err := tag.Validate(); err != nil {
+			return nil, domainErrors.ErrConfigTagInvalid(err.Error())
+		}
+	}
+
+	// 2. 查询同时包含所有标签的配置ID
+	if s.tagSvc == nil {
+		return shareRepo.NewPageResult([]*entity.Config{}, 0, params.Page, params.Size), nil
+	}
+	configIDs, err := s.tagSvc.QueryConfigIDsByTags(ctx, tags)
+	if err != nil {
+		return nil, err
+	}
+	if len(configIDs) == 0 {
+		return shareRepo.NewPageResult([]*entity.Config{}, 0, params.Page, params.Size), nil
+	}
+
+	// 3. 在匹配的配置ID范围内分页查询
+	params.IDs = configIDs
+	return s.configRepo.QueryByParams(ctx, params)
+}
+
+// SearchConfigs 按关键字搜索配置
+// 业务规则：
+// 1. 关键字去除首尾空白后不能为空，长度不超过 200
+// 2. 搜索字段只能是 key/description/group/value，为空时搜索全部字段
+// 3. 启用脱敏时敏感配置不按值匹配，加密配置始终不按值匹配
+func (s *ConfigService) SearchConfigs(ctx context.Context, params *repository.ConfigSearchParams) (*shareRepo.PageResult[*entity.Config], error) {
+	// 1. 校验关键字
+	params.Query = strings.TrimSpace(params.Query)
+	if params.Query == "" {
+		return nil, domainErrors.ErrConfigSearchInvalid("搜索关键字不能为空")
+	}
+	if utf8.RuneCountInString(params.Query) > maxSearchQueryLength {
+		return nil, domainErrors.ErrConfigSearchInvalid("搜索关键字长度不能超过 " + strconv.Itoa(maxSearchQueryLength))
+	}
+
+	// 2. 校验搜索字段
+	for _, field := range params.Fields {
+		switch field {
+		case repository.ConfigSearchFieldKey, repository.ConfigSearchFieldDescription,
+			repository.ConfigSearchFieldGroup, repository.ConfigSearchFieldValue:
+		default:
+			return nil, domainErrors.ErrConfigSearchInvalid("不支持的搜索字段: " + field + "（可选值: key/description/group/value）")
+		}
+	}
+
+	// 3. 敏感配置不按值匹配
+	if s.maskingSvc != nil {
+		params.SensitiveKeywords = s.maskingSvc.SensitiveKeywords()
+	}
+
+	return s.configRepo.Search(ctx, params)
+}
+
 // ==================== 辅助函数 ====================
 
 // isValidConfigKey 验证配置键是否符合命名规范
@@ -528,7 +984,20 @@ func contains(slice []string, item string) bool {
 // ==================== 值类型验证函数 ====================
 
 // validateValueByType 根据类型验证配置值
-func validateValueByType(value string, valueType string) error {
+// 类型校验通过后，再按元数据中声明的校验规则（regex/range/enum/url/cron 等）校验
+// 加密类型的值为密文，不执行校验规则
+func validateValueByType(value string, valueType string, metadata string) error {
+	if err := validateValueTypeOnly(value, valueType); err != nil {
+		return err
+	}
+	if valueType == constants.ValueTypeEncrypted || valueType == constants.ValueTypeFile {
+		return nil
+	}
+	return defaultValidatorRegistry.ValidateValue(value, metadata)
+}
+
+// validateValueTypeOnly 仅根据类型验证配置值
+func validateValueTypeOnly(value string, valueType string) error {
 	switch valueType {
 	case constants.ValueTypeString:
 		return validateStringValue(value)
@@ -542,6 +1011,8 @@ func validateValueByType(value string, valueType string) error {
 		return validateJSONValue(value)
 	case constants.ValueTypeYAML:
 		return validateYAMLValue(value)
+	case constants.ValueTypeFile:
+		return validateFileValue(value)
 	default:
 		// 如果没有指定类型或类型不在预定义列表中，默认按 string 处理
 		return nil
@@ -634,30 +1105,121 @@ func validateYAMLValue(value string) error {
 	return nil
 }
 
+// validateFileValue 验证文件类型的值
+// 文件类型配置的值为内容引用（sha256:<内容哈希>），内容通过文件上传接口写入
+func validateFileValue(value string) error {
+	if _, ok := entity.ParseFileReference(value); !ok {
+		return domainErrors.ErrConfigValueTypeInvalid("file", "值必须为文件内容引用（sha256:<64位十六进制哈希>），请通过文件上传接口写入内容")
+	}
+	return nil
+}
+
+// withEventTx 在工作单元中执行配置写入、变更历史记录及事件发布
+// 配置写入（含版本号递增）与变更历史在同一事务内保存，任一步骤失败时整体回滚；
+// 启用发件箱时事件同事务保存，未启用时事件暂存，均在事务提交后发布，避免订阅方读取到未提交的数据
+// 已处于批量变更中时直接执行，由批量变更统一保存和发布
+func (s *ConfigService) withEventTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(configChangeBatchKey{}).(*configChangeBatch); ok {
+		return fn(ctx)
+	}
+
+	unit := &configChangeBatch{}
+	err := s.runInTx(context.WithValue(ctx, configChangeBatchKey{}, unit), func(txCtx context.Context) error {
+		if err := fn(txCtx); err != nil {
+			return err
+		}
+		return s.saveChangeBatch(txCtx, unit)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.publishConfigChangeEvents(ctx, unit.events)
+	return nil
+}
+
+// runInTx 在事务中执行操作，未设置事务管理器时使用配置仓储的事务
+func (s *ConfigService) runInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.txManager != nil {
+		return s.txManager.RunInTx(ctx, fn)
+	}
+	return s.configRepo.WithTx(ctx, fn)
+}
+
+// saveChangeBatch 在事务内保存暂存的变更历史，启用发件箱时同时保存变更事件
+func (s *ConfigService) saveChangeBatch(ctx context.Context, batch *configChangeBatch) error {
+	if s.changeHistorySvc != nil {
+		for _, record := range batch.records {
+			if err := s.changeHistorySvc.RecordChangeWithTx(ctx, record); err != nil {
+				return err
+			}
+		}
+	}
+	if s.outbox != nil && len(batch.events) > 0 {
+		return s.outbox.Enqueue(ctx, batch.events...)
+	}
+	return nil
+}
+
 // publishConfigChangeEvent 发布配置变更事件
-func (s *ConfigService) publishConfigChangeEvent(ctx context.Context, event *listener.ConfigChangeEvent) {
-	if s.listener == nil {
-		return
+// 启用发件箱时写入发件箱（需在配置写入的事务上下文中调用），否则异步发布
+// 启用 Webhook 时同时写入命名空间 Webhook 的投递记录
+func (s *ConfigService) publishConfigChangeEvent(ctx context.Context, event *listener.ConfigChangeEvent) error {
+	if s.webhooks != nil {
+		if err := s.webhooks.NotifyConfigChange(ctx, event); err != nil {
+			return err
+		}
+	}
+	if s.listener == nil && s.outbox == nil {
+		return nil
+	}
+	event.TraceContext = tracing.Inject(ctx)
+
+	// 工作单元或批量变更中暂存事件，事务提交后统一处理
+	if batch, ok := ctx.Value(configChangeBatchKey{}).(*configChangeBatch); ok {
+		batch.events = append(batch.events, event)
+		return nil
+	}
+
+	if s.outbox != nil {
+		return s.outbox.Enqueue(ctx, event)
 	}
 
 	// 异步发布事件，不阻塞主流程
 	go func() {
 		if err := s.listener.Publish(ctx, event); err != nil {
-			hlog.Errorf("发布配置变更事件失败: %v, event: %+v", err, event)
+			hlog.CtxErrorf(ctx, "发布配置变更事件失败: %v, event: %+v", err, event)
 		}
 	}()
+	return nil
+}
+
+// configSpanAttributes 配置相关 span 的公共属性
+func configSpanAttributes(config *entity.Config) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.Int("config.id", config.ID),
+		attribute.Int("config.namespace_id", config.NamespaceID),
+		attribute.String("config.key", config.Key),
+		attribute.String("config.environment", config.Environment),
+	}
 }
 
 // recordChangeHistory 记录配置变更历史
+// 在工作单元或批量变更中暂存变更记录，与配置写入在同一事务内保存
 func (s *ConfigService) recordChangeHistory(ctx context.Context, record *entity.ChangeRecord) {
 	if s.changeHistorySvc == nil {
 		return
 	}
 
+	if batch, ok := ctx.Value(configChangeBatchKey{}).(*configChangeBatch); ok {
+		batch.records = append(batch.records, record)
+		return
+	}
+
 	// 异步保存，不阻塞主流程
 	go func() {
 		if err := s.changeHistorySvc.RecordChange(context.Background(), record); err != nil {
-			hlog.Errorf("记录变更历史失败: %v, record: %+v", err, record)
+			hlog.CtxErrorf(ctx, "记录变更历史失败: %v, record: %+v", err, record)
 		}
 	}()
 }
@@ -670,6 +1232,14 @@ func (s *ConfigService) getOperator(ctx context.Context) string {
 	return "system"
 }
 
+// getChangeReason 从 context 中获取变更原因，未设置时使用默认原因
+func (s *ConfigService) getChangeReason(ctx context.Context, defaultReason string) string {
+	if reason, ok := ctx.Value(shareConstants.ChangeReasonKey).(string); ok && reason != "" {
+		return reason
+	}
+	return defaultReason
+}
+
 // getOperatorIP 从 context 中获取操作人IP
 func (s *ConfigService) getOperatorIP(ctx context.Context) string {
 	if ip, ok := ctx.Value(shareConstants.OperatorIPKey).(string); ok {
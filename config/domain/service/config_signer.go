@@ -0,0 +1,37 @@
+package service
+
+import (
+	"crypto/ed25519"
+
+	"config-client/share/config-client/signing"
+)
+
+// ConfigSigner 配置值签名服务
+// 使用 Ed25519 私钥对下发给客户端的配置值签名，客户端使用对应的公钥校验后再应用配置值
+type ConfigSigner struct {
+	privateKey ed25519.PrivateKey
+	keyID      string // 签名密钥ID（随签名返回，便于客户端在密钥轮换时选择公钥）
+}
+
+// NewConfigSigner 创建配置值签名服务
+func NewConfigSigner(privateKey ed25519.PrivateKey, keyID string) *ConfigSigner {
+	return &ConfigSigner{
+		privateKey: privateKey,
+		keyID:      keyID,
+	}
+}
+
+// Sign 对配置值签名，返回 base64 编码的签名
+func (s *ConfigSigner) Sign(namespaceID int, environment string, key string, value string) string {
+	return signing.Sign(s.privateKey, namespaceID, environment, key, value)
+}
+
+// KeyID 签名密钥ID
+func (s *ConfigSigner) KeyID() string {
+	return s.keyID
+}
+
+// PublicKey 签名公钥（分发给客户端用于校验）
+func (s *ConfigSigner) PublicKey() ed25519.PublicKey {
+	return s.privateKey.Public().(ed25519.PublicKey)
+}
@@ -168,6 +168,24 @@ func (s *ConfigTagService) AutoGenerateTags(ctx context.Context, config *entity.
 	return tags
 }
 
+// autoTagKeys 自动生成的标签键（重新生成时只替换这些标签，手动添加的标签保持不变）
+var autoTagKeys = []string{"sensitive", "category", "type", "environment", "importance"}
+
+// RegenerateAutoTags 根据配置当前属性重新生成自动标签
+// 业务规则：
+// 1. 先删除所有自动标签键下的标签（配置分组、环境变化后旧标签不再适用）
+// 2. 再按当前配置属性生成自动标签
+// 3. 其他标签键下手动添加的标签保持不变
+func (s *ConfigTagService) RegenerateAutoTags(ctx context.Context, config *entity.Config) error {
+	// 1. 删除旧的自动标签
+	if err := s.RemoveTags(ctx, config.ID, autoTagKeys); err != nil {
+		return err
+	}
+
+	// 2. 生成并保存新的自动标签
+	return s.AddTags(ctx, config.ID, s.AutoGenerateTags(ctx, config))
+}
+
 // inferImportance 推断配置的重要程度
 func (s *ConfigTagService) inferImportance(key, groupName string) string {
 	lowerKey := strings.ToLower(key)
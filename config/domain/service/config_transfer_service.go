@@ -0,0 +1,1033 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"config-client/config/domain/constants"
+	"config-client/config/domain/entity"
+	domainErrors "config-client/config/domain/errors"
+	"config-client/config/domain/repository"
+	shareConstants "config-client/share/constants"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// ConfigTransferService 配置导入导出领域服务
+// 负责配置在外部文件格式与配置中心之间的批量迁移
+type ConfigTransferService struct {
+	configRepo    repository.ConfigRepository
+	namespaceRepo repository.NamespaceRepository
+	configSvc     *ConfigService
+	namespaceSvc  *NamespaceService
+	tagSvc        *ConfigTagService        // 标签服务（可选）
+	maskingSvc    *MaskingService          // 脱敏服务（可选）
+	resolver      *ConfigReferenceResolver // 引用解析服务（可选，用于渲染命名空间配置）
+}
+
+// NewConfigTransferService 创建配置导入导出服务实例
+func NewConfigTransferService(
+	configRepo repository.ConfigRepository,
+	namespaceRepo repository.NamespaceRepository,
+	configSvc *ConfigService,
+	namespaceSvc *NamespaceService,
+	tagSvc *ConfigTagService,
+	maskingSvc *MaskingService,
+	resolver *ConfigReferenceResolver,
+) *ConfigTransferService {
+	return &ConfigTransferService{
+		configRepo:    configRepo,
+		namespaceRepo: namespaceRepo,
+		configSvc:     configSvc,
+		namespaceSvc:  namespaceSvc,
+		tagSvc:        tagSvc,
+		maskingSvc:    maskingSvc,
+		resolver:      resolver,
+	}
+}
+
+// ==================== 配置导入 ====================
+
+// ImportAction 导入动作
+type ImportAction string
+
+const (
+	ImportActionCreate    ImportAction = "create"    // 新建配置
+	ImportActionUpdate    ImportAction = "update"    // 覆盖已有配置
+	ImportActionSkip      ImportAction = "skip"      // 已存在且按策略跳过
+	ImportActionUnchanged ImportAction = "unchanged" // 已存在且值未变化
+	ImportActionFailed    ImportAction = "failed"    // 校验或写入失败
+)
+
+// ImportConfigsRequest 导入配置请求
+type ImportConfigsRequest struct {
+	NamespaceID int
+	Environment string
+	GroupName   string
+	Format      string
+	Content     string
+	Strategy    string // 冲突策略：skip/overwrite/fail
+	DryRun      bool   // 仅预览，不写入
+	Operator    string
+}
+
+// ImportItemResult 单个配置的导入结果
+type ImportItemResult struct {
+	Key       string
+	ValueType string
+	Action    ImportAction
+	Reason    string
+}
+
+// ImportResult 导入结果
+type ImportResult struct {
+	DryRun    bool
+	Total     int
+	Created   int
+	Updated   int
+	Skipped   int
+	Unchanged int
+	Failed    int
+	Items     []*ImportItemResult
+}
+
+// importPlanItem 导入计划项
+type importPlanItem struct {
+	entry    *ConfigEntry
+	existing *entity.Config
+	result   *ImportItemResult
+}
+
+// ImportConfigs 批量导入配置
+// 业务规则：
+// 1. 解析内容并为每个配置键生成导入计划（新建/覆盖/跳过/未变化/失败）
+// 2. strategy=fail 时，只要存在值不同的已有配置就整体失败，不写入任何数据
+// 3. dry_run=true 时只返回导入计划，不写入
+// 4. 单个配置写入失败不影响其他配置，失败原因记录在结果中
+func (s *ConfigTransferService) ImportConfigs(ctx context.Context, req *ImportConfigsRequest) (*ImportResult, error) {
+	// 1. 校验参数
+	if req.Environment == "" {
+		req.Environment = constants.EnvDefault
+	}
+	if req.GroupName == "" {
+		req.GroupName = constants.DefaultGroupName
+	}
+	if req.Strategy == "" {
+		req.Strategy = constants.ImportStrategySkip
+	}
+	if !contains(constants.ValidImportStrategies, req.Strategy) {
+		return nil, domainErrors.ErrConfigImportStrategyInvalid(req.Strategy)
+	}
+	if !contains(constants.ValidEnvironments, req.Environment) {
+		return nil, domainErrors.ErrConfigEnvironmentInvalid(req.Environment)
+	}
+
+	// 2. 解析内容
+	entries, err := ParseConfigContent(req.Format, req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 生成导入计划
+	plan, err := s.buildImportPlan(ctx, req, entries)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. fail 策略：存在冲突时整体失败
+	if req.Strategy == constants.ImportStrategyFail {
+		conflicts := make([]string, 0)
+		for _, item := range plan {
+			if item.result.Action == ImportActionUpdate {
+				conflicts = append(conflicts, item.entry.Key)
+			}
+		}
+		if len(conflicts) > 0 {
+			return nil, domainErrors.ErrConfigImportConflict(conflicts)
+		}
+	}
+
+	// 5. 执行导入（预览模式跳过）
+	if !req.DryRun {
+		for _, item := range plan {
+			s.applyImportItem(ctx, req, item)
+		}
+		hlog.CtxInfof(ctx, "配置导入完成: namespace=%d, env=%s, format=%s, total=%d",
+			req.NamespaceID, req.Environment, req.Format, len(plan))
+	}
+
+	// 6. 汇总结果
+	result := &ImportResult{
+		DryRun: req.DryRun,
+		Total:  len(plan),
+		Items:  make([]*ImportItemResult, 0, len(plan)),
+	}
+	for _, item := range plan {
+		switch item.result.Action {
+		case ImportActionCreate:
+			result.Created++
+		case ImportActionUpdate:
+			result.Updated++
+		case ImportActionSkip:
+			result.Skipped++
+		case ImportActionUnchanged:
+			result.Unchanged++
+		case ImportActionFailed:
+			result.Failed++
+		}
+		result.Items = append(result.Items, item.result)
+	}
+
+	return result, nil
+}
+
+// buildImportPlan 生成导入计划
+func (s *ConfigTransferService) buildImportPlan(ctx context.Context, req *ImportConfigsRequest, entries []*ConfigEntry) ([]*importPlanItem, error) {
+	plan := make([]*importPlanItem, 0, len(entries))
+
+	for _, entry := range entries {
+		item := &importPlanItem{
+			entry: entry,
+			result: &ImportItemResult{
+				Key:       entry.Key,
+				ValueType: entry.ValueType,
+			},
+		}
+		plan = append(plan, item)
+
+		// 1. 基础校验（键格式、值类型）
+		candidate := &entity.Config{
+			NamespaceID: req.NamespaceID,
+			Key:         entry.Key,
+			Value:       entry.Value,
+			ValueType:   entry.ValueType,
+			Environment: req.Environment,
+		}
+		if err := s.configSvc.ValidateConfig(ctx, candidate); err != nil {
+			item.result.Action = ImportActionFailed
+			item.result.Reason = err.Error()
+			continue
+		}
+
+		// 2. 查询已有配置
+		existing, err := s.configRepo.FindByNamespaceAndKey(ctx, req.NamespaceID, entry.Key, req.Environment)
+		if err != nil {
+			return nil, err
+		}
+		if existing == nil {
+			item.result.Action = ImportActionCreate
+			continue
+		}
+		item.existing = existing
+
+		// 3. 值未变化
+		if s.plainValue(ctx, existing) == entry.Value {
+			item.result.Action = ImportActionUnchanged
+			continue
+		}
+
+		// 4. 按策略处理冲突
+		switch req.Strategy {
+		case constants.ImportStrategySkip:
+			item.result.Action = ImportActionSkip
+			item.result.Reason = "配置已存在"
+		default:
+			item.result.Action = ImportActionUpdate
+			if existing.IsReleased {
+				item.result.Action = ImportActionFailed
+				item.result.Reason = "配置已发布，无法覆盖，请先取消发布"
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+// applyImportItem 执行单个导入计划项
+func (s *ConfigTransferService) applyImportItem(ctx context.Context, req *ImportConfigsRequest, item *importPlanItem) {
+	var err error
+
+	switch item.result.Action {
+	case ImportActionCreate:
+		config := &entity.Config{
+			NamespaceID: req.NamespaceID,
+			Key:         item.entry.Key,
+			Value:       item.entry.Value,
+			ValueType:   item.entry.ValueType,
+			GroupName:   req.GroupName,
+			Environment: req.Environment,
+			Metadata:    "{}",
+		}
+		config.CreatedBy = req.Operator
+		config.UpdatedBy = req.Operator
+		err = s.configSvc.CreateConfig(ctx, config)
+	case ImportActionUpdate:
+		err = s.overwriteConfig(ctx, item.existing, item.entry.Value, item.entry.ValueType, req.Operator)
+	default:
+		return
+	}
+
+	if err != nil {
+		hlog.CtxWarnf(ctx, "导入配置失败: key=%s, err=%v", item.entry.Key, err)
+		item.result.Action = ImportActionFailed
+		item.result.Reason = err.Error()
+	}
+}
+
+// overwriteConfig 使用明文值覆盖已有配置
+// 以下情况加密存储（配置服务更新配置时不会自动加密）：值来自加密存储的配置（valueType 为 encrypted）、
+// 被覆盖的配置已加密、配置键为敏感键（文件类型配置的值为内容引用，不加密）
+func (s *ConfigTransferService) overwriteConfig(ctx context.Context, existing *entity.Config, value string, valueType string, operator string) error {
+	encrypt := valueType == constants.ValueTypeEncrypted || existing.ValueType == constants.ValueTypeEncrypted ||
+		(valueType != constants.ValueTypeFile && s.maskingSvc != nil && s.maskingSvc.IsSensitiveKey(existing.Key))
+	if encrypt && s.maskingSvc != nil {
+		encrypted, err := s.maskingSvc.EncryptValue(value)
+		if err != nil {
+			return err
+		}
+		value = encrypted
+		valueType = constants.ValueTypeEncrypted
+	}
+
+	config := &entity.Config{
+		NamespaceID: existing.NamespaceID,
+		Key:         existing.Key,
+		Environment: existing.Environment,
+		Value:       value,
+		ValueType:   valueType,
+		GroupName:   existing.GroupName,
+		Description: existing.Description,
+		Metadata:    existing.Metadata,
+	}
+	config.ID = existing.ID
+	config.UpdatedBy = operator
+	return s.configSvc.UpdateConfig(ctx, config)
+}
+
+// ==================== 配置导出 ====================
+
+// ExportConfigsRequest 导出配置请求
+type ExportConfigsRequest struct {
+	NamespaceID    int
+	Environment    string
+	GroupName      string // 仅导出指定分组（可选）
+	Format         string
+	IncludeSecrets bool // 是否导出敏感配置的明文，否则输出脱敏值
+}
+
+// ExportResult 导出结果
+type ExportResult struct {
+	NamespaceName string
+	Environment   string
+	GroupName     string
+	Format        string
+	ContentType   string
+	Content       string
+	Count         int
+}
+
+// ExportConfigs 导出命名空间下已发布的配置
+// 业务规则：
+// 1. 命名空间必须存在
+// 2. 只导出指定环境下已发布且已激活的配置，指定分组时只导出该分组的配置
+// 3. 加密配置默认输出脱敏值，include_secrets=true 时输出解密后的明文
+func (s *ConfigTransferService) ExportConfigs(ctx context.Context, req *ExportConfigsRequest) (*ExportResult, error) {
+	// 1. 校验参数
+	if req.Environment == "" {
+		req.Environment = constants.EnvDefault
+	}
+	if !contains(constants.ValidEnvironments, req.Environment) {
+		return nil, domainErrors.ErrConfigEnvironmentInvalid(req.Environment)
+	}
+	format := normalizeFormat(req.Format)
+	if format == "" {
+		format = constants.ConfigFormatYAML
+	}
+	if !contains(constants.ValidConfigFormats, format) {
+		return nil, domainErrors.ErrConfigFormatUnsupported(req.Format)
+	}
+
+	// 2. 检查命名空间
+	namespace, err := s.namespaceRepo.GetByID(ctx, req.NamespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if namespace == nil {
+		return nil, domainErrors.ErrNamespaceNotFound("")
+	}
+
+	// 3. 查询已发布配置
+	configs, err := s.configRepo.FindReleasedConfigs(ctx, req.NamespaceID, req.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*ConfigEntry, 0, len(configs))
+	for _, config := range configs {
+		if !config.IsActive || (req.GroupName != "" && config.GroupName != req.GroupName) {
+			continue
+		}
+		entries = append(entries, s.toExportEntry(ctx, config, req.IncludeSecrets))
+	}
+
+	// 4. 渲染文档
+	content, err := RenderConfigContent(format, entries)
+	if err != nil {
+		return nil, err
+	}
+
+	hlog.CtxInfof(ctx, "配置导出完成: namespace=%s, env=%s, group=%s, format=%s, count=%d, includeSecrets=%v",
+		namespace.Name, req.Environment, req.GroupName, format, len(entries), req.IncludeSecrets)
+
+	return &ExportResult{
+		NamespaceName: namespace.Name,
+		Environment:   req.Environment,
+		GroupName:     req.GroupName,
+		Format:        format,
+		ContentType:   ConfigFormatContentType(format),
+		Content:       content,
+		Count:         len(entries),
+	}, nil
+}
+
+// toExportEntry 将配置转换为导出条目
+func (s *ConfigTransferService) toExportEntry(ctx context.Context, config *entity.Config, includeSecrets bool) *ConfigEntry {
+	entry := &ConfigEntry{
+		Key:       config.Key,
+		Value:     config.Value,
+		ValueType: config.ValueType,
+	}
+
+	isSensitive := config.ValueType == constants.ValueTypeEncrypted ||
+		(s.maskingSvc != nil && s.maskingSvc.IsSensitiveKey(config.Key))
+	if !isSensitive {
+		return entry
+	}
+
+	// 敏感配置统一按字符串输出
+	entry.ValueType = constants.ValueTypeString
+	if includeSecrets {
+		entry.Value = s.plainValue(ctx, config)
+	} else if s.maskingSvc != nil {
+		entry.Value = s.maskingSvc.MaskValue(s.plainValue(ctx, config))
+	}
+	return entry
+}
+
+// ==================== 命名空间渲染 ====================
+
+// RenderNamespaceRequest 渲染命名空间配置请求
+type RenderNamespaceRequest struct {
+	NamespaceID    int
+	Environment    string
+	Format         string
+	IncludeSecrets bool // 是否输出敏感配置的明文，否则输出脱敏值
+}
+
+// RenderNamespace 将命名空间的生效配置渲染为单个文档，供 sidecar 一次拉取完整配置文件
+// 业务规则：
+// 1. 命名空间必须存在
+// 2. 与生效配置一致：只包含已发布、已激活且未过期的配置，指定环境覆盖默认环境中的同名配置
+// 3. 配置值中的 ${namespace:key} 引用按指定环境解析，解析失败时返回错误，不输出不完整的文档
+// 4. 文件类型配置不输出，通过文件下载接口单独获取
+// 5. 敏感配置（含引用了敏感配置的值）默认输出脱敏值，include_secrets=true 时输出明文
+func (s *ConfigTransferService) RenderNamespace(ctx context.Context, req *RenderNamespaceRequest) (*ExportResult, error) {
+	// 1. 校验参数
+	if req.Environment == "" {
+		req.Environment = constants.EnvDefault
+	}
+	format := normalizeFormat(req.Format)
+	if format == "" {
+		format = constants.ConfigFormatYAML
+	}
+	if !contains(constants.ValidConfigFormats, format) {
+		return nil, domainErrors.ErrConfigFormatUnsupported(req.Format)
+	}
+
+	// 2. 检查命名空间
+	namespace, err := s.namespaceRepo.GetByID(ctx, req.NamespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if namespace == nil {
+		return nil, domainErrors.ErrNamespaceNotFound("")
+	}
+
+	// 3. 查询合并后的生效配置（环境在此校验）
+	configs, err := s.configSvc.GetEffectiveConfigs(ctx, req.NamespaceID, req.Environment, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. 解析引用并转换为渲染条目
+	entries := make([]*ConfigEntry, 0, len(configs))
+	for _, config := range configs {
+		if config.ValueType == constants.ValueTypeFile {
+			continue
+		}
+		entry, err := s.toRenderEntry(ctx, config, req.Environment, req.IncludeSecrets)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	// 5. 渲染文档
+	content, err := RenderConfigContent(format, entries)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExportResult{
+		NamespaceName: namespace.Name,
+		Environment:   req.Environment,
+		Format:        format,
+		ContentType:   ConfigFormatContentType(format),
+		Content:       content,
+		Count:         len(entries),
+	}, nil
+}
+
+// toRenderEntry 将生效配置转换为渲染条目，引用按请求的环境解析而不是配置的来源环境
+func (s *ConfigTransferService) toRenderEntry(ctx context.Context, config *entity.Config, environment string, includeSecrets bool) (*ConfigEntry, error) {
+	entry := &ConfigEntry{
+		Key:       config.Key,
+		Value:     s.plainValue(ctx, config),
+		ValueType: config.ValueType,
+	}
+	isSensitive := config.ValueType == constants.ValueTypeEncrypted ||
+		(s.maskingSvc != nil && s.maskingSvc.IsSensitiveKey(config.Key))
+
+	if s.resolver != nil && HasConfigReference(entry.Value) {
+		target := *config
+		target.Environment = environment
+		resolved, err := s.resolver.Resolve(ctx, &target)
+		if err != nil {
+			return nil, err
+		}
+		entry.Value = resolved.Value
+		isSensitive = isSensitive || resolved.Sensitive
+	}
+
+	if !isSensitive {
+		return entry, nil
+	}
+
+	// 敏感配置统一按字符串输出
+	entry.ValueType = constants.ValueTypeString
+	if !includeSecrets && s.maskingSvc != nil {
+		entry.Value = s.maskingSvc.MaskValue(entry.Value)
+	}
+	return entry, nil
+}
+
+// ==================== 环境晋升 ====================
+
+// PromotionAction 晋升动作
+type PromotionAction string
+
+const (
+	PromotionActionCreate PromotionAction = "create" // 目标环境新建配置
+	PromotionActionUpdate PromotionAction = "update" // 覆盖目标环境配置
+	PromotionActionDelete PromotionAction = "delete" // 删除目标环境多余配置
+	PromotionActionSkip   PromotionAction = "skip"   // 未确认删除而跳过
+	PromotionActionFailed PromotionAction = "failed" // 执行失败
+)
+
+// PromotionRequest 环境晋升请求
+type PromotionRequest struct {
+	NamespaceID       int
+	SourceEnvironment string
+	TargetEnvironment string
+	GroupName         string   // 仅晋升指定分组（可选）
+	Keys              []string // 仅晋升指定配置键（可选）
+	IncludeUnreleased bool     // 是否包含源环境未发布的配置
+}
+
+// PromotionDiffItem 晋升差异项
+// 敏感配置的新旧值均为脱敏值
+type PromotionDiffItem struct {
+	Key            string
+	ValueType      string
+	OldValue       string
+	NewValue       string
+	IsSensitive    bool
+	TargetReleased bool // 目标配置已发布，需先取消发布才能覆盖或删除
+
+	source *entity.Config
+	target *entity.Config
+}
+
+// PromotionDiff 晋升差异
+type PromotionDiff struct {
+	NamespaceID       int
+	SourceEnvironment string
+	TargetEnvironment string
+	Added             []*PromotionDiffItem
+	Modified          []*PromotionDiffItem
+	Deleted           []*PromotionDiffItem
+	DiffToken         string // 差异摘要，确认执行时需回传
+}
+
+// ApplyPromotionRequest 执行环境晋升请求
+type ApplyPromotionRequest struct {
+	PromotionRequest
+	DiffToken      string // 预览时返回的差异摘要
+	ApplyDeletions bool   // 是否删除目标环境中源环境不存在的配置
+	Operator       string
+}
+
+// PromotionItemResult 单个配置的晋升结果
+type PromotionItemResult struct {
+	Key    string
+	Action PromotionAction
+	Reason string
+}
+
+// PromotionResult 晋升结果
+type PromotionResult struct {
+	Diff    *PromotionDiff
+	Applied int
+	Skipped int
+	Failed  int
+	Items   []*PromotionItemResult
+}
+
+// PreviewPromotion 预览环境晋升差异
+// 对比源环境与目标环境的配置，返回新增、修改、删除三类差异以及差异摘要
+func (s *ConfigTransferService) PreviewPromotion(ctx context.Context, req *PromotionRequest) (*PromotionDiff, error) {
+	if err := s.validatePromotionRequest(req); err != nil {
+		return nil, err
+	}
+	return s.computePromotionDiff(ctx, req)
+}
+
+// ApplyPromotion 执行环境晋升
+// 业务规则：
+// 1. 重新计算差异，与预览时的差异摘要不一致则拒绝执行
+// 2. 新增和修改的配置在目标环境中为未发布状态，需走正常发布流程
+// 3. 删除仅在 apply_deletions=true 时执行
+// 4. 每个配置的变更都会记录变更历史，变更原因为晋升来源
+func (s *ConfigTransferService) ApplyPromotion(ctx context.Context, req *ApplyPromotionRequest) (*PromotionResult, error) {
+	// 1. 校验参数并重新计算差异
+	if err := s.validatePromotionRequest(&req.PromotionRequest); err != nil {
+		return nil, err
+	}
+	diff, err := s.computePromotionDiff(ctx, &req.PromotionRequest)
+	if err != nil {
+		return nil, err
+	}
+	if diff.DiffToken != req.DiffToken {
+		return nil, domainErrors.ErrPromotionDiffChanged()
+	}
+
+	// 2. 设置操作人和变更原因，由配置服务写入变更历史
+	if req.Operator != "" {
+		ctx = context.WithValue(ctx, shareConstants.OperatorKey, req.Operator)
+	}
+	ctx = context.WithValue(ctx, shareConstants.ChangeReasonKey,
+		fmt.Sprintf("环境晋升: %s -> %s", req.SourceEnvironment, req.TargetEnvironment))
+
+	result := &PromotionResult{Diff: diff}
+	record := func(key string, action PromotionAction, err error) {
+		item := &PromotionItemResult{Key: key, Action: action}
+		switch {
+		case err != nil:
+			hlog.CtxWarnf(ctx, "环境晋升失败: key=%s, action=%s, err=%v", key, action, err)
+			item.Action = PromotionActionFailed
+			item.Reason = err.Error()
+			result.Failed++
+		case action == PromotionActionSkip:
+			item.Reason = "未确认删除"
+			result.Skipped++
+		default:
+			result.Applied++
+		}
+		result.Items = append(result.Items, item)
+	}
+
+	// 3. 新增
+	for _, item := range diff.Added {
+		_, err := s.copyConfig(ctx, item.source, item.source.NamespaceID, req.TargetEnvironment, req.Operator)
+		record(item.Key, PromotionActionCreate, err)
+	}
+
+	// 4. 修改
+	for _, item := range diff.Modified {
+		// 源配置为加密存储时传入明文和 encrypted 类型，由 overwriteConfig 重新加密
+		value, valueType := s.plainValue(ctx, item.source), item.source.ValueType
+		record(item.Key, PromotionActionUpdate, s.overwriteConfig(ctx, item.target, value, valueType, req.Operator))
+	}
+
+	// 5. 删除
+	for _, item := range diff.Deleted {
+		if !req.ApplyDeletions {
+			record(item.Key, PromotionActionSkip, nil)
+			continue
+		}
+		record(item.Key, PromotionActionDelete, s.configSvc.DeleteConfig(ctx, item.target.ID))
+	}
+
+	hlog.CtxInfof(ctx, "环境晋升完成: namespace=%d, %s -> %s, applied=%d, skipped=%d, failed=%d",
+		req.NamespaceID, req.SourceEnvironment, req.TargetEnvironment, result.Applied, result.Skipped, result.Failed)
+
+	return result, nil
+}
+
+// validatePromotionRequest 校验晋升请求
+func (s *ConfigTransferService) validatePromotionRequest(req *PromotionRequest) error {
+	if !contains(constants.ValidEnvironments, req.SourceEnvironment) {
+		return domainErrors.ErrConfigEnvironmentInvalid(req.SourceEnvironment)
+	}
+	if !contains(constants.ValidEnvironments, req.TargetEnvironment) {
+		return domainErrors.ErrConfigEnvironmentInvalid(req.TargetEnvironment)
+	}
+	if strings.EqualFold(req.SourceEnvironment, req.TargetEnvironment) {
+		return domainErrors.ErrPromotionSameEnvironment(req.SourceEnvironment)
+	}
+	return nil
+}
+
+// computePromotionDiff 计算晋升差异
+func (s *ConfigTransferService) computePromotionDiff(ctx context.Context, req *PromotionRequest) (*PromotionDiff, error) {
+	// 1. 查询命名空间下的所有配置并按环境和范围过滤
+	configs, err := s.configRepo.FindByNamespace(ctx, req.NamespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	keyFilter := make(map[string]bool, len(req.Keys))
+	for _, key := range req.Keys {
+		keyFilter[key] = true
+	}
+	inScope := func(config *entity.Config) bool {
+		if !config.IsActive {
+			return false
+		}
+		if req.GroupName != "" && config.GroupName != req.GroupName {
+			return false
+		}
+		return len(keyFilter) == 0 || keyFilter[config.Key]
+	}
+
+	sources := make(map[string]*entity.Config)
+	targets := make(map[string]*entity.Config)
+	for _, config := range configs {
+		if !inScope(config) {
+			continue
+		}
+		switch config.Environment {
+		case req.SourceEnvironment:
+			sources[config.Key] = config
+		case req.TargetEnvironment:
+			targets[config.Key] = config
+		}
+	}
+
+	// 2. 计算差异
+	diff := &PromotionDiff{
+		NamespaceID:       req.NamespaceID,
+		SourceEnvironment: req.SourceEnvironment,
+		TargetEnvironment: req.TargetEnvironment,
+		Added:             make([]*PromotionDiffItem, 0),
+		Modified:          make([]*PromotionDiffItem, 0),
+		Deleted:           make([]*PromotionDiffItem, 0),
+	}
+
+	for key, source := range sources {
+		// 未发布的源配置仅在 include_unreleased 时参与晋升，但仍用于删除判断
+		if !source.IsReleased && !req.IncludeUnreleased {
+			continue
+		}
+
+		target, exists := targets[key]
+		if !exists {
+			diff.Added = append(diff.Added, s.newPromotionDiffItem(ctx, source, nil))
+			continue
+		}
+
+		if s.plainValue(ctx, source) != s.plainValue(ctx, target) ||
+			!sameValueType(source.ValueType, target.ValueType) {
+			diff.Modified = append(diff.Modified, s.newPromotionDiffItem(ctx, source, target))
+		}
+	}
+
+	for key, target := range targets {
+		if _, exists := sources[key]; !exists {
+			diff.Deleted = append(diff.Deleted, s.newPromotionDiffItem(ctx, nil, target))
+		}
+	}
+
+	// 3. 排序并计算差异摘要
+	for _, items := range [][]*PromotionDiffItem{diff.Added, diff.Modified, diff.Deleted} {
+		sort.Slice(items, func(i, j int) bool {
+			return items[i].Key < items[j].Key
+		})
+	}
+	diff.DiffToken = s.computeDiffToken(ctx, diff)
+
+	return diff, nil
+}
+
+// newPromotionDiffItem 构建晋升差异项（敏感配置脱敏展示）
+func (s *ConfigTransferService) newPromotionDiffItem(ctx context.Context, source *entity.Config, target *entity.Config) *PromotionDiffItem {
+	item := &PromotionDiffItem{source: source, target: target}
+
+	if source != nil {
+		item.Key = source.Key
+		item.ValueType = source.ValueType
+		item.NewValue = s.plainValue(ctx, source)
+	}
+	if target != nil {
+		item.Key = target.Key
+		item.OldValue = s.plainValue(ctx, target)
+		item.TargetReleased = target.IsReleased
+		if item.ValueType == "" {
+			item.ValueType = target.ValueType
+		}
+	}
+
+	if item.ValueType == constants.ValueTypeEncrypted ||
+		(s.maskingSvc != nil && s.maskingSvc.IsSensitiveKey(item.Key)) {
+		item.IsSensitive = true
+		if s.maskingSvc != nil {
+			item.OldValue = s.maskingSvc.MaskValue(item.OldValue)
+			item.NewValue = s.maskingSvc.MaskValue(item.NewValue)
+		}
+	}
+	return item
+}
+
+// computeDiffToken 计算差异摘要
+// 基于配置键、配置ID、版本号和明文值哈希，任一差异项变化都会导致摘要变化
+func (s *ConfigTransferService) computeDiffToken(ctx context.Context, diff *PromotionDiff) string {
+	h := sha256.New()
+	write := func(tag string, item *PromotionDiffItem) {
+		fmt.Fprintf(h, "%s|%s", tag, item.Key)
+		for _, config := range []*entity.Config{item.source, item.target} {
+			if config == nil {
+				h.Write([]byte("|-"))
+				continue
+			}
+			valueHash := sha256.Sum256([]byte(s.plainValue(ctx, config)))
+			fmt.Fprintf(h, "|%d:%d:%s:%x", config.ID, config.Version, config.ValueType, valueHash)
+		}
+		h.Write([]byte("\n"))
+	}
+
+	fmt.Fprintf(h, "%d|%s|%s\n", diff.NamespaceID, diff.SourceEnvironment, diff.TargetEnvironment)
+	for _, item := range diff.Added {
+		write("A", item)
+	}
+	for _, item := range diff.Modified {
+		write("M", item)
+	}
+	for _, item := range diff.Deleted {
+		write("D", item)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// copyConfig 将源配置复制到指定命名空间和环境（新配置为未发布状态）
+func (s *ConfigTransferService) copyConfig(ctx context.Context, source *entity.Config, namespaceID int, environment string, operator string) (*entity.Config, error) {
+	value := s.plainValue(ctx, source)
+	valueType := source.ValueType
+
+	// 源配置为加密存储时：敏感键由配置服务自动加密，其余键保持加密存储
+	if valueType == constants.ValueTypeEncrypted {
+		valueType = constants.ValueTypeString
+		if s.maskingSvc != nil && !s.maskingSvc.IsSensitiveKey(source.Key) {
+			encrypted, err := s.maskingSvc.EncryptValue(value)
+			if err != nil {
+				return nil, err
+			}
+			value = encrypted
+			valueType = constants.ValueTypeEncrypted
+		}
+	}
+
+	config := &entity.Config{
+		NamespaceID: namespaceID,
+		Key:         source.Key,
+		Value:       value,
+		ValueType:   valueType,
+		GroupName:   source.GroupName,
+		Environment: environment,
+		Description: source.Description,
+		Metadata:    source.Metadata,
+	}
+	config.CreatedBy = operator
+	config.UpdatedBy = operator
+	if err := s.configSvc.CreateConfig(ctx, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// sameValueType 判断值类型是否一致（加密类型视为与任意类型一致，按明文比较）
+func sameValueType(a string, b string) bool {
+	if a == constants.ValueTypeEncrypted || b == constants.ValueTypeEncrypted {
+		return true
+	}
+	return a == b
+}
+
+// ==================== 命名空间克隆 ====================
+
+// CloneNamespaceRequest 克隆命名空间请求
+type CloneNamespaceRequest struct {
+	SourceNamespaceID int
+	Name              string // 新命名空间名称
+	DisplayName       string // 新命名空间显示名称（为空时使用名称）
+	Description       string // 新命名空间描述（为空时自动生成）
+	Environment       string // 仅克隆指定环境（可选）
+	GroupName         string // 仅克隆指定分组（可选）
+	IncludeUnreleased bool   // 是否包含未发布的配置
+	Operator          string
+}
+
+// CloneItemResult 单个配置的克隆结果
+type CloneItemResult struct {
+	Key         string
+	Environment string
+	Success     bool
+	Reason      string
+}
+
+// CloneResult 克隆结果
+type CloneResult struct {
+	Namespace *entity.Namespace
+	Cloned    int
+	Failed    int
+	Items     []*CloneItemResult
+}
+
+// CloneNamespace 克隆命名空间
+// 业务规则：
+// 1. 源命名空间必须存在，新命名空间名称必须符合规范且全局唯一
+// 2. 新命名空间继承源命名空间的元数据
+// 3. 配置的分组、描述、元数据、标签随配置一起复制
+// 4. 默认仅复制已发布的配置，复制后的配置均为未发布状态，需在新命名空间中重新发布
+// 5. 单个配置复制失败不影响其他配置，结果中逐项返回
+func (s *ConfigTransferService) CloneNamespace(ctx context.Context, req *CloneNamespaceRequest) (*CloneResult, error) {
+	// 1. 校验参数
+	if req.Environment != "" && !contains(constants.ValidEnvironments, req.Environment) {
+		return nil, domainErrors.ErrConfigEnvironmentInvalid(req.Environment)
+	}
+
+	// 2. 检查源命名空间
+	source, err := s.namespaceRepo.GetByID(ctx, req.SourceNamespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return nil, domainErrors.ErrNamespaceNotFound("")
+	}
+
+	// 3. 筛选需要复制的配置（在创建命名空间前完成，避免查询失败留下空命名空间）
+	configs, err := s.configRepo.FindByNamespace(ctx, source.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make([]*entity.Config, 0, len(configs))
+	for _, config := range configs {
+		if !config.IsActive || (!config.IsReleased && !req.IncludeUnreleased) {
+			continue
+		}
+		if req.Environment != "" && config.Environment != req.Environment {
+			continue
+		}
+		if req.GroupName != "" && config.GroupName != req.GroupName {
+			continue
+		}
+		sources = append(sources, config)
+	}
+	sort.Slice(sources, func(i, j int) bool {
+		if sources[i].Environment != sources[j].Environment {
+			return sources[i].Environment < sources[j].Environment
+		}
+		return sources[i].Key < sources[j].Key
+	})
+
+	// 4. 创建新命名空间
+	description := req.Description
+	if description == "" {
+		description = fmt.Sprintf("克隆自命名空间 %s", source.Name)
+	}
+	namespace := &entity.Namespace{
+		Name:        req.Name,
+		DisplayName: req.DisplayName,
+		Description: description,
+		Metadata:    source.Metadata,
+	}
+	namespace.CreatedBy = req.Operator
+	namespace.UpdatedBy = req.Operator
+	if err := s.namespaceSvc.CreateNamespace(ctx, namespace); err != nil {
+		return nil, err
+	}
+
+	// 5. 逐个复制配置及其标签
+	if req.Operator != "" {
+		ctx = context.WithValue(ctx, shareConstants.OperatorKey, req.Operator)
+	}
+	ctx = context.WithValue(ctx, shareConstants.ChangeReasonKey, fmt.Sprintf("克隆命名空间: %s -> %s", source.Name, namespace.Name))
+
+	result := &CloneResult{Namespace: namespace, Items: make([]*CloneItemResult, 0, len(sources))}
+	for _, config := range sources {
+		item := &CloneItemResult{Key: config.Key, Environment: config.Environment, Success: true}
+		if err := s.cloneConfig(ctx, config, namespace.ID, req.Operator); err != nil {
+			hlog.CtxWarnf(ctx, "克隆配置失败: key=%s, env=%s, err=%v", config.Key, config.Environment, err)
+			item.Success = false
+			item.Reason = err.Error()
+			result.Failed++
+		} else {
+			result.Cloned++
+		}
+		result.Items = append(result.Items, item)
+	}
+
+	hlog.CtxInfof(ctx, "命名空间克隆完成: %s -> %s, cloned=%d, failed=%d",
+		source.Name, namespace.Name, result.Cloned, result.Failed)
+
+	return result, nil
+}
+
+// cloneConfig 复制单个配置到新命名空间，并以源配置的标签替换自动生成的标签
+func (s *ConfigTransferService) cloneConfig(ctx context.Context, source *entity.Config, namespaceID int, operator string) error {
+	config, err := s.copyConfig(ctx, source, namespaceID, source.Environment, operator)
+	if err != nil {
+		return err
+	}
+	if s.tagSvc == nil {
+		return nil
+	}
+
+	tags, err := s.tagSvc.GetTags(ctx, source.ID)
+	if err != nil {
+		return err
+	}
+	inputs := make([]entity.TagInput, 0, len(tags))
+	for _, tag := range tags {
+		inputs = append(inputs, entity.TagInput{TagKey: tag.TagKey, TagValue: tag.TagValue})
+	}
+	return s.tagSvc.UpdateTags(ctx, config.ID, inputs)
+}
+
+// plainValue 获取配置的明文值（加密配置自动解密）
+func (s *ConfigTransferService) plainValue(ctx context.Context, config *entity.Config) string {
+	if config.ValueType != constants.ValueTypeEncrypted || s.maskingSvc == nil {
+		return config.Value
+	}
+
+	value, err := s.maskingSvc.DecryptValue(config.Value)
+	if err != nil {
+		hlog.CtxWarnf(ctx, "解密配置值失败: key=%s, err=%v", config.Key, err)
+		return config.Value
+	}
+	return value
+}
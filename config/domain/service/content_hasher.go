@@ -0,0 +1,79 @@
+package service
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"sort"
+	"sync"
+
+	"config-client/config/domain/constants"
+)
+
+// ContentHasher 配置内容哈希算法
+// 内置 md5、sha256，其他算法可通过 RegisterContentHasher 注册
+type ContentHasher interface {
+	// Algorithm 算法名称（保存在配置的 content_hash_algorithm 字段，不超过 20 个字符）
+	Algorithm() string
+
+	// Hash 计算内容哈希，返回十六进制字符串（不超过 64 个字符）
+	Hash(value string) string
+}
+
+// digestHasher 基于标准库 hash.Hash 的内容哈希算法
+type digestHasher struct {
+	algorithm string
+	newHash   func() hash.Hash
+}
+
+// Algorithm 实现 ContentHasher 接口
+func (h *digestHasher) Algorithm() string {
+	return h.algorithm
+}
+
+// Hash 实现 ContentHasher 接口
+func (h *digestHasher) Hash(value string) string {
+	digest := h.newHash()
+	digest.Write([]byte(value))
+	return hex.EncodeToString(digest.Sum(nil))
+}
+
+var (
+	contentHashersMu sync.RWMutex
+	contentHashers   = map[string]ContentHasher{
+		constants.HashAlgorithmMD5:    &digestHasher{algorithm: constants.HashAlgorithmMD5, newHash: md5.New},
+		constants.HashAlgorithmSHA256: &digestHasher{algorithm: constants.HashAlgorithmSHA256, newHash: sha256.New},
+	}
+)
+
+// RegisterContentHasher 注册内容哈希算法（同名算法会被覆盖）
+func RegisterContentHasher(hasher ContentHasher) {
+	contentHashersMu.Lock()
+	defer contentHashersMu.Unlock()
+	contentHashers[hasher.Algorithm()] = hasher
+}
+
+// LookupContentHasher 按算法名称查找内容哈希算法
+// 算法名称为空时视为 md5（早期数据未记录算法）
+func LookupContentHasher(algorithm string) (ContentHasher, bool) {
+	if algorithm == "" {
+		algorithm = constants.HashAlgorithmMD5
+	}
+	contentHashersMu.RLock()
+	defer contentHashersMu.RUnlock()
+	hasher, ok := contentHashers[algorithm]
+	return hasher, ok
+}
+
+// ContentHashAlgorithms 已注册的内容哈希算法名称（按名称排序）
+func ContentHashAlgorithms() []string {
+	contentHashersMu.RLock()
+	defer contentHashersMu.RUnlock()
+	algorithms := make([]string, 0, len(contentHashers))
+	for algorithm := range contentHashers {
+		algorithms = append(algorithms, algorithm)
+	}
+	sort.Strings(algorithms)
+	return algorithms
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"config-client/config/domain/entity"
+	"config-client/config/domain/listener"
+	"config-client/config/domain/repository"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	// DefaultOutboxPollInterval 发件箱默认轮询间隔
+	DefaultOutboxPollInterval = time.Second
+	// DefaultOutboxBatchSize 发件箱单次默认投递条数
+	DefaultOutboxBatchSize = 100
+	// DefaultOutboxRetention 已投递事件默认保留时长
+	DefaultOutboxRetention = 24 * time.Hour
+
+	// outboxLease 领取租约时长，投递实例异常退出时租约到期后由其他实例重新投递
+	outboxLease = 30 * time.Second
+	// outboxMaxBackoff 投递失败的最大退避时间
+	outboxMaxBackoff = time.Minute
+	// outboxCleanupInterval 已投递事件清理间隔
+	outboxCleanupInterval = time.Hour
+)
+
+// EventOutboxOptions 事务性发件箱参数
+type EventOutboxOptions struct {
+	PollInterval time.Duration // 轮询间隔（<=0 时使用默认值）
+	BatchSize    int           // 单次投递条数（<=0 时使用默认值）
+	Retention    time.Duration // 已投递事件保留时长（<=0 时使用默认值）
+}
+
+// EventOutbox 事务性发件箱
+// 配置变更事件与配置写入在同一数据库事务内保存，提交后由后台投递任务发布到配置变更监听器，
+// 投递失败时按指数退避重试，保证变更提交后事件最终一定会发出（至少一次投递）
+type EventOutbox struct {
+	outboxRepo repository.OutboxRepository
+	listener   listener.ConfigListener
+	opts       EventOutboxOptions
+
+	wakeCh chan struct{}
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewEventOutbox 创建事务性发件箱
+func NewEventOutbox(outboxRepo repository.OutboxRepository, listener listener.ConfigListener, opts EventOutboxOptions) *EventOutbox {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultOutboxPollInterval
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultOutboxBatchSize
+	}
+	if opts.Retention <= 0 {
+		opts.Retention = DefaultOutboxRetention
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &EventOutbox{
+		outboxRepo: outboxRepo,
+		listener:   listener,
+		opts:       opts,
+		wakeCh:     make(chan struct{}, 1),
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+}
+
+// Enqueue 保存待投递事件
+// 调用方需在配置写入所在的事务上下文中调用，事务回滚时事件一并丢弃
+func (o *EventOutbox) Enqueue(ctx context.Context, events ...*listener.ConfigChangeEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	records := make([]*entity.OutboxEvent, 0, len(events))
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("序列化配置变更事件失败: %w", err)
+		}
+		records = append(records, &entity.OutboxEvent{
+			Payload:       string(payload),
+			Status:        entity.OutboxStatusPending,
+			NextAttemptAt: now,
+		})
+	}
+
+	if err := o.outboxRepo.Save(ctx, records); err != nil {
+		return fmt.Errorf("保存发件箱事件失败: %w", err)
+	}
+	return nil
+}
+
+// Wake 唤醒投递任务立即投递（事务提交后调用，减少通知延迟）
+func (o *EventOutbox) Wake() {
+	select {
+	case o.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+// Start 启动后台投递任务
+func (o *EventOutbox) Start() {
+	o.wg.Add(1)
+	go o.run()
+	hlog.Infof("事务性发件箱投递任务已启动: pollInterval=%v, batchSize=%d", o.opts.PollInterval, o.opts.BatchSize)
+}
+
+// Stop 停止后台投递任务（等待进行中的投递完成，未投递的事件在下次启动后继续投递）
+func (o *EventOutbox) Stop() {
+	o.cancel()
+	o.wg.Wait()
+}
+
+// run 投递循环：定时轮询，或在事务提交后被唤醒
+func (o *EventOutbox) run() {
+	defer o.wg.Done()
+
+	ticker := time.NewTicker(o.opts.PollInterval)
+	defer ticker.Stop()
+	cleanupTicker := time.NewTicker(outboxCleanupInterval)
+	defer cleanupTicker.Stop()
+
+	for {
+		select {
+		case <-o.ctx.Done():
+			return
+		case <-ticker.C:
+		case <-o.wakeCh:
+		case <-cleanupTicker.C:
+			o.cleanup()
+			continue
+		}
+
+		// 一批取满说明仍有积压，继续投递直至清空
+		for o.ctx.Err() == nil {
+			n, err := o.relayOnce(o.ctx)
+			if err != nil {
+				hlog.Errorf("投递发件箱事件失败: %v", err)
+				break
+			}
+			if n < o.opts.BatchSize {
+				break
+			}
+		}
+	}
+}
+
+// relayOnce 领取并投递一批事件，返回领取的事件数
+// 业务规则：
+// 1. 按写入顺序批量发布，发布成功后标记为已投递
+// 2. 发布失败时按失败次数指数退避后重试
+// 3. 无法解析的事件直接标记为已投递，避免阻塞后续事件
+func (o *EventOutbox) relayOnce(ctx context.Context) (int, error) {
+	// 1. 领取待投递事件
+	records, err := o.outboxRepo.ClaimPending(ctx, o.opts.BatchSize, outboxLease)
+	if err != nil {
+		return 0, fmt.Errorf("领取发件箱事件失败: %w", err)
+	}
+	if len(records) == 0 {
+		return 0, nil
+	}
+
+	// 2. 解析事件
+	events := make([]*listener.ConfigChangeEvent, 0, len(records))
+	ids := make([]int64, 0, len(records))
+	maxAttempts := 0
+	for _, record := range records {
+		ids = append(ids, record.ID)
+		if record.Attempts > maxAttempts {
+			maxAttempts = record.Attempts
+		}
+
+		var event listener.ConfigChangeEvent
+		if err := json.Unmarshal([]byte(record.Payload), &event); err != nil {
+			hlog.Errorf("解析发件箱事件失败，丢弃: id=%d, err=%v", record.ID, err)
+			continue
+		}
+		events = append(events, &event)
+	}
+
+	// 3. 发布事件，失败时退避重试
+	if len(events) > 0 {
+		if err := o.listener.PublishBatch(ctx, events); err != nil {
+			nextAttemptAt := time.Now().Add(outboxBackoff(maxAttempts))
+			if markErr := o.outboxRepo.MarkFailed(context.WithoutCancel(ctx), ids, err.Error(), nextAttemptAt); markErr != nil {
+				hlog.Errorf("标记发件箱事件投递失败出错: %v", markErr)
+			}
+			return 0, fmt.Errorf("发布配置变更事件失败: count=%d, attempts=%d, err=%w", len(events), maxAttempts+1, err)
+		}
+	}
+
+	// 4. 标记已投递（标记失败时租约到期后会重复投递，订阅方按版本比较天然幂等）
+	if err := o.outboxRepo.MarkSent(context.WithoutCancel(ctx), ids); err != nil {
+		return len(records), fmt.Errorf("标记发件箱事件已投递失败: %w", err)
+	}
+	return len(records), nil
+}
+
+// cleanup 清理超过保留时长的已投递事件
+func (o *EventOutbox) cleanup() {
+	count, err := o.outboxRepo.DeleteSentBefore(o.ctx, time.Now().Add(-o.opts.Retention))
+	if err != nil {
+		hlog.Errorf("清理已投递发件箱事件失败: %v", err)
+		return
+	}
+	if count > 0 {
+		hlog.Infof("已清理 %d 条已投递发件箱事件", count)
+	}
+}
+
+// outboxBackoff 计算第 attempts 次失败后的退避时间：1s, 2s, 4s ... 最长 1 分钟
+func outboxBackoff(attempts int) time.Duration {
+	if attempts >= 6 {
+		return outboxMaxBackoff
+	}
+	backoff := time.Second << attempts
+	if backoff > outboxMaxBackoff {
+		return outboxMaxBackoff
+	}
+	return backoff
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"config-client/config/domain/entity"
+	"config-client/config/domain/repository"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	// DefaultHistoryRetentionInterval 变更历史清理任务默认执行间隔
+	DefaultHistoryRetentionInterval = time.Hour
+	// DefaultHistoryRetentionBatchSize 变更历史单批默认清理条数
+	DefaultHistoryRetentionBatchSize = 500
+)
+
+// HistoryRetentionPolicy 变更历史保留策略
+type HistoryRetentionPolicy struct {
+	MaxAge       time.Duration // 保留时长（<=0 时不按时间清理）
+	MaxPerConfig int           // 每个配置保留的最新记录数（<=0 时不按条数清理）
+	Interval     time.Duration // 清理任务执行间隔（<=0 时使用默认值）
+	BatchSize    int           // 单批清理条数（<=0 时使用默认值）
+}
+
+// HistoryRetentionService 变更历史保留策略服务
+// 定期清理超出保留策略的变更记录，配置了归档器时先归档再删除
+type HistoryRetentionService struct {
+	historyRepo repository.ChangeHistoryRepository
+	configRepo  repository.ConfigRepository // 提供事务
+	archiver    repository.ChangeHistoryArchiver
+	policy      HistoryRetentionPolicy
+
+	// 主节点选举器 (可选，多实例部署时仅主节点执行清理)
+	leaderElector LeaderElector
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewHistoryRetentionService 创建变更历史保留策略服务
+// archiver 为 nil 时清理的记录直接删除，不做归档
+func NewHistoryRetentionService(
+	historyRepo repository.ChangeHistoryRepository,
+	configRepo repository.ConfigRepository,
+	archiver repository.ChangeHistoryArchiver,
+	policy HistoryRetentionPolicy,
+) *HistoryRetentionService {
+	if policy.Interval <= 0 {
+		policy.Interval = DefaultHistoryRetentionInterval
+	}
+	if policy.BatchSize <= 0 {
+		policy.BatchSize = DefaultHistoryRetentionBatchSize
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &HistoryRetentionService{
+		historyRepo: historyRepo,
+		configRepo:  configRepo,
+		archiver:    archiver,
+		policy:      policy,
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+}
+
+// SetLeaderElector 设置主节点选举器（多实例部署时仅主节点执行清理）
+func (s *HistoryRetentionService) SetLeaderElector(elector LeaderElector) {
+	s.leaderElector = elector
+}
+
+// Start 启动后台清理任务
+func (s *HistoryRetentionService) Start() {
+	s.wg.Add(1)
+	go s.run()
+	hlog.Infof("变更历史清理任务已启动: maxAge=%v, maxPerConfig=%d, interval=%v, archive=%v",
+		s.policy.MaxAge, s.policy.MaxPerConfig, s.policy.Interval, s.archiver != nil)
+}
+
+// Stop 停止后台清理任务（等待进行中的批次完成）
+func (s *HistoryRetentionService) Stop() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// run 清理循环
+func (s *HistoryRetentionService) run() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.policy.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			// 多实例部署时仅主节点执行清理
+			if s.leaderElector != nil && !s.leaderElector.IsLeader() {
+				continue
+			}
+			count, err := s.Prune(s.ctx)
+			if err != nil {
+				hlog.Errorf("清理变更历史失败: pruned=%d, err=%v", count, err)
+				continue
+			}
+			if count > 0 {
+				hlog.Infof("已清理 %d 条超出保留策略的变更历史", count)
+			}
+		}
+	}
+}
+
+// Prune 按保留策略清理变更记录，返回清理数量
+// 业务规则：
+// 1. 早于保留时长的记录被清理
+// 2. 每个配置只保留最新 MaxPerConfig 条记录
+// 3. 配置了归档器时，每批记录先归档，归档成功后再删除；归档失败时该批记录保留，下次执行时重试
+func (s *HistoryRetentionService) Prune(ctx context.Context) (int64, error) {
+	var total int64
+
+	// 1. 按保留时长清理
+	if s.policy.MaxAge > 0 {
+		cutoff := time.Now().Add(-s.policy.MaxAge)
+		count, err := s.pruneBatches(ctx, func(ctx context.Context) ([]*entity.ChangeHistory, error) {
+			return s.historyRepo.FindCreatedBefore(ctx, cutoff, s.policy.BatchSize)
+		})
+		total += count
+		if err != nil {
+			return total, fmt.Errorf("按保留时长清理变更历史失败: %w", err)
+		}
+	}
+
+	// 2. 按每个配置保留条数清理
+	if s.policy.MaxPerConfig > 0 {
+		count, err := s.pruneBatches(ctx, func(ctx context.Context) ([]*entity.ChangeHistory, error) {
+			return s.historyRepo.FindBeyondLatestPerConfig(ctx, s.policy.MaxPerConfig, s.policy.BatchSize)
+		})
+		total += count
+		if err != nil {
+			return total, fmt.Errorf("按保留条数清理变更历史失败: %w", err)
+		}
+	}
+
+	return total, nil
+}
+
+// pruneBatches 逐批查询、归档并删除，直至没有待清理的记录
+func (s *HistoryRetentionService) pruneBatches(ctx context.Context, find func(ctx context.Context) ([]*entity.ChangeHistory, error)) (int64, error) {
+	var total int64
+	for ctx.Err() == nil {
+		histories, err := find(ctx)
+		if err != nil {
+			return total, err
+		}
+		if len(histories) == 0 {
+			return total, nil
+		}
+
+		count, err := s.archiveAndDelete(ctx, histories)
+		total += count
+		if err != nil {
+			return total, err
+		}
+		if len(histories) < s.policy.BatchSize {
+			return total, nil
+		}
+	}
+	return total, ctx.Err()
+}
+
+// archiveAndDelete 归档并删除一批记录（归档表写入与删除在同一事务内完成）
+func (s *HistoryRetentionService) archiveAndDelete(ctx context.Context, histories []*entity.ChangeHistory) (int64, error) {
+	ids := make([]int, 0, len(histories))
+	for _, history := range histories {
+		ids = append(ids, history.ID)
+	}
+
+	var deleted int64
+	err := s.configRepo.WithTx(ctx, func(ctx context.Context) error {
+		if s.archiver != nil {
+			if err := s.archiver.Archive(ctx, histories); err != nil {
+				return fmt.Errorf("归档变更历史失败: %w", err)
+			}
+		}
+
+		count, err := s.historyRepo.DeleteByIDs(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("删除变更历史失败: %w", err)
+		}
+		deleted = count
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return deleted, nil
+}
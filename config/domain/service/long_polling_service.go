@@ -4,29 +4,74 @@ import (
 	"context"
 	"crypto/md5"
 	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync/atomic"
 	"time"
 
+	"config-client/config/domain/entity"
 	"config-client/config/domain/errors"
+	"config-client/config/domain/repository"
+	shareErrors "config-client/share/errors"
+	"config-client/share/tracing"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // WaitRequest 等待请求
+// 一次请求可以监听多个命名空间和环境的配置，按（命名空间, 环境）分组分别订阅
 type WaitRequest struct {
-	ClientID       string            // 客户端ID
-	ClientIP       string            // 客户端IP
-	ClientHostname string            // 客户端主机名
-	NamespaceID    int               // 命名空间ID
-	Environment    string            // 环境
-	ConfigKeys     []string          // 配置键列表 (格式: "namespaceID:configKey")
-	Versions       map[string]string // 配置键 -> 版本号映射
+	ClientID       string        // 客户端ID
+	ClientIP       string        // 客户端IP
+	ClientHostname string        // 客户端主机名
+	Groups         []*WatchGroup // 监听分组（同一命名空间和环境的配置为一组）
+}
+
+// WatchGroup 同一命名空间和环境下监听的配置
+type WatchGroup struct {
+	NamespaceID  int               // 命名空间ID
+	Environment  string            // 环境
+	ConfigKeys   []string          // 配置键列表 (格式: "namespaceID:configKey")
+	Versions     map[string]string // 配置键 -> 版本号映射
+	LastSequence *int64            // 客户端在该命名空间最后收到的事件序号（为空时不补发遗漏事件）
+	Prefixes     []string          // 按前缀监听的配置键前缀（前缀下新建的配置也会通知，空前缀表示整个命名空间）
+}
+
+// matchesPrefix 配置键是否以分组监听的前缀开头
+func (g *WatchGroup) matchesPrefix(namespaceID int, key string) bool {
+	if namespaceID != g.NamespaceID {
+		return false
+	}
+	for _, prefix := range g.Prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
 }
 
 // WaitResult 等待结果
 type WaitResult struct {
-	Changed    bool              // 是否有变更
-	ConfigKeys []string          // 变更的配置键列表
-	Versions   map[string]string // 最新版本号映射
+	Changed     bool              // 是否有变更
+	NamespaceID int               // 发生变更的分组的命名空间ID
+	Environment string            // 发生变更的分组的环境
+	ConfigKeys  []string          // 变更的配置键列表
+	Versions    map[string]string // 最新版本号映射
+	Sequences   map[int]int64     // 各命名空间客户端应保存的事件序号（下次请求携带，未启用事件日志时为0）
+}
+
+// subscribedGroup 已订阅的监听分组
+type subscribedGroup struct {
+	group          *WatchGroup
+	notifyChan     <-chan *ChangeNotification
+	subscriptionID int
+}
+
+// groupNotification 分组收到的变更通知（notification 为空表示通知通道已关闭）
+type groupNotification struct {
+	subscribed   *subscribedGroup
+	notification *ChangeNotification
 }
 
 // LongPollingService 长轮询领域服务
@@ -35,6 +80,7 @@ type LongPollingService struct {
 	subscriptionMgr *SubscriptionManager // 订阅管理器
 	systemConfigSvc *SystemConfigService // 系统配置服务（可选）
 	defaultTimeout  time.Duration        // 默认长轮询超时时间（用于向后兼容）
+	activeWaiters   atomic.Int64         // 正在等待的长轮询请求数
 }
 
 // NewLongPollingService 创建长轮询领域服务
@@ -67,86 +113,425 @@ func (s *LongPollingService) Stop() error {
 }
 
 // getTimeout 获取长轮询超时时间
-// 优先从系统配置读取，如果系统配置服务未注入或配置不存在，则使用默认值
+// 业务规则：
+// 1. 每次请求从系统配置读取，修改 long.polling.timeout 后对之后的请求立即生效，无需重启
+// 2. 系统配置服务未注入或配置值 <=0 时使用默认值
+// 3. 不超过系统配置 long.polling.max.wait（<=0 时不限制）
 func (s *LongPollingService) getTimeout() time.Duration {
-	if s.systemConfigSvc != nil {
-		return s.systemConfigSvc.GetLongPollingTimeoutDuration()
+	if s.systemConfigSvc == nil {
+		return s.defaultTimeout
 	}
-	return s.defaultTimeout
+	timeout := s.systemConfigSvc.GetLongPollingTimeoutDuration()
+	if timeout <= 0 {
+		timeout = s.defaultTimeout
+	}
+	if maxWait := s.systemConfigSvc.GetLongPollingMaxWaitDuration(); maxWait > 0 && timeout > maxWait {
+		timeout = maxWait
+	}
+	return timeout
 }
 
 // Wait 等待配置变更
 // ctx: 请求上下文（用于取消等待）
 // req: 等待请求
 // 返回: 变更结果或超时
-func (s *LongPollingService) Wait(ctx context.Context, req *WaitRequest) (*WaitResult, error) {
-	// 1. 订阅配置变更
-	notifyChan, subscriptionID, err := s.subscriptionMgr.Subscribe(ctx, &SubscribeRequest{
-		ClientID:       req.ClientID,
-		ClientIP:       req.ClientIP,
-		ClientHostname: req.ClientHostname,
-		NamespaceID:    req.NamespaceID,
-		Environment:    req.Environment,
-		ConfigKeys:     req.ConfigKeys,
-		Versions:       req.Versions,
-	})
-	if err != nil {
-		return nil, errors.ErrLongPollingSubscribeFailed(err)
+func (s *LongPollingService) Wait(ctx context.Context, req *WaitRequest) (result *WaitResult, err error) {
+	keyCount := 0
+	prefixCount := 0
+	for _, group := range req.Groups {
+		keyCount += len(group.ConfigKeys)
+		prefixCount += len(group.Prefixes)
 	}
-
-	hlog.Infof("客户端开始长轮询: clientID=%s, namespace=%d, env=%s, subscriptionID=%d",
-		req.ClientID, req.NamespaceID, req.Environment, subscriptionID)
-
-	// 2. 延迟取消订阅
+	ctx, span := tracing.Start(ctx, "LongPollingService.Wait",
+		attribute.String("client.id", req.ClientID),
+		attribute.Int("poll.group_count", len(req.Groups)),
+		attribute.Int("poll.key_count", keyCount),
+		attribute.Int("poll.prefix_count", prefixCount),
+	)
 	defer func() {
-		if err := s.subscriptionMgr.Unsubscribe(req.ClientID, req.NamespaceID, req.Environment); err != nil {
-			hlog.Errorf("取消订阅失败: %v", err)
+		if result != nil {
+			span.SetAttributes(attribute.Bool("poll.changed", result.Changed))
 		}
+		tracing.End(span, err)
 	}()
 
-	// 3. 获取超时时间（从系统配置读取）
-	timeout := s.getTimeout()
+	// 并发长轮询数超出上限时立即拒绝，避免客户端风暴耗尽内存和文件描述符
+	if !s.acquireWaiter() {
+		span.SetAttributes(attribute.Bool("poll.rejected", true))
+		return nil, errors.ErrLongPollingTooManyWaiters(s.getRetryAfter())
+	}
+	defer s.activeWaiters.Add(-1)
 
-	// 4. 等待通知或超时
-	select {
-	case notification, ok := <-notifyChan:
-		if !ok {
-			// 通道已关闭
-			return &WaitResult{
-				Changed:    false,
-				ConfigKeys: []string{},
-				Versions:   req.Versions,
-			}, nil
+	// 1. 记录各命名空间当前最新事件序号，并补发客户端断线期间遗漏的事件
+	baselines := make(map[int]int64, len(req.Groups))
+	for _, group := range req.Groups {
+		if _, exists := baselines[group.NamespaceID]; !exists {
+			baselines[group.NamespaceID] = s.latestSequence(ctx, group.NamespaceID)
+		}
+	}
+	for _, group := range req.Groups {
+		if replayed := s.replayMissedEvents(ctx, req, group, baselines); replayed != nil {
+			span.SetAttributes(attribute.Int("poll.replayed_keys", len(replayed.ConfigKeys)))
+			return replayed, nil
 		}
+	}
 
-		// 收到变更通知
-		hlog.Infof("配置变更通知: clientID=%s, configKey=%s, newVersion=%s",
-			req.ClientID, notification.ConfigKey, notification.NewVersion)
+	// 2. 按分组订阅配置变更
+	subscribedGroups := make([]*subscribedGroup, 0, len(req.Groups))
+	defer func() {
+		// 3. 延迟取消订阅
+		for _, subscribed := range subscribedGroups {
+			if err := s.subscriptionMgr.Unsubscribe(req.ClientID, subscribed.group.NamespaceID, subscribed.group.Environment); err != nil {
+				hlog.CtxErrorf(ctx, "取消订阅失败: %v", err)
+			}
+		}
+	}()
+	for _, group := range req.Groups {
+		notifyChan, subscriptionID, err := s.subscriptionMgr.Subscribe(ctx, &SubscribeRequest{
+			ClientID:       req.ClientID,
+			ClientIP:       req.ClientIP,
+			ClientHostname: req.ClientHostname,
+			NamespaceID:    group.NamespaceID,
+			Environment:    group.Environment,
+			ConfigKeys:     group.ConfigKeys,
+			Versions:       group.Versions,
+			Prefixes:       group.Prefixes,
+		})
+		if err != nil {
+			// 超出订阅数量配额时直接返回配额错误，便于客户端识别
+			if appErr, ok := shareErrors.AsAppError(err); ok && appErr.Code == errors.NamespaceQuotaExceeded {
+				return nil, appErr
+			}
+			return nil, errors.ErrLongPollingSubscribeFailed(err)
+		}
+		subscribedGroups = append(subscribedGroups, &subscribedGroup{
+			group:          group,
+			notifyChan:     notifyChan,
+			subscriptionID: subscriptionID,
+		})
 
-		return &WaitResult{
-			Changed:    true,
-			ConfigKeys: []string{notification.ConfigKey},
-			Versions: map[string]string{
-				notification.ConfigKey: notification.NewVersion,
-			},
-		}, nil
+		hlog.CtxInfof(ctx, "客户端开始长轮询: clientID=%s, namespace=%d, env=%s, subscriptionID=%d",
+			req.ClientID, group.NamespaceID, group.Environment, subscriptionID)
+	}
+
+	// 4. 获取超时时间（从系统配置读取）
+	timeout := s.getTimeout()
 
-	case <-time.After(timeout):
-		// 超时，返回未变更
-		hlog.Infof("长轮询超时: clientID=%s, namespace=%d, timeout=%v", req.ClientID, req.NamespaceID, timeout)
+	// 5. 等待任一分组的通知或超时
+	received, err := s.waitAny(ctx, subscribedGroups, timeout)
+	if err != nil {
+		// 客户端取消请求
+		hlog.CtxInfof(ctx, "客户端取消请求: clientID=%s, error=%v", req.ClientID, err)
+		return nil, err
+	}
+	if received == nil || received.notification == nil {
+		// 超时或通道已关闭，返回未变更
+		if received == nil {
+			hlog.CtxInfof(ctx, "长轮询超时: clientID=%s, groups=%d, timeout=%v", req.ClientID, len(req.Groups), timeout)
+		}
 		return &WaitResult{
 			Changed:    false,
 			ConfigKeys: []string{},
-			Versions:   req.Versions,
+			Versions:   map[string]string{},
+			Sequences:  baselines,
 		}, nil
+	}
+
+	// 收到变更通知
+	notification := received.notification
+	group := received.subscribed.group
+	hlog.CtxInfof(ctx, "配置变更通知: clientID=%s, configKey=%s, env=%s, newVersion=%s",
+		req.ClientID, notification.ConfigKey, group.Environment, notification.NewVersion)
+	if notification.TraceID != "" {
+		span.SetAttributes(attribute.String("poll.trigger_trace_id", notification.TraceID))
+	}
+	s.subscriptionMgr.RecordPush(ctx, received.subscribed.subscriptionID, req.ClientID, group.Environment,
+		map[string]string{notification.ConfigKey: notification.NewVersion}, notification)
+
+	// 事件触发的通知使用事件序号；版本比较触发的通知使用订阅前的最新序号
+	// 通知通道只缓冲一条，之后的事件可能被丢弃，客户端下次携带该序号即可补发
+	sequence := baselines[group.NamespaceID]
+	if notification.Sequence > 0 {
+		sequence = notification.Sequence
+	}
+
+	return &WaitResult{
+		Changed:     true,
+		NamespaceID: group.NamespaceID,
+		Environment: group.Environment,
+		ConfigKeys:  []string{notification.ConfigKey},
+		Versions: map[string]string{
+			notification.ConfigKey: notification.NewVersion,
+		},
+		Sequences: mergeSequences(baselines, req.Groups, group, sequence),
+	}, nil
+}
+
+// acquireWaiter 占用一个长轮询等待名额
+// 业务规则：
+// 1. 上限从系统配置读取，<=0 或未注入系统配置服务时不限制
+// 2. 超出上限时归还名额并返回 false
+func (s *LongPollingService) acquireWaiter() bool {
+	active := s.activeWaiters.Add(1)
 
+	maxWaiters := 0
+	if s.systemConfigSvc != nil {
+		maxWaiters = s.systemConfigSvc.GetLongPollingMaxWaiters()
+	}
+	if maxWaiters > 0 && active > int64(maxWaiters) {
+		s.activeWaiters.Add(-1)
+		hlog.Warnf("并发长轮询数超出上限，拒绝请求: active=%d, max=%d", active-1, maxWaiters)
+		return false
+	}
+	return true
+}
+
+// getRetryAfter 获取超出并发上限时建议客户端重试的等待时间（秒）
+func (s *LongPollingService) getRetryAfter() int {
+	retryAfter := DefaultLongPollingRetryAfter
+	if s.systemConfigSvc != nil {
+		retryAfter = s.systemConfigSvc.GetLongPollingRetryAfter()
+	}
+	if retryAfter <= 0 {
+		retryAfter = DefaultLongPollingRetryAfter
+	}
+	return retryAfter
+}
+
+// busyWaiterRatio 并发长轮询数达到上限的该比例后，逐步延长建议客户端的长轮询间隔
+const busyWaiterRatio = 0.8
+
+// NextPollDelay 建议客户端发起下一次长轮询前等待的时间（为 0 时由客户端决定）
+// 业务规则：
+// 1. 基础间隔从系统配置 long.polling.poll.delay 读取
+// 2. 并发长轮询数达到上限的 80% 后，按超出的比例在基础间隔和 long.polling.retry.after 之间线性延长（在触发 429 之前平滑客户端的请求速率）
+func (s *LongPollingService) NextPollDelay() time.Duration {
+	if s.systemConfigSvc == nil {
+		return 0
+	}
+	delay := time.Duration(max(s.systemConfigSvc.GetLongPollingPollDelay(), 0)) * time.Millisecond
+
+	maxWaiters := s.systemConfigSvc.GetLongPollingMaxWaiters()
+	if maxWaiters <= 0 {
+		return delay
+	}
+	load := float64(s.activeWaiters.Load()) / float64(maxWaiters)
+	if load < busyWaiterRatio {
+		return delay
+	}
+
+	busyDelay := time.Duration(s.getRetryAfter()) * time.Second
+	if busyDelay <= delay {
+		return delay
+	}
+	ratio := min((load-busyWaiterRatio)/(1-busyWaiterRatio), 1)
+	return delay + time.Duration(ratio*float64(busyDelay-delay))
+}
+
+// waitAny 等待任一分组收到通知
+// 返回: 收到的通知（超时返回 nil），客户端取消请求时返回错误
+func (s *LongPollingService) waitAny(ctx context.Context, subscribedGroups []*subscribedGroup, timeout time.Duration) (*groupNotification, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	// 单个分组直接等待，避免额外的 goroutine
+	if len(subscribedGroups) == 1 {
+		select {
+		case notification, ok := <-subscribedGroups[0].notifyChan:
+			if !ok {
+				notification = nil
+			}
+			return &groupNotification{subscribed: subscribedGroups[0], notification: notification}, nil
+		case <-timer.C:
+			return nil, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	// 多个分组时汇聚各分组的通知
+	merged := make(chan *groupNotification, len(subscribedGroups))
+	done := make(chan struct{})
+	defer close(done)
+	for _, subscribed := range subscribedGroups {
+		go func(subscribed *subscribedGroup) {
+			select {
+			case notification, ok := <-subscribed.notifyChan:
+				if !ok {
+					notification = nil
+				}
+				merged <- &groupNotification{subscribed: subscribed, notification: notification}
+			case <-done:
+			}
+		}(subscribed)
+	}
+
+	select {
+	case received := <-merged:
+		return received, nil
+	case <-timer.C:
+		return nil, nil
 	case <-ctx.Done():
-		// 客户端取消请求
-		hlog.Infof("客户端取消请求: clientID=%s, error=%v", req.ClientID, ctx.Err())
 		return nil, ctx.Err()
 	}
 }
 
+// mergeSequences 计算各命名空间客户端应保存的事件序号
+// 同一命名空间有多个分组（不同环境）时取最小值，未返回变更的分组下次仍可补发遗漏的事件
+func mergeSequences(baselines map[int]int64, groups []*WatchGroup, changed *WatchGroup, sequence int64) map[int]int64 {
+	sequences := make(map[int]int64, len(baselines))
+	for _, group := range groups {
+		groupSequence := baselines[group.NamespaceID]
+		if group == changed {
+			groupSequence = sequence
+		}
+		if current, exists := sequences[group.NamespaceID]; !exists || groupSequence < current {
+			sequences[group.NamespaceID] = groupSequence
+		}
+	}
+	return sequences
+}
+
+// latestSequence 获取命名空间的最新事件序号（未启用事件日志或查询失败时返回0）
+func (s *LongPollingService) latestSequence(ctx context.Context, namespaceID int) int64 {
+	eventSvc := s.subscriptionMgr.changeEventSvc
+	if eventSvc == nil {
+		return 0
+	}
+
+	sequence, err := eventSvc.GetLatestSequence(ctx, namespaceID)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "获取最新事件序号失败: namespace=%d, err=%v", namespaceID, err)
+		return 0
+	}
+	return sequence
+}
+
+// replayMissedEvents 补发客户端最后序号之后、涉及其关注配置的事件
+// 业务规则：
+// 1. 客户端未携带序号或未启用事件日志时不补发
+// 2. 事件日志无法完整覆盖遗漏区间时不补发，由版本比较兜底
+// 3. 只补发当前版本与客户端版本不同的配置，避免重复通知已同步的变更
+// 4. 只补发客户端所在环境的事件
+// 返回: 补发结果，无需补发时返回 nil
+func (s *LongPollingService) replayMissedEvents(ctx context.Context, req *WaitRequest, group *WatchGroup, baselines map[int]int64) *WaitResult {
+	eventSvc := s.subscriptionMgr.changeEventSvc
+	if eventSvc == nil || group.LastSequence == nil {
+		return nil
+	}
+
+	// 1. 查询遗漏的事件
+	replay, err := eventSvc.Replay(ctx, group.NamespaceID, *group.LastSequence)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "查询遗漏事件失败: clientID=%s, namespace=%d, err=%v", req.ClientID, group.NamespaceID, err)
+		return nil
+	}
+	if !replay.Complete {
+		hlog.CtxInfof(ctx, "事件日志无法覆盖遗漏区间，退回版本比较: clientID=%s, namespace=%d, lastSequence=%d",
+			req.ClientID, group.NamespaceID, *group.LastSequence)
+		return nil
+	}
+	if len(replay.Events) == 0 {
+		return nil
+	}
+
+	// 2. 筛选客户端关注的本环境配置（包括监听的前缀下新建的配置）
+	watched := make(map[string]bool, len(group.ConfigKeys))
+	for _, configKey := range group.ConfigKeys {
+		watched[configKey] = true
+	}
+
+	candidateKeys := make([]string, 0)
+	lookups := make(map[string]repository.NamespaceKeyEnv)
+	for _, event := range replay.Events {
+		configKey := fmt.Sprintf("%d:%s", event.NamespaceID, event.ConfigKey)
+		if _, checked := lookups[configKey]; checked || !(watched[configKey] || group.matchesPrefix(event.NamespaceID, event.ConfigKey)) {
+			continue
+		}
+		// 其他环境的变更不补发（未记录环境的事件仍按版本比较）
+		if event.Environment != "" && normalizeEnvironment(event.Environment) != normalizeEnvironment(group.Environment) {
+			continue
+		}
+		candidateKeys = append(candidateKeys, configKey)
+		lookups[configKey] = repository.NamespaceKeyEnv{
+			NamespaceID: event.NamespaceID,
+			Key:         event.ConfigKey,
+			Environment: normalizeEnvironment(group.Environment),
+		}
+	}
+	if len(candidateKeys) == 0 {
+		return nil
+	}
+
+	// 3. 批量查询当前版本，查询失败时不补发，由版本比较兜底
+	keys := make([]repository.NamespaceKeyEnv, 0, len(candidateKeys))
+	for _, configKey := range candidateKeys {
+		keys = append(keys, lookups[configKey])
+	}
+	currentVersions, err := s.subscriptionMgr.getConfigVersions(keys, true)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "批量获取配置版本失败: clientID=%s, namespace=%d, err=%v", req.ClientID, group.NamespaceID, err)
+		return nil
+	}
+
+	// 4. 筛选版本已变化的配置
+	changedKeys := make([]string, 0)
+	versions := make(map[string]string)
+	for _, configKey := range candidateKeys {
+		// 配置已删除时版本为空
+		version := currentVersions[lookups[configKey]]
+		if version == group.Versions[configKey] {
+			continue
+		}
+		changedKeys = append(changedKeys, configKey)
+		versions[configKey] = version
+	}
+	if len(changedKeys) == 0 {
+		return nil
+	}
+
+	hlog.CtxInfof(ctx, "补发遗漏事件: clientID=%s, namespace=%d, env=%s, lastSequence=%d, configKeys=%v",
+		req.ClientID, group.NamespaceID, group.Environment, *group.LastSequence, changedKeys)
+
+	sequence := replay.Events[len(replay.Events)-1].Sequence
+	result := &WaitResult{
+		Changed:     true,
+		NamespaceID: group.NamespaceID,
+		Environment: group.Environment,
+		ConfigKeys:  changedKeys,
+		Versions:    versions,
+		Sequences:   mergeSequences(baselines, req.Groups, group, sequence),
+	}
+	s.recordReplay(ctx, req, group, result, sequence)
+	return result
+}
+
+// recordReplay 记录补发给客户端的变更通知
+// 补发在订阅之前完成，需按客户端查询已有的订阅记录；首次轮询尚无订阅时不记录
+func (s *LongPollingService) recordReplay(ctx context.Context, req *WaitRequest, group *WatchGroup, result *WaitResult, sequence int64) {
+	mgr := s.subscriptionMgr
+	if mgr.pushTraceSvc == nil {
+		return
+	}
+
+	subscription, err := mgr.subscriptionRepo.GetByClientAndNamespace(ctx, req.ClientID, group.NamespaceID, group.Environment)
+	if err != nil || subscription == nil {
+		return
+	}
+
+	mgr.RecordPush(ctx, subscription.ID, req.ClientID, group.Environment, result.Versions, &ChangeNotification{
+		NamespaceID: group.NamespaceID,
+		Timestamp:   time.Now(),
+		Sequence:    sequence,
+		Source:      entity.PushSourceReplay,
+	})
+}
+
+// GetActiveWaiterCount 获取正在等待的长轮询请求数
+func (s *LongPollingService) GetActiveWaiterCount() int64 {
+	return s.activeWaiters.Load()
+}
+
 // ComputeVersion 计算配置版本（使用MD5）
 func ComputeVersion(content string) string {
 	hash := md5.Sum([]byte(content))
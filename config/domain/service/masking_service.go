@@ -8,6 +8,9 @@ import (
 	"errors"
 	"io"
 	"strings"
+	"sync"
+
+	"config-client/config/domain/entity"
 )
 
 // MaskingService 配置脱敏服务
@@ -49,6 +52,11 @@ var sensitiveKeywords = []string{
 	"salt", "encryption_key",
 }
 
+var (
+	extraSensitiveKeywords []string     // 系统配置 masking.sensitive.keywords 追加的关键字
+	sensitiveKeywordsMu    sync.RWMutex // 保护 sensitiveKeywords 和 extraSensitiveKeywords
+)
+
 // IsSensitiveKey 判断配置键是否为敏感配置
 func (s *MaskingService) IsSensitiveKey(key string) bool {
 	if !s.enabled {
@@ -57,16 +65,66 @@ func (s *MaskingService) IsSensitiveKey(key string) bool {
 
 	lowerKey := strings.ToLower(key)
 
+	sensitiveKeywordsMu.RLock()
+	defer sensitiveKeywordsMu.RUnlock()
+
 	// 检查是否包含敏感关键字
 	for _, keyword := range sensitiveKeywords {
 		if strings.Contains(lowerKey, keyword) {
 			return true
 		}
 	}
+	for _, keyword := range extraSensitiveKeywords {
+		if strings.Contains(lowerKey, keyword) {
+			return true
+		}
+	}
 
 	return false
 }
 
+// SensitiveKeywords 返回识别敏感配置键的关键字（未启用脱敏时返回 nil）
+func (s *MaskingService) SensitiveKeywords() []string {
+	if !s.enabled {
+		return nil
+	}
+	return s.GetSensitiveKeywords()
+}
+
+// SetExtraSensitiveKeywords 设置内置关键字以外的敏感关键字（替换上一次设置的值，传 nil 清空）
+// 系统配置 masking.sensitive.keywords 变更时调用，对所有脱敏服务实例生效
+func (s *MaskingService) SetExtraSensitiveKeywords(keywords []string) {
+	sensitiveKeywordsMu.Lock()
+	defer sensitiveKeywordsMu.Unlock()
+	extraSensitiveKeywords = keywords
+}
+
+// SetSystemConfigService 从系统配置 masking.sensitive.keywords 读取追加的敏感关键字，配置变更时立即生效
+func (s *MaskingService) SetSystemConfigService(systemConfigSvc *SystemConfigService) {
+	s.SetExtraSensitiveKeywords(systemConfigSvc.GetMaskingSensitiveKeywords())
+	systemConfigSvc.Watch(ConfigKeyMaskingSensitiveKeywords, func(string, *entity.SystemConfig) {
+		s.SetExtraSensitiveKeywords(systemConfigSvc.GetMaskingSensitiveKeywords())
+	})
+}
+
+// ParseSensitiveKeywords 解析逗号分隔的敏感关键字（转小写、去除空白和重复项）
+func ParseSensitiveKeywords(value string) []string {
+	var keywords []string
+	seen := make(map[string]struct{})
+	for _, part := range strings.Split(value, ",") {
+		keyword := strings.ToLower(strings.TrimSpace(part))
+		if keyword == "" {
+			continue
+		}
+		if _, ok := seen[keyword]; ok {
+			continue
+		}
+		seen[keyword] = struct{}{}
+		keywords = append(keywords, keyword)
+	}
+	return keywords
+}
+
 // ==================== 脱敏展示 ====================
 
 // MaskValue 对配置值进行脱敏展示
@@ -204,9 +262,14 @@ func (s *MaskingService) IsEnabled() bool {
 	return s.enabled
 }
 
-// GetSensitiveKeywords 获取敏感关键字列表（用于配置或调试）
+// GetSensitiveKeywords 获取敏感关键字列表，包括系统配置追加的关键字（用于配置或调试）
 func (s *MaskingService) GetSensitiveKeywords() []string {
-	return sensitiveKeywords
+	sensitiveKeywordsMu.RLock()
+	defer sensitiveKeywordsMu.RUnlock()
+
+	keywords := make([]string, 0, len(sensitiveKeywords)+len(extraSensitiveKeywords))
+	keywords = append(keywords, sensitiveKeywords...)
+	return append(keywords, extraSensitiveKeywords...)
 }
 
 // AddSensitiveKeyword 动态添加敏感关键字
@@ -216,6 +279,9 @@ func (s *MaskingService) AddSensitiveKeyword(keyword string) {
 		return
 	}
 
+	sensitiveKeywordsMu.Lock()
+	defer sensitiveKeywordsMu.Unlock()
+
 	// 检查是否已存在
 	for _, k := range sensitiveKeywords {
 		if k == keyword {
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"config-client/config/domain/entity"
+	domainErrors "config-client/config/domain/errors"
+	"config-client/config/domain/repository"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// 命名空间配额项名称（用于配额超限错误）
+const (
+	QuotaMaxConfigs     = "max_configs"
+	QuotaMaxSubscribers = "max_subscribers"
+)
+
+// NamespaceStats 命名空间用量统计
+type NamespaceStats struct {
+	NamespaceID      int                                  // 命名空间ID
+	Quota            entity.NamespaceQuota                // 生效配额（已合并全局默认配额，0 表示不限制）
+	Configs          int64                                // 配置数量（不含回收站中的配置）
+	LargestValueSize int64                                // 最大的配置值大小（字节，按存储值计算）
+	Subscribers      int64                                // 活跃订阅数量
+	Releases         []*repository.ReleaseEnvironmentStat // 各环境的发布版本数量
+}
+
+// NamespaceQuotaService 命名空间配额服务
+// 负责配额的设置与校验（配置数量、配置值大小、活跃订阅数量）、超出保留数量的发布版本清理以及用量统计
+// 配额按写入前的用量校验，并发写入时可能短暂超出上限
+type NamespaceQuotaService struct {
+	namespaceRepo    repository.NamespaceRepository
+	configRepo       repository.ConfigRepository
+	subscriptionRepo repository.SubscriptionRepository
+	releaseRepo      repository.ReleaseRepository
+	defaults         entity.NamespaceQuota // 全局默认配额
+}
+
+// NewNamespaceQuotaService 创建命名空间配额服务
+func NewNamespaceQuotaService(
+	namespaceRepo repository.NamespaceRepository,
+	configRepo repository.ConfigRepository,
+	subscriptionRepo repository.SubscriptionRepository,
+	releaseRepo repository.ReleaseRepository,
+	defaults entity.NamespaceQuota,
+) *NamespaceQuotaService {
+	return &NamespaceQuotaService{
+		namespaceRepo:    namespaceRepo,
+		configRepo:       configRepo,
+		subscriptionRepo: subscriptionRepo,
+		releaseRepo:      releaseRepo,
+		defaults:         defaults,
+	}
+}
+
+// Defaults 全局默认配额
+func (s *NamespaceQuotaService) Defaults() entity.NamespaceQuota {
+	return s.defaults
+}
+
+// GetQuota 查询命名空间的生效配额（已合并全局默认配额）
+func (s *NamespaceQuotaService) GetQuota(ctx context.Context, namespaceID int) (entity.NamespaceQuota, error) {
+	namespace, err := s.namespaceRepo.GetByID(ctx, namespaceID)
+	if err != nil {
+		return entity.NamespaceQuota{}, err
+	}
+	if namespace == nil {
+		return entity.NamespaceQuota{}, domainErrors.ErrNamespaceNotFound("")
+	}
+	return namespace.Quota.WithDefaults(s.defaults), nil
+}
+
+// UpdateQuota 设置命名空间配额
+// 业务规则：
+// 1. 命名空间必须存在
+// 2. 各项不能为负数，为 0 时继承全局默认配额
+// 3. 只影响之后的写入，已超出新配额的数据不会被删除（发布版本在下次创建版本时清理）
+func (s *NamespaceQuotaService) UpdateQuota(ctx context.Context, namespaceID int, quota entity.NamespaceQuota, operator string) (*entity.Namespace, error) {
+	// 1. 校验配额
+	if quota.MaxConfigs < 0 || quota.MaxValueSize < 0 || quota.MaxSubscribers < 0 || quota.MaxReleasesRetained < 0 {
+		return nil, domainErrors.ErrNamespaceQuotaInvalid("配额不能为负数")
+	}
+
+	// 2. 检查命名空间
+	namespace, err := s.namespaceRepo.GetByID(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if namespace == nil {
+		return nil, domainErrors.ErrNamespaceNotFound("")
+	}
+
+	// 3. 保存配额
+	namespace.Quota = quota
+	namespace.UpdatedBy = operator
+	if err := s.namespaceRepo.Update(ctx, namespace); err != nil {
+		return nil, err
+	}
+
+	hlog.CtxInfof(ctx, "命名空间配额已更新: namespace=%d, quota=%+v, operator=%s", namespaceID, quota, operator)
+	return namespace, nil
+}
+
+// CheckConfigCount 校验命名空间是否还能新增配置（创建或从回收站恢复前调用）
+func (s *NamespaceQuotaService) CheckConfigCount(ctx context.Context, namespaceID int) error {
+	quota, err := s.GetQuota(ctx, namespaceID)
+	if err != nil || quota.MaxConfigs <= 0 {
+		return err
+	}
+
+	count, err := s.configRepo.CountByNamespace(ctx, namespaceID)
+	if err != nil {
+		return err
+	}
+	if count >= int64(quota.MaxConfigs) {
+		return domainErrors.ErrNamespaceQuotaExceeded(namespaceID, QuotaMaxConfigs, count, quota.MaxConfigs)
+	}
+	return nil
+}
+
+// CheckValueSize 校验配置值大小（按明文字节数计算）
+func (s *NamespaceQuotaService) CheckValueSize(ctx context.Context, namespaceID int, key string, value string) error {
+	quota, err := s.GetQuota(ctx, namespaceID)
+	if err != nil || quota.MaxValueSize <= 0 {
+		return err
+	}
+
+	if len(value) > quota.MaxValueSize {
+		return domainErrors.ErrConfigValueTooLarge(key, len(value), quota.MaxValueSize)
+	}
+	return nil
+}
+
+// CheckSubscriberCount 校验命名空间是否还能新增活跃订阅（创建或恢复订阅前调用）
+func (s *NamespaceQuotaService) CheckSubscriberCount(ctx context.Context, namespaceID int) error {
+	quota, err := s.GetQuota(ctx, namespaceID)
+	if err != nil || quota.MaxSubscribers <= 0 {
+		return err
+	}
+
+	count, err := s.subscriptionRepo.CountActiveByNamespace(ctx, namespaceID)
+	if err != nil {
+		return err
+	}
+	if count >= int64(quota.MaxSubscribers) {
+		return domainErrors.ErrNamespaceQuotaExceeded(namespaceID, QuotaMaxSubscribers, count, quota.MaxSubscribers)
+	}
+	return nil
+}
+
+// PruneReleases 清理超出保留数量的发布版本（软删除），返回清理数量
+// 业务规则：
+// 1. 保留版本号最大的 max_releases_retained 个版本
+// 2. 最新的已发布版本（当前生效版本）始终保留
+// 3. 保留版本依赖的增量发布基线版本一并保留，保证快照可以还原
+func (s *NamespaceQuotaService) PruneReleases(ctx context.Context, namespaceID int, environment string) (int, error) {
+	quota, err := s.GetQuota(ctx, namespaceID)
+	if err != nil || quota.MaxReleasesRetained <= 0 {
+		return 0, err
+	}
+
+	// 1. 查询全部版本（按版本号倒序）
+	releases, err := s.releaseRepo.FindByNamespace(ctx, namespaceID, environment)
+	if err != nil {
+		return 0, fmt.Errorf("查询发布版本失败: %w", err)
+	}
+	if len(releases) <= quota.MaxReleasesRetained {
+		return 0, nil
+	}
+
+	// 2. 标记保留的版本
+	byID := make(map[int]*entity.Release, len(releases))
+	for _, release := range releases {
+		byID[release.ID] = release
+	}
+	retained := make(map[int]bool, quota.MaxReleasesRetained)
+	var retain func(release *entity.Release)
+	retain = func(release *entity.Release) {
+		for release != nil && !retained[release.ID] {
+			retained[release.ID] = true
+			release = byID[release.BaseReleaseID]
+		}
+	}
+	for _, release := range releases[:quota.MaxReleasesRetained] {
+		retain(release)
+	}
+	latest, err := s.releaseRepo.FindLatestPublishedRelease(ctx, namespaceID, environment)
+	if err != nil {
+		return 0, fmt.Errorf("查询最新已发布版本失败: %w", err)
+	}
+	if latest != nil {
+		retain(byID[latest.ID])
+	}
+
+	// 3. 删除其余版本
+	pruned := 0
+	for _, release := range releases {
+		if retained[release.ID] {
+			continue
+		}
+		if err := s.releaseRepo.Delete(ctx, release.ID); err != nil {
+			return pruned, fmt.Errorf("清理发布版本失败: id=%d, err=%w", release.ID, err)
+		}
+		pruned++
+	}
+
+	if pruned > 0 {
+		hlog.CtxInfof(ctx, "已清理超出保留数量的发布版本: namespace=%d, env=%s, pruned=%d, max_releases_retained=%d",
+			namespaceID, environment, pruned, quota.MaxReleasesRetained)
+	}
+	return pruned, nil
+}
+
+// GetStats 查询命名空间的用量统计及生效配额
+func (s *NamespaceQuotaService) GetStats(ctx context.Context, namespaceID int) (*NamespaceStats, error) {
+	quota, err := s.GetQuota(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &NamespaceStats{NamespaceID: namespaceID, Quota: quota}
+	if stats.Configs, err = s.configRepo.CountByNamespace(ctx, namespaceID); err != nil {
+		return nil, err
+	}
+	if stats.LargestValueSize, err = s.configRepo.MaxValueSizeByNamespace(ctx, namespaceID); err != nil {
+		return nil, err
+	}
+	if stats.Subscribers, err = s.subscriptionRepo.CountActiveByNamespace(ctx, namespaceID); err != nil {
+		return nil, err
+	}
+	if stats.Releases, err = s.releaseRepo.CountByEnvironment(ctx, namespaceID); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
@@ -0,0 +1,546 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"config-client/config/domain/entity"
+	domainErrors "config-client/config/domain/errors"
+	"config-client/config/domain/repository"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	// DefaultNotificationTimeout 单次发送默认超时时间
+	DefaultNotificationTimeout = 10 * time.Second
+
+	// notificationMaxAttempts 单条通知最大尝试次数（含首次发送）
+	notificationMaxAttempts = 3
+	// notificationRetryInterval 首次失败后的重试间隔，之后每次翻倍
+	notificationRetryInterval = 2 * time.Second
+	// notificationKeyLimit 消息中每类变更最多列出的配置键数量
+	notificationKeyLimit = 10
+)
+
+// Notifier 通知发送器（由基础设施层按渠道类型实现，如钉钉、Slack、SMTP）
+type Notifier interface {
+	// Type 渠道类型，与 NotificationChannel.Type 对应
+	Type() string
+	// Send 发送一条通知，超时由调用方通过 context 控制
+	Send(ctx context.Context, channel *entity.NotificationChannel, msg *NotificationMessage) error
+}
+
+// NotificationMessage 渲染后的通知消息
+type NotificationMessage struct {
+	Event string // 通知事件
+	Title string // 标题（邮件主题、钉钉消息标题）
+	Text  string // 正文（模板渲染结果，Markdown 列表格式，纯文本下同样可读）
+	Link  string // 详情链接（未配置链接模板时为空）
+}
+
+// ReleaseNotification 发布通知
+type ReleaseNotification struct {
+	Event    string                   // 通知事件
+	Release  *entity.Release          // 发布的版本（回滚时为被回滚的版本）
+	Target   *entity.Release          // 回滚到的目标版本（回滚时）
+	Operator string                   // 操作人
+	Diff     *entity.ReleaseChangelog // 变更摘要（发布时为相对上一已发布版本的变更，回滚时为回滚带来的变更）
+}
+
+// NotificationTemplateData 消息模板数据
+// 自定义模板可使用以下字段，以及 keys 函数（列出前 10 个配置键，如 {{keys .Added}}）
+type NotificationTemplateData struct {
+	Event            string    // 通知事件: publish, rollback, canary_failed
+	EventName        string    // 通知事件名称（如 发布、回滚、灰度失败）
+	NamespaceID      int       // 命名空间ID
+	Namespace        string    // 命名空间名称
+	Environment      string    // 环境
+	ReleaseID        int       // 发布版本ID
+	Version          int       // 版本号
+	VersionName      string    // 版本名称
+	IsCanary         bool      // 是否灰度版本
+	CanaryPercentage int       // 灰度比例
+	Operator         string    // 操作人
+	ReleaseNotes     string    // 发布说明
+	TargetVersion    int       // 回滚到的目标版本号（回滚时）
+	RollbackReason   string    // 回滚原因（回滚时）
+	Added            []string  // 新增的配置键
+	Modified         []string  // 修改的配置键
+	Deleted          []string  // 删除的配置键
+	DiffSummary      string    // 变更摘要（如 新增 1 项，修改 2 项，删除 0 项）
+	Link             string    // 详情链接
+	OccurredAt       time.Time // 事件发生时间
+}
+
+// defaultNotificationTemplate 默认消息模板
+const defaultNotificationTemplate = `- 命名空间: {{.Namespace}}
+- 环境: {{.Environment}}
+- 版本: v{{.Version}}{{with .VersionName}} {{.}}{{end}}
+{{- if eq .Event "publish"}}
+- 发布类型: {{if .IsCanary}}灰度发布（{{.CanaryPercentage}}%）{{else}}全量发布{{end}}
+{{- else}}
+- 回滚到: v{{.TargetVersion}}
+{{- with .RollbackReason}}
+- 回滚原因: {{.}}{{end}}
+{{- end}}
+- 操作人: {{.Operator}}
+{{- with .ReleaseNotes}}
+- 发布说明: {{.}}{{end}}
+- 变更: {{.DiffSummary}}
+{{- with .Added}}
+- 新增: {{keys .}}{{end}}
+{{- with .Modified}}
+- 修改: {{keys .}}{{end}}
+{{- with .Deleted}}
+- 删除: {{keys .}}{{end}}
+- 时间: {{.OccurredAt.Format "2006-01-02 15:04:05"}}`
+
+// notificationTemplateFuncs 消息模板函数
+var notificationTemplateFuncs = template.FuncMap{
+	"keys": formatNotificationKeys,
+}
+
+// NotificationOptions 通知参数
+type NotificationOptions struct {
+	// ReleaseURL 详情链接模板，支持 {namespace_id}、{namespace}、{environment}、{release_id}、{version} 占位符（为空时消息不带链接）
+	ReleaseURL string
+	// Timeout 单次发送超时时间（<=0 时使用默认值）
+	Timeout time.Duration
+}
+
+// NotificationService 通知领域服务
+// 负责命名空间通知渠道的管理，并在发布、回滚和灰度失败时向订阅的渠道发送模板渲染的消息：
+// - 通知在版本状态提交后异步发送，失败时短暂重试，不影响发布结果
+// - 发送器按渠道类型注册，未注册的渠道类型（如未配置 SMTP 时的邮件）不能创建
+type NotificationService struct {
+	channelRepo   repository.NotificationChannelRepository
+	namespaceRepo repository.NamespaceRepository
+	notifiers     map[string]Notifier
+	opts          NotificationOptions
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewNotificationService 创建通知领域服务
+func NewNotificationService(
+	channelRepo repository.NotificationChannelRepository,
+	namespaceRepo repository.NamespaceRepository,
+	opts NotificationOptions,
+) *NotificationService {
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultNotificationTimeout
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &NotificationService{
+		channelRepo:   channelRepo,
+		namespaceRepo: namespaceRepo,
+		notifiers:     make(map[string]Notifier),
+		opts:          opts,
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+}
+
+// RegisterNotifier 注册通知发送器（同类型重复注册时覆盖）
+func (s *NotificationService) RegisterNotifier(notifier Notifier) {
+	s.notifiers[notifier.Type()] = notifier
+}
+
+// Stop 停止通知服务（取消重试等待，等待发送中的通知完成）
+func (s *NotificationService) Stop() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// ==================== 通知渠道管理 ====================
+
+// CreateChannel 创建通知渠道
+// 业务规则：
+// 1. 命名空间必须存在
+// 2. 渠道类型必须已注册发送器，发送目标与渠道类型匹配
+// 3. 事件过滤为空时订阅全部事件，自定义模板必须能够解析
+func (s *NotificationService) CreateChannel(ctx context.Context, channel *entity.NotificationChannel) error {
+	// 1. 校验命名空间和渠道参数
+	namespace, err := s.namespaceRepo.GetByID(ctx, channel.NamespaceID)
+	if err != nil {
+		return err
+	}
+	if namespace == nil {
+		return domainErrors.ErrNamespaceNotFound(strconv.Itoa(channel.NamespaceID))
+	}
+	if err := s.validateChannel(channel); err != nil {
+		return err
+	}
+
+	// 2. 保存
+	channel.UpdatedBy = channel.CreatedBy
+	if err := s.channelRepo.Create(ctx, channel); err != nil {
+		return fmt.Errorf("创建通知渠道失败: %w", err)
+	}
+
+	hlog.CtxInfof(ctx, "创建通知渠道: id=%d, namespaceID=%d, type=%s, events=%v",
+		channel.ID, channel.NamespaceID, channel.Type, channel.Events)
+	return nil
+}
+
+// UpdateChannel 更新通知渠道
+// 渠道类型不可修改；加签密钥为空时保留原密钥
+func (s *NotificationService) UpdateChannel(ctx context.Context, channel *entity.NotificationChannel) error {
+	// 1. 查询已有渠道
+	existing, err := s.GetChannel(ctx, channel.ID)
+	if err != nil {
+		return err
+	}
+
+	// 2. 合并并校验
+	channel.NamespaceID = existing.NamespaceID
+	channel.Type = existing.Type
+	channel.CreatedBy = existing.CreatedBy
+	channel.CreatedAt = existing.CreatedAt
+	if channel.Secret == "" {
+		channel.Secret = existing.Secret
+	}
+	if err := s.validateChannel(channel); err != nil {
+		return err
+	}
+
+	// 3. 保存
+	if err := s.channelRepo.Update(ctx, channel); err != nil {
+		return fmt.Errorf("更新通知渠道失败: %w", err)
+	}
+	return nil
+}
+
+// DeleteChannel 删除通知渠道
+func (s *NotificationService) DeleteChannel(ctx context.Context, id int) error {
+	if _, err := s.GetChannel(ctx, id); err != nil {
+		return err
+	}
+	if err := s.channelRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("删除通知渠道失败: %w", err)
+	}
+	return nil
+}
+
+// GetChannel 根据ID查询通知渠道
+// 所属命名空间不属于当前租户时视为不存在
+func (s *NotificationService) GetChannel(ctx context.Context, id int) (*entity.NotificationChannel, error) {
+	channel, err := s.channelRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if channel == nil {
+		return nil, domainErrors.ErrNotificationChannelNotFound(id)
+	}
+	namespace, err := s.namespaceRepo.GetByID(ctx, channel.NamespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if namespace == nil {
+		return nil, domainErrors.ErrNotificationChannelNotFound(id)
+	}
+	return channel, nil
+}
+
+// ListChannels 查询命名空间下的通知渠道
+func (s *NotificationService) ListChannels(ctx context.Context, namespaceID int) ([]*entity.NotificationChannel, error) {
+	namespace, err := s.namespaceRepo.GetByID(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if namespace == nil {
+		return nil, domainErrors.ErrNamespaceNotFound(strconv.Itoa(namespaceID))
+	}
+	return s.channelRepo.FindByNamespace(ctx, namespaceID, false)
+}
+
+// TestChannel 向通知渠道发送一条测试消息（同步发送，不重试，用于验证渠道配置）
+func (s *NotificationService) TestChannel(ctx context.Context, id int, operator string) error {
+	// 1. 查询渠道和命名空间
+	channel, err := s.GetChannel(ctx, id)
+	if err != nil {
+		return err
+	}
+	namespace, err := s.namespaceRepo.GetByID(ctx, channel.NamespaceID)
+	if err != nil {
+		return err
+	}
+	namespaceName := strconv.Itoa(channel.NamespaceID)
+	if namespace != nil {
+		namespaceName = namespace.Name
+	}
+
+	// 2. 以示例发布数据渲染消息
+	data := &NotificationTemplateData{
+		Event:        entity.NotificationEventPublish,
+		EventName:    "测试消息",
+		NamespaceID:  channel.NamespaceID,
+		Namespace:    namespaceName,
+		Environment:  "test",
+		Version:      1,
+		VersionName:  "v1.0.0",
+		Operator:     operator,
+		ReleaseNotes: "这是一条测试消息，用于验证通知渠道配置",
+		Added:        []string{"example.key"},
+		OccurredAt:   time.Now(),
+	}
+	data.DiffSummary = formatDiffSummary(data)
+	msg, err := renderNotification(channel, data)
+	if err != nil {
+		return domainErrors.ErrNotificationChannelInvalid(err.Error())
+	}
+
+	// 3. 发送
+	sendCtx, cancel := context.WithTimeout(ctx, s.opts.Timeout)
+	defer cancel()
+	if err := s.send(sendCtx, channel, msg); err != nil {
+		return domainErrors.ErrNotificationSendFailed(err.Error())
+	}
+	return nil
+}
+
+// validateChannel 校验通知渠道参数
+func (s *NotificationService) validateChannel(channel *entity.NotificationChannel) error {
+	channel.Name = strings.TrimSpace(channel.Name)
+	if channel.Name == "" {
+		return domainErrors.ErrNotificationChannelInvalid("名称不能为空")
+	}
+	channel.Target = strings.TrimSpace(channel.Target)
+
+	// 1. 渠道类型和发送目标
+	if _, ok := s.notifiers[channel.Type]; !ok {
+		return domainErrors.ErrNotificationChannelInvalid("不支持的渠道类型或未配置发送器: " + channel.Type +
+			"（可选值: " + strings.Join(entity.NotificationChannelTypes, "/") + "，邮件需配置 notification.smtp）")
+	}
+	switch channel.Type {
+	case entity.NotificationChannelEmail:
+		recipients := channel.Recipients()
+		if len(recipients) == 0 {
+			return domainErrors.ErrNotificationChannelInvalid("收件人不能为空")
+		}
+		for _, addr := range recipients {
+			if _, err := mail.ParseAddress(addr); err != nil {
+				return domainErrors.ErrNotificationChannelInvalid("收件人地址无效: " + addr)
+			}
+		}
+	default:
+		parsed, err := url.Parse(channel.Target)
+		if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+			return domainErrors.ErrNotificationChannelInvalid("机器人 Webhook 地址必须是 https 绝对地址")
+		}
+	}
+
+	// 2. 事件过滤
+	for _, event := range channel.Events {
+		if !entity.IsValidNotificationEvent(event) {
+			return domainErrors.ErrNotificationChannelInvalid("不支持的通知事件: " + event +
+				"（可选值: " + strings.Join(entity.NotificationEvents, "/") + "）")
+		}
+	}
+
+	// 3. 自定义模板
+	if strings.TrimSpace(channel.Template) != "" {
+		if _, err := template.New("notification").Funcs(notificationTemplateFuncs).Parse(channel.Template); err != nil {
+			return domainErrors.ErrNotificationChannelInvalid("消息模板无效: " + err.Error())
+		}
+	}
+	return nil
+}
+
+// ==================== 发送通知 ====================
+
+// NotifyRelease 向命名空间内订阅了事件的通知渠道发送发布通知
+// 需在版本状态提交后调用；查询渠道、渲染和发送均在后台进行，失败仅记录日志
+func (s *NotificationService) NotifyRelease(ctx context.Context, n *ReleaseNotification) {
+	if s.ctx.Err() != nil {
+		return
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.dispatch(context.WithoutCancel(ctx), n)
+	}()
+}
+
+// dispatch 渲染消息并发送到订阅了事件的渠道
+func (s *NotificationService) dispatch(ctx context.Context, n *ReleaseNotification) {
+	release := n.Release
+
+	// 1. 查询订阅了事件的渠道
+	channels, err := s.channelRepo.FindByNamespace(ctx, release.NamespaceID, true)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "查询通知渠道失败: namespaceID=%d, err=%v", release.NamespaceID, err)
+		return
+	}
+	matched := make([]*entity.NotificationChannel, 0, len(channels))
+	for _, channel := range channels {
+		if channel.Matches(n.Event) {
+			matched = append(matched, channel)
+		}
+	}
+	if len(matched) == 0 {
+		return
+	}
+
+	// 2. 构建模板数据
+	data := s.buildTemplateData(ctx, n)
+
+	// 3. 逐个渠道渲染并发送（渠道间互不影响）
+	var wg sync.WaitGroup
+	for _, channel := range matched {
+		msg, err := renderNotification(channel, data)
+		if err != nil {
+			hlog.CtxErrorf(ctx, "渲染通知消息失败: channelID=%d, err=%v", channel.ID, err)
+			continue
+		}
+		wg.Add(1)
+		go func(channel *entity.NotificationChannel) {
+			defer wg.Done()
+			s.sendWithRetry(ctx, channel, msg)
+		}(channel)
+	}
+	wg.Wait()
+}
+
+// sendWithRetry 发送通知，失败时按间隔翻倍重试，服务停止时放弃重试
+func (s *NotificationService) sendWithRetry(ctx context.Context, channel *entity.NotificationChannel, msg *NotificationMessage) {
+	interval := notificationRetryInterval
+	for attempt := 1; ; attempt++ {
+		sendCtx, cancel := context.WithTimeout(ctx, s.opts.Timeout)
+		err := s.send(sendCtx, channel, msg)
+		cancel()
+		if err == nil {
+			return
+		}
+
+		hlog.CtxWarnf(ctx, "发送通知失败: channelID=%d, type=%s, event=%s, attempt=%d, err=%v",
+			channel.ID, channel.Type, msg.Event, attempt, err)
+		if attempt >= notificationMaxAttempts {
+			return
+		}
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+		interval *= 2
+	}
+}
+
+// send 使用渠道类型对应的发送器发送一次通知
+func (s *NotificationService) send(ctx context.Context, channel *entity.NotificationChannel, msg *NotificationMessage) error {
+	notifier, ok := s.notifiers[channel.Type]
+	if !ok {
+		return fmt.Errorf("未注册的渠道类型: %s", channel.Type)
+	}
+	return notifier.Send(ctx, channel, msg)
+}
+
+// buildTemplateData 构建消息模板数据
+func (s *NotificationService) buildTemplateData(ctx context.Context, n *ReleaseNotification) *NotificationTemplateData {
+	release := n.Release
+	data := &NotificationTemplateData{
+		Event:            n.Event,
+		EventName:        notificationEventName(n.Event),
+		NamespaceID:      release.NamespaceID,
+		Namespace:        strconv.Itoa(release.NamespaceID),
+		Environment:      release.Environment,
+		ReleaseID:        release.ID,
+		Version:          release.Version,
+		VersionName:      release.VersionName,
+		IsCanary:         release.ReleaseType == entity.ReleaseTypeCanary,
+		CanaryPercentage: release.CanaryPercentage,
+		Operator:         n.Operator,
+		ReleaseNotes:     release.ReleaseNotes,
+		OccurredAt:       time.Now(),
+	}
+	if n.Target != nil {
+		data.TargetVersion = n.Target.Version
+		data.RollbackReason = release.RollbackReason
+	}
+	if n.Diff != nil {
+		data.Added = n.Diff.Added
+		data.Modified = n.Diff.Modified
+		data.Deleted = n.Diff.Deleted
+	}
+	data.DiffSummary = formatDiffSummary(data)
+
+	// 命名空间名称查询失败时以ID代替
+	if namespace, err := s.namespaceRepo.GetByID(ctx, release.NamespaceID); err == nil && namespace != nil {
+		data.Namespace = namespace.Name
+	}
+
+	if s.opts.ReleaseURL != "" {
+		data.Link = strings.NewReplacer(
+			"{namespace_id}", strconv.Itoa(data.NamespaceID),
+			"{namespace}", url.PathEscape(data.Namespace),
+			"{environment}", url.PathEscape(data.Environment),
+			"{release_id}", strconv.Itoa(data.ReleaseID),
+			"{version}", strconv.Itoa(data.Version),
+		).Replace(s.opts.ReleaseURL)
+	}
+	return data
+}
+
+// renderNotification 使用渠道模板（为空时使用默认模板）渲染通知消息
+func renderNotification(channel *entity.NotificationChannel, data *NotificationTemplateData) (*NotificationMessage, error) {
+	text := channel.Template
+	if strings.TrimSpace(text) == "" {
+		text = defaultNotificationTemplate
+	}
+	tmpl, err := template.New("notification").Funcs(notificationTemplateFuncs).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return &NotificationMessage{
+		Event: data.Event,
+		Title: fmt.Sprintf("[配置中心] %s: %s/%s v%d", data.EventName, data.Namespace, data.Environment, data.Version),
+		Text:  buf.String(),
+		Link:  data.Link,
+	}, nil
+}
+
+// notificationEventName 通知事件名称
+func notificationEventName(event string) string {
+	switch event {
+	case entity.NotificationEventPublish:
+		return "发布"
+	case entity.NotificationEventRollback:
+		return "回滚"
+	case entity.NotificationEventCanaryFailed:
+		return "灰度失败"
+	default:
+		return event
+	}
+}
+
+// formatDiffSummary 格式化变更摘要
+func formatDiffSummary(data *NotificationTemplateData) string {
+	return fmt.Sprintf("新增 %d 项，修改 %d 项，删除 %d 项", len(data.Added), len(data.Modified), len(data.Deleted))
+}
+
+// formatNotificationKeys 列出前 notificationKeyLimit 个配置键，超出部分以数量表示
+func formatNotificationKeys(keys []string) string {
+	if len(keys) <= notificationKeyLimit {
+		return strings.Join(keys, ", ")
+	}
+	return strings.Join(keys[:notificationKeyLimit], ", ") + fmt.Sprintf(" 等 %d 项", len(keys))
+}
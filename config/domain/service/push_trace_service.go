@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"config-client/config/domain/entity"
+	"config-client/config/domain/repository"
+	shareRepo "config-client/share/repository"
+)
+
+// DefaultPushTraceRetention 通知下发记录默认保留时长
+const DefaultPushTraceRetention = 7 * 24 * time.Hour
+
+// PushTraceService 变更通知下发追踪服务
+// 记录每次长轮询返回给客户端的变更通知，客户端随后上报的版本与通知一致时标记为已确认，
+// 用于回答"客户端是否收到了某次变更"
+type PushTraceService struct {
+	traceRepo repository.PushTraceRepository
+	retention time.Duration
+}
+
+// NewPushTraceService 创建变更通知下发追踪服务
+// retention: 记录保留时长（<=0 时使用默认值 7 天）
+func NewPushTraceService(traceRepo repository.PushTraceRepository, retention time.Duration) *PushTraceService {
+	if retention <= 0 {
+		retention = DefaultPushTraceRetention
+	}
+	return &PushTraceService{
+		traceRepo: traceRepo,
+		retention: retention,
+	}
+}
+
+// Record 保存下发记录
+func (s *PushTraceService) Record(ctx context.Context, traces []*entity.PushTrace) error {
+	if err := s.traceRepo.BatchCreate(ctx, traces); err != nil {
+		return fmt.Errorf("记录通知下发失败: %w", err)
+	}
+	return nil
+}
+
+// AckReported 根据客户端上报的版本确认下发记录
+// versions: 配置键（格式 "namespaceID:configKey"）到客户端当前版本的映射
+// 返回: 本次确认的记录数量
+func (s *PushTraceService) AckReported(ctx context.Context, subscriptionID int, versions map[string]string) (int, error) {
+	traces, err := s.traceRepo.FindUnackedBySubscriptionID(ctx, subscriptionID)
+	if err != nil {
+		return 0, err
+	}
+
+	ids := make([]int64, 0, len(traces))
+	for _, trace := range traces {
+		version, reported := versions[fmt.Sprintf("%d:%s", trace.NamespaceID, trace.ConfigKey)]
+		if reported && version == trace.Version {
+			ids = append(ids, trace.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	if err := s.traceRepo.MarkAcked(ctx, ids, time.Now()); err != nil {
+		return 0, err
+	}
+	return len(ids), nil
+}
+
+// PushTraceQueryResult 下发记录查询结果
+type PushTraceQueryResult struct {
+	Page  *shareRepo.PageResult[*entity.PushTrace] // 分页记录
+	Acked int64                                    // 满足条件的记录中已确认的数量（不受 Acked 过滤影响）
+	All   int64                                    // 满足条件的记录总数（不受 Acked 过滤影响）
+}
+
+// Query 分页查询下发记录，并统计确认情况
+func (s *PushTraceService) Query(ctx context.Context, params *repository.PushTraceQueryParams) (*PushTraceQueryResult, error) {
+	page, err := s.traceRepo.Query(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	all, acked, err := s.traceRepo.Summarize(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PushTraceQueryResult{Page: page, Acked: acked, All: all}, nil
+}
+
+// CleanExpired 清理超过保留时长的下发记录
+func (s *PushTraceService) CleanExpired(ctx context.Context) (int64, error) {
+	return s.traceRepo.DeleteBefore(ctx, time.Now().Add(-s.retention))
+}
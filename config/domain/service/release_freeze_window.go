@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"config-client/config/domain/entity"
+	"config-client/config/domain/errors"
+	"config-client/config/domain/repository"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// weekdays 冻结窗口时间中的星期缩写
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// NewFreezeWindow 创建发布冻结窗口
+// start/end 格式为 "星期缩写 HH:MM"，例如 "Fri 18:00"、"Mon 08:00"；timezone 为空时使用本地时区
+func NewFreezeWindow(name string, namespaceIDs []int, environments []string, start, end, timezone string) (*entity.FreezeWindow, error) {
+	startMinute, err := parseWeeklyTime(start)
+	if err != nil {
+		return nil, fmt.Errorf("冻结窗口 %s 开始时间无效: %w", name, err)
+	}
+	endMinute, err := parseWeeklyTime(end)
+	if err != nil {
+		return nil, fmt.Errorf("冻结窗口 %s 结束时间无效: %w", name, err)
+	}
+	if startMinute == endMinute {
+		return nil, fmt.Errorf("冻结窗口 %s 开始时间与结束时间相同", name)
+	}
+
+	location := time.Local
+	if timezone != "" {
+		location, err = time.LoadLocation(timezone)
+		if err != nil {
+			return nil, fmt.Errorf("冻结窗口 %s 时区无效: %w", name, err)
+		}
+	}
+
+	return &entity.FreezeWindow{
+		Name:         name,
+		NamespaceIDs: namespaceIDs,
+		Environments: environments,
+		StartMinute:  startMinute,
+		EndMinute:    endMinute,
+		Location:     location,
+	}, nil
+}
+
+// parseWeeklyTime 解析 "星期缩写 HH:MM" 为自周日 00:00 起的分钟数
+func parseWeeklyTime(value string) (int, error) {
+	parts := strings.Fields(value)
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("格式应为 \"Fri 18:00\": %q", value)
+	}
+
+	weekday, ok := weekdays[strings.ToLower(parts[0])]
+	if !ok {
+		return 0, fmt.Errorf("无效的星期: %q", parts[0])
+	}
+	clock, err := time.Parse("15:04", parts[1])
+	if err != nil {
+		return 0, fmt.Errorf("无效的时间: %q", parts[1])
+	}
+	return int(weekday)*24*60 + clock.Hour()*60 + clock.Minute(), nil
+}
+
+// SetFreezeWindows 设置发布冻结窗口及覆盖审计记录仓储
+func (s *ReleaseService) SetFreezeWindows(windows []*entity.FreezeWindow, overrideRepo repository.ReleaseFreezeOverrideRepository) {
+	s.freezeWindows = windows
+	s.freezeOverrideRepo = overrideRepo
+}
+
+// FindActiveFreezeWindow 查询指定时间作用于命名空间和环境的冻结窗口（不在冻结期时返回 nil）
+func (s *ReleaseService) FindActiveFreezeWindow(namespaceID int, environment string, at time.Time) *entity.FreezeWindow {
+	for _, window := range s.freezeWindows {
+		if window.Applies(namespaceID, environment) && window.Contains(at) {
+			return window
+		}
+	}
+	return nil
+}
+
+// FreezeOverride 冻结窗口覆盖
+// 冻结窗口内执行发布或回滚必须显式覆盖并填写原因，覆盖会记录审计
+type FreezeOverride struct {
+	Enabled bool   // 是否覆盖冻结窗口
+	Reason  string // 覆盖原因
+}
+
+// checkFreezeWindow 检查发布或回滚是否处于冻结窗口
+// 业务规则：
+// 1. 不在冻结窗口内时直接放行，覆盖标记被忽略
+// 2. 冻结窗口内未覆盖时拒绝
+// 3. 覆盖必须填写原因
+// 返回: 被覆盖的冻结窗口（需在操作成功后记录审计），不在冻结期时返回 nil
+func (s *ReleaseService) checkFreezeWindow(release *entity.Release, override *FreezeOverride) (*entity.FreezeWindow, error) {
+	now := time.Now()
+	window := s.FindActiveFreezeWindow(release.NamespaceID, release.Environment, now)
+	if window == nil {
+		return nil, nil
+	}
+
+	if override == nil || !override.Enabled {
+		return nil, errors.ErrReleaseFrozen(window.Name, window.EndsAt(now).Format("2006-01-02 15:04 MST"))
+	}
+	if strings.TrimSpace(override.Reason) == "" {
+		return nil, errors.ErrReleaseFreezeOverrideInvalid("覆盖冻结窗口必须填写原因")
+	}
+	return window, nil
+}
+
+// recordFreezeOverride 记录冻结窗口覆盖审计
+// 记录失败仅打印日志，不影响已完成的发布或回滚
+func (s *ReleaseService) recordFreezeOverride(
+	ctx context.Context,
+	window *entity.FreezeWindow,
+	release *entity.Release,
+	operation string,
+	operator string,
+	override *FreezeOverride,
+) {
+	if window == nil {
+		return
+	}
+
+	hlog.CtxWarnf(ctx, "覆盖发布冻结窗口: window=%s, releaseID=%d, namespace=%d, env=%s, operation=%s, operator=%s, reason=%s",
+		window.Name, release.ID, release.NamespaceID, release.Environment, operation, operator, override.Reason)
+
+	if s.freezeOverrideRepo == nil {
+		return
+	}
+	if err := s.freezeOverrideRepo.Create(ctx, &entity.ReleaseFreezeOverride{
+		ReleaseID:   release.ID,
+		NamespaceID: release.NamespaceID,
+		Environment: release.Environment,
+		WindowName:  window.Name,
+		Operation:   operation,
+		Operator:    operator,
+		Reason:      strings.TrimSpace(override.Reason),
+	}); err != nil {
+		hlog.CtxErrorf(ctx, "记录冻结窗口覆盖失败: releaseID=%d, error=%v", release.ID, err)
+	}
+}
+
+// ListFreezeOverrides 查询命名空间和环境最近的冻结窗口覆盖记录
+func (s *ReleaseService) ListFreezeOverrides(ctx context.Context, namespaceID int, environment string, limit int) ([]*entity.ReleaseFreezeOverride, error) {
+	if s.freezeOverrideRepo == nil {
+		return []*entity.ReleaseFreezeOverride{}, nil
+	}
+	return s.freezeOverrideRepo.FindRecent(ctx, namespaceID, environment, limit)
+}
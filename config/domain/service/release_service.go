@@ -3,13 +3,18 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"config-client/config/domain/entity"
+	"config-client/config/domain/errors"
 	"config-client/config/domain/listener"
 	"config-client/config/domain/repository"
 	shareRepo "config-client/share/repository"
+	"config-client/share/tracing"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // ReleaseService 发布管理领域服务
@@ -20,6 +25,18 @@ type ReleaseService struct {
 	configSvc    *ConfigService
 	listener     listener.ConfigListener
 	canaryEngine *CanaryRuleEngine
+	outbox       *EventOutbox // 事务性发件箱（可选，启用后变更事件与版本状态同事务保存）
+
+	approvalRepo          repository.ReleaseApprovalRepository // 审批记录仓储（可选，启用发布审批时注入）
+	protectedEnvironments map[string]bool                      // 发布前需要审批的受保护环境
+
+	freezeWindows      []*entity.FreezeWindow                     // 发布冻结窗口（窗口内拒绝发布和回滚，除非显式覆盖）
+	freezeOverrideRepo repository.ReleaseFreezeOverrideRepository // 冻结窗口覆盖审计记录仓储（可选）
+
+	webhooks      *WebhookService      // Webhook 服务（可选，发布和回滚事件投递到命名空间 Webhook）
+	notifications *NotificationService // 通知服务（可选，发布、回滚和灰度失败时发送钉钉/Slack/邮件通知）
+
+	quotas *NamespaceQuotaService // 命名空间配额服务（可选，创建版本后清理超出保留数量的版本）
 }
 
 // NewReleaseService 创建发布管理服务
@@ -39,19 +56,57 @@ func NewReleaseService(
 	}
 }
 
+// SetEventOutbox 设置事务性发件箱
+func (s *ReleaseService) SetEventOutbox(outbox *EventOutbox) {
+	s.outbox = outbox
+}
+
+// SetWebhookService 设置 Webhook 服务
+func (s *ReleaseService) SetWebhookService(webhooks *WebhookService) {
+	s.webhooks = webhooks
+}
+
+// SetNotificationService 设置通知服务
+func (s *ReleaseService) SetNotificationService(notifications *NotificationService) {
+	s.notifications = notifications
+}
+
+// SetQuotaService 设置命名空间配额服务
+func (s *ReleaseService) SetQuotaService(quotas *NamespaceQuotaService) {
+	s.quotas = quotas
+}
+
+// SetApprovalGate 启用发布审批
+// 发布到受保护环境的版本必须先由创建人以外的用户审批通过
+func (s *ReleaseService) SetApprovalGate(approvalRepo repository.ReleaseApprovalRepository, protectedEnvironments []string) {
+	s.approvalRepo = approvalRepo
+	s.protectedEnvironments = make(map[string]bool, len(protectedEnvironments))
+	for _, environment := range protectedEnvironments {
+		s.protectedEnvironments[environment] = true
+	}
+}
+
+// RequiresApproval 判断发布到指定环境是否需要审批
+func (s *ReleaseService) RequiresApproval(environment string) bool {
+	return s.approvalRepo != nil && s.protectedEnvironments[environment]
+}
+
 // ==================== 版本创建 ====================
 
 // CreateReleaseRequest 创建发布版本请求
 type CreateReleaseRequest struct {
-	NamespaceID int
-	Environment string
-	VersionName string
-	ReleaseType entity.ReleaseType
-	CreatedBy   string
+	NamespaceID  int
+	Environment  string
+	VersionName  string
+	ReleaseType  entity.ReleaseType
+	ReleaseNotes string // 发布说明（可选，发布前必须填写）
+	CreatedBy    string
 }
 
 // CreateRelease 创建发布版本
 // 将当前命名空间下的所有配置打快照,创建发布版本
+// 增量发布只保存相对最新已发布版本变更的配置，生效配置为基线版本合并增量快照
+// 版本数量超过命名空间配额 max_releases_retained 时清理最早的版本
 func (s *ReleaseService) CreateRelease(ctx context.Context, req *CreateReleaseRequest) (*entity.Release, error) {
 	// 1. 查询该命名空间下的所有配置
 	configs, err := s.configRepo.FindReleasedConfigs(ctx, req.NamespaceID, req.Environment)
@@ -64,6 +119,76 @@ func (s *ReleaseService) CreateRelease(ctx context.Context, req *CreateReleaseRe
 	}
 
 	// 2. 构建配置快照
+	snapshot := buildConfigSnapshot(configs)
+
+	// 3. 查询基线版本（最新已发布版本），生成相对基线的变更明细
+	base, baseSnapshot, err := s.loadBaseRelease(ctx, req.NamespaceID, req.Environment)
+	if err != nil {
+		return nil, err
+	}
+	changelog := buildChangelog(base, baseSnapshot, snapshot)
+
+	// 增量发布：只保留相对基线版本变更的配置
+	baseReleaseID := 0
+	if req.ReleaseType == entity.ReleaseTypeIncremental {
+		if base == nil {
+			hlog.CtxInfof(ctx, "没有已发布的基线版本，增量发布使用全量快照: namespace=%d, env=%s", req.NamespaceID, req.Environment)
+		} else {
+			snapshot, err = buildIncrementalSnapshot(base, baseSnapshot, snapshot)
+			if err != nil {
+				return nil, err
+			}
+			baseReleaseID = base.ID
+		}
+	}
+
+	// 4. 获取下一个版本号
+	nextVersion, err := s.releaseRepo.GetNextVersion(ctx, req.NamespaceID, req.Environment)
+	if err != nil {
+		return nil, fmt.Errorf("获取版本号失败: %w", err)
+	}
+
+	// 5. 创建发布版本实体
+	release := &entity.Release{
+		NamespaceID:   req.NamespaceID,
+		Environment:   req.Environment,
+		Version:       nextVersion,
+		VersionName:   req.VersionName,
+		Status:        entity.ReleaseStatusTesting,
+		ReleaseType:   req.ReleaseType,
+		BaseReleaseID: baseReleaseID,
+		ReleaseNotes:  strings.TrimSpace(req.ReleaseNotes),
+	}
+	release.CreatedBy = req.CreatedBy
+
+	// 设置配置快照和变更明细
+	if err := release.SetConfigSnapshot(snapshot); err != nil {
+		return nil, fmt.Errorf("设置配置快照失败: %w", err)
+	}
+	if err := release.SetChangelog(changelog); err != nil {
+		return nil, fmt.Errorf("设置变更明细失败: %w", err)
+	}
+
+	// 6. 保存发布版本
+	if err := s.releaseRepo.Create(ctx, release); err != nil {
+		return nil, fmt.Errorf("保存发布版本失败: %w", err)
+	}
+
+	hlog.CtxInfof(ctx, "创建发布版本成功: namespace=%d, env=%s, version=%d, versionName=%s, type=%s, baseReleaseID=%d",
+		req.NamespaceID, req.Environment, release.Version, release.VersionName, release.ReleaseType, release.BaseReleaseID)
+
+	// 7. 清理超出保留数量的版本（失败只记录日志，不影响版本创建）
+	if s.quotas != nil {
+		if _, err := s.quotas.PruneReleases(ctx, req.NamespaceID, req.Environment); err != nil {
+			hlog.CtxWarnf(ctx, "清理发布版本失败: namespace=%d, env=%s, err=%v", req.NamespaceID, req.Environment, err)
+		}
+	}
+
+	return release, nil
+}
+
+// buildConfigSnapshot 将配置列表构建为配置快照
+func buildConfigSnapshot(configs []*entity.Config) []entity.ConfigSnapshotItem {
 	snapshot := make([]entity.ConfigSnapshotItem, 0, len(configs))
 	for _, config := range configs {
 		snapshot = append(snapshot, entity.ConfigSnapshotItem{
@@ -78,51 +203,170 @@ func (s *ReleaseService) CreateRelease(ctx context.Context, req *CreateReleaseRe
 			Version:              config.Version,
 		})
 	}
+	return snapshot
+}
 
-	// 3. 获取下一个版本号
-	nextVersion, err := s.releaseRepo.GetNextVersion(ctx, req.NamespaceID, req.Environment)
+// loadBaseRelease 查询基线版本（最新已发布版本）及其生效快照
+// 没有已发布版本时返回 nil
+func (s *ReleaseService) loadBaseRelease(ctx context.Context, namespaceID int, environment string) (*entity.Release, []entity.ConfigSnapshotItem, error) {
+	base, err := s.releaseRepo.FindLatestPublishedRelease(ctx, namespaceID, environment)
 	if err != nil {
-		return nil, fmt.Errorf("获取版本号失败: %w", err)
+		return nil, nil, fmt.Errorf("查询基线版本失败: %w", err)
+	}
+	if base == nil {
+		return nil, nil, nil
 	}
 
-	// 4. 创建发布版本实体
-	release := &entity.Release{
-		NamespaceID: req.NamespaceID,
-		Environment: req.Environment,
-		Version:     nextVersion,
-		VersionName: req.VersionName,
-		Status:      entity.ReleaseStatusTesting,
-		ReleaseType: req.ReleaseType,
+	baseSnapshot, err := s.ResolveSnapshot(ctx, base)
+	if err != nil {
+		return nil, nil, fmt.Errorf("获取基线版本快照失败: %w", err)
 	}
-	release.CreatedBy = req.CreatedBy
+	return base, baseSnapshot, nil
+}
 
-	// 设置配置快照
-	if err := release.SetConfigSnapshot(snapshot); err != nil {
-		return nil, fmt.Errorf("设置配置快照失败: %w", err)
+// buildChangelog 生成相对基线版本的键级变更明细
+// 没有基线版本时所有配置均视为新增
+func buildChangelog(base *entity.Release, baseSnapshot []entity.ConfigSnapshotItem, current []entity.ConfigSnapshotItem) *entity.ReleaseChangelog {
+	changelog := &entity.ReleaseChangelog{
+		Added:    []string{},
+		Modified: []string{},
+		Deleted:  []string{},
+	}
+	if base != nil {
+		changelog.BaseReleaseID = base.ID
+		changelog.BaseVersion = base.Version
 	}
 
-	// 5. 保存发布版本
-	if err := s.releaseRepo.Create(ctx, release); err != nil {
-		return nil, fmt.Errorf("保存发布版本失败: %w", err)
+	baseItems := make(map[string]*entity.ConfigSnapshotItem, len(baseSnapshot))
+	for i := range baseSnapshot {
+		baseItems[baseSnapshot[i].Key] = &baseSnapshot[i]
 	}
 
-	hlog.Infof("创建发布版本成功: namespace=%d, env=%s, version=%d, versionName=%s",
-		req.NamespaceID, req.Environment, release.Version, release.VersionName)
+	currentKeys := make(map[string]bool, len(current))
+	for i := range current {
+		currentKeys[current[i].Key] = true
+		baseItem, exists := baseItems[current[i].Key]
+		if !exists {
+			changelog.Added = append(changelog.Added, current[i].Key)
+		} else if snapshotItemChanged(baseItem, &current[i]) {
+			changelog.Modified = append(changelog.Modified, current[i].Key)
+		}
+	}
+	for _, item := range baseSnapshot {
+		if !currentKeys[item.Key] {
+			changelog.Deleted = append(changelog.Deleted, item.Key)
+		}
+	}
 
-	return release, nil
+	sort.Strings(changelog.Added)
+	sort.Strings(changelog.Modified)
+	sort.Strings(changelog.Deleted)
+	return changelog
+}
+
+// buildIncrementalSnapshot 构建增量快照
+// 业务规则：
+// 1. 新增或内容变化的配置写入增量快照
+// 2. 基线中存在但当前已不存在的配置以删除标记写入增量快照
+// 3. 相对基线没有任何变更时不允许创建版本
+func buildIncrementalSnapshot(
+	base *entity.Release,
+	baseSnapshot []entity.ConfigSnapshotItem,
+	current []entity.ConfigSnapshotItem,
+) ([]entity.ConfigSnapshotItem, error) {
+	// 1. 对比当前配置与基线
+	baseItems := make(map[string]*entity.ConfigSnapshotItem, len(baseSnapshot))
+	for i := range baseSnapshot {
+		baseItems[baseSnapshot[i].Key] = &baseSnapshot[i]
+	}
+
+	increment := make([]entity.ConfigSnapshotItem, 0)
+	currentKeys := make(map[string]bool, len(current))
+	for _, item := range current {
+		currentKeys[item.Key] = true
+		if baseItem, exists := baseItems[item.Key]; exists && !snapshotItemChanged(baseItem, &item) {
+			continue
+		}
+		increment = append(increment, item)
+	}
+
+	// 2. 记录已删除的配置
+	for _, item := range baseSnapshot {
+		if !currentKeys[item.Key] {
+			item.Deleted = true
+			increment = append(increment, item)
+		}
+	}
+
+	if len(increment) == 0 {
+		return nil, fmt.Errorf("自基线版本 v%d 以来没有配置变更，无需创建增量版本", base.Version)
+	}
+	return increment, nil
+}
+
+// snapshotItemChanged 判断快照项内容是否变化
+func snapshotItemChanged(base *entity.ConfigSnapshotItem, current *entity.ConfigSnapshotItem) bool {
+	return base.Value != current.Value ||
+		base.ValueType != current.ValueType ||
+		base.GroupName != current.GroupName ||
+		base.Description != current.Description
+}
+
+// maxIncrementalChainDepth 增量版本链的最大深度，防止基线引用异常时无限查询
+const maxIncrementalChainDepth = 100
+
+// ResolveSnapshot 获取发布版本的生效配置快照
+// 全量版本直接返回其快照；增量版本沿基线链找到全量版本，再依次合并各增量快照
+func (s *ReleaseService) ResolveSnapshot(ctx context.Context, release *entity.Release) ([]entity.ConfigSnapshotItem, error) {
+	// 1. 沿基线链向上查找，直到全量版本
+	chain := []*entity.Release{release}
+	current := release
+	for current.IsIncremental() {
+		if len(chain) > maxIncrementalChainDepth {
+			return nil, fmt.Errorf("增量版本链过长: releaseID=%d", release.ID)
+		}
+		base, err := s.releaseRepo.GetByID(ctx, current.BaseReleaseID)
+		if err != nil {
+			return nil, fmt.Errorf("查询基线版本失败: %w", err)
+		}
+		if base == nil {
+			return nil, fmt.Errorf("基线版本不存在: id=%d", current.BaseReleaseID)
+		}
+		chain = append(chain, base)
+		current = base
+	}
+
+	// 2. 从全量版本开始依次合并增量快照
+	snapshot, err := current.GetConfigSnapshot()
+	if err != nil {
+		return nil, fmt.Errorf("解析版本快照失败: releaseID=%d, %w", current.ID, err)
+	}
+	for i := len(chain) - 2; i >= 0; i-- {
+		increment, err := chain[i].GetConfigSnapshot()
+		if err != nil {
+			return nil, fmt.Errorf("解析版本快照失败: releaseID=%d, %w", chain[i].ID, err)
+		}
+		snapshot = entity.MergeConfigSnapshot(snapshot, increment)
+	}
+	return snapshot, nil
 }
 
 // ==================== 发布操作 ====================
 
 // PublishRequest 发布请求
 type PublishRequest struct {
-	ReleaseID   int
-	PublishedBy string
+	ReleaseID    int
+	PublishedBy  string
+	ReleaseNotes string          // 发布说明（可选，非空时覆盖创建版本时填写的说明）
+	Override     *FreezeOverride // 冻结窗口覆盖（可选）
 }
 
 // PublishFull 全量发布
 // 将发布版本标记为已发布,并通知所有订阅者
-func (s *ReleaseService) PublishFull(ctx context.Context, req *PublishRequest) error {
+func (s *ReleaseService) PublishFull(ctx context.Context, req *PublishRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "ReleaseService.PublishFull", attribute.Int("release.id", req.ReleaseID))
+	defer func() { tracing.End(span, err) }()
+
 	// 1. 查询发布版本
 	release, err := s.releaseRepo.GetByID(ctx, req.ReleaseID)
 	if err != nil {
@@ -136,46 +380,57 @@ func (s *ReleaseService) PublishFull(ctx context.Context, req *PublishRequest) e
 	if !release.CanPublish() {
 		return fmt.Errorf("发布版本状态不允许发布: status=%s", release.Status)
 	}
+	if err := applyReleaseNotes(release, req.ReleaseNotes); err != nil {
+		return err
+	}
+	if s.RequiresApproval(release.Environment) && !release.IsApproved() {
+		return errors.ErrReleaseNotApproved(release.Environment, release.ID)
+	}
+	frozenWindow, err := s.checkFreezeWindow(release, req.Override)
+	if err != nil {
+		return err
+	}
 
 	// 3. 标记为已发布
 	release.Publish(req.PublishedBy)
 
-	// 4. 保存更新
-	if err := s.releaseRepo.Update(ctx, release); err != nil {
-		return fmt.Errorf("更新发布版本失败: %w", err)
-	}
-
-	// 5. 发布配置变更事件,通知所有订阅者
-	snapshot, err := release.GetConfigSnapshot()
-	if err != nil {
-		hlog.Errorf("获取配置快照失败: %v", err)
-	} else {
-		for _, item := range snapshot {
-			s.publishConfigChangeEvent(ctx, &listener.ConfigChangeEvent{
-				NamespaceID: release.NamespaceID,
-				ConfigKey:   item.Key,
-				ConfigID:    item.ConfigID,
-				Action:      "release",
-			})
+	// 4. 保存更新并发布配置变更事件,通知所有订阅者
+	err = s.withEventTx(ctx, func(txCtx context.Context) error {
+		if err := s.releaseRepo.Update(txCtx, release); err != nil {
+			return fmt.Errorf("更新发布版本失败: %w", err)
 		}
+		if err := s.publishSnapshotEvents(txCtx, release, "release"); err != nil {
+			return err
+		}
+		return s.notifyReleaseWebhooks(txCtx, entity.WebhookEventReleasePublish, release, req.PublishedBy, nil)
+	})
+	if err != nil {
+		return err
 	}
 
-	hlog.Infof("全量发布成功: releaseID=%d, version=%d, configCount=%d",
+	hlog.CtxInfof(ctx, "全量发布成功: releaseID=%d, version=%d, configCount=%d",
 		release.ID, release.Version, release.ConfigCount)
+	s.notifyRelease(ctx, entity.NotificationEventPublish, release, req.PublishedBy, nil, nil)
+	s.recordFreezeOverride(ctx, frozenWindow, release, entity.FreezeOperationPublishFull, req.PublishedBy, req.Override)
 
 	return nil
 }
 
 // PublishCanaryRequest 灰度发布请求
 type PublishCanaryRequest struct {
-	ReleaseID   int
-	CanaryRule  *entity.CanaryRule
-	PublishedBy string
+	ReleaseID    int
+	CanaryRule   *entity.CanaryRule
+	PublishedBy  string
+	ReleaseNotes string          // 发布说明（可选，非空时覆盖创建版本时填写的说明）
+	Override     *FreezeOverride // 冻结窗口覆盖（可选）
 }
 
 // PublishCanary 灰度发布
 // 设置灰度规则,只对匹配规则的客户端生效
-func (s *ReleaseService) PublishCanary(ctx context.Context, req *PublishCanaryRequest) error {
+func (s *ReleaseService) PublishCanary(ctx context.Context, req *PublishCanaryRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "ReleaseService.PublishCanary", attribute.Int("release.id", req.ReleaseID))
+	defer func() { tracing.End(span, err) }()
+
 	// 1. 验证灰度规则
 	if err := s.canaryEngine.ValidateRule(req.CanaryRule); err != nil {
 		return fmt.Errorf("灰度规则验证失败: %w", err)
@@ -194,6 +449,16 @@ func (s *ReleaseService) PublishCanary(ctx context.Context, req *PublishCanaryRe
 	if !release.CanPublish() {
 		return fmt.Errorf("发布版本状态不允许发布: status=%s", release.Status)
 	}
+	if err := applyReleaseNotes(release, req.ReleaseNotes); err != nil {
+		return err
+	}
+	if s.RequiresApproval(release.Environment) && !release.IsApproved() {
+		return errors.ErrReleaseNotApproved(release.Environment, release.ID)
+	}
+	frozenWindow, err := s.checkFreezeWindow(release, req.Override)
+	if err != nil {
+		return err
+	}
 
 	// 4. 设置灰度规则
 	if err := release.SetCanaryRule(req.CanaryRule); err != nil {
@@ -204,30 +469,125 @@ func (s *ReleaseService) PublishCanary(ctx context.Context, req *PublishCanaryRe
 	release.Publish(req.PublishedBy)
 	release.ReleaseType = entity.ReleaseTypeCanary
 
-	// 6. 保存更新
-	if err := s.releaseRepo.Update(ctx, release); err != nil {
-		return fmt.Errorf("更新发布版本失败: %w", err)
+	// 6. 保存更新并发布配置变更事件（订阅管理器会根据灰度规则过滤）
+	err = s.withEventTx(ctx, func(txCtx context.Context) error {
+		if err := s.releaseRepo.Update(txCtx, release); err != nil {
+			return fmt.Errorf("更新发布版本失败: %w", err)
+		}
+		if err := s.publishSnapshotEvents(txCtx, release, "canary_release"); err != nil {
+			return err
+		}
+		return s.notifyReleaseWebhooks(txCtx, entity.WebhookEventReleaseCanary, release, req.PublishedBy, nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	hlog.CtxInfof(ctx, "灰度发布成功: releaseID=%d, version=%d, percentage=%d",
+		release.ID, release.Version, req.CanaryRule.Percentage)
+	s.notifyRelease(ctx, entity.NotificationEventPublish, release, req.PublishedBy, nil, nil)
+	s.recordFreezeOverride(ctx, frozenWindow, release, entity.FreezeOperationPublishCanary, req.PublishedBy, req.Override)
+
+	return nil
+}
+
+// applyReleaseNotes 设置发布时填写的发布说明，并校验发布说明不为空
+// 发布说明可在创建版本或发布时填写，发布时填写的覆盖创建时的说明
+func applyReleaseNotes(release *entity.Release, notes string) error {
+	if notes = strings.TrimSpace(notes); notes != "" {
+		release.ReleaseNotes = notes
+	}
+	if release.ReleaseNotes == "" {
+		return fmt.Errorf("发布说明不能为空，请在创建版本或发布时填写: releaseID=%d", release.ID)
 	}
+	return nil
+}
 
-	// 7. 发布配置变更事件（订阅管理器会根据灰度规则过滤）
-	snapshot, err := release.GetConfigSnapshot()
+// ==================== 发布审批 ====================
+
+// ReviewReleaseRequest 审批请求
+type ReviewReleaseRequest struct {
+	ReleaseID int
+	Operator  string
+	Comment   string
+}
+
+// ApproveRelease 审批通过发布版本
+// 业务规则：
+// 1. 只有测试中的版本可以审批
+// 2. 审批人不能是版本创建人
+// 3. 审批状态更新与审批记录在同一事务内保存
+func (s *ReleaseService) ApproveRelease(ctx context.Context, req *ReviewReleaseRequest) (*entity.Release, error) {
+	return s.reviewRelease(ctx, req, entity.ReleaseApprovalActionApprove)
+}
+
+// RejectRelease 驳回发布版本
+// 驳回后需重新审批通过才能发布；驳回必须填写意见
+func (s *ReleaseService) RejectRelease(ctx context.Context, req *ReviewReleaseRequest) (*entity.Release, error) {
+	if strings.TrimSpace(req.Comment) == "" {
+		return nil, errors.ErrReleaseApprovalInvalid("驳回必须填写审批意见")
+	}
+	return s.reviewRelease(ctx, req, entity.ReleaseApprovalActionReject)
+}
+
+// reviewRelease 执行审批动作并记录审批记录
+func (s *ReleaseService) reviewRelease(ctx context.Context, req *ReviewReleaseRequest, action string) (*entity.Release, error) {
+	if s.approvalRepo == nil {
+		return nil, errors.ErrReleaseApprovalInvalid("未启用发布审批")
+	}
+
+	// 1. 查询发布版本
+	release, err := s.GetReleaseByID(ctx, req.ReleaseID)
 	if err != nil {
-		hlog.Errorf("获取配置快照失败: %v", err)
+		return nil, err
+	}
+
+	// 2. 校验版本状态和审批人
+	if !release.CanApprove() {
+		return nil, errors.ErrReleaseApprovalInvalid("版本状态不允许审批: status=" + string(release.Status))
+	}
+	if req.Operator == release.CreatedBy {
+		return nil, errors.ErrReleaseApproverConflict(req.Operator)
+	}
+
+	// 3. 更新审批状态
+	if action == entity.ReleaseApprovalActionApprove {
+		release.Approve(req.Operator)
 	} else {
-		for _, item := range snapshot {
-			s.publishConfigChangeEvent(ctx, &listener.ConfigChangeEvent{
-				NamespaceID: release.NamespaceID,
-				ConfigKey:   item.Key,
-				ConfigID:    item.ConfigID,
-				Action:      "canary_release",
-			})
+		release.Reject(req.Operator)
+	}
+
+	// 4. 保存审批状态和审批记录
+	err = s.configRepo.WithTx(ctx, func(txCtx context.Context) error {
+		if err := s.releaseRepo.Update(txCtx, release); err != nil {
+			return fmt.Errorf("更新发布版本失败: %w", err)
 		}
+		return s.approvalRepo.Create(txCtx, &entity.ReleaseApproval{
+			ReleaseID:   release.ID,
+			NamespaceID: release.NamespaceID,
+			Environment: release.Environment,
+			Version:     release.Version,
+			Action:      action,
+			Operator:    req.Operator,
+			Comment:     strings.TrimSpace(req.Comment),
+		})
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	hlog.Infof("灰度发布成功: releaseID=%d, version=%d, percentage=%d",
-		release.ID, release.Version, req.CanaryRule.Percentage)
+	hlog.CtxInfof(ctx, "发布版本审批: releaseID=%d, env=%s, version=%d, action=%s, operator=%s",
+		release.ID, release.Environment, release.Version, action, req.Operator)
 
-	return nil
+	return release, nil
+}
+
+// ListApprovals 查询发布版本的审批记录
+func (s *ReleaseService) ListApprovals(ctx context.Context, releaseID int) ([]*entity.ReleaseApproval, error) {
+	if s.approvalRepo == nil {
+		return []*entity.ReleaseApproval{}, nil
+	}
+	return s.approvalRepo.FindByReleaseID(ctx, releaseID)
 }
 
 // ==================== 回滚操作 ====================
@@ -238,13 +598,18 @@ type RollbackRequest struct {
 	TargetReleaseID  int
 	RollbackBy       string
 	Reason           string
+	Override         *FreezeOverride // 冻结窗口覆盖（可选）
 }
 
 // Rollback 回滚到指定版本
 // 1. 标记当前版本为已回滚
 // 2. 恢复目标版本的配置快照
 // 3. 通知所有订阅者
-func (s *ReleaseService) Rollback(ctx context.Context, req *RollbackRequest) error {
+func (s *ReleaseService) Rollback(ctx context.Context, req *RollbackRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "ReleaseService.Rollback",
+		attribute.Int("release.id", req.CurrentReleaseID), attribute.Int("release.target_id", req.TargetReleaseID))
+	defer func() { tracing.End(span, err) }()
+
 	// 1. 查询当前版本
 	currentRelease, err := s.releaseRepo.GetByID(ctx, req.CurrentReleaseID)
 	if err != nil {
@@ -258,6 +623,10 @@ func (s *ReleaseService) Rollback(ctx context.Context, req *RollbackRequest) err
 	if !currentRelease.CanRollback() {
 		return fmt.Errorf("当前版本状态不允许回滚: status=%s", currentRelease.Status)
 	}
+	frozenWindow, err := s.checkFreezeWindow(currentRelease, req.Override)
+	if err != nil {
+		return err
+	}
 
 	// 3. 查询目标版本
 	targetRelease, err := s.releaseRepo.GetByID(ctx, req.TargetReleaseID)
@@ -274,51 +643,67 @@ func (s *ReleaseService) Rollback(ctx context.Context, req *RollbackRequest) err
 		return fmt.Errorf("版本不在同一命名空间或环境")
 	}
 
-	// 5. 恢复目标版本的配置快照
-	targetSnapshot, err := targetRelease.GetConfigSnapshot()
+	// 5. 恢复目标版本的配置快照（增量版本合并基线后的生效配置）
+	targetSnapshot, err := s.ResolveSnapshot(ctx, targetRelease)
 	if err != nil {
 		return fmt.Errorf("获取目标版本快照失败: %w", err)
 	}
+	rollbackDiff := s.rollbackChangelog(ctx, currentRelease, targetRelease, targetSnapshot)
 
 	for _, item := range targetSnapshot {
 		config, err := s.configRepo.GetByID(ctx, item.ConfigID)
 		if err != nil {
-			hlog.Errorf("查询配置失败: configID=%d, error=%v", item.ConfigID, err)
+			hlog.CtxErrorf(ctx, "查询配置失败: configID=%d, error=%v", item.ConfigID, err)
 			continue
 		}
 		if config == nil {
-			hlog.Warnf("配置不存在，跳过回滚: configID=%d", item.ConfigID)
+			hlog.CtxWarnf(ctx, "配置不存在，跳过回滚: configID=%d", item.ConfigID)
 			continue
 		}
 
 		// 更新配置值
-		hash, _ := s.configSvc.ComputeContentHash(item.Value, item.ContentHashAlgorithm)
+		hash, _ := s.configSvc.ComputeContentHash(item.Value, s.configSvc.HashAlgorithm())
 		config.UpdateValue(item.Value, hash)
+		config.ContentHashAlgorithm = s.configSvc.HashAlgorithm()
 		if err := s.configRepo.Update(ctx, config); err != nil {
-			hlog.Errorf("更新配置失败: configID=%d, error=%v", item.ConfigID, err)
+			hlog.CtxErrorf(ctx, "更新配置失败: configID=%d, error=%v", item.ConfigID, err)
 			continue
 		}
 	}
 
-	// 6. 标记当前版本为已回滚
+	// 6. 标记当前版本为已回滚并发布配置变更事件
 	currentRelease.Rollback(req.RollbackBy, req.Reason)
 	currentRelease.RollbackFromVersion = targetRelease.Version
-	if err := s.releaseRepo.Update(ctx, currentRelease); err != nil {
-		return fmt.Errorf("更新当前版本状态失败: %w", err)
-	}
-
-	// 7. 发布配置变更事件
-	for _, item := range targetSnapshot {
-		s.publishConfigChangeEvent(ctx, &listener.ConfigChangeEvent{
-			NamespaceID: currentRelease.NamespaceID,
-			ConfigKey:   item.Key,
-			ConfigID:    item.ConfigID,
-			Action:      "rollback",
-		})
+	err = s.withEventTx(ctx, func(txCtx context.Context) error {
+		if err := s.releaseRepo.Update(txCtx, currentRelease); err != nil {
+			return fmt.Errorf("更新当前版本状态失败: %w", err)
+		}
+		for _, item := range targetSnapshot {
+			if err := s.publishConfigChangeEvent(txCtx, &listener.ConfigChangeEvent{
+				NamespaceID: currentRelease.NamespaceID,
+				ConfigKey:   item.Key,
+				ConfigID:    item.ConfigID,
+				Environment: currentRelease.Environment,
+				Action:      "rollback",
+				ReleaseID:   targetRelease.ID, // 回滚下发的是目标版本的内容
+			}); err != nil {
+				return err
+			}
+		}
+		return s.notifyReleaseWebhooks(txCtx, entity.WebhookEventReleaseRollback, currentRelease, req.RollbackBy, targetRelease)
+	})
+	if err != nil {
+		return err
 	}
 
-	hlog.Infof("回滚成功: 从版本%d回滚到版本%d, namespace=%d",
+	hlog.CtxInfof(ctx, "回滚成功: 从版本%d回滚到版本%d, namespace=%d",
 		currentRelease.Version, targetRelease.Version, currentRelease.NamespaceID)
+	rollbackEvent := entity.NotificationEventRollback
+	if currentRelease.ReleaseType == entity.ReleaseTypeCanary {
+		rollbackEvent = entity.NotificationEventCanaryFailed
+	}
+	s.notifyRelease(ctx, rollbackEvent, currentRelease, req.RollbackBy, targetRelease, rollbackDiff)
+	s.recordFreezeOverride(ctx, frozenWindow, currentRelease, entity.FreezeOperationRollback, req.RollbackBy, req.Override)
 
 	return nil
 }
@@ -347,6 +732,20 @@ func (s *ReleaseService) ListReleasesByNamespace(ctx context.Context, namespaceI
 	return s.releaseRepo.FindByNamespace(ctx, namespaceID, environment)
 }
 
+// GetChangelog 获取发布版本的变更日志（发布说明及相对基线版本的键级变更明细）
+func (s *ReleaseService) GetChangelog(ctx context.Context, id int) (*entity.Release, *entity.ReleaseChangelog, error) {
+	release, err := s.GetReleaseByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changelog, err := release.GetChangelog()
+	if err != nil {
+		return nil, nil, fmt.Errorf("解析变更明细失败: %w", err)
+	}
+	return release, changelog, nil
+}
+
 // QueryReleases 分页查询发布版本
 func (s *ReleaseService) QueryReleases(ctx context.Context, params *repository.ReleaseQueryParams) (*shareRepo.PageResult[*entity.Release], error) {
 	return s.releaseRepo.QueryByParams(ctx, params)
@@ -373,7 +772,7 @@ func (s *ReleaseService) ShouldUseCanaryRelease(ctx context.Context, namespaceID
 	// 3. 获取灰度规则
 	rule, err := release.GetCanaryRule()
 	if err != nil {
-		hlog.Errorf("获取灰度规则失败: %v", err)
+		hlog.CtxErrorf(ctx, "获取灰度规则失败: %v", err)
 		return release, false, nil
 	}
 
@@ -382,19 +781,186 @@ func (s *ReleaseService) ShouldUseCanaryRelease(ctx context.Context, namespaceID
 	return release, matched, nil
 }
 
+// GetCanaryConfigItem 获取客户端命中灰度发布时指定配置的快照
+// 最新发布版本不是灰度版本、客户端未命中灰度规则或快照中不包含该配置时返回 nil
+func (s *ReleaseService) GetCanaryConfigItem(ctx context.Context, namespaceID int, environment string, key string, clientID string, clientIP string) (*entity.ConfigSnapshotItem, *entity.Release, error) {
+	items, release, err := s.GetCanaryConfigItems(ctx, namespaceID, environment, []string{key}, clientID, clientIP)
+	if err != nil || items[key] == nil {
+		return nil, nil, err
+	}
+	return items[key], release, nil
+}
+
+// GetCanaryConfigItems 获取客户端命中灰度发布时一组配置的快照（灰度规则只判断一次）
+// 返回：配置键 -> 快照项，快照中不包含的配置键不在结果中；客户端未命中灰度规则时返回空结果和 nil 版本
+func (s *ReleaseService) GetCanaryConfigItems(ctx context.Context, namespaceID int, environment string, keys []string, clientID string, clientIP string) (map[string]*entity.ConfigSnapshotItem, *entity.Release, error) {
+	if clientID == "" && clientIP == "" {
+		return nil, nil, nil
+	}
+
+	// 1. 判断客户端是否命中灰度规则
+	release, matched, err := s.ShouldUseCanaryRelease(ctx, namespaceID, environment, clientID, clientIP)
+	if err != nil || !matched {
+		return nil, nil, err
+	}
+
+	// 2. 从灰度版本生效快照中查找配置
+	snapshot, err := s.ResolveSnapshot(ctx, release)
+	if err != nil {
+		return nil, nil, fmt.Errorf("获取配置快照失败: %w", err)
+	}
+	wanted := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		wanted[key] = true
+	}
+	items := make(map[string]*entity.ConfigSnapshotItem, len(keys))
+	for i := range snapshot {
+		if wanted[snapshot[i].Key] {
+			items[snapshot[i].Key] = &snapshot[i]
+		}
+	}
+	if len(items) == 0 {
+		return nil, nil, nil
+	}
+	return items, release, nil
+}
+
 // ==================== 辅助方法 ====================
 
+// withEventTx 在事务中执行版本状态更新及事件发布
+// 启用发件箱时事件与版本状态同事务保存，提交后唤醒投递任务；未启用时直接执行，事件异步发布
+func (s *ReleaseService) withEventTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.outbox == nil {
+		return fn(ctx)
+	}
+	if err := s.configRepo.WithTx(ctx, fn); err != nil {
+		return err
+	}
+	s.outbox.Wake()
+	return nil
+}
+
+// publishSnapshotEvents 为发布版本快照中的每个配置发布变更事件
+// 快照解析失败时仅记录日志，不影响版本发布
+func (s *ReleaseService) publishSnapshotEvents(ctx context.Context, release *entity.Release, action string) error {
+	snapshot, err := release.GetConfigSnapshot()
+	if err != nil {
+		hlog.CtxErrorf(ctx, "获取配置快照失败: %v", err)
+		return nil
+	}
+	for _, item := range snapshot {
+		if err := s.publishConfigChangeEvent(ctx, &listener.ConfigChangeEvent{
+			NamespaceID: release.NamespaceID,
+			ConfigKey:   item.Key,
+			ConfigID:    item.ConfigID,
+			Environment: release.Environment,
+			Action:      action,
+			ReleaseID:   release.ID,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // publishConfigChangeEvent 发布配置变更事件
-func (s *ReleaseService) publishConfigChangeEvent(ctx context.Context, event *listener.ConfigChangeEvent) {
-	if s.listener == nil {
-		return
+// 启用发件箱时写入发件箱（需在版本状态更新的事务上下文中调用），否则异步发布
+func (s *ReleaseService) publishConfigChangeEvent(ctx context.Context, event *listener.ConfigChangeEvent) error {
+	if s.listener == nil && s.outbox == nil {
+		return nil
+	}
+	event.TraceContext = tracing.Inject(ctx)
+
+	if s.outbox != nil {
+		return s.outbox.Enqueue(ctx, event)
 	}
 
 	go func() {
 		if err := s.listener.Publish(context.Background(), event); err != nil {
-			hlog.Errorf("发布配置变更事件失败: %v, event: %+v", err, event)
+			hlog.CtxErrorf(ctx, "发布配置变更事件失败: %v, event: %+v", err, event)
 		}
 	}()
+	return nil
+}
+
+// notifyReleaseWebhooks 为发布事件写入 Webhook 投递记录（需在版本状态更新的事务上下文中调用）
+// 回滚时 release 为被回滚的版本，target 为回滚到的目标版本
+func (s *ReleaseService) notifyReleaseWebhooks(ctx context.Context, eventType string, release *entity.Release, operator string, target *entity.Release) error {
+	if s.webhooks == nil {
+		return nil
+	}
+
+	data := &WebhookReleaseData{
+		ReleaseID:        release.ID,
+		Version:          release.Version,
+		VersionName:      release.VersionName,
+		ReleaseType:      string(release.ReleaseType),
+		Operator:         operator,
+		ReleaseNotes:     release.ReleaseNotes,
+		ConfigCount:      release.ConfigCount,
+		CanaryPercentage: release.CanaryPercentage,
+	}
+	if target != nil {
+		data.TargetReleaseID = target.ID
+		data.TargetVersion = target.Version
+		data.RollbackReason = release.RollbackReason
+	} else if changelog, err := release.GetChangelog(); err == nil {
+		data.Changelog = changelog
+	}
+
+	return s.webhooks.Notify(ctx, &WebhookEvent{
+		Type:        eventType,
+		NamespaceID: release.NamespaceID,
+		Environment: release.Environment,
+		Data:        data,
+	})
+}
+
+// notifyRelease 发送发布通知（需在版本状态提交后调用，异步发送）
+// 发布时变更摘要取版本的键级变更明细；回滚时 release 为被回滚的版本，target 为回滚到的目标版本
+func (s *ReleaseService) notifyRelease(ctx context.Context, event string, release *entity.Release, operator string, target *entity.Release, diff *entity.ReleaseChangelog) {
+	if s.notifications == nil {
+		return
+	}
+	if diff == nil && target == nil {
+		if changelog, err := release.GetChangelog(); err == nil {
+			diff = changelog
+		}
+	}
+	s.notifications.NotifyRelease(ctx, &ReleaseNotification{
+		Event:    event,
+		Release:  release,
+		Target:   target,
+		Operator: operator,
+		Diff:     diff,
+	})
+}
+
+// rollbackChangelog 计算回滚带来的键级变更（从被回滚版本到目标版本），用于通知的变更摘要
+// 未启用通知或快照解析失败时返回 nil
+func (s *ReleaseService) rollbackChangelog(ctx context.Context, current, target *entity.Release, targetSnapshot []entity.ConfigSnapshotItem) *entity.ReleaseChangelog {
+	if s.notifications == nil {
+		return nil
+	}
+	currentSnapshot, err := s.ResolveSnapshot(ctx, current)
+	if err != nil {
+		hlog.CtxWarnf(ctx, "获取被回滚版本快照失败，通知将不含变更摘要: releaseID=%d, err=%v", current.ID, err)
+		return nil
+	}
+
+	result := diffSnapshots(currentSnapshot, targetSnapshot,
+		fmt.Sprintf("release-v%d", current.Version), fmt.Sprintf("release-v%d", target.Version))
+	changelog := &entity.ReleaseChangelog{BaseReleaseID: current.ID, BaseVersion: current.Version}
+	for _, item := range result.Added {
+		changelog.Added = append(changelog.Added, item.Key)
+	}
+	for _, diff := range result.Modified {
+		changelog.Modified = append(changelog.Modified, diff.Key)
+	}
+	for _, item := range result.Deleted {
+		changelog.Deleted = append(changelog.Deleted, item.Key)
+	}
+	return changelog
 }
 
 // CompareReleases 对比两个版本的差异
@@ -416,11 +982,64 @@ func (s *ReleaseService) CompareReleases(ctx context.Context, fromReleaseID, toR
 		return nil, fmt.Errorf("目标版本不存在: id=%d", toReleaseID)
 	}
 
-	// 2. 获取配置快照
-	fromSnapshot, _ := fromRelease.GetConfigSnapshot()
-	toSnapshot, _ := toRelease.GetConfigSnapshot()
+	// 2. 获取生效配置快照（增量版本合并基线后对比）
+	fromSnapshot, err := s.ResolveSnapshot(ctx, fromRelease)
+	if err != nil {
+		return nil, err
+	}
+	toSnapshot, err := s.ResolveSnapshot(ctx, toRelease)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 对比差异
+	result := diffSnapshots(
+		fromSnapshot, toSnapshot,
+		fmt.Sprintf("release-v%d", fromRelease.Version),
+		fmt.Sprintf("release-v%d", toRelease.Version),
+	)
+	result.FromReleaseID = fromReleaseID
+	result.ToReleaseID = toReleaseID
+	result.FromVersion = fromRelease.Version
+	result.ToVersion = toRelease.Version
+	return result, nil
+}
+
+// CompareWithLive 对比发布版本与当前线上配置
+// 以数据库中当前已发布的配置为源、发布版本的生效快照为目标，结果即立即发布该版本将产生的变更
+// 业务规则：
+// 1. 增量版本按合并基线后的生效快照对比
+// 2. 结果标记 FromLive，源版本ID和版本号为 0
+func (s *ReleaseService) CompareWithLive(ctx context.Context, releaseID int) (*ReleaseCompareResult, error) {
+	// 1. 查询发布版本及其生效快照
+	release, err := s.GetReleaseByID(ctx, releaseID)
+	if err != nil {
+		return nil, err
+	}
+	toSnapshot, err := s.ResolveSnapshot(ctx, release)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 查询当前线上配置
+	configs, err := s.configRepo.FindReleasedConfigs(ctx, release.NamespaceID, release.Environment)
+	if err != nil {
+		return nil, fmt.Errorf("查询配置失败: %w", err)
+	}
+	liveSnapshot := buildConfigSnapshot(configs)
+
+	// 3. 对比差异
+	result := diffSnapshots(liveSnapshot, toSnapshot, "live", fmt.Sprintf("release-v%d", release.Version))
+	result.FromLive = true
+	result.ToReleaseID = release.ID
+	result.ToVersion = release.Version
+	return result, nil
+}
 
-	// 3. 构建配置映射
+// diffSnapshots 对比两个配置快照的差异
+// fromLabel/toLabel 用于生成差异文本中的来源标识
+func diffSnapshots(fromSnapshot, toSnapshot []entity.ConfigSnapshotItem, fromLabel, toLabel string) *ReleaseCompareResult {
+	// 1. 构建配置映射
 	fromMap := make(map[string]*entity.ConfigSnapshotItem)
 	for i := range fromSnapshot {
 		fromMap[fromSnapshot[i].Key] = &fromSnapshot[i]
@@ -431,13 +1050,7 @@ func (s *ReleaseService) CompareReleases(ctx context.Context, fromReleaseID, toR
 		toMap[toSnapshot[i].Key] = &toSnapshot[i]
 	}
 
-	// 4. 对比差异
-	result := &ReleaseCompareResult{
-		FromReleaseID: fromReleaseID,
-		ToReleaseID:   toReleaseID,
-		FromVersion:   fromRelease.Version,
-		ToVersion:     toRelease.Version,
-	}
+	result := &ReleaseCompareResult{}
 
 	// 新增的配置
 	for key, item := range toMap {
@@ -458,19 +1071,26 @@ func (s *ReleaseService) CompareReleases(ctx context.Context, fromReleaseID, toR
 		if toItem, exists := toMap[key]; exists {
 			if fromItem.Value != toItem.Value {
 				result.Modified = append(result.Modified, &ConfigDiff{
-					Key:      key,
-					OldValue: fromItem.Value,
-					NewValue: toItem.Value,
+					Key:       key,
+					ValueType: toItem.ValueType,
+					OldValue:  fromItem.Value,
+					NewValue:  toItem.Value,
+					Diff: DiffValues(
+						fmt.Sprintf("%s@%s", key, fromLabel),
+						fmt.Sprintf("%s@%s", key, toLabel),
+						fromItem.Value, toItem.Value, toItem.ValueType,
+					),
 				})
 			}
 		}
 	}
 
-	return result, nil
+	return result
 }
 
 // ReleaseCompareResult 版本对比结果
 type ReleaseCompareResult struct {
+	FromLive      bool // 源为当前线上配置（FromReleaseID 和 FromVersion 为 0）
 	FromReleaseID int
 	ToReleaseID   int
 	FromVersion   int
@@ -482,7 +1102,9 @@ type ReleaseCompareResult struct {
 
 // ConfigDiff 配置差异
 type ConfigDiff struct {
-	Key      string
-	OldValue string
-	NewValue string
+	Key       string
+	ValueType string
+	OldValue  string
+	NewValue  string
+	Diff      *ValueDiff // 值差异（加密配置为 nil）
 }
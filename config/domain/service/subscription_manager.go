@@ -2,15 +2,25 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"config-client/config/domain/constants"
 	"config-client/config/domain/entity"
+	domainErrors "config-client/config/domain/errors"
 	"config-client/config/domain/listener"
 	"config-client/config/domain/repository"
+	"config-client/share/replica"
+	"config-client/share/tracing"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // SubscribeRequest 订阅请求
@@ -22,6 +32,7 @@ type SubscribeRequest struct {
 	Environment    string            // 环境
 	ConfigKeys     []string          // 关注的配置键列表 (格式: "namespaceID:configKey")
 	Versions       map[string]string // 当前版本映射 (configKey -> MD5)
+	Prefixes       []string          // 按前缀关注的配置键前缀（命名空间内以前缀开头的配置，包括之后新建的配置）
 }
 
 // ChangeNotification 变更通知
@@ -30,6 +41,10 @@ type ChangeNotification struct {
 	ConfigKey   string    // 变更的配置键
 	NewVersion  string    // 新版本MD5
 	Timestamp   time.Time // 变更时间
+	TraceID     string    // 触发本次变更的请求链路ID（用于关联变更请求与长轮询响应）
+	Sequence    int64     // 触发本次通知的事件序号（为0表示非事件触发，如版本比较）
+	ReleaseID   int       // 触发本次通知的发布版本ID（为0表示非发布触发）
+	Source      string    // 通知来源（event/compare/replay）
 }
 
 // ActiveSubscriber 活跃订阅者 (内存中的长轮询连接)
@@ -42,6 +57,13 @@ type ActiveSubscriber struct {
 	NotifyChan      chan *ChangeNotification // 通知通道
 	RegisteredAt    time.Time                // 注册时间
 	SubscriptionID  int                      // 数据库订阅记录ID
+	Prefixes        []string                 // 按前缀关注的配置键前缀
+}
+
+// LeaderElector 主节点选举器（多实例部署时由 share/leader 提供实现）
+type LeaderElector interface {
+	// IsLeader 当前实例是否为主节点
+	IsLeader() bool
 }
 
 // SubscriptionManager 订阅管理器
@@ -57,6 +79,27 @@ type SubscriptionManager struct {
 	// 发布管理服务 (用于灰度发布判断)
 	releaseSvc *ReleaseService
 
+	// 主节点选举器 (为空时视为单实例部署，始终执行后台任务)
+	leaderElector LeaderElector
+
+	// 事件日志服务 (可选，用于断线补发和过期事件清理)
+	changeEventSvc *ChangeEventService
+
+	// 订阅配置键仓储 (可选，记录客户端上报的配置版本，用于查询变更下发状态)
+	subscriptionKeyRepo repository.SubscriptionKeyRepository
+
+	// 通知下发追踪服务 (可选，记录每次下发的变更通知及客户端确认情况)
+	pushTraceSvc *PushTraceService
+
+	// 命名空间配额服务 (可选，校验活跃订阅数量)
+	quotas *NamespaceQuotaService
+
+	// 配置读取缓存 (可选，比较版本时优先读取缓存，收到变更事件时失效)
+	configCache *ConfigReadCache
+
+	// 系统配置服务 (可选，心跳超时和清理间隔从系统配置读取，修改后无需重启)
+	systemConfigSvc *SystemConfigService
+
 	// 活跃订阅者 (内存)
 	// key: "namespaceID:environment:clientID"
 	activeSubscribers map[string]*ActiveSubscriber
@@ -67,13 +110,23 @@ type SubscriptionManager struct {
 	// value: 订阅该配置的 subscriber keys
 	configSubscribers map[string][]string
 
+	// 配置键前缀到订阅者的映射（按前缀订阅，变更的配置键以前缀开头时通知）
+	// key: namespaceID -> prefix
+	// value: 订阅该前缀的 subscriber keys
+	prefixSubscribers map[int]map[string][]string
+
 	// 上下文
 	ctx    context.Context
 	cancel context.CancelFunc
 
-	// 配置
+	// 是否正在处理配置变更事件（事件通道关闭后为 false，长轮询将收不到通知）
+	listening atomic.Bool
+
+	// 配置（未注入系统配置服务或系统配置无效时使用）
 	heartbeatTimeout time.Duration // 心跳超时时间
 	cleanInterval    time.Duration // 清理过期订阅的间隔
+
+	cleanIntervalChanged chan struct{} // 系统配置中的清理间隔变更时通知清理任务重新计时
 }
 
 // NewSubscriptionManager 创建订阅管理器
@@ -92,10 +145,13 @@ func NewSubscriptionManager(
 		releaseSvc:        nil, // 通过 SetReleaseService 延迟注入,避免循环依赖
 		activeSubscribers: make(map[string]*ActiveSubscriber),
 		configSubscribers: make(map[string][]string),
+		prefixSubscribers: make(map[int]map[string][]string),
 		ctx:               ctx,
 		cancel:            cancel,
 		heartbeatTimeout:  heartbeatTimeout,
 		cleanInterval:     5 * time.Minute, // 默认5分钟清理一次
+
+		cleanIntervalChanged: make(chan struct{}, 1),
 	}
 }
 
@@ -104,6 +160,68 @@ func (m *SubscriptionManager) SetReleaseService(releaseSvc *ReleaseService) {
 	m.releaseSvc = releaseSvc
 }
 
+// SetLeaderElector 设置主节点选举器（多实例部署时仅主节点执行过期订阅清理）
+func (m *SubscriptionManager) SetLeaderElector(elector LeaderElector) {
+	m.leaderElector = elector
+}
+
+// SetChangeEventService 设置事件日志服务（启用断线补发，过期事件随订阅清理任务一并清理）
+func (m *SubscriptionManager) SetChangeEventService(changeEventSvc *ChangeEventService) {
+	m.changeEventSvc = changeEventSvc
+}
+
+// SetSubscriptionKeyRepository 设置订阅配置键仓储（启用客户端上报版本的持久化和监听状态查询）
+func (m *SubscriptionManager) SetSubscriptionKeyRepository(subscriptionKeyRepo repository.SubscriptionKeyRepository) {
+	m.subscriptionKeyRepo = subscriptionKeyRepo
+}
+
+// SetPushTraceService 设置通知下发追踪服务（记录下发的变更通知，过期记录随订阅清理任务一并清理）
+func (m *SubscriptionManager) SetPushTraceService(pushTraceSvc *PushTraceService) {
+	m.pushTraceSvc = pushTraceSvc
+}
+
+// SetQuotaService 设置命名空间配额服务（新增或恢复活跃订阅前校验订阅数量配额）
+func (m *SubscriptionManager) SetQuotaService(quotas *NamespaceQuotaService) {
+	m.quotas = quotas
+}
+
+// SetConfigReadCache 设置配置读取缓存（版本比较优先读取缓存，收到配置变更事件时失效对应缓存）
+func (m *SubscriptionManager) SetConfigReadCache(configCache *ConfigReadCache) {
+	m.configCache = configCache
+}
+
+// SetSystemConfigService 设置系统配置服务（心跳超时和清理间隔从系统配置读取）
+// 心跳超时对下一次清理生效；清理间隔变更时清理任务立即按新间隔重新计时
+func (m *SubscriptionManager) SetSystemConfigService(systemConfigSvc *SystemConfigService) {
+	m.systemConfigSvc = systemConfigSvc
+	systemConfigSvc.Watch(ConfigKeySubscriptionCleanupInterval, func(string, *entity.SystemConfig) {
+		select {
+		case m.cleanIntervalChanged <- struct{}{}:
+		default:
+		}
+	})
+}
+
+// getHeartbeatTimeout 获取心跳超时时间（优先读取系统配置 heartbeat.timeout，<=0 时使用创建时的默认值）
+func (m *SubscriptionManager) getHeartbeatTimeout() time.Duration {
+	if m.systemConfigSvc != nil {
+		if timeout := m.systemConfigSvc.GetHeartbeatTimeoutDuration(); timeout > 0 {
+			return timeout
+		}
+	}
+	return m.heartbeatTimeout
+}
+
+// getCleanInterval 获取清理过期订阅的间隔（优先读取系统配置 subscription.cleanup.interval，<=0 时使用默认值）
+func (m *SubscriptionManager) getCleanInterval() time.Duration {
+	if m.systemConfigSvc != nil {
+		if interval := m.systemConfigSvc.GetSubscriptionCleanupIntervalDuration(); interval > 0 {
+			return interval
+		}
+	}
+	return m.cleanInterval
+}
+
 // Start 启动订阅管理器
 func (m *SubscriptionManager) Start() error {
 	// 订阅配置变更事件
@@ -139,17 +257,20 @@ func (m *SubscriptionManager) Subscribe(ctx context.Context, req *SubscribeReque
 
 	// 2. 增加轮询计数
 	if err := m.subscriptionRepo.IncrementPollCount(ctx, subscription.ID); err != nil {
-		hlog.Errorf("增加轮询计数失败: %v", err)
+		hlog.CtxErrorf(ctx, "增加轮询计数失败: %v", err)
 	}
 
 	// 3. 检查灰度发布,判断该客户端应该使用哪个版本的配置
 	versionToUse := m.determineVersionForClient(ctx, req)
 
-	// 4. 检查配置是否已有变更
+	// 4. 检查配置是否已有变更（包括前缀下新建的配置）
 	changed, changedKey, newVersion := m.checkVersionChanges(req.ConfigKeys, req.Versions, versionToUse, req.Environment)
+	if !changed && len(req.Prefixes) > 0 {
+		changed, changedKey, newVersion = m.checkPrefixChanges(req.NamespaceID, req.Prefixes, req.Versions, versionToUse, req.Environment)
+	}
 	if changed {
 		// 配置已变更，立即返回
-		hlog.Infof("配置已变更: %s, 立即返回", changedKey)
+		hlog.CtxInfof(ctx, "配置已变更: %s, 立即返回", changedKey)
 
 		// 创建一个带缓冲的通道，立即发送通知
 		notifyChan := make(chan *ChangeNotification, 1)
@@ -158,11 +279,12 @@ func (m *SubscriptionManager) Subscribe(ctx context.Context, req *SubscribeReque
 			ConfigKey:   changedKey,
 			NewVersion:  newVersion,
 			Timestamp:   time.Now(),
+			Source:      entity.PushSourceCompare,
 		}
 
 		// 增加变更计数
 		if err := m.subscriptionRepo.IncrementChangeCount(ctx, subscription.ID); err != nil {
-			hlog.Errorf("增加变更计数失败: %v", err)
+			hlog.CtxErrorf(ctx, "增加变更计数失败: %v", err)
 		}
 
 		return notifyChan, subscription.ID, nil
@@ -181,12 +303,13 @@ func (m *SubscriptionManager) Subscribe(ctx context.Context, req *SubscribeReque
 		NotifyChan:      notifyChan,
 		RegisteredAt:    time.Now(),
 		SubscriptionID:  subscription.ID,
+		Prefixes:        req.Prefixes,
 	}
 
 	m.registerActiveSubscriber(subscriberKey, subscriber)
 
-	hlog.Infof("注册活跃订阅者: clientID=%s, namespace=%d, env=%s, configKeys=%v",
-		req.ClientID, req.NamespaceID, req.Environment, req.ConfigKeys)
+	hlog.CtxInfof(ctx, "注册活跃订阅者: clientID=%s, namespace=%d, env=%s, configKeys=%v, prefixes=%v",
+		req.ClientID, req.NamespaceID, req.Environment, req.ConfigKeys, req.Prefixes)
 
 	return notifyChan, subscription.ID, nil
 }
@@ -208,7 +331,7 @@ func (m *SubscriptionManager) determineVersionForClient(ctx context.Context, req
 	)
 
 	if err != nil {
-		hlog.Errorf("判断灰度发布失败: %v", err)
+		hlog.CtxErrorf(ctx, "判断灰度发布失败: %v", err)
 		return nil
 	}
 
@@ -216,10 +339,10 @@ func (m *SubscriptionManager) determineVersionForClient(ctx context.Context, req
 		return nil
 	}
 
-	// 获取灰度版本的配置快照
-	snapshot, err := release.GetConfigSnapshot()
+	// 获取灰度版本的配置快照（增量版本合并基线后的生效配置）
+	snapshot, err := m.releaseSvc.ResolveSnapshot(ctx, release)
 	if err != nil {
-		hlog.Errorf("获取灰度版本快照失败: %v", err)
+		hlog.CtxErrorf(ctx, "获取灰度版本快照失败: %v", err)
 		return nil
 	}
 
@@ -230,7 +353,7 @@ func (m *SubscriptionManager) determineVersionForClient(ctx context.Context, req
 		canaryVersions[configKey] = ComputeVersion(item.Value)
 	}
 
-	hlog.Infof("客户端匹配灰度规则: clientID=%s, releaseID=%d, version=%d",
+	hlog.CtxInfof(ctx, "客户端匹配灰度规则: clientID=%s, releaseID=%d, version=%d",
 		req.ClientID, release.ID, release.Version)
 
 	return canaryVersions
@@ -244,23 +367,73 @@ func (m *SubscriptionManager) Unsubscribe(clientID string, namespaceID int, envi
 	return nil
 }
 
-// UpdateHeartbeat 更新心跳
-func (m *SubscriptionManager) UpdateHeartbeat(ctx context.Context, clientID string, namespaceID int, environment string) error {
-	// 获取订阅记录
+// Deactivate 客户端主动取消订阅，返回停用后的订阅记录
+// 业务规则：
+// 1. 订阅必须存在（客户端至少发起过一次长轮询）
+// 2. 立即停用订阅记录，不必等待心跳超时，订阅者清单保持准确；已停用的订阅重复取消时直接返回
+// 3. 移除当前实例上的活跃订阅，客户端重新轮询时订阅恢复激活
+func (m *SubscriptionManager) Deactivate(ctx context.Context, clientID string, namespaceID int, environment string) (*entity.Subscription, error) {
+	// 1. 获取订阅记录
 	subscription, err := m.subscriptionRepo.GetByClientAndNamespace(ctx, clientID, namespaceID, environment)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if subscription == nil {
-		return fmt.Errorf("订阅不存在")
+		return nil, domainErrors.ErrSubscriptionNotFound(clientID, namespaceID, environment)
+	}
+
+	// 2. 停用订阅记录
+	if subscription.IsActive {
+		if err := m.subscriptionRepo.Deactivate(ctx, subscription.ID); err != nil {
+			return nil, err
+		}
+		subscription.Deactivate()
+	}
+
+	// 3. 移除内存中的活跃订阅
+	m.unregisterActiveSubscriber(m.makeSubscriberKey(namespaceID, environment, clientID))
+	hlog.CtxInfof(ctx, "客户端取消订阅: clientID=%s, namespace=%d, env=%s", clientID, namespaceID, environment)
+
+	return subscription, nil
+}
+
+// UpdateHeartbeat 更新心跳，返回更新后的订阅记录
+// 业务规则：
+// 1. 订阅必须存在（客户端至少发起过一次长轮询）
+// 2. 已停用或因心跳超时被清理的订阅在收到心跳时恢复激活（与重新轮询的处理一致），恢复前校验订阅数量配额
+func (m *SubscriptionManager) UpdateHeartbeat(ctx context.Context, clientID string, namespaceID int, environment string) (*entity.Subscription, error) {
+	// 1. 获取订阅记录
+	subscription, err := m.subscriptionRepo.GetByClientAndNamespace(ctx, clientID, namespaceID, environment)
+	if err != nil {
+		return nil, err
+	}
+	if subscription == nil {
+		return nil, domainErrors.ErrSubscriptionNotFound(clientID, namespaceID, environment)
+	}
+
+	// 2. 更新心跳
+	if subscription.IsActive {
+		err = m.subscriptionRepo.UpdateHeartbeat(ctx, subscription.ID)
+	} else {
+		if err := m.checkSubscriberQuota(ctx, namespaceID); err != nil {
+			return nil, err
+		}
+		subscription.Activate()
+		subscription.UpdateHeartbeat()
+		err = m.subscriptionRepo.Update(ctx, subscription)
+	}
+	if err != nil {
+		return nil, domainErrors.ErrSubscriptionHeartbeatFailed(err)
 	}
 
-	// 更新心跳
-	return m.subscriptionRepo.UpdateHeartbeat(ctx, subscription.ID)
+	return m.subscriptionRepo.GetByID(ctx, subscription.ID)
 }
 
 // handleConfigChangeEvents 处理配置变更事件
 func (m *SubscriptionManager) handleConfigChangeEvents(eventChan <-chan *listener.ConfigChangeEvent) {
+	m.listening.Store(true)
+	defer m.listening.Store(false)
+
 	for {
 		select {
 		case <-m.ctx.Done():
@@ -278,71 +451,128 @@ func (m *SubscriptionManager) handleConfigChangeEvents(eventChan <-chan *listene
 func (m *SubscriptionManager) handleConfigChangeEvent(event *listener.ConfigChangeEvent) {
 	configKey := fmt.Sprintf("%d:%s", event.NamespaceID, event.ConfigKey)
 
-	hlog.Infof("收到配置变更事件: %s, action=%s", configKey, event.Action)
+	// 延续发布方链路，将通知下发挂在触发变更的请求之下
+	ctx, span := tracing.Start(tracing.Extract(context.Background(), event.TraceContext), "SubscriptionManager.NotifySubscribers",
+		attribute.String("config.key", configKey),
+		attribute.String("config.action", event.Action),
+	)
+	defer span.End()
+
+	hlog.CtxInfof(ctx, "收到配置变更事件: %s, env=%s, action=%s", configKey, event.Environment, event.Action)
+
+	// 先失效缓存，没有活跃订阅者时后续的版本比较也需要读取最新配置
+	if m.configCache != nil {
+		m.configCache.Invalidate(ctx, event)
+	}
 
 	// 获取订阅该配置的活跃订阅者
 	subscribers := m.findSubscribersByConfigKey(configKey)
+	span.SetAttributes(attribute.Int("subscription.count", len(subscribers)))
 	if len(subscribers) == 0 {
 		hlog.Infof("没有活跃订阅者关注配置: %s", configKey)
 		return
 	}
 
-	// 获取第一个订阅者的环境（同一配置的所有订阅者应该在相同环境）
-	environment := "default"
-	if len(subscribers) > 0 && subscribers[0].Environment != "" {
-		environment = subscribers[0].Environment
+	// 按环境分组：事件带环境时只通知该环境的订阅者
+	// 未带环境的事件（旧版本实例发布）按各订阅者所在环境分别比较版本，版本未变化的不通知
+	eventEnv := ""
+	if event.Environment != "" {
+		eventEnv = normalizeEnvironment(event.Environment)
+		span.SetAttributes(attribute.String("config.environment", eventEnv))
 	}
-
-	// 获取最新版本
-	newVersion, err := m.getConfigVersion(event.NamespaceID, event.ConfigKey, environment)
-	if err != nil {
-		hlog.Errorf("获取配置版本失败: %s, error: %v", configKey, err)
+	groups := make(map[string][]*ActiveSubscriber)
+	for _, subscriber := range subscribers {
+		environment := normalizeEnvironment(subscriber.Environment)
+		if eventEnv != "" && environment != eventEnv {
+			continue
+		}
+		groups[environment] = append(groups[environment], subscriber)
+	}
+	if len(groups) == 0 {
+		hlog.Infof("没有该环境的活跃订阅者关注配置: %s, env=%s", configKey, eventEnv)
 		return
 	}
 
-	// 通知所有订阅者
-	notification := &ChangeNotification{
-		NamespaceID: event.NamespaceID,
-		ConfigKey:   configKey,
-		NewVersion:  newVersion,
-		Timestamp:   time.Now(),
-	}
+	notified := 0
+	defer func() { span.SetAttributes(attribute.Int("subscription.notified", notified)) }()
+	for environment, group := range groups {
+		// 获取该环境的最新版本
+		newVersion, err := m.getConfigVersion(event.NamespaceID, event.ConfigKey, environment, false)
+		if err != nil {
+			hlog.Errorf("获取配置版本失败: %s, env=%s, error: %v", configKey, environment, err)
+			tracing.RecordError(span, err)
+			continue
+		}
 
-	for _, subscriber := range subscribers {
-		// 非阻塞发送通知
-		select {
-		case subscriber.NotifyChan <- notification:
-			hlog.Infof("通知订阅者: clientID=%s, configKey=%s, newVersion=%s",
-				subscriber.ClientID, configKey, newVersion)
+		notification := &ChangeNotification{
+			NamespaceID: event.NamespaceID,
+			ConfigKey:   configKey,
+			NewVersion:  newVersion,
+			Timestamp:   time.Now(),
+			TraceID:     tracing.TraceIDFromContext(ctx),
+			Sequence:    event.Sequence,
+			ReleaseID:   event.ReleaseID,
+			Source:      entity.PushSourceEvent,
+		}
 
-			// 增加变更计数
-			if err := m.subscriptionRepo.IncrementChangeCount(context.Background(), subscriber.SubscriptionID); err != nil {
-				hlog.Errorf("增加变更计数失败: %v", err)
+		for _, subscriber := range group {
+			if eventEnv == "" && subscriber.CurrentVersions[configKey] == newVersion {
+				continue
+			}
+
+			// 非阻塞发送通知
+			select {
+			case subscriber.NotifyChan <- notification:
+				notified++
+				hlog.Infof("通知订阅者: clientID=%s, configKey=%s, env=%s, newVersion=%s",
+					subscriber.ClientID, configKey, environment, newVersion)
+
+				// 增加变更计数
+				if err := m.subscriptionRepo.IncrementChangeCount(ctx, subscriber.SubscriptionID); err != nil {
+					hlog.Errorf("增加变更计数失败: %v", err)
+				}
+			default:
+				hlog.Warnf("订阅者通道已满，跳过通知: clientID=%s", subscriber.ClientID)
 			}
-		default:
-			hlog.Warnf("订阅者通道已满，跳过通知: clientID=%s", subscriber.ClientID)
 		}
 	}
 }
 
-// startCleanupTask 启动定期清理任务
+// normalizeEnvironment 规范化环境名称（为空时使用默认环境）
+func normalizeEnvironment(environment string) string {
+	if environment == "" {
+		return constants.EnvDefault
+	}
+	return environment
+}
+
+// startCleanupTask 启动定期清理任务（每次清理后重新读取间隔，系统配置中的间隔变更时重新计时）
 func (m *SubscriptionManager) startCleanupTask() {
-	ticker := time.NewTicker(m.cleanInterval)
-	defer ticker.Stop()
+	timer := time.NewTimer(m.getCleanInterval())
+	defer timer.Stop()
 
 	for {
 		select {
 		case <-m.ctx.Done():
 			return
-		case <-ticker.C:
+		case <-m.cleanIntervalChanged:
+			timer.Reset(m.getCleanInterval())
+		case <-timer.C:
+			timer.Reset(m.getCleanInterval())
+			// 多实例部署时仅主节点执行清理
+			if m.leaderElector != nil && !m.leaderElector.IsLeader() {
+				continue
+			}
 			m.cleanExpiredSubscriptions()
+			m.cleanExpiredChangeEvents()
+			m.cleanExpiredPushTraces()
 		}
 	}
 }
 
 // cleanExpiredSubscriptions 清理过期订阅
 func (m *SubscriptionManager) cleanExpiredSubscriptions() {
-	expireTime := time.Now().Add(-m.heartbeatTimeout)
+	expireTime := time.Now().Add(-m.getHeartbeatTimeout())
 	count, err := m.subscriptionRepo.CleanExpiredSubscriptions(context.Background(), expireTime)
 	if err != nil {
 		hlog.Errorf("清理过期订阅失败: %v", err)
@@ -354,6 +584,40 @@ func (m *SubscriptionManager) cleanExpiredSubscriptions() {
 	}
 }
 
+// cleanExpiredChangeEvents 清理超过保留时长的事件日志
+func (m *SubscriptionManager) cleanExpiredChangeEvents() {
+	if m.changeEventSvc == nil {
+		return
+	}
+
+	count, err := m.changeEventSvc.CleanExpired(context.Background())
+	if err != nil {
+		hlog.Errorf("清理过期事件日志失败: %v", err)
+		return
+	}
+
+	if count > 0 {
+		hlog.Infof("清理过期事件日志: 数量=%d", count)
+	}
+}
+
+// cleanExpiredPushTraces 清理超过保留时长的通知下发记录
+func (m *SubscriptionManager) cleanExpiredPushTraces() {
+	if m.pushTraceSvc == nil {
+		return
+	}
+
+	count, err := m.pushTraceSvc.CleanExpired(context.Background())
+	if err != nil {
+		hlog.Errorf("清理过期通知下发记录失败: %v", err)
+		return
+	}
+
+	if count > 0 {
+		hlog.Infof("清理过期通知下发记录: 数量=%d", count)
+	}
+}
+
 // getOrCreateSubscription 获取或创建订阅记录
 func (m *SubscriptionManager) getOrCreateSubscription(ctx context.Context, req *SubscribeRequest) (*entity.Subscription, error) {
 	// 查询是否已存在订阅
@@ -362,16 +626,40 @@ func (m *SubscriptionManager) getOrCreateSubscription(ctx context.Context, req *
 		return nil, err
 	}
 
+	// 客户端上报的版本快照哈希（未启用订阅配置键仓储和通知下发追踪时不记录）
+	var snapshotHash string
+	if m.subscriptionKeyRepo != nil || m.pushTraceSvc != nil {
+		snapshotHash = entity.ComputeVersionSnapshotHash(reportedVersions(req))
+	}
+
 	if subscription != nil {
-		// 已存在，更新心跳
+		// 已存在，更新心跳（被停用的订阅在客户端重新轮询时恢复激活）
+		if !subscription.IsActive {
+			if err := m.checkSubscriberQuota(ctx, req.NamespaceID); err != nil {
+				return nil, err
+			}
+			subscription.Activate()
+		}
 		subscription.UpdateHeartbeat()
+		keysChanged := snapshotHash != "" && subscription.ConfigSnapshotHash != snapshotHash
+		if keysChanged {
+			subscription.UpdateSnapshotHash(snapshotHash)
+		}
 		if err := m.subscriptionRepo.Update(ctx, subscription); err != nil {
-			hlog.Errorf("更新订阅心跳失败: %v", err)
+			hlog.CtxErrorf(ctx, "更新订阅心跳失败: %v", err)
+			return subscription, nil
+		}
+		if keysChanged {
+			m.saveSubscriptionKeys(ctx, subscription.ID, req)
+			m.ackPushTraces(ctx, subscription.ID, req)
 		}
 		return subscription, nil
 	}
 
 	// 不存在，创建新订阅
+	if err := m.checkSubscriberQuota(ctx, req.NamespaceID); err != nil {
+		return nil, err
+	}
 	now := time.Now()
 	subscription = &entity.Subscription{
 		NamespaceID:     req.NamespaceID,
@@ -388,45 +676,177 @@ func (m *SubscriptionManager) getOrCreateSubscription(ctx context.Context, req *
 		CreatedAt:       now,
 		UpdatedAt:       now,
 	}
+	subscription.UpdateSnapshotHash(snapshotHash)
 
 	if err := m.subscriptionRepo.Create(ctx, subscription); err != nil {
 		return nil, err
 	}
+	if snapshotHash != "" {
+		m.saveSubscriptionKeys(ctx, subscription.ID, req)
+	}
 
-	hlog.Infof("创建新订阅: clientID=%s, namespace=%d, env=%s", req.ClientID, req.NamespaceID, req.Environment)
+	hlog.CtxInfof(ctx, "创建新订阅: clientID=%s, namespace=%d, env=%s", req.ClientID, req.NamespaceID, req.Environment)
 	return subscription, nil
 }
 
+// checkSubscriberQuota 校验命名空间活跃订阅数量配额（未设置配额服务时不校验）
+func (m *SubscriptionManager) checkSubscriberQuota(ctx context.Context, namespaceID int) error {
+	if m.quotas == nil {
+		return nil
+	}
+	return m.quotas.CheckSubscriberCount(ctx, namespaceID)
+}
+
+// reportedVersions 整理客户端上报的版本（以关注的配置键为准，未持有的配置版本为空）
+func reportedVersions(req *SubscribeRequest) map[string]string {
+	versions := make(map[string]string, len(req.ConfigKeys))
+	for _, configKey := range req.ConfigKeys {
+		versions[configKey] = req.Versions[configKey]
+	}
+	return versions
+}
+
+// saveSubscriptionKeys 保存客户端上报的配置版本（失败仅记录日志，不影响长轮询）
+func (m *SubscriptionManager) saveSubscriptionKeys(ctx context.Context, subscriptionID int, req *SubscribeRequest) {
+	if m.subscriptionKeyRepo == nil {
+		return
+	}
+
+	now := time.Now()
+	keys := make([]*entity.SubscriptionKey, 0, len(req.ConfigKeys))
+	seen := make(map[string]bool, len(req.ConfigKeys))
+	for _, configKey := range req.ConfigKeys {
+		namespaceID, key, ok := parseConfigKey(configKey)
+		if !ok || seen[configKey] {
+			continue
+		}
+		seen[configKey] = true
+		keys = append(keys, &entity.SubscriptionKey{
+			SubscriptionID: subscriptionID,
+			NamespaceID:    namespaceID,
+			ConfigKey:      key,
+			Version:        req.Versions[configKey],
+			ReportedAt:     now,
+		})
+	}
+
+	if err := m.subscriptionKeyRepo.ReplaceBySubscriptionID(ctx, subscriptionID, keys); err != nil {
+		hlog.CtxErrorf(ctx, "保存订阅配置键失败: subscriptionID=%d, error=%v", subscriptionID, err)
+	}
+}
+
+// ackPushTraces 客户端上报的版本与已下发的通知一致时确认下发记录（失败仅记录日志，不影响长轮询）
+func (m *SubscriptionManager) ackPushTraces(ctx context.Context, subscriptionID int, req *SubscribeRequest) {
+	if m.pushTraceSvc == nil {
+		return
+	}
+
+	acked, err := m.pushTraceSvc.AckReported(ctx, subscriptionID, reportedVersions(req))
+	if err != nil {
+		hlog.CtxErrorf(ctx, "确认通知下发记录失败: subscriptionID=%d, error=%v", subscriptionID, err)
+		return
+	}
+	if acked > 0 {
+		hlog.CtxInfof(ctx, "客户端已确认变更通知: clientID=%s, subscriptionID=%d, 数量=%d", req.ClientID, subscriptionID, acked)
+	}
+}
+
+// RecordPush 记录返回给客户端的变更通知（未启用通知下发追踪时忽略，失败仅记录日志）
+// versions: 通知的配置键（格式 "namespaceID:configKey"）到新版本的映射
+func (m *SubscriptionManager) RecordPush(ctx context.Context, subscriptionID int, clientID string, environment string, versions map[string]string, notification *ChangeNotification) {
+	if m.pushTraceSvc == nil || subscriptionID == 0 || notification == nil || len(versions) == 0 {
+		return
+	}
+
+	now := time.Now()
+	traces := make([]*entity.PushTrace, 0, len(versions))
+	for configKey, version := range versions {
+		namespaceID, key, ok := parseConfigKey(configKey)
+		if !ok {
+			continue
+		}
+		traces = append(traces, &entity.PushTrace{
+			SubscriptionID: subscriptionID,
+			ClientID:       clientID,
+			NamespaceID:    namespaceID,
+			Environment:    environment,
+			ConfigKey:      key,
+			Version:        version,
+			ReleaseID:      notification.ReleaseID,
+			Sequence:       notification.Sequence,
+			TraceID:        notification.TraceID,
+			Source:         notification.Source,
+			PushedAt:       now,
+		})
+	}
+
+	if err := m.pushTraceSvc.Record(ctx, traces); err != nil {
+		hlog.CtxErrorf(ctx, "%v: clientID=%s, subscriptionID=%d", err, clientID, subscriptionID)
+	}
+}
+
+// parseConfigKey 解析 "namespaceID:configKey" 格式的配置键
+func parseConfigKey(configKey string) (int, string, bool) {
+	nsPart, key, found := strings.Cut(configKey, ":")
+	if !found || key == "" {
+		return 0, "", false
+	}
+	namespaceID, err := strconv.Atoi(nsPart)
+	if err != nil {
+		return 0, "", false
+	}
+	return namespaceID, key, true
+}
+
 // checkVersionChanges 检查版本是否有变更
+// 有灰度版本的配置与灰度版本比较，其余配置的服务端版本通过一次批量查询获取
 // 返回: 是否有变更, 变更的配置键, 新版本
 func (m *SubscriptionManager) checkVersionChanges(configKeys []string, clientVersions map[string]string, canaryVersions map[string]string, environment string) (bool, string, string) {
+	// 1. 解析需要查询服务端版本的配置键
+	lookups := make(map[string]repository.NamespaceKeyEnv, len(configKeys))
+	keys := make([]repository.NamespaceKeyEnv, 0, len(configKeys))
+	for _, configKey := range configKeys {
+		if _, exists := canaryVersions[configKey]; exists {
+			continue
+		}
+		namespaceID, key, ok := parseConfigKey(configKey)
+		if !ok {
+			hlog.Errorf("解析配置键失败: %s", configKey)
+			continue
+		}
+		lookup := repository.NamespaceKeyEnv{NamespaceID: namespaceID, Key: key, Environment: normalizeEnvironment(environment)}
+		if _, exists := lookups[configKey]; !exists {
+			lookups[configKey] = lookup
+			keys = append(keys, lookup)
+		}
+	}
+
+	// 2. 批量查询服务端版本
+	serverVersions, err := m.getConfigVersions(keys, true)
+	if err != nil {
+		hlog.Errorf("批量获取配置版本失败: keys=%d, error: %v", len(keys), err)
+		serverVersions = map[repository.NamespaceKeyEnv]string{}
+	}
+
+	// 3. 按客户端关注顺序比较版本（不存在的配置不视为变更）
 	for _, configKey := range configKeys {
 		clientVersion := clientVersions[configKey]
 
 		// 如果有灰度版本,优先使用灰度版本进行比较
-		if canaryVersions != nil {
-			if canaryVersion, exists := canaryVersions[configKey]; exists {
-				if clientVersion != canaryVersion {
-					hlog.Infof("[版本比较] 灰度版本不同: configKey=%s, clientVersion=%s, canaryVersion=%s", configKey, clientVersion, canaryVersion)
-					return true, configKey, canaryVersion
-				}
-				continue
+		if canaryVersion, exists := canaryVersions[configKey]; exists {
+			if clientVersion != canaryVersion {
+				hlog.Infof("[版本比较] 灰度版本不同: configKey=%s, clientVersion=%s, canaryVersion=%s", configKey, clientVersion, canaryVersion)
+				return true, configKey, canaryVersion
 			}
+			continue
 		}
 
-		// 解析 configKey
-		var namespaceID int
-		var key string
-		_, err := fmt.Sscanf(configKey, "%d:%s", &namespaceID, &key)
-		if err != nil {
-			hlog.Errorf("解析配置键失败: %s, error: %v", configKey, err)
+		lookup, parsed := lookups[configKey]
+		if !parsed {
 			continue
 		}
-
-		// 获取服务端版本
-		serverVersion, err := m.getConfigVersion(namespaceID, key, environment)
-		if err != nil {
-			hlog.Errorf("获取配置版本失败: %s, error: %v", configKey, err)
+		serverVersion, found := serverVersions[lookup]
+		if !found {
 			continue
 		}
 
@@ -441,8 +861,69 @@ func (m *SubscriptionManager) checkVersionChanges(configKeys []string, clientVer
 	return false, "", ""
 }
 
+// checkPrefixChanges 检查前缀下的配置是否有变更
+// 服务端存在、但客户端未上报版本的配置（前缀下新建的配置）视为变更；前缀下已删除的配置与按键订阅一致，不视为变更
+// 返回: 是否有变更, 变更的配置键, 新版本
+func (m *SubscriptionManager) checkPrefixChanges(namespaceID int, prefixes []string, clientVersions map[string]string, canaryVersions map[string]string, environment string) (bool, string, string) {
+	for _, prefix := range prefixes {
+		serverVersions, err := m.getPrefixVersions(namespaceID, prefix, environment)
+		if err != nil {
+			hlog.Errorf("获取前缀下的配置版本失败: namespace=%d, prefix=%s, error: %v", namespaceID, prefix, err)
+			continue
+		}
+
+		// 按配置键排序，保证多个配置同时变更时每次返回的配置一致
+		keys := make([]string, 0, len(serverVersions))
+		for key := range serverVersions {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			configKey := fmt.Sprintf("%d:%s", namespaceID, key)
+			serverVersion := serverVersions[key]
+			if canaryVersion, exists := canaryVersions[configKey]; exists {
+				serverVersion = canaryVersion
+			}
+			if clientVersions[configKey] != serverVersion {
+				hlog.Infof("[版本比较] 前缀下的配置已变更: prefix=%s, configKey=%s, clientVersion=%s, serverVersion=%s",
+					prefix, configKey, clientVersions[configKey], serverVersion)
+				return true, configKey, serverVersion
+			}
+		}
+	}
+	return false, "", ""
+}
+
+// getPrefixVersions 获取前缀下所有配置的版本（允许读取只读副本），返回配置键 -> 版本
+func (m *SubscriptionManager) getPrefixVersions(namespaceID int, prefix string, environment string) (map[string]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return m.configRepo.FindVersionsByPrefix(replica.WithReadReplica(ctx), namespaceID, prefix, normalizeEnvironment(environment))
+}
+
+// getConfigVersions 批量获取配置版本（一次查询），不存在的配置不在结果中
+// fromReplica 的含义与 getConfigVersion 相同
+func (m *SubscriptionManager) getConfigVersions(keys []repository.NamespaceKeyEnv, fromReplica bool) (map[repository.NamespaceKeyEnv]string, error) {
+	if len(keys) == 0 {
+		return map[repository.NamespaceKeyEnv]string{}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if m.configCache != nil {
+		return m.configCache.LoadVersions(ctx, keys)
+	}
+	if fromReplica {
+		ctx = replica.WithReadReplica(ctx)
+	}
+	return m.configRepo.FindVersionsByKeys(ctx, keys)
+}
+
 // getConfigVersion 获取配置版本
-func (m *SubscriptionManager) getConfigVersion(namespaceID int, configKey string, environment string) (string, error) {
+// fromReplica 为 true 时允许读取只读副本（长轮询版本比较），处理变更事件时读取主库，避免复制延迟导致下发旧版本；
+// 启用配置读取缓存时未命中的查询始终读取主库，避免旧数据在缓存中保留到过期
+func (m *SubscriptionManager) getConfigVersion(namespaceID int, configKey string, environment string, fromReplica bool) (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -451,6 +932,20 @@ func (m *SubscriptionManager) getConfigVersion(namespaceID int, configKey string
 		environment = "default"
 	}
 
+	if m.configCache != nil {
+		cached, err := m.configCache.Load(ctx, namespaceID, configKey, environment)
+		if err != nil {
+			return "", err
+		}
+		if cached == nil {
+			return "", fmt.Errorf("配置不存在: %s (environment=%s)", configKey, environment)
+		}
+		return cached.Version, nil
+	}
+
+	if fromReplica {
+		ctx = replica.WithReadReplica(ctx)
+	}
 	config, err := m.configRepo.FindByNamespaceAndKey(ctx, namespaceID, configKey, environment)
 	if err != nil {
 		return "", err
@@ -474,6 +969,18 @@ func (m *SubscriptionManager) registerActiveSubscriber(subscriberKey string, sub
 	for _, configKey := range subscriber.ConfigKeys {
 		m.configSubscribers[configKey] = append(m.configSubscribers[configKey], subscriberKey)
 	}
+
+	// 建立前缀到订阅者的映射
+	if len(subscriber.Prefixes) > 0 {
+		prefixes, exists := m.prefixSubscribers[subscriber.NamespaceID]
+		if !exists {
+			prefixes = make(map[string][]string)
+			m.prefixSubscribers[subscriber.NamespaceID] = prefixes
+		}
+		for _, prefix := range subscriber.Prefixes {
+			prefixes[prefix] = append(prefixes[prefix], subscriberKey)
+		}
+	}
 }
 
 // unregisterActiveSubscriber 注销活跃订阅者
@@ -504,22 +1011,46 @@ func (m *SubscriptionManager) unregisterActiveSubscriber(subscriberKey string) {
 		}
 	}
 
+	// 移除前缀映射
+	prefixes := m.prefixSubscribers[subscriber.NamespaceID]
+	for _, prefix := range subscriber.Prefixes {
+		prefixes[prefix] = removeSubscriberKey(prefixes[prefix], subscriberKey)
+		if len(prefixes[prefix]) == 0 {
+			delete(prefixes, prefix)
+		}
+	}
+	if len(subscriber.Prefixes) > 0 && len(prefixes) == 0 {
+		delete(m.prefixSubscribers, subscriber.NamespaceID)
+	}
+
 	// 移除订阅者
 	delete(m.activeSubscribers, subscriberKey)
 }
 
-// findSubscribersByConfigKey 根据配置键查找订阅者
+// findSubscribersByConfigKey 根据配置键查找订阅者（包括订阅了该配置键所在前缀的订阅者，同一订阅者只返回一次）
 func (m *SubscriptionManager) findSubscribersByConfigKey(configKey string) []*ActiveSubscriber {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	subscriberKeys := m.configSubscribers[configKey]
+	subscriberKeys := append([]string(nil), m.configSubscribers[configKey]...)
+	if namespaceID, key, ok := parseConfigKey(configKey); ok {
+		for prefix, keys := range m.prefixSubscribers[namespaceID] {
+			if strings.HasPrefix(key, prefix) {
+				subscriberKeys = append(subscriberKeys, keys...)
+			}
+		}
+	}
 	if len(subscriberKeys) == 0 {
 		return nil
 	}
 
 	subscribers := make([]*ActiveSubscriber, 0, len(subscriberKeys))
+	seen := make(map[string]bool, len(subscriberKeys))
 	for _, key := range subscriberKeys {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
 		if subscriber, exists := m.activeSubscribers[key]; exists {
 			subscribers = append(subscribers, subscriber)
 		}
@@ -528,6 +1059,16 @@ func (m *SubscriptionManager) findSubscribersByConfigKey(configKey string) []*Ac
 	return subscribers
 }
 
+// removeSubscriberKey 从订阅者列表中移除指定订阅者
+func removeSubscriberKey(subscriberKeys []string, subscriberKey string) []string {
+	for i, key := range subscriberKeys {
+		if key == subscriberKey {
+			return append(subscriberKeys[:i], subscriberKeys[i+1:]...)
+		}
+	}
+	return subscriberKeys
+}
+
 // makeSubscriberKey 生成订阅者唯一键
 func (m *SubscriptionManager) makeSubscriberKey(namespaceID int, environment string, clientID string) string {
 	return fmt.Sprintf("%d:%s:%s", namespaceID, environment, clientID)
@@ -546,3 +1087,216 @@ func (m *SubscriptionManager) GetActiveSubscriberCount() int {
 	defer m.mu.RUnlock()
 	return len(m.activeSubscribers)
 }
+
+// ActiveSubscriberInfo 活跃订阅者快照（不含通知通道，用于订阅管理接口查看当前实例的内存状态）
+type ActiveSubscriberInfo struct {
+	ClientID        string            // 客户端ID
+	NamespaceID     int               // 命名空间ID
+	Environment     string            // 环境
+	ConfigKeys      []string          // 关注的配置键列表 (格式: "namespaceID:configKey")
+	CurrentVersions map[string]string // 客户端当前版本
+	RegisteredAt    time.Time         // 注册时间
+	SubscriptionID  int               // 数据库订阅记录ID
+	Prefixes        []string          // 按前缀关注的配置键前缀
+}
+
+// GetActiveSubscriber 获取客户端在指定命名空间和环境下的活跃订阅者，不存在时返回 nil
+// 活跃订阅者只存在于处理长轮询请求的实例内存中，多实例部署时其他实例上的连接不可见
+func (m *SubscriptionManager) GetActiveSubscriber(clientID string, namespaceID int, environment string) *ActiveSubscriberInfo {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	subscriber, exists := m.activeSubscribers[m.makeSubscriberKey(namespaceID, environment, clientID)]
+	if !exists {
+		return nil
+	}
+	return subscriber.snapshot()
+}
+
+// snapshot 复制活跃订阅者的状态（调用方需持有读锁）
+func (s *ActiveSubscriber) snapshot() *ActiveSubscriberInfo {
+	versions := make(map[string]string, len(s.CurrentVersions))
+	for key, version := range s.CurrentVersions {
+		versions[key] = version
+	}
+	return &ActiveSubscriberInfo{
+		ClientID:        s.ClientID,
+		NamespaceID:     s.NamespaceID,
+		Environment:     s.Environment,
+		ConfigKeys:      append([]string(nil), s.ConfigKeys...),
+		CurrentVersions: versions,
+		RegisteredAt:    s.RegisteredAt,
+		SubscriptionID:  s.SubscriptionID,
+		Prefixes:        append([]string(nil), s.Prefixes...),
+	}
+}
+
+// 监听状态
+const (
+	ListenerStatusSynced   = "synced"   // 客户端版本与应下发版本一致
+	ListenerStatusOutdated = "outdated" // 客户端版本落后于应下发版本
+)
+
+// ConfigListenerInfo 监听配置键的客户端及其上报版本
+type ConfigListenerInfo struct {
+	Subscription     *entity.Subscription // 订阅记录
+	ReportedVersion  string               // 客户端上报的版本
+	ExpectedVersion  string               // 客户端应持有的版本（命中灰度规则时为灰度版本）
+	Canary           bool                 // 是否命中灰度发布
+	Status           string               // 监听状态（synced/outdated）
+	HeartbeatExpired bool                 // 心跳是否已超时（客户端可能已下线）
+	ReportedAt       time.Time            // 版本上报时间
+}
+
+// ListenerStatus 配置键的监听状态
+type ListenerStatus struct {
+	NamespaceID   int                   // 命名空间ID
+	ConfigKey     string                // 配置键
+	Environment   string                // 环境
+	ServerVersion string                // 服务端当前版本（配置不存在时为空）
+	Listeners     []*ConfigListenerInfo // 监听该配置键的客户端
+	SyncedCount   int                   // 已同步的客户端数量
+	OutdatedCount int                   // 未同步的客户端数量
+	ExpiredCount  int                   // 心跳已超时的客户端数量
+}
+
+// GetListenerStatus 查询监听指定配置键的客户端及其版本是否与服务端一致
+// 业务规则：
+// 1. 客户端版本以最近一次长轮询上报的版本为准，需启用订阅配置键仓储
+// 2. 只统计激活状态且环境匹配的订阅
+// 3. 客户端命中灰度发布时，以灰度版本作为应持有的版本
+func (m *SubscriptionManager) GetListenerStatus(ctx context.Context, namespaceID int, configKey string, environment string) (*ListenerStatus, error) {
+	if m.subscriptionKeyRepo == nil {
+		return nil, errors.New("未启用订阅配置键仓储")
+	}
+	if environment == "" {
+		environment = "default"
+	}
+
+	// 1. 查询服务端当前版本
+	config, err := m.configRepo.FindByNamespaceAndKey(ctx, namespaceID, configKey, environment)
+	if err != nil {
+		return nil, err
+	}
+	var serverVersion string
+	if config != nil {
+		serverVersion = ComputeVersion(config.Value)
+	}
+
+	status := &ListenerStatus{
+		NamespaceID:   namespaceID,
+		ConfigKey:     configKey,
+		Environment:   environment,
+		ServerVersion: serverVersion,
+		Listeners:     []*ConfigListenerInfo{},
+	}
+
+	// 2. 查询上报了该配置键的订阅
+	keys, err := m.subscriptionKeyRepo.FindByConfigKey(ctx, namespaceID, configKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return status, nil
+	}
+
+	ids := make([]int, 0, len(keys))
+	for _, key := range keys {
+		ids = append(ids, key.SubscriptionID)
+	}
+	subscriptions, err := m.subscriptionRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	subscriptionMap := make(map[int]*entity.Subscription, len(subscriptions))
+	for _, subscription := range subscriptions {
+		subscriptionMap[subscription.ID] = subscription
+	}
+
+	// 3. 逐个比较客户端版本与应下发版本
+	fullKey := fmt.Sprintf("%d:%s", namespaceID, configKey)
+	for _, key := range keys {
+		subscription, exists := subscriptionMap[key.SubscriptionID]
+		if !exists || !subscription.IsActive || subscription.Environment != environment {
+			continue
+		}
+
+		info := &ConfigListenerInfo{
+			Subscription:     subscription,
+			ReportedVersion:  key.Version,
+			ExpectedVersion:  serverVersion,
+			HeartbeatExpired: subscription.IsExpired(m.getHeartbeatTimeout()),
+			ReportedAt:       key.ReportedAt,
+		}
+
+		canaryVersions := m.determineVersionForClient(ctx, &SubscribeRequest{
+			ClientID:    subscription.ClientID,
+			ClientIP:    subscription.ClientIP,
+			NamespaceID: subscription.NamespaceID,
+			Environment: subscription.Environment,
+		})
+		if canaryVersion, ok := canaryVersions[fullKey]; ok {
+			info.ExpectedVersion = canaryVersion
+			info.Canary = true
+		}
+
+		if info.ReportedVersion == info.ExpectedVersion {
+			info.Status = ListenerStatusSynced
+			status.SyncedCount++
+		} else {
+			info.Status = ListenerStatusOutdated
+			status.OutdatedCount++
+		}
+		if info.HeartbeatExpired {
+			status.ExpiredCount++
+		}
+		status.Listeners = append(status.Listeners, info)
+	}
+
+	return status, nil
+}
+
+// CheckListenerHealth 检查配置变更事件的订阅状态
+// 事件处理已停止或监听器订阅连接不可用时返回错误
+func (m *SubscriptionManager) CheckListenerHealth(ctx context.Context) error {
+	if !m.listening.Load() {
+		return errors.New("配置变更事件处理未运行")
+	}
+	if checker, ok := m.listener.(listener.HealthChecker); ok {
+		return checker.CheckHealth(ctx)
+	}
+	return nil
+}
+
+// SubscriptionStats 订阅管理器运行时统计（用于排查长轮询连接泄漏）
+type SubscriptionStats struct {
+	ActiveSubscribers    int // 活跃订阅者数量（activeSubscribers 大小）
+	WatchedConfigKeys    int // 被关注的配置键数量（configSubscribers 大小）
+	WatchedPrefixes      int // 被关注的配置键前缀数量（各命名空间 prefixSubscribers 大小之和）
+	SubscriberRefs       int // 配置键到订阅者的引用总数
+	PendingNotifications int // 已投递但尚未被消费的通知数量
+}
+
+// GetStats 获取订阅管理器运行时统计
+func (m *SubscriptionManager) GetStats() *SubscriptionStats {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	stats := &SubscriptionStats{
+		ActiveSubscribers: len(m.activeSubscribers),
+		WatchedConfigKeys: len(m.configSubscribers),
+	}
+	for _, keys := range m.configSubscribers {
+		stats.SubscriberRefs += len(keys)
+	}
+	for _, prefixes := range m.prefixSubscribers {
+		stats.WatchedPrefixes += len(prefixes)
+		for _, keys := range prefixes {
+			stats.SubscriberRefs += len(keys)
+		}
+	}
+	for _, subscriber := range m.activeSubscribers {
+		stats.PendingNotifications += len(subscriber.NotifyChan)
+	}
+	return stats
+}
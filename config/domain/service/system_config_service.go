@@ -2,10 +2,14 @@ package service
 
 import (
 	"context"
+	"regexp"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"config-client/config/domain/entity"
+	domainErrors "config-client/config/domain/errors"
 	"config-client/config/domain/repository"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
@@ -14,8 +18,11 @@ import (
 // 预定义的系统配置键常量
 const (
 	// 长轮询相关配置
-	ConfigKeyLongPollingTimeout = "long.polling.timeout"  // 长轮询超时时间（秒）
-	ConfigKeyLongPollingMaxWait = "long.polling.max.wait" // 长轮询最大等待时间（秒）
+	ConfigKeyLongPollingTimeout    = "long.polling.timeout"     // 长轮询超时时间（秒）
+	ConfigKeyLongPollingMaxWait    = "long.polling.max.wait"    // 长轮询最大等待时间（秒）
+	ConfigKeyLongPollingMaxWaiters = "long.polling.max.waiters" // 单实例最大并发长轮询数（<=0 表示不限制）
+	ConfigKeyLongPollingRetryAfter = "long.polling.retry.after" // 超出并发上限时建议客户端重试的等待时间（秒）
+	ConfigKeyLongPollingPollDelay  = "long.polling.poll.delay"  // 建议客户端两次长轮询之间的间隔（毫秒，<=0 时由客户端决定）
 
 	// 订阅相关配置
 	ConfigKeyMaxSubscriptions = "max.subscriptions" // 最大订阅数
@@ -24,31 +31,85 @@ const (
 	ConfigKeyHeartbeatInterval = "heartbeat.interval" // 心跳间隔（秒）
 	ConfigKeyHeartbeatTimeout  = "heartbeat.timeout"  // 心跳超时（秒）
 
+	// 清理任务相关配置
+	ConfigKeySubscriptionCleanupInterval = "subscription.cleanup.interval" // 清理过期订阅、事件日志和下发记录的间隔（秒）
+
+	// 脱敏相关配置
+	ConfigKeyMaskingSensitiveKeywords = "masking.sensitive.keywords" // 额外的敏感配置键关键字（逗号分隔，与内置关键字一起生效）
+
+	// 系统配置自身
+	ConfigKeySystemConfigRefreshInterval = "system.config.refresh.interval" // 从数据库重新加载系统配置的间隔（秒，<=0 时不定期加载）
+
 	// 默认值
-	DefaultLongPollingTimeout = 30    // 默认长轮询超时 30 秒
-	DefaultLongPollingMaxWait = 60    // 默认长轮询最大等待 60 秒
-	DefaultMaxSubscriptions   = 10000 // 默认最大订阅数 10000
-	DefaultHeartbeatInterval  = 60    // 默认心跳间隔 60 秒
-	DefaultHeartbeatTimeout   = 300   // 默认心跳超时 300 秒
+	DefaultLongPollingTimeout    = 30    // 默认长轮询超时 30 秒
+	DefaultLongPollingMaxWait    = 60    // 默认长轮询最大等待 60 秒
+	DefaultLongPollingMaxWaiters = 10000 // 默认单实例最大并发长轮询 10000 个
+	DefaultLongPollingRetryAfter = 5     // 默认建议 5 秒后重试
+	DefaultLongPollingPollDelay  = 0     // 默认不建议长轮询间隔
+	DefaultMaxSubscriptions      = 10000 // 默认最大订阅数 10000
+	DefaultHeartbeatInterval     = 60    // 默认心跳间隔 60 秒
+	DefaultHeartbeatTimeout      = 300   // 默认心跳超时 300 秒
+
+	DefaultSubscriptionCleanupInterval = 300 // 默认 300 秒清理一次
+
+	DefaultSystemConfigRefreshInterval = 30 // 默认 30 秒重新加载一次系统配置
 )
 
+// systemConfigKeyPattern 系统配置键格式：小写字母、数字，以 . _ - 分段
+var systemConfigKeyPattern = regexp.MustCompile(`^[a-z0-9]+([._-][a-z0-9]+)*$`)
+
+// integerSystemConfigKeys 预定义的整数类型配置键（值无法解析时读取方会静默使用默认值，因此写入时校验）
+var integerSystemConfigKeys = map[string]struct{}{
+	ConfigKeyLongPollingTimeout:          {},
+	ConfigKeyLongPollingMaxWait:          {},
+	ConfigKeyLongPollingMaxWaiters:       {},
+	ConfigKeyLongPollingRetryAfter:       {},
+	ConfigKeyLongPollingPollDelay:        {},
+	ConfigKeyMaxSubscriptions:            {},
+	ConfigKeyHeartbeatInterval:           {},
+	ConfigKeyHeartbeatTimeout:            {},
+	ConfigKeySubscriptionCleanupInterval: {},
+	ConfigKeySystemConfigRefreshInterval: {},
+}
+
+// minSystemConfigRefreshInterval 定期加载的最小间隔（避免误配置导致频繁查询数据库）
+const minSystemConfigRefreshInterval = time.Second
+
+// SystemConfigChangeCallback 系统配置变更回调
+// config 为变更后的配置，配置被删除或禁用时为 nil（读取方应使用默认值）
+type SystemConfigChangeCallback func(key string, config *entity.SystemConfig)
+
 // SystemConfigService 系统配置服务
 // 提供系统配置的读取和管理功能，支持内存缓存
+// 缓存每次加载后与旧缓存比较，值或启用状态变化的配置回调给 Watch 注册的消费方；
+// Start 后定期从数据库加载，其他实例通过接口修改或直接修改数据库的配置无需重启即可生效
 type SystemConfigService struct {
 	repo  repository.SystemConfigRepository
 	cache map[string]*entity.SystemConfig // 内存缓存
 	mu    sync.RWMutex                    // 读写锁保护缓存
+
+	loadMu   sync.Mutex                              // 串行化加载和变更回调，保证回调顺序与加载顺序一致
+	loaded   bool                                    // 是否已成功加载过（首次加载不回调）
+	watchers map[string][]SystemConfigChangeCallback // 配置键 -> 变更回调
+	watchMu  sync.RWMutex                            // 保护 watchers
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
 }
 
 // NewSystemConfigService 创建系统配置服务实例
 func NewSystemConfigService(repo repository.SystemConfigRepository) *SystemConfigService {
+	ctx, cancel := context.WithCancel(context.Background())
 	svc := &SystemConfigService{
-		repo:  repo,
-		cache: make(map[string]*entity.SystemConfig),
+		repo:     repo,
+		cache:    make(map[string]*entity.SystemConfig),
+		watchers: make(map[string][]SystemConfigChangeCallback),
+		ctx:      ctx,
+		cancel:   cancel,
 	}
 
 	// 启动时加载缓存
-	ctx := context.Background()
 	if err := svc.loadCache(ctx); err != nil {
 		hlog.Errorf("系统配置服务初始化失败，无法加载缓存: %v", err)
 	}
@@ -58,30 +119,119 @@ func NewSystemConfigService(repo repository.SystemConfigRepository) *SystemConfi
 
 // ==================== 缓存管理 ====================
 
-// loadCache 从数据库加载所有启用的配置到内存缓存
+// loadCache 从数据库加载所有启用的配置到内存缓存，并回调值或启用状态发生变化的配置
 func (s *SystemConfigService) loadCache(ctx context.Context) error {
+	s.loadMu.Lock()
+	defer s.loadMu.Unlock()
+
 	configs, err := s.repo.FindAllActive(ctx)
 	if err != nil {
 		return err
 	}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	// 清空旧缓存
-	s.cache = make(map[string]*entity.SystemConfig)
-
 	// 加载新缓存
+	cache := make(map[string]*entity.SystemConfig, len(configs))
 	for _, config := range configs {
-		s.cache[config.ConfigKey] = config
+		cache[config.ConfigKey] = config
 	}
 
-	hlog.Infof("系统配置缓存已加载，共 %d 个配置项", len(s.cache))
+	s.mu.Lock()
+	old := s.cache
+	s.cache = cache
+	s.mu.Unlock()
+
+	if !s.loaded {
+		s.loaded = true
+		hlog.Infof("系统配置缓存已加载，共 %d 个配置项", len(cache))
+		return nil
+	}
+
+	// 比较新旧缓存，回调变化的配置（在缓存锁外回调，回调中可以读取配置）
+	for key, config := range cache {
+		if prev, ok := old[key]; !ok || prev.ConfigValue != config.ConfigValue {
+			s.notify(key, config)
+		}
+	}
+	for key := range old {
+		if _, ok := cache[key]; !ok {
+			s.notify(key, nil)
+		}
+	}
 	return nil
 }
 
+// notify 回调配置变更
+func (s *SystemConfigService) notify(key string, config *entity.SystemConfig) {
+	if config != nil {
+		hlog.Infof("系统配置已变更: key=%s, value=%s", key, config.ConfigValue)
+	} else {
+		hlog.Infof("系统配置已删除或禁用，使用默认值: key=%s", key)
+	}
+
+	s.watchMu.RLock()
+	callbacks := s.watchers[key]
+	s.watchMu.RUnlock()
+
+	for _, callback := range callbacks {
+		callback(key, config)
+	}
+}
+
+// Watch 监听配置变更（缓存加载后配置值变化、新建、删除或禁用时回调）
+// 回调在加载缓存的协程中同步执行，不应阻塞；注册时不回调当前值，调用方需自行读取初始值
+func (s *SystemConfigService) Watch(key string, callback SystemConfigChangeCallback) {
+	s.watchMu.Lock()
+	defer s.watchMu.Unlock()
+	s.watchers[key] = append(s.watchers[key], callback)
+}
+
+// Start 启动定期加载任务（间隔从系统配置 system.config.refresh.interval 读取，每次加载后重新读取）
+func (s *SystemConfigService) Start() {
+	s.wg.Add(1)
+	go s.run()
+	hlog.Infof("系统配置定期加载任务已启动: interval=%v", s.getRefreshInterval())
+}
+
+// Stop 停止定期加载任务
+func (s *SystemConfigService) Stop() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// run 定期加载循环
+func (s *SystemConfigService) run() {
+	defer s.wg.Done()
+
+	timer := time.NewTimer(s.getRefreshInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-timer.C:
+			if err := s.loadCache(s.ctx); err != nil && s.ctx.Err() == nil {
+				hlog.Errorf("定期加载系统配置失败: %v", err)
+			}
+			timer.Reset(s.getRefreshInterval())
+		}
+	}
+}
+
+// getRefreshInterval 获取定期加载的间隔（配置值 <=0 时使用默认值，不低于最小间隔）
+func (s *SystemConfigService) getRefreshInterval() time.Duration {
+	interval := time.Duration(s.GetIntValue(ConfigKeySystemConfigRefreshInterval, DefaultSystemConfigRefreshInterval)) * time.Second
+	if interval <= 0 {
+		interval = DefaultSystemConfigRefreshInterval * time.Second
+	}
+	if interval < minSystemConfigRefreshInterval {
+		interval = minSystemConfigRefreshInterval
+	}
+	return interval
+}
+
 // RefreshCache 刷新缓存
-// 手动触发缓存刷新，通常在配置更新后调用
+// 通过本服务修改配置后自动调用；直接修改数据库后可手动调用，使配置立即生效（否则等待下一次定期加载）
 func (s *SystemConfigService) RefreshCache(ctx context.Context) error {
 	return s.loadCache(ctx)
 }
@@ -171,6 +321,21 @@ func (s *SystemConfigService) GetLongPollingMaxWaitDuration() time.Duration {
 	return time.Duration(seconds) * time.Second
 }
 
+// GetLongPollingMaxWaiters 获取单实例最大并发长轮询数
+func (s *SystemConfigService) GetLongPollingMaxWaiters() int {
+	return s.GetIntValue(ConfigKeyLongPollingMaxWaiters, DefaultLongPollingMaxWaiters)
+}
+
+// GetLongPollingRetryAfter 获取超出并发上限时建议客户端重试的等待时间（秒）
+func (s *SystemConfigService) GetLongPollingRetryAfter() int {
+	return s.GetIntValue(ConfigKeyLongPollingRetryAfter, DefaultLongPollingRetryAfter)
+}
+
+// GetLongPollingPollDelay 获取建议客户端两次长轮询之间的间隔（毫秒）
+func (s *SystemConfigService) GetLongPollingPollDelay() int {
+	return s.GetIntValue(ConfigKeyLongPollingPollDelay, DefaultLongPollingPollDelay)
+}
+
 // GetMaxSubscriptions 获取最大订阅数
 func (s *SystemConfigService) GetMaxSubscriptions() int {
 	return s.GetIntValue(ConfigKeyMaxSubscriptions, DefaultMaxSubscriptions)
@@ -198,11 +363,31 @@ func (s *SystemConfigService) GetHeartbeatTimeoutDuration() time.Duration {
 	return time.Duration(seconds) * time.Second
 }
 
-// ==================== 配置管理方法（可选，用于内部管理） ====================
+// GetSubscriptionCleanupInterval 获取清理过期订阅的间隔（秒）
+func (s *SystemConfigService) GetSubscriptionCleanupInterval() int {
+	return s.GetIntValue(ConfigKeySubscriptionCleanupInterval, DefaultSubscriptionCleanupInterval)
+}
+
+// GetSubscriptionCleanupIntervalDuration 获取清理过期订阅的间隔（Duration）
+func (s *SystemConfigService) GetSubscriptionCleanupIntervalDuration() time.Duration {
+	seconds := s.GetSubscriptionCleanupInterval()
+	return time.Duration(seconds) * time.Second
+}
+
+// GetMaskingSensitiveKeywords 获取额外的敏感配置键关键字（未配置时返回 nil）
+func (s *SystemConfigService) GetMaskingSensitiveKeywords() []string {
+	return ParseSensitiveKeywords(s.GetConfigValue(ConfigKeyMaskingSensitiveKeywords, ""))
+}
+
+// ==================== 配置管理方法 ====================
 
 // SetConfig 设置或更新配置
-// 如果配置已存在则更新，否则创建新配置
+// 如果配置已存在则更新（保留启用状态），否则创建新配置；预定义的整数类型配置键要求值为整数
 func (s *SystemConfigService) SetConfig(ctx context.Context, key, value, description string) error {
+	if err := validateSystemConfig(key, value); err != nil {
+		return err
+	}
+
 	// 检查配置是否存在
 	existingConfig, err := s.repo.FindByKey(ctx, key)
 	if err != nil {
@@ -245,6 +430,9 @@ func (s *SystemConfigService) UpdateConfigValue(ctx context.Context, key, value
 
 // ActivateConfig 启用配置
 func (s *SystemConfigService) ActivateConfig(ctx context.Context, key string) error {
+	if _, err := s.GetConfigByKey(ctx, key); err != nil {
+		return err
+	}
 	if err := s.repo.UpdateActive(ctx, key, true); err != nil {
 		return err
 	}
@@ -253,8 +441,11 @@ func (s *SystemConfigService) ActivateConfig(ctx context.Context, key string) er
 	return s.RefreshCache(ctx)
 }
 
-// DeactivateConfig 禁用配置
+// DeactivateConfig 禁用配置（禁用后读取方使用默认值）
 func (s *SystemConfigService) DeactivateConfig(ctx context.Context, key string) error {
+	if _, err := s.GetConfigByKey(ctx, key); err != nil {
+		return err
+	}
 	if err := s.repo.UpdateActive(ctx, key, false); err != nil {
 		return err
 	}
@@ -267,3 +458,28 @@ func (s *SystemConfigService) DeactivateConfig(ctx context.Context, key string)
 func (s *SystemConfigService) GetAllConfigs(ctx context.Context) ([]*entity.SystemConfig, error) {
 	return s.repo.FindAll(ctx)
 }
+
+// GetConfigByKey 根据配置键获取配置（从数据库查询，包括禁用的配置）
+func (s *SystemConfigService) GetConfigByKey(ctx context.Context, key string) (*entity.SystemConfig, error) {
+	config, err := s.repo.FindByKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, domainErrors.ErrSystemConfigNotFound(key)
+	}
+	return config, nil
+}
+
+// validateSystemConfig 校验配置键格式和预定义配置键的值类型
+func validateSystemConfig(key, value string) error {
+	if len(key) > 255 || !systemConfigKeyPattern.MatchString(key) {
+		return domainErrors.ErrSystemConfigInvalid("配置键只能包含小写字母、数字，以 . _ - 分段，且不超过255个字符")
+	}
+	if _, ok := integerSystemConfigKeys[key]; ok {
+		if _, err := strconv.Atoi(strings.TrimSpace(value)); err != nil {
+			return domainErrors.ErrSystemConfigInvalid(key + " 的值必须是整数")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"config-client/config/domain/entity"
+	domainErrors "config-client/config/domain/errors"
+	"config-client/config/domain/repository"
+	"config-client/share/tenant"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// DefaultTenantStatusCacheTTL 租户状态默认缓存时长（停用后其他实例最长在该时长后生效）
+const DefaultTenantStatusCacheTTL = time.Minute
+
+// tenantCodePattern 租户编码格式：小写字母或数字开头，只包含小写字母、数字、下划线、中划线，2-64 个字符
+var tenantCodePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{1,63}$`)
+
+// TenantService 租户领域服务
+// 负责租户的创建、修改和请求租户的状态校验
+type TenantService struct {
+	repo     repository.TenantRepository
+	cacheTTL time.Duration
+
+	mu     sync.Mutex
+	status map[int]*tenantStatusEntry // 租户ID -> 状态缓存
+}
+
+// tenantStatusEntry 租户状态缓存项
+type tenantStatusEntry struct {
+	active    bool
+	expiresAt time.Time
+}
+
+// NewTenantService 创建租户领域服务（cacheTTL <= 0 时使用默认值）
+func NewTenantService(repo repository.TenantRepository, cacheTTL time.Duration) *TenantService {
+	if cacheTTL <= 0 {
+		cacheTTL = DefaultTenantStatusCacheTTL
+	}
+	return &TenantService{
+		repo:     repo,
+		cacheTTL: cacheTTL,
+		status:   make(map[int]*tenantStatusEntry),
+	}
+}
+
+// CreateTenant 创建租户
+// 业务规则：
+// 1. 编码必须符合命名规范且全局唯一
+// 2. 名称必填，不超过 255 个字符
+// 3. 新建租户默认启用
+func (s *TenantService) CreateTenant(ctx context.Context, t *entity.Tenant) error {
+	// 1. 校验参数
+	t.Code = strings.TrimSpace(t.Code)
+	if !tenantCodePattern.MatchString(t.Code) {
+		return domainErrors.ErrTenantInvalid("编码只能包含小写字母、数字、下划线、中划线，长度 2-64 个字符")
+	}
+	if err := validateTenantName(t); err != nil {
+		return err
+	}
+
+	// 2. 编码唯一
+	existing, err := s.repo.GetByCode(ctx, t.Code)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return domainErrors.ErrTenantConflict(t.Code)
+	}
+
+	// 3. 保存租户
+	t.IsActive = true
+	t.UpdatedBy = t.CreatedBy
+	if err := s.repo.Create(ctx, t); err != nil {
+		return err
+	}
+
+	hlog.CtxInfof(ctx, "租户已创建: id=%d, code=%s, operator=%s", t.ID, t.Code, t.CreatedBy)
+	return nil
+}
+
+// UpdateTenant 更新租户名称、描述和状态
+// 业务规则：
+// 1. 租户必须存在，编码不可修改
+// 2. 默认租户不能停用
+// 3. 停用后该租户的请求被拒绝（本实例立即生效，其他实例在状态缓存过期后生效）
+func (s *TenantService) UpdateTenant(ctx context.Context, t *entity.Tenant) (*entity.Tenant, error) {
+	// 1. 查询租户
+	existing, err := s.GetTenant(ctx, t.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 校验参数
+	if err := validateTenantName(t); err != nil {
+		return nil, err
+	}
+	if !t.IsActive && existing.ID == tenant.DefaultTenantID {
+		return nil, domainErrors.ErrTenantInvalid("默认租户不能停用")
+	}
+
+	// 3. 保存修改
+	existing.Name = t.Name
+	existing.Description = t.Description
+	existing.IsActive = t.IsActive
+	existing.UpdatedBy = t.UpdatedBy
+	if err := s.repo.Update(ctx, existing); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	delete(s.status, existing.ID)
+	s.mu.Unlock()
+
+	hlog.CtxInfof(ctx, "租户已更新: id=%d, code=%s, active=%t, operator=%s", existing.ID, existing.Code, existing.IsActive, existing.UpdatedBy)
+	return existing, nil
+}
+
+// GetTenant 根据ID查询租户
+func (s *TenantService) GetTenant(ctx context.Context, id int) (*entity.Tenant, error) {
+	t, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, domainErrors.ErrTenantNotFound(id)
+	}
+	return t, nil
+}
+
+// ListTenants 查询全部租户
+func (s *TenantService) ListTenants(ctx context.Context) ([]*entity.Tenant, error) {
+	return s.repo.FindAll(ctx)
+}
+
+// CheckActive 校验请求的租户存在且已启用（结果缓存 cacheTTL，避免每个请求都查询数据库）
+func (s *TenantService) CheckActive(ctx context.Context, id int) error {
+	now := time.Now()
+
+	s.mu.Lock()
+	entry, ok := s.status[id]
+	s.mu.Unlock()
+
+	if !ok || !now.Before(entry.expiresAt) {
+		t, err := s.repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		entry = &tenantStatusEntry{active: t != nil && t.IsActive, expiresAt: now.Add(s.cacheTTL)}
+
+		s.mu.Lock()
+		s.status[id] = entry
+		s.mu.Unlock()
+	}
+
+	if !entry.active {
+		return domainErrors.ErrTenantForbidden("租户不存在或已停用")
+	}
+	return nil
+}
+
+// validateTenantName 校验租户名称
+func validateTenantName(t *entity.Tenant) error {
+	t.Name = strings.TrimSpace(t.Name)
+	if t.Name == "" || utf8.RuneCountInString(t.Name) > 255 {
+		return domainErrors.ErrTenantInvalid("名称不能为空且不超过255个字符")
+	}
+	return nil
+}
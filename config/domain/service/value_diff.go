@@ -0,0 +1,398 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"config-client/config/domain/constants"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// unifiedDiffContext 统一差异格式中每个变更块前后保留的上下文行数
+	unifiedDiffContext = 3
+	// maxDiffLines 参与行级对比的最大行数，超过时整体视为删除后新增
+	maxDiffLines = 20000
+	// maxDiffEdits 行级对比的最大编辑距离，超过时整体视为删除后新增
+	maxDiffEdits = 2000
+)
+
+// 字段变更类型
+const (
+	FieldChangeAdded    = "added"    // 新增字段
+	FieldChangeRemoved  = "removed"  // 删除字段
+	FieldChangeModified = "modified" // 修改字段
+)
+
+// ValueDiff 配置值差异
+type ValueDiff struct {
+	UnifiedDiff string         // 行级差异（unified diff 格式），值相同时为空
+	Changes     []*FieldChange // 字段级差异（仅 JSON/YAML 值且两侧均可解析时提供）
+}
+
+// FieldChange 结构化配置值的字段级变更
+type FieldChange struct {
+	Path     string      // 字段路径，如 db.hosts[0]
+	Type     string      // 变更类型: added, removed, modified
+	OldValue interface{} // 变更前的值（新增时为 nil）
+	NewValue interface{} // 变更后的值（删除时为 nil）
+}
+
+// DiffValues 对比两个配置值，生成行级差异和字段级差异
+// 业务规则：
+// 1. 加密配置不生成差异（密文对比没有意义）
+// 2. valueType 为 json/yaml 时生成字段级差异；未知类型时两侧均为 JSON 对象或数组也按 JSON 对比
+// 3. fromLabel/toLabel 作为 unified diff 的文件头（--- / +++）
+func DiffValues(fromLabel, toLabel, oldValue, newValue, valueType string) *ValueDiff {
+	if valueType == constants.ValueTypeEncrypted {
+		return nil
+	}
+
+	diff := &ValueDiff{
+		UnifiedDiff: UnifiedDiff(fromLabel, toLabel, oldValue, newValue),
+	}
+	if format := structuredFormat(valueType, oldValue, newValue); format != "" {
+		diff.Changes = StructuredDiff(format, oldValue, newValue)
+	}
+	return diff
+}
+
+// structuredFormat 确定结构化对比使用的格式，无法结构化对比时返回空
+func structuredFormat(valueType, oldValue, newValue string) string {
+	switch valueType {
+	case constants.ValueTypeJSON, constants.ValueTypeYAML:
+		return valueType
+	case "":
+		if looksLikeJSON(oldValue) && looksLikeJSON(newValue) {
+			return constants.ValueTypeJSON
+		}
+	}
+	return ""
+}
+
+// looksLikeJSON 判断值是否像 JSON 对象或数组
+func looksLikeJSON(value string) bool {
+	value = strings.TrimSpace(value)
+	return strings.HasPrefix(value, "{") || strings.HasPrefix(value, "[")
+}
+
+// ==================== 字段级差异 ====================
+
+// StructuredDiff 对比两个 JSON/YAML 值的字段级差异（按路径排序）
+// 任意一侧解析失败时返回 nil
+func StructuredDiff(format, oldValue, newValue string) []*FieldChange {
+	oldData, err := decodeStructured(format, oldValue)
+	if err != nil {
+		return nil
+	}
+	newData, err := decodeStructured(format, newValue)
+	if err != nil {
+		return nil
+	}
+
+	changes := make([]*FieldChange, 0)
+	diffStructured("", oldData, newData, &changes)
+	return changes
+}
+
+// decodeStructured 解析 JSON/YAML 值（空值视为 nil）
+func decodeStructured(format, value string) (interface{}, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	var data interface{}
+	if format == constants.ValueTypeYAML {
+		if err := yaml.Unmarshal([]byte(value), &data); err != nil {
+			return nil, err
+		}
+		return normalizeYAMLValue(data), nil
+	}
+	if err := json.Unmarshal([]byte(value), &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// normalizeYAMLValue 将 YAML 中非字符串键的映射转换为字符串键映射，保证差异结果可序列化为 JSON
+func normalizeYAMLValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			v[key] = normalizeYAMLValue(child)
+		}
+		return v
+	case map[interface{}]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			normalized[fmt.Sprint(key)] = normalizeYAMLValue(child)
+		}
+		return normalized
+	case []interface{}:
+		for i, child := range v {
+			v[i] = normalizeYAMLValue(child)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// diffStructured 递归对比两个结构化值
+func diffStructured(path string, oldData, newData interface{}, changes *[]*FieldChange) {
+	oldMap, oldIsMap := oldData.(map[string]interface{})
+	newMap, newIsMap := newData.(map[string]interface{})
+	if oldIsMap && newIsMap {
+		keys := make([]string, 0, len(oldMap)+len(newMap))
+		for key := range oldMap {
+			keys = append(keys, key)
+		}
+		for key := range newMap {
+			if _, exists := oldMap[key]; !exists {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			childPath := key
+			if path != "" {
+				childPath = path + "." + key
+			}
+			oldChild, inOld := oldMap[key]
+			newChild, inNew := newMap[key]
+			switch {
+			case !inOld:
+				*changes = append(*changes, &FieldChange{Path: childPath, Type: FieldChangeAdded, NewValue: newChild})
+			case !inNew:
+				*changes = append(*changes, &FieldChange{Path: childPath, Type: FieldChangeRemoved, OldValue: oldChild})
+			default:
+				diffStructured(childPath, oldChild, newChild, changes)
+			}
+		}
+		return
+	}
+
+	oldList, oldIsList := oldData.([]interface{})
+	newList, newIsList := newData.([]interface{})
+	if oldIsList && newIsList {
+		for i := 0; i < len(oldList) || i < len(newList); i++ {
+			childPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(oldList):
+				*changes = append(*changes, &FieldChange{Path: childPath, Type: FieldChangeAdded, NewValue: newList[i]})
+			case i >= len(newList):
+				*changes = append(*changes, &FieldChange{Path: childPath, Type: FieldChangeRemoved, OldValue: oldList[i]})
+			default:
+				diffStructured(childPath, oldList[i], newList[i], changes)
+			}
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(oldData, newData) {
+		*changes = append(*changes, &FieldChange{Path: path, Type: FieldChangeModified, OldValue: oldData, NewValue: newData})
+	}
+}
+
+// ==================== 行级差异 ====================
+
+// diffLine 行级差异中的一行
+type diffLine struct {
+	op   byte // ' ' 相同, '-' 删除, '+' 新增
+	text string
+}
+
+// UnifiedDiff 生成两个文本的行级差异（unified diff 格式，每个变更块保留 3 行上下文）
+// 文本相同时返回空字符串
+func UnifiedDiff(fromLabel, toLabel, oldText, newText string) string {
+	if oldText == newText {
+		return ""
+	}
+
+	lines := diffTextLines(splitLines(oldText), splitLines(newText))
+
+	var sb strings.Builder
+	sb.WriteString("--- " + fromLabel + "\n")
+	sb.WriteString("+++ " + toLabel + "\n")
+	for _, hunk := range buildHunks(lines, unifiedDiffContext) {
+		sb.WriteString(hunk)
+	}
+	return sb.String()
+}
+
+// splitLines 按行拆分文本（空文本为 0 行，忽略末尾换行）
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// diffTextLines 计算两组行的最短编辑脚本（Myers 差分算法）
+// 行数或编辑距离超过上限时整体视为删除后新增，避免大文本耗尽内存
+func diffTextLines(a, b []string) []diffLine {
+	n, m := len(a), len(b)
+	if n+m > maxDiffLines {
+		return replaceAllLines(a, b)
+	}
+
+	maxD := n + m
+	if maxD > maxDiffEdits {
+		maxD = maxDiffEdits
+	}
+	offset := maxD + 1
+	v := make([]int, 2*maxD+3)
+	// trace[d] 保存第 d 步开始前对角线 [-d, d] 上的最远到达位置
+	trace := make([][]int, 0, 16)
+
+	for d := 0; d <= maxD; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrackDiff(trace, a, b)
+			}
+		}
+	}
+	return replaceAllLines(a, b)
+}
+
+// backtrackDiff 根据 Myers 算法的搜索轨迹回溯出编辑脚本
+func backtrackDiff(trace [][]int, a, b []string) []diffLine {
+	// at 读取第 d 步的对角线 k 上的位置（超出范围时为 0）
+	at := func(d, k int) int {
+		if k < -d || k > d {
+			return 0
+		}
+		return trace[d][k+d]
+	}
+
+	x, y := len(a), len(b)
+	reversed := make([]diffLine, 0, len(a)+len(b))
+	for d := len(trace) - 1; d >= 0; d-- {
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && at(d, k-1) < at(d, k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := at(d, prevK)
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			reversed = append(reversed, diffLine{op: ' ', text: a[x-1]})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				reversed = append(reversed, diffLine{op: '+', text: b[prevY]})
+			} else {
+				reversed = append(reversed, diffLine{op: '-', text: a[prevX]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	lines := make([]diffLine, len(reversed))
+	for i := range reversed {
+		lines[i] = reversed[len(reversed)-1-i]
+	}
+	return lines
+}
+
+// replaceAllLines 整体视为删除全部旧行后新增全部新行
+func replaceAllLines(a, b []string) []diffLine {
+	lines := make([]diffLine, 0, len(a)+len(b))
+	for _, line := range a {
+		lines = append(lines, diffLine{op: '-', text: line})
+	}
+	for _, line := range b {
+		lines = append(lines, diffLine{op: '+', text: line})
+	}
+	return lines
+}
+
+// buildHunks 将编辑脚本划分为变更块，相邻变更之间的相同行不超过 2*context 时合并为一个块
+func buildHunks(lines []diffLine, context int) []string {
+	// 每行之前旧文本、新文本已经过的行数
+	oldPos := make([]int, len(lines)+1)
+	newPos := make([]int, len(lines)+1)
+	for i, line := range lines {
+		oldPos[i+1], newPos[i+1] = oldPos[i], newPos[i]
+		if line.op != '+' {
+			oldPos[i+1]++
+		}
+		if line.op != '-' {
+			newPos[i+1]++
+		}
+	}
+
+	hunks := make([]string, 0)
+	for i := 0; i < len(lines); {
+		if lines[i].op == ' ' {
+			i++
+			continue
+		}
+
+		// 向后扩展，直到与下一个变更之间的相同行超过 2*context
+		start := i - context
+		if start < 0 {
+			start = 0
+		}
+		end := i
+		for j := i; j < len(lines); j++ {
+			if lines[j].op != ' ' {
+				end = j
+			} else if j-end > 2*context {
+				break
+			}
+		}
+		stop := end + context + 1
+		if stop > len(lines) {
+			stop = len(lines)
+		}
+
+		var sb strings.Builder
+		oldCount := oldPos[stop] - oldPos[start]
+		newCount := newPos[stop] - newPos[start]
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n",
+			hunkRange(oldPos[start], oldCount), hunkRange(newPos[start], newCount))
+		for _, line := range lines[start:stop] {
+			sb.WriteByte(line.op)
+			sb.WriteString(line.text)
+			sb.WriteByte('\n')
+		}
+		hunks = append(hunks, sb.String())
+		i = stop
+	}
+	return hunks
+}
+
+// hunkRange 格式化变更块的行范围（起始行从 1 开始；行数为 0 时起始行为前一行）
+func hunkRange(pos, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", pos)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", pos+1)
+	}
+	return fmt.Sprintf("%d,%d", pos+1, count)
+}
@@ -0,0 +1,439 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	domainErrors "config-client/config/domain/errors"
+)
+
+// metadataValidatorsKey 配置元数据中声明校验规则的字段名
+// 示例：{"validators": [{"type": "range", "min": 1, "max": 65535}, {"type": "regex", "pattern": "^\\d+$"}]}
+const metadataValidatorsKey = "validators"
+
+// ValidatorRule 单条校验规则，type 指定校验器名称，其余字段为校验器参数
+// 可选字段 message 用于覆盖默认的错误提示
+type ValidatorRule map[string]interface{}
+
+// Type 获取校验器名称
+func (r ValidatorRule) Type() string {
+	name, _ := r["type"].(string)
+	return name
+}
+
+// String 获取字符串参数
+func (r ValidatorRule) String(name string) (string, bool) {
+	value, ok := r[name].(string)
+	return value, ok
+}
+
+// Float 获取数值参数
+func (r ValidatorRule) Float(name string) (float64, bool) {
+	switch value := r[name].(type) {
+	case float64:
+		return value, true
+	case json.Number:
+		f, err := value.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// Bool 获取布尔参数
+func (r ValidatorRule) Bool(name string) bool {
+	value, _ := r[name].(bool)
+	return value
+}
+
+// Strings 获取字符串数组参数（非字符串元素按字面量转换）
+func (r ValidatorRule) Strings(name string) ([]string, bool) {
+	list, ok := r[name].([]interface{})
+	if !ok {
+		return nil, false
+	}
+	values := make([]string, 0, len(list))
+	for _, item := range list {
+		values = append(values, fmt.Sprint(item))
+	}
+	return values, true
+}
+
+// ValueValidator 配置值校验器
+// 实现方通过 RegisterValueValidator 注册后，即可在配置元数据中按名称引用
+type ValueValidator interface {
+	// Name 校验器名称（对应规则中的 type）
+	Name() string
+
+	// CheckRule 检查规则参数是否完整有效
+	CheckRule(rule ValidatorRule) error
+
+	// Validate 校验配置值，返回不通过的原因
+	Validate(value string, rule ValidatorRule) error
+}
+
+// ValidatorRegistry 配置值校验器注册表
+type ValidatorRegistry struct {
+	mu         sync.RWMutex
+	validators map[string]ValueValidator
+}
+
+// NewValidatorRegistry 创建校验器注册表（已注册内置校验器：regex/range/enum/url/cron）
+func NewValidatorRegistry() *ValidatorRegistry {
+	registry := &ValidatorRegistry{validators: make(map[string]ValueValidator)}
+	registry.Register(&regexValidator{})
+	registry.Register(&rangeValidator{})
+	registry.Register(&enumValidator{})
+	registry.Register(&urlValidator{})
+	registry.Register(&cronValidator{})
+	return registry
+}
+
+// Register 注册校验器（同名校验器会被覆盖）
+func (r *ValidatorRegistry) Register(validator ValueValidator) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.validators[validator.Name()] = validator
+}
+
+// Get 根据名称获取校验器
+func (r *ValidatorRegistry) Get(name string) (ValueValidator, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	validator, ok := r.validators[name]
+	return validator, ok
+}
+
+// Names 获取已注册的校验器名称（已排序）
+func (r *ValidatorRegistry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.validators))
+	for name := range r.validators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ValidateValue 按配置元数据中声明的规则校验配置值
+// 业务规则：
+// 1. 元数据为空、不是合法 JSON 或未声明 validators 时不校验
+// 2. validators 格式错误、引用未注册的校验器或参数无效时返回规则错误
+// 3. 规则按声明顺序执行，遇到第一条不通过的规则即返回
+func (r *ValidatorRegistry) ValidateValue(value string, metadata string) error {
+	rules, err := ParseValidatorRules(metadata)
+	if err != nil || len(rules) == 0 {
+		return err
+	}
+
+	for _, rule := range rules {
+		validator, ok := r.Get(rule.Type())
+		if !ok {
+			return domainErrors.ErrConfigValidatorInvalid(rule.Type(), "未注册的校验器，可选值: "+strings.Join(r.Names(), "/"))
+		}
+		if err := validator.CheckRule(rule); err != nil {
+			return domainErrors.ErrConfigValidatorInvalid(rule.Type(), err.Error())
+		}
+		if err := validator.Validate(value, rule); err != nil {
+			reason := err.Error()
+			if message, ok := rule.String("message"); ok && message != "" {
+				reason = message
+			}
+			return domainErrors.ErrConfigValueRuleViolation(rule.Type(), reason)
+		}
+	}
+	return nil
+}
+
+// ParseValidatorRules 从配置元数据中解析校验规则
+func ParseValidatorRules(metadata string) ([]ValidatorRule, error) {
+	if strings.TrimSpace(metadata) == "" {
+		return nil, nil
+	}
+
+	var meta map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(metadata), &meta); err != nil {
+		return nil, nil // 元数据不是 JSON 对象时不视为声明了校验规则
+	}
+	raw, ok := meta[metadataValidatorsKey]
+	if !ok {
+		return nil, nil
+	}
+
+	var rules []ValidatorRule
+	if err := json.Unmarshal(raw, &rules); err != nil {
+		return nil, domainErrors.ErrConfigValidatorInvalid(metadataValidatorsKey, "必须是对象数组")
+	}
+	for _, rule := range rules {
+		if rule.Type() == "" {
+			return nil, domainErrors.ErrConfigValidatorInvalid(metadataValidatorsKey, "每条规则都必须指定 type")
+		}
+	}
+	return rules, nil
+}
+
+// defaultValidatorRegistry 默认校验器注册表，validateValueByType 使用
+var defaultValidatorRegistry = NewValidatorRegistry()
+
+// DefaultValidatorRegistry 获取默认校验器注册表
+func DefaultValidatorRegistry() *ValidatorRegistry {
+	return defaultValidatorRegistry
+}
+
+// RegisterValueValidator 向默认注册表注册自定义校验器
+func RegisterValueValidator(validator ValueValidator) {
+	defaultValidatorRegistry.Register(validator)
+}
+
+// ==================== 内置校验器 ====================
+
+// regexValidator 正则校验器
+// 参数：pattern（必填）
+type regexValidator struct {
+	cache sync.Map // pattern -> *regexp.Regexp
+}
+
+func (v *regexValidator) Name() string { return "regex" }
+
+func (v *regexValidator) CheckRule(rule ValidatorRule) error {
+	_, err := v.compile(rule)
+	return err
+}
+
+func (v *regexValidator) Validate(value string, rule ValidatorRule) error {
+	re, err := v.compile(rule)
+	if err != nil {
+		return err
+	}
+	if !re.MatchString(value) {
+		return fmt.Errorf("值不匹配正则表达式 %s", re.String())
+	}
+	return nil
+}
+
+func (v *regexValidator) compile(rule ValidatorRule) (*regexp.Regexp, error) {
+	pattern, ok := rule.String("pattern")
+	if !ok || pattern == "" {
+		return nil, fmt.Errorf("缺少参数 pattern")
+	}
+	if cached, ok := v.cache.Load(pattern); ok {
+		return cached.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("pattern 不是合法的正则表达式: %v", err)
+	}
+	v.cache.Store(pattern, re)
+	return re, nil
+}
+
+// rangeValidator 数值范围校验器
+// 参数：min、max（至少指定一个，闭区间）
+type rangeValidator struct{}
+
+func (v *rangeValidator) Name() string { return "range" }
+
+func (v *rangeValidator) CheckRule(rule ValidatorRule) error {
+	min, hasMin := rule.Float("min")
+	max, hasMax := rule.Float("max")
+	if !hasMin && !hasMax {
+		return fmt.Errorf("至少需要指定 min 或 max")
+	}
+	if hasMin && hasMax && min > max {
+		return fmt.Errorf("min 不能大于 max")
+	}
+	return nil
+}
+
+func (v *rangeValidator) Validate(value string, rule ValidatorRule) error {
+	number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return fmt.Errorf("值不是数字，无法进行范围校验")
+	}
+	if min, ok := rule.Float("min"); ok && number < min {
+		return fmt.Errorf("值不能小于 %v", min)
+	}
+	if max, ok := rule.Float("max"); ok && number > max {
+		return fmt.Errorf("值不能大于 %v", max)
+	}
+	return nil
+}
+
+// enumValidator 枚举校验器
+// 参数：values（必填），ignore_case（可选，默认区分大小写）
+type enumValidator struct{}
+
+func (v *enumValidator) Name() string { return "enum" }
+
+func (v *enumValidator) CheckRule(rule ValidatorRule) error {
+	if values, ok := rule.Strings("values"); !ok || len(values) == 0 {
+		return fmt.Errorf("缺少参数 values")
+	}
+	return nil
+}
+
+func (v *enumValidator) Validate(value string, rule ValidatorRule) error {
+	values, _ := rule.Strings("values")
+	ignoreCase := rule.Bool("ignore_case")
+	for _, candidate := range values {
+		if candidate == value || (ignoreCase && strings.EqualFold(candidate, value)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("值必须是 %s 之一", strings.Join(values, "/"))
+}
+
+// urlValidator URL 校验器
+// 参数：schemes（可选，默认 http/https）
+type urlValidator struct{}
+
+func (v *urlValidator) Name() string { return "url" }
+
+func (v *urlValidator) CheckRule(rule ValidatorRule) error {
+	if _, exists := rule["schemes"]; exists {
+		if schemes, ok := rule.Strings("schemes"); !ok || len(schemes) == 0 {
+			return fmt.Errorf("schemes 必须是非空字符串数组")
+		}
+	}
+	return nil
+}
+
+func (v *urlValidator) Validate(value string, rule ValidatorRule) error {
+	schemes, ok := rule.Strings("schemes")
+	if !ok {
+		schemes = []string{"http", "https"}
+	}
+
+	parsed, err := url.Parse(strings.TrimSpace(value))
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return fmt.Errorf("值不是合法的 URL")
+	}
+	for _, scheme := range schemes {
+		if strings.EqualFold(parsed.Scheme, scheme) {
+			return nil
+		}
+	}
+	return fmt.Errorf("URL 协议必须是 %s 之一", strings.Join(schemes, "/"))
+}
+
+// cronValidator Cron 表达式校验器
+// 支持 5 段标准格式（分 时 日 月 周）、6 段带秒格式（参数 seconds=true 时要求 6 段）以及 @daily 等描述符
+type cronValidator struct{}
+
+// cronField Cron 字段定义
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int // 字段支持的英文别名
+}
+
+var (
+	cronMonthNames = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+	cronWeekNames = map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}
+	cronDescriptors = map[string]bool{
+		"@yearly": true, "@annually": true, "@monthly": true, "@weekly": true,
+		"@daily": true, "@midnight": true, "@hourly": true,
+	}
+	cronStandardFields = []cronField{
+		{name: "分钟", min: 0, max: 59},
+		{name: "小时", min: 0, max: 23},
+		{name: "日", min: 1, max: 31},
+		{name: "月", min: 1, max: 12, names: cronMonthNames},
+		{name: "周", min: 0, max: 7, names: cronWeekNames},
+	}
+	cronSecondField = cronField{name: "秒", min: 0, max: 59}
+)
+
+func (v *cronValidator) Name() string { return "cron" }
+
+func (v *cronValidator) CheckRule(rule ValidatorRule) error {
+	return nil
+}
+
+func (v *cronValidator) Validate(value string, rule ValidatorRule) error {
+	expr := strings.TrimSpace(value)
+	if cronDescriptors[strings.ToLower(expr)] {
+		return nil
+	}
+	if strings.HasPrefix(expr, "@every ") {
+		return nil
+	}
+
+	parts := strings.Fields(expr)
+	seconds := rule.Bool("seconds")
+	var fields []cronField
+	switch {
+	case len(parts) == 6:
+		fields = append([]cronField{cronSecondField}, cronStandardFields...)
+	case len(parts) == 5 && !seconds:
+		fields = cronStandardFields
+	case seconds:
+		return fmt.Errorf("Cron 表达式必须为 6 段（秒 分 时 日 月 周）")
+	default:
+		return fmt.Errorf("Cron 表达式必须为 5 段（分 时 日 月 周）或 6 段（秒 分 时 日 月 周）")
+	}
+
+	for i, part := range parts {
+		if err := validateCronField(part, fields[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateCronField 校验单个 Cron 字段：支持 *、?、列表(,)、范围(-)、步长(/)
+func validateCronField(part string, field cronField) error {
+	for _, item := range strings.Split(part, ",") {
+		base, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n <= 0 {
+				return fmt.Errorf("Cron %s字段步长无效: %s", field.name, item)
+			}
+		}
+
+		if base == "*" || base == "?" {
+			continue
+		}
+
+		low, high, isRange := strings.Cut(base, "-")
+		lowValue, err := parseCronValue(low, field)
+		if err != nil {
+			return err
+		}
+		if isRange {
+			highValue, err := parseCronValue(high, field)
+			if err != nil {
+				return err
+			}
+			if lowValue > highValue {
+				return fmt.Errorf("Cron %s字段范围无效: %s", field.name, base)
+			}
+		}
+	}
+	return nil
+}
+
+// parseCronValue 解析 Cron 字段中的单个值（数字或英文别名）
+func parseCronValue(token string, field cronField) (int, error) {
+	if value, ok := field.names[strings.ToUpper(token)]; ok {
+		return value, nil
+	}
+	value, err := strconv.Atoi(token)
+	if err != nil || value < field.min || value > field.max {
+		return 0, fmt.Errorf("Cron %s字段取值无效: %s（范围 %d-%d）", field.name, token, field.min, field.max)
+	}
+	return value, nil
+}
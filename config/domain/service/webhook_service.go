@@ -0,0 +1,612 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"config-client/config/domain/entity"
+	domainErrors "config-client/config/domain/errors"
+	"config-client/config/domain/listener"
+	"config-client/config/domain/repository"
+	shareRepo "config-client/share/repository"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	// DefaultWebhookPollInterval Webhook 投递任务默认轮询间隔
+	DefaultWebhookPollInterval = time.Second
+	// DefaultWebhookBatchSize 单次默认投递条数
+	DefaultWebhookBatchSize = 50
+	// DefaultWebhookMaxAttempts 默认最大尝试次数（含首次投递）
+	DefaultWebhookMaxAttempts = 8
+	// DefaultWebhookTimeout 单次请求默认超时时间
+	DefaultWebhookTimeout = 10 * time.Second
+	// DefaultWebhookRetention 已结束投递记录默认保留时长
+	DefaultWebhookRetention = 7 * 24 * time.Hour
+
+	// webhookBaseBackoff 首次失败后的退避时间，之后每次翻倍
+	webhookBaseBackoff = 10 * time.Second
+	// webhookMaxBackoff 投递失败的最大退避时间
+	webhookMaxBackoff = time.Hour
+	// webhookCleanupInterval 投递记录清理间隔
+	webhookCleanupInterval = time.Hour
+	// webhookResponseBodyLimit 投递记录保存的响应内容长度上限（字节）
+	webhookResponseBodyLimit = 1024
+)
+
+// WebhookSender Webhook 请求发送器（由基础设施层实现，负责请求签名和 HTTP 发送）
+type WebhookSender interface {
+	// Send 发送一次投递请求，收到响应时返回响应（无论状态码），网络错误或超时时返回错误
+	Send(ctx context.Context, req *WebhookRequest) (*WebhookResponse, error)
+}
+
+// WebhookRequest Webhook 投递请求
+type WebhookRequest struct {
+	URL        string // 投递地址
+	Secret     string // 签名密钥
+	EventType  string // 事件类型
+	DeliveryID int64  // 投递记录ID
+	Payload    []byte // 请求体
+}
+
+// WebhookResponse Webhook 投递响应
+type WebhookResponse struct {
+	StatusCode int    // 响应状态码
+	Body       string // 响应内容
+}
+
+// WebhookEvent 投递给 Webhook 的事件（即请求体）
+type WebhookEvent struct {
+	Type        string    `json:"type"`                  // 事件类型
+	NamespaceID int       `json:"namespace_id"`          // 命名空间ID
+	Environment string    `json:"environment,omitempty"` // 环境
+	OccurredAt  time.Time `json:"occurred_at"`           // 事件发生时间
+	Data        any       `json:"data"`                  // 事件内容：配置事件为 WebhookConfigData，发布事件为 WebhookReleaseData
+}
+
+// WebhookConfigData 配置变更事件内容
+type WebhookConfigData struct {
+	ConfigID int    `json:"config_id"` // 配置ID
+	Key      string `json:"key"`       // 配置键
+}
+
+// WebhookReleaseData 发布事件内容
+type WebhookReleaseData struct {
+	ReleaseID        int                      `json:"release_id"`                  // 发布版本ID
+	Version          int                      `json:"version"`                     // 版本号
+	VersionName      string                   `json:"version_name"`                // 版本名称
+	ReleaseType      string                   `json:"release_type"`                // 发布类型
+	Operator         string                   `json:"operator"`                    // 操作人
+	ReleaseNotes     string                   `json:"release_notes,omitempty"`     // 发布说明
+	ConfigCount      int                      `json:"config_count"`                // 配置项数量
+	CanaryPercentage int                      `json:"canary_percentage,omitempty"` // 灰度比例（灰度发布时）
+	Changelog        *entity.ReleaseChangelog `json:"changelog,omitempty"`         // 相对上一已发布版本的键级变更明细
+	TargetReleaseID  int                      `json:"target_release_id,omitempty"` // 回滚到的目标版本ID（回滚时）
+	TargetVersion    int                      `json:"target_version,omitempty"`    // 回滚到的目标版本号（回滚时）
+	RollbackReason   string                   `json:"rollback_reason,omitempty"`   // 回滚原因（回滚时）
+}
+
+// WebhookOptions Webhook 投递参数
+type WebhookOptions struct {
+	PollInterval time.Duration // 轮询间隔（<=0 时使用默认值）
+	BatchSize    int           // 单次投递条数（<=0 时使用默认值）
+	MaxAttempts  int           // 最大尝试次数（<=0 时使用默认值）
+	Timeout      time.Duration // 单次请求超时时间（<=0 时使用默认值）
+	Retention    time.Duration // 已结束投递记录保留时长（<=0 时使用默认值）
+}
+
+// WebhookService Webhook 领域服务
+// 负责命名空间 Webhook 的管理和事件投递：
+// - 事件发生时为每个匹配的 Webhook 写入投递记录（在调用方事务内，事务回滚时一并丢弃）
+// - 后台投递任务领取投递记录发送 HMAC 签名的 POST 请求，失败时按指数退避重试
+// - 投递记录保留每次尝试的结果，可手动重新投递
+type WebhookService struct {
+	webhookRepo   repository.WebhookRepository
+	deliveryRepo  repository.WebhookDeliveryRepository
+	namespaceRepo repository.NamespaceRepository
+	sender        WebhookSender
+	opts          WebhookOptions
+
+	wakeCh chan struct{}
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewWebhookService 创建 Webhook 领域服务
+func NewWebhookService(
+	webhookRepo repository.WebhookRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+	namespaceRepo repository.NamespaceRepository,
+	sender WebhookSender,
+	opts WebhookOptions,
+) *WebhookService {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultWebhookPollInterval
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultWebhookBatchSize
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = DefaultWebhookMaxAttempts
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultWebhookTimeout
+	}
+	if opts.Retention <= 0 {
+		opts.Retention = DefaultWebhookRetention
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &WebhookService{
+		webhookRepo:   webhookRepo,
+		deliveryRepo:  deliveryRepo,
+		namespaceRepo: namespaceRepo,
+		sender:        sender,
+		opts:          opts,
+		wakeCh:        make(chan struct{}, 1),
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+}
+
+// ==================== Webhook 管理 ====================
+
+// CreateWebhook 创建 Webhook
+// 业务规则：
+// 1. 命名空间必须存在
+// 2. URL 必须是 http/https 绝对地址，签名密钥不能为空
+// 3. 事件过滤为空时订阅全部事件
+func (s *WebhookService) CreateWebhook(ctx context.Context, webhook *entity.Webhook) error {
+	// 1. 校验命名空间和 Webhook 参数
+	namespace, err := s.namespaceRepo.GetByID(ctx, webhook.NamespaceID)
+	if err != nil {
+		return err
+	}
+	if namespace == nil {
+		return domainErrors.ErrNamespaceNotFound(strconv.Itoa(webhook.NamespaceID))
+	}
+	if err := validateWebhook(webhook); err != nil {
+		return err
+	}
+
+	// 2. 保存
+	webhook.UpdatedBy = webhook.CreatedBy
+	if err := s.webhookRepo.Create(ctx, webhook); err != nil {
+		return fmt.Errorf("创建 Webhook 失败: %w", err)
+	}
+
+	hlog.CtxInfof(ctx, "创建 Webhook: id=%d, namespaceID=%d, url=%s, events=%v",
+		webhook.ID, webhook.NamespaceID, webhook.URL, webhook.Events)
+	return nil
+}
+
+// UpdateWebhook 更新 Webhook
+// 签名密钥为空时保留原密钥
+func (s *WebhookService) UpdateWebhook(ctx context.Context, webhook *entity.Webhook) error {
+	// 1. 查询已有 Webhook
+	existing, err := s.GetWebhook(ctx, webhook.ID)
+	if err != nil {
+		return err
+	}
+
+	// 2. 合并并校验
+	webhook.NamespaceID = existing.NamespaceID
+	webhook.CreatedBy = existing.CreatedBy
+	webhook.CreatedAt = existing.CreatedAt
+	if webhook.Secret == "" {
+		webhook.Secret = existing.Secret
+	}
+	if err := validateWebhook(webhook); err != nil {
+		return err
+	}
+
+	// 3. 保存
+	if err := s.webhookRepo.Update(ctx, webhook); err != nil {
+		return fmt.Errorf("更新 Webhook 失败: %w", err)
+	}
+	return nil
+}
+
+// DeleteWebhook 删除 Webhook
+// 投递记录保留为日志，尚未完成的投递在投递时标记为失败
+func (s *WebhookService) DeleteWebhook(ctx context.Context, id int) error {
+	if _, err := s.GetWebhook(ctx, id); err != nil {
+		return err
+	}
+	if err := s.webhookRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("删除 Webhook 失败: %w", err)
+	}
+	return nil
+}
+
+// GetWebhook 根据ID查询 Webhook
+// 所属命名空间不属于当前租户时视为不存在
+func (s *WebhookService) GetWebhook(ctx context.Context, id int) (*entity.Webhook, error) {
+	webhook, err := s.webhookRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if webhook == nil {
+		return nil, domainErrors.ErrWebhookNotFound(id)
+	}
+	namespace, err := s.namespaceRepo.GetByID(ctx, webhook.NamespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if namespace == nil {
+		return nil, domainErrors.ErrWebhookNotFound(id)
+	}
+	return webhook, nil
+}
+
+// ListWebhooks 查询命名空间下的 Webhook
+func (s *WebhookService) ListWebhooks(ctx context.Context, namespaceID int) ([]*entity.Webhook, error) {
+	namespace, err := s.namespaceRepo.GetByID(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if namespace == nil {
+		return nil, domainErrors.ErrNamespaceNotFound(strconv.Itoa(namespaceID))
+	}
+	return s.webhookRepo.FindByNamespace(ctx, namespaceID, false)
+}
+
+// validateWebhook 校验 Webhook 参数
+func validateWebhook(webhook *entity.Webhook) error {
+	webhook.Name = strings.TrimSpace(webhook.Name)
+	if webhook.Name == "" {
+		return domainErrors.ErrWebhookInvalid("名称不能为空")
+	}
+
+	parsed, err := url.Parse(webhook.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return domainErrors.ErrWebhookInvalid("URL 必须是 http/https 绝对地址: " + webhook.URL)
+	}
+	if webhook.Secret == "" {
+		return domainErrors.ErrWebhookInvalid("签名密钥不能为空")
+	}
+
+	for _, filter := range webhook.Events {
+		if !entity.IsValidWebhookEventFilter(filter) {
+			return domainErrors.ErrWebhookInvalid("不支持的事件类型: " + filter + "（可选值: " +
+				strings.Join(entity.WebhookEventTypes, "/") + "，或 config.*/release.*）")
+		}
+	}
+	return nil
+}
+
+// ==================== 事件通知 ====================
+
+// Notify 为订阅了事件的 Webhook 写入投递记录
+// 调用方需在变更写入所在的事务上下文中调用（未开启事务时直接写入），事务回滚时投递记录一并丢弃
+// 投递任务被立即唤醒；事务尚未提交时记录暂不可见，由下一次轮询投递
+func (s *WebhookService) Notify(ctx context.Context, event *WebhookEvent) error {
+	// 1. 查询订阅了事件的 Webhook
+	webhooks, err := s.webhookRepo.FindByNamespace(ctx, event.NamespaceID, true)
+	if err != nil {
+		return fmt.Errorf("查询 Webhook 失败: %w", err)
+	}
+	matched := make([]*entity.Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		if webhook.Matches(event.Type) {
+			matched = append(matched, webhook)
+		}
+	}
+	if len(matched) == 0 {
+		return nil
+	}
+
+	// 2. 序列化事件
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("序列化 Webhook 事件失败: %w", err)
+	}
+
+	// 3. 写入投递记录
+	deliveries := make([]*entity.WebhookDelivery, 0, len(matched))
+	for _, webhook := range matched {
+		deliveries = append(deliveries, &entity.WebhookDelivery{
+			WebhookID:     webhook.ID,
+			NamespaceID:   event.NamespaceID,
+			EventType:     event.Type,
+			Payload:       string(payload),
+			Status:        entity.WebhookDeliveryPending,
+			NextAttemptAt: event.OccurredAt,
+		})
+	}
+	if err := s.deliveryRepo.Save(ctx, deliveries); err != nil {
+		return fmt.Errorf("保存 Webhook 投递记录失败: %w", err)
+	}
+
+	s.Wake()
+	return nil
+}
+
+// NotifyConfigChange 为配置变更事件写入投递记录（事件类型为 config.<action>）
+func (s *WebhookService) NotifyConfigChange(ctx context.Context, event *listener.ConfigChangeEvent) error {
+	return s.Notify(ctx, &WebhookEvent{
+		Type:        "config." + event.Action,
+		NamespaceID: event.NamespaceID,
+		Environment: event.Environment,
+		Data: &WebhookConfigData{
+			ConfigID: event.ConfigID,
+			Key:      event.ConfigKey,
+		},
+	})
+}
+
+// ==================== 投递记录 ====================
+
+// GetDelivery 根据ID查询投递记录
+// 所属命名空间不属于当前租户时视为不存在
+func (s *WebhookService) GetDelivery(ctx context.Context, id int64) (*entity.WebhookDelivery, error) {
+	delivery, err := s.deliveryRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if delivery == nil {
+		return nil, domainErrors.ErrWebhookDeliveryNotFound(id)
+	}
+	namespace, err := s.namespaceRepo.GetByID(ctx, delivery.NamespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if namespace == nil {
+		return nil, domainErrors.ErrWebhookDeliveryNotFound(id)
+	}
+	return delivery, nil
+}
+
+// QueryDeliveries 分页查询 Webhook 的投递记录
+func (s *WebhookService) QueryDeliveries(ctx context.Context, params *repository.WebhookDeliveryQueryParams) (*shareRepo.PageResult[*entity.WebhookDelivery], error) {
+	if _, err := s.GetWebhook(ctx, params.WebhookID); err != nil {
+		return nil, err
+	}
+	return s.deliveryRepo.Query(ctx, params)
+}
+
+// Redeliver 重新投递
+// 以原投递记录的请求体创建一条新的投递记录，原记录保持不变
+// 业务规则：
+// 1. Webhook 必须存在
+// 2. 原记录必须已结束投递（成功或重试耗尽），避免与进行中的重试重复发送
+func (s *WebhookService) Redeliver(ctx context.Context, deliveryID int64) (*entity.WebhookDelivery, error) {
+	// 1. 查询原投递记录和 Webhook
+	original, err := s.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if !original.IsFinished() {
+		return nil, domainErrors.ErrWebhookInvalid("投递记录尚未结束投递，无法重新投递: status=" + original.Status)
+	}
+	if _, err := s.GetWebhook(ctx, original.WebhookID); err != nil {
+		return nil, err
+	}
+
+	// 2. 创建新的投递记录
+	delivery := &entity.WebhookDelivery{
+		WebhookID:     original.WebhookID,
+		NamespaceID:   original.NamespaceID,
+		EventType:     original.EventType,
+		Payload:       original.Payload,
+		Status:        entity.WebhookDeliveryPending,
+		NextAttemptAt: time.Now(),
+		RedeliveryOf:  &original.ID,
+	}
+	if err := s.deliveryRepo.Save(ctx, []*entity.WebhookDelivery{delivery}); err != nil {
+		return nil, fmt.Errorf("保存 Webhook 投递记录失败: %w", err)
+	}
+
+	hlog.CtxInfof(ctx, "重新投递 Webhook 事件: webhookID=%d, originalID=%d, deliveryID=%d",
+		delivery.WebhookID, original.ID, delivery.ID)
+	s.Wake()
+	return delivery, nil
+}
+
+// ==================== 后台投递 ====================
+
+// Wake 唤醒投递任务立即投递
+func (s *WebhookService) Wake() {
+	select {
+	case s.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+// Start 启动后台投递任务
+func (s *WebhookService) Start() {
+	s.wg.Add(1)
+	go s.run()
+	hlog.Infof("Webhook 投递任务已启动: pollInterval=%v, batchSize=%d, maxAttempts=%d",
+		s.opts.PollInterval, s.opts.BatchSize, s.opts.MaxAttempts)
+}
+
+// Stop 停止后台投递任务（等待进行中的投递完成，未投递的记录在下次启动后继续投递）
+func (s *WebhookService) Stop() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// run 投递循环：定时轮询，或在写入投递记录后被唤醒
+func (s *WebhookService) run() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.opts.PollInterval)
+	defer ticker.Stop()
+	cleanupTicker := time.NewTicker(webhookCleanupInterval)
+	defer cleanupTicker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.wakeCh:
+		case <-cleanupTicker.C:
+			s.cleanup()
+			continue
+		}
+
+		// 一批取满说明仍有积压，继续投递直至清空
+		for s.ctx.Err() == nil {
+			n, err := s.deliverOnce(s.ctx)
+			if err != nil {
+				hlog.Errorf("投递 Webhook 事件失败: %v", err)
+				break
+			}
+			if n < s.opts.BatchSize {
+				break
+			}
+		}
+	}
+}
+
+// deliverOnce 领取并并发投递一批记录，返回领取的记录数
+func (s *WebhookService) deliverOnce(ctx context.Context) (int, error) {
+	// 租约需覆盖请求超时，避免投递中的记录被其他实例重复领取
+	deliveries, err := s.deliveryRepo.ClaimPending(ctx, s.opts.BatchSize, s.opts.Timeout+30*time.Second)
+	if err != nil {
+		return 0, fmt.Errorf("领取 Webhook 投递记录失败: %w", err)
+	}
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+
+	// 同一批内的 Webhook 只查询一次
+	webhooks := make(map[int]*entity.Webhook)
+	for _, delivery := range deliveries {
+		if _, ok := webhooks[delivery.WebhookID]; ok {
+			continue
+		}
+		webhook, err := s.webhookRepo.GetByID(ctx, delivery.WebhookID)
+		if err != nil {
+			return 0, fmt.Errorf("查询 Webhook 失败: id=%d, err=%w", delivery.WebhookID, err)
+		}
+		webhooks[delivery.WebhookID] = webhook
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func(delivery *entity.WebhookDelivery) {
+			defer wg.Done()
+			s.deliver(ctx, webhooks[delivery.WebhookID], delivery)
+		}(delivery)
+	}
+	wg.Wait()
+	return len(deliveries), nil
+}
+
+// deliver 投递单条记录并保存结果
+// 业务规则：
+// 1. 响应状态码为 2xx 时视为成功
+// 2. 失败时按尝试次数指数退避后重试，达到最大尝试次数后标记为失败
+// 3. Webhook 已删除或停用时直接标记为失败
+func (s *WebhookService) deliver(ctx context.Context, webhook *entity.Webhook, delivery *entity.WebhookDelivery) {
+	// 1. 发送请求（ClaimPending 已累加尝试次数）
+	var resp *WebhookResponse
+	var err error
+	start := time.Now()
+	switch {
+	case webhook == nil:
+		err = fmt.Errorf("Webhook 已删除")
+	case !webhook.Enabled:
+		err = fmt.Errorf("Webhook 已停用")
+	default:
+		sendCtx, cancel := context.WithTimeout(ctx, s.opts.Timeout)
+		resp, err = s.sender.Send(sendCtx, &WebhookRequest{
+			URL:        webhook.URL,
+			Secret:     webhook.Secret,
+			EventType:  delivery.EventType,
+			DeliveryID: delivery.ID,
+			Payload:    []byte(delivery.Payload),
+		})
+		cancel()
+	}
+	delivery.DurationMs = time.Since(start).Milliseconds()
+
+	// 2. 记录响应
+	delivery.ResponseStatus = 0
+	delivery.ResponseBody = ""
+	if resp != nil {
+		delivery.ResponseStatus = resp.StatusCode
+		delivery.ResponseBody = truncateUTF8(resp.Body, webhookResponseBodyLimit)
+		if err == nil && (resp.StatusCode < 200 || resp.StatusCode >= 300) {
+			err = fmt.Errorf("响应状态码 %d", resp.StatusCode)
+		}
+	}
+
+	// 3. 更新投递状态
+	delivery.LockedUntil = nil
+	if err == nil {
+		now := time.Now()
+		delivery.Status = entity.WebhookDeliverySucceeded
+		delivery.LastError = ""
+		delivery.DeliveredAt = &now
+	} else {
+		delivery.LastError = err.Error()
+		if webhook == nil || !webhook.Enabled || delivery.Attempts >= s.opts.MaxAttempts {
+			delivery.Status = entity.WebhookDeliveryFailed
+		} else {
+			delivery.Status = entity.WebhookDeliveryPending
+			delivery.NextAttemptAt = time.Now().Add(webhookBackoff(delivery.Attempts))
+		}
+		hlog.Warnf("Webhook 投递失败: deliveryID=%d, webhookID=%d, event=%s, attempts=%d, status=%s, err=%v",
+			delivery.ID, delivery.WebhookID, delivery.EventType, delivery.Attempts, delivery.Status, err)
+	}
+
+	// 4. 保存结果（保存失败时租约到期后会重复投递，接收方可按投递ID去重）
+	if saveErr := s.deliveryRepo.SaveResult(context.WithoutCancel(ctx), delivery); saveErr != nil {
+		hlog.Errorf("保存 Webhook 投递结果失败: deliveryID=%d, err=%v", delivery.ID, saveErr)
+	}
+}
+
+// cleanup 清理超过保留时长的已结束投递记录
+func (s *WebhookService) cleanup() {
+	count, err := s.deliveryRepo.DeleteFinishedBefore(s.ctx, time.Now().Add(-s.opts.Retention))
+	if err != nil {
+		hlog.Errorf("清理 Webhook 投递记录失败: %v", err)
+		return
+	}
+	if count > 0 {
+		hlog.Infof("已清理 %d 条 Webhook 投递记录", count)
+	}
+}
+
+// webhookBackoff 计算第 attempts 次失败后的退避时间：10s, 20s, 40s ... 最长 1 小时
+func webhookBackoff(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	if attempts > 10 {
+		return webhookMaxBackoff
+	}
+	backoff := webhookBaseBackoff << (attempts - 1)
+	if backoff > webhookMaxBackoff {
+		return webhookMaxBackoff
+	}
+	return backoff
+}
+
+// truncateUTF8 按字节截断字符串，不截断多字节字符
+func truncateUTF8(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	for limit > 0 && !utf8.RuneStart(s[limit]) {
+		limit--
+	}
+	return s[:limit]
+}
@@ -0,0 +1,25 @@
+package archive
+
+import (
+	"fmt"
+
+	"config-client/config/domain/repository"
+	"config-client/share/config"
+
+	"gorm.io/gorm"
+)
+
+// NewChangeHistoryArchiver 根据配置创建变更历史归档器
+// type: none（默认，不归档，返回 nil）, table（归档表）, file（JSON Lines 文件）
+func NewChangeHistoryArchiver(cfg config.HistoryArchiveConfig, db *gorm.DB) (repository.ChangeHistoryArchiver, error) {
+	switch cfg.Type {
+	case "", "none":
+		return nil, nil
+	case "table":
+		return NewTableChangeHistoryArchiver(db), nil
+	case "file":
+		return NewFileChangeHistoryArchiver(cfg.Dir)
+	default:
+		return nil, fmt.Errorf("不支持的变更历史归档方式: %s（可选值: none/table/file）", cfg.Type)
+	}
+}
@@ -0,0 +1,67 @@
+package archive
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	domainEntity "config-client/config/domain/entity"
+	"config-client/config/domain/repository"
+)
+
+// FileChangeHistoryArchiver 文件变更历史归档器
+// 按归档日期将变更记录追加写入 dir/change_history_YYYYMMDD.jsonl（每行一条 JSON），
+// 归档文件可由外部任务同步到对象存储（如 S3、OSS）后删除
+type FileChangeHistoryArchiver struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewFileChangeHistoryArchiver 创建文件变更历史归档器（目录不存在时自动创建）
+func NewFileChangeHistoryArchiver(dir string) (*FileChangeHistoryArchiver, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("变更历史归档目录不能为空")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("创建变更历史归档目录失败: %w", err)
+	}
+	return &FileChangeHistoryArchiver{dir: dir}, nil
+}
+
+// Archive 归档变更记录
+// 写入并刷盘成功后才返回，保证记录删除前已落盘
+func (a *FileChangeHistoryArchiver) Archive(ctx context.Context, histories []*domainEntity.ChangeHistory) error {
+	if len(histories) == 0 {
+		return nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	path := filepath.Join(a.dir, "change_history_"+time.Now().Format("20060102")+".jsonl")
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("打开变更历史归档文件失败: %w", err)
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for _, history := range histories {
+		if err := encoder.Encode(history); err != nil {
+			return fmt.Errorf("写入变更历史归档文件失败: %w", err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("写入变更历史归档文件失败: %w", err)
+	}
+	return file.Sync()
+}
+
+// 确保实现了接口
+var _ repository.ChangeHistoryArchiver = (*FileChangeHistoryArchiver)(nil)
@@ -0,0 +1,42 @@
+package archive
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	domainEntity "config-client/config/domain/entity"
+	"config-client/config/domain/repository"
+	"config-client/config/infrastructure/converter"
+	gormRepo "config-client/share/repository/gorm"
+)
+
+// TableChangeHistoryArchiver 归档表变更历史归档器
+// 将变更记录写入 t_change_history_archive，上下文中存在事务时与删除操作在同一事务内完成
+type TableChangeHistoryArchiver struct {
+	db        *gorm.DB
+	converter *converter.ChangeHistoryConverter
+}
+
+// NewTableChangeHistoryArchiver 创建归档表变更历史归档器
+func NewTableChangeHistoryArchiver(db *gorm.DB) *TableChangeHistoryArchiver {
+	return &TableChangeHistoryArchiver{
+		db:        db,
+		converter: converter.NewChangeHistoryConverter(),
+	}
+}
+
+// Archive 归档变更记录（原记录ID已归档时忽略，重复执行不会报错）
+func (a *TableChangeHistoryArchiver) Archive(ctx context.Context, histories []*domainEntity.ChangeHistory) error {
+	if len(histories) == 0 {
+		return nil
+	}
+	pos := a.converter.ToArchivePOList(histories)
+	return gormRepo.GetDB(ctx, a.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(pos).Error
+}
+
+// 确保实现了接口
+var _ repository.ChangeHistoryArchiver = (*TableChangeHistoryArchiver)(nil)
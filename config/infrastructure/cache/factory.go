@@ -0,0 +1,26 @@
+package cache
+
+import (
+	"fmt"
+
+	"config-client/config/domain/cache"
+	"config-client/share/config"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// NewConfigCache 根据配置创建配置读取缓存
+// backend: memory（默认，进程内）, redis（多实例共享）
+func NewConfigCache(cfg config.ConfigCacheConfig, rdb redis.UniversalClient) (cache.ConfigCache, error) {
+	switch cfg.Backend {
+	case "", "memory":
+		return NewMemoryConfigCache(cfg.GetTTL(), cfg.MaxEntries), nil
+	case "redis":
+		if rdb == nil {
+			return nil, fmt.Errorf("配置读取缓存存储为 redis，但 Redis 已禁用")
+		}
+		return NewRedisConfigCache(rdb, cfg.GetTTL()), nil
+	default:
+		return nil, fmt.Errorf("不支持的配置读取缓存存储: %s（可选值: memory/redis）", cfg.Backend)
+	}
+}
@@ -0,0 +1,123 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"config-client/config/domain/cache"
+)
+
+// MemoryConfigCache 进程内配置读取缓存（仅对当前实例生效）
+// 条目数达到上限时先回收过期条目，仍然超出则随机淘汰
+type MemoryConfigCache struct {
+	mu         sync.Mutex
+	entries    map[string]map[string]*cache.CachedConfig // 命名空间ID:配置键 -> 环境 -> 缓存
+	size       int                                       // 当前条目数
+	ttl        time.Duration                             // 缓存有效期
+	maxEntries int                                       // 最大条目数（<=0 表示不限制）
+}
+
+// NewMemoryConfigCache 创建进程内配置读取缓存
+func NewMemoryConfigCache(ttl time.Duration, maxEntries int) *MemoryConfigCache {
+	return &MemoryConfigCache{
+		entries:    make(map[string]map[string]*cache.CachedConfig),
+		ttl:        ttl,
+		maxEntries: maxEntries,
+	}
+}
+
+// Get 查询缓存
+func (c *MemoryConfigCache) Get(ctx context.Context, namespaceID int, configKey string, environment string) (*cache.CachedConfig, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := c.entries[memoryCacheKey(namespaceID, configKey)][environment]
+	if entry == nil || c.expired(entry, time.Now()) {
+		return nil, nil
+	}
+	return entry, nil
+}
+
+// Set 写入缓存
+func (c *MemoryConfigCache) Set(ctx context.Context, namespaceID int, configKey string, environment string, config *cache.CachedConfig) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := memoryCacheKey(namespaceID, configKey)
+	envs := c.entries[key]
+	if envs == nil {
+		envs = make(map[string]*cache.CachedConfig)
+		c.entries[key] = envs
+	}
+	if _, exists := envs[environment]; !exists {
+		if c.maxEntries > 0 && c.size >= c.maxEntries {
+			c.evict()
+		}
+		c.size++
+	}
+	envs[environment] = config
+	return nil
+}
+
+// Invalidate 失效缓存
+func (c *MemoryConfigCache) Invalidate(ctx context.Context, namespaceID int, configKey string, environment string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := memoryCacheKey(namespaceID, configKey)
+	envs, exists := c.entries[key]
+	if !exists {
+		return nil
+	}
+	if environment == "" {
+		c.size -= len(envs)
+		delete(c.entries, key)
+		return nil
+	}
+	if _, exists := envs[environment]; exists {
+		delete(envs, environment)
+		c.size--
+	}
+	if len(envs) == 0 {
+		delete(c.entries, key)
+	}
+	return nil
+}
+
+// evict 回收过期条目，仍然达到上限时随机淘汰一个配置键的缓存（调用方需持有锁）
+func (c *MemoryConfigCache) evict() {
+	now := time.Now()
+	for key, envs := range c.entries {
+		for environment, entry := range envs {
+			if c.expired(entry, now) {
+				delete(envs, environment)
+				c.size--
+			}
+		}
+		if len(envs) == 0 {
+			delete(c.entries, key)
+		}
+	}
+	if c.size < c.maxEntries {
+		return
+	}
+	for key, envs := range c.entries {
+		c.size -= len(envs)
+		delete(c.entries, key)
+		if c.size < c.maxEntries {
+			return
+		}
+	}
+}
+
+// expired 缓存是否已过期
+func (c *MemoryConfigCache) expired(entry *cache.CachedConfig, now time.Time) bool {
+	return c.ttl > 0 && now.Sub(entry.CachedAt) >= c.ttl
+}
+
+// memoryCacheKey 缓存键
+func memoryCacheKey(namespaceID int, configKey string) string {
+	return fmt.Sprintf("%d:%s", namespaceID, configKey)
+}
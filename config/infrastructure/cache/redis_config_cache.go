@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"config-client/config/domain/cache"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// configCacheKeyPrefix Redis 配置读取缓存键前缀
+const configCacheKeyPrefix = "config:cache:"
+
+// RedisConfigCache 基于 Redis 的配置读取缓存（多实例共享）
+// 每个配置键对应一个 Hash，字段为环境，值为 JSON 编码的缓存内容，便于按配置键一次失效所有环境
+type RedisConfigCache struct {
+	client redis.UniversalClient
+	ttl    time.Duration
+}
+
+// NewRedisConfigCache 创建 Redis 配置读取缓存
+func NewRedisConfigCache(client redis.UniversalClient, ttl time.Duration) *RedisConfigCache {
+	return &RedisConfigCache{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+// Get 查询缓存
+// Hash 的过期时间随写入刷新，各环境的有效期按写入时间单独判断
+func (c *RedisConfigCache) Get(ctx context.Context, namespaceID int, configKey string, environment string) (*cache.CachedConfig, error) {
+	raw, err := c.client.HGet(ctx, c.redisKey(namespaceID, configKey), environment).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entry cache.CachedConfig
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return nil, err
+	}
+	if c.ttl > 0 && time.Since(entry.CachedAt) >= c.ttl {
+		return nil, nil
+	}
+	return &entry, nil
+}
+
+// Set 写入缓存
+func (c *RedisConfigCache) Set(ctx context.Context, namespaceID int, configKey string, environment string, config *cache.CachedConfig) error {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	key := c.redisKey(namespaceID, configKey)
+	pipe := c.client.TxPipeline()
+	pipe.HSet(ctx, key, environment, data)
+	if c.ttl > 0 {
+		pipe.Expire(ctx, key, c.ttl)
+	}
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// Invalidate 失效缓存
+func (c *RedisConfigCache) Invalidate(ctx context.Context, namespaceID int, configKey string, environment string) error {
+	key := c.redisKey(namespaceID, configKey)
+	if environment == "" {
+		return c.client.Del(ctx, key).Err()
+	}
+	return c.client.HDel(ctx, key, environment).Err()
+}
+
+// redisKey 缓存键
+func (c *RedisConfigCache) redisKey(namespaceID int, configKey string) string {
+	return fmt.Sprintf("%s%d:%s", configCacheKeyPrefix, namespaceID, configKey)
+}
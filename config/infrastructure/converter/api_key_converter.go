@@ -0,0 +1,84 @@
+package converter
+
+import (
+	"strings"
+
+	domainEntity "config-client/config/domain/entity"
+	infraEntity "config-client/config/infrastructure/entity"
+)
+
+// APIKeyConverter API Key 转换器，负责领域实体和持久化对象之间的转换
+type APIKeyConverter struct{}
+
+// NewAPIKeyConverter 创建 API Key 转换器实例
+func NewAPIKeyConverter() *APIKeyConverter {
+	return &APIKeyConverter{}
+}
+
+// ToDO 将持久化对象转换为领域实体（PO -> DO）
+func (c *APIKeyConverter) ToDO(po *infraEntity.APIKeyPO) *domainEntity.APIKey {
+	if po == nil {
+		return nil
+	}
+
+	var keyPrefixes []string
+	if po.KeyPrefixes != "" {
+		keyPrefixes = strings.Split(po.KeyPrefixes, ",")
+	}
+
+	return &domainEntity.APIKey{
+		ID:          po.ID,
+		TenantID:    po.TenantID,
+		Name:        po.Name,
+		Prefix:      po.Prefix,
+		KeyHash:     po.KeyHash,
+		Role:        po.Role,
+		NamespaceID: po.NamespaceID,
+		Environment: po.Environment,
+		KeyPrefixes: keyPrefixes,
+		Description: po.Description,
+		ExpiresAt:   po.ExpiresAt,
+		LastUsedAt:  po.LastUsedAt,
+		RevokedAt:   po.RevokedAt,
+		RevokedBy:   po.RevokedBy,
+		CreatedBy:   po.CreatedBy,
+		CreatedAt:   po.CreatedAt,
+		UpdatedAt:   po.UpdatedAt,
+	}
+}
+
+// ToPO 将领域实体转换为持久化对象（DO -> PO）
+func (c *APIKeyConverter) ToPO(do *domainEntity.APIKey) *infraEntity.APIKeyPO {
+	if do == nil {
+		return nil
+	}
+
+	return &infraEntity.APIKeyPO{
+		ID:          do.ID,
+		TenantID:    do.TenantID,
+		Name:        do.Name,
+		Prefix:      do.Prefix,
+		KeyHash:     do.KeyHash,
+		Role:        do.Role,
+		NamespaceID: do.NamespaceID,
+		Environment: do.Environment,
+		KeyPrefixes: strings.Join(do.KeyPrefixes, ","),
+		Description: do.Description,
+		ExpiresAt:   do.ExpiresAt,
+		LastUsedAt:  do.LastUsedAt,
+		RevokedAt:   do.RevokedAt,
+		RevokedBy:   do.RevokedBy,
+		CreatedBy:   do.CreatedBy,
+		CreatedAt:   do.CreatedAt,
+		UpdatedAt:   do.UpdatedAt,
+	}
+}
+
+// ToDOList 批量转换为领域实体
+func (c *APIKeyConverter) ToDOList(pos []*infraEntity.APIKeyPO) []*domainEntity.APIKey {
+	result := make([]*domainEntity.APIKey, 0, len(pos))
+	for _, po := range pos {
+		result = append(result, c.ToDO(po))
+	}
+	return result
+}
@@ -0,0 +1,63 @@
+package converter
+
+import (
+	domainEntity "config-client/config/domain/entity"
+	infraEntity "config-client/config/infrastructure/entity"
+)
+
+// ChangeEventConverter 配置变更事件转换器，负责领域实体和持久化对象之间的转换
+type ChangeEventConverter struct{}
+
+// NewChangeEventConverter 创建配置变更事件转换器实例
+func NewChangeEventConverter() *ChangeEventConverter {
+	return &ChangeEventConverter{}
+}
+
+// ToDO 将持久化对象转换为领域实体（PO -> DO）
+func (c *ChangeEventConverter) ToDO(po *infraEntity.ChangeEventPO) *domainEntity.ChangeEvent {
+	if po == nil {
+		return nil
+	}
+
+	return &domainEntity.ChangeEvent{
+		ID:          po.ID,
+		NamespaceID: po.NamespaceID,
+		Sequence:    po.Sequence,
+		ConfigKey:   po.ConfigKey,
+		ConfigID:    po.ConfigID,
+		Environment: po.Environment,
+		Action:      po.Action,
+		CreatedAt:   po.CreatedAt,
+	}
+}
+
+// ToPO 将领域实体转换为持久化对象（DO -> PO）
+func (c *ChangeEventConverter) ToPO(do *domainEntity.ChangeEvent) *infraEntity.ChangeEventPO {
+	if do == nil {
+		return nil
+	}
+
+	return &infraEntity.ChangeEventPO{
+		ID:          do.ID,
+		NamespaceID: do.NamespaceID,
+		Sequence:    do.Sequence,
+		ConfigKey:   do.ConfigKey,
+		ConfigID:    do.ConfigID,
+		Environment: do.Environment,
+		Action:      do.Action,
+		CreatedAt:   do.CreatedAt,
+	}
+}
+
+// ToDOList 批量转换 PO -> DO
+func (c *ChangeEventConverter) ToDOList(pos []*infraEntity.ChangeEventPO) []*domainEntity.ChangeEvent {
+	if len(pos) == 0 {
+		return []*domainEntity.ChangeEvent{}
+	}
+
+	dos := make([]*domainEntity.ChangeEvent, 0, len(pos))
+	for _, po := range pos {
+		dos = append(dos, c.ToDO(po))
+	}
+	return dos
+}
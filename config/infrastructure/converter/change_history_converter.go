@@ -106,3 +106,29 @@ func (c *ChangeHistoryConverter) ToPOList(dos []*domainEntity.ChangeHistory) []*
 	}
 	return pos
 }
+
+// ToArchivePOList 将领域实体批量转换为归档持久化对象（保留原记录ID）
+func (c *ChangeHistoryConverter) ToArchivePOList(dos []*domainEntity.ChangeHistory) []*infraEntity.ChangeHistoryArchivePO {
+	pos := make([]*infraEntity.ChangeHistoryArchivePO, 0, len(dos))
+	for _, do := range dos {
+		po := c.ToPO(do)
+		pos = append(pos, &infraEntity.ChangeHistoryArchivePO{
+			ID:           po.ID,
+			ConfigID:     po.ConfigID,
+			NamespaceID:  po.NamespaceID,
+			ConfigKey:    po.ConfigKey,
+			Environment:  po.Environment,
+			Operation:    po.Operation,
+			OldValue:     po.OldValue,
+			NewValue:     po.NewValue,
+			OldVersion:   po.OldVersion,
+			NewVersion:   po.NewVersion,
+			Operator:     po.Operator,
+			OperatorIP:   po.OperatorIP,
+			ChangeReason: po.ChangeReason,
+			CreatedAt:    po.CreatedAt,
+			Metadata:     po.Metadata,
+		})
+	}
+	return pos
+}
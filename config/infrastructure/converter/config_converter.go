@@ -20,6 +20,7 @@ func (c *ConfigConverter) ToDO(po *infraEntity.ConfigPO) *domainEntity.Config {
 	}
 
 	config := &domainEntity.Config{
+		TenantID:             po.TenantID,
 		NamespaceID:          po.NamespaceID,
 		Key:                  po.Key,
 		Value:                po.Value,
@@ -32,6 +33,7 @@ func (c *ConfigConverter) ToDO(po *infraEntity.ConfigPO) *domainEntity.Config {
 		Metadata:             po.Metadata,
 		ContentHash:          po.ContentHash,
 		ContentHashAlgorithm: po.ContentHashAlgorithm,
+		ExpiresAt:            po.ExpiresAt,
 	}
 
 	// 设置 BaseEntity 字段
@@ -63,6 +65,7 @@ func (c *ConfigConverter) ToPO(do *domainEntity.Config) *infraEntity.ConfigPO {
 		DeletedAt: do.DeletedAt,
 
 		// 业务字段
+		TenantID:             do.TenantID,
 		NamespaceID:          do.NamespaceID,
 		Key:                  do.Key,
 		Value:                do.Value,
@@ -75,6 +78,7 @@ func (c *ConfigConverter) ToPO(do *domainEntity.Config) *infraEntity.ConfigPO {
 		Metadata:             do.Metadata,
 		ContentHash:          do.ContentHash,
 		ContentHashAlgorithm: do.ContentHashAlgorithm,
+		ExpiresAt:            do.ExpiresAt,
 	}
 
 	// 同步软删除状态：如果 DeletedAt 有效，设置 IsDeleted = true
@@ -0,0 +1,66 @@
+package converter
+
+import (
+	domainEntity "config-client/config/domain/entity"
+	infraEntity "config-client/config/infrastructure/entity"
+)
+
+// ConfigDependencyConverter 配置依赖转换器，负责领域实体和持久化对象之间的转换
+type ConfigDependencyConverter struct{}
+
+// NewConfigDependencyConverter 创建配置依赖转换器实例
+func NewConfigDependencyConverter() *ConfigDependencyConverter {
+	return &ConfigDependencyConverter{}
+}
+
+// ToDO 将持久化对象转换为领域实体（PO -> DO）
+func (c *ConfigDependencyConverter) ToDO(po *infraEntity.ConfigDependencyPO) *domainEntity.ConfigDependency {
+	if po == nil {
+		return nil
+	}
+
+	return &domainEntity.ConfigDependency{
+		ID:                po.ID,
+		ConfigID:          po.ConfigID,
+		DependsOnConfigID: po.DependsOnConfigID,
+		CreatedBy:         po.CreatedBy,
+		CreatedAt:         po.CreatedAt,
+	}
+}
+
+// ToPO 将领域实体转换为持久化对象（DO -> PO）
+func (c *ConfigDependencyConverter) ToPO(do *domainEntity.ConfigDependency) *infraEntity.ConfigDependencyPO {
+	if do == nil {
+		return nil
+	}
+
+	return &infraEntity.ConfigDependencyPO{
+		ID:                do.ID,
+		ConfigID:          do.ConfigID,
+		DependsOnConfigID: do.DependsOnConfigID,
+		CreatedBy:         do.CreatedBy,
+		CreatedAt:         do.CreatedAt,
+	}
+}
+
+// ToDOList 批量转换为领域实体列表
+func (c *ConfigDependencyConverter) ToDOList(pos []*infraEntity.ConfigDependencyPO) []*domainEntity.ConfigDependency {
+	if len(pos) == 0 {
+		return []*domainEntity.ConfigDependency{}
+	}
+
+	dos := make([]*domainEntity.ConfigDependency, len(pos))
+	for i, po := range pos {
+		dos[i] = c.ToDO(po)
+	}
+	return dos
+}
+
+// ToPOList 批量转换为持久化对象列表
+func (c *ConfigDependencyConverter) ToPOList(dos []*domainEntity.ConfigDependency) []*infraEntity.ConfigDependencyPO {
+	pos := make([]*infraEntity.ConfigDependencyPO, len(dos))
+	for i, do := range dos {
+		pos[i] = c.ToPO(do)
+	}
+	return pos
+}
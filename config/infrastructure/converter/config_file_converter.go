@@ -0,0 +1,52 @@
+package converter
+
+import (
+	domainEntity "config-client/config/domain/entity"
+	infraEntity "config-client/config/infrastructure/entity"
+)
+
+// ConfigFileConverter 文件配置内容转换器，负责领域实体和持久化对象之间的转换
+type ConfigFileConverter struct{}
+
+// NewConfigFileConverter 创建文件配置内容转换器实例
+func NewConfigFileConverter() *ConfigFileConverter {
+	return &ConfigFileConverter{}
+}
+
+// ToDO 将持久化对象转换为领域实体（PO -> DO）
+func (c *ConfigFileConverter) ToDO(po *infraEntity.ConfigFilePO) *domainEntity.ConfigFile {
+	if po == nil {
+		return nil
+	}
+
+	return &domainEntity.ConfigFile{
+		ID:          po.ID,
+		ConfigID:    po.ConfigID,
+		SHA256:      po.SHA256,
+		FileName:    po.FileName,
+		ContentType: po.ContentType,
+		Size:        po.Size,
+		Content:     po.Content,
+		CreatedBy:   po.CreatedBy,
+		CreatedAt:   po.CreatedAt,
+	}
+}
+
+// ToPO 将领域实体转换为持久化对象（DO -> PO）
+func (c *ConfigFileConverter) ToPO(do *domainEntity.ConfigFile) *infraEntity.ConfigFilePO {
+	if do == nil {
+		return nil
+	}
+
+	return &infraEntity.ConfigFilePO{
+		ID:          do.ID,
+		ConfigID:    do.ConfigID,
+		SHA256:      do.SHA256,
+		FileName:    do.FileName,
+		ContentType: do.ContentType,
+		Size:        do.Size,
+		Content:     do.Content,
+		CreatedBy:   do.CreatedBy,
+		CreatedAt:   do.CreatedAt,
+	}
+}
@@ -0,0 +1,76 @@
+package converter
+
+import (
+	domainEntity "config-client/config/domain/entity"
+	infraEntity "config-client/config/infrastructure/entity"
+)
+
+// ConfigGroupConverter 配置分组转换器，负责领域实体和持久化对象之间的转换
+type ConfigGroupConverter struct{}
+
+// NewConfigGroupConverter 创建配置分组转换器实例
+func NewConfigGroupConverter() *ConfigGroupConverter {
+	return &ConfigGroupConverter{}
+}
+
+// ToDO 将持久化对象转换为领域实体（PO -> DO）
+func (c *ConfigGroupConverter) ToDO(po *infraEntity.ConfigGroupPO) *domainEntity.ConfigGroup {
+	if po == nil {
+		return nil
+	}
+
+	group := &domainEntity.ConfigGroup{
+		NamespaceID: po.NamespaceID,
+		Name:        po.Name,
+		Description: po.Description,
+		SortOrder:   po.SortOrder,
+	}
+
+	// 设置 BaseEntity 字段
+	group.ID = po.ID
+	group.Version = po.Version
+	group.CreatedBy = po.CreatedBy
+	group.UpdatedBy = po.UpdatedBy
+	group.CreatedAt = po.CreatedAt
+	group.UpdatedAt = po.UpdatedAt
+	group.DeletedAt = po.DeletedAt
+
+	return group
+}
+
+// ToPO 将领域实体转换为持久化对象（DO -> PO）
+func (c *ConfigGroupConverter) ToPO(do *domainEntity.ConfigGroup) *infraEntity.ConfigGroupPO {
+	if do == nil {
+		return nil
+	}
+
+	return &infraEntity.ConfigGroupPO{
+		// BaseEntity 字段
+		ID:        do.ID,
+		Version:   do.Version,
+		CreatedBy: do.CreatedBy,
+		UpdatedBy: do.UpdatedBy,
+		CreatedAt: do.CreatedAt,
+		UpdatedAt: do.UpdatedAt,
+		DeletedAt: do.DeletedAt,
+
+		// 业务字段
+		NamespaceID: do.NamespaceID,
+		Name:        do.Name,
+		Description: do.Description,
+		SortOrder:   do.SortOrder,
+	}
+}
+
+// ToDOList 批量转换为领域实体列表
+func (c *ConfigGroupConverter) ToDOList(pos []*infraEntity.ConfigGroupPO) []*domainEntity.ConfigGroup {
+	if len(pos) == 0 {
+		return []*domainEntity.ConfigGroup{}
+	}
+
+	dos := make([]*domainEntity.ConfigGroup, len(pos))
+	for i, po := range pos {
+		dos[i] = c.ToDO(po)
+	}
+	return dos
+}
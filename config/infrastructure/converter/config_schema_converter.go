@@ -0,0 +1,80 @@
+package converter
+
+import (
+	domainEntity "config-client/config/domain/entity"
+	infraEntity "config-client/config/infrastructure/entity"
+)
+
+// ConfigSchemaConverter 配置 Schema 转换器，负责领域实体和持久化对象之间的转换
+type ConfigSchemaConverter struct{}
+
+// NewConfigSchemaConverter 创建配置 Schema 转换器实例
+func NewConfigSchemaConverter() *ConfigSchemaConverter {
+	return &ConfigSchemaConverter{}
+}
+
+// ToDO 将持久化对象转换为领域实体（PO -> DO）
+func (c *ConfigSchemaConverter) ToDO(po *infraEntity.ConfigSchemaPO) *domainEntity.ConfigSchema {
+	if po == nil {
+		return nil
+	}
+
+	schema := &domainEntity.ConfigSchema{
+		NamespaceID: po.NamespaceID,
+		ConfigKey:   po.ConfigKey,
+		GroupName:   po.GroupName,
+		Schema:      po.Schema,
+		Description: po.Description,
+		IsActive:    po.IsActive,
+	}
+
+	// 设置 BaseEntity 字段
+	schema.ID = po.ID
+	schema.Version = po.Version
+	schema.CreatedBy = po.CreatedBy
+	schema.UpdatedBy = po.UpdatedBy
+	schema.CreatedAt = po.CreatedAt
+	schema.UpdatedAt = po.UpdatedAt
+	schema.DeletedAt = po.DeletedAt
+
+	return schema
+}
+
+// ToPO 将领域实体转换为持久化对象（DO -> PO）
+func (c *ConfigSchemaConverter) ToPO(do *domainEntity.ConfigSchema) *infraEntity.ConfigSchemaPO {
+	if do == nil {
+		return nil
+	}
+
+	return &infraEntity.ConfigSchemaPO{
+		// BaseEntity 字段
+		ID:        do.ID,
+		Version:   do.Version,
+		CreatedBy: do.CreatedBy,
+		UpdatedBy: do.UpdatedBy,
+		CreatedAt: do.CreatedAt,
+		UpdatedAt: do.UpdatedAt,
+		DeletedAt: do.DeletedAt,
+
+		// 业务字段
+		NamespaceID: do.NamespaceID,
+		ConfigKey:   do.ConfigKey,
+		GroupName:   do.GroupName,
+		Schema:      do.Schema,
+		Description: do.Description,
+		IsActive:    do.IsActive,
+	}
+}
+
+// ToDOList 批量转换为领域实体列表
+func (c *ConfigSchemaConverter) ToDOList(pos []*infraEntity.ConfigSchemaPO) []*domainEntity.ConfigSchema {
+	if len(pos) == 0 {
+		return []*domainEntity.ConfigSchema{}
+	}
+
+	dos := make([]*domainEntity.ConfigSchema, len(pos))
+	for i, po := range pos {
+		dos[i] = c.ToDO(po)
+	}
+	return dos
+}
@@ -20,11 +20,18 @@ func (c *NamespaceConverter) ToDO(po *infraEntity.NamespacePO) *domainEntity.Nam
 	}
 
 	namespace := &domainEntity.Namespace{
+		TenantID:    po.TenantID,
 		Name:        po.Name,
 		DisplayName: po.DisplayName,
 		Description: po.Description,
 		IsActive:    po.IsActive,
 		Metadata:    po.Metadata,
+		Quota: domainEntity.NamespaceQuota{
+			MaxConfigs:          po.MaxConfigs,
+			MaxValueSize:        po.MaxValueSize,
+			MaxSubscribers:      po.MaxSubscribers,
+			MaxReleasesRetained: po.MaxReleasesRetained,
+		},
 	}
 
 	// 设置 BaseEntity 字段
@@ -54,11 +61,18 @@ func (c *NamespaceConverter) ToPO(do *domainEntity.Namespace) *infraEntity.Names
 		DeletedAt: do.DeletedAt,
 
 		// 业务字段
+		TenantID:    do.TenantID,
 		Name:        do.Name,
 		DisplayName: do.DisplayName,
 		Description: do.Description,
 		IsActive:    do.IsActive,
 		Metadata:    do.Metadata,
+
+		// 配额
+		MaxConfigs:          do.Quota.MaxConfigs,
+		MaxValueSize:        do.Quota.MaxValueSize,
+		MaxSubscribers:      do.Quota.MaxSubscribers,
+		MaxReleasesRetained: do.Quota.MaxReleasesRetained,
 	}
 
 	// 同步软删除状态：如果 DeletedAt 有效，设置 IsDeleted = true
@@ -0,0 +1,75 @@
+package converter
+
+import (
+	"strings"
+
+	domainEntity "config-client/config/domain/entity"
+	infraEntity "config-client/config/infrastructure/entity"
+)
+
+// NotificationChannelConverter 通知渠道转换器，负责领域实体和持久化对象之间的转换
+type NotificationChannelConverter struct{}
+
+// NewNotificationChannelConverter 创建通知渠道转换器实例
+func NewNotificationChannelConverter() *NotificationChannelConverter {
+	return &NotificationChannelConverter{}
+}
+
+// ToDO 将持久化对象转换为领域实体（PO -> DO）
+func (c *NotificationChannelConverter) ToDO(po *infraEntity.NotificationChannelPO) *domainEntity.NotificationChannel {
+	if po == nil {
+		return nil
+	}
+
+	var events []string
+	if po.Events != "" {
+		events = strings.Split(po.Events, ",")
+	}
+	return &domainEntity.NotificationChannel{
+		ID:          po.ID,
+		NamespaceID: po.NamespaceID,
+		Name:        po.Name,
+		Type:        po.Type,
+		Target:      po.Target,
+		Secret:      po.Secret,
+		Events:      events,
+		Template:    po.Template,
+		Enabled:     po.Enabled,
+		CreatedBy:   po.CreatedBy,
+		UpdatedBy:   po.UpdatedBy,
+		CreatedAt:   po.CreatedAt,
+		UpdatedAt:   po.UpdatedAt,
+	}
+}
+
+// ToPO 将领域实体转换为持久化对象（DO -> PO）
+func (c *NotificationChannelConverter) ToPO(do *domainEntity.NotificationChannel) *infraEntity.NotificationChannelPO {
+	if do == nil {
+		return nil
+	}
+
+	return &infraEntity.NotificationChannelPO{
+		ID:          do.ID,
+		NamespaceID: do.NamespaceID,
+		Name:        do.Name,
+		Type:        do.Type,
+		Target:      do.Target,
+		Secret:      do.Secret,
+		Events:      strings.Join(do.Events, ","),
+		Template:    do.Template,
+		Enabled:     do.Enabled,
+		CreatedBy:   do.CreatedBy,
+		UpdatedBy:   do.UpdatedBy,
+		CreatedAt:   do.CreatedAt,
+		UpdatedAt:   do.UpdatedAt,
+	}
+}
+
+// ToDOList 批量转换为领域实体
+func (c *NotificationChannelConverter) ToDOList(pos []*infraEntity.NotificationChannelPO) []*domainEntity.NotificationChannel {
+	result := make([]*domainEntity.NotificationChannel, 0, len(pos))
+	for _, po := range pos {
+		result = append(result, c.ToDO(po))
+	}
+	return result
+}
@@ -0,0 +1,78 @@
+package converter
+
+import (
+	domainEntity "config-client/config/domain/entity"
+	infraEntity "config-client/config/infrastructure/entity"
+)
+
+// OutboxEventConverter 发件箱事件转换器，负责领域实体和持久化对象之间的转换
+type OutboxEventConverter struct{}
+
+// NewOutboxEventConverter 创建发件箱事件转换器实例
+func NewOutboxEventConverter() *OutboxEventConverter {
+	return &OutboxEventConverter{}
+}
+
+// ToDO 将持久化对象转换为领域实体（PO -> DO）
+func (c *OutboxEventConverter) ToDO(po *infraEntity.OutboxEventPO) *domainEntity.OutboxEvent {
+	if po == nil {
+		return nil
+	}
+
+	return &domainEntity.OutboxEvent{
+		ID:            po.ID,
+		Payload:       po.Payload,
+		Status:        po.Status,
+		Attempts:      po.Attempts,
+		LastError:     po.LastError,
+		NextAttemptAt: po.NextAttemptAt,
+		LockedUntil:   po.LockedUntil,
+		CreatedAt:     po.CreatedAt,
+		SentAt:        po.SentAt,
+	}
+}
+
+// ToPO 将领域实体转换为持久化对象（DO -> PO）
+func (c *OutboxEventConverter) ToPO(do *domainEntity.OutboxEvent) *infraEntity.OutboxEventPO {
+	if do == nil {
+		return nil
+	}
+
+	return &infraEntity.OutboxEventPO{
+		ID:            do.ID,
+		Payload:       do.Payload,
+		Status:        do.Status,
+		Attempts:      do.Attempts,
+		LastError:     do.LastError,
+		NextAttemptAt: do.NextAttemptAt,
+		LockedUntil:   do.LockedUntil,
+		CreatedAt:     do.CreatedAt,
+		SentAt:        do.SentAt,
+	}
+}
+
+// ToDOList 批量转换 PO -> DO
+func (c *OutboxEventConverter) ToDOList(pos []*infraEntity.OutboxEventPO) []*domainEntity.OutboxEvent {
+	if len(pos) == 0 {
+		return []*domainEntity.OutboxEvent{}
+	}
+
+	dos := make([]*domainEntity.OutboxEvent, 0, len(pos))
+	for _, po := range pos {
+		dos = append(dos, c.ToDO(po))
+	}
+	return dos
+}
+
+// ToPOList 批量转换 DO -> PO
+func (c *OutboxEventConverter) ToPOList(dos []*domainEntity.OutboxEvent) []*infraEntity.OutboxEventPO {
+	if len(dos) == 0 {
+		return []*infraEntity.OutboxEventPO{}
+	}
+
+	pos := make([]*infraEntity.OutboxEventPO, 0, len(dos))
+	for _, do := range dos {
+		pos = append(pos, c.ToPO(do))
+	}
+	return pos
+}
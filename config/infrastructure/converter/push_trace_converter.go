@@ -0,0 +1,80 @@
+package converter
+
+import (
+	domainEntity "config-client/config/domain/entity"
+	infraEntity "config-client/config/infrastructure/entity"
+)
+
+// PushTraceConverter 通知下发记录转换器，负责领域实体和持久化对象之间的转换
+type PushTraceConverter struct{}
+
+// NewPushTraceConverter 创建通知下发记录转换器实例
+func NewPushTraceConverter() *PushTraceConverter {
+	return &PushTraceConverter{}
+}
+
+// ToDO 将持久化对象转换为领域实体（PO -> DO）
+func (c *PushTraceConverter) ToDO(po *infraEntity.PushTracePO) *domainEntity.PushTrace {
+	if po == nil {
+		return nil
+	}
+
+	return &domainEntity.PushTrace{
+		ID:             po.ID,
+		SubscriptionID: po.SubscriptionID,
+		ClientID:       po.ClientID,
+		NamespaceID:    po.NamespaceID,
+		Environment:    po.Environment,
+		ConfigKey:      po.ConfigKey,
+		Version:        po.Version,
+		ReleaseID:      po.ReleaseID,
+		Sequence:       po.Sequence,
+		TraceID:        po.TraceID,
+		Source:         po.Source,
+		PushedAt:       po.PushedAt,
+		Acked:          po.Acked,
+		AckedAt:        po.AckedAt,
+	}
+}
+
+// ToPO 将领域实体转换为持久化对象（DO -> PO）
+func (c *PushTraceConverter) ToPO(do *domainEntity.PushTrace) *infraEntity.PushTracePO {
+	if do == nil {
+		return nil
+	}
+
+	return &infraEntity.PushTracePO{
+		ID:             do.ID,
+		SubscriptionID: do.SubscriptionID,
+		ClientID:       do.ClientID,
+		NamespaceID:    do.NamespaceID,
+		Environment:    do.Environment,
+		ConfigKey:      do.ConfigKey,
+		Version:        do.Version,
+		ReleaseID:      do.ReleaseID,
+		Sequence:       do.Sequence,
+		TraceID:        do.TraceID,
+		Source:         do.Source,
+		PushedAt:       do.PushedAt,
+		Acked:          do.Acked,
+		AckedAt:        do.AckedAt,
+	}
+}
+
+// ToDOList 批量转换为领域实体
+func (c *PushTraceConverter) ToDOList(pos []*infraEntity.PushTracePO) []*domainEntity.PushTrace {
+	result := make([]*domainEntity.PushTrace, 0, len(pos))
+	for _, po := range pos {
+		result = append(result, c.ToDO(po))
+	}
+	return result
+}
+
+// ToPOList 批量转换为持久化对象
+func (c *PushTraceConverter) ToPOList(dos []*domainEntity.PushTrace) []*infraEntity.PushTracePO {
+	result := make([]*infraEntity.PushTracePO, 0, len(dos))
+	for _, do := range dos {
+		result = append(result, c.ToPO(do))
+	}
+	return result
+}
@@ -0,0 +1,61 @@
+package converter
+
+import (
+	domainEntity "config-client/config/domain/entity"
+	infraEntity "config-client/config/infrastructure/entity"
+)
+
+// ReleaseApprovalConverter 发布审批记录转换器，负责领域实体和持久化对象之间的转换
+type ReleaseApprovalConverter struct{}
+
+// NewReleaseApprovalConverter 创建发布审批记录转换器实例
+func NewReleaseApprovalConverter() *ReleaseApprovalConverter {
+	return &ReleaseApprovalConverter{}
+}
+
+// ToDO 将持久化对象转换为领域实体（PO -> DO）
+func (c *ReleaseApprovalConverter) ToDO(po *infraEntity.ReleaseApprovalPO) *domainEntity.ReleaseApproval {
+	if po == nil {
+		return nil
+	}
+
+	return &domainEntity.ReleaseApproval{
+		ID:          po.ID,
+		ReleaseID:   po.ReleaseID,
+		NamespaceID: po.NamespaceID,
+		Environment: po.Environment,
+		Version:     po.Version,
+		Action:      po.Action,
+		Operator:    po.Operator,
+		Comment:     po.Comment,
+		CreatedAt:   po.CreatedAt,
+	}
+}
+
+// ToPO 将领域实体转换为持久化对象（DO -> PO）
+func (c *ReleaseApprovalConverter) ToPO(do *domainEntity.ReleaseApproval) *infraEntity.ReleaseApprovalPO {
+	if do == nil {
+		return nil
+	}
+
+	return &infraEntity.ReleaseApprovalPO{
+		ID:          do.ID,
+		ReleaseID:   do.ReleaseID,
+		NamespaceID: do.NamespaceID,
+		Environment: do.Environment,
+		Version:     do.Version,
+		Action:      do.Action,
+		Operator:    do.Operator,
+		Comment:     do.Comment,
+		CreatedAt:   do.CreatedAt,
+	}
+}
+
+// ToDOList 批量转换为领域实体
+func (c *ReleaseApprovalConverter) ToDOList(pos []*infraEntity.ReleaseApprovalPO) []*domainEntity.ReleaseApproval {
+	result := make([]*domainEntity.ReleaseApproval, 0, len(pos))
+	for _, po := range pos {
+		result = append(result, c.ToDO(po))
+	}
+	return result
+}
@@ -20,6 +20,7 @@ func (c *ReleaseConverter) ToDO(po *infraEntity.ReleasePO) *domainEntity.Release
 	}
 
 	release := &domainEntity.Release{
+		TenantID:            po.TenantID,
 		NamespaceID:         po.NamespaceID,
 		Environment:         po.Environment,
 		Version:             po.Version,
@@ -32,6 +33,12 @@ func (c *ReleaseConverter) ToDO(po *infraEntity.ReleasePO) *domainEntity.Release
 		CanaryPercentage:    po.CanaryPercentage,
 		ReleasedBy:          po.ReleasedBy,
 		ReleasedAt:          po.ReleasedAt,
+		BaseReleaseID:       po.BaseReleaseID,
+		ReleaseNotes:        po.ReleaseNotes,
+		Changelog:           po.Changelog,
+		ApprovalStatus:      domainEntity.ReleaseApprovalStatus(po.ApprovalStatus),
+		ApprovedBy:          po.ApprovedBy,
+		ApprovedAt:          po.ApprovedAt,
 		RollbackFromVersion: po.RollbackFromVersion,
 		RollbackBy:          po.RollbackBy,
 		RollbackAt:          po.RollbackAt,
@@ -63,6 +70,7 @@ func (c *ReleaseConverter) ToPO(do *domainEntity.Release) *infraEntity.ReleasePO
 		DeletedAt: do.DeletedAt,
 
 		// 业务字段
+		TenantID:            do.TenantID,
 		NamespaceID:         do.NamespaceID,
 		Environment:         do.Environment,
 		Version:             do.Version,
@@ -75,6 +83,12 @@ func (c *ReleaseConverter) ToPO(do *domainEntity.Release) *infraEntity.ReleasePO
 		CanaryPercentage:    do.CanaryPercentage,
 		ReleasedBy:          do.ReleasedBy,
 		ReleasedAt:          do.ReleasedAt,
+		BaseReleaseID:       do.BaseReleaseID,
+		ReleaseNotes:        do.ReleaseNotes,
+		Changelog:           do.Changelog,
+		ApprovalStatus:      string(do.ApprovalStatus),
+		ApprovedBy:          do.ApprovedBy,
+		ApprovedAt:          do.ApprovedAt,
 		RollbackFromVersion: do.RollbackFromVersion,
 		RollbackBy:          do.RollbackBy,
 		RollbackAt:          do.RollbackAt,
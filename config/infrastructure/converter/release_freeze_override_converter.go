@@ -0,0 +1,61 @@
+package converter
+
+import (
+	domainEntity "config-client/config/domain/entity"
+	infraEntity "config-client/config/infrastructure/entity"
+)
+
+// ReleaseFreezeOverrideConverter 冻结窗口覆盖记录转换器，负责领域实体和持久化对象之间的转换
+type ReleaseFreezeOverrideConverter struct{}
+
+// NewReleaseFreezeOverrideConverter 创建冻结窗口覆盖记录转换器实例
+func NewReleaseFreezeOverrideConverter() *ReleaseFreezeOverrideConverter {
+	return &ReleaseFreezeOverrideConverter{}
+}
+
+// ToDO 将持久化对象转换为领域实体（PO -> DO）
+func (c *ReleaseFreezeOverrideConverter) ToDO(po *infraEntity.ReleaseFreezeOverridePO) *domainEntity.ReleaseFreezeOverride {
+	if po == nil {
+		return nil
+	}
+
+	return &domainEntity.ReleaseFreezeOverride{
+		ID:          po.ID,
+		ReleaseID:   po.ReleaseID,
+		NamespaceID: po.NamespaceID,
+		Environment: po.Environment,
+		WindowName:  po.WindowName,
+		Operation:   po.Operation,
+		Operator:    po.Operator,
+		Reason:      po.Reason,
+		CreatedAt:   po.CreatedAt,
+	}
+}
+
+// ToPO 将领域实体转换为持久化对象（DO -> PO）
+func (c *ReleaseFreezeOverrideConverter) ToPO(do *domainEntity.ReleaseFreezeOverride) *infraEntity.ReleaseFreezeOverridePO {
+	if do == nil {
+		return nil
+	}
+
+	return &infraEntity.ReleaseFreezeOverridePO{
+		ID:          do.ID,
+		ReleaseID:   do.ReleaseID,
+		NamespaceID: do.NamespaceID,
+		Environment: do.Environment,
+		WindowName:  do.WindowName,
+		Operation:   do.Operation,
+		Operator:    do.Operator,
+		Reason:      do.Reason,
+		CreatedAt:   do.CreatedAt,
+	}
+}
+
+// ToDOList 批量转换为领域实体
+func (c *ReleaseFreezeOverrideConverter) ToDOList(pos []*infraEntity.ReleaseFreezeOverridePO) []*domainEntity.ReleaseFreezeOverride {
+	result := make([]*domainEntity.ReleaseFreezeOverride, 0, len(pos))
+	for _, po := range pos {
+		result = append(result, c.ToDO(po))
+	}
+	return result
+}
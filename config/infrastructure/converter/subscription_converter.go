@@ -21,6 +21,7 @@ func (c *SubscriptionConverter) ToEntity(po *infraEntity.SubscriptionPO) *domain
 
 	return &domainEntity.Subscription{
 		ID:                 po.ID,
+		TenantID:           po.TenantID,
 		NamespaceID:        po.NamespaceID,
 		ClientID:           po.ClientID,
 		ClientIP:           po.ClientIP,
@@ -48,6 +49,7 @@ func (c *SubscriptionConverter) ToPO(entity *domainEntity.Subscription) *infraEn
 
 	return &infraEntity.SubscriptionPO{
 		ID:                 entity.ID,
+		TenantID:           entity.TenantID,
 		NamespaceID:        entity.NamespaceID,
 		ClientID:           entity.ClientID,
 		ClientIP:           entity.ClientIP,
@@ -0,0 +1,64 @@
+package converter
+
+import (
+	domainEntity "config-client/config/domain/entity"
+	infraEntity "config-client/config/infrastructure/entity"
+)
+
+// SubscriptionKeyConverter 订阅配置键转换器，负责领域实体和持久化对象之间的转换
+type SubscriptionKeyConverter struct{}
+
+// NewSubscriptionKeyConverter 创建订阅配置键转换器实例
+func NewSubscriptionKeyConverter() *SubscriptionKeyConverter {
+	return &SubscriptionKeyConverter{}
+}
+
+// ToDO 将持久化对象转换为领域实体（PO -> DO）
+func (c *SubscriptionKeyConverter) ToDO(po *infraEntity.SubscriptionKeyPO) *domainEntity.SubscriptionKey {
+	if po == nil {
+		return nil
+	}
+
+	return &domainEntity.SubscriptionKey{
+		ID:             po.ID,
+		SubscriptionID: po.SubscriptionID,
+		NamespaceID:    po.NamespaceID,
+		ConfigKey:      po.ConfigKey,
+		Version:        po.Version,
+		ReportedAt:     po.ReportedAt,
+	}
+}
+
+// ToPO 将领域实体转换为持久化对象（DO -> PO）
+func (c *SubscriptionKeyConverter) ToPO(do *domainEntity.SubscriptionKey) *infraEntity.SubscriptionKeyPO {
+	if do == nil {
+		return nil
+	}
+
+	return &infraEntity.SubscriptionKeyPO{
+		ID:             do.ID,
+		SubscriptionID: do.SubscriptionID,
+		NamespaceID:    do.NamespaceID,
+		ConfigKey:      do.ConfigKey,
+		Version:        do.Version,
+		ReportedAt:     do.ReportedAt,
+	}
+}
+
+// ToDOList 批量转换为领域实体
+func (c *SubscriptionKeyConverter) ToDOList(pos []*infraEntity.SubscriptionKeyPO) []*domainEntity.SubscriptionKey {
+	result := make([]*domainEntity.SubscriptionKey, 0, len(pos))
+	for _, po := range pos {
+		result = append(result, c.ToDO(po))
+	}
+	return result
+}
+
+// ToPOList 批量转换为持久化对象
+func (c *SubscriptionKeyConverter) ToPOList(dos []*domainEntity.SubscriptionKey) []*infraEntity.SubscriptionKeyPO {
+	result := make([]*infraEntity.SubscriptionKeyPO, 0, len(dos))
+	for _, do := range dos {
+		result = append(result, c.ToPO(do))
+	}
+	return result
+}
@@ -0,0 +1,61 @@
+package converter
+
+import (
+	domainEntity "config-client/config/domain/entity"
+	infraEntity "config-client/config/infrastructure/entity"
+)
+
+// TenantConverter 租户转换器，负责领域实体和持久化对象之间的转换
+type TenantConverter struct{}
+
+// NewTenantConverter 创建租户转换器实例
+func NewTenantConverter() *TenantConverter {
+	return &TenantConverter{}
+}
+
+// ToDO 将持久化对象转换为领域实体（PO -> DO）
+func (c *TenantConverter) ToDO(po *infraEntity.TenantPO) *domainEntity.Tenant {
+	if po == nil {
+		return nil
+	}
+
+	return &domainEntity.Tenant{
+		ID:          po.ID,
+		Code:        po.Code,
+		Name:        po.Name,
+		Description: po.Description,
+		IsActive:    po.IsActive,
+		CreatedBy:   po.CreatedBy,
+		UpdatedBy:   po.UpdatedBy,
+		CreatedAt:   po.CreatedAt,
+		UpdatedAt:   po.UpdatedAt,
+	}
+}
+
+// ToPO 将领域实体转换为持久化对象（DO -> PO）
+func (c *TenantConverter) ToPO(do *domainEntity.Tenant) *infraEntity.TenantPO {
+	if do == nil {
+		return nil
+	}
+
+	return &infraEntity.TenantPO{
+		ID:          do.ID,
+		Code:        do.Code,
+		Name:        do.Name,
+		Description: do.Description,
+		IsActive:    do.IsActive,
+		CreatedBy:   do.CreatedBy,
+		UpdatedBy:   do.UpdatedBy,
+		CreatedAt:   do.CreatedAt,
+		UpdatedAt:   do.UpdatedAt,
+	}
+}
+
+// ToDOList 批量转换为领域实体
+func (c *TenantConverter) ToDOList(pos []*infraEntity.TenantPO) []*domainEntity.Tenant {
+	result := make([]*domainEntity.Tenant, 0, len(pos))
+	for _, po := range pos {
+		result = append(result, c.ToDO(po))
+	}
+	return result
+}
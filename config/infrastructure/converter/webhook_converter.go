@@ -0,0 +1,149 @@
+package converter
+
+import (
+	"strings"
+
+	domainEntity "config-client/config/domain/entity"
+	infraEntity "config-client/config/infrastructure/entity"
+)
+
+// WebhookConverter Webhook 转换器，负责领域实体和持久化对象之间的转换
+type WebhookConverter struct{}
+
+// NewWebhookConverter 创建 Webhook 转换器实例
+func NewWebhookConverter() *WebhookConverter {
+	return &WebhookConverter{}
+}
+
+// ToDO 将持久化对象转换为领域实体（PO -> DO）
+func (c *WebhookConverter) ToDO(po *infraEntity.WebhookPO) *domainEntity.Webhook {
+	if po == nil {
+		return nil
+	}
+
+	var events []string
+	if po.Events != "" {
+		events = strings.Split(po.Events, ",")
+	}
+	return &domainEntity.Webhook{
+		ID:          po.ID,
+		NamespaceID: po.NamespaceID,
+		Name:        po.Name,
+		URL:         po.URL,
+		Secret:      po.Secret,
+		Events:      events,
+		Enabled:     po.Enabled,
+		CreatedBy:   po.CreatedBy,
+		UpdatedBy:   po.UpdatedBy,
+		CreatedAt:   po.CreatedAt,
+		UpdatedAt:   po.UpdatedAt,
+	}
+}
+
+// ToPO 将领域实体转换为持久化对象（DO -> PO）
+func (c *WebhookConverter) ToPO(do *domainEntity.Webhook) *infraEntity.WebhookPO {
+	if do == nil {
+		return nil
+	}
+
+	return &infraEntity.WebhookPO{
+		ID:          do.ID,
+		NamespaceID: do.NamespaceID,
+		Name:        do.Name,
+		URL:         do.URL,
+		Secret:      do.Secret,
+		Events:      strings.Join(do.Events, ","),
+		Enabled:     do.Enabled,
+		CreatedBy:   do.CreatedBy,
+		UpdatedBy:   do.UpdatedBy,
+		CreatedAt:   do.CreatedAt,
+		UpdatedAt:   do.UpdatedAt,
+	}
+}
+
+// ToDOList 批量转换为领域实体
+func (c *WebhookConverter) ToDOList(pos []*infraEntity.WebhookPO) []*domainEntity.Webhook {
+	result := make([]*domainEntity.Webhook, 0, len(pos))
+	for _, po := range pos {
+		result = append(result, c.ToDO(po))
+	}
+	return result
+}
+
+// WebhookDeliveryConverter Webhook 投递记录转换器，负责领域实体和持久化对象之间的转换
+type WebhookDeliveryConverter struct{}
+
+// NewWebhookDeliveryConverter 创建 Webhook 投递记录转换器实例
+func NewWebhookDeliveryConverter() *WebhookDeliveryConverter {
+	return &WebhookDeliveryConverter{}
+}
+
+// ToDO 将持久化对象转换为领域实体（PO -> DO）
+func (c *WebhookDeliveryConverter) ToDO(po *infraEntity.WebhookDeliveryPO) *domainEntity.WebhookDelivery {
+	if po == nil {
+		return nil
+	}
+
+	return &domainEntity.WebhookDelivery{
+		ID:             po.ID,
+		WebhookID:      po.WebhookID,
+		NamespaceID:    po.NamespaceID,
+		EventType:      po.EventType,
+		Payload:        po.Payload,
+		Status:         po.Status,
+		Attempts:       po.Attempts,
+		ResponseStatus: po.ResponseStatus,
+		ResponseBody:   po.ResponseBody,
+		LastError:      po.LastError,
+		DurationMs:     po.DurationMs,
+		NextAttemptAt:  po.NextAttemptAt,
+		LockedUntil:    po.LockedUntil,
+		RedeliveryOf:   po.RedeliveryOf,
+		CreatedAt:      po.CreatedAt,
+		DeliveredAt:    po.DeliveredAt,
+	}
+}
+
+// ToPO 将领域实体转换为持久化对象（DO -> PO）
+func (c *WebhookDeliveryConverter) ToPO(do *domainEntity.WebhookDelivery) *infraEntity.WebhookDeliveryPO {
+	if do == nil {
+		return nil
+	}
+
+	return &infraEntity.WebhookDeliveryPO{
+		ID:             do.ID,
+		WebhookID:      do.WebhookID,
+		NamespaceID:    do.NamespaceID,
+		EventType:      do.EventType,
+		Payload:        do.Payload,
+		Status:         do.Status,
+		Attempts:       do.Attempts,
+		ResponseStatus: do.ResponseStatus,
+		ResponseBody:   do.ResponseBody,
+		LastError:      do.LastError,
+		DurationMs:     do.DurationMs,
+		NextAttemptAt:  do.NextAttemptAt,
+		LockedUntil:    do.LockedUntil,
+		RedeliveryOf:   do.RedeliveryOf,
+		CreatedAt:      do.CreatedAt,
+		DeliveredAt:    do.DeliveredAt,
+	}
+}
+
+// ToDOList 批量转换 PO -> DO
+func (c *WebhookDeliveryConverter) ToDOList(pos []*infraEntity.WebhookDeliveryPO) []*domainEntity.WebhookDelivery {
+	dos := make([]*domainEntity.WebhookDelivery, 0, len(pos))
+	for _, po := range pos {
+		dos = append(dos, c.ToDO(po))
+	}
+	return dos
+}
+
+// ToPOList 批量转换 DO -> PO
+func (c *WebhookDeliveryConverter) ToPOList(dos []*domainEntity.WebhookDelivery) []*infraEntity.WebhookDeliveryPO {
+	pos := make([]*infraEntity.WebhookDeliveryPO, 0, len(dos))
+	for _, do := range dos {
+		pos = append(pos, c.ToPO(do))
+	}
+	return pos
+}
@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// APIKeyPO API Key 持久化对象，对应数据库表 t_api_keys
+type APIKeyPO struct {
+	ID          int        `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	TenantID    int        `gorm:"column:tenant_id;not null;default:1;uniqueIndex:uk_t_api_keys_tenant_name,priority:1" json:"tenant_id"`
+	Name        string     `gorm:"column:name;type:varchar(100);not null;uniqueIndex:uk_t_api_keys_tenant_name,priority:2" json:"name"`
+	Prefix      string     `gorm:"column:prefix;type:varchar(20);not null" json:"prefix"`
+	KeyHash     string     `gorm:"column:key_hash;type:char(64);not null;uniqueIndex:uk_t_api_keys_hash" json:"-"`
+	Role        string     `gorm:"column:role;type:varchar(20);not null" json:"role"`
+	NamespaceID int        `gorm:"column:namespace_id;not null;default:0" json:"namespace_id"`                     // 只读令牌绑定的命名空间ID（管理员为0）
+	Environment string     `gorm:"column:environment;type:varchar(50);not null;default:''" json:"environment"`     // 只读令牌绑定的环境
+	KeyPrefixes string     `gorm:"column:key_prefixes;type:varchar(1000);not null;default:''" json:"key_prefixes"` // 允许读取的配置键前缀，逗号分隔
+	Description string     `gorm:"column:description;type:text" json:"description"`
+	ExpiresAt   *time.Time `gorm:"column:expires_at" json:"expires_at"`
+	LastUsedAt  *time.Time `gorm:"column:last_used_at" json:"last_used_at"`
+	RevokedAt   *time.Time `gorm:"column:revoked_at" json:"revoked_at"`
+	RevokedBy   string     `gorm:"column:revoked_by;type:varchar(100)" json:"revoked_by"`
+	CreatedBy   string     `gorm:"column:created_by;type:varchar(100)" json:"created_by"`
+	CreatedAt   time.Time  `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time  `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (APIKeyPO) TableName() string {
+	return "t_api_keys"
+}
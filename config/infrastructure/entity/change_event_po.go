@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// ChangeEventPO 配置变更事件日志持久化对象，与数据库表 t_change_events 对应
+type ChangeEventPO struct {
+	// 主键
+	ID int64 `gorm:"primaryKey;autoIncrement" json:"id"`
+
+	// 事件序号（命名空间内唯一）
+	NamespaceID int   `gorm:"column:namespace_id;not null;uniqueIndex:uk_change_events_ns_seq,priority:1" json:"namespace_id"`
+	Sequence    int64 `gorm:"column:sequence;not null;uniqueIndex:uk_change_events_ns_seq,priority:2" json:"sequence"`
+
+	// 事件内容
+	ConfigKey   string `gorm:"column:config_key;type:varchar(500);not null" json:"config_key"`
+	ConfigID    int    `gorm:"column:config_id" json:"config_id"`
+	Environment string `gorm:"column:environment;type:varchar(50)" json:"environment"`
+	Action      string `gorm:"column:action;type:varchar(20);not null" json:"action"`
+
+	// 时间戳
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime;index:idx_change_events_created_at" json:"created_at"`
+}
+
+// TableName 指定表名
+func (ChangeEventPO) TableName() string {
+	return "t_change_events"
+}
+
+// TenantScope 变更事件通过命名空间归属租户
+func (ChangeEventPO) TenantScope(tenantID int) clause.Expression {
+	return namespaceTenantScope(tenantID)
+}
+
+// GetID 获取主键ID
+func (e *ChangeEventPO) GetID() int64 {
+	return e.ID
+}
+
+// ChangeEventSequencePO 命名空间事件序号计数器，与数据库表 t_change_event_sequences 对应
+type ChangeEventSequencePO struct {
+	NamespaceID  int       `gorm:"column:namespace_id;primaryKey" json:"namespace_id"`
+	LastSequence int64     `gorm:"column:last_sequence;not null;default:0" json:"last_sequence"`
+	UpdatedAt    time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (ChangeEventSequencePO) TableName() string {
+	return "t_change_event_sequences"
+}
+
+// TenantScope 事件序号计数器通过命名空间归属租户
+func (ChangeEventSequencePO) TenantScope(tenantID int) clause.Expression {
+	return namespaceTenantScope(tenantID)
+}
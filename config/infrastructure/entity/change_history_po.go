@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"time"
+
+	"gorm.io/gorm/clause"
 )
 
 // ChangeHistoryPO 配置变更历史持久化对象，与数据库表 t_change_history 对应
@@ -53,6 +55,11 @@ func (h *ChangeHistoryPO) GetID() int {
 	return h.ID
 }
 
+// TenantScope 变更历史通过命名空间归属租户
+func (ChangeHistoryPO) TenantScope(tenantID int) clause.Expression {
+	return namespaceTenantScope(tenantID)
+}
+
 // JSONB 自定义类型，用于处理 PostgreSQL 的 JSONB 类型
 type JSONB map[string]interface{}
 
@@ -78,3 +85,29 @@ func (j JSONB) Value() (driver.Value, error) {
 	}
 	return json.Marshal(j)
 }
+
+// ChangeHistoryArchivePO 变更历史归档持久化对象，与数据库表 t_change_history_archive 对应
+// 字段与 t_change_history 一致，保留原记录ID，另记录归档时间
+type ChangeHistoryArchivePO struct {
+	ID           int       `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	ConfigID     int       `gorm:"column:config_id;not null" json:"config_id"`
+	NamespaceID  int       `gorm:"column:namespace_id;not null" json:"namespace_id"`
+	ConfigKey    string    `gorm:"column:config_key;type:varchar(500);not null" json:"config_key"`
+	Environment  string    `gorm:"column:environment;type:varchar(50);default:'default'" json:"environment"`
+	Operation    string    `gorm:"column:operation;type:varchar(20);not null" json:"operation"`
+	OldValue     string    `gorm:"column:old_value;type:text" json:"old_value"`
+	NewValue     string    `gorm:"column:new_value;type:text" json:"new_value"`
+	OldVersion   int       `gorm:"column:old_version" json:"old_version"`
+	NewVersion   int       `gorm:"column:new_version" json:"new_version"`
+	Operator     string    `gorm:"column:operator;type:varchar(100);not null" json:"operator"`
+	OperatorIP   string    `gorm:"column:operator_ip;type:varchar(50)" json:"operator_ip"`
+	ChangeReason string    `gorm:"column:change_reason;type:text" json:"change_reason"`
+	CreatedAt    time.Time `gorm:"column:created_at" json:"created_at"`
+	Metadata     JSONB     `gorm:"column:metadata;type:jsonb;default:'{}'" json:"metadata"`
+	ArchivedAt   time.Time `gorm:"column:archived_at;autoCreateTime" json:"archived_at"`
+}
+
+// TableName 指定表名
+func (ChangeHistoryArchivePO) TableName() string {
+	return "t_change_history_archive"
+}
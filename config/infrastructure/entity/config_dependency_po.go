@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// ConfigDependencyPO 配置依赖持久化对象
+// 对应数据库表 t_config_dependencies
+type ConfigDependencyPO struct {
+	ID                int       `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	ConfigID          int       `gorm:"column:config_id;not null;index" json:"config_id"`
+	DependsOnConfigID int       `gorm:"column:depends_on_config_id;not null;index" json:"depends_on_config_id"`
+	CreatedBy         string    `gorm:"column:created_by;type:varchar(100);default:'system'" json:"created_by"`
+	CreatedAt         time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (ConfigDependencyPO) TableName() string {
+	return "t_config_dependencies"
+}
+
+// TenantScope 配置依赖通过配置归属租户
+func (ConfigDependencyPO) TenantScope(tenantID int) clause.Expression {
+	return configTenantScope(tenantID)
+}
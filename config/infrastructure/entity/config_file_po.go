@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// ConfigFilePO 文件类型配置内容持久化对象
+// 对应数据库表 t_config_files
+type ConfigFilePO struct {
+	ID          int       `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	ConfigID    int       `gorm:"column:config_id;not null;uniqueIndex:uk_t_config_files_config_hash" json:"config_id"`
+	SHA256      string    `gorm:"column:sha256;type:varchar(64);not null;uniqueIndex:uk_t_config_files_config_hash" json:"sha256"`
+	FileName    string    `gorm:"column:file_name;type:varchar(255)" json:"file_name"`
+	ContentType string    `gorm:"column:content_type;type:varchar(255)" json:"content_type"`
+	Size        int64     `gorm:"column:size;not null" json:"size"`
+	Content     []byte    `gorm:"column:content;type:bytea" json:"-"`
+	CreatedBy   string    `gorm:"column:created_by;type:varchar(100);default:'system'" json:"created_by"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (ConfigFilePO) TableName() string {
+	return "t_config_files"
+}
+
+// TenantScope 配置文件内容通过配置归属租户
+func (ConfigFilePO) TenantScope(tenantID int) clause.Expression {
+	return configTenantScope(tenantID)
+}
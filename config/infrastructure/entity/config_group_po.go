@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ConfigGroupPO 配置分组持久化对象，与数据库表 t_config_groups 对应
+type ConfigGroupPO struct {
+	// 主键
+	ID int `gorm:"primaryKey;autoIncrement" json:"id"`
+
+	// 业务字段
+	NamespaceID int    `gorm:"column:namespace_id;not null;index" json:"namespace_id"`
+	Name        string `gorm:"column:name;type:varchar(255);not null" json:"name"`
+	Description string `gorm:"column:description;type:text" json:"description"`
+	SortOrder   int    `gorm:"column:sort_order;default:0" json:"sort_order"`
+
+	// 审计字段
+	Version   int            `gorm:"column:version;default:1" json:"version"`
+	CreatedBy string         `gorm:"column:created_by;type:varchar(100);default:'system'" json:"created_by"`
+	UpdatedBy string         `gorm:"column:updated_by;type:varchar(100);default:'system'" json:"updated_by"`
+	CreatedAt time.Time      `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index" json:"deleted_at,omitempty"`
+}
+
+// TableName 指定表名
+func (ConfigGroupPO) TableName() string {
+	return "t_config_groups"
+}
+
+// TenantScope 配置分组通过命名空间归属租户
+func (ConfigGroupPO) TenantScope(tenantID int) clause.Expression {
+	return namespaceTenantScope(tenantID)
+}
+
+// GetID 获取主键ID
+func (g *ConfigGroupPO) GetID() int {
+	return g.ID
+}
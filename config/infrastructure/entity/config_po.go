@@ -12,6 +12,7 @@ type ConfigPO struct {
 	ID int `gorm:"primaryKey;autoIncrement" json:"id"`
 
 	// 关联信息
+	TenantID    int `gorm:"column:tenant_id;not null;default:1;index" json:"tenant_id"`
 	NamespaceID int `gorm:"column:namespace_id;not null;index" json:"namespace_id"`
 
 	// 配置标识
@@ -23,7 +24,7 @@ type ConfigPO struct {
 	ValueType string `gorm:"column:value_type;type:varchar(50);default:'string'" json:"value_type"`
 
 	// 配置哈希
-	ContentHash          string `gorm:"column:content_hash;type:varchar(32)" json:"content_hash"`
+	ContentHash          string `gorm:"column:content_hash;type:varchar(64)" json:"content_hash"`
 	ContentHashAlgorithm string `gorm:"column:content_hash_algorithm;type:varchar(20);default:'md5'" json:"content_hash_algorithm"`
 
 	// 环境隔离
@@ -37,6 +38,9 @@ type ConfigPO struct {
 	IsActive  bool `gorm:"column:is_active;default:true" json:"is_active"`
 	IsDeleted bool `gorm:"column:is_deleted;default:false" json:"is_deleted"`
 
+	// 过期时间（为空表示永不过期）
+	ExpiresAt *time.Time `gorm:"column:expires_at;index" json:"expires_at,omitempty"`
+
 	// 审计字段
 	CreatedBy string         `gorm:"column:created_by;type:varchar(100);default:'system'" json:"created_by"`
 	UpdatedBy string         `gorm:"column:updated_by;type:varchar(100);default:'system'" json:"updated_by"`
@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ConfigSchemaPO 配置 Schema 持久化对象，与数据库表 t_config_schemas 对应
+type ConfigSchemaPO struct {
+	// 主键
+	ID int `gorm:"primaryKey;autoIncrement" json:"id"`
+
+	// 绑定目标（config_key 与 group_name 二选一，未使用的为空字符串）
+	NamespaceID int    `gorm:"column:namespace_id;not null;index" json:"namespace_id"`
+	ConfigKey   string `gorm:"column:config_key;type:varchar(500);default:''" json:"config_key"`
+	GroupName   string `gorm:"column:group_name;type:varchar(255);default:''" json:"group_name"`
+
+	// Schema 内容
+	Schema      string `gorm:"column:schema;type:text;not null" json:"schema"`
+	Description string `gorm:"column:description;type:text" json:"description"`
+
+	// 状态管理
+	IsActive bool `gorm:"column:is_active;default:true" json:"is_active"`
+
+	// 审计字段
+	Version   int            `gorm:"column:version;default:1" json:"version"`
+	CreatedBy string         `gorm:"column:created_by;type:varchar(100);default:'system'" json:"created_by"`
+	UpdatedBy string         `gorm:"column:updated_by;type:varchar(100);default:'system'" json:"updated_by"`
+	CreatedAt time.Time      `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index" json:"deleted_at,omitempty"`
+}
+
+// TableName 指定表名
+func (ConfigSchemaPO) TableName() string {
+	return "t_config_schemas"
+}
+
+// TenantScope 配置 Schema 通过命名空间归属租户
+func (ConfigSchemaPO) TenantScope(tenantID int) clause.Expression {
+	return namespaceTenantScope(tenantID)
+}
+
+// GetID 获取主键ID
+func (s *ConfigSchemaPO) GetID() int {
+	return s.ID
+}
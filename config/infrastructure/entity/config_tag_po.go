@@ -1,6 +1,10 @@
 package entity
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+)
 
 // ConfigTagPO 配置标签持久化对象
 // 对应数据库表 t_config_tags
@@ -16,3 +20,8 @@ type ConfigTagPO struct {
 func (ConfigTagPO) TableName() string {
 	return "t_config_tags"
 }
+
+// TenantScope 配置标签通过配置归属租户
+func (ConfigTagPO) TenantScope(tenantID int) clause.Expression {
+	return configTenantScope(tenantID)
+}
@@ -0,0 +1,50 @@
+package entity
+
+import "gorm.io/gorm/clause"
+
+// Models 全部持久化对象，用于 GORM AutoMigrate（database.auto_migrate）
+// 新增持久化对象时需同时在此注册，并添加对应的版本化迁移脚本
+func Models() []interface{} {
+	return []interface{}{
+		&NamespacePO{},
+		&ConfigPO{},
+		&SubscriptionPO{},
+		&SubscriptionKeyPO{},
+		&ChangeHistoryPO{},
+		&ChangeHistoryArchivePO{},
+		&ReleasePO{},
+		&ReleaseApprovalPO{},
+		&ReleaseFreezeOverridePO{},
+		&ConfigTagPO{},
+		&SystemConfigPO{},
+		&ConfigSchemaPO{},
+		&ConfigGroupPO{},
+		&ConfigDependencyPO{},
+		&ConfigFilePO{},
+		&ChangeEventPO{},
+		&ChangeEventSequencePO{},
+		&OutboxEventPO{},
+		&PushTracePO{},
+		&WebhookPO{},
+		&WebhookDeliveryPO{},
+		&NotificationChannelPO{},
+		&APIKeyPO{},
+		&TenantPO{},
+	}
+}
+
+// namespaceTenantScope 通过 namespace_id 归属租户的表的租户过滤条件（只保留该租户命名空间下的数据）
+func namespaceTenantScope(tenantID int) clause.Expression {
+	return clause.Expr{
+		SQL:  "? IN (SELECT id FROM t_namespaces WHERE tenant_id = ?)",
+		Vars: []interface{}{clause.Column{Table: clause.CurrentTable, Name: "namespace_id"}, tenantID},
+	}
+}
+
+// configTenantScope 通过 config_id 归属租户的表的租户过滤条件（只保留该租户配置的数据）
+func configTenantScope(tenantID int) clause.Expression {
+	return clause.Expr{
+		SQL:  "? IN (SELECT id FROM t_configs WHERE tenant_id = ?)",
+		Vars: []interface{}{clause.Column{Table: clause.CurrentTable, Name: "config_id"}, tenantID},
+	}
+}
@@ -11,8 +11,11 @@ type NamespacePO struct {
 	// 主键
 	ID int `gorm:"primaryKey;autoIncrement" json:"id"`
 
+	// 所属租户（名称在租户内唯一）
+	TenantID int `gorm:"column:tenant_id;not null;default:1;uniqueIndex:uk_t_namespaces_tenant_name,priority:1" json:"tenant_id"`
+
 	// 基本信息
-	Name        string `gorm:"column:name;type:varchar(255);uniqueIndex;not null" json:"name"`
+	Name        string `gorm:"column:name;type:varchar(255);not null;uniqueIndex:uk_t_namespaces_tenant_name,priority:2" json:"name"`
 	DisplayName string `gorm:"column:display_name;type:varchar(255)" json:"display_name"`
 	Description string `gorm:"column:description;type:text" json:"description"`
 
@@ -29,6 +32,12 @@ type NamespacePO struct {
 
 	// 扩展字段
 	Metadata string `gorm:"column:metadata;type:jsonb;default:'{}'" json:"metadata"`
+
+	// 配额（0 表示继承全局默认配额）
+	MaxConfigs          int `gorm:"column:max_configs;not null;default:0" json:"max_configs"`
+	MaxValueSize        int `gorm:"column:max_value_size;not null;default:0" json:"max_value_size"`
+	MaxSubscribers      int `gorm:"column:max_subscribers;not null;default:0" json:"max_subscribers"`
+	MaxReleasesRetained int `gorm:"column:max_releases_retained;not null;default:0" json:"max_releases_retained"`
 }
 
 // TableName 指定表名
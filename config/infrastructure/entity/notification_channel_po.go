@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// NotificationChannelPO 通知渠道持久化对象，对应数据库表 t_notification_channels
+type NotificationChannelPO struct {
+	ID          int       `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	NamespaceID int       `gorm:"column:namespace_id;not null;index:idx_t_notification_channels_namespace" json:"namespace_id"`
+	Name        string    `gorm:"column:name;type:varchar(100);not null" json:"name"`
+	Type        string    `gorm:"column:type;type:varchar(20);not null" json:"type"`
+	Target      string    `gorm:"column:target;type:varchar(1000);not null" json:"target"`
+	Secret      string    `gorm:"column:secret;type:varchar(255);not null;default:''" json:"-"`
+	Events      string    `gorm:"column:events;type:varchar(200);not null;default:''" json:"events"` // 事件过滤，逗号分隔（为空时订阅全部事件）
+	Template    string    `gorm:"column:template;type:text" json:"template"`
+	Enabled     bool      `gorm:"column:enabled;not null;default:true" json:"enabled"`
+	CreatedBy   string    `gorm:"column:created_by;type:varchar(100)" json:"created_by"`
+	UpdatedBy   string    `gorm:"column:updated_by;type:varchar(100)" json:"updated_by"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (NotificationChannelPO) TableName() string {
+	return "t_notification_channels"
+}
+
+// TenantScope 通知渠道通过命名空间归属租户
+func (NotificationChannelPO) TenantScope(tenantID int) clause.Expression {
+	return namespaceTenantScope(tenantID)
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"time"
+)
+
+// OutboxEventPO 事务性发件箱事件持久化对象，与数据库表 t_event_outbox 对应
+type OutboxEventPO struct {
+	// 主键
+	ID int64 `gorm:"primaryKey;autoIncrement" json:"id"`
+
+	// 事件内容
+	Payload string `gorm:"column:payload;type:text;not null" json:"payload"`
+
+	// 投递状态
+	Status        string     `gorm:"column:status;type:varchar(20);not null;default:'pending';index:idx_event_outbox_status_next,priority:1" json:"status"`
+	Attempts      int        `gorm:"column:attempts;not null;default:0" json:"attempts"`
+	LastError     string     `gorm:"column:last_error;type:text" json:"last_error"`
+	NextAttemptAt time.Time  `gorm:"column:next_attempt_at;not null;index:idx_event_outbox_status_next,priority:2" json:"next_attempt_at"`
+	LockedUntil   *time.Time `gorm:"column:locked_until" json:"locked_until"`
+
+	// 时间戳
+	CreatedAt time.Time  `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	SentAt    *time.Time `gorm:"column:sent_at;index:idx_event_outbox_sent_at" json:"sent_at"`
+}
+
+// TableName 指定表名
+func (OutboxEventPO) TableName() string {
+	return "t_event_outbox"
+}
+
+// GetID 获取主键ID
+func (e *OutboxEventPO) GetID() int64 {
+	return e.ID
+}
@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// PushTracePO 变更通知下发记录持久化对象
+// 对应数据库表 t_push_traces
+type PushTracePO struct {
+	ID             int64      `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	SubscriptionID int        `gorm:"column:subscription_id;not null;index" json:"subscription_id"`
+	ClientID       string     `gorm:"column:client_id;type:varchar(255);not null;index" json:"client_id"`
+	NamespaceID    int        `gorm:"column:namespace_id;not null" json:"namespace_id"`
+	Environment    string     `gorm:"column:environment;type:varchar(50);not null" json:"environment"`
+	ConfigKey      string     `gorm:"column:config_key;type:varchar(500);not null" json:"config_key"`
+	Version        string     `gorm:"column:version;type:varchar(64)" json:"version"`
+	ReleaseID      int        `gorm:"column:release_id;not null;default:0;index" json:"release_id"`
+	Sequence       int64      `gorm:"column:sequence;not null;default:0" json:"sequence"`
+	TraceID        string     `gorm:"column:trace_id;type:varchar(64)" json:"trace_id"`
+	Source         string     `gorm:"column:source;type:varchar(20);not null" json:"source"`
+	PushedAt       time.Time  `gorm:"column:pushed_at;not null;index" json:"pushed_at"`
+	Acked          bool       `gorm:"column:acked;not null;default:false" json:"acked"`
+	AckedAt        *time.Time `gorm:"column:acked_at" json:"acked_at"`
+}
+
+// TableName 指定表名
+func (PushTracePO) TableName() string {
+	return "t_push_traces"
+}
+
+// TenantScope 推送记录通过命名空间归属租户
+func (PushTracePO) TenantScope(tenantID int) clause.Expression {
+	return namespaceTenantScope(tenantID)
+}
@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// ReleaseApprovalPO 发布审批记录持久化对象
+// 对应数据库表 t_release_approvals
+type ReleaseApprovalPO struct {
+	ID          int       `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	ReleaseID   int       `gorm:"column:release_id;not null;index" json:"release_id"`
+	NamespaceID int       `gorm:"column:namespace_id;not null" json:"namespace_id"`
+	Environment string    `gorm:"column:environment;type:varchar(50);not null" json:"environment"`
+	Version     int       `gorm:"column:version;not null" json:"version"`
+	Action      string    `gorm:"column:action;type:varchar(20);not null" json:"action"`
+	Operator    string    `gorm:"column:operator;type:varchar(100);not null" json:"operator"`
+	Comment     string    `gorm:"column:comment;type:text" json:"comment"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (ReleaseApprovalPO) TableName() string {
+	return "t_release_approvals"
+}
+
+// TenantScope 审批记录通过命名空间归属租户
+func (ReleaseApprovalPO) TenantScope(tenantID int) clause.Expression {
+	return namespaceTenantScope(tenantID)
+}
@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// ReleaseFreezeOverridePO 冻结窗口覆盖审计记录持久化对象
+// 对应数据库表 t_release_freeze_overrides
+type ReleaseFreezeOverridePO struct {
+	ID          int       `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	ReleaseID   int       `gorm:"column:release_id;not null" json:"release_id"`
+	NamespaceID int       `gorm:"column:namespace_id;not null;index:idx_t_release_freeze_overrides_ns" json:"namespace_id"`
+	Environment string    `gorm:"column:environment;type:varchar(50);not null;index:idx_t_release_freeze_overrides_ns" json:"environment"`
+	WindowName  string    `gorm:"column:window_name;type:varchar(100);not null" json:"window_name"`
+	Operation   string    `gorm:"column:operation;type:varchar(20);not null" json:"operation"`
+	Operator    string    `gorm:"column:operator;type:varchar(100);not null" json:"operator"`
+	Reason      string    `gorm:"column:reason;type:text;not null" json:"reason"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (ReleaseFreezeOverridePO) TableName() string {
+	return "t_release_freeze_overrides"
+}
+
+// TenantScope 冻结窗口放行记录通过命名空间归属租户
+func (ReleaseFreezeOverridePO) TenantScope(tenantID int) clause.Expression {
+	return namespaceTenantScope(tenantID)
+}
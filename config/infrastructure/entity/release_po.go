@@ -12,6 +12,7 @@ type ReleasePO struct {
 	ID int `gorm:"primaryKey;autoIncrement" json:"id"`
 
 	// 关联信息
+	TenantID    int    `gorm:"column:tenant_id;not null;default:1;index" json:"tenant_id"`
 	NamespaceID int    `gorm:"column:namespace_id;not null;index" json:"namespace_id"`
 	Environment string `gorm:"column:environment;type:varchar(50);not null" json:"environment"`
 
@@ -27,6 +28,18 @@ type ReleasePO struct {
 	Status      string `gorm:"column:status;type:varchar(20);default:'testing'" json:"status"`
 	ReleaseType string `gorm:"column:release_type;type:varchar(20);default:'full'" json:"release_type"`
 
+	// 增量发布
+	BaseReleaseID int `gorm:"column:base_release_id;default:0" json:"base_release_id"`
+
+	// 发布说明
+	ReleaseNotes string `gorm:"column:release_notes;type:text" json:"release_notes"`
+	Changelog    string `gorm:"column:changelog;type:text" json:"changelog"`
+
+	// 发布审批
+	ApprovalStatus string     `gorm:"column:approval_status;type:varchar(20);default:''" json:"approval_status"`
+	ApprovedBy     string     `gorm:"column:approved_by;type:varchar(100)" json:"approved_by"`
+	ApprovedAt     *time.Time `gorm:"column:approved_at" json:"approved_at"`
+
 	// 灰度发布
 	CanaryRule       string `gorm:"column:canary_rule;type:jsonb" json:"canary_rule"`
 	CanaryPercentage int    `gorm:"column:canary_percentage;default:0" json:"canary_percentage"`
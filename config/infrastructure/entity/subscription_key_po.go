@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// SubscriptionKeyPO 订阅配置键持久化对象
+// 对应数据库表 t_subscription_keys
+type SubscriptionKeyPO struct {
+	ID             int       `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	SubscriptionID int       `gorm:"column:subscription_id;not null;index" json:"subscription_id"`
+	NamespaceID    int       `gorm:"column:namespace_id;not null;index:idx_t_subscription_keys_config" json:"namespace_id"`
+	ConfigKey      string    `gorm:"column:config_key;type:varchar(500);not null;index:idx_t_subscription_keys_config" json:"config_key"`
+	Version        string    `gorm:"column:version;type:varchar(64)" json:"version"`
+	ReportedAt     time.Time `gorm:"column:reported_at;not null" json:"reported_at"`
+}
+
+// TableName 指定表名
+func (SubscriptionKeyPO) TableName() string {
+	return "t_subscription_keys"
+}
+
+// TenantScope 订阅键通过命名空间归属租户
+func (SubscriptionKeyPO) TenantScope(tenantID int) clause.Expression {
+	return namespaceTenantScope(tenantID)
+}
@@ -8,6 +8,7 @@ type SubscriptionPO struct {
 	ID int `gorm:"primaryKey;column:id"`
 
 	// 关联信息
+	TenantID    int `gorm:"column:tenant_id;not null;default:1;index:idx_t_subscriptions_tenant_id"`
 	NamespaceID int `gorm:"column:namespace_id;not null;index:idx_t_subscriptions_namespace_id"`
 
 	// 客户端信息
@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// TenantPO 租户持久化对象，对应数据库表 t_tenants
+// 注意：租户表本身不包含 TenantID 字段，不受多租户回调过滤
+type TenantPO struct {
+	ID          int       `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	Code        string    `gorm:"column:code;type:varchar(64);not null;uniqueIndex:uk_t_tenants_code" json:"code"`
+	Name        string    `gorm:"column:name;type:varchar(255);not null" json:"name"`
+	Description string    `gorm:"column:description;type:text" json:"description"`
+	IsActive    bool      `gorm:"column:is_active;default:true" json:"is_active"`
+	CreatedBy   string    `gorm:"column:created_by;type:varchar(100);default:'system'" json:"created_by"`
+	UpdatedBy   string    `gorm:"column:updated_by;type:varchar(100);default:'system'" json:"updated_by"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (TenantPO) TableName() string {
+	return "t_tenants"
+}
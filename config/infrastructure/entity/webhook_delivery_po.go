@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// WebhookDeliveryPO Webhook 投递记录持久化对象，对应数据库表 t_webhook_deliveries
+type WebhookDeliveryPO struct {
+	// 主键
+	ID int64 `gorm:"primaryKey;autoIncrement" json:"id"`
+
+	// 投递目标与事件
+	WebhookID   int    `gorm:"column:webhook_id;not null;index:idx_t_webhook_deliveries_webhook" json:"webhook_id"`
+	NamespaceID int    `gorm:"column:namespace_id;not null" json:"namespace_id"`
+	EventType   string `gorm:"column:event_type;type:varchar(50);not null" json:"event_type"`
+	Payload     string `gorm:"column:payload;type:text;not null" json:"payload"`
+
+	// 投递状态
+	Status         string     `gorm:"column:status;type:varchar(20);not null;default:'pending';index:idx_t_webhook_deliveries_status_next,priority:1" json:"status"`
+	Attempts       int        `gorm:"column:attempts;not null;default:0" json:"attempts"`
+	ResponseStatus int        `gorm:"column:response_status;not null;default:0" json:"response_status"`
+	ResponseBody   string     `gorm:"column:response_body;type:text" json:"response_body"`
+	LastError      string     `gorm:"column:last_error;type:text" json:"last_error"`
+	DurationMs     int64      `gorm:"column:duration_ms;not null;default:0" json:"duration_ms"`
+	NextAttemptAt  time.Time  `gorm:"column:next_attempt_at;not null;index:idx_t_webhook_deliveries_status_next,priority:2" json:"next_attempt_at"`
+	LockedUntil    *time.Time `gorm:"column:locked_until" json:"locked_until"`
+	RedeliveryOf   *int64     `gorm:"column:redelivery_of" json:"redelivery_of"`
+
+	// 时间戳
+	CreatedAt   time.Time  `gorm:"column:created_at;autoCreateTime;index:idx_t_webhook_deliveries_created_at" json:"created_at"`
+	DeliveredAt *time.Time `gorm:"column:delivered_at" json:"delivered_at"`
+}
+
+// TableName 指定表名
+func (WebhookDeliveryPO) TableName() string {
+	return "t_webhook_deliveries"
+}
+
+// TenantScope 投递记录通过命名空间归属租户
+func (WebhookDeliveryPO) TenantScope(tenantID int) clause.Expression {
+	return namespaceTenantScope(tenantID)
+}
+
+// GetID 获取主键ID
+func (e *WebhookDeliveryPO) GetID() int64 {
+	return e.ID
+}
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// WebhookPO Webhook 持久化对象，对应数据库表 t_webhooks
+type WebhookPO struct {
+	ID          int       `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	NamespaceID int       `gorm:"column:namespace_id;not null;index:idx_t_webhooks_namespace" json:"namespace_id"`
+	Name        string    `gorm:"column:name;type:varchar(100);not null" json:"name"`
+	URL         string    `gorm:"column:url;type:varchar(1000);not null" json:"url"`
+	Secret      string    `gorm:"column:secret;type:varchar(255);not null" json:"-"`
+	Events      string    `gorm:"column:events;type:varchar(500);not null;default:''" json:"events"` // 事件过滤，逗号分隔（为空时订阅全部事件）
+	Enabled     bool      `gorm:"column:enabled;not null;default:true" json:"enabled"`
+	CreatedBy   string    `gorm:"column:created_by;type:varchar(100)" json:"created_by"`
+	UpdatedBy   string    `gorm:"column:updated_by;type:varchar(100)" json:"updated_by"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (WebhookPO) TableName() string {
+	return "t_webhooks"
+}
+
+// TenantScope Webhook 通过命名空间归属租户
+func (WebhookPO) TenantScope(tenantID int) clause.Expression {
+	return namespaceTenantScope(tenantID)
+}
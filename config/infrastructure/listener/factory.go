@@ -0,0 +1,33 @@
+package listener
+
+import (
+	"fmt"
+
+	"config-client/config/domain/listener"
+	"config-client/share/config"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// NewConfigListener 根据配置创建配置变更监听器
+// type: redis（默认，Redis Pub/Sub）, kafka, nats（NATS JetStream）, postgres（PostgreSQL LISTEN/NOTIFY）, memory（进程内，仅单节点）
+func NewConfigListener(cfg *config.Config, rdb redis.UniversalClient, db *gorm.DB) (listener.ConfigListener, error) {
+	switch cfg.Listener.Type {
+	case "", "redis":
+		if rdb == nil {
+			return nil, fmt.Errorf("监听器类型为 redis，但 Redis 已禁用")
+		}
+		return NewRedisConfigListener(rdb), nil
+	case "kafka":
+		return NewKafkaConfigListener(cfg.Listener.Kafka), nil
+	case "nats":
+		return NewNATSConfigListener(cfg.Listener.NATS)
+	case "memory":
+		return NewMemoryConfigListener(), nil
+	case "postgres":
+		return NewPostgresConfigListener(cfg.Listener.Postgres, cfg.Database.GetDSN(), db), nil
+	default:
+		return nil, fmt.Errorf("不支持的监听器类型: %s（可选值: redis/kafka/nats/postgres/memory）", cfg.Listener.Type)
+	}
+}
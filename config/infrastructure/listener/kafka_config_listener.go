@@ -0,0 +1,162 @@
+package listener
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"config-client/config/domain/listener"
+	"config-client/share/config"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/segmentio/kafka-go"
+)
+
+// KafkaConfigListener 基于 Kafka 的配置变更监听器
+// 发布：以 "命名空间ID:配置键" 为消息键写入主题，同一配置的事件落在同一分区、保持顺序
+// 订阅：每个实例使用独立消费组（前缀-主机名-进程号）从最新位点开始消费，保证所有实例都收到全部事件
+type KafkaConfigListener struct {
+	cfg    config.KafkaListenerConfig
+	writer *kafka.Writer
+
+	mu     sync.Mutex
+	reader *kafka.Reader
+}
+
+// NewKafkaConfigListener 创建 Kafka 配置监听器
+func NewKafkaConfigListener(cfg config.KafkaListenerConfig) *KafkaConfigListener {
+	return &KafkaConfigListener{
+		cfg: cfg,
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(cfg.Brokers...),
+			Topic:                  cfg.Topic,
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: true,
+			BatchTimeout:           10 * time.Millisecond, // 配置变更频率低，缩短攒批等待以降低通知延迟
+		},
+	}
+}
+
+// Subscribe 订阅配置变更
+func (l *KafkaConfigListener) Subscribe(ctx context.Context) (<-chan *listener.ConfigChangeEvent, error) {
+	hostname, _ := os.Hostname()
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     l.cfg.Brokers,
+		Topic:       l.cfg.Topic,
+		GroupID:     fmt.Sprintf("%s-%s-%d", l.cfg.GroupPrefix, hostname, os.Getpid()),
+		StartOffset: kafka.LastOffset,
+		MaxWait:     500 * time.Millisecond,
+	})
+
+	l.mu.Lock()
+	l.reader = reader
+	l.mu.Unlock()
+
+	// 创建事件通道
+	eventChan := make(chan *listener.ConfigChangeEvent, 100)
+
+	// 启动goroutine接收消息
+	go func() {
+		defer close(eventChan)
+		for {
+			msg, err := reader.ReadMessage(ctx)
+			if err != nil {
+				if ctx.Err() != nil || errors.Is(err, io.EOF) {
+					return
+				}
+				hlog.Errorf("读取 Kafka 配置变更事件失败: %v", err)
+				select {
+				case <-time.After(time.Second):
+					continue
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			// 解析消息
+			var event listener.ConfigChangeEvent
+			if err := json.Unmarshal(msg.Value, &event); err != nil {
+				continue
+			}
+			// 发送事件
+			select {
+			case eventChan <- &event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return eventChan, nil
+}
+
+// Publish 发布配置变更事件
+func (l *KafkaConfigListener) Publish(ctx context.Context, event *listener.ConfigChangeEvent) error {
+	return l.PublishBatch(ctx, []*listener.ConfigChangeEvent{event})
+}
+
+// PublishBatch 批量发布配置变更事件（一次写入请求发送全部消息）
+func (l *KafkaConfigListener) PublishBatch(ctx context.Context, events []*listener.ConfigChangeEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	messages := make([]kafka.Message, 0, len(events))
+	for _, event := range events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("序列化配置变更事件失败: %w", err)
+		}
+		messages = append(messages, kafka.Message{
+			Key:   []byte(strconv.Itoa(event.NamespaceID) + ":" + event.ConfigKey),
+			Value: data,
+		})
+	}
+
+	if err := l.writer.WriteMessages(ctx, messages...); err != nil {
+		return fmt.Errorf("发布配置变更事件失败: %w", err)
+	}
+	return nil
+}
+
+// CheckHealth 检查订阅状态和 Broker 连通性
+func (l *KafkaConfigListener) CheckHealth(ctx context.Context) error {
+	l.mu.Lock()
+	reader := l.reader
+	l.mu.Unlock()
+	if reader == nil {
+		return errors.New("尚未订阅配置变更主题")
+	}
+
+	var lastErr error
+	for _, broker := range l.cfg.Brokers {
+		conn, err := kafka.DialContext(ctx, "tcp", broker)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		return conn.Close()
+	}
+	return fmt.Errorf("无法连接 Kafka Broker: %w", lastErr)
+}
+
+// Close 关闭监听器
+func (l *KafkaConfigListener) Close() error {
+	l.mu.Lock()
+	reader := l.reader
+	l.mu.Unlock()
+
+	var errs []error
+	if reader != nil {
+		errs = append(errs, reader.Close())
+	}
+	errs = append(errs, l.writer.Close())
+	return errors.Join(errs...)
+}
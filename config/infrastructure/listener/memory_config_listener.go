@@ -0,0 +1,139 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"config-client/config/domain/listener"
+)
+
+// errMemoryListenerClosed 监听器已关闭
+var errMemoryListenerClosed = errors.New("内存配置监听器已关闭")
+
+// MemoryConfigListener 基于进程内通道的配置变更监听器
+// 发布的事件扇出到本进程内的所有订阅者，不跨实例传播，仅适用于单节点部署和本地开发
+type MemoryConfigListener struct {
+	mu          sync.RWMutex
+	subscribers map[*memorySubscriber]struct{}
+	closed      bool
+}
+
+// memorySubscriber 内存订阅者
+type memorySubscriber struct {
+	events chan *listener.ConfigChangeEvent
+	done   chan struct{} // 退订信号，用于唤醒阻塞在该订阅者上的发布方
+	once   sync.Once
+}
+
+// stop 发出退订信号
+func (s *memorySubscriber) stop() {
+	s.once.Do(func() { close(s.done) })
+}
+
+// NewMemoryConfigListener 创建内存配置监听器
+func NewMemoryConfigListener() *MemoryConfigListener {
+	return &MemoryConfigListener{
+		subscribers: make(map[*memorySubscriber]struct{}),
+	}
+}
+
+// Subscribe 订阅配置变更（上下文取消时自动退订并关闭通道）
+func (l *MemoryConfigListener) Subscribe(ctx context.Context) (<-chan *listener.ConfigChangeEvent, error) {
+	// 创建事件通道
+	sub := &memorySubscriber{
+		events: make(chan *listener.ConfigChangeEvent, 100),
+		done:   make(chan struct{}),
+	}
+
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return nil, errMemoryListenerClosed
+	}
+	l.subscribers[sub] = struct{}{}
+	l.mu.Unlock()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			l.unsubscribe(sub)
+		case <-sub.done:
+		}
+	}()
+
+	return sub.events, nil
+}
+
+// unsubscribe 退订并关闭通道
+// 先发出退订信号唤醒阻塞的发布方（释放读锁），再加写锁关闭通道，避免向已关闭通道发送
+func (l *MemoryConfigListener) unsubscribe(sub *memorySubscriber) {
+	sub.stop()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.subscribers[sub]; ok {
+		delete(l.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+// Publish 发布配置变更事件
+func (l *MemoryConfigListener) Publish(ctx context.Context, event *listener.ConfigChangeEvent) error {
+	return l.PublishBatch(ctx, []*listener.ConfigChangeEvent{event})
+}
+
+// PublishBatch 批量发布配置变更事件
+// 订阅者通道已满时阻塞等待，直至订阅者退订或上下文取消，保证事件不丢失
+func (l *MemoryConfigListener) PublishBatch(ctx context.Context, events []*listener.ConfigChangeEvent) error {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if l.closed {
+		return errMemoryListenerClosed
+	}
+
+	for _, event := range events {
+		for sub := range l.subscribers {
+			select {
+			case sub.events <- event:
+			case <-sub.done:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	return nil
+}
+
+// CheckHealth 检查监听器状态
+func (l *MemoryConfigListener) CheckHealth(ctx context.Context) error {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if l.closed {
+		return errMemoryListenerClosed
+	}
+	return nil
+}
+
+// Close 关闭监听器及所有订阅通道
+func (l *MemoryConfigListener) Close() error {
+	l.mu.RLock()
+	subscribers := make([]*memorySubscriber, 0, len(l.subscribers))
+	for sub := range l.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+	l.mu.RUnlock()
+
+	for _, sub := range subscribers {
+		sub.stop()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.closed = true
+	for sub := range l.subscribers {
+		delete(l.subscribers, sub)
+		close(sub.events)
+	}
+	return nil
+}
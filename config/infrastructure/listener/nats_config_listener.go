@@ -0,0 +1,216 @@
+package listener
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"config-client/config/domain/listener"
+	"config-client/share/config"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// NATSConfigListener 基于 NATS JetStream 的配置变更监听器
+// 发布：写入主题 "前缀.命名空间ID"，事件持久化在流中（保留 max_age）
+// 订阅：每个实例使用名称固定的持久化消费者，逐条确认；实例重启后从上次确认位置继续消费，停机期间的事件不会丢失
+type NATSConfigListener struct {
+	cfg config.NATSListenerConfig
+	nc  *nats.Conn
+	js  jetstream.JetStream
+
+	mu       sync.Mutex
+	consumer jetstream.Consumer
+	consume  jetstream.ConsumeContext
+}
+
+// NewNATSConfigListener 创建 NATS JetStream 配置监听器（连接服务器并确保流存在）
+func NewNATSConfigListener(cfg config.NATSListenerConfig) (*NATSConfigListener, error) {
+	// 1. 连接服务器（断线后无限重连）
+	nc, err := nats.Connect(cfg.URL,
+		nats.Name("config-center"),
+		nats.MaxReconnects(-1),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			if err != nil {
+				hlog.Warnf("NATS 连接断开: %v", err)
+			}
+		}),
+		nats.ReconnectHandler(func(nc *nats.Conn) {
+			hlog.Infof("NATS 已重连: %s", nc.ConnectedUrl())
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("连接 NATS 失败: %w", err)
+	}
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("创建 JetStream 上下文失败: %w", err)
+	}
+
+	// 2. 创建或更新流
+	ctx, cancel := context.WithTimeout(context.Background(), nats.DefaultTimeout)
+	defer cancel()
+	if _, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     cfg.Stream,
+		Subjects: []string{cfg.SubjectPrefix + ".>"},
+		Storage:  jetstream.FileStorage,
+		MaxAge:   cfg.GetMaxAge(),
+	}); err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("创建 JetStream 流失败: stream=%s, err=%w", cfg.Stream, err)
+	}
+
+	return &NATSConfigListener{cfg: cfg, nc: nc, js: js}, nil
+}
+
+// Subscribe 订阅配置变更
+func (l *NATSConfigListener) Subscribe(ctx context.Context) (<-chan *listener.ConfigChangeEvent, error) {
+	// 1. 创建或复用持久化消费者（首次创建时只投递之后的新事件，已存在时从上次确认位置继续）
+	consumer, err := l.js.CreateOrUpdateConsumer(ctx, l.cfg.Stream, jetstream.ConsumerConfig{
+		Durable:           l.cfg.ConsumerName,
+		FilterSubject:     l.cfg.SubjectPrefix + ".>",
+		DeliverPolicy:     jetstream.DeliverNewPolicy,
+		AckPolicy:         jetstream.AckExplicitPolicy,
+		InactiveThreshold: l.cfg.GetInactiveThreshold(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("创建 JetStream 消费者失败: consumer=%s, err=%w", l.cfg.ConsumerName, err)
+	}
+
+	// 创建事件通道
+	eventChan := make(chan *listener.ConfigChangeEvent, 100)
+	done := make(chan struct{})
+	var closeOnce sync.Once
+	stop := func() {
+		closeOnce.Do(func() { close(done) })
+	}
+
+	// 2. 消费消息（事件投递到通道后再确认，未确认的消息会被服务端重投）
+	consume, err := consumer.Consume(func(msg jetstream.Msg) {
+		var event listener.ConfigChangeEvent
+		if err := json.Unmarshal(msg.Data(), &event); err != nil {
+			// 无法解析的消息直接终止投递，避免反复重投
+			_ = msg.Term()
+			return
+		}
+		select {
+		case eventChan <- &event:
+			if err := msg.Ack(); err != nil {
+				hlog.Warnf("确认 NATS 配置变更事件失败: %v", err)
+			}
+		case <-done:
+			_ = msg.Nak()
+		}
+	}, jetstream.ConsumeErrHandler(func(_ jetstream.ConsumeContext, err error) {
+		hlog.Errorf("消费 NATS 配置变更事件失败: %v", err)
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("启动 JetStream 消费失败: %w", err)
+	}
+
+	l.mu.Lock()
+	l.consumer = consumer
+	l.consume = consume
+	l.mu.Unlock()
+
+	// 3. 上下文取消时停止消费并关闭通道
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-consume.Closed():
+		}
+		stop()
+		consume.Stop()
+		<-consume.Closed()
+		close(eventChan)
+	}()
+
+	return eventChan, nil
+}
+
+// Publish 发布配置变更事件
+func (l *NATSConfigListener) Publish(ctx context.Context, event *listener.ConfigChangeEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("序列化配置变更事件失败: %w", err)
+	}
+
+	if _, err := l.js.Publish(ctx, l.subject(event), data); err != nil {
+		return fmt.Errorf("发布配置变更事件失败: %w", err)
+	}
+	return nil
+}
+
+// PublishBatch 批量发布配置变更事件（异步发布后统一等待服务端确认）
+func (l *NATSConfigListener) PublishBatch(ctx context.Context, events []*listener.ConfigChangeEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	futures := make([]jetstream.PubAckFuture, 0, len(events))
+	for _, event := range events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("序列化配置变更事件失败: %w", err)
+		}
+		future, err := l.js.PublishAsync(l.subject(event), data)
+		if err != nil {
+			return fmt.Errorf("发布配置变更事件失败: %w", err)
+		}
+		futures = append(futures, future)
+	}
+
+	for _, future := range futures {
+		select {
+		case <-future.Ok():
+		case err := <-future.Err():
+			return fmt.Errorf("发布配置变更事件失败: %w", err)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// CheckHealth 检查服务器连接和消费者状态
+func (l *NATSConfigListener) CheckHealth(ctx context.Context) error {
+	if !l.nc.IsConnected() {
+		return fmt.Errorf("NATS 连接不可用: status=%s", l.nc.Status())
+	}
+
+	l.mu.Lock()
+	consumer := l.consumer
+	l.mu.Unlock()
+	if consumer == nil {
+		return errors.New("尚未订阅配置变更主题")
+	}
+
+	if _, err := consumer.Info(ctx); err != nil {
+		return fmt.Errorf("获取 JetStream 消费者信息失败: %w", err)
+	}
+	return nil
+}
+
+// Close 关闭监听器（停止消费并排空连接，保留持久化消费者以便重启后续接）
+func (l *NATSConfigListener) Close() error {
+	l.mu.Lock()
+	consume := l.consume
+	l.mu.Unlock()
+
+	if consume != nil {
+		consume.Stop()
+	}
+	return l.nc.Drain()
+}
+
+// subject 事件对应的主题
+func (l *NATSConfigListener) subject(event *listener.ConfigChangeEvent) string {
+	return l.cfg.SubjectPrefix + "." + strconv.Itoa(event.NamespaceID)
+}
@@ -0,0 +1,195 @@
+package listener
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"config-client/config/domain/listener"
+	"config-client/share/config"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/jackc/pgx/v5"
+	"gorm.io/gorm"
+)
+
+// postgresNotifyMaxPayload PostgreSQL NOTIFY 负载上限（默认配置下为 8000 字节）
+const postgresNotifyMaxPayload = 8000
+
+// PostgresConfigListener 基于 PostgreSQL LISTEN/NOTIFY 的配置变更监听器
+// 适用于小规模部署：无需 Redis/Kafka 等中间件，仅依赖配置中心数据库即可实现多实例通知
+// 发布：通过业务连接池执行 pg_notify，批量发布在同一事务中执行，提交后一次性投递
+// 订阅：使用独立的数据库连接执行 LISTEN，断线后自动重连（与 Redis Pub/Sub 一致，断线期间的事件不会补发）
+type PostgresConfigListener struct {
+	cfg     config.PostgresListenerConfig
+	dsn     string
+	db      *gorm.DB
+	channel string // 经过转义的通道标识符
+
+	listening atomic.Bool
+	mu        sync.Mutex
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+// NewPostgresConfigListener 创建 PostgreSQL 配置监听器
+// dsn: 订阅专用连接的连接串；db: 用于发布通知的业务连接池
+func NewPostgresConfigListener(cfg config.PostgresListenerConfig, dsn string, db *gorm.DB) *PostgresConfigListener {
+	return &PostgresConfigListener{
+		cfg:     cfg,
+		dsn:     dsn,
+		db:      db,
+		channel: pgx.Identifier{cfg.Channel}.Sanitize(),
+	}
+}
+
+// Subscribe 订阅配置变更
+func (l *PostgresConfigListener) Subscribe(ctx context.Context) (<-chan *listener.ConfigChangeEvent, error) {
+	// 1. 建立订阅连接（首次连接失败直接返回，便于启动时暴露配置错误）
+	conn, err := l.listen(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	l.mu.Lock()
+	l.cancel = cancel
+	l.done = done
+	l.mu.Unlock()
+
+	// 创建事件通道
+	eventChan := make(chan *listener.ConfigChangeEvent, 100)
+
+	// 2. 启动goroutine接收通知，连接异常时重连
+	go func() {
+		defer close(done)
+		defer close(eventChan)
+		defer func() {
+			if conn != nil {
+				_ = conn.Close(context.Background())
+			}
+		}()
+
+		for {
+			if conn == nil {
+				if conn, err = l.listen(ctx); err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+					hlog.Errorf("重连 PostgreSQL 配置变更通道失败: %v", err)
+					select {
+					case <-time.After(l.cfg.GetReconnectInterval()):
+						continue
+					case <-ctx.Done():
+						return
+					}
+				}
+				hlog.Infof("已重新订阅 PostgreSQL 配置变更通道: %s", l.cfg.Channel)
+			}
+
+			notification, err := conn.WaitForNotification(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				l.listening.Store(false)
+				hlog.Errorf("接收 PostgreSQL 配置变更通知失败: %v", err)
+				_ = conn.Close(context.Background())
+				conn = nil
+				continue
+			}
+
+			// 解析消息
+			var event listener.ConfigChangeEvent
+			if err := json.Unmarshal([]byte(notification.Payload), &event); err != nil {
+				continue
+			}
+			// 发送事件
+			select {
+			case eventChan <- &event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return eventChan, nil
+}
+
+// listen 建立订阅专用连接并执行 LISTEN
+func (l *PostgresConfigListener) listen(ctx context.Context) (*pgx.Conn, error) {
+	conn, err := pgx.Connect(ctx, l.dsn)
+	if err != nil {
+		return nil, fmt.Errorf("连接 PostgreSQL 失败: %w", err)
+	}
+	if _, err := conn.Exec(ctx, "LISTEN "+l.channel); err != nil {
+		_ = conn.Close(context.Background())
+		return nil, fmt.Errorf("订阅配置变更通道失败: channel=%s, err=%w", l.cfg.Channel, err)
+	}
+	l.listening.Store(true)
+	return conn, nil
+}
+
+// Publish 发布配置变更事件
+func (l *PostgresConfigListener) Publish(ctx context.Context, event *listener.ConfigChangeEvent) error {
+	return l.notify(l.db.WithContext(ctx), event)
+}
+
+// PublishBatch 批量发布配置变更事件（同一事务内发送，事务提交后统一投递）
+func (l *PostgresConfigListener) PublishBatch(ctx context.Context, events []*listener.ConfigChangeEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	return l.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, event := range events {
+			if err := l.notify(tx, event); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// notify 执行 pg_notify
+func (l *PostgresConfigListener) notify(db *gorm.DB, event *listener.ConfigChangeEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("序列化配置变更事件失败: %w", err)
+	}
+	if len(data) >= postgresNotifyMaxPayload {
+		return fmt.Errorf("配置变更事件超过 NOTIFY 负载上限: size=%d, limit=%d", len(data), postgresNotifyMaxPayload)
+	}
+
+	if err := db.Exec("SELECT pg_notify(?, ?)", l.cfg.Channel, string(data)).Error; err != nil {
+		return fmt.Errorf("发布配置变更事件失败: %w", err)
+	}
+	return nil
+}
+
+// CheckHealth 检查订阅连接状态
+func (l *PostgresConfigListener) CheckHealth(ctx context.Context) error {
+	if !l.listening.Load() {
+		return errors.New("PostgreSQL 配置变更通道未订阅或连接已断开")
+	}
+	return nil
+}
+
+// Close 关闭监听器（关闭订阅连接，业务连接池由调用方管理）
+func (l *PostgresConfigListener) Close() error {
+	l.mu.Lock()
+	cancel, done := l.cancel, l.done
+	l.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+	l.listening.Store(false)
+	return nil
+}
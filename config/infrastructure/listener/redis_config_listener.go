@@ -3,6 +3,7 @@ package listener
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"config-client/config/domain/listener"
@@ -16,13 +17,14 @@ const (
 )
 
 // RedisConfigListener 基于Redis Pub/Sub的配置变更监听器
+// 客户端支持单机、哨兵和集群模式（集群模式下 PUBLISH 会广播到所有节点，订阅任一节点即可收到）
 type RedisConfigListener struct {
-	client *redis.Client
+	client redis.UniversalClient
 	pubsub *redis.PubSub
 }
 
 // NewRedisConfigListener 创建Redis配置监听器
-func NewRedisConfigListener(client *redis.Client) *RedisConfigListener {
+func NewRedisConfigListener(client redis.UniversalClient) *RedisConfigListener {
 	return &RedisConfigListener{
 		client: client,
 	}
@@ -88,6 +90,37 @@ func (l *RedisConfigListener) Publish(ctx context.Context, event *listener.Confi
 	return nil
 }
 
+// PublishBatch 批量发布配置变更事件
+// 使用 Pipeline 一次网络往返发布全部事件，订阅方仍按单个事件接收
+func (l *RedisConfigListener) PublishBatch(ctx context.Context, events []*listener.ConfigChangeEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	pipe := l.client.Pipeline()
+	for _, event := range events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("序列化配置变更事件失败: %w", err)
+		}
+		pipe.Publish(ctx, ConfigChangeChannel, data)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("批量发布配置变更事件失败: %w", err)
+	}
+
+	return nil
+}
+
+// CheckHealth 检查订阅连接（在订阅连接上发送 PING）
+func (l *RedisConfigListener) CheckHealth(ctx context.Context) error {
+	if l.pubsub == nil {
+		return errors.New("尚未订阅配置变更频道")
+	}
+	return l.pubsub.Ping(ctx)
+}
+
 // Close 关闭监听器
 func (l *RedisConfigListener) Close() error {
 	if l.pubsub != nil {
@@ -0,0 +1,278 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+)
+
+// sqlFiles 内嵌的迁移脚本，文件名格式与 golang-migrate 一致：{版本号}_{名称}.up.sql / .down.sql
+//
+//go:embed sql/*.sql
+var sqlFiles embed.FS
+
+// migrationsTable 迁移版本表，表结构与 golang-migrate 一致（单行：当前版本号 + 是否处于失败状态），
+// 必要时可直接使用 migrate 命令行工具排查
+const migrationsTable = "schema_migrations"
+
+// advisoryLockKey 迁移使用的 PostgreSQL 咨询锁，多实例同时启动时只有一个实例执行迁移
+const advisoryLockKey int64 = 0x636667_6d6967 // "cfgmig"
+
+// baselineTable 用于识别手工导入过初始表结构的数据库
+const baselineTable = "t_namespaces"
+
+var fileNamePattern = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Migration 单个版本化迁移
+type Migration struct {
+	Version int64  // 版本号
+	Name    string // 名称
+	Up      string // 升级脚本
+	Down    string // 回退脚本（可为空，表示不支持回退）
+}
+
+// Migrator 版本化迁移执行器
+// 每个迁移在独立事务中执行脚本并更新版本号，失败时整体回滚，版本号保持不变
+type Migrator struct {
+	db         *gorm.DB
+	migrations []*Migration
+}
+
+// NewMigrator 创建迁移执行器（加载内嵌的迁移脚本）
+func NewMigrator(db *gorm.DB) (*Migrator, error) {
+	migrations, err := loadMigrations(sqlFiles, "sql")
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// LatestVersion 最新的迁移版本号
+func (m *Migrator) LatestVersion() int64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Version 查询数据库当前的迁移版本（未执行过迁移时为 0）
+func (m *Migrator) Version(ctx context.Context) (version int64, dirty bool, err error) {
+	err = m.withLock(ctx, func(conn *gorm.DB) error {
+		version, dirty, err = readVersion(ctx, conn)
+		return err
+	})
+	return version, dirty, err
+}
+
+// Up 执行全部未执行的迁移，返回执行的迁移数量
+// 业务规则：
+// 1. 持有咨询锁期间执行，多实例同时启动时其余实例等待后发现已是最新版本
+// 2. 版本表不存在但初始表已存在（手工导入过表结构）时，以版本 1 作为基线，不重复执行初始脚本
+// 3. 版本表处于失败状态（dirty）时拒绝执行，需人工确认表结构后修正版本号
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	applied := 0
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		if err := m.ensureVersionTable(conn); err != nil {
+			return err
+		}
+
+		current, dirty, err := readVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if dirty {
+			return fmt.Errorf("数据库迁移版本 %d 处于失败状态，请确认表结构后修正 %s 表", current, migrationsTable)
+		}
+
+		for _, migration := range m.migrations {
+			if migration.Version <= current {
+				continue
+			}
+			hlog.Infof("执行数据库迁移: %d_%s", migration.Version, migration.Name)
+			if err := apply(ctx, conn, migration.Up, migration.Version); err != nil {
+				return fmt.Errorf("执行数据库迁移 %d_%s 失败: %w", migration.Version, migration.Name, err)
+			}
+			applied++
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down 回退最近的 steps 个已执行迁移，返回回退的迁移数量
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	reverted := 0
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		current, dirty, err := readVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if dirty {
+			return fmt.Errorf("数据库迁移版本 %d 处于失败状态，请确认表结构后修正 %s 表", current, migrationsTable)
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && reverted < steps; i-- {
+			migration := m.migrations[i]
+			if migration.Version > current {
+				continue
+			}
+			if migration.Down == "" {
+				return fmt.Errorf("数据库迁移 %d_%s 不支持回退", migration.Version, migration.Name)
+			}
+
+			var previous int64
+			if i > 0 {
+				previous = m.migrations[i-1].Version
+			}
+			hlog.Infof("回退数据库迁移: %d_%s", migration.Version, migration.Name)
+			if err := apply(ctx, conn, migration.Down, previous); err != nil {
+				return fmt.Errorf("回退数据库迁移 %d_%s 失败: %w", migration.Version, migration.Name, err)
+			}
+			reverted++
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// withLock 在固定连接上持有咨询锁执行（咨询锁是会话级的，加锁和解锁必须在同一连接上）
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *gorm.DB) error) error {
+	return m.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		if err := conn.Exec("SELECT pg_advisory_lock(?)", advisoryLockKey).Error; err != nil {
+			return fmt.Errorf("获取数据库迁移锁失败: %w", err)
+		}
+		defer conn.Exec("SELECT pg_advisory_unlock(?)", advisoryLockKey)
+
+		return fn(conn)
+	})
+}
+
+// ensureVersionTable 创建迁移版本表，已导入初始表结构的数据库以版本 1 作为基线
+func (m *Migrator) ensureVersionTable(conn *gorm.DB) error {
+	exists, err := tableExists(conn, migrationsTable)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	if err := conn.Exec(`CREATE TABLE ` + migrationsTable + ` (version BIGINT NOT NULL PRIMARY KEY, dirty BOOLEAN NOT NULL)`).Error; err != nil {
+		return fmt.Errorf("创建迁移版本表失败: %w", err)
+	}
+
+	baseline, err := tableExists(conn, baselineTable)
+	if err != nil {
+		return err
+	}
+	if baseline && len(m.migrations) > 0 {
+		version := m.migrations[0].Version
+		if err := conn.Exec("INSERT INTO "+migrationsTable+" (version, dirty) VALUES (?, false)", version).Error; err != nil {
+			return fmt.Errorf("写入迁移基线版本失败: %w", err)
+		}
+		hlog.Warnf("检测到已存在的表结构，以迁移版本 %d 作为基线", version)
+	}
+	return nil
+}
+
+// apply 在事务中执行迁移脚本并更新版本号（version 为 0 时清空版本表）
+func apply(ctx context.Context, conn *gorm.DB, script string, version int64) error {
+	return conn.Transaction(func(tx *gorm.DB) error {
+		// 直接在底层连接上执行：脚本包含多条语句，且不经过 GORM 占位符解析和 SQL 日志
+		if _, err := tx.Statement.ConnPool.ExecContext(ctx, script); err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM " + migrationsTable).Error; err != nil {
+			return err
+		}
+		if version == 0 {
+			return nil
+		}
+		return tx.Exec("INSERT INTO "+migrationsTable+" (version, dirty) VALUES (?, false)", version).Error
+	})
+}
+
+// readVersion 读取当前迁移版本（版本表不存在或为空时为 0）
+func readVersion(ctx context.Context, conn *gorm.DB) (int64, bool, error) {
+	exists, err := tableExists(conn, migrationsTable)
+	if err != nil || !exists {
+		return 0, false, err
+	}
+
+	var version int64
+	var dirty bool
+	row := conn.Statement.ConnPool.QueryRowContext(ctx, "SELECT version, dirty FROM "+migrationsTable+" LIMIT 1")
+	if err := row.Scan(&version, &dirty); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("读取迁移版本失败: %w", err)
+	}
+	return version, dirty, nil
+}
+
+// tableExists 表是否存在（当前 search_path 下）
+func tableExists(conn *gorm.DB, table string) (bool, error) {
+	var exists bool
+	if err := conn.Raw("SELECT to_regclass(?) IS NOT NULL", table).Scan(&exists).Error; err != nil {
+		return false, fmt.Errorf("检查表 %s 是否存在失败: %w", table, err)
+	}
+	return exists, nil
+}
+
+// loadMigrations 从目录加载迁移脚本（按版本号升序），每个版本必须有升级脚本
+func loadMigrations(fsys fs.FS, dir string) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("读取迁移脚本目录失败: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("迁移脚本 %s 版本号无效", entry.Name())
+		}
+		content, err := fs.ReadFile(fsys, dir+"/"+entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("读取迁移脚本 %s 失败: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		} else if migration.Name != match[2] {
+			return nil, fmt.Errorf("迁移版本 %d 存在多个名称: %s, %s", version, migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("迁移版本 %d_%s 缺少升级脚本", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
@@ -0,0 +1,33 @@
+-- ============================================================================
+-- 回退初始表结构（删除全部表、视图和函数，数据不可恢复）
+-- ============================================================================
+
+DROP VIEW IF EXISTS v_active_subscriptions;
+DROP VIEW IF EXISTS v_config_overview;
+
+DROP TABLE IF EXISTS t_notification_channels;
+DROP TABLE IF EXISTS t_webhook_deliveries;
+DROP TABLE IF EXISTS t_webhooks;
+DROP TABLE IF EXISTS t_release_freeze_overrides;
+DROP TABLE IF EXISTS t_release_approvals;
+DROP TABLE IF EXISTS t_push_traces;
+DROP TABLE IF EXISTS t_subscription_keys;
+DROP TABLE IF EXISTS t_config_files;
+DROP TABLE IF EXISTS t_config_dependencies;
+DROP TABLE IF EXISTS t_config_groups;
+DROP TABLE IF EXISTS t_change_history_archive;
+DROP TABLE IF EXISTS t_event_outbox;
+DROP TABLE IF EXISTS t_change_event_sequences;
+DROP TABLE IF EXISTS t_change_events;
+DROP TABLE IF EXISTS t_config_schemas;
+DROP TABLE IF EXISTS t_system_configs;
+DROP TABLE IF EXISTS t_config_tags;
+DROP TABLE IF EXISTS t_release_versions;
+DROP TABLE IF EXISTS t_change_history;
+DROP TABLE IF EXISTS t_subscriptions;
+DROP TABLE IF EXISTS t_configs;
+DROP TABLE IF EXISTS t_namespaces;
+
+DROP FUNCTION IF EXISTS log_config_change();
+DROP FUNCTION IF EXISTS md5_hash(TEXT);
+DROP FUNCTION IF EXISTS update_updated_at_column();
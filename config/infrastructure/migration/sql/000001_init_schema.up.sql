@@ -1,8 +1,11 @@
 -- ============================================================================
--- 配置中心数据库表结构设计
+-- 配置中心数据库表结构设计（初始版本）
 -- 数据库: PostgreSQL 16
 -- 编码: UTF-8
 --
+-- 由服务启动时的版本化迁移执行（database.migrate），后续表结构变更以新的迁移文件追加，
+-- 不要修改已发布的迁移文件
+--
 -- 注意：本设计不使用数据库外键约束，数据一致性由应用层维护
 -- 优点：更灵活的性能优化、避免级联删除带来的风险、更容易实现分布式架构
 -- ============================================================================
@@ -72,7 +75,7 @@ CREATE TABLE t_configs (
 
     -- 配置值
     value TEXT,                                     -- 配置值（文本格式）
-    value_type VARCHAR(50) DEFAULT 'string',        -- 值类型：string/json/int/float/boolean/encrypted
+    value_type VARCHAR(50) DEFAULT 'string',        -- 值类型：string/json/int/float/boolean/encrypted/file
 
     -- 配置哈希（用于快速比对配置内容是否变化）
     content_hash VARCHAR(32),                       -- 配置内容的MD5哈希值
@@ -88,6 +91,7 @@ CREATE TABLE t_configs (
     -- 状态管理
     is_active BOOLEAN DEFAULT true,                 -- 是否启用
     is_deleted BOOLEAN DEFAULT false,               -- 是否删除（软删除）
+    expires_at TIMESTAMP,                           -- 过期时间（为空表示永不过期）
 
     -- 审计字段
     created_by VARCHAR(100) DEFAULT 'system',       -- 创建人
@@ -112,20 +116,29 @@ CREATE INDEX idx_t_configs_group ON t_configs(group_name) WHERE is_deleted = fal
 CREATE INDEX idx_t_configs_version ON t_configs(version) WHERE is_deleted = false;
 CREATE INDEX idx_t_configs_released ON t_configs(is_released) WHERE is_deleted = false;
 CREATE INDEX idx_t_configs_hash ON t_configs(content_hash) WHERE is_deleted = false;
+CREATE INDEX idx_t_configs_expires_at ON t_configs(expires_at) WHERE is_deleted = false AND expires_at IS NOT NULL;
 
 -- 复合索引：优化查询性能
 CREATE INDEX idx_t_configs_ns_env ON t_configs(namespace_id, environment) WHERE is_deleted = false;
 CREATE INDEX idx_t_configs_ns_env_ver ON t_configs(namespace_id, environment, version) WHERE is_deleted = false;
 
+-- 三元组索引：加速配置搜索（ILIKE 任意位置子串匹配），依赖 pg_trgm 扩展
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE INDEX idx_t_configs_key_trgm ON t_configs USING gin (key gin_trgm_ops) WHERE is_deleted = false;
+CREATE INDEX idx_t_configs_group_trgm ON t_configs USING gin (group_name gin_trgm_ops) WHERE is_deleted = false;
+CREATE INDEX idx_t_configs_description_trgm ON t_configs USING gin (description gin_trgm_ops) WHERE is_deleted = false;
+CREATE INDEX idx_t_configs_value_trgm ON t_configs USING gin (value gin_trgm_ops) WHERE is_deleted = false;
+
 -- 注释
 COMMENT ON TABLE t_configs IS '配置项表，存储应用的所有配置';
 COMMENT ON COLUMN t_configs.namespace_id IS '所属命名空间ID，关联 t_namespaces 表';
 COMMENT ON COLUMN t_configs.key IS '配置键，例如：database.host、redis.port';
 COMMENT ON COLUMN t_configs.value IS '配置值，存储实际配置数据';
-COMMENT ON COLUMN t_configs.value_type IS '值类型：string/json/int/float/boolean/encrypted';
+COMMENT ON COLUMN t_configs.value_type IS '值类型：string/json/int/float/boolean/encrypted/file';
 COMMENT ON COLUMN t_configs.content_hash IS '配置内容的MD5哈希值，用于快速比对配置是否变化';
 COMMENT ON COLUMN t_configs.content_hash_algorithm IS '哈希算法，默认使用MD5';
 COMMENT ON COLUMN t_configs.group_name IS '配置分组，用于逻辑分类，例如：database、cache、feature';
+COMMENT ON COLUMN t_configs.expires_at IS '过期时间，到期后由后台任务停用或删除，适用于临时开关和临时凭据';
 COMMENT ON COLUMN t_configs.environment IS '环境标识：dev/test/staging/prod';
 COMMENT ON COLUMN t_configs.version IS '版本号，每次修改自动递增';
 COMMENT ON COLUMN t_configs.is_released IS '是否已发布到生产环境';
@@ -234,7 +247,7 @@ CREATE TABLE t_change_history (
     environment VARCHAR(50) DEFAULT 'default',      -- 环境（冗余字段）
 
     -- 变更信息
-    operation VARCHAR(20) NOT NULL,                 -- 操作类型：CREATE/UPDATE/DELETE/ROLLBACK
+    operation VARCHAR(20) NOT NULL,                 -- 操作类型：CREATE/UPDATE/DELETE/ROLLBACK/RESTORE/EXPIRE
     old_value TEXT,                                 -- 变更前的值
     new_value TEXT,                                 -- 变更后的值
 
@@ -268,7 +281,7 @@ CREATE INDEX idx_t_change_history_ns_key ON t_change_history(namespace_id, confi
 
 -- 注释
 COMMENT ON TABLE t_change_history IS '配置变更历史表，记录所有配置的变更操作';
-COMMENT ON COLUMN t_change_history.operation IS '操作类型：CREATE（创建）/UPDATE（更新）/DELETE（删除）/ROLLBACK（回滚）';
+COMMENT ON COLUMN t_change_history.operation IS '操作类型：CREATE（创建）/UPDATE（更新）/DELETE（删除）/ROLLBACK（回滚）/RESTORE（恢复）/EXPIRE（过期）';
 COMMENT ON COLUMN t_change_history.old_value IS '变更前的配置值';
 COMMENT ON COLUMN t_change_history.new_value IS '变更后的配置值';
 COMMENT ON COLUMN t_change_history.change_reason IS '变更原因说明，例如：切换到新数据库服务器';
@@ -309,6 +322,18 @@ CREATE TABLE t_release_versions (
     status VARCHAR(20) DEFAULT 'testing',            -- 状态：testing（测试中）/published（已发布）/rollback（已回滚）
     release_type VARCHAR(20) DEFAULT 'full',        -- 发布类型：full（全量）/incremental（增量）/canary（灰度）
 
+    -- 增量发布
+    base_release_id INTEGER DEFAULT 0,              -- 增量发布的基线版本ID（0 表示快照包含全部配置）
+
+    -- 发布说明
+    release_notes TEXT,                             -- 发布说明（发布前必须填写）
+    changelog TEXT,                                 -- 相对上一已发布版本的键级变更明细（JSON格式）
+
+    -- 发布审批
+    approval_status VARCHAR(20) DEFAULT '',         -- 审批状态：''（未审批）/approved（通过）/rejected（驳回）
+    approved_by VARCHAR(100),                       -- 最近一次审批人
+    approved_at TIMESTAMP,                          -- 最近一次审批时间
+
     -- 灰度发布
     canary_rule JSONB,                             -- 灰度规则（JSON格式）
     canary_percentage INTEGER DEFAULT 0,            -- 灰度比例（0-100）
@@ -346,6 +371,10 @@ COMMENT ON COLUMN t_release_versions.status IS '状态：testing（测试中）/
 COMMENT ON COLUMN t_release_versions.release_type IS '发布类型：full（全量发布）/incremental（增量更新）/canary（灰度发布）';
 COMMENT ON COLUMN t_release_versions.canary_rule IS '灰度规则，例如：按IP、按用户ID、按百分比等';
 COMMENT ON COLUMN t_release_versions.canary_percentage IS '灰度比例，0-100，表示多少比例的流量使用新版本';
+COMMENT ON COLUMN t_release_versions.release_notes IS '发布说明，可在创建版本或发布时填写，发布前必须填写';
+COMMENT ON COLUMN t_release_versions.changelog IS '创建版本时生成的相对上一已发布版本的键级变更明细，格式：{"base_release_id":1,"base_version":1,"added":[],"modified":[],"deleted":[]}';
+COMMENT ON COLUMN t_release_versions.approval_status IS '审批状态，发布到受保护环境（release.approval_environments）前必须由创建人以外的用户审批通过';
+COMMENT ON COLUMN t_release_versions.base_release_id IS '增量发布的基线版本ID，快照只包含相对基线变更的配置（删除的配置带 deleted 标记），生效配置为基线合并增量；0 表示快照包含全部配置';
 COMMENT ON COLUMN t_release_versions.id IS '主键ID，自增';
 COMMENT ON COLUMN t_release_versions.namespace_id IS '所属命名空间ID，关联t_namespaces表';
 COMMENT ON COLUMN t_release_versions.environment IS '发布环境：dev/test/staging/prod';
@@ -427,6 +456,382 @@ COMMENT ON COLUMN t_system_configs.created_at IS '创建时间戳';
 COMMENT ON COLUMN t_system_configs.updated_at IS '更新时间戳';
 
 
+-- ============================================================================
+-- 8. 配置 Schema 表 (t_config_schemas)
+-- 用途: 将 JSON Schema 绑定到配置键或配置分组，校验 JSON/YAML 配置值
+-- ============================================================================
+CREATE TABLE t_config_schemas (
+    id SERIAL PRIMARY KEY,
+    namespace_id INTEGER NOT NULL,                  -- 命名空间ID
+    config_key VARCHAR(500) DEFAULT '',             -- 绑定的配置键（与 group_name 二选一）
+    group_name VARCHAR(255) DEFAULT '',             -- 绑定的配置分组（与 config_key 二选一）
+    schema TEXT NOT NULL,                           -- JSON Schema 文档
+    description TEXT,                               -- 描述
+    is_active BOOLEAN DEFAULT true,                 -- 是否启用
+    version INTEGER DEFAULT 1,
+    created_by VARCHAR(100) DEFAULT 'system',
+    updated_by VARCHAR(100) DEFAULT 'system',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+-- 索引
+CREATE INDEX idx_t_config_schemas_namespace ON t_config_schemas(namespace_id);
+CREATE UNIQUE INDEX uk_t_config_schemas_binding ON t_config_schemas(namespace_id, config_key, group_name) WHERE deleted_at IS NULL;
+CREATE INDEX idx_t_config_schemas_deleted_at ON t_config_schemas(deleted_at);
+
+-- 注释
+COMMENT ON TABLE t_config_schemas IS '配置Schema表，用于校验JSON/YAML类型的配置值';
+COMMENT ON COLUMN t_config_schemas.config_key IS '绑定的配置键，键绑定优先于分组绑定';
+COMMENT ON COLUMN t_config_schemas.group_name IS '绑定的配置分组';
+COMMENT ON COLUMN t_config_schemas.schema IS 'JSON Schema 文档（draft-07 常用关键字）';
+
+
+-- ============================================================================
+-- 9. 配置变更事件日志表 (t_change_events)
+-- 用途: 记录带命名空间内递增序号的变更事件，供长轮询客户端断线重连后补发
+-- ============================================================================
+CREATE TABLE t_change_events (
+    id BIGSERIAL PRIMARY KEY,
+    namespace_id INTEGER NOT NULL,                  -- 命名空间ID
+    sequence BIGINT NOT NULL,                       -- 命名空间内的事件序号
+    config_key VARCHAR(500) NOT NULL,               -- 配置键
+    config_id INTEGER,                              -- 配置ID
+    environment VARCHAR(50),                        -- 配置所属环境（为空表示未知）
+    action VARCHAR(20) NOT NULL,                    -- 操作类型
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 索引
+CREATE UNIQUE INDEX uk_change_events_ns_seq ON t_change_events(namespace_id, sequence);
+CREATE INDEX idx_change_events_created_at ON t_change_events(created_at);
+
+-- 注释
+COMMENT ON TABLE t_change_events IS '配置变更事件日志表，按保留时长定期清理';
+COMMENT ON COLUMN t_change_events.sequence IS '命名空间内单调递增的事件序号，由 t_change_event_sequences 分配';
+COMMENT ON COLUMN t_change_events.action IS '操作类型：create/update/delete';
+
+-- 命名空间事件序号计数器
+CREATE TABLE t_change_event_sequences (
+    namespace_id INTEGER PRIMARY KEY,               -- 命名空间ID
+    last_sequence BIGINT NOT NULL DEFAULT 0,        -- 最近分配的事件序号
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE t_change_event_sequences IS '命名空间事件序号计数器，分配序号时行锁保证同一命名空间串行递增';
+
+
+-- ============================================================================
+-- 10. 事件发件箱表 (t_event_outbox)
+-- 用途: 与配置写入同事务保存待发布的变更事件，由后台任务投递到监听器，保证事件不丢失
+-- ============================================================================
+CREATE TABLE t_event_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    payload TEXT NOT NULL,                          -- 事件内容（JSON）
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',  -- 状态
+    attempts INTEGER NOT NULL DEFAULT 0,            -- 投递失败次数
+    last_error TEXT,                                -- 最近一次投递失败原因
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP, -- 下次可投递时间
+    locked_until TIMESTAMP,                         -- 领取租约到期时间
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    sent_at TIMESTAMP                               -- 投递成功时间
+);
+
+-- 索引
+CREATE INDEX idx_event_outbox_status_next ON t_event_outbox(status, next_attempt_at);
+CREATE INDEX idx_event_outbox_sent_at ON t_event_outbox(sent_at);
+
+-- 注释
+COMMENT ON TABLE t_event_outbox IS '事务性发件箱表，已投递事件按保留时长定期清理';
+COMMENT ON COLUMN t_event_outbox.status IS '状态：pending(待投递)/processing(投递中)/sent(已投递)';
+COMMENT ON COLUMN t_event_outbox.locked_until IS '投递实例领取后设置租约，实例异常退出时租约到期后由其他实例重新投递';
+
+
+-- ============================================================================
+-- 11. 配置变更历史归档表 (t_change_history_archive)
+-- 用途: 保留策略清理变更历史前将记录归档到此表（history.retention.archive.type = table）
+-- ============================================================================
+CREATE TABLE t_change_history_archive (
+    id INTEGER PRIMARY KEY,                         -- 原变更记录ID
+    config_id INTEGER NOT NULL,
+    namespace_id INTEGER NOT NULL,
+    config_key VARCHAR(500) NOT NULL,
+    environment VARCHAR(50) DEFAULT 'default',
+    operation VARCHAR(20) NOT NULL,
+    old_value TEXT,
+    new_value TEXT,
+    old_version INTEGER,
+    new_version INTEGER,
+    operator VARCHAR(100) NOT NULL,
+    operator_ip VARCHAR(50),
+    change_reason TEXT,
+    created_at TIMESTAMP,                           -- 原变更时间
+    metadata JSONB DEFAULT '{}'::jsonb,
+    archived_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP -- 归档时间
+);
+
+-- 索引
+CREATE INDEX idx_t_change_history_archive_config_id ON t_change_history_archive(config_id);
+CREATE INDEX idx_t_change_history_archive_ns_key ON t_change_history_archive(namespace_id, config_key, created_at DESC);
+
+-- 注释
+COMMENT ON TABLE t_change_history_archive IS '配置变更历史归档表，字段与 t_change_history 一致';
+
+
+-- ============================================================================
+-- 12. 配置分组表 (t_config_groups)
+-- 用途: 登记配置分组（对应 t_configs.group_name），记录描述和展示顺序
+-- ============================================================================
+CREATE TABLE t_config_groups (
+    id SERIAL PRIMARY KEY,
+    namespace_id INTEGER NOT NULL,                  -- 命名空间ID
+    name VARCHAR(255) NOT NULL,                     -- 分组名称（对应 t_configs.group_name）
+    description TEXT,                               -- 描述
+    sort_order INTEGER DEFAULT 0,                   -- 展示顺序（升序）
+    version INTEGER DEFAULT 1,
+    created_by VARCHAR(100) DEFAULT 'system',
+    updated_by VARCHAR(100) DEFAULT 'system',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+-- 索引
+CREATE UNIQUE INDEX uk_t_config_groups_ns_name ON t_config_groups(namespace_id, name) WHERE deleted_at IS NULL;
+CREATE INDEX idx_t_config_groups_deleted_at ON t_config_groups(deleted_at);
+
+-- 注释
+COMMENT ON TABLE t_config_groups IS '配置分组表，分组名称创建后不可修改';
+COMMENT ON COLUMN t_config_groups.name IS '分组名称，配置通过 group_name 关联分组';
+COMMENT ON COLUMN t_config_groups.sort_order IS '展示顺序，按升序排列';
+
+
+-- ============================================================================
+-- 13. 配置依赖表 (t_config_dependencies)
+-- 用途: 记录配置显式声明的依赖（depends_on），用于依赖图和发布前影响分析
+-- ============================================================================
+CREATE TABLE t_config_dependencies (
+    id SERIAL PRIMARY KEY,
+    config_id INTEGER NOT NULL,                     -- 配置ID（依赖方）
+    depends_on_config_id INTEGER NOT NULL,          -- 被依赖的配置ID
+    created_by VARCHAR(100) DEFAULT 'system',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 索引
+CREATE UNIQUE INDEX uk_t_config_dependencies_pair ON t_config_dependencies(config_id, depends_on_config_id);
+CREATE INDEX idx_t_config_dependencies_depends_on ON t_config_dependencies(depends_on_config_id);
+
+-- 注释
+COMMENT ON TABLE t_config_dependencies IS '配置依赖表，只记录显式声明的依赖，${namespace:key} 引用依赖从配置值实时解析';
+
+-- ============================================================================
+-- 14. 文件配置内容表 (t_config_files)
+-- 用途: 存储文件类型配置（证书、logback.xml 等）的内容，配置值保存 sha256:<hash> 引用
+-- ============================================================================
+CREATE TABLE t_config_files (
+    id SERIAL PRIMARY KEY,
+    config_id INTEGER NOT NULL,                     -- 配置ID
+    sha256 VARCHAR(64) NOT NULL,                    -- 内容 SHA-256 哈希（十六进制）
+    file_name VARCHAR(255),                         -- 文件名
+    content_type VARCHAR(255),                      -- 内容类型
+    size BIGINT NOT NULL,                           -- 内容大小（字节）
+    content BYTEA,                                  -- 文件内容
+    created_by VARCHAR(100) DEFAULT 'system',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 索引
+CREATE UNIQUE INDEX uk_t_config_files_config_hash ON t_config_files(config_id, sha256);
+
+-- 注释
+COMMENT ON TABLE t_config_files IS '文件配置内容表，按配置和内容哈希存储，历史版本和发布快照中的引用保持有效';
+
+-- ============================================================================
+-- 15. 订阅配置键表 (t_subscription_keys)
+-- 用途: 记录客户端长轮询时上报的配置版本，用于查询配置变更是否已下发到所有客户端
+-- ============================================================================
+CREATE TABLE t_subscription_keys (
+    id SERIAL PRIMARY KEY,
+    subscription_id INTEGER NOT NULL,               -- 订阅ID
+    namespace_id INTEGER NOT NULL,                  -- 配置所属命名空间ID
+    config_key VARCHAR(500) NOT NULL,               -- 配置键
+    version VARCHAR(64),                            -- 客户端上报的配置版本（为空表示尚未持有该配置）
+    reported_at TIMESTAMP NOT NULL                  -- 上报时间
+);
+
+-- 索引
+CREATE UNIQUE INDEX uk_t_subscription_keys_key ON t_subscription_keys(subscription_id, namespace_id, config_key);
+CREATE INDEX idx_t_subscription_keys_config ON t_subscription_keys(namespace_id, config_key);
+
+-- 注释
+COMMENT ON TABLE t_subscription_keys IS '订阅配置键表，客户端上报的版本快照变化时（对比 t_subscriptions.config_snapshot_hash）整体替换';
+
+-- ============================================================================
+-- 16. 变更通知下发记录表 (t_push_traces)
+-- 用途: 记录长轮询返回给客户端的每条变更通知，客户端随后上报通知中的版本即标记为已确认
+-- ============================================================================
+CREATE TABLE t_push_traces (
+    id BIGSERIAL PRIMARY KEY,
+    subscription_id INTEGER NOT NULL,               -- 订阅ID
+    client_id VARCHAR(255) NOT NULL,                -- 客户端ID
+    namespace_id INTEGER NOT NULL,                  -- 配置所属命名空间ID
+    environment VARCHAR(50) NOT NULL,               -- 环境
+    config_key VARCHAR(500) NOT NULL,               -- 配置键
+    version VARCHAR(64),                            -- 通知的配置版本
+    release_id INTEGER NOT NULL DEFAULT 0,          -- 触发通知的发布版本ID（非发布触发时为0）
+    sequence BIGINT NOT NULL DEFAULT 0,             -- 触发通知的事件序号
+    trace_id VARCHAR(64),                           -- 触发变更的请求链路ID
+    source VARCHAR(20) NOT NULL,                    -- 通知来源: event, compare, replay
+    pushed_at TIMESTAMP NOT NULL,                   -- 下发时间
+    acked BOOLEAN NOT NULL DEFAULT FALSE,           -- 客户端是否已确认
+    acked_at TIMESTAMP                              -- 确认时间
+);
+
+-- 索引
+CREATE INDEX idx_t_push_traces_subscription ON t_push_traces(subscription_id) WHERE acked = FALSE;
+CREATE INDEX idx_t_push_traces_release ON t_push_traces(release_id);
+CREATE INDEX idx_t_push_traces_client ON t_push_traces(client_id);
+CREATE INDEX idx_t_push_traces_pushed_at ON t_push_traces(pushed_at);
+
+-- 注释
+COMMENT ON TABLE t_push_traces IS '变更通知下发记录表，超过 listener.push_trace_retention 的记录由订阅清理任务删除';
+
+-- ============================================================================
+-- 17. 发布审批记录表 (t_release_approvals)
+-- 用途: 记录受保护环境发布版本的每次审批通过和驳回，用于审计
+-- ============================================================================
+CREATE TABLE t_release_approvals (
+    id SERIAL PRIMARY KEY,
+    release_id INTEGER NOT NULL,                    -- 发布版本ID
+    namespace_id INTEGER NOT NULL,                  -- 命名空间ID
+    environment VARCHAR(50) NOT NULL,               -- 发布环境
+    version INTEGER NOT NULL,                       -- 发布版本号
+    action VARCHAR(20) NOT NULL,                    -- 审批动作: approve, reject
+    operator VARCHAR(100) NOT NULL,                 -- 审批人
+    comment TEXT,                                   -- 审批意见
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP  -- 审批时间
+);
+
+-- 索引
+CREATE INDEX idx_t_release_approvals_release ON t_release_approvals(release_id);
+
+-- 注释
+COMMENT ON TABLE t_release_approvals IS '发布审批记录表，审批人不能是版本创建人';
+
+-- ============================================================================
+-- 18. 冻结窗口覆盖记录表 (t_release_freeze_overrides)
+-- 用途: 审计在发布冻结窗口（release.freeze_windows）内显式覆盖执行的发布和回滚
+-- ============================================================================
+CREATE TABLE t_release_freeze_overrides (
+    id SERIAL PRIMARY KEY,
+    release_id INTEGER NOT NULL,                    -- 发布版本ID（回滚时为当前版本ID）
+    namespace_id INTEGER NOT NULL,                  -- 命名空间ID
+    environment VARCHAR(50) NOT NULL,               -- 环境
+    window_name VARCHAR(100) NOT NULL,              -- 被覆盖的冻结窗口名称
+    operation VARCHAR(20) NOT NULL,                 -- 操作类型: publish_full, publish_canary, rollback
+    operator VARCHAR(100) NOT NULL,                 -- 操作人
+    reason TEXT NOT NULL,                           -- 覆盖原因
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP  -- 操作时间
+);
+
+-- 索引
+CREATE INDEX idx_t_release_freeze_overrides_ns ON t_release_freeze_overrides(namespace_id, environment);
+
+-- 注释
+COMMENT ON TABLE t_release_freeze_overrides IS '冻结窗口覆盖记录表，冻结窗口内携带 freeze_override 和覆盖原因执行发布或回滚时记录';
+
+
+-- ============================================================================
+-- 19. Webhook 表 (t_webhooks)
+-- 用途: 命名空间注册的 Webhook，配置变更和发布事件按事件过滤投递到 URL
+-- ============================================================================
+CREATE TABLE t_webhooks (
+    id SERIAL PRIMARY KEY,
+    namespace_id INTEGER NOT NULL,                  -- 命名空间ID
+    name VARCHAR(100) NOT NULL,                     -- 名称
+    url VARCHAR(1000) NOT NULL,                     -- 投递地址
+    secret VARCHAR(255) NOT NULL,                   -- 签名密钥
+    events VARCHAR(500) NOT NULL DEFAULT '',        -- 事件过滤，逗号分隔（为空时订阅全部事件）
+    enabled BOOLEAN NOT NULL DEFAULT true,          -- 是否启用
+    created_by VARCHAR(100),                        -- 创建人
+    updated_by VARCHAR(100),                        -- 更新人
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 索引
+CREATE INDEX idx_t_webhooks_namespace ON t_webhooks(namespace_id);
+
+-- 注释
+COMMENT ON TABLE t_webhooks IS 'Webhook 表，请求体使用 secret 做 HMAC-SHA256 签名（X-Webhook-Signature）';
+COMMENT ON COLUMN t_webhooks.events IS '事件类型：config.create/update/delete/restore、release.publish/canary/rollback，支持 config.*、release.* 通配';
+
+
+-- ============================================================================
+-- 20. Webhook 投递记录表 (t_webhook_deliveries)
+-- 用途: 事件发生时写入的待投递记录，由后台任务投递并记录结果，同时作为投递日志
+-- ============================================================================
+CREATE TABLE t_webhook_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    webhook_id INTEGER NOT NULL,                    -- Webhook ID
+    namespace_id INTEGER NOT NULL,                  -- 命名空间ID
+    event_type VARCHAR(50) NOT NULL,                -- 事件类型
+    payload TEXT NOT NULL,                          -- 请求体（JSON）
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',  -- 状态
+    attempts INTEGER NOT NULL DEFAULT 0,            -- 已尝试次数
+    response_status INTEGER NOT NULL DEFAULT 0,     -- 最近一次响应状态码
+    response_body TEXT,                             -- 最近一次响应内容（截断）
+    last_error TEXT,                                -- 最近一次失败原因
+    duration_ms BIGINT NOT NULL DEFAULT 0,          -- 最近一次请求耗时（毫秒）
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP, -- 下次可投递时间
+    locked_until TIMESTAMP,                         -- 领取租约到期时间
+    redelivery_of BIGINT,                           -- 重新投递的原投递记录ID
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMP                          -- 投递成功时间
+);
+
+-- 索引
+CREATE INDEX idx_t_webhook_deliveries_webhook ON t_webhook_deliveries(webhook_id);
+CREATE INDEX idx_t_webhook_deliveries_status_next ON t_webhook_deliveries(status, next_attempt_at);
+CREATE INDEX idx_t_webhook_deliveries_created_at ON t_webhook_deliveries(created_at);
+
+-- 注释
+COMMENT ON TABLE t_webhook_deliveries IS 'Webhook 投递记录表，已结束投递的记录按保留时长定期清理';
+COMMENT ON COLUMN t_webhook_deliveries.status IS '状态：pending(待投递)/processing(投递中)/succeeded(投递成功)/failed(重试耗尽)';
+COMMENT ON COLUMN t_webhook_deliveries.locked_until IS '投递实例领取后设置租约，实例异常退出时租约到期后由其他实例重新投递';
+
+
+-- ============================================================================
+-- 21. 通知渠道表 (t_notification_channels)
+-- 用途: 命名空间的钉钉/Slack/邮件通知渠道，发布、回滚和灰度失败时发送模板渲染的消息
+-- ============================================================================
+CREATE TABLE t_notification_channels (
+    id SERIAL PRIMARY KEY,
+    namespace_id INTEGER NOT NULL,                  -- 命名空间ID
+    name VARCHAR(100) NOT NULL,                     -- 名称
+    type VARCHAR(20) NOT NULL,                      -- 渠道类型: dingtalk, slack, email
+    target VARCHAR(1000) NOT NULL,                  -- 发送目标
+    secret VARCHAR(255) NOT NULL DEFAULT '',        -- 钉钉机器人加签密钥
+    events VARCHAR(200) NOT NULL DEFAULT '',        -- 事件过滤，逗号分隔（为空时订阅全部事件）
+    template TEXT,                                  -- 自定义消息模板（Go text/template）
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,          -- 是否启用
+    created_by VARCHAR(100),
+    updated_by VARCHAR(100),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 索引
+CREATE INDEX idx_t_notification_channels_namespace ON t_notification_channels(namespace_id);
+
+-- 注释
+COMMENT ON TABLE t_notification_channels IS '通知渠道表，通知在版本状态提交后异步发送，失败时短暂重试';
+COMMENT ON COLUMN t_notification_channels.target IS '钉钉/Slack 为机器人 Webhook 地址（https），邮件为收件人（逗号分隔）';
+COMMENT ON COLUMN t_notification_channels.events IS '通知事件：publish(发布)/rollback(回滚)/canary_failed(灰度版本被回滚)';
+
+
 -- ============================================================================
 -- 触发器：自动更新 updated_at 字段
 -- ============================================================================
@@ -454,6 +859,18 @@ CREATE TRIGGER update_t_release_versions_updated_at BEFORE UPDATE ON t_release_v
 CREATE TRIGGER update_t_system_configs_updated_at BEFORE UPDATE ON t_system_configs
     FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
 
+CREATE TRIGGER update_t_config_schemas_updated_at BEFORE UPDATE ON t_config_schemas
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_t_config_groups_updated_at BEFORE UPDATE ON t_config_groups
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_t_webhooks_updated_at BEFORE UPDATE ON t_webhooks
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_t_notification_channels_updated_at BEFORE UPDATE ON t_notification_channels
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
 
 -- ============================================================================
 -- 触发器：配置变更时自动记录变更历史
@@ -533,31 +950,18 @@ CREATE TRIGGER log_config_change_trigger
 
 -- 插入默认命名空间
 INSERT INTO t_namespaces (name, display_name, description, created_by) VALUES
-('default', '默认命名空间', '系统默认命名空间', 'system'),
-('demo-app', '示例应用', '配置中心演示应用', 'system');
+('default', '默认命名空间', '系统默认命名空间', 'system');
 
 -- 插入系统配置
 INSERT INTO t_system_configs (config_key, config_value, description) VALUES
 ('long.polling.timeout', '30', '长轮询超时时间（秒）'),
 ('long.polling.max.wait', '60', '长轮询最大等待时间（秒）'),
+('long.polling.max.waiters', '10000', '单实例最大并发长轮询数（<=0 表示不限制）'),
+('long.polling.retry.after', '5', '超出并发长轮询上限时建议客户端重试的等待时间（秒）'),
 ('max.subscriptions', '10000', '最大订阅数'),
 ('heartbeat.interval', '60', '心跳间隔时间（秒）'),
 ('heartbeat.timeout', '300', '心跳超时时间（秒）');
 
--- 插入示例配置（用于演示）
-INSERT INTO t_configs (namespace_id, key, value, group_name, environment, value_type, is_released, created_by) VALUES
-(2, 'database.host', 'localhost', 'database', 'default', 'string', true, 'system'),
-(2, 'database.port', '5432', 'database', 'default', 'int', true, 'system'),
-(2, 'database.username', 'admin', 'database', 'default', 'string', true, 'system'),
-(2, 'redis.host', 'localhost', 'cache', 'default', 'string', true, 'system'),
-(2, 'redis.port', '6379', 'cache', 'default', 'int', true, 'system'),
-(2, 'app.debug', 'true', 'application', 'default', 'boolean', true, 'system'),
-(2, 'app.log.level', 'info', 'application', 'default', 'string', true, 'system');
-
--- 更新示例配置的MD5哈希（因为INSERT时触发器会自动计算，这里只是确保数据一致）
-UPDATE t_configs SET content_hash = MD5(value) WHERE content_hash IS NULL;
-
-
 -- ============================================================================
 -- 查询视图：配置总览
 -- ============================================================================
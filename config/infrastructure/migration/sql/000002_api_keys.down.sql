@@ -0,0 +1 @@
+DROP TABLE IF EXISTS t_api_keys;
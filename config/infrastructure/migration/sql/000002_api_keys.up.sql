@@ -0,0 +1,31 @@
+-- ============================================================================
+-- 22. API Key 表 (t_api_keys)
+-- 用途: 管理接口认证（auth.enabled），数据库只保存密钥的 SHA-256 摘要
+-- ============================================================================
+CREATE TABLE t_api_keys (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,                     -- 名称（唯一）
+    prefix VARCHAR(20) NOT NULL,                    -- 密钥前缀（用于识别密钥）
+    key_hash CHAR(64) NOT NULL,                     -- 密钥 SHA-256 摘要（十六进制）
+    role VARCHAR(20) NOT NULL,                      -- 角色: admin
+    description TEXT,                               -- 描述
+    expires_at TIMESTAMP,                           -- 过期时间（为空表示永不过期）
+    last_used_at TIMESTAMP,                         -- 最近使用时间
+    revoked_at TIMESTAMP,                           -- 吊销时间
+    revoked_by VARCHAR(100),                        -- 吊销人
+    created_by VARCHAR(100),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 索引
+CREATE UNIQUE INDEX uk_t_api_keys_name ON t_api_keys(name);
+CREATE UNIQUE INDEX uk_t_api_keys_hash ON t_api_keys(key_hash);
+
+-- 注释
+COMMENT ON TABLE t_api_keys IS 'API Key 表，密钥明文仅在创建时返回一次';
+COMMENT ON COLUMN t_api_keys.prefix IS '密钥前 12 位，用于在列表和日志中识别密钥，不可用于认证';
+COMMENT ON COLUMN t_api_keys.key_hash IS '密钥 SHA-256 摘要，认证时按摘要查询';
+
+CREATE TRIGGER update_t_api_keys_updated_at BEFORE UPDATE ON t_api_keys
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
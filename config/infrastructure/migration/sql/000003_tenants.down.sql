@@ -0,0 +1,19 @@
+DROP INDEX IF EXISTS idx_t_subscriptions_tenant_id;
+DROP INDEX IF EXISTS idx_t_release_versions_tenant_id;
+DROP INDEX IF EXISTS idx_t_configs_tenant_id;
+
+-- 回退前需确保不同租户之间没有同名的命名空间和 API Key
+DROP INDEX IF EXISTS uk_t_api_keys_tenant_name;
+CREATE UNIQUE INDEX uk_t_api_keys_name ON t_api_keys(name);
+
+DROP INDEX IF EXISTS uk_t_namespaces_tenant_name;
+ALTER TABLE t_namespaces ADD CONSTRAINT t_namespaces_name_key UNIQUE (name);
+COMMENT ON COLUMN t_namespaces.name IS '命名空间名称，全局唯一，例如：user-service、order-service';
+
+ALTER TABLE t_api_keys DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE t_subscriptions DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE t_release_versions DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE t_configs DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE t_namespaces DROP COLUMN IF EXISTS tenant_id;
+
+DROP TABLE IF EXISTS t_tenants;
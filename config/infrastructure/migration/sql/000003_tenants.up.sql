@@ -0,0 +1,59 @@
+-- ============================================================================
+-- 23. 租户表 (t_tenants)
+-- 用途: 多租户隔离，一个集群同时服务多个组织
+-- 命名空间、配置、发布版本、订阅和 API Key 通过 tenant_id 归属租户，
+-- 应用层按请求的租户自动过滤（不使用外键约束，与其他表保持一致）
+-- ============================================================================
+CREATE TABLE t_tenants (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(64) NOT NULL,                      -- 租户编码（唯一，创建后不可修改）
+    name VARCHAR(255) NOT NULL,                     -- 租户名称
+    description TEXT,                               -- 描述
+    is_active BOOLEAN DEFAULT true,                 -- 是否启用
+    created_by VARCHAR(100) DEFAULT 'system',
+    updated_by VARCHAR(100) DEFAULT 'system',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX uk_t_tenants_code ON t_tenants(code);
+
+COMMENT ON TABLE t_tenants IS '租户表，ID 为 1 的默认租户可以管理其他租户';
+COMMENT ON COLUMN t_tenants.code IS '租户编码，全局唯一，例如：acme、globex';
+COMMENT ON COLUMN t_tenants.is_active IS '是否启用，停用后该租户的 API Key 无法访问接口';
+
+CREATE TRIGGER update_t_tenants_updated_at BEFORE UPDATE ON t_tenants
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- 默认租户（已有数据全部归属默认租户）
+INSERT INTO t_tenants (id, code, name, description) VALUES
+(1, 'default', '默认租户', '系统默认租户');
+SELECT setval(pg_get_serial_sequence('t_tenants', 'id'), (SELECT MAX(id) FROM t_tenants));
+
+-- ============================================================================
+-- 租户归属字段
+-- ============================================================================
+ALTER TABLE t_namespaces ADD COLUMN tenant_id INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE t_configs ADD COLUMN tenant_id INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE t_release_versions ADD COLUMN tenant_id INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE t_subscriptions ADD COLUMN tenant_id INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE t_api_keys ADD COLUMN tenant_id INTEGER NOT NULL DEFAULT 1;
+
+COMMENT ON COLUMN t_namespaces.tenant_id IS '所属租户ID，关联 t_tenants 表';
+COMMENT ON COLUMN t_configs.tenant_id IS '所属租户ID（与命名空间一致），关联 t_tenants 表';
+COMMENT ON COLUMN t_release_versions.tenant_id IS '所属租户ID（与命名空间一致），关联 t_tenants 表';
+COMMENT ON COLUMN t_subscriptions.tenant_id IS '所属租户ID（与命名空间一致），关联 t_tenants 表';
+COMMENT ON COLUMN t_api_keys.tenant_id IS '所属租户ID，使用该 API Key 的请求只能访问此租户的数据';
+
+-- 命名空间名称、API Key 名称改为租户内唯一
+ALTER TABLE t_namespaces DROP CONSTRAINT IF EXISTS t_namespaces_name_key;
+CREATE UNIQUE INDEX uk_t_namespaces_tenant_name ON t_namespaces(tenant_id, name);
+COMMENT ON COLUMN t_namespaces.name IS '命名空间名称，租户内唯一，例如：user-service、order-service';
+
+DROP INDEX IF EXISTS uk_t_api_keys_name;
+CREATE UNIQUE INDEX uk_t_api_keys_tenant_name ON t_api_keys(tenant_id, name);
+
+-- 租户过滤索引
+CREATE INDEX idx_t_configs_tenant_id ON t_configs(tenant_id);
+CREATE INDEX idx_t_release_versions_tenant_id ON t_release_versions(tenant_id);
+CREATE INDEX idx_t_subscriptions_tenant_id ON t_subscriptions(tenant_id);
@@ -0,0 +1,8 @@
+-- 回退后不再区分角色，只读令牌会获得管理员权限，因此一并删除
+DELETE FROM t_api_keys WHERE role = 'reader';
+
+ALTER TABLE t_api_keys DROP COLUMN IF EXISTS key_prefixes;
+ALTER TABLE t_api_keys DROP COLUMN IF EXISTS environment;
+ALTER TABLE t_api_keys DROP COLUMN IF EXISTS namespace_id;
+
+COMMENT ON COLUMN t_api_keys.role IS NULL;
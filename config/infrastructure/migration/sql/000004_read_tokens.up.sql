@@ -0,0 +1,13 @@
+-- ============================================================================
+-- 只读令牌 (t_api_keys.role = 'reader')
+-- 用途: SDK 客户端使用的轻量只读令牌，绑定一个命名空间和环境，
+-- 只能读取和监听其中已发布的配置，可按配置键前缀进一步限制
+-- ============================================================================
+ALTER TABLE t_api_keys ADD COLUMN namespace_id INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE t_api_keys ADD COLUMN environment VARCHAR(50) NOT NULL DEFAULT '';
+ALTER TABLE t_api_keys ADD COLUMN key_prefixes VARCHAR(1000) NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN t_api_keys.role IS '角色: admin（管理员）, reader（只读令牌）';
+COMMENT ON COLUMN t_api_keys.namespace_id IS '只读令牌绑定的命名空间ID，关联 t_namespaces 表（管理员为 0）';
+COMMENT ON COLUMN t_api_keys.environment IS '只读令牌绑定的环境，例如：default、prod';
+COMMENT ON COLUMN t_api_keys.key_prefixes IS '只读令牌允许读取的配置键前缀，逗号分隔，为空时允许命名空间下的全部配置';
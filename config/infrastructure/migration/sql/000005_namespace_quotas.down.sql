@@ -0,0 +1,4 @@
+ALTER TABLE t_namespaces DROP COLUMN IF EXISTS max_releases_retained;
+ALTER TABLE t_namespaces DROP COLUMN IF EXISTS max_subscribers;
+ALTER TABLE t_namespaces DROP COLUMN IF EXISTS max_value_size;
+ALTER TABLE t_namespaces DROP COLUMN IF EXISTS max_configs;
@@ -0,0 +1,14 @@
+-- ============================================================================
+-- 命名空间配额 (t_namespaces.max_*)
+-- 用途: 限制命名空间的配置数量、配置值大小、活跃订阅数量和每个环境保留的发布版本数量，
+-- 为 0 时使用全局默认配额（quota 配置），全局默认也为 0 时不限制
+-- ============================================================================
+ALTER TABLE t_namespaces ADD COLUMN max_configs INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE t_namespaces ADD COLUMN max_value_size INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE t_namespaces ADD COLUMN max_subscribers INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE t_namespaces ADD COLUMN max_releases_retained INTEGER NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN t_namespaces.max_configs IS '配置数量上限（所有环境合计），0 表示使用全局默认配额';
+COMMENT ON COLUMN t_namespaces.max_value_size IS '单个配置值大小上限（字节），0 表示使用全局默认配额';
+COMMENT ON COLUMN t_namespaces.max_subscribers IS '活跃订阅数量上限，0 表示使用全局默认配额';
+COMMENT ON COLUMN t_namespaces.max_releases_retained IS '每个环境保留的发布版本数量，0 表示使用全局默认配额';
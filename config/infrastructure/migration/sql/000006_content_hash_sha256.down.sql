@@ -0,0 +1,5 @@
+-- 回退前需先将使用 SHA-256 的配置迁移回 MD5，否则超过 32 个字符的哈希值无法转换
+ALTER TABLE t_configs ALTER COLUMN content_hash TYPE VARCHAR(32);
+
+COMMENT ON COLUMN t_configs.content_hash IS '配置内容的MD5哈希值，用于快速比对配置是否变化';
+COMMENT ON COLUMN t_configs.content_hash_algorithm IS '哈希算法，默认使用MD5';
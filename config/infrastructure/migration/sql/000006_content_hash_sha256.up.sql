@@ -0,0 +1,8 @@
+-- ============================================================================
+-- 内容哈希支持 SHA-256 (t_configs.content_hash)
+-- 用途: SHA-256 十六进制摘要为 64 个字符，加宽内容哈希列；算法记录在 content_hash_algorithm 列
+-- ============================================================================
+ALTER TABLE t_configs ALTER COLUMN content_hash TYPE VARCHAR(64);
+
+COMMENT ON COLUMN t_configs.content_hash IS '配置内容的哈希值（十六进制），算法见 content_hash_algorithm，用于快速比对配置是否变化';
+COMMENT ON COLUMN t_configs.content_hash_algorithm IS '哈希算法：md5/sha256，默认使用MD5';
@@ -0,0 +1 @@
+DELETE FROM t_system_configs WHERE config_key = 'subscription.cleanup.interval';
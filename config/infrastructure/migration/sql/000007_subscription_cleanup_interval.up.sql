@@ -0,0 +1,7 @@
+-- ============================================================================
+-- 系统配置: 清理过期订阅的间隔 (subscription.cleanup.interval)
+-- 用途: 订阅管理器每次清理后重新读取该配置，修改后无需重启
+-- ============================================================================
+INSERT INTO t_system_configs (config_key, config_value, description) VALUES
+('subscription.cleanup.interval', '300', '清理过期订阅的间隔（秒）')
+ON CONFLICT (config_key) DO NOTHING;
@@ -0,0 +1,7 @@
+-- ============================================================================
+-- 系统配置: 从数据库重新加载系统配置的间隔 (system.config.refresh.interval)
+-- 用途: 多实例部署时，其它实例通过周期性重新加载感知系统配置的修改
+-- ============================================================================
+INSERT INTO t_system_configs (config_key, config_value, description) VALUES
+('system.config.refresh.interval', '30', '从数据库重新加载系统配置的间隔（秒）')
+ON CONFLICT (config_key) DO NOTHING;
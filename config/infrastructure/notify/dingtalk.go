@@ -0,0 +1,91 @@
+package notify
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"config-client/config/domain/entity"
+	domainService "config-client/config/domain/service"
+)
+
+// DingTalkNotifier 钉钉群机器人通知发送器
+// 以 Markdown 消息发送；渠道配置了加签密钥时按钉钉加签规则在地址上附加 timestamp 和 sign
+type DingTalkNotifier struct {
+	client *http.Client
+}
+
+// NewDingTalkNotifier 创建钉钉通知发送器
+func NewDingTalkNotifier() *DingTalkNotifier {
+	return &DingTalkNotifier{client: newHTTPClient()}
+}
+
+// Type 渠道类型
+func (n *DingTalkNotifier) Type() string {
+	return entity.NotificationChannelDingTalk
+}
+
+// Send 发送一条通知
+func (n *DingTalkNotifier) Send(ctx context.Context, channel *entity.NotificationChannel, msg *domainService.NotificationMessage) error {
+	// 1. 构建请求地址（加签）
+	target := channel.Target
+	if channel.Secret != "" {
+		timestamp := strconv.FormatInt(time.Now().UnixMilli(), 10)
+		parsed, err := url.Parse(target)
+		if err != nil {
+			return err
+		}
+		query := parsed.Query()
+		query.Set("timestamp", timestamp)
+		query.Set("sign", dingTalkSign(channel.Secret, timestamp))
+		parsed.RawQuery = query.Encode()
+		target = parsed.String()
+	}
+
+	// 2. 构建 Markdown 消息
+	text := "#### " + msg.Title + "\n\n" + msg.Text
+	if msg.Link != "" {
+		text += "\n\n[查看详情](" + msg.Link + ")"
+	}
+	body := map[string]any{
+		"msgtype": "markdown",
+		"markdown": map[string]string{
+			"title": msg.Title,
+			"text":  text,
+		},
+	}
+
+	// 3. 发送并检查钉钉返回的错误码（钉钉在 HTTP 200 中返回业务错误）
+	respBody, err := postJSON(ctx, n.client, target, body)
+	if err != nil {
+		return err
+	}
+	var result struct {
+		ErrCode int    `json:"errcode"`
+		ErrMsg  string `json:"errmsg"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return fmt.Errorf("解析钉钉响应失败: %w", err)
+	}
+	if result.ErrCode != 0 {
+		return fmt.Errorf("钉钉返回错误: errcode=%d, errmsg=%s", result.ErrCode, result.ErrMsg)
+	}
+	return nil
+}
+
+// dingTalkSign 计算钉钉加签：base64(HMAC-SHA256(secret, timestamp + "\n" + secret))
+func dingTalkSign(secret, timestamp string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "\n" + secret))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// 确保实现了接口
+var _ domainService.Notifier = (*DingTalkNotifier)(nil)
@@ -0,0 +1,52 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const (
+	userAgent = "config-center-notify/1.0"
+
+	// maxResponseBodySize 读取的响应内容上限，超出部分丢弃
+	maxResponseBodySize = 64 << 10
+)
+
+// newHTTPClient 创建机器人 Webhook 请求客户端（超时由调用方通过 context 控制，不跟随重定向）
+func newHTTPClient() *http.Client {
+	return &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// postJSON 以 JSON 请求体 POST 到机器人 Webhook 地址，非 2xx 响应返回错误，否则返回响应内容
+func postJSON(ctx context.Context, client *http.Client, url string, body any) ([]byte, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("响应状态码 %d: %s", resp.StatusCode, respBody)
+	}
+	return respBody, nil
+}
@@ -0,0 +1,37 @@
+package notify
+
+import (
+	"context"
+	"net/http"
+
+	"config-client/config/domain/entity"
+	domainService "config-client/config/domain/service"
+)
+
+// SlackNotifier Slack Incoming Webhook 通知发送器
+type SlackNotifier struct {
+	client *http.Client
+}
+
+// NewSlackNotifier 创建 Slack 通知发送器
+func NewSlackNotifier() *SlackNotifier {
+	return &SlackNotifier{client: newHTTPClient()}
+}
+
+// Type 渠道类型
+func (n *SlackNotifier) Type() string {
+	return entity.NotificationChannelSlack
+}
+
+// Send 发送一条通知（标题加粗，详情链接使用 Slack 链接语法）
+func (n *SlackNotifier) Send(ctx context.Context, channel *entity.NotificationChannel, msg *domainService.NotificationMessage) error {
+	text := "*" + msg.Title + "*\n" + msg.Text
+	if msg.Link != "" {
+		text += "\n<" + msg.Link + "|查看详情>"
+	}
+	_, err := postJSON(ctx, n.client, channel.Target, map[string]string{"text": text})
+	return err
+}
+
+// 确保实现了接口
+var _ domainService.Notifier = (*SlackNotifier)(nil)
@@ -0,0 +1,146 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"config-client/config/domain/entity"
+	domainService "config-client/config/domain/service"
+)
+
+// SMTPOptions SMTP 服务器参数
+type SMTPOptions struct {
+	Host        string // 服务器地址
+	Port        int    // 端口
+	Username    string // 用户名（为空时不认证）
+	Password    string // 密码
+	From        string // 发件人地址
+	ImplicitTLS bool   // 是否使用隐式 TLS（通常为 465 端口），否则服务器支持时使用 STARTTLS
+}
+
+// SMTPNotifier 邮件通知发送器
+// 发送纯文本邮件到渠道配置的收件人；认证仅在加密连接上进行
+type SMTPNotifier struct {
+	opts SMTPOptions
+}
+
+// NewSMTPNotifier 创建邮件通知发送器
+func NewSMTPNotifier(opts SMTPOptions) *SMTPNotifier {
+	return &SMTPNotifier{opts: opts}
+}
+
+// Type 渠道类型
+func (n *SMTPNotifier) Type() string {
+	return entity.NotificationChannelEmail
+}
+
+// Send 发送一封通知邮件
+func (n *SMTPNotifier) Send(ctx context.Context, channel *entity.NotificationChannel, msg *domainService.NotificationMessage) error {
+	recipients := channel.Recipients()
+	if len(recipients) == 0 {
+		return fmt.Errorf("收件人为空")
+	}
+
+	// 1. 建立连接（超时由 context 控制）
+	client, err := n.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	// 2. 认证
+	if n.opts.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", n.opts.Username, n.opts.Password, n.opts.Host)); err != nil {
+			return fmt.Errorf("SMTP 认证失败: %w", err)
+		}
+	}
+
+	// 3. 发送邮件
+	if err := client.Mail(n.opts.From); err != nil {
+		return err
+	}
+	for _, rcpt := range recipients {
+		if err := client.Rcpt(rcpt); err != nil {
+			return fmt.Errorf("收件人被拒绝 %s: %w", rcpt, err)
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(n.buildMessage(recipients, msg)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// dial 连接 SMTP 服务器，非隐式 TLS 时在服务器支持的情况下升级为 STARTTLS
+func (n *SMTPNotifier) dial(ctx context.Context) (*smtp.Client, error) {
+	addr := net.JoinHostPort(n.opts.Host, strconv.Itoa(n.opts.Port))
+	tlsConfig := &tls.Config{ServerName: n.opts.Host}
+
+	var conn net.Conn
+	var err error
+	if n.opts.ImplicitTLS {
+		conn, err = (&tls.Dialer{Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, n.opts.Host)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if !n.opts.ImplicitTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(tlsConfig); err != nil {
+				client.Close()
+				return nil, err
+			}
+		}
+	}
+	return client, nil
+}
+
+// buildMessage 构建邮件内容（UTF-8 纯文本，quoted-printable 编码）
+func (n *SMTPNotifier) buildMessage(recipients []string, msg *domainService.NotificationMessage) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("From: " + n.opts.From + "\r\n")
+	buf.WriteString("To: " + strings.Join(recipients, ", ") + "\r\n")
+	buf.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", msg.Title) + "\r\n")
+	buf.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	body := msg.Text
+	if msg.Link != "" {
+		body += "\n\n详情: " + msg.Link
+	}
+	qp := quotedprintable.NewWriter(&buf)
+	_, _ = qp.Write([]byte(strings.ReplaceAll(body, "\n", "\r\n")))
+	_ = qp.Close()
+	return buf.Bytes()
+}
+
+// 确保实现了接口
+var _ domainService.Notifier = (*SMTPNotifier)(nil)
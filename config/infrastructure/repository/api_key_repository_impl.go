@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	domainEntity "config-client/config/domain/entity"
+	"config-client/config/domain/repository"
+	"config-client/config/infrastructure/converter"
+	infraEntity "config-client/config/infrastructure/entity"
+	gormRepo "config-client/share/repository/gorm"
+	"config-client/share/repository/queryutil"
+)
+
+// APIKeyRepositoryImpl API Key 仓储实现
+type APIKeyRepositoryImpl struct {
+	db        *gorm.DB
+	converter *converter.APIKeyConverter
+	fields    *queryutil.EntityFields[infraEntity.APIKeyPO] // Lambda 字段查询构建器
+}
+
+// NewAPIKeyRepository 创建 API Key 仓储实例
+func NewAPIKeyRepository(db *gorm.DB) repository.APIKeyRepository {
+	return &APIKeyRepositoryImpl{
+		db:        db,
+		converter: converter.NewAPIKeyConverter(),
+		fields:    queryutil.Lambda[infraEntity.APIKeyPO](), // 初始化 Lambda 构建器
+	}
+}
+
+// Create 创建 API Key
+func (r *APIKeyRepositoryImpl) Create(ctx context.Context, key *domainEntity.APIKey) error {
+	po := r.converter.ToPO(key)
+	if err := r.getDB(ctx).Create(po).Error; err != nil {
+		return err
+	}
+	key.ID = po.ID
+	key.CreatedAt = po.CreatedAt
+	key.UpdatedAt = po.UpdatedAt
+	return nil
+}
+
+// Revoke 吊销 API Key
+func (r *APIKeyRepositoryImpl) Revoke(ctx context.Context, id int, revokedBy string, revokedAt time.Time) error {
+	db := queryutil.WhereEq(r.getDB(ctx).Model(&infraEntity.APIKeyPO{}), r.fields.Get("ID").GetColumnName(), id)
+	return db.Updates(map[string]interface{}{
+		r.fields.Get("RevokedAt").GetColumnName(): revokedAt,
+		r.fields.Get("RevokedBy").GetColumnName(): revokedBy,
+	}).Error
+}
+
+// TouchLastUsed 更新最近使用时间
+func (r *APIKeyRepositoryImpl) TouchLastUsed(ctx context.Context, id int, usedAt time.Time) error {
+	db := queryutil.WhereEq(r.getDB(ctx).Model(&infraEntity.APIKeyPO{}), r.fields.Get("ID").GetColumnName(), id)
+	return db.UpdateColumn(r.fields.Get("LastUsedAt").GetColumnName(), usedAt).Error
+}
+
+// GetByID 根据ID查询 API Key
+func (r *APIKeyRepositoryImpl) GetByID(ctx context.Context, id int) (*domainEntity.APIKey, error) {
+	return r.first(queryutil.WhereEq(r.getDB(ctx), r.fields.Get("ID").GetColumnName(), id))
+}
+
+// GetByName 根据名称查询 API Key
+func (r *APIKeyRepositoryImpl) GetByName(ctx context.Context, name string) (*domainEntity.APIKey, error) {
+	return r.first(queryutil.WhereEq(r.getDB(ctx), r.fields.Get("Name").GetColumnName(), name))
+}
+
+// GetByHash 根据密钥摘要查询 API Key
+func (r *APIKeyRepositoryImpl) GetByHash(ctx context.Context, keyHash string) (*domainEntity.APIKey, error) {
+	return r.first(queryutil.WhereEq(r.getDB(ctx), r.fields.Get("KeyHash").GetColumnName(), keyHash))
+}
+
+// FindAll 查询全部 API Key
+func (r *APIKeyRepositoryImpl) FindAll(ctx context.Context, includeRevoked bool) ([]*domainEntity.APIKey, error) {
+	var pos []*infraEntity.APIKeyPO
+	db := r.getDB(ctx)
+	if !includeRevoked {
+		db = queryutil.WhereIsNull(db, r.fields.Get("RevokedAt").GetColumnName())
+	}
+	db = queryutil.OrderBy(db, r.fields.Get("ID").GetColumnName())
+	if err := db.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return r.converter.ToDOList(pos), nil
+}
+
+// CountUsableByRole 统计指定角色未吊销且未过期的 API Key 数量
+func (r *APIKeyRepositoryImpl) CountUsableByRole(ctx context.Context, role string, now time.Time) (int64, error) {
+	var count int64
+	db := queryutil.WhereEq(r.getDB(ctx).Model(&infraEntity.APIKeyPO{}), r.fields.Get("Role").GetColumnName(), role)
+	db = queryutil.WhereIsNull(db, r.fields.Get("RevokedAt").GetColumnName())
+	expiresAt := r.fields.Get("ExpiresAt").GetColumnName()
+	db = db.Where(expiresAt+" IS NULL OR "+expiresAt+" > ?", now)
+	if err := db.Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// first 查询单条记录（不存在时返回 nil）
+func (r *APIKeyRepositoryImpl) first(db *gorm.DB) (*domainEntity.APIKey, error) {
+	var po infraEntity.APIKeyPO
+	if err := db.First(&po).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.converter.ToDO(&po), nil
+}
+
+// getDB 获取数据库连接（上下文中存在事务时使用事务）
+func (r *APIKeyRepositoryImpl) getDB(ctx context.Context) *gorm.DB {
+	return gormRepo.GetDB(ctx, r.db)
+}
+
+// 确保实现了接口
+var _ repository.APIKeyRepository = (*APIKeyRepositoryImpl)(nil)
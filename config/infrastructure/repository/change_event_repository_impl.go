@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	domainEntity "config-client/config/domain/entity"
+	"config-client/config/domain/repository"
+	"config-client/config/infrastructure/converter"
+	infraEntity "config-client/config/infrastructure/entity"
+	gormRepo "config-client/share/repository/gorm"
+	"config-client/share/tenant"
+)
+
+// nextSequenceSQL 递增并返回命名空间的事件序号
+// 计数器行在事务提交前保持行锁，同一命名空间的事件按提交顺序获得递增序号
+// 原生 SQL 不经过租户回调，命名空间不属于上下文中的租户时不返回序号（租户ID为 0 时不校验）
+const nextSequenceSQL = `
+INSERT INTO t_change_event_sequences (namespace_id, last_sequence, updated_at)
+SELECT ?, 1, CURRENT_TIMESTAMP
+WHERE ? = 0 OR ? IN (SELECT id FROM t_namespaces WHERE tenant_id = ?)
+ON CONFLICT (namespace_id) DO UPDATE
+SET last_sequence = t_change_event_sequences.last_sequence + 1, updated_at = CURRENT_TIMESTAMP
+RETURNING last_sequence`
+
+// ChangeEventRepositoryImpl 配置变更事件日志仓储实现
+type ChangeEventRepositoryImpl struct {
+	db        *gorm.DB
+	converter *converter.ChangeEventConverter
+}
+
+// NewChangeEventRepository 创建配置变更事件日志仓储实例
+func NewChangeEventRepository(db *gorm.DB) repository.ChangeEventRepository {
+	return &ChangeEventRepositoryImpl{
+		db:        db,
+		converter: converter.NewChangeEventConverter(),
+	}
+}
+
+// ==================== 写操作实现 ====================
+
+// Append 追加事件并分配序号（分配序号与写入事件在同一事务内完成）
+func (r *ChangeEventRepositoryImpl) Append(ctx context.Context, event *domainEntity.ChangeEvent) error {
+	return gormRepo.RunInTx(ctx, r.db, func(ctx context.Context) error {
+		db := r.getDB(ctx)
+
+		tenantID, _ := tenant.FromContext(ctx)
+		var sequence int64
+		if err := db.Raw(nextSequenceSQL, event.NamespaceID, tenantID, event.NamespaceID, tenantID).Scan(&sequence).Error; err != nil {
+			return err
+		}
+		if sequence == 0 {
+			return fmt.Errorf("命名空间不属于当前租户: namespaceID=%d", event.NamespaceID)
+		}
+
+		po := r.converter.ToPO(event)
+		po.Sequence = sequence
+		if err := db.Create(po).Error; err != nil {
+			return err
+		}
+
+		event.ID = po.ID
+		event.Sequence = po.Sequence
+		event.CreatedAt = po.CreatedAt
+		return nil
+	})
+}
+
+// DeleteBefore 删除指定时间之前的事件
+func (r *ChangeEventRepositoryImpl) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.getDB(ctx).
+		Where("created_at < ?", before).
+		Delete(&infraEntity.ChangeEventPO{})
+	return result.RowsAffected, result.Error
+}
+
+// ==================== 读操作实现 ====================
+
+// FindAfterSequence 查询序号大于 afterSequence 的事件（按序号升序）
+func (r *ChangeEventRepositoryImpl) FindAfterSequence(ctx context.Context, namespaceID int, afterSequence int64, limit int) ([]*domainEntity.ChangeEvent, error) {
+	var pos []*infraEntity.ChangeEventPO
+	db := r.getDB(ctx).
+		Where("namespace_id = ? AND sequence > ?", namespaceID, afterSequence).
+		Order("sequence ASC")
+	if limit > 0 {
+		db = db.Limit(limit)
+	}
+
+	if err := db.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return r.converter.ToDOList(pos), nil
+}
+
+// GetLatestSequence 获取命名空间的最新事件序号
+func (r *ChangeEventRepositoryImpl) GetLatestSequence(ctx context.Context, namespaceID int) (int64, error) {
+	var sequence int64
+	err := r.getDB(ctx).
+		Model(&infraEntity.ChangeEventSequencePO{}).
+		Select("COALESCE(MAX(last_sequence), 0)").
+		Where("namespace_id = ?", namespaceID).
+		Scan(&sequence).Error
+	return sequence, err
+}
+
+// GetOldestSequence 获取命名空间中仍保留的最早事件序号
+func (r *ChangeEventRepositoryImpl) GetOldestSequence(ctx context.Context, namespaceID int) (int64, error) {
+	var sequence int64
+	err := r.getDB(ctx).
+		Model(&infraEntity.ChangeEventPO{}).
+		Select("COALESCE(MIN(sequence), 0)").
+		Where("namespace_id = ?", namespaceID).
+		Scan(&sequence).Error
+	return sequence, err
+}
+
+// getDB 获取数据库连接（上下文中存在事务时使用事务）
+func (r *ChangeEventRepositoryImpl) getDB(ctx context.Context) *gorm.DB {
+	return gormRepo.GetDB(ctx, r.db)
+}
+
+// 确保实现了接口
+var _ repository.ChangeEventRepository = (*ChangeEventRepositoryImpl)(nil)
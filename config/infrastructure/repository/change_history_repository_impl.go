@@ -12,7 +12,9 @@ import (
 	"config-client/config/infrastructure/converter"
 	infraEntity "config-client/config/infrastructure/entity"
 	shareRepo "config-client/share/repository"
+	gormRepo "config-client/share/repository/gorm"
 	"config-client/share/repository/queryutil"
+	"config-client/share/tenant"
 )
 
 // ChangeHistoryRepositoryImpl 变更历史仓储实现
@@ -21,14 +23,19 @@ type ChangeHistoryRepositoryImpl struct {
 	converter *converter.ChangeHistoryConverter
 	fields    *queryutil.EntityFields[infraEntity.ChangeHistoryPO] // Lambda 字段查询构建器
 	model     infraEntity.ChangeHistoryPO                          // 用于类型安全的字段引用
+
+	sortFields shareRepo.SortFields // 分页查询允许的排序字段
 }
 
 // NewChangeHistoryRepository 创建变更历史仓储实例
 func NewChangeHistoryRepository(db *gorm.DB) repository.ChangeHistoryRepository {
+	fields := queryutil.Lambda[infraEntity.ChangeHistoryPO]() // 初始化 Lambda 构建器
 	return &ChangeHistoryRepositoryImpl{
 		db:        db,
 		converter: converter.NewChangeHistoryConverter(),
-		fields:    queryutil.Lambda[infraEntity.ChangeHistoryPO](), // 初始化 Lambda 构建器
+		fields:    fields,
+		sortFields: fields.SortFields("ID", "ConfigID", "NamespaceID", "ConfigKey", "Environment", "Operation",
+			"OldVersion", "NewVersion", "Operator", "CreatedAt"),
 	}
 }
 
@@ -37,7 +44,7 @@ func NewChangeHistoryRepository(db *gorm.DB) repository.ChangeHistoryRepository
 // Save 保存变更记录
 func (r *ChangeHistoryRepositoryImpl) Save(ctx context.Context, history *domainEntity.ChangeHistory) error {
 	po := r.converter.ToPO(history)
-	return r.db.WithContext(ctx).Create(po).Error
+	return r.getDB(ctx).Create(po).Error
 }
 
 // BatchSave 批量保存变更记录
@@ -46,7 +53,16 @@ func (r *ChangeHistoryRepositoryImpl) BatchSave(ctx context.Context, histories [
 		return nil
 	}
 	pos := r.converter.ToPOList(histories)
-	return r.db.WithContext(ctx).Create(pos).Error
+	return r.getDB(ctx).Create(pos).Error
+}
+
+// DeleteByIDs 根据ID批量删除变更记录
+func (r *ChangeHistoryRepositoryImpl) DeleteByIDs(ctx context.Context, ids []int) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	result := r.getDB(ctx).Where("id IN ?", ids).Delete(&infraEntity.ChangeHistoryPO{})
+	return result.RowsAffected, result.Error
 }
 
 // ==================== 读操作实现 ====================
@@ -54,7 +70,7 @@ func (r *ChangeHistoryRepositoryImpl) BatchSave(ctx context.Context, histories [
 // FindByConfigID 查询指定配置的所有变更历史(按时间倒序)
 func (r *ChangeHistoryRepositoryImpl) FindByConfigID(ctx context.Context, configID int, limit int) ([]*domainEntity.ChangeHistory, error) {
 	var pos []*infraEntity.ChangeHistoryPO
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 	db = queryutil.WhereEq(db, r.fields.Get("ConfigID").GetColumnName(), configID)
 	db = queryutil.OrderByDesc(db, r.fields.Get("CreatedAt").GetColumnName())
 
@@ -73,7 +89,7 @@ func (r *ChangeHistoryRepositoryImpl) FindByConfigID(ctx context.Context, config
 // FindByNamespaceAndKey 查询指定命名空间和配置键的变更历史
 func (r *ChangeHistoryRepositoryImpl) FindByNamespaceAndKey(ctx context.Context, namespaceID int, configKey string, limit int) ([]*domainEntity.ChangeHistory, error) {
 	var pos []*infraEntity.ChangeHistoryPO
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
 	db = queryutil.WhereEq(db, r.fields.Get("ConfigKey").GetColumnName(), configKey)
 	db = queryutil.OrderByDesc(db, r.fields.Get("CreatedAt").GetColumnName())
@@ -93,7 +109,7 @@ func (r *ChangeHistoryRepositoryImpl) FindByNamespaceAndKey(ctx context.Context,
 // FindByID 根据ID查询变更记录
 func (r *ChangeHistoryRepositoryImpl) FindByID(ctx context.Context, id int) (*domainEntity.ChangeHistory, error) {
 	var po infraEntity.ChangeHistoryPO
-	err := r.db.WithContext(ctx).First(&po, id).Error
+	err := r.getDB(ctx).First(&po, id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -106,7 +122,7 @@ func (r *ChangeHistoryRepositoryImpl) FindByID(ctx context.Context, id int) (*do
 // FindLatestByConfigID 查询指定配置的最新变更记录
 func (r *ChangeHistoryRepositoryImpl) FindLatestByConfigID(ctx context.Context, configID int) (*domainEntity.ChangeHistory, error) {
 	var po infraEntity.ChangeHistoryPO
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 	db = queryutil.WhereEq(db, r.fields.Get("ConfigID").GetColumnName(), configID)
 	db = queryutil.OrderByDesc(db, r.fields.Get("CreatedAt").GetColumnName())
 	err := db.First(&po).Error
@@ -122,7 +138,7 @@ func (r *ChangeHistoryRepositoryImpl) FindLatestByConfigID(ctx context.Context,
 
 // FindByOperator 查询指定操作人的变更记录
 func (r *ChangeHistoryRepositoryImpl) FindByOperator(ctx context.Context, operator string, page, size int) (*shareRepo.PageResult[*domainEntity.ChangeHistory], error) {
-	db := r.db.WithContext(ctx).Model(&infraEntity.ChangeHistoryPO{})
+	db := r.getDB(ctx).Model(&infraEntity.ChangeHistoryPO{})
 	db = queryutil.WhereEq(db, r.fields.Get("Operator").GetColumnName(), operator)
 
 	// 统计总数
@@ -148,9 +164,69 @@ func (r *ChangeHistoryRepositoryImpl) FindByOperator(ctx context.Context, operat
 
 // QueryByParams 根据查询参数分页查询变更历史
 func (r *ChangeHistoryRepositoryImpl) QueryByParams(ctx context.Context, params *repository.ChangeHistoryQueryParams) (*shareRepo.PageResult[*domainEntity.ChangeHistory], error) {
-	db := r.db.WithContext(ctx).Model(&infraEntity.ChangeHistoryPO{})
+	db := r.applyQueryFilters(r.getDB(ctx).Model(&infraEntity.ChangeHistoryPO{}), params)
+
+	// 校验排序字段（只允许白名单内的字段）
+	orderBys, err := r.sortFields.ParseOrderBy(params.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// 游标分页：不统计总数，从游标位置之后查询
+	if params.Cursor != nil {
+		db = queryutil.ApplyCursor(db, r.fields.Get("CreatedAt").GetColumnName(), r.fields.Get("ID").GetColumnName(), *params.Cursor, params.Size)
+		var pos []*infraEntity.ChangeHistoryPO
+		if err := db.Find(&pos).Error; err != nil {
+			return nil, err
+		}
+		return shareRepo.NewCursorPageResult(r.converter.ToDOList(pos), params.Size, (*domainEntity.ChangeHistory).PageCursor), nil
+	}
+
+	// 统计总数
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	// 应用排序（默认按时间倒序）
+	if len(orderBys) > 0 {
+		db = queryutil.ApplyOrderBys(db, orderBys)
+	} else {
+		db = queryutil.OrderByDesc(db, r.fields.Get("CreatedAt").GetColumnName())
+	}
+
+	// 应用分页
+	offset := (params.Page - 1) * params.Size
+	db = db.Offset(offset).Limit(params.Size)
+
+	// 查询数据
+	var pos []*infraEntity.ChangeHistoryPO
+	if err := db.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+
+	dos := r.converter.ToDOList(pos)
+	return shareRepo.NewPageResult(dos, total, params.Page, params.Size), nil
+}
+
+// FindByParamsAfterID 按查询条件查询ID大于 afterID 的变更记录（按ID升序，用于游标式遍历导出）
+func (r *ChangeHistoryRepositoryImpl) FindByParamsAfterID(ctx context.Context, params *repository.ChangeHistoryQueryParams, afterID int, limit int) ([]*domainEntity.ChangeHistory, error) {
+	db := r.applyQueryFilters(r.getDB(ctx).Model(&infraEntity.ChangeHistoryPO{}), params)
+	db = queryutil.WhereGt(db, r.fields.Get("ID").GetColumnName(), afterID)
+	db = queryutil.OrderBy(db, r.fields.Get("ID").GetColumnName())
+	if limit > 0 {
+		db = db.Limit(limit)
+	}
+
+	var pos []*infraEntity.ChangeHistoryPO
+	if err := db.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return r.converter.ToDOList(pos), nil
+}
 
-	// 构建查询条件
+// applyQueryFilters 应用变更历史查询条件
+func (r *ChangeHistoryRepositoryImpl) applyQueryFilters(db *gorm.DB, params *repository.ChangeHistoryQueryParams) *gorm.DB {
 	if params.ConfigID != nil {
 		db = queryutil.WhereEq(db, r.fields.Get("ConfigID").GetColumnName(), *params.ConfigID)
 	}
@@ -178,28 +254,7 @@ func (r *ChangeHistoryRepositoryImpl) QueryByParams(ctx context.Context, params
 			db = queryutil.WhereLte(db, r.fields.Get("CreatedAt").GetColumnName(), endTime)
 		}
 	}
-
-	// 统计总数
-	var total int64
-	if err := db.Count(&total).Error; err != nil {
-		return nil, err
-	}
-
-	// 默认按时间倒序
-	db = queryutil.OrderByDesc(db, r.fields.Get("CreatedAt").GetColumnName())
-
-	// 应用分页
-	offset := (params.Page - 1) * params.Size
-	db = db.Offset(offset).Limit(params.Size)
-
-	// 查询数据
-	var pos []*infraEntity.ChangeHistoryPO
-	if err := db.Find(&pos).Error; err != nil {
-		return nil, err
-	}
-
-	dos := r.converter.ToDOList(pos)
-	return shareRepo.NewPageResult(dos, total, params.Page, params.Size), nil
+	return db
 }
 
 // ==================== 基础 CRUD 实现 ====================
@@ -234,7 +289,7 @@ func (r *ChangeHistoryRepositoryImpl) Delete(ctx context.Context, id int) error
 // List 查询全部列表
 func (r *ChangeHistoryRepositoryImpl) List(ctx context.Context) ([]*domainEntity.ChangeHistory, error) {
 	var pos []*infraEntity.ChangeHistoryPO
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 	db = queryutil.OrderByDesc(db, r.fields.Get("CreatedAt").GetColumnName())
 	err := db.Find(&pos).Error
 	if err != nil {
@@ -257,7 +312,7 @@ func (r *ChangeHistoryRepositoryImpl) Page(ctx context.Context, request *shareRe
 // CountByConfigID 统计指定配置的变更次数
 func (r *ChangeHistoryRepositoryImpl) CountByConfigID(ctx context.Context, configID int) (int64, error) {
 	var count int64
-	db := r.db.WithContext(ctx).Model(&infraEntity.ChangeHistoryPO{})
+	db := r.getDB(ctx).Model(&infraEntity.ChangeHistoryPO{})
 	db = queryutil.WhereEq(db, r.fields.Get("ConfigID").GetColumnName(), configID)
 	err := db.Count(&count).Error
 	return count, err
@@ -266,7 +321,7 @@ func (r *ChangeHistoryRepositoryImpl) CountByConfigID(ctx context.Context, confi
 // CountByOperation 统计指定操作类型的变更次数
 func (r *ChangeHistoryRepositoryImpl) CountByOperation(ctx context.Context, operation string) (int64, error) {
 	var count int64
-	db := r.db.WithContext(ctx).Model(&infraEntity.ChangeHistoryPO{})
+	db := r.getDB(ctx).Model(&infraEntity.ChangeHistoryPO{})
 	db = queryutil.WhereEq(db, r.fields.Get("Operation").GetColumnName(), operation)
 	err := db.Count(&count).Error
 	return count, err
@@ -275,7 +330,7 @@ func (r *ChangeHistoryRepositoryImpl) CountByOperation(ctx context.Context, oper
 // CountByTimeRange 统计时间范围内的变更次数
 func (r *ChangeHistoryRepositoryImpl) CountByTimeRange(ctx context.Context, startTime, endTime string) (int64, error) {
 	var count int64
-	db := r.db.WithContext(ctx).Model(&infraEntity.ChangeHistoryPO{})
+	db := r.getDB(ctx).Model(&infraEntity.ChangeHistoryPO{})
 
 	if startTime != "" {
 		if t, err := time.Parse("2006-01-02 15:04:05", startTime); err == nil {
@@ -292,5 +347,48 @@ func (r *ChangeHistoryRepositoryImpl) CountByTimeRange(ctx context.Context, star
 	return count, err
 }
 
+// FindCreatedBefore 查询指定时间之前的变更记录（按ID升序）
+func (r *ChangeHistoryRepositoryImpl) FindCreatedBefore(ctx context.Context, before time.Time, limit int) ([]*domainEntity.ChangeHistory, error) {
+	var pos []*infraEntity.ChangeHistoryPO
+	db := r.getDB(ctx)
+	db = queryutil.WhereLt(db, r.fields.Get("CreatedAt").GetColumnName(), before)
+	db = queryutil.OrderBy(db, r.fields.Get("ID").GetColumnName())
+	if limit > 0 {
+		db = db.Limit(limit)
+	}
+
+	if err := db.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return r.converter.ToDOList(pos), nil
+}
+
+// beyondLatestPerConfigSQL 按配置分组、ID倒序编号，取每个配置最新 keep 条之外的记录
+// 原生 SQL 不经过租户回调，租户ID为 0（后台任务）时不按租户过滤
+const beyondLatestPerConfigSQL = `
+SELECT * FROM (
+	SELECT h.*, ROW_NUMBER() OVER (PARTITION BY h.config_id ORDER BY h.id DESC) AS rn
+	FROM t_change_history h
+	WHERE ? = 0 OR h.namespace_id IN (SELECT id FROM t_namespaces WHERE tenant_id = ?)
+) ranked
+WHERE ranked.rn > ?
+ORDER BY ranked.id
+LIMIT ?`
+
+// FindBeyondLatestPerConfig 查询每个配置最新 keep 条之外的变更记录（按ID升序）
+func (r *ChangeHistoryRepositoryImpl) FindBeyondLatestPerConfig(ctx context.Context, keep int, limit int) ([]*domainEntity.ChangeHistory, error) {
+	tenantID, _ := tenant.FromContext(ctx)
+	var pos []*infraEntity.ChangeHistoryPO
+	if err := r.getDB(ctx).Raw(beyondLatestPerConfigSQL, tenantID, tenantID, keep, limit).Scan(&pos).Error; err != nil {
+		return nil, err
+	}
+	return r.converter.ToDOList(pos), nil
+}
+
+// getDB 获取数据库连接（上下文中存在事务时使用事务）
+func (r *ChangeHistoryRepositoryImpl) getDB(ctx context.Context) *gorm.DB {
+	return gormRepo.GetDB(ctx, r.db)
+}
+
 // 确保实现了接口
 var _ repository.ChangeHistoryRepository = (*ChangeHistoryRepositoryImpl)(nil)
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	domainEntity "config-client/config/domain/entity"
+	"config-client/config/domain/repository"
+	"config-client/config/infrastructure/converter"
+	infraEntity "config-client/config/infrastructure/entity"
+	gormRepo "config-client/share/repository/gorm"
+	"config-client/share/repository/queryutil"
+)
+
+// ConfigDependencyRepositoryImpl 配置依赖仓储实现
+type ConfigDependencyRepositoryImpl struct {
+	db        *gorm.DB
+	converter *converter.ConfigDependencyConverter
+	fields    *queryutil.EntityFields[infraEntity.ConfigDependencyPO] // Lambda 字段查询构建器
+}
+
+// NewConfigDependencyRepository 创建配置依赖仓储实例
+func NewConfigDependencyRepository(db *gorm.DB) repository.ConfigDependencyRepository {
+	return &ConfigDependencyRepositoryImpl{
+		db:        db,
+		converter: converter.NewConfigDependencyConverter(),
+		fields:    queryutil.Lambda[infraEntity.ConfigDependencyPO](), // 初始化 Lambda 构建器
+	}
+}
+
+// BatchCreate 批量创建依赖关系
+func (r *ConfigDependencyRepositoryImpl) BatchCreate(ctx context.Context, dependencies []*domainEntity.ConfigDependency) error {
+	if len(dependencies) == 0 {
+		return nil
+	}
+
+	pos := r.converter.ToPOList(dependencies)
+	if err := r.getDB(ctx).Create(&pos).Error; err != nil {
+		return err
+	}
+
+	// 回写自增ID
+	for i, po := range pos {
+		dependencies[i].ID = po.ID
+		dependencies[i].CreatedAt = po.CreatedAt
+	}
+	return nil
+}
+
+// DeleteByConfigID 删除配置声明的所有依赖关系
+func (r *ConfigDependencyRepositoryImpl) DeleteByConfigID(ctx context.Context, configID int) error {
+	db := queryutil.WhereEq(r.getDB(ctx), r.fields.Get("ConfigID").GetColumnName(), configID)
+	return db.Delete(&infraEntity.ConfigDependencyPO{}).Error
+}
+
+// FindByConfigIDs 查询配置声明的依赖关系（上游）
+func (r *ConfigDependencyRepositoryImpl) FindByConfigIDs(ctx context.Context, configIDs []int) ([]*domainEntity.ConfigDependency, error) {
+	return r.findIn(ctx, r.fields.Get("ConfigID").GetColumnName(), configIDs)
+}
+
+// FindByDependsOnIDs 查询依赖指定配置的依赖关系（下游）
+func (r *ConfigDependencyRepositoryImpl) FindByDependsOnIDs(ctx context.Context, dependsOnIDs []int) ([]*domainEntity.ConfigDependency, error) {
+	return r.findIn(ctx, r.fields.Get("DependsOnConfigID").GetColumnName(), dependsOnIDs)
+}
+
+// findIn 按列值集合查询依赖关系
+func (r *ConfigDependencyRepositoryImpl) findIn(ctx context.Context, column string, values []int) ([]*domainEntity.ConfigDependency, error) {
+	if len(values) == 0 {
+		return []*domainEntity.ConfigDependency{}, nil
+	}
+
+	var pos []*infraEntity.ConfigDependencyPO
+	db := queryutil.WhereIn(r.getDB(ctx), column, values)
+	db = queryutil.OrderBy(db, r.fields.Get("ID").GetColumnName())
+	if err := db.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return r.converter.ToDOList(pos), nil
+}
+
+// getDB 获取数据库连接（上下文中存在事务时使用事务）
+func (r *ConfigDependencyRepositoryImpl) getDB(ctx context.Context) *gorm.DB {
+	return gormRepo.GetDB(ctx, r.db)
+}
+
+// 确保实现了接口
+var _ repository.ConfigDependencyRepository = (*ConfigDependencyRepositoryImpl)(nil)
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	domainEntity "config-client/config/domain/entity"
+	"config-client/config/domain/repository"
+	"config-client/config/infrastructure/converter"
+	infraEntity "config-client/config/infrastructure/entity"
+	gormRepo "config-client/share/repository/gorm"
+	"config-client/share/repository/queryutil"
+)
+
+// ConfigFileRepositoryImpl 文件配置内容仓储实现
+type ConfigFileRepositoryImpl struct {
+	db        *gorm.DB
+	converter *converter.ConfigFileConverter
+	fields    *queryutil.EntityFields[infraEntity.ConfigFilePO] // Lambda 字段查询构建器
+}
+
+// NewConfigFileRepository 创建文件配置内容仓储实例
+func NewConfigFileRepository(db *gorm.DB) repository.ConfigFileRepository {
+	return &ConfigFileRepositoryImpl{
+		db:        db,
+		converter: converter.NewConfigFileConverter(),
+		fields:    queryutil.Lambda[infraEntity.ConfigFilePO](), // 初始化 Lambda 构建器
+	}
+}
+
+// Save 保存文件内容（按配置ID和内容哈希去重，已存在时只更新文件名和内容类型）
+func (r *ConfigFileRepositoryImpl) Save(ctx context.Context, file *domainEntity.ConfigFile) error {
+	po := r.converter.ToPO(file)
+	err := r.getDB(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: r.fields.Get("ConfigID").GetColumnName()},
+			{Name: r.fields.Get("SHA256").GetColumnName()},
+		},
+		DoUpdates: clause.AssignmentColumns([]string{
+			r.fields.Get("FileName").GetColumnName(),
+			r.fields.Get("ContentType").GetColumnName(),
+		}),
+	}).Create(po).Error
+	if err != nil {
+		return err
+	}
+
+	file.ID = po.ID
+	file.CreatedAt = po.CreatedAt
+	return nil
+}
+
+// FindByHash 根据配置ID和内容哈希查询文件
+func (r *ConfigFileRepositoryImpl) FindByHash(ctx context.Context, configID int, sha256 string, withContent bool) (*domainEntity.ConfigFile, error) {
+	var po infraEntity.ConfigFilePO
+	db := queryutil.WhereEq(r.getDB(ctx), r.fields.Get("ConfigID").GetColumnName(), configID)
+	db = queryutil.WhereEq(db, r.fields.Get("SHA256").GetColumnName(), sha256)
+	if !withContent {
+		db = db.Omit(r.fields.Get("Content").GetColumnName())
+	}
+	if err := db.First(&po).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.converter.ToDO(&po), nil
+}
+
+// getDB 获取数据库连接（上下文中存在事务时使用事务）
+func (r *ConfigFileRepositoryImpl) getDB(ctx context.Context) *gorm.DB {
+	return gormRepo.GetDB(ctx, r.db)
+}
+
+// 确保实现了接口
+var _ repository.ConfigFileRepository = (*ConfigFileRepositoryImpl)(nil)
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	domainEntity "config-client/config/domain/entity"
+	"config-client/config/domain/repository"
+	"config-client/config/infrastructure/converter"
+	infraEntity "config-client/config/infrastructure/entity"
+	"config-client/share/repository/queryutil"
+)
+
+// ConfigGroupRepositoryImpl 配置分组仓储实现
+type ConfigGroupRepositoryImpl struct {
+	db        *gorm.DB
+	converter *converter.ConfigGroupConverter
+	fields    *queryutil.EntityFields[infraEntity.ConfigGroupPO] // Lambda 字段查询构建器
+}
+
+// NewConfigGroupRepository 创建配置分组仓储实例
+func NewConfigGroupRepository(db *gorm.DB) repository.ConfigGroupRepository {
+	return &ConfigGroupRepositoryImpl{
+		db:        db,
+		converter: converter.NewConfigGroupConverter(),
+		fields:    queryutil.Lambda[infraEntity.ConfigGroupPO](), // 初始化 Lambda 构建器
+	}
+}
+
+// Create 创建配置分组
+func (r *ConfigGroupRepositoryImpl) Create(ctx context.Context, group *domainEntity.ConfigGroup) error {
+	po := r.converter.ToPO(group)
+	if err := r.db.WithContext(ctx).Create(po).Error; err != nil {
+		return err
+	}
+
+	// 回写自增ID和审计字段
+	group.ID = po.ID
+	group.CreatedAt = po.CreatedAt
+	group.UpdatedAt = po.UpdatedAt
+	return nil
+}
+
+// Update 更新配置分组
+func (r *ConfigGroupRepositoryImpl) Update(ctx context.Context, group *domainEntity.ConfigGroup) error {
+	po := r.converter.ToPO(group)
+	return r.db.WithContext(ctx).Save(po).Error
+}
+
+// Delete 删除配置分组（软删除）
+func (r *ConfigGroupRepositoryImpl) Delete(ctx context.Context, id int) error {
+	return r.db.WithContext(ctx).Delete(&infraEntity.ConfigGroupPO{}, id).Error
+}
+
+// GetByID 根据ID查询配置分组
+func (r *ConfigGroupRepositoryImpl) GetByID(ctx context.Context, id int) (*domainEntity.ConfigGroup, error) {
+	db := r.db.WithContext(ctx)
+	db = queryutil.WhereEq(db, r.fields.Get("ID").GetColumnName(), id)
+	return r.first(db)
+}
+
+// FindByName 根据命名空间和分组名称查询配置分组
+func (r *ConfigGroupRepositoryImpl) FindByName(ctx context.Context, namespaceID int, name string) (*domainEntity.ConfigGroup, error) {
+	db := r.db.WithContext(ctx)
+	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
+	db = queryutil.WhereEq(db, r.fields.Get("Name").GetColumnName(), name)
+	return r.first(db)
+}
+
+// FindByNamespace 查询命名空间下的所有配置分组（按展示顺序、名称升序）
+func (r *ConfigGroupRepositoryImpl) FindByNamespace(ctx context.Context, namespaceID int) ([]*domainEntity.ConfigGroup, error) {
+	var pos []*infraEntity.ConfigGroupPO
+	db := r.db.WithContext(ctx)
+	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
+	db = queryutil.OrderBy(db, r.fields.Get("SortOrder").GetColumnName())
+	db = queryutil.OrderBy(db, r.fields.Get("Name").GetColumnName())
+	if err := db.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return r.converter.ToDOList(pos), nil
+}
+
+// first 查询第一条记录，不存在时返回 nil
+func (r *ConfigGroupRepositoryImpl) first(db *gorm.DB) (*domainEntity.ConfigGroup, error) {
+	var po infraEntity.ConfigGroupPO
+	if err := db.First(&po).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.converter.ToDO(&po), nil
+}
+
+// 确保实现了接口
+var _ repository.ConfigGroupRepository = (*ConfigGroupRepositoryImpl)(nil)
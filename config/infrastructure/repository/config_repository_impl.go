@@ -3,8 +3,11 @@ package repository
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	domainEntity "config-client/config/domain/entity"
 	"config-client/config/domain/repository"
@@ -21,14 +24,19 @@ type ConfigRepositoryImpl struct {
 	converter *converter.ConfigConverter
 	fields    *queryutil.EntityFields[infraEntity.ConfigPO] // Lambda 字段查询构建器
 	model     infraEntity.ConfigPO                          // 用于类型安全的字段引用
+
+	sortFields shareRepo.SortFields // 分页查询允许的排序字段
 }
 
 // NewConfigRepository 创建配置仓储实例
 func NewConfigRepository(db *gorm.DB) repository.ConfigRepository {
+	fields := queryutil.Lambda[infraEntity.ConfigPO]() // 初始化 Lambda 构建器
 	return &ConfigRepositoryImpl{
 		db:        db,
 		converter: converter.NewConfigConverter(),
-		fields:    queryutil.Lambda[infraEntity.ConfigPO](), // 初始化 Lambda 构建器
+		fields:    fields,
+		sortFields: fields.SortFields("ID", "NamespaceID", "Key", "GroupName", "Environment", "ValueType",
+			"Version", "IsReleased", "IsActive", "ExpiresAt", "CreatedAt", "UpdatedAt", "DeletedAt"),
 	}
 }
 
@@ -37,7 +45,7 @@ func NewConfigRepository(db *gorm.DB) repository.ConfigRepository {
 // Create 创建配置
 func (r *ConfigRepositoryImpl) Create(ctx context.Context, entity *domainEntity.Config) error {
 	po := r.converter.ToPO(entity)
-	if err := r.db.WithContext(ctx).Create(po).Error; err != nil {
+	if err := r.getDB(ctx).Create(po).Error; err != nil {
 		return err
 	}
 	// 更新实体ID
@@ -51,13 +59,13 @@ func (r *ConfigRepositoryImpl) CreateBatch(ctx context.Context, entities []*doma
 		return nil
 	}
 	pos := r.converter.ToPOList(entities)
-	return r.db.WithContext(ctx).Create(pos).Error
+	return r.getDB(ctx).Create(pos).Error
 }
 
 // GetByID 根据ID查询配置
 func (r *ConfigRepositoryImpl) GetByID(ctx context.Context, id int) (*domainEntity.Config, error) {
 	var po infraEntity.ConfigPO
-	err := r.db.WithContext(ctx).First(&po, id).Error
+	err := r.getDB(ctx).First(&po, id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -67,21 +75,78 @@ func (r *ConfigRepositoryImpl) GetByID(ctx context.Context, id int) (*domainEnti
 	return r.converter.ToDO(&po), nil
 }
 
+// GetDeletedByID 根据ID查询已删除的配置
+func (r *ConfigRepositoryImpl) GetDeletedByID(ctx context.Context, id int) (*domainEntity.Config, error) {
+	var po infraEntity.ConfigPO
+	err := r.getDB(ctx).Unscoped().Where("deleted_at IS NOT NULL").First(&po, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.converter.ToDO(&po), nil
+}
+
+// Restore 恢复已删除的配置
+func (r *ConfigRepositoryImpl) Restore(ctx context.Context, entity *domainEntity.Config) error {
+	return r.getDB(ctx).Unscoped().
+		Model(&infraEntity.ConfigPO{}).
+		Where("id = ? AND deleted_at IS NOT NULL", entity.ID).
+		Updates(map[string]interface{}{
+			"deleted_at": nil,
+			"is_deleted": false,
+			"version":    entity.Version,
+			"updated_by": entity.UpdatedBy,
+		}).Error
+}
+
 // Update 更新配置
 func (r *ConfigRepositoryImpl) Update(ctx context.Context, entity *domainEntity.Config) error {
 	po := r.converter.ToPO(entity)
-	return r.db.WithContext(ctx).Save(po).Error
+	return r.getDB(ctx).Save(po).Error
+}
+
+// UpdateWithVersion 按版本号条件更新配置（乐观锁）
+// 不使用 Save：Save 在未命中行时会退化为插入，无法感知版本冲突
+func (r *ConfigRepositoryImpl) UpdateWithVersion(ctx context.Context, entity *domainEntity.Config, expectedVersion int) (bool, error) {
+	po := r.converter.ToPO(entity)
+	result := r.getDB(ctx).
+		Model(po).
+		Where("version = ?", expectedVersion).
+		Select("*").
+		Omit("created_at", "created_by").
+		Updates(po)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// UpdateContentHash 按哈希值条件更新配置的内容哈希及算法
+func (r *ConfigRepositoryImpl) UpdateContentHash(ctx context.Context, id int, expectedHash string, hash string, algorithm string) (bool, error) {
+	result := r.getDB(ctx).
+		Model(&infraEntity.ConfigPO{}).
+		Where("id = ? AND content_hash = ?", id, expectedHash).
+		Updates(map[string]interface{}{
+			"content_hash":           hash,
+			"content_hash_algorithm": algorithm,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
 }
 
 // Delete 删除配置（软删除）
 func (r *ConfigRepositoryImpl) Delete(ctx context.Context, id int) error {
-	return r.db.WithContext(ctx).Delete(&infraEntity.ConfigPO{}, id).Error
+	return r.getDB(ctx).Delete(&infraEntity.ConfigPO{}, id).Error
 }
 
 // List 查询全部列表
 func (r *ConfigRepositoryImpl) List(ctx context.Context) ([]*domainEntity.Config, error) {
 	var pos []*infraEntity.ConfigPO
-	err := r.db.WithContext(ctx).Find(&pos).Error
+	err := r.getDB(ctx).Find(&pos).Error
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +155,7 @@ func (r *ConfigRepositoryImpl) List(ctx context.Context) ([]*domainEntity.Config
 
 // Page 分页查询
 func (r *ConfigRepositoryImpl) Page(ctx context.Context, request *shareRepo.PageRequest) (*shareRepo.PageResult[*domainEntity.Config], error) {
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 
 	// 统计总数
 	var total int64
@@ -120,7 +185,7 @@ func (r *ConfigRepositoryImpl) Page(ctx context.Context, request *shareRepo.Page
 // FindByNamespaceAndKey 根据命名空间ID、配置键和环境查询配置
 func (r *ConfigRepositoryImpl) FindByNamespaceAndKey(ctx context.Context, namespaceID int, key string, environment string) (*domainEntity.Config, error) {
 	var po infraEntity.ConfigPO
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
 	db = queryutil.WhereEq(db, r.fields.Get("Key").GetColumnName(), key)
 	db = queryutil.WhereEq(db, r.fields.Get("Environment").GetColumnName(), environment)
@@ -136,10 +201,89 @@ func (r *ConfigRepositoryImpl) FindByNamespaceAndKey(ctx context.Context, namesp
 	return r.converter.ToDO(&po), nil
 }
 
+// FindByNamespaceAndKeys 根据命名空间ID和一组配置键批量查询配置
+func (r *ConfigRepositoryImpl) FindByNamespaceAndKeys(ctx context.Context, namespaceID int, keys []string, environment string) ([]*domainEntity.Config, error) {
+	if len(keys) == 0 {
+		return []*domainEntity.Config{}, nil
+	}
+
+	var pos []*infraEntity.ConfigPO
+	db := r.getDB(ctx)
+	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
+	db = queryutil.WhereEq(db, r.fields.Get("Environment").GetColumnName(), environment)
+	db = queryutil.WhereIn(db, r.fields.Get("Key").GetColumnName(), keys)
+	db = queryutil.OrderBy(db, r.fields.Get("Key").GetColumnName())
+	if err := db.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return r.converter.ToDOList(pos), nil
+}
+
+// FindVersionsByKeys 批量查询配置版本
+// 版本在数据库中计算（MD5(value)，与 ComputeVersion 一致），不传输配置值
+func (r *ConfigRepositoryImpl) FindVersionsByKeys(ctx context.Context, keys []repository.NamespaceKeyEnv) (map[repository.NamespaceKeyEnv]string, error) {
+	versions := make(map[repository.NamespaceKeyEnv]string, len(keys))
+	if len(keys) == 0 {
+		return versions, nil
+	}
+
+	namespaceColumn := r.fields.Get("NamespaceID").GetColumnName()
+	keyColumn := r.fields.Get("Key").GetColumnName()
+	envColumn := r.fields.Get("Environment").GetColumnName()
+	tuples := make([][]interface{}, 0, len(keys))
+	for _, key := range keys {
+		tuples = append(tuples, []interface{}{key.NamespaceID, key.Key, key.Environment})
+	}
+
+	var rows []struct {
+		NamespaceID int
+		Key         string
+		Environment string
+		Version     string
+	}
+	err := r.getDB(ctx).Model(&infraEntity.ConfigPO{}).
+		Select(namespaceColumn+" AS namespace_id, "+keyColumn+" AS key, "+envColumn+" AS environment, "+
+			"MD5("+r.fields.Get("Value").GetColumnName()+") AS version").
+		Where("("+namespaceColumn+", "+keyColumn+", "+envColumn+") IN ?", tuples).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		versions[repository.NamespaceKeyEnv{NamespaceID: row.NamespaceID, Key: row.Key, Environment: row.Environment}] = row.Version
+	}
+	return versions, nil
+}
+
+// FindVersionsByPrefix 查询配置键以 prefix 开头的配置版本
+// 版本在数据库中计算（MD5(value)，与 ComputeVersion 一致），不传输配置值
+func (r *ConfigRepositoryImpl) FindVersionsByPrefix(ctx context.Context, namespaceID int, prefix string, environment string) (map[string]string, error) {
+	keyColumn := r.fields.Get("Key").GetColumnName()
+	var rows []struct {
+		Key     string
+		Version string
+	}
+	db := r.getDB(ctx).Model(&infraEntity.ConfigPO{}).
+		Select(keyColumn + " AS key, MD5(" + r.fields.Get("Value").GetColumnName() + ") AS version")
+	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
+	db = queryutil.WhereEq(db, r.fields.Get("Environment").GetColumnName(), environment)
+	db = db.Where(keyColumn+" LIKE ?", escapeLike(prefix)+"%")
+	if err := db.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	versions := make(map[string]string, len(rows))
+	for _, row := range rows {
+		versions[row.Key] = row.Version
+	}
+	return versions, nil
+}
+
 // FindByNamespace 根据命名空间ID查询该命名空间下的所有配置
 func (r *ConfigRepositoryImpl) FindByNamespace(ctx context.Context, namespaceID int) ([]*domainEntity.Config, error) {
 	var pos []*infraEntity.ConfigPO
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
 	db = queryutil.OrderBy(db, r.fields.Get("GroupName").GetColumnName())
 	db = queryutil.OrderBy(db, r.fields.Get("Key").GetColumnName())
@@ -155,7 +299,7 @@ func (r *ConfigRepositoryImpl) FindByNamespace(ctx context.Context, namespaceID
 // FindByEnvironment 根据环境查询配置列表
 func (r *ConfigRepositoryImpl) FindByEnvironment(ctx context.Context, environment string) ([]*domainEntity.Config, error) {
 	var pos []*infraEntity.ConfigPO
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 	db = queryutil.WhereEq(db, r.fields.Get("Environment").GetColumnName(), environment)
 	db = queryutil.OrderBy(db, r.fields.Get("NamespaceID").GetColumnName())
 	db = queryutil.OrderBy(db, r.fields.Get("GroupName").GetColumnName())
@@ -172,7 +316,7 @@ func (r *ConfigRepositoryImpl) FindByEnvironment(ctx context.Context, environmen
 // FindByGroup 根据分组查询配置列表
 func (r *ConfigRepositoryImpl) FindByGroup(ctx context.Context, namespaceID int, groupName string) ([]*domainEntity.Config, error) {
 	var pos []*infraEntity.ConfigPO
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
 	db = queryutil.WhereEq(db, r.fields.Get("GroupName").GetColumnName(), groupName)
 	db = queryutil.OrderBy(db, r.fields.Get("Key").GetColumnName())
@@ -185,10 +329,38 @@ func (r *ConfigRepositoryImpl) FindByGroup(ctx context.Context, namespaceID int,
 	return r.converter.ToDOList(pos), nil
 }
 
+// FindByValueContains 查询值中包含指定文本的配置
+func (r *ConfigRepositoryImpl) FindByValueContains(ctx context.Context, text string) ([]*domainEntity.Config, error) {
+	var pos []*infraEntity.ConfigPO
+	db := r.getDB(ctx).Where(r.fields.Get("Value").GetColumnName()+" LIKE ?", "%"+escapeLike(text)+"%")
+	db = queryutil.OrderBy(db, r.fields.Get("ID").GetColumnName())
+	if err := db.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return r.converter.ToDOList(pos), nil
+}
+
+// FindExpired 查询过期时间不晚于 before 的配置
+func (r *ConfigRepositoryImpl) FindExpired(ctx context.Context, before time.Time, onlyActive bool, limit int) ([]*domainEntity.Config, error) {
+	var pos []*infraEntity.ConfigPO
+	expiresAtColumn := r.fields.Get("ExpiresAt").GetColumnName()
+	db := r.getDB(ctx).Where(expiresAtColumn + " IS NOT NULL")
+	db = queryutil.WhereLte(db, expiresAtColumn, before)
+	if onlyActive {
+		db = queryutil.WhereEq(db, r.fields.Get("IsActive").GetColumnName(), true)
+	}
+	db = queryutil.OrderBy(db, expiresAtColumn)
+	db = queryutil.OrderBy(db, r.fields.Get("ID").GetColumnName())
+	if err := db.Limit(limit).Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return r.converter.ToDOList(pos), nil
+}
+
 // FindReleasedConfigs 查询已发布的配置列表
 func (r *ConfigRepositoryImpl) FindReleasedConfigs(ctx context.Context, namespaceID int, environment string) ([]*domainEntity.Config, error) {
 	var pos []*infraEntity.ConfigPO
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
 	db = queryutil.WhereEq(db, r.fields.Get("Environment").GetColumnName(), environment)
 	db = queryutil.WhereEq(db, r.fields.Get("IsReleased").GetColumnName(), true)
@@ -205,7 +377,7 @@ func (r *ConfigRepositoryImpl) FindReleasedConfigs(ctx context.Context, namespac
 
 // PageWithConditions 根据多条件分页查询配置
 func (r *ConfigRepositoryImpl) PageWithConditions(ctx context.Context, req *shareRepo.PageRequest, conditions ...*shareRepo.Condition) (*shareRepo.PageResult[*domainEntity.Config], error) {
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 
 	// 应用查询条件
 	if len(conditions) > 0 {
@@ -240,7 +412,7 @@ func (r *ConfigRepositoryImpl) PageWithConditions(ctx context.Context, req *shar
 // ExistsByNamespaceAndKey 判断指定命名空间和键的配置是否存在
 func (r *ConfigRepositoryImpl) ExistsByNamespaceAndKey(ctx context.Context, namespaceID int, key string, environment string) (bool, error) {
 	var count int64
-	db := r.db.WithContext(ctx).Model(&infraEntity.ConfigPO{})
+	db := r.getDB(ctx).Model(&infraEntity.ConfigPO{})
 	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
 	db = queryutil.WhereEq(db, r.fields.Get("Key").GetColumnName(), key)
 	db = queryutil.WhereEq(db, r.fields.Get("Environment").GetColumnName(), environment)
@@ -256,7 +428,7 @@ func (r *ConfigRepositoryImpl) ExistsByNamespaceAndKey(ctx context.Context, name
 // CountByNamespace 统计指定命名空间的配置数量
 func (r *ConfigRepositoryImpl) CountByNamespace(ctx context.Context, namespaceID int) (int64, error) {
 	var count int64
-	db := r.db.WithContext(ctx).Model(&infraEntity.ConfigPO{})
+	db := r.getDB(ctx).Model(&infraEntity.ConfigPO{})
 	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
 	err := db.Count(&count).Error
 
@@ -267,11 +439,53 @@ func (r *ConfigRepositoryImpl) CountByNamespace(ctx context.Context, namespaceID
 	return count, nil
 }
 
+// MaxValueSizeByNamespace 查询命名空间下最大的配置值大小
+func (r *ConfigRepositoryImpl) MaxValueSizeByNamespace(ctx context.Context, namespaceID int) (int64, error) {
+	var size int64
+	db := r.getDB(ctx).Model(&infraEntity.ConfigPO{}).
+		Select("COALESCE(MAX(OCTET_LENGTH(" + r.fields.Get("Value").GetColumnName() + ")), 0)")
+	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
+	if err := db.Scan(&size).Error; err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+// CountByGroup 按分组统计命名空间下的配置数量
+func (r *ConfigRepositoryImpl) CountByGroup(ctx context.Context, namespaceID int, environment string) ([]*repository.ConfigGroupStat, error) {
+	groupColumn := r.fields.Get("GroupName").GetColumnName()
+	db := r.getDB(ctx).Model(&infraEntity.ConfigPO{}).
+		Select(groupColumn + " AS group_name, COUNT(*) AS total" +
+			", COUNT(CASE WHEN is_released THEN 1 END) AS released" +
+			", COUNT(CASE WHEN is_active THEN 1 END) AS active" +
+			", MAX(updated_at) AS last_updated_at")
+	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
+	if environment != "" {
+		db = queryutil.WhereEq(db, r.fields.Get("Environment").GetColumnName(), environment)
+	}
+
+	var stats []*repository.ConfigGroupStat
+	if err := db.Group(groupColumn).Order(groupColumn).Scan(&stats).Error; err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
 // QueryByParams 根据查询参数分页查询配置
 // 封装了查询条件的构建逻辑和字段映射
 func (r *ConfigRepositoryImpl) QueryByParams(ctx context.Context, params *repository.ConfigQueryParams) (*shareRepo.PageResult[*domainEntity.Config], error) {
-	db := r.db.WithContext(ctx).Model(&infraEntity.ConfigPO{})
+	db := r.getDB(ctx).Model(&infraEntity.ConfigPO{})
+	return r.pageByParams(db, params, r.fields.Get("CreatedAt").GetColumnName())
+}
+
+// QueryDeletedByParams 分页查询已删除的配置（回收站）
+func (r *ConfigRepositoryImpl) QueryDeletedByParams(ctx context.Context, params *repository.ConfigQueryParams) (*shareRepo.PageResult[*domainEntity.Config], error) {
+	db := r.getDB(ctx).Unscoped().Model(&infraEntity.ConfigPO{}).Where("deleted_at IS NOT NULL")
+	return r.pageByParams(db, params, "deleted_at")
+}
 
+// pageByParams 按查询参数构建条件并分页查询，未指定排序时按 defaultOrderColumn 倒序（游标分页固定按创建时间倒序）
+func (r *ConfigRepositoryImpl) pageByParams(db *gorm.DB, params *repository.ConfigQueryParams, defaultOrderColumn string) (*shareRepo.PageResult[*domainEntity.Config], error) {
 	// 构建查询条件(字段映射在这里处理)
 	if params.NamespaceID != nil {
 		db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), *params.NamespaceID)
@@ -302,6 +516,32 @@ func (r *ConfigRepositoryImpl) QueryByParams(ctx context.Context, params *reposi
 		db = queryutil.WhereEq(db, r.fields.Get("ValueType").GetColumnName(), *params.ValueType)
 	}
 
+	if params.IDs != nil {
+		db = queryutil.WhereIn(db, r.fields.Get("ID").GetColumnName(), params.IDs)
+	}
+
+	expiresAtColumn := r.fields.Get("ExpiresAt").GetColumnName()
+	if params.ExpiresBefore != nil {
+		db = db.Where(expiresAtColumn + " IS NOT NULL")
+		db = queryutil.WhereLte(db, expiresAtColumn, *params.ExpiresBefore)
+	}
+
+	// 校验排序字段（只允许白名单内的字段）
+	orderBys, err := r.sortFields.ParseOrderBy(params.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// 游标分页：不统计总数，按 created_at DESC, id DESC 从游标位置之后查询
+	if params.Cursor != nil {
+		db = queryutil.ApplyCursor(db, r.fields.Get("CreatedAt").GetColumnName(), r.fields.Get("ID").GetColumnName(), *params.Cursor, params.Size)
+		var pos []*infraEntity.ConfigPO
+		if err := db.Find(&pos).Error; err != nil {
+			return nil, err
+		}
+		return shareRepo.NewCursorPageResult(r.converter.ToDOList(pos), params.Size, (*domainEntity.Config).PageCursor), nil
+	}
+
 	// 统计总数
 	var total int64
 	if err := db.Count(&total).Error; err != nil {
@@ -309,13 +549,12 @@ func (r *ConfigRepositoryImpl) QueryByParams(ctx context.Context, params *reposi
 	}
 
 	// 处理排序
-	if params.OrderBy != "" {
-		// 这里需要将排序字段名转换为数据库字段名
-		// 简化处理，直接使用传入的字段名
-		db = db.Order(params.OrderBy)
+	if len(orderBys) > 0 {
+		db = queryutil.ApplyOrderBys(db, orderBys)
+	} else if params.ExpiresBefore != nil {
+		db = queryutil.OrderBy(db, expiresAtColumn)
 	} else {
-		// 默认按创建时间倒序排列
-		db = queryutil.OrderByDesc(db, r.fields.Get("CreatedAt").GetColumnName())
+		db = queryutil.OrderByDesc(db, defaultOrderColumn)
 	}
 
 	// 应用分页
@@ -332,5 +571,105 @@ func (r *ConfigRepositoryImpl) QueryByParams(ctx context.Context, params *reposi
 	return shareRepo.NewPageResult(dos, total, params.Page, params.Size), nil
 }
 
+// searchRankSQL 搜索结果匹配度：键完全匹配 > 键前缀匹配 > 键包含 > 分组 > 描述 > 值
+const searchRankSQL = `CASE
+	WHEN LOWER(key) = LOWER(?) THEN 0
+	WHEN key ILIKE ? THEN 1
+	WHEN key ILIKE ? THEN 2
+	WHEN group_name ILIKE ? THEN 3
+	WHEN description ILIKE ? THEN 4
+	ELSE 5 END`
+
+// Search 按关键字搜索配置
+// 使用 ILIKE 子串匹配，t_configs 上的 pg_trgm 三元组索引可加速任意位置的模糊匹配
+func (r *ConfigRepositoryImpl) Search(ctx context.Context, params *repository.ConfigSearchParams) (*shareRepo.PageResult[*domainEntity.Config], error) {
+	db := r.getDB(ctx).Model(&infraEntity.ConfigPO{})
+	if params.NamespaceID != nil {
+		db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), *params.NamespaceID)
+	}
+	if params.Environment != nil && *params.Environment != "" {
+		db = queryutil.WhereEq(db, r.fields.Get("Environment").GetColumnName(), *params.Environment)
+	}
+
+	// 1. 构建字段匹配条件（各字段之间为 OR）
+	escaped := escapeLike(params.Query)
+	contains := "%" + escaped + "%"
+	fields := params.Fields
+	if len(fields) == 0 {
+		fields = []string{
+			repository.ConfigSearchFieldKey, repository.ConfigSearchFieldDescription,
+			repository.ConfigSearchFieldGroup, repository.ConfigSearchFieldValue,
+		}
+	}
+
+	var clauses []string
+	var args []interface{}
+	for _, field := range fields {
+		switch field {
+		case repository.ConfigSearchFieldKey:
+			clauses = append(clauses, "key ILIKE ?")
+			args = append(args, contains)
+		case repository.ConfigSearchFieldDescription:
+			clauses = append(clauses, "description ILIKE ?")
+			args = append(args, contains)
+		case repository.ConfigSearchFieldGroup:
+			clauses = append(clauses, "group_name ILIKE ?")
+			args = append(args, contains)
+		case repository.ConfigSearchFieldValue:
+			// 加密配置和敏感配置不按值匹配
+			valueClause := "(value ILIKE ? AND value_type <> 'encrypted'"
+			args = append(args, contains)
+			for _, keyword := range params.SensitiveKeywords {
+				valueClause += " AND key NOT ILIKE ?"
+				args = append(args, "%"+escapeLike(keyword)+"%")
+			}
+			clauses = append(clauses, valueClause+")")
+		}
+	}
+	if len(clauses) == 0 {
+		return shareRepo.NewPageResult([]*domainEntity.Config{}, 0, params.Page, params.Size), nil
+	}
+	db = db.Where("("+strings.Join(clauses, " OR ")+")", args...)
+
+	// 2. 统计总数
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	// 3. 按匹配度排序分页
+	db = db.Clauses(clause.OrderBy{
+		Expression: clause.Expr{
+			SQL:  searchRankSQL + ", key, id",
+			Vars: []interface{}{params.Query, escaped + "%", contains, contains, contains},
+		},
+	})
+	offset := (params.Page - 1) * params.Size
+	db = db.Offset(offset).Limit(params.Size)
+
+	var pos []*infraEntity.ConfigPO
+	if err := db.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+
+	dos := r.converter.ToDOList(pos)
+	return shareRepo.NewPageResult(dos, total, params.Page, params.Size), nil
+}
+
+// escapeLike 转义 LIKE 模式中的通配符
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// getDB 获取数据库连接（上下文中存在事务时使用事务）
+func (r *ConfigRepositoryImpl) getDB(ctx context.Context) *gorm.DB {
+	return gormRepo.GetDB(ctx, r.db)
+}
+
+// WithTx 在事务中执行操作
+func (r *ConfigRepositoryImpl) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return gormRepo.RunInTx(ctx, r.db, fn)
+}
+
 // 确保实现了接口
 var _ repository.ConfigRepository = (*ConfigRepositoryImpl)(nil)
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	domainEntity "config-client/config/domain/entity"
+	"config-client/config/domain/repository"
+	"config-client/config/infrastructure/converter"
+	infraEntity "config-client/config/infrastructure/entity"
+	"config-client/share/repository/queryutil"
+)
+
+// ConfigSchemaRepositoryImpl 配置 Schema 仓储实现
+type ConfigSchemaRepositoryImpl struct {
+	db        *gorm.DB
+	converter *converter.ConfigSchemaConverter
+	fields    *queryutil.EntityFields[infraEntity.ConfigSchemaPO] // Lambda 字段查询构建器
+}
+
+// NewConfigSchemaRepository 创建配置 Schema 仓储实例
+func NewConfigSchemaRepository(db *gorm.DB) repository.ConfigSchemaRepository {
+	return &ConfigSchemaRepositoryImpl{
+		db:        db,
+		converter: converter.NewConfigSchemaConverter(),
+		fields:    queryutil.Lambda[infraEntity.ConfigSchemaPO](), // 初始化 Lambda 构建器
+	}
+}
+
+// Create 创建 Schema 绑定
+func (r *ConfigSchemaRepositoryImpl) Create(ctx context.Context, schema *domainEntity.ConfigSchema) error {
+	po := r.converter.ToPO(schema)
+	if err := r.db.WithContext(ctx).Create(po).Error; err != nil {
+		return err
+	}
+
+	// 回写自增ID和审计字段
+	schema.ID = po.ID
+	schema.CreatedAt = po.CreatedAt
+	schema.UpdatedAt = po.UpdatedAt
+	return nil
+}
+
+// Update 更新 Schema 绑定
+func (r *ConfigSchemaRepositoryImpl) Update(ctx context.Context, schema *domainEntity.ConfigSchema) error {
+	po := r.converter.ToPO(schema)
+	return r.db.WithContext(ctx).Save(po).Error
+}
+
+// Delete 删除 Schema 绑定（软删除）
+func (r *ConfigSchemaRepositoryImpl) Delete(ctx context.Context, id int) error {
+	return r.db.WithContext(ctx).Delete(&infraEntity.ConfigSchemaPO{}, id).Error
+}
+
+// GetByID 根据ID查询 Schema 绑定
+func (r *ConfigSchemaRepositoryImpl) GetByID(ctx context.Context, id int) (*domainEntity.ConfigSchema, error) {
+	var po infraEntity.ConfigSchemaPO
+	err := r.db.WithContext(ctx).First(&po, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.converter.ToDO(&po), nil
+}
+
+// FindByNamespace 查询命名空间下的所有 Schema 绑定
+func (r *ConfigSchemaRepositoryImpl) FindByNamespace(ctx context.Context, namespaceID int) ([]*domainEntity.ConfigSchema, error) {
+	var pos []*infraEntity.ConfigSchemaPO
+	db := r.db.WithContext(ctx)
+	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
+	db = queryutil.OrderByDesc(db, r.fields.Get("CreatedAt").GetColumnName())
+	if err := db.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return r.converter.ToDOList(pos), nil
+}
+
+// FindByKey 查询绑定到指定配置键的 Schema
+func (r *ConfigSchemaRepositoryImpl) FindByKey(ctx context.Context, namespaceID int, configKey string) (*domainEntity.ConfigSchema, error) {
+	db := r.db.WithContext(ctx)
+	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
+	db = queryutil.WhereEq(db, r.fields.Get("ConfigKey").GetColumnName(), configKey)
+	return r.first(db)
+}
+
+// FindByGroup 查询绑定到指定配置分组的 Schema
+func (r *ConfigSchemaRepositoryImpl) FindByGroup(ctx context.Context, namespaceID int, groupName string) (*domainEntity.ConfigSchema, error) {
+	db := r.db.WithContext(ctx)
+	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
+	db = queryutil.WhereEq(db, r.fields.Get("ConfigKey").GetColumnName(), "")
+	db = queryutil.WhereEq(db, r.fields.Get("GroupName").GetColumnName(), groupName)
+	return r.first(db)
+}
+
+// first 查询第一条记录，不存在时返回 nil
+func (r *ConfigSchemaRepositoryImpl) first(db *gorm.DB) (*domainEntity.ConfigSchema, error) {
+	var po infraEntity.ConfigSchemaPO
+	if err := db.First(&po).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.converter.ToDO(&po), nil
+}
+
+// 确保实现了接口
+var _ repository.ConfigSchemaRepository = (*ConfigSchemaRepositoryImpl)(nil)
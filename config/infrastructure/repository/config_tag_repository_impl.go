@@ -7,6 +7,7 @@ import (
 	"config-client/config/domain/repository"
 	"config-client/config/infrastructure/converter"
 	infraEntity "config-client/config/infrastructure/entity"
+	gormRepo "config-client/share/repository/gorm"
 	"config-client/share/repository/queryutil"
 
 	"gorm.io/gorm"
@@ -32,7 +33,7 @@ func NewConfigTagRepository(db *gorm.DB) repository.ConfigTagRepository {
 // Create 创建标签
 func (r *configTagRepositoryImpl) Create(ctx context.Context, tag *entity.ConfigTag) error {
 	po := r.converter.ToPO(tag)
-	if err := r.db.WithContext(ctx).Create(po).Error; err != nil {
+	if err := r.getDB(ctx).Create(po).Error; err != nil {
 		return err
 	}
 
@@ -49,7 +50,7 @@ func (r *configTagRepositoryImpl) BatchCreate(ctx context.Context, tags []*entit
 	}
 
 	poList := r.converter.ToPOList(tags)
-	if err := r.db.WithContext(ctx).Create(&poList).Error; err != nil {
+	if err := r.getDB(ctx).Create(&poList).Error; err != nil {
 		return err
 	}
 
@@ -63,7 +64,7 @@ func (r *configTagRepositoryImpl) BatchCreate(ctx context.Context, tags []*entit
 
 // Delete 删除标签
 func (r *configTagRepositoryImpl) Delete(ctx context.Context, id int) error {
-	result := r.db.WithContext(ctx).Delete(&infraEntity.ConfigTagPO{}, id)
+	result := r.getDB(ctx).Delete(&infraEntity.ConfigTagPO{}, id)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -72,7 +73,7 @@ func (r *configTagRepositoryImpl) Delete(ctx context.Context, id int) error {
 
 // DeleteByConfigIDAndTagKey 根据配置ID和标签键删除标签
 func (r *configTagRepositoryImpl) DeleteByConfigIDAndTagKey(ctx context.Context, configID int, tagKey string) error {
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 	db = queryutil.WhereEq(db, r.fields.Get("ConfigID").GetColumnName(), configID)
 	db = queryutil.WhereEq(db, r.fields.Get("TagKey").GetColumnName(), tagKey)
 	result := db.Delete(&infraEntity.ConfigTagPO{})
@@ -85,7 +86,7 @@ func (r *configTagRepositoryImpl) DeleteByConfigIDAndTagKey(ctx context.Context,
 
 // DeleteByConfigID 删除某个配置的所有标签
 func (r *configTagRepositoryImpl) DeleteByConfigID(ctx context.Context, configID int) error {
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 	db = queryutil.WhereEq(db, r.fields.Get("ConfigID").GetColumnName(), configID)
 	result := db.Delete(&infraEntity.ConfigTagPO{})
 
@@ -98,7 +99,7 @@ func (r *configTagRepositoryImpl) DeleteByConfigID(ctx context.Context, configID
 // FindByConfigID 查询某个配置的所有标签
 func (r *configTagRepositoryImpl) FindByConfigID(ctx context.Context, configID int) ([]*entity.ConfigTag, error) {
 	var poList []*infraEntity.ConfigTagPO
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 	db = queryutil.WhereEq(db, r.fields.Get("ConfigID").GetColumnName(), configID)
 	db = queryutil.OrderBy(db, r.fields.Get("TagKey").GetColumnName())
 	db = queryutil.OrderBy(db, r.fields.Get("TagValue").GetColumnName())
@@ -114,7 +115,7 @@ func (r *configTagRepositoryImpl) FindByConfigID(ctx context.Context, configID i
 // FindByTagKey 根据标签键查询标签
 func (r *configTagRepositoryImpl) FindByTagKey(ctx context.Context, tagKey string) ([]*entity.ConfigTag, error) {
 	var poList []*infraEntity.ConfigTagPO
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 	db = queryutil.WhereEq(db, r.fields.Get("TagKey").GetColumnName(), tagKey)
 	err := db.Find(&poList).Error
 
@@ -128,7 +129,7 @@ func (r *configTagRepositoryImpl) FindByTagKey(ctx context.Context, tagKey strin
 // FindByTagKeyValue 根据标签键值查询标签
 func (r *configTagRepositoryImpl) FindByTagKeyValue(ctx context.Context, tagKey, tagValue string) ([]*entity.ConfigTag, error) {
 	var poList []*infraEntity.ConfigTagPO
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 	db = queryutil.WhereEq(db, r.fields.Get("TagKey").GetColumnName(), tagKey)
 	db = queryutil.WhereEq(db, r.fields.Get("TagValue").GetColumnName(), tagValue)
 	err := db.Find(&poList).Error
@@ -143,7 +144,7 @@ func (r *configTagRepositoryImpl) FindByTagKeyValue(ctx context.Context, tagKey,
 // ExistsByConfigIDAndTag 检查某个配置是否已存在指定标签
 func (r *configTagRepositoryImpl) ExistsByConfigIDAndTag(ctx context.Context, configID int, tagKey, tagValue string) (bool, error) {
 	var count int64
-	db := r.db.WithContext(ctx).Model(&infraEntity.ConfigTagPO{})
+	db := r.getDB(ctx).Model(&infraEntity.ConfigTagPO{})
 	db = queryutil.WhereEq(db, r.fields.Get("ConfigID").GetColumnName(), configID)
 	db = queryutil.WhereEq(db, r.fields.Get("TagKey").GetColumnName(), tagKey)
 	db = queryutil.WhereEq(db, r.fields.Get("TagValue").GetColumnName(), tagValue)
@@ -170,7 +171,7 @@ func (r *configTagRepositoryImpl) FindConfigIDsByTags(ctx context.Context, tags
 	var configIDs []int
 
 	// 第一个标签作为基础查询
-	db := r.db.WithContext(ctx).Model(&infraEntity.ConfigTagPO{}).Select("config_id")
+	db := r.getDB(ctx).Model(&infraEntity.ConfigTagPO{}).Select("config_id")
 	db = queryutil.WhereEq(db, r.fields.Get("TagKey").GetColumnName(), tags[0].TagKey)
 	db = queryutil.WhereEq(db, r.fields.Get("TagValue").GetColumnName(), tags[0].TagValue)
 
@@ -191,3 +192,8 @@ func (r *configTagRepositoryImpl) FindConfigIDsByTags(ctx context.Context, tags
 
 	return configIDs, nil
 }
+
+// getDB 获取数据库连接（上下文中存在事务时使用事务）
+func (r *configTagRepositoryImpl) getDB(ctx context.Context) *gorm.DB {
+	return gormRepo.GetDB(ctx, r.db)
+}
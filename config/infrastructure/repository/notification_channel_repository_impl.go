@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	domainEntity "config-client/config/domain/entity"
+	"config-client/config/domain/repository"
+	"config-client/config/infrastructure/converter"
+	infraEntity "config-client/config/infrastructure/entity"
+	gormRepo "config-client/share/repository/gorm"
+	"config-client/share/repository/queryutil"
+)
+
+// NotificationChannelRepositoryImpl 通知渠道仓储实现
+type NotificationChannelRepositoryImpl struct {
+	db        *gorm.DB
+	converter *converter.NotificationChannelConverter
+	fields    *queryutil.EntityFields[infraEntity.NotificationChannelPO] // Lambda 字段查询构建器
+}
+
+// NewNotificationChannelRepository 创建通知渠道仓储实例
+func NewNotificationChannelRepository(db *gorm.DB) repository.NotificationChannelRepository {
+	return &NotificationChannelRepositoryImpl{
+		db:        db,
+		converter: converter.NewNotificationChannelConverter(),
+		fields:    queryutil.Lambda[infraEntity.NotificationChannelPO](), // 初始化 Lambda 构建器
+	}
+}
+
+// Create 创建通知渠道
+func (r *NotificationChannelRepositoryImpl) Create(ctx context.Context, channel *domainEntity.NotificationChannel) error {
+	po := r.converter.ToPO(channel)
+	if err := r.getDB(ctx).Create(po).Error; err != nil {
+		return err
+	}
+	channel.ID = po.ID
+	channel.CreatedAt = po.CreatedAt
+	channel.UpdatedAt = po.UpdatedAt
+	return nil
+}
+
+// Update 更新通知渠道
+func (r *NotificationChannelRepositoryImpl) Update(ctx context.Context, channel *domainEntity.NotificationChannel) error {
+	po := r.converter.ToPO(channel)
+	if err := r.getDB(ctx).Save(po).Error; err != nil {
+		return err
+	}
+	channel.UpdatedAt = po.UpdatedAt
+	return nil
+}
+
+// Delete 删除通知渠道
+func (r *NotificationChannelRepositoryImpl) Delete(ctx context.Context, id int) error {
+	return r.getDB(ctx).Delete(&infraEntity.NotificationChannelPO{}, id).Error
+}
+
+// GetByID 根据ID查询通知渠道
+func (r *NotificationChannelRepositoryImpl) GetByID(ctx context.Context, id int) (*domainEntity.NotificationChannel, error) {
+	var po infraEntity.NotificationChannelPO
+	db := queryutil.WhereEq(r.getDB(ctx), r.fields.Get("ID").GetColumnName(), id)
+	if err := db.First(&po).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.converter.ToDO(&po), nil
+}
+
+// FindByNamespace 查询命名空间下的通知渠道
+func (r *NotificationChannelRepositoryImpl) FindByNamespace(ctx context.Context, namespaceID int, enabledOnly bool) ([]*domainEntity.NotificationChannel, error) {
+	var pos []*infraEntity.NotificationChannelPO
+	db := queryutil.WhereEq(r.getDB(ctx), r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
+	if enabledOnly {
+		db = queryutil.WhereEq(db, r.fields.Get("Enabled").GetColumnName(), true)
+	}
+	db = queryutil.OrderBy(db, r.fields.Get("ID").GetColumnName())
+	if err := db.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return r.converter.ToDOList(pos), nil
+}
+
+// getDB 获取数据库连接（上下文中存在事务时使用事务）
+func (r *NotificationChannelRepositoryImpl) getDB(ctx context.Context) *gorm.DB {
+	return gormRepo.GetDB(ctx, r.db)
+}
+
+// 确保实现了接口
+var _ repository.NotificationChannelRepository = (*NotificationChannelRepositoryImpl)(nil)
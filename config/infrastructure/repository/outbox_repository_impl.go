@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+
+	domainEntity "config-client/config/domain/entity"
+	"config-client/config/domain/repository"
+	"config-client/config/infrastructure/converter"
+	infraEntity "config-client/config/infrastructure/entity"
+	gormRepo "config-client/share/repository/gorm"
+)
+
+// claimPendingSQL 领取可投递事件并设置租约
+// FOR UPDATE SKIP LOCKED 保证多实例并发领取时互不阻塞、互不重复
+const claimPendingSQL = `
+UPDATE t_event_outbox SET status = ?, locked_until = ?
+WHERE id IN (
+	SELECT id FROM t_event_outbox
+	WHERE (status = ? AND next_attempt_at <= ?) OR (status = ? AND locked_until < ?)
+	ORDER BY id
+	LIMIT ?
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING *`
+
+// OutboxRepositoryImpl 事务性发件箱仓储实现
+type OutboxRepositoryImpl struct {
+	db        *gorm.DB
+	converter *converter.OutboxEventConverter
+}
+
+// NewOutboxRepository 创建事务性发件箱仓储实例
+func NewOutboxRepository(db *gorm.DB) repository.OutboxRepository {
+	return &OutboxRepositoryImpl{
+		db:        db,
+		converter: converter.NewOutboxEventConverter(),
+	}
+}
+
+// ==================== 写操作实现 ====================
+
+// Save 保存待投递事件
+func (r *OutboxRepositoryImpl) Save(ctx context.Context, events []*domainEntity.OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	pos := r.converter.ToPOList(events)
+	if err := r.getDB(ctx).Create(pos).Error; err != nil {
+		return err
+	}
+	for i, po := range pos {
+		events[i].ID = po.ID
+		events[i].CreatedAt = po.CreatedAt
+	}
+	return nil
+}
+
+// ClaimPending 领取一批可投递的事件并设置租约
+func (r *OutboxRepositoryImpl) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*domainEntity.OutboxEvent, error) {
+	now := time.Now()
+	var pos []*infraEntity.OutboxEventPO
+	err := r.getDB(ctx).Raw(claimPendingSQL,
+		domainEntity.OutboxStatusProcessing, now.Add(lease),
+		domainEntity.OutboxStatusPending, now,
+		domainEntity.OutboxStatusProcessing, now,
+		limit,
+	).Scan(&pos).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// UPDATE ... RETURNING 不保证返回顺序，按ID排序保证投递顺序与写入顺序一致
+	events := r.converter.ToDOList(pos)
+	sortOutboxEvents(events)
+	return events, nil
+}
+
+// MarkSent 标记事件已投递
+func (r *OutboxRepositoryImpl) MarkSent(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.getDB(ctx).
+		Model(&infraEntity.OutboxEventPO{}).
+		Where("id IN ?", ids).
+		Updates(map[string]interface{}{
+			"status":       domainEntity.OutboxStatusSent,
+			"locked_until": nil,
+			"sent_at":      time.Now(),
+		}).Error
+}
+
+// MarkFailed 标记事件投递失败
+func (r *OutboxRepositoryImpl) MarkFailed(ctx context.Context, ids []int64, lastError string, nextAttemptAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.getDB(ctx).
+		Model(&infraEntity.OutboxEventPO{}).
+		Where("id IN ?", ids).
+		Updates(map[string]interface{}{
+			"status":          domainEntity.OutboxStatusPending,
+			"attempts":        gorm.Expr("attempts + 1"),
+			"last_error":      lastError,
+			"next_attempt_at": nextAttemptAt,
+			"locked_until":    nil,
+		}).Error
+}
+
+// DeleteSentBefore 删除指定时间之前已投递的事件
+func (r *OutboxRepositoryImpl) DeleteSentBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.getDB(ctx).
+		Where("status = ? AND sent_at < ?", domainEntity.OutboxStatusSent, before).
+		Delete(&infraEntity.OutboxEventPO{})
+	return result.RowsAffected, result.Error
+}
+
+// ==================== 读操作实现 ====================
+
+// CountPending 统计未投递的事件数量
+func (r *OutboxRepositoryImpl) CountPending(ctx context.Context) (int64, error) {
+	var count int64
+	err := r.getDB(ctx).
+		Model(&infraEntity.OutboxEventPO{}).
+		Where("status <> ?", domainEntity.OutboxStatusSent).
+		Count(&count).Error
+	return count, err
+}
+
+// getDB 获取数据库连接（上下文中存在事务时使用事务）
+func (r *OutboxRepositoryImpl) getDB(ctx context.Context) *gorm.DB {
+	return gormRepo.GetDB(ctx, r.db)
+}
+
+// sortOutboxEvents 按ID升序排序
+func sortOutboxEvents(events []*domainEntity.OutboxEvent) {
+	sort.Slice(events, func(i, j int) bool { return events[i].ID < events[j].ID })
+}
+
+// 确保实现了接口
+var _ repository.OutboxRepository = (*OutboxRepositoryImpl)(nil)
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	domainEntity "config-client/config/domain/entity"
+	"config-client/config/domain/repository"
+	"config-client/config/infrastructure/converter"
+	infraEntity "config-client/config/infrastructure/entity"
+	shareRepo "config-client/share/repository"
+	gormRepo "config-client/share/repository/gorm"
+	"config-client/share/repository/queryutil"
+)
+
+// PushTraceRepositoryImpl 通知下发记录仓储实现
+type PushTraceRepositoryImpl struct {
+	db        *gorm.DB
+	converter *converter.PushTraceConverter
+	fields    *queryutil.EntityFields[infraEntity.PushTracePO] // Lambda 字段查询构建器
+}
+
+// NewPushTraceRepository 创建通知下发记录仓储实例
+func NewPushTraceRepository(db *gorm.DB) repository.PushTraceRepository {
+	return &PushTraceRepositoryImpl{
+		db:        db,
+		converter: converter.NewPushTraceConverter(),
+		fields:    queryutil.Lambda[infraEntity.PushTracePO](), // 初始化 Lambda 构建器
+	}
+}
+
+// BatchCreate 批量保存下发记录
+func (r *PushTraceRepositoryImpl) BatchCreate(ctx context.Context, traces []*domainEntity.PushTrace) error {
+	if len(traces) == 0 {
+		return nil
+	}
+
+	pos := r.converter.ToPOList(traces)
+	if err := r.getDB(ctx).Create(&pos).Error; err != nil {
+		return err
+	}
+
+	// 回写自增ID
+	for i, po := range pos {
+		traces[i].ID = po.ID
+	}
+	return nil
+}
+
+// FindUnackedBySubscriptionID 查询订阅下尚未确认的下发记录
+func (r *PushTraceRepositoryImpl) FindUnackedBySubscriptionID(ctx context.Context, subscriptionID int) ([]*domainEntity.PushTrace, error) {
+	var pos []*infraEntity.PushTracePO
+	db := queryutil.WhereEq(r.getDB(ctx), r.fields.Get("SubscriptionID").GetColumnName(), subscriptionID)
+	db = queryutil.WhereEq(db, r.fields.Get("Acked").GetColumnName(), false)
+	if err := db.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return r.converter.ToDOList(pos), nil
+}
+
+// MarkAcked 将指定下发记录标记为已确认
+func (r *PushTraceRepositoryImpl) MarkAcked(ctx context.Context, ids []int64, ackedAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	db := r.getDB(ctx).Model(&infraEntity.PushTracePO{})
+	db = queryutil.WhereIn(db, r.fields.Get("ID").GetColumnName(), ids)
+	return db.Updates(map[string]interface{}{
+		r.fields.Get("Acked").GetColumnName():   true,
+		r.fields.Get("AckedAt").GetColumnName(): ackedAt,
+	}).Error
+}
+
+// Query 根据查询参数分页查询下发记录
+func (r *PushTraceRepositoryImpl) Query(ctx context.Context, params *repository.PushTraceQueryParams) (*shareRepo.PageResult[*domainEntity.PushTrace], error) {
+	db := r.applyFilters(r.getDB(ctx), params)
+	if params.Acked != nil {
+		db = queryutil.WhereEq(db, r.fields.Get("Acked").GetColumnName(), *params.Acked)
+	}
+
+	// 统计总数
+	var total int64
+	if err := db.Model(&infraEntity.PushTracePO{}).Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	// 应用分页
+	page := params.Page
+	size := params.Size
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 20
+	}
+	db = queryutil.OrderByDesc(db, r.fields.Get("PushedAt").GetColumnName())
+	db = db.Offset((page - 1) * size).Limit(size)
+
+	var pos []*infraEntity.PushTracePO
+	if err := db.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return shareRepo.NewPageResult(r.converter.ToDOList(pos), total, page, size), nil
+}
+
+// Summarize 统计满足查询条件的记录总数和已确认数量
+func (r *PushTraceRepositoryImpl) Summarize(ctx context.Context, params *repository.PushTraceQueryParams) (int64, int64, error) {
+	var summary struct {
+		Total int64
+		Acked int64
+	}
+	ackedColumn := r.fields.Get("Acked").GetColumnName()
+	db := r.applyFilters(r.getDB(ctx).Model(&infraEntity.PushTracePO{}), params)
+	err := db.Select("COUNT(*) AS total, COALESCE(SUM(CASE WHEN " + ackedColumn + " THEN 1 ELSE 0 END), 0) AS acked").
+		Scan(&summary).Error
+	return summary.Total, summary.Acked, err
+}
+
+// DeleteBefore 删除指定时间之前下发的记录
+func (r *PushTraceRepositoryImpl) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	db := queryutil.WhereLt(r.getDB(ctx), r.fields.Get("PushedAt").GetColumnName(), before)
+	result := db.Delete(&infraEntity.PushTracePO{})
+	return result.RowsAffected, result.Error
+}
+
+// applyFilters 应用查询条件（不含 Acked 和分页）
+func (r *PushTraceRepositoryImpl) applyFilters(db *gorm.DB, params *repository.PushTraceQueryParams) *gorm.DB {
+	if params.ReleaseID != nil {
+		db = queryutil.WhereEq(db, r.fields.Get("ReleaseID").GetColumnName(), *params.ReleaseID)
+	}
+	if params.ClientID != nil && *params.ClientID != "" {
+		db = queryutil.WhereEq(db, r.fields.Get("ClientID").GetColumnName(), *params.ClientID)
+	}
+	if params.NamespaceID != nil {
+		db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), *params.NamespaceID)
+	}
+	if params.ConfigKey != nil && *params.ConfigKey != "" {
+		db = queryutil.WhereEq(db, r.fields.Get("ConfigKey").GetColumnName(), *params.ConfigKey)
+	}
+	if params.Environment != nil && *params.Environment != "" {
+		db = queryutil.WhereEq(db, r.fields.Get("Environment").GetColumnName(), *params.Environment)
+	}
+	return db
+}
+
+// getDB 获取数据库连接（上下文中存在事务时使用事务）
+func (r *PushTraceRepositoryImpl) getDB(ctx context.Context) *gorm.DB {
+	return gormRepo.GetDB(ctx, r.db)
+}
+
+// 确保实现了接口
+var _ repository.PushTraceRepository = (*PushTraceRepositoryImpl)(nil)
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	domainEntity "config-client/config/domain/entity"
+	"config-client/config/domain/repository"
+	"config-client/config/infrastructure/converter"
+	infraEntity "config-client/config/infrastructure/entity"
+	gormRepo "config-client/share/repository/gorm"
+	"config-client/share/repository/queryutil"
+)
+
+// ReleaseApprovalRepositoryImpl 发布审批记录仓储实现
+type ReleaseApprovalRepositoryImpl struct {
+	db        *gorm.DB
+	converter *converter.ReleaseApprovalConverter
+	fields    *queryutil.EntityFields[infraEntity.ReleaseApprovalPO] // Lambda 字段查询构建器
+}
+
+// NewReleaseApprovalRepository 创建发布审批记录仓储实例
+func NewReleaseApprovalRepository(db *gorm.DB) repository.ReleaseApprovalRepository {
+	return &ReleaseApprovalRepositoryImpl{
+		db:        db,
+		converter: converter.NewReleaseApprovalConverter(),
+		fields:    queryutil.Lambda[infraEntity.ReleaseApprovalPO](), // 初始化 Lambda 构建器
+	}
+}
+
+// Create 创建审批记录
+func (r *ReleaseApprovalRepositoryImpl) Create(ctx context.Context, approval *domainEntity.ReleaseApproval) error {
+	po := r.converter.ToPO(approval)
+	if err := r.getDB(ctx).Create(po).Error; err != nil {
+		return err
+	}
+	approval.ID = po.ID
+	approval.CreatedAt = po.CreatedAt
+	return nil
+}
+
+// FindByReleaseID 查询发布版本的全部审批记录
+func (r *ReleaseApprovalRepositoryImpl) FindByReleaseID(ctx context.Context, releaseID int) ([]*domainEntity.ReleaseApproval, error) {
+	var pos []*infraEntity.ReleaseApprovalPO
+	db := queryutil.WhereEq(r.getDB(ctx), r.fields.Get("ReleaseID").GetColumnName(), releaseID)
+	db = queryutil.OrderBy(db, r.fields.Get("ID").GetColumnName())
+	if err := db.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return r.converter.ToDOList(pos), nil
+}
+
+// getDB 获取数据库连接（上下文中存在事务时使用事务）
+func (r *ReleaseApprovalRepositoryImpl) getDB(ctx context.Context) *gorm.DB {
+	return gormRepo.GetDB(ctx, r.db)
+}
+
+// 确保实现了接口
+var _ repository.ReleaseApprovalRepository = (*ReleaseApprovalRepositoryImpl)(nil)
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	domainEntity "config-client/config/domain/entity"
+	"config-client/config/domain/repository"
+	"config-client/config/infrastructure/converter"
+	infraEntity "config-client/config/infrastructure/entity"
+	gormRepo "config-client/share/repository/gorm"
+	"config-client/share/repository/queryutil"
+)
+
+// ReleaseFreezeOverrideRepositoryImpl 冻结窗口覆盖审计记录仓储实现
+type ReleaseFreezeOverrideRepositoryImpl struct {
+	db        *gorm.DB
+	converter *converter.ReleaseFreezeOverrideConverter
+	fields    *queryutil.EntityFields[infraEntity.ReleaseFreezeOverridePO] // Lambda 字段查询构建器
+}
+
+// NewReleaseFreezeOverrideRepository 创建冻结窗口覆盖审计记录仓储实例
+func NewReleaseFreezeOverrideRepository(db *gorm.DB) repository.ReleaseFreezeOverrideRepository {
+	return &ReleaseFreezeOverrideRepositoryImpl{
+		db:        db,
+		converter: converter.NewReleaseFreezeOverrideConverter(),
+		fields:    queryutil.Lambda[infraEntity.ReleaseFreezeOverridePO](), // 初始化 Lambda 构建器
+	}
+}
+
+// Create 创建覆盖记录
+func (r *ReleaseFreezeOverrideRepositoryImpl) Create(ctx context.Context, override *domainEntity.ReleaseFreezeOverride) error {
+	po := r.converter.ToPO(override)
+	if err := r.getDB(ctx).Create(po).Error; err != nil {
+		return err
+	}
+	override.ID = po.ID
+	override.CreatedAt = po.CreatedAt
+	return nil
+}
+
+// FindRecent 查询命名空间和环境最近的覆盖记录
+func (r *ReleaseFreezeOverrideRepositoryImpl) FindRecent(ctx context.Context, namespaceID int, environment string, limit int) ([]*domainEntity.ReleaseFreezeOverride, error) {
+	var pos []*infraEntity.ReleaseFreezeOverridePO
+	db := queryutil.WhereEq(r.getDB(ctx), r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
+	if environment != "" {
+		db = queryutil.WhereEq(db, r.fields.Get("Environment").GetColumnName(), environment)
+	}
+	db = queryutil.OrderByDesc(db, r.fields.Get("ID").GetColumnName())
+	if err := db.Limit(limit).Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return r.converter.ToDOList(pos), nil
+}
+
+// getDB 获取数据库连接（上下文中存在事务时使用事务）
+func (r *ReleaseFreezeOverrideRepositoryImpl) getDB(ctx context.Context) *gorm.DB {
+	return gormRepo.GetDB(ctx, r.db)
+}
+
+// 确保实现了接口
+var _ repository.ReleaseFreezeOverrideRepository = (*ReleaseFreezeOverrideRepositoryImpl)(nil)
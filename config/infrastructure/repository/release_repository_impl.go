@@ -22,14 +22,19 @@ type ReleaseRepositoryImpl struct {
 	converter *converter.ReleaseConverter
 	fields    *queryutil.EntityFields[infraEntity.ReleasePO]
 	model     infraEntity.ReleasePO
+
+	sortFields shareRepo.SortFields // 分页查询允许的排序字段
 }
 
 // NewReleaseRepository 创建发布版本仓储实例
 func NewReleaseRepository(db *gorm.DB) repository.ReleaseRepository {
+	fields := queryutil.Lambda[infraEntity.ReleasePO]()
 	return &ReleaseRepositoryImpl{
 		db:        db,
 		converter: converter.NewReleaseConverter(),
-		fields:    queryutil.Lambda[infraEntity.ReleasePO](),
+		fields:    fields,
+		sortFields: fields.SortFields("ID", "NamespaceID", "Environment", "Version", "VersionName", "Status",
+			"ReleaseType", "ApprovalStatus", "ReleasedAt", "CreatedAt", "UpdatedAt"),
 	}
 }
 
@@ -38,7 +43,7 @@ func NewReleaseRepository(db *gorm.DB) repository.ReleaseRepository {
 // Create 创建发布版本
 func (r *ReleaseRepositoryImpl) Create(ctx context.Context, entity *domainEntity.Release) error {
 	po := r.converter.ToPO(entity)
-	if err := r.db.WithContext(ctx).Create(po).Error; err != nil {
+	if err := r.getDB(ctx).Create(po).Error; err != nil {
 		return err
 	}
 	entity.ID = po.ID
@@ -51,13 +56,13 @@ func (r *ReleaseRepositoryImpl) CreateBatch(ctx context.Context, entities []*dom
 		return nil
 	}
 	pos := r.converter.ToPOList(entities)
-	return r.db.WithContext(ctx).Create(pos).Error
+	return r.getDB(ctx).Create(pos).Error
 }
 
 // GetByID 根据ID查询发布版本
 func (r *ReleaseRepositoryImpl) GetByID(ctx context.Context, id int) (*domainEntity.Release, error) {
 	var po infraEntity.ReleasePO
-	err := r.db.WithContext(ctx).First(&po, id).Error
+	err := r.getDB(ctx).First(&po, id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -70,18 +75,18 @@ func (r *ReleaseRepositoryImpl) GetByID(ctx context.Context, id int) (*domainEnt
 // Update 更新发布版本
 func (r *ReleaseRepositoryImpl) Update(ctx context.Context, entity *domainEntity.Release) error {
 	po := r.converter.ToPO(entity)
-	return r.db.WithContext(ctx).Save(po).Error
+	return r.getDB(ctx).Save(po).Error
 }
 
 // Delete 删除发布版本（软删除）
 func (r *ReleaseRepositoryImpl) Delete(ctx context.Context, id int) error {
-	return r.db.WithContext(ctx).Delete(&infraEntity.ReleasePO{}, id).Error
+	return r.getDB(ctx).Delete(&infraEntity.ReleasePO{}, id).Error
 }
 
 // List 查询全部发布版本
 func (r *ReleaseRepositoryImpl) List(ctx context.Context) ([]*domainEntity.Release, error) {
 	var pos []*infraEntity.ReleasePO
-	err := r.db.WithContext(ctx).Find(&pos).Error
+	err := r.getDB(ctx).Find(&pos).Error
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +95,7 @@ func (r *ReleaseRepositoryImpl) List(ctx context.Context) ([]*domainEntity.Relea
 
 // Page 分页查询发布版本
 func (r *ReleaseRepositoryImpl) Page(ctx context.Context, request *shareRepo.PageRequest) (*shareRepo.PageResult[*domainEntity.Release], error) {
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 
 	// 统计总数
 	var total int64
@@ -120,7 +125,7 @@ func (r *ReleaseRepositoryImpl) Page(ctx context.Context, request *shareRepo.Pag
 // FindByNamespaceAndVersion 根据命名空间和版本号查询发布版本
 func (r *ReleaseRepositoryImpl) FindByNamespaceAndVersion(ctx context.Context, namespaceID int, version int, environment string) (*domainEntity.Release, error) {
 	var po infraEntity.ReleasePO
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
 	db = queryutil.WhereEq(db, r.fields.Get("Version").GetColumnName(), version)
 	db = queryutil.WhereEq(db, r.fields.Get("Environment").GetColumnName(), environment)
@@ -138,7 +143,7 @@ func (r *ReleaseRepositoryImpl) FindByNamespaceAndVersion(ctx context.Context, n
 // FindByVersionName 根据版本名称查询发布版本
 func (r *ReleaseRepositoryImpl) FindByVersionName(ctx context.Context, namespaceID int, versionName string, environment string) (*domainEntity.Release, error) {
 	var po infraEntity.ReleasePO
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
 	db = queryutil.WhereEq(db, r.fields.Get("VersionName").GetColumnName(), versionName)
 	db = queryutil.WhereEq(db, r.fields.Get("Environment").GetColumnName(), environment)
@@ -156,7 +161,7 @@ func (r *ReleaseRepositoryImpl) FindByVersionName(ctx context.Context, namespace
 // FindLatestPublishedRelease 查询最新的已发布版本
 func (r *ReleaseRepositoryImpl) FindLatestPublishedRelease(ctx context.Context, namespaceID int, environment string) (*domainEntity.Release, error) {
 	var po infraEntity.ReleasePO
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
 	db = queryutil.WhereEq(db, r.fields.Get("Environment").GetColumnName(), environment)
 	db = queryutil.WhereEq(db, r.fields.Get("Status").GetColumnName(), domainEntity.ReleaseStatusPublished)
@@ -175,7 +180,7 @@ func (r *ReleaseRepositoryImpl) FindLatestPublishedRelease(ctx context.Context,
 // FindByNamespace 查询指定命名空间的所有发布版本
 func (r *ReleaseRepositoryImpl) FindByNamespace(ctx context.Context, namespaceID int, environment string) ([]*domainEntity.Release, error) {
 	var pos []*infraEntity.ReleasePO
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
 	db = queryutil.WhereEq(db, r.fields.Get("Environment").GetColumnName(), environment)
 	db = queryutil.OrderByDesc(db, r.fields.Get("Version").GetColumnName())
@@ -190,7 +195,7 @@ func (r *ReleaseRepositoryImpl) FindByNamespace(ctx context.Context, namespaceID
 // FindByStatus 根据状态查询发布版本列表
 func (r *ReleaseRepositoryImpl) FindByStatus(ctx context.Context, namespaceID int, environment string, status domainEntity.ReleaseStatus) ([]*domainEntity.Release, error) {
 	var pos []*infraEntity.ReleasePO
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
 	db = queryutil.WhereEq(db, r.fields.Get("Environment").GetColumnName(), environment)
 	db = queryutil.WhereEq(db, r.fields.Get("Status").GetColumnName(), status)
@@ -205,7 +210,7 @@ func (r *ReleaseRepositoryImpl) FindByStatus(ctx context.Context, namespaceID in
 
 // QueryByParams 根据查询参数分页查询发布版本
 func (r *ReleaseRepositoryImpl) QueryByParams(ctx context.Context, params *repository.ReleaseQueryParams) (*shareRepo.PageResult[*domainEntity.Release], error) {
-	db := r.db.WithContext(ctx).Model(&infraEntity.ReleasePO{})
+	db := r.getDB(ctx).Model(&infraEntity.ReleasePO{})
 
 	// 构建查询条件
 	if params.NamespaceID != nil {
@@ -224,12 +229,6 @@ func (r *ReleaseRepositoryImpl) QueryByParams(ctx context.Context, params *repos
 		db = queryutil.WhereLike(db, r.fields.Get("VersionName").GetColumnName(), "%"+*params.VersionName+"%")
 	}
 
-	// 统计总数
-	var total int64
-	if err := db.Count(&total).Error; err != nil {
-		return nil, err
-	}
-
 	// 设置默认分页参数
 	if params.Page <= 0 {
 		params.Page = 1
@@ -238,9 +237,31 @@ func (r *ReleaseRepositoryImpl) QueryByParams(ctx context.Context, params *repos
 		params.Size = 20
 	}
 
+	// 校验排序字段（只允许白名单内的字段）
+	orderBys, err := r.sortFields.ParseOrderBy(params.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// 游标分页：不统计总数，按 created_at DESC, id DESC 从游标位置之后查询
+	if params.Cursor != nil {
+		db = queryutil.ApplyCursor(db, r.fields.Get("CreatedAt").GetColumnName(), r.fields.Get("ID").GetColumnName(), *params.Cursor, params.Size)
+		var pos []*infraEntity.ReleasePO
+		if err := db.Find(&pos).Error; err != nil {
+			return nil, err
+		}
+		return shareRepo.NewCursorPageResult(r.converter.ToDOList(pos), params.Size, (*domainEntity.Release).PageCursor), nil
+	}
+
+	// 统计总数
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
 	// 应用排序
-	if params.OrderBy != "" {
-		db = db.Order(params.OrderBy)
+	if len(orderBys) > 0 {
+		db = queryutil.ApplyOrderBys(db, orderBys)
 	} else {
 		db = queryutil.OrderByDesc(db, r.fields.Get("Version").GetColumnName())
 	}
@@ -262,7 +283,7 @@ func (r *ReleaseRepositoryImpl) QueryByParams(ctx context.Context, params *repos
 // GetNextVersion 获取下一个版本号
 func (r *ReleaseRepositoryImpl) GetNextVersion(ctx context.Context, namespaceID int, environment string) (int, error) {
 	var maxVersion int
-	db := r.db.WithContext(ctx).Model(&infraEntity.ReleasePO{})
+	db := r.getDB(ctx).Model(&infraEntity.ReleasePO{})
 	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
 	db = queryutil.WhereEq(db, r.fields.Get("Environment").GetColumnName(), environment)
 	err := db.Select("COALESCE(MAX(version), 0)").Scan(&maxVersion).Error
@@ -276,17 +297,31 @@ func (r *ReleaseRepositoryImpl) GetNextVersion(ctx context.Context, namespaceID
 // CountByNamespace 统计指定命名空间的发布版本数量
 func (r *ReleaseRepositoryImpl) CountByNamespace(ctx context.Context, namespaceID int, environment string) (int64, error) {
 	var count int64
-	db := r.db.WithContext(ctx).Model(&infraEntity.ReleasePO{})
+	db := r.getDB(ctx).Model(&infraEntity.ReleasePO{})
 	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
 	db = queryutil.WhereEq(db, r.fields.Get("Environment").GetColumnName(), environment)
 	err := db.Count(&count).Error
 	return count, err
 }
 
+// CountByEnvironment 按环境统计命名空间的发布版本数量
+func (r *ReleaseRepositoryImpl) CountByEnvironment(ctx context.Context, namespaceID int) ([]*repository.ReleaseEnvironmentStat, error) {
+	environmentColumn := r.fields.Get("Environment").GetColumnName()
+	db := r.getDB(ctx).Model(&infraEntity.ReleasePO{}).
+		Select(environmentColumn + " AS environment, COUNT(*) AS total")
+	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
+
+	var stats []*repository.ReleaseEnvironmentStat
+	if err := db.Group(environmentColumn).Order(environmentColumn).Scan(&stats).Error; err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
 // FindReleasesInTimeRange 查询指定时间范围内的发布版本
 func (r *ReleaseRepositoryImpl) FindReleasesInTimeRange(ctx context.Context, namespaceID int, environment string, startTime, endTime time.Time) ([]*domainEntity.Release, error) {
 	var pos []*infraEntity.ReleasePO
-	db := r.db.WithContext(ctx)
+	db := r.getDB(ctx)
 	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
 	db = queryutil.WhereEq(db, r.fields.Get("Environment").GetColumnName(), environment)
 	db = queryutil.WhereBetween(db, r.fields.Get("ReleasedAt").GetColumnName(), startTime, endTime)
@@ -302,10 +337,15 @@ func (r *ReleaseRepositoryImpl) FindReleasesInTimeRange(ctx context.Context, nam
 // ExistsByVersion 判断指定版本是否存在
 func (r *ReleaseRepositoryImpl) ExistsByVersion(ctx context.Context, namespaceID int, version int, environment string) (bool, error) {
 	var count int64
-	db := r.db.WithContext(ctx).Model(&infraEntity.ReleasePO{})
+	db := r.getDB(ctx).Model(&infraEntity.ReleasePO{})
 	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
 	db = queryutil.WhereEq(db, r.fields.Get("Version").GetColumnName(), version)
 	db = queryutil.WhereEq(db, r.fields.Get("Environment").GetColumnName(), environment)
 	err := db.Count(&count).Error
 	return count > 0, err
 }
+
+// getDB 获取数据库连接（上下文中存在事务时使用事务）
+func (r *ReleaseRepositoryImpl) getDB(ctx context.Context) *gorm.DB {
+	return gormRepo.GetDB(ctx, r.db)
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	domainEntity "config-client/config/domain/entity"
+	"config-client/config/domain/repository"
+	"config-client/config/infrastructure/converter"
+	infraEntity "config-client/config/infrastructure/entity"
+	gormRepo "config-client/share/repository/gorm"
+	"config-client/share/repository/queryutil"
+)
+
+// SubscriptionKeyRepositoryImpl 订阅配置键仓储实现
+type SubscriptionKeyRepositoryImpl struct {
+	db        *gorm.DB
+	converter *converter.SubscriptionKeyConverter
+	fields    *queryutil.EntityFields[infraEntity.SubscriptionKeyPO] // Lambda 字段查询构建器
+}
+
+// NewSubscriptionKeyRepository 创建订阅配置键仓储实例
+func NewSubscriptionKeyRepository(db *gorm.DB) repository.SubscriptionKeyRepository {
+	return &SubscriptionKeyRepositoryImpl{
+		db:        db,
+		converter: converter.NewSubscriptionKeyConverter(),
+		fields:    queryutil.Lambda[infraEntity.SubscriptionKeyPO](), // 初始化 Lambda 构建器
+	}
+}
+
+// ReplaceBySubscriptionID 全量替换订阅监听的配置键及上报版本
+func (r *SubscriptionKeyRepositoryImpl) ReplaceBySubscriptionID(ctx context.Context, subscriptionID int, keys []*domainEntity.SubscriptionKey) error {
+	return gormRepo.RunInTx(ctx, r.db, func(txCtx context.Context) error {
+		db := queryutil.WhereEq(r.getDB(txCtx), r.fields.Get("SubscriptionID").GetColumnName(), subscriptionID)
+		if err := db.Delete(&infraEntity.SubscriptionKeyPO{}).Error; err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+
+		pos := r.converter.ToPOList(keys)
+		return r.getDB(txCtx).Create(&pos).Error
+	})
+}
+
+// FindByConfigKey 查询监听指定配置键的全部上报记录
+func (r *SubscriptionKeyRepositoryImpl) FindByConfigKey(ctx context.Context, namespaceID int, configKey string) ([]*domainEntity.SubscriptionKey, error) {
+	var pos []*infraEntity.SubscriptionKeyPO
+	db := queryutil.WhereEq(r.getDB(ctx), r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
+	db = queryutil.WhereEq(db, r.fields.Get("ConfigKey").GetColumnName(), configKey)
+	db = queryutil.OrderBy(db, r.fields.Get("SubscriptionID").GetColumnName())
+	if err := db.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return r.converter.ToDOList(pos), nil
+}
+
+// getDB 获取数据库连接（上下文中存在事务时使用事务）
+func (r *SubscriptionKeyRepositoryImpl) getDB(ctx context.Context) *gorm.DB {
+	return gormRepo.GetDB(ctx, r.db)
+}
+
+// 确保实现了接口
+var _ repository.SubscriptionKeyRepository = (*SubscriptionKeyRepositoryImpl)(nil)
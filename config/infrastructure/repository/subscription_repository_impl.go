@@ -20,14 +20,19 @@ type SubscriptionRepositoryImpl struct {
 	converter *converter.SubscriptionConverter
 	fields    *queryutil.EntityFields[infraEntity.SubscriptionPO] // Lambda 字段查询构建器
 	model     infraEntity.SubscriptionPO                          // 用于类型安全的字段引用
+
+	sortFields shareRepo.SortFields // 分页查询允许的排序字段
 }
 
 // NewSubscriptionRepository 创建订阅仓储实例
 func NewSubscriptionRepository(db *gorm.DB) repository.SubscriptionRepository {
+	fields := queryutil.Lambda[infraEntity.SubscriptionPO]() // 初始化 Lambda 构建器
 	return &SubscriptionRepositoryImpl{
 		db:        db,
 		converter: converter.NewSubscriptionConverter(),
-		fields:    queryutil.Lambda[infraEntity.SubscriptionPO](), // 初始化 Lambda 构建器
+		fields:    fields,
+		sortFields: fields.SortFields("ID", "NamespaceID", "ClientID", "ClientIP", "ClientHostname", "Environment",
+			"IsActive", "LastHeartbeatAt", "HeartbeatCount", "PollCount", "ChangeCount", "SubscribedAt", "CreatedAt", "UpdatedAt"),
 	}
 }
 
@@ -103,6 +108,40 @@ func (r *SubscriptionRepositoryImpl) FindActiveSubscriptions(
 	return r.converter.ToEntityList(pos), nil
 }
 
+// FindByClientID 查询客户端的全部订阅
+func (r *SubscriptionRepositoryImpl) FindByClientID(ctx context.Context, clientID string) ([]*entity.Subscription, error) {
+	var pos []*infraEntity.SubscriptionPO
+	db := r.db.WithContext(ctx)
+	db = queryutil.WhereEq(db, r.fields.Get("ClientID").GetColumnName(), clientID)
+	db = queryutil.OrderBy(db, r.fields.Get("ID").GetColumnName())
+	err := db.Find(&pos).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.converter.ToEntityList(pos), nil
+}
+
+// FindByIDs 根据ID批量查询订阅
+func (r *SubscriptionRepositoryImpl) FindByIDs(ctx context.Context, ids []int) ([]*entity.Subscription, error) {
+	if len(ids) == 0 {
+		return []*entity.Subscription{}, nil
+	}
+
+	var pos []*infraEntity.SubscriptionPO
+	db := r.db.WithContext(ctx)
+	db = queryutil.WhereIn(db, r.fields.Get("ID").GetColumnName(), ids)
+	db = queryutil.OrderBy(db, r.fields.Get("ID").GetColumnName())
+	err := db.Find(&pos).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.converter.ToEntityList(pos), nil
+}
+
 // FindAllActiveSubscriptions 查询所有活跃订阅
 func (r *SubscriptionRepositoryImpl) FindAllActiveSubscriptions(ctx context.Context) ([]*entity.Subscription, error) {
 	var pos []*infraEntity.SubscriptionPO
@@ -121,6 +160,12 @@ func (r *SubscriptionRepositoryImpl) FindAllActiveSubscriptions(ctx context.Cont
 func (r *SubscriptionRepositoryImpl) Query(ctx context.Context, params *repository.SubscriptionQueryParams) (*shareRepo.PageResult[*entity.Subscription], error) {
 	db := r.db.WithContext(ctx)
 
+	// 校验排序字段（只允许白名单内的字段）
+	orderBys, err := r.sortFields.ParseOrderBy(params.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
 	// 应用查询条件
 	if params.NamespaceID != nil {
 		db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), *params.NamespaceID)
@@ -142,8 +187,8 @@ func (r *SubscriptionRepositoryImpl) Query(ctx context.Context, params *reposito
 	}
 
 	// 应用排序
-	if params.OrderBy != "" {
-		db = db.Order(params.OrderBy)
+	if len(orderBys) > 0 {
+		db = queryutil.ApplyOrderBys(db, orderBys)
 	} else {
 		db = queryutil.OrderByDesc(db, r.fields.Get("SubscribedAt").GetColumnName())
 	}
@@ -191,6 +236,18 @@ func (r *SubscriptionRepositoryImpl) CountByActive(ctx context.Context, isActive
 	return count, nil
 }
 
+// CountActiveByNamespace 统计命名空间下的活跃订阅数量
+func (r *SubscriptionRepositoryImpl) CountActiveByNamespace(ctx context.Context, namespaceID int) (int64, error) {
+	var count int64
+	db := r.db.WithContext(ctx).Model(&infraEntity.SubscriptionPO{})
+	db = queryutil.WhereEq(db, r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
+	db = queryutil.WhereEq(db, r.fields.Get("IsActive").GetColumnName(), true)
+	if err := db.Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 // CountExpired 统计过期订阅数量（仅统计激活状态）
 func (r *SubscriptionRepositoryImpl) CountExpired(ctx context.Context, expireTime time.Time) (int64, error) {
 	var count int64
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	domainEntity "config-client/config/domain/entity"
+	"config-client/config/domain/repository"
+	"config-client/config/infrastructure/converter"
+	infraEntity "config-client/config/infrastructure/entity"
+	gormRepo "config-client/share/repository/gorm"
+	"config-client/share/repository/queryutil"
+)
+
+// TenantRepositoryImpl 租户仓储实现
+type TenantRepositoryImpl struct {
+	db        *gorm.DB
+	converter *converter.TenantConverter
+	fields    *queryutil.EntityFields[infraEntity.TenantPO] // Lambda 字段查询构建器
+}
+
+// NewTenantRepository 创建租户仓储实例
+func NewTenantRepository(db *gorm.DB) repository.TenantRepository {
+	return &TenantRepositoryImpl{
+		db:        db,
+		converter: converter.NewTenantConverter(),
+		fields:    queryutil.Lambda[infraEntity.TenantPO](), // 初始化 Lambda 构建器
+	}
+}
+
+// Create 创建租户
+func (r *TenantRepositoryImpl) Create(ctx context.Context, tenant *domainEntity.Tenant) error {
+	po := r.converter.ToPO(tenant)
+	if err := r.getDB(ctx).Create(po).Error; err != nil {
+		return err
+	}
+	tenant.ID = po.ID
+	tenant.CreatedAt = po.CreatedAt
+	tenant.UpdatedAt = po.UpdatedAt
+	return nil
+}
+
+// Update 更新租户名称、描述和状态
+func (r *TenantRepositoryImpl) Update(ctx context.Context, tenant *domainEntity.Tenant) error {
+	db := queryutil.WhereEq(r.getDB(ctx).Model(&infraEntity.TenantPO{}), r.fields.Get("ID").GetColumnName(), tenant.ID)
+	return db.Updates(map[string]interface{}{
+		r.fields.Get("Name").GetColumnName():        tenant.Name,
+		r.fields.Get("Description").GetColumnName(): tenant.Description,
+		r.fields.Get("IsActive").GetColumnName():    tenant.IsActive,
+		r.fields.Get("UpdatedBy").GetColumnName():   tenant.UpdatedBy,
+	}).Error
+}
+
+// GetByID 根据ID查询租户
+func (r *TenantRepositoryImpl) GetByID(ctx context.Context, id int) (*domainEntity.Tenant, error) {
+	return r.first(queryutil.WhereEq(r.getDB(ctx), r.fields.Get("ID").GetColumnName(), id))
+}
+
+// GetByCode 根据编码查询租户
+func (r *TenantRepositoryImpl) GetByCode(ctx context.Context, code string) (*domainEntity.Tenant, error) {
+	return r.first(queryutil.WhereEq(r.getDB(ctx), r.fields.Get("Code").GetColumnName(), code))
+}
+
+// FindAll 查询全部租户
+func (r *TenantRepositoryImpl) FindAll(ctx context.Context) ([]*domainEntity.Tenant, error) {
+	var pos []*infraEntity.TenantPO
+	db := queryutil.OrderBy(r.getDB(ctx), r.fields.Get("ID").GetColumnName())
+	if err := db.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return r.converter.ToDOList(pos), nil
+}
+
+// first 查询单条记录（不存在时返回 nil）
+func (r *TenantRepositoryImpl) first(db *gorm.DB) (*domainEntity.Tenant, error) {
+	var po infraEntity.TenantPO
+	if err := db.First(&po).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.converter.ToDO(&po), nil
+}
+
+// getDB 获取数据库连接（上下文中存在事务时使用事务）
+func (r *TenantRepositoryImpl) getDB(ctx context.Context) *gorm.DB {
+	return gormRepo.GetDB(ctx, r.db)
+}
+
+// 确保实现了接口
+var _ repository.TenantRepository = (*TenantRepositoryImpl)(nil)
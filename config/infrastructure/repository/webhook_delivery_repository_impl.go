@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+
+	domainEntity "config-client/config/domain/entity"
+	"config-client/config/domain/repository"
+	"config-client/config/infrastructure/converter"
+	infraEntity "config-client/config/infrastructure/entity"
+	shareRepo "config-client/share/repository"
+	gormRepo "config-client/share/repository/gorm"
+	"config-client/share/repository/queryutil"
+	"config-client/share/tenant"
+)
+
+// claimWebhookDeliveriesSQL 领取可投递记录、设置租约并累加尝试次数
+// FOR UPDATE SKIP LOCKED 保证多实例并发领取时互不阻塞、互不重复
+// 原生 SQL 不经过租户回调，租户ID为 0（后台任务）时不按租户过滤
+const claimWebhookDeliveriesSQL = `
+UPDATE t_webhook_deliveries SET status = ?, locked_until = ?, attempts = attempts + 1
+WHERE id IN (
+	SELECT id FROM t_webhook_deliveries
+	WHERE ((status = ? AND next_attempt_at <= ?) OR (status = ? AND locked_until < ?))
+	AND (? = 0 OR namespace_id IN (SELECT id FROM t_namespaces WHERE tenant_id = ?))
+	ORDER BY id
+	LIMIT ?
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING *`
+
+// WebhookDeliveryRepositoryImpl Webhook 投递记录仓储实现
+type WebhookDeliveryRepositoryImpl struct {
+	db        *gorm.DB
+	converter *converter.WebhookDeliveryConverter
+	fields    *queryutil.EntityFields[infraEntity.WebhookDeliveryPO] // Lambda 字段查询构建器
+}
+
+// NewWebhookDeliveryRepository 创建 Webhook 投递记录仓储实例
+func NewWebhookDeliveryRepository(db *gorm.DB) repository.WebhookDeliveryRepository {
+	return &WebhookDeliveryRepositoryImpl{
+		db:        db,
+		converter: converter.NewWebhookDeliveryConverter(),
+		fields:    queryutil.Lambda[infraEntity.WebhookDeliveryPO](), // 初始化 Lambda 构建器
+	}
+}
+
+// ==================== 写操作实现 ====================
+
+// Save 保存待投递记录
+func (r *WebhookDeliveryRepositoryImpl) Save(ctx context.Context, deliveries []*domainEntity.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	pos := r.converter.ToPOList(deliveries)
+	if err := r.getDB(ctx).Create(pos).Error; err != nil {
+		return err
+	}
+	for i, po := range pos {
+		deliveries[i].ID = po.ID
+		deliveries[i].CreatedAt = po.CreatedAt
+	}
+	return nil
+}
+
+// ClaimPending 领取一批可投递的记录并设置租约
+func (r *WebhookDeliveryRepositoryImpl) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*domainEntity.WebhookDelivery, error) {
+	now := time.Now()
+	tenantID, _ := tenant.FromContext(ctx)
+	var pos []*infraEntity.WebhookDeliveryPO
+	err := r.getDB(ctx).Raw(claimWebhookDeliveriesSQL,
+		domainEntity.WebhookDeliveryProcessing, now.Add(lease),
+		domainEntity.WebhookDeliveryPending, now,
+		domainEntity.WebhookDeliveryProcessing, now,
+		tenantID, tenantID,
+		limit,
+	).Scan(&pos).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// UPDATE ... RETURNING 不保证返回顺序，按ID排序
+	deliveries := r.converter.ToDOList(pos)
+	sort.Slice(deliveries, func(i, j int) bool { return deliveries[i].ID < deliveries[j].ID })
+	return deliveries, nil
+}
+
+// SaveResult 保存一次投递尝试的结果
+func (r *WebhookDeliveryRepositoryImpl) SaveResult(ctx context.Context, delivery *domainEntity.WebhookDelivery) error {
+	db := r.getDB(ctx).Model(&infraEntity.WebhookDeliveryPO{})
+	db = queryutil.WhereEq(db, r.fields.Get("ID").GetColumnName(), delivery.ID)
+	return db.Updates(map[string]interface{}{
+		r.fields.Get("Status").GetColumnName():         delivery.Status,
+		r.fields.Get("ResponseStatus").GetColumnName(): delivery.ResponseStatus,
+		r.fields.Get("ResponseBody").GetColumnName():   delivery.ResponseBody,
+		r.fields.Get("LastError").GetColumnName():      delivery.LastError,
+		r.fields.Get("DurationMs").GetColumnName():     delivery.DurationMs,
+		r.fields.Get("NextAttemptAt").GetColumnName():  delivery.NextAttemptAt,
+		r.fields.Get("LockedUntil").GetColumnName():    delivery.LockedUntil,
+		r.fields.Get("DeliveredAt").GetColumnName():    delivery.DeliveredAt,
+	}).Error
+}
+
+// DeleteFinishedBefore 删除指定时间之前创建且已结束投递的记录
+func (r *WebhookDeliveryRepositoryImpl) DeleteFinishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	db := queryutil.WhereIn(r.getDB(ctx), r.fields.Get("Status").GetColumnName(),
+		[]string{domainEntity.WebhookDeliverySucceeded, domainEntity.WebhookDeliveryFailed})
+	db = queryutil.WhereLt(db, r.fields.Get("CreatedAt").GetColumnName(), before)
+	result := db.Delete(&infraEntity.WebhookDeliveryPO{})
+	return result.RowsAffected, result.Error
+}
+
+// ==================== 读操作实现 ====================
+
+// GetByID 根据ID查询投递记录
+func (r *WebhookDeliveryRepositoryImpl) GetByID(ctx context.Context, id int64) (*domainEntity.WebhookDelivery, error) {
+	var po infraEntity.WebhookDeliveryPO
+	db := queryutil.WhereEq(r.getDB(ctx), r.fields.Get("ID").GetColumnName(), id)
+	if err := db.First(&po).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.converter.ToDO(&po), nil
+}
+
+// Query 分页查询投递记录
+func (r *WebhookDeliveryRepositoryImpl) Query(ctx context.Context, params *repository.WebhookDeliveryQueryParams) (*shareRepo.PageResult[*domainEntity.WebhookDelivery], error) {
+	db := queryutil.WhereEq(r.getDB(ctx), r.fields.Get("WebhookID").GetColumnName(), params.WebhookID)
+	if params.Status != nil && *params.Status != "" {
+		db = queryutil.WhereEq(db, r.fields.Get("Status").GetColumnName(), *params.Status)
+	}
+	if params.EventType != nil && *params.EventType != "" {
+		db = queryutil.WhereEq(db, r.fields.Get("EventType").GetColumnName(), *params.EventType)
+	}
+
+	// 统计总数
+	var total int64
+	if err := db.Model(&infraEntity.WebhookDeliveryPO{}).Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	// 应用分页
+	page := params.Page
+	size := params.Size
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 20
+	}
+	db = queryutil.OrderByDesc(db, r.fields.Get("ID").GetColumnName())
+	db = db.Offset((page - 1) * size).Limit(size)
+
+	var pos []*infraEntity.WebhookDeliveryPO
+	if err := db.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return shareRepo.NewPageResult(r.converter.ToDOList(pos), total, page, size), nil
+}
+
+// getDB 获取数据库连接（上下文中存在事务时使用事务）
+func (r *WebhookDeliveryRepositoryImpl) getDB(ctx context.Context) *gorm.DB {
+	return gormRepo.GetDB(ctx, r.db)
+}
+
+// 确保实现了接口
+var _ repository.WebhookDeliveryRepository = (*WebhookDeliveryRepositoryImpl)(nil)
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	domainEntity "config-client/config/domain/entity"
+	"config-client/config/domain/repository"
+	"config-client/config/infrastructure/converter"
+	infraEntity "config-client/config/infrastructure/entity"
+	gormRepo "config-client/share/repository/gorm"
+	"config-client/share/repository/queryutil"
+)
+
+// WebhookRepositoryImpl Webhook 仓储实现
+type WebhookRepositoryImpl struct {
+	db        *gorm.DB
+	converter *converter.WebhookConverter
+	fields    *queryutil.EntityFields[infraEntity.WebhookPO] // Lambda 字段查询构建器
+}
+
+// NewWebhookRepository 创建 Webhook 仓储实例
+func NewWebhookRepository(db *gorm.DB) repository.WebhookRepository {
+	return &WebhookRepositoryImpl{
+		db:        db,
+		converter: converter.NewWebhookConverter(),
+		fields:    queryutil.Lambda[infraEntity.WebhookPO](), // 初始化 Lambda 构建器
+	}
+}
+
+// Create 创建 Webhook
+func (r *WebhookRepositoryImpl) Create(ctx context.Context, webhook *domainEntity.Webhook) error {
+	po := r.converter.ToPO(webhook)
+	if err := r.getDB(ctx).Create(po).Error; err != nil {
+		return err
+	}
+	webhook.ID = po.ID
+	webhook.CreatedAt = po.CreatedAt
+	webhook.UpdatedAt = po.UpdatedAt
+	return nil
+}
+
+// Update 更新 Webhook
+func (r *WebhookRepositoryImpl) Update(ctx context.Context, webhook *domainEntity.Webhook) error {
+	po := r.converter.ToPO(webhook)
+	if err := r.getDB(ctx).Save(po).Error; err != nil {
+		return err
+	}
+	webhook.UpdatedAt = po.UpdatedAt
+	return nil
+}
+
+// Delete 删除 Webhook
+func (r *WebhookRepositoryImpl) Delete(ctx context.Context, id int) error {
+	return r.getDB(ctx).Delete(&infraEntity.WebhookPO{}, id).Error
+}
+
+// GetByID 根据ID查询 Webhook
+func (r *WebhookRepositoryImpl) GetByID(ctx context.Context, id int) (*domainEntity.Webhook, error) {
+	var po infraEntity.WebhookPO
+	db := queryutil.WhereEq(r.getDB(ctx), r.fields.Get("ID").GetColumnName(), id)
+	if err := db.First(&po).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.converter.ToDO(&po), nil
+}
+
+// FindByNamespace 查询命名空间下的 Webhook
+func (r *WebhookRepositoryImpl) FindByNamespace(ctx context.Context, namespaceID int, enabledOnly bool) ([]*domainEntity.Webhook, error) {
+	var pos []*infraEntity.WebhookPO
+	db := queryutil.WhereEq(r.getDB(ctx), r.fields.Get("NamespaceID").GetColumnName(), namespaceID)
+	if enabledOnly {
+		db = queryutil.WhereEq(db, r.fields.Get("Enabled").GetColumnName(), true)
+	}
+	db = queryutil.OrderBy(db, r.fields.Get("ID").GetColumnName())
+	if err := db.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	return r.converter.ToDOList(pos), nil
+}
+
+// getDB 获取数据库连接（上下文中存在事务时使用事务）
+func (r *WebhookRepositoryImpl) getDB(ctx context.Context) *gorm.DB {
+	return gormRepo.GetDB(ctx, r.db)
+}
+
+// 确保实现了接口
+var _ repository.WebhookRepository = (*WebhookRepositoryImpl)(nil)
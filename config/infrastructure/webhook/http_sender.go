@@ -0,0 +1,88 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	domainService "config-client/config/domain/service"
+)
+
+// Webhook 请求头
+const (
+	HeaderEvent     = "X-Webhook-Event"     // 事件类型
+	HeaderDelivery  = "X-Webhook-Delivery"  // 投递记录ID（重试时不变，接收方可据此去重）
+	HeaderTimestamp = "X-Webhook-Timestamp" // 签名时间戳（Unix 秒）
+	HeaderSignature = "X-Webhook-Signature" // 签名: sha256=<hex(HMAC-SHA256(secret, timestamp + "." + body))>
+
+	userAgent = "config-center-webhook/1.0"
+
+	// maxResponseBodySize 读取的响应内容上限，超出部分丢弃
+	maxResponseBodySize = 64 << 10
+)
+
+// HTTPSender 基于 HTTP POST 的 Webhook 请求发送器
+// 请求体为 JSON 事件，签名覆盖时间戳和请求体，接收方校验签名并拒绝时间戳过旧的请求以防重放
+type HTTPSender struct {
+	client *http.Client
+}
+
+// NewHTTPSender 创建 Webhook 请求发送器（超时由调用方通过 context 控制）
+func NewHTTPSender() *HTTPSender {
+	return &HTTPSender{
+		client: &http.Client{
+			// 不跟随重定向，避免签名请求被转发到非预期地址
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Send 发送一次投递请求
+func (s *HTTPSender) Send(ctx context.Context, req *domainService.WebhookRequest) (*domainService.WebhookResponse, error) {
+	// 1. 构建请求并签名
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Payload))
+	if err != nil {
+		return nil, err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", userAgent)
+	httpReq.Header.Set(HeaderEvent, req.EventType)
+	httpReq.Header.Set(HeaderDelivery, strconv.FormatInt(req.DeliveryID, 10))
+	httpReq.Header.Set(HeaderTimestamp, timestamp)
+	httpReq.Header.Set(HeaderSignature, "sha256="+Sign(req.Secret, timestamp, req.Payload))
+
+	// 2. 发送请求
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// 3. 读取响应（读取失败不影响按状态码判断投递结果）
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+	return &domainService.WebhookResponse{
+		StatusCode: resp.StatusCode,
+		Body:       string(body),
+	}, nil
+}
+
+// Sign 计算请求签名：hex(HMAC-SHA256(secret, timestamp + "." + payload))
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// 确保实现了接口
+var _ domainService.WebhookSender = (*HTTPSender)(nil)
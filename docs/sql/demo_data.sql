@@ -0,0 +1,21 @@
+-- ============================================================================
+-- 配置中心演示数据
+-- 在服务完成数据库迁移后手工执行：psql -d config_center -f docs/sql/demo_data.sql
+-- ============================================================================
+
+-- 插入示例命名空间
+INSERT INTO t_namespaces (name, display_name, description, created_by) VALUES
+('demo-app', '示例应用', '配置中心演示应用', 'system');
+
+-- 插入示例配置（用于演示）
+INSERT INTO t_configs (namespace_id, key, value, group_name, environment, value_type, is_released, created_by) VALUES
+((SELECT id FROM t_namespaces WHERE name = 'demo-app'), 'database.host', 'localhost', 'database', 'default', 'string', true, 'system'),
+((SELECT id FROM t_namespaces WHERE name = 'demo-app'), 'database.port', '5432', 'database', 'default', 'int', true, 'system'),
+((SELECT id FROM t_namespaces WHERE name = 'demo-app'), 'database.username', 'admin', 'database', 'default', 'string', true, 'system'),
+((SELECT id FROM t_namespaces WHERE name = 'demo-app'), 'redis.host', 'localhost', 'cache', 'default', 'string', true, 'system'),
+((SELECT id FROM t_namespaces WHERE name = 'demo-app'), 'redis.port', '6379', 'cache', 'default', 'int', true, 'system'),
+((SELECT id FROM t_namespaces WHERE name = 'demo-app'), 'app.debug', 'true', 'application', 'default', 'boolean', true, 'system'),
+((SELECT id FROM t_namespaces WHERE name = 'demo-app'), 'app.log.level', 'info', 'application', 'default', 'string', true, 'system');
+
+-- 更新示例配置的MD5哈希（因为INSERT时触发器会自动计算，这里只是确保数据一致）
+UPDATE t_configs SET content_hash = MD5(value) WHERE content_hash IS NULL;
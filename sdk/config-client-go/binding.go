@@ -0,0 +1,237 @@
+package configsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"gopkg.in/yaml.v3"
+)
+
+// BindFormat 结构体绑定的配置值格式
+type BindFormat string
+
+const (
+	// BindFormatAuto 按内容判断格式：以 { 或 [ 开头为 JSON，否则为 YAML
+	BindFormatAuto BindFormat = ""
+	// BindFormatJSON JSON 格式（按 json 标签解析）
+	BindFormatJSON BindFormat = "json"
+	// BindFormatYAML YAML 格式（按 yaml 标签解析）
+	BindFormatYAML BindFormat = "yaml"
+)
+
+// BindCallback 绑定的结构体更新回调
+// generation 为结构体当前的版本代数；err 不为空表示新配置值获取或解析失败，结构体保持上一次成功解析的值
+type BindCallback func(generation uint64, err error)
+
+// BindOption 结构体绑定选项
+type BindOption func(*Binding)
+
+// WithBindFormat 设置配置值格式（默认按内容判断）
+func WithBindFormat(format BindFormat) BindOption {
+	return func(b *Binding) {
+		b.format = format
+	}
+}
+
+// WithBindCallback 设置结构体更新回调（配置变更后结构体更新或解析失败时调用，初次绑定不调用）
+func WithBindCallback(callback BindCallback) BindOption {
+	return func(b *Binding) {
+		b.callbacks = append(b.callbacks, callback)
+	}
+}
+
+// Binding 配置与结构体的绑定
+// 配置变更时先将新值解析到新的结构体，解析成功后在写锁内整体替换绑定的结构体，读取方不会读到解析了一半的配置；
+// 读取绑定的结构体需通过 Read 加读锁，或使用 Snapshot 获取不共享内存的只读副本
+type Binding struct {
+	client      *Client
+	namespaceID int
+	key         string
+	format      BindFormat
+	callbacks   []BindCallback
+
+	mu     sync.RWMutex  // 保护绑定的结构体和最近一次错误
+	target reflect.Value // 绑定的结构体（指针指向的值）
+	err    error         // 最近一次更新失败的错误
+
+	reloadMu    sync.Mutex // 串行化配置值的获取和解析
+	rawValue    string     // 最近一次成功解析的配置值
+	failedValue string     // 最近一次解析失败的配置值（同一错误值不重复回调）
+
+	snapshot   atomic.Value  // 最近一次成功解析的副本（与绑定的结构体同类型的指针）
+	generation atomic.Uint64 // 版本代数：每次成功更新结构体后加 1，初次绑定后为 1
+	closed     atomic.Bool
+}
+
+// Bind 将默认命名空间的配置值（JSON 或 YAML）解析到结构体，并在配置变更时重新解析
+func (c *Client) Bind(key string, target interface{}, opts ...BindOption) (*Binding, error) {
+	return c.BindIn(c.opts.NamespaceID, key, target, opts...)
+}
+
+// BindIn 将指定命名空间的配置值（JSON 或 YAML）解析到结构体，并在配置变更时重新解析
+// target 必须是非空指针；初次获取或解析失败时返回错误，之后的变更解析失败时结构体保持原值，通过回调和 Err 获取错误
+// 每次更新都解析到新的零值结构体，新配置值中缺少的字段为零值，不保留结构体的初始值
+func (c *Client) BindIn(namespaceID int, key string, target interface{}, opts ...BindOption) (*Binding, error) {
+	return c.BindInCtx(context.Background(), namespaceID, key, target, opts...)
+}
+
+// BindCtx 将默认命名空间的配置值解析到结构体，初次获取配置的请求按 ctx 的截止时间和取消
+func (c *Client) BindCtx(ctx context.Context, key string, target interface{}, opts ...BindOption) (*Binding, error) {
+	return c.BindInCtx(ctx, c.opts.NamespaceID, key, target, opts...)
+}
+
+// BindInCtx 将指定命名空间的配置值解析到结构体，初次获取配置的请求按 ctx 的截止时间和取消
+// ctx 只作用于初次绑定，之后配置变更时的重新获取不受其影响
+func (c *Client) BindInCtx(ctx context.Context, namespaceID int, key string, target interface{}, opts ...BindOption) (*Binding, error) {
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return nil, fmt.Errorf("绑定配置 %s 失败: target 必须是非空指针", key)
+	}
+
+	binding := &Binding{
+		client:      c,
+		namespaceID: namespaceID,
+		key:         key,
+		target:      value.Elem(),
+	}
+	for _, opt := range opts {
+		opt(binding)
+	}
+	switch binding.format {
+	case BindFormatAuto, BindFormatJSON, BindFormatYAML:
+	default:
+		return nil, fmt.Errorf("绑定配置 %s 失败: 不支持的配置格式: %s", key, binding.format)
+	}
+
+	// 1. 初次获取并解析配置
+	if _, err := binding.reload(ctx); err != nil {
+		return nil, err
+	}
+
+	// 2. 监听配置变更
+	if err := c.WatchIn(namespaceID, key, func(string, string) { binding.onChange() }); err != nil {
+		return nil, fmt.Errorf("监听配置 %s 失败: %w", key, err)
+	}
+
+	return binding, nil
+}
+
+// Key 绑定的配置键
+func (b *Binding) Key() string {
+	return b.key
+}
+
+// Generation 结构体的版本代数（每次成功更新后加 1），可用于判断配置是否已更新
+func (b *Binding) Generation() uint64 {
+	return b.generation.Load()
+}
+
+// Read 在读锁内执行 fn，fn 中读取绑定的结构体不会与配置更新并发
+func (b *Binding) Read(fn func()) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	fn()
+}
+
+// Snapshot 最近一次成功解析的副本（与绑定的结构体同类型的指针）
+// 副本不与绑定的结构体共享内存，配置更新时替换为新的副本，调用方无需加锁，但不应修改副本
+func (b *Binding) Snapshot() interface{} {
+	return b.snapshot.Load()
+}
+
+// Err 最近一次配置变更获取或解析失败的错误（之后成功更新时清空）
+func (b *Binding) Err() error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.err
+}
+
+// Close 停止跟随配置变更更新结构体（不影响同一配置键的其他监听）
+func (b *Binding) Close() {
+	b.closed.Store(true)
+}
+
+// onChange 处理配置变更事件
+func (b *Binding) onChange() {
+	if b.closed.Load() {
+		return
+	}
+
+	changed, err := b.reload(context.Background())
+	if !changed && err == nil {
+		return
+	}
+
+	b.mu.Lock()
+	b.err = err
+	b.mu.Unlock()
+
+	generation := b.generation.Load()
+	for _, callback := range b.callbacks {
+		callback(generation, err)
+	}
+}
+
+// reload 获取并解析配置值，配置值未变化时不更新结构体
+// 变更事件可能不携带配置值（如 Redis 通知），统一通过客户端读取（优先读缓存）
+func (b *Binding) reload(ctx context.Context) (bool, error) {
+	b.reloadMu.Lock()
+	defer b.reloadMu.Unlock()
+
+	value, err := b.client.GetInCtx(ctx, b.namespaceID, b.key)
+	if err != nil {
+		return false, err
+	}
+	if (b.generation.Load() > 0 && value == b.rawValue) || (b.failedValue != "" && value == b.failedValue) {
+		return false, nil
+	}
+
+	// 解析到新的结构体，解析失败时保持原值
+	elemType := b.target.Type()
+	next := reflect.New(elemType)
+	if err := unmarshalValue(value, b.format, next.Interface()); err != nil {
+		b.failedValue = value
+		return false, fmt.Errorf("解析配置 %s 失败: %w", b.key, err)
+	}
+	snapshot := reflect.New(elemType)
+	_ = unmarshalValue(value, b.format, snapshot.Interface())
+
+	b.mu.Lock()
+	b.target.Set(next.Elem())
+	b.mu.Unlock()
+
+	b.snapshot.Store(snapshot.Interface())
+	b.rawValue = value
+	b.failedValue = ""
+	b.generation.Add(1)
+	return true, nil
+}
+
+// unmarshalValue 按格式解析配置值
+func unmarshalValue(value string, format BindFormat, out interface{}) error {
+	if format == BindFormatAuto {
+		format = detectFormat(value)
+	}
+	switch format {
+	case BindFormatJSON:
+		return json.Unmarshal([]byte(value), out)
+	case BindFormatYAML:
+		return yaml.Unmarshal([]byte(value), out)
+	default:
+		return fmt.Errorf("不支持的配置格式: %s", format)
+	}
+}
+
+// detectFormat 按内容判断配置值格式
+func detectFormat(value string) BindFormat {
+	trimmed := strings.TrimSpace(value)
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		return BindFormatJSON
+	}
+	return BindFormatYAML
+}
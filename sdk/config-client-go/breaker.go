@@ -0,0 +1,232 @@
+package configsdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen 熔断器处于打开状态，请求未发送到配置中心
+var ErrCircuitOpen = errors.New("配置中心熔断中，请求未发送")
+
+// CircuitState 熔断器状态
+type CircuitState int
+
+const (
+	// CircuitClosed 关闭：请求正常发送，统计连续失败次数
+	CircuitClosed CircuitState = iota
+	// CircuitOpen 打开：请求直接返回 ErrCircuitOpen，读取使用缓存或降级配置
+	CircuitOpen
+	// CircuitHalfOpen 半开：熔断时间结束后放行少量探测请求，成功则关闭，失败则重新打开
+	CircuitHalfOpen
+)
+
+// String 状态名称
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half_open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitBreakerConfig 熔断器配置
+type CircuitBreakerConfig struct {
+	// FailureThreshold 连续失败多少次后打开熔断器（默认: 5）
+	FailureThreshold int
+
+	// OpenTimeout 熔断持续时间，之后进入半开状态（默认: 30s）
+	OpenTimeout time.Duration
+
+	// HalfOpenProbes 半开状态下同时放行的探测请求数（默认: 1）
+	HalfOpenProbes int
+
+	// OnStateChange 状态变化回调（在发送请求的协程中同步调用，不应阻塞）
+	OnStateChange func(from, to CircuitState)
+}
+
+// DefaultCircuitBreakerConfig 默认熔断器配置：连续失败 5 次后熔断 30s，半开状态放行 1 个探测请求
+func DefaultCircuitBreakerConfig() *CircuitBreakerConfig {
+	return &CircuitBreakerConfig{
+		FailureThreshold: 5,
+		OpenTimeout:      30 * time.Second,
+		HalfOpenProbes:   1,
+	}
+}
+
+// CircuitBreakerStatus 熔断器状态
+type CircuitBreakerStatus struct {
+	Enabled             bool         // 是否启用熔断器
+	State               CircuitState // 当前状态
+	ConsecutiveFailures int          // 连续失败次数
+	OpenedAt            time.Time    // 最近一次打开的时间（未打开过时为零值）
+	NextProbeAt         time.Time    // 打开状态下允许发送探测请求的时间
+	LastError           error        // 最近一次失败的错误
+}
+
+// circuitBreaker 配置中心请求的熔断器
+// 请求出现网络错误或服务端返回 5xx、429（重试后仍失败）计为失败；其他状态码说明服务端可用，计为成功
+type circuitBreaker struct {
+	cfg CircuitBreakerConfig
+	now func() time.Time
+
+	mu       sync.Mutex
+	state    CircuitState
+	failures int       // 连续失败次数
+	openedAt time.Time // 最近一次打开的时间
+	probes   int       // 半开状态下进行中的探测请求数
+	lastErr  error     // 最近一次失败的错误
+}
+
+// newCircuitBreaker 创建熔断器（cfg 为 nil 时返回 nil，表示不启用熔断）
+func newCircuitBreaker(cfg *CircuitBreakerConfig) *circuitBreaker {
+	if cfg == nil {
+		return nil
+	}
+	b := &circuitBreaker{cfg: *cfg, now: time.Now}
+	if b.cfg.FailureThreshold <= 0 {
+		b.cfg.FailureThreshold = 5
+	}
+	if b.cfg.OpenTimeout <= 0 {
+		b.cfg.OpenTimeout = 30 * time.Second
+	}
+	if b.cfg.HalfOpenProbes <= 0 {
+		b.cfg.HalfOpenProbes = 1
+	}
+	return b
+}
+
+// allow 请求前检查是否放行：打开状态返回 ErrCircuitOpen，熔断时间结束后转为半开并放行探测请求
+func (b *circuitBreaker) allow() error {
+	if b == nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	from := b.state
+	switch b.state {
+	case CircuitOpen:
+		if b.now().Before(b.openedAt.Add(b.cfg.OpenTimeout)) {
+			b.mu.Unlock()
+			return ErrCircuitOpen
+		}
+		b.state = CircuitHalfOpen
+		b.probes = 0
+		fallthrough
+	case CircuitHalfOpen:
+		// 刚转为半开时探测名额已清零，名额用完只会发生在已处于半开状态时，无状态变化
+		if b.probes >= b.cfg.HalfOpenProbes {
+			b.mu.Unlock()
+			return ErrCircuitOpen
+		}
+		b.probes++
+	}
+	to := b.state
+	b.mu.Unlock()
+
+	b.notify(from, to)
+	return nil
+}
+
+// success 记录请求成功：半开状态下探测成功则关闭熔断器
+func (b *circuitBreaker) success() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	from := b.state
+	switch b.state {
+	case CircuitClosed:
+		b.failures = 0
+	case CircuitHalfOpen:
+		b.state = CircuitClosed
+		b.failures = 0
+		b.probes = 0
+	}
+	to := b.state
+	b.mu.Unlock()
+
+	b.notify(from, to)
+}
+
+// failure 记录请求失败：连续失败次数达到阈值，或半开状态下探测失败时打开熔断器
+func (b *circuitBreaker) failure(err error) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	from := b.state
+	b.lastErr = err
+	switch b.state {
+	case CircuitClosed:
+		b.failures++
+		if b.failures >= b.cfg.FailureThreshold {
+			b.state = CircuitOpen
+			b.openedAt = b.now()
+		}
+	case CircuitHalfOpen:
+		b.failures++
+		b.state = CircuitOpen
+		b.openedAt = b.now()
+		b.probes = 0
+	}
+	to := b.state
+	b.mu.Unlock()
+
+	b.notify(from, to)
+}
+
+// cancel 请求未发送或被调用方取消，不计入成功或失败，只释放半开状态的探测名额
+func (b *circuitBreaker) cancel() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == CircuitHalfOpen && b.probes > 0 {
+		b.probes--
+	}
+}
+
+// status 当前状态
+func (b *circuitBreaker) status() CircuitBreakerStatus {
+	if b == nil {
+		return CircuitBreakerStatus{State: CircuitClosed}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	status := CircuitBreakerStatus{
+		Enabled:             true,
+		State:               b.state,
+		ConsecutiveFailures: b.failures,
+		OpenedAt:            b.openedAt,
+		LastError:           b.lastErr,
+	}
+	if b.state == CircuitOpen {
+		status.NextProbeAt = b.openedAt.Add(b.cfg.OpenTimeout)
+	}
+	return status
+}
+
+// notify 状态变化时调用回调（在锁外调用，回调中可读取熔断器状态）
+func (b *circuitBreaker) notify(from, to CircuitState) {
+	if from != to && b.cfg.OnStateChange != nil {
+		b.cfg.OnStateChange(from, to)
+	}
+}
+
+// isCallerCanceled 请求是否因调用方取消或超时而结束（不说明服务端不可用）
+func isCallerCanceled(req *http.Request, err error) bool {
+	return req.Context().Err() != nil && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded))
+}
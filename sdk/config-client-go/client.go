@@ -1,20 +1,85 @@
 package configsdk
 
 import (
+	"bytes"
 	"context"
+	"crypto/ed25519"
 	"crypto/md5"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
+
+	"config-client/share/config-client/auth"
+	"config-client/share/config-client/listener"
+	"config-client/share/config-client/listener/impl"
+	"config-client/share/config-client/retry"
+	"config-client/share/config-client/signing"
+	"config-client/share/config-client/telemetry"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// ErrInvalidSignature 配置值签名缺失或校验失败
+var ErrInvalidSignature = signing.ErrInvalidSignature
+
+// RetryPolicy 重试策略（最大尝试次数、指数退避时间、随机抖动、可重试的状态码）
+type RetryPolicy = retry.Policy
+
+// DefaultRetryPolicy 默认重试策略：最多请求 3 次，退避时间 500ms 起、最长 30s，抖动 20%，429/502/503/504 可重试
+func DefaultRetryPolicy() *RetryPolicy {
+	return retry.DefaultPolicy()
+}
+
+// TokenProvider 访问令牌提供者（每次请求前调用，支持令牌轮换）
+type TokenProvider = auth.TokenProvider
+
+// TokenProviderFunc 函数形式的 TokenProvider
+type TokenProviderFunc = auth.TokenProviderFunc
+
+// ParseSigningPublicKey 解析 base64 编码的 Ed25519 签名公钥（服务端启动日志中输出）
+func ParseSigningPublicKey(encoded string) (ed25519.PublicKey, error) {
+	return signing.ParsePublicKey(encoded)
+}
+
 // ChangeCallback 配置变更回调（简化版）
 type ChangeCallback func(key, value string)
 
+// ChangeType 配置变更类型
+type ChangeType string
+
+const (
+	ChangeTypeCreate ChangeType = "create" // 创建
+	ChangeTypeUpdate ChangeType = "update" // 更新
+	ChangeTypeDelete ChangeType = "delete" // 删除
+)
+
+// ChangeEvent 配置变更事件
+type ChangeEvent struct {
+	NamespaceID int        // 命名空间 ID
+	Key         string     // 配置键
+	OldValue    string     // 变更前的值（取自缓存，未启用缓存或此前未缓存时为空）
+	NewValue    string     // 变更后的值（删除时为空；Redis 模式的通知不携带配置值，同样为空）
+	Version     string     // 变更后的版本号（删除时为空）
+	Type        ChangeType // 变更类型
+	Timestamp   time.Time  // 收到变更的时间
+}
+
+// ChangeEventCallback 配置变更回调（携带旧值、新值、版本号和变更类型）
+type ChangeEventCallback func(event *ChangeEvent)
+
+// eventCallback 将简化版回调转换为事件回调（兼容只接收配置键和新值的回调）
+func (cb ChangeCallback) eventCallback() ChangeEventCallback {
+	return func(event *ChangeEvent) {
+		cb(event.Key, event.NewValue)
+	}
+}
+
 // ConfigItem 配置缓存项
 type ConfigItem struct {
 	Value     string
@@ -87,6 +152,25 @@ func (c *ConfigCache) GetByPrefix(prefix string) map[string]string {
 	return result
 }
 
+// GetVersionsByPrefix 获取前缀下所有配置的版本号（配置键 -> 版本号）
+func (c *ConfigCache) GetVersionsByPrefix(prefix string) map[string]string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	result := make(map[string]string)
+	for key, item := range c.items {
+		if strings.HasPrefix(key, prefix) {
+			result[key] = item.Version
+		}
+	}
+	return result
+}
+
+func (c *ConfigCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.items, key)
+}
+
 func (c *ConfigCache) Has(key string) bool {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -113,6 +197,14 @@ func (c *ConfigCache) SetBatch(configs map[string]struct {
 type HTTPClient struct {
 	serverURL  string
 	httpClient *http.Client
+	publicKey  ed25519.PublicKey // 配置值签名公钥（设置后校验返回的配置值签名）
+
+	credentials *auth.Credentials // 认证信息（服务端启用认证时附加到每个请求）
+	retryPolicy *retry.Policy     // 重试策略（为 nil 时不重试）
+	breaker     *circuitBreaker   // 熔断器（为 nil 时不熔断）
+	tracer      *telemetry.Tracer // 链路追踪（为 nil 时使用 OTel 全局实现）
+
+	decrypter Decrypter // 配置值解密器（为 nil 时不解密）
 }
 
 // NewHTTPClient 创建 HTTP 客户端
@@ -125,6 +217,138 @@ func NewHTTPClient(serverURL string) *HTTPClient {
 	}
 }
 
+// SetSigningPublicKey 设置配置值签名公钥，设置后返回的配置值签名缺失或校验失败时返回 ErrInvalidSignature
+func (c *HTTPClient) SetSigningPublicKey(publicKey ed25519.PublicKey) {
+	c.publicKey = publicKey
+}
+
+// SetCredentials 设置认证信息，设置后每个请求均携带 API Key 或 Bearer 令牌
+func (c *HTTPClient) SetCredentials(credentials *auth.Credentials) {
+	c.credentials = credentials
+}
+
+// SetRetryPolicy 设置重试策略，请求出现网络错误或返回可重试的状态码时按指数退避重试
+func (c *HTTPClient) SetRetryPolicy(policy *retry.Policy) {
+	c.retryPolicy = policy
+}
+
+// SetCircuitBreaker 设置熔断器（cfg 为 nil 时不熔断）
+// 连续失败达到阈值后熔断，熔断期间请求直接返回 ErrCircuitOpen，不再等待超时和重试
+func (c *HTTPClient) SetCircuitBreaker(cfg *CircuitBreakerConfig) {
+	c.breaker = newCircuitBreaker(cfg)
+}
+
+// SetTracer 设置链路追踪，设置后每次读取创建客户端 span，并在请求头中向服务端传递链路上下文
+func (c *HTTPClient) SetTracer(tracer *telemetry.Tracer) {
+	c.tracer = tracer
+}
+
+// SetDecrypter 设置配置值解密器，设置后值类型为 encrypted 或被标记为敏感的配置解密后返回，解密失败时返回 ErrDecryptFailed
+func (c *HTTPClient) SetDecrypter(decrypter Decrypter) {
+	c.decrypter = decrypter
+}
+
+// CircuitBreakerStatus 熔断器状态
+func (c *HTTPClient) CircuitBreakerStatus() CircuitBreakerStatus {
+	return c.breaker.status()
+}
+
+// do 经熔断器发送请求：熔断期间直接返回 ErrCircuitOpen，否则按重试策略发送并记录结果
+// 每次调用创建一个客户端 span（包含全部重试），attrs 为 span 的附加属性
+func (c *HTTPClient) do(req *http.Request, attrs ...attribute.KeyValue) (resp *http.Response, err error) {
+	req, span := c.tracer.StartRequest(req, attrs...)
+	defer func() { telemetry.EndRequest(span, resp, err) }()
+
+	if err := c.breaker.allow(); err != nil {
+		span.SetAttributes(attribute.String("circuit.state", CircuitOpen.String()))
+		return nil, err
+	}
+
+	resp, sent, err := c.send(req)
+	switch {
+	case !sent || isCallerCanceled(req, err):
+		c.breaker.cancel()
+	case err != nil:
+		c.breaker.failure(err)
+	case resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests:
+		c.breaker.failure(fmt.Errorf("请求失败: status=%d", resp.StatusCode))
+	default:
+		c.breaker.success()
+	}
+	return resp, err
+}
+
+// send 附加认证信息并发送请求，按重试策略重试
+// 达到最大尝试次数后返回最后一次的响应或错误；服务端返回 Retry-After 时等待时间不少于该值（不超过最大退避时间）
+// sent 表示返回的结果是否来自服务端（读取请求体或获取认证信息失败时请求未发送）
+func (c *HTTPClient) send(req *http.Request) (resp *http.Response, sent bool, err error) {
+	attempts := c.retryPolicy.Attempts()
+	for attempt := 1; ; attempt++ {
+		// 每次尝试使用新的请求（请求体需重新读取，令牌可能已轮换）
+		attemptReq := req.Clone(req.Context())
+		c.tracer.Inject(attemptReq)
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, false, err
+			}
+			attemptReq.Body = body
+		}
+		if err := c.credentials.Apply(attemptReq); err != nil {
+			return nil, false, err
+		}
+
+		resp, err := c.httpClient.Do(attemptReq)
+		if attempt >= attempts || (err == nil && !c.retryPolicy.IsRetryableStatus(resp.StatusCode)) {
+			return resp, true, err
+		}
+
+		wait := c.retryPolicy.Backoff(attempt)
+		if err == nil {
+			// 服务端要求的等待时间不超过最大退避时间（MaxBackoff <= 0 时不限制）
+			if retryAfter := retry.RetryAfter(resp.Header.Get("Retry-After")); retryAfter > wait {
+				wait = retryAfter
+				if c.retryPolicy.MaxBackoff > 0 {
+					wait = min(wait, c.retryPolicy.MaxBackoff)
+				}
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		trace.SpanFromContext(req.Context()).AddEvent("retry", trace.WithAttributes(
+			attribute.Int("retry.attempt", attempt),
+			attribute.String("retry.wait", wait.String()),
+		))
+		select {
+		case <-req.Context().Done():
+			return nil, true, req.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// verify 校验配置值签名（未设置公钥时不校验），按请求的环境校验，不信任响应中的环境
+func (c *HTTPClient) verify(environment string, configs ...ConfigVO) error {
+	if c.publicKey == nil {
+		return nil
+	}
+	for _, config := range configs {
+		if err := signing.Verify(c.publicKey, config.NamespaceID, environment, config.Key, config.Value, config.Signature); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// namespaceAttributes 读取请求 span 的命名空间和环境属性
+func namespaceAttributes(namespaceID int, environment string, attrs ...attribute.KeyValue) []attribute.KeyValue {
+	return append([]attribute.KeyValue{
+		attribute.Int("config.namespace_id", namespaceID),
+		attribute.String("config.environment", environment),
+	}, attrs...)
+}
+
 // ConfigVO 配置值对象
 type ConfigVO struct {
 	ID          int       `json:"id"`
@@ -139,6 +363,12 @@ type ConfigVO struct {
 	Version     int       `json:"version"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+
+	Signature      string `json:"signature"`        // 配置值签名（服务端启用签名时返回）
+	SignatureKeyID string `json:"signature_key_id"` // 签名密钥ID
+
+	IsSensitive bool `json:"is_sensitive"` // 是否为敏感配置
+	IsMasked    bool `json:"is_masked"`    // 值是否已被服务端脱敏
 }
 
 // Response 标准响应格式
@@ -157,26 +387,38 @@ type ConfigListVO struct {
 	TotalPages int        `json:"total_pages"`
 }
 
+// BulkGetResult 批量获取配置结果
+type BulkGetResult struct {
+	Items   []ConfigVO `json:"items"`   // 已发布且已激活的配置
+	Missing []string   `json:"missing"` // 不存在或不可读取的配置键
+}
+
 // GetConfigByKey 根据命名空间和键获取配置
-func (c *HTTPClient) GetConfigByKey(namespaceID int, key string) (*ConfigVO, error) {
-	url := fmt.Sprintf("%s/api/v1/configs", c.serverURL)
-	httpReq, _ := http.NewRequest("GET", url, nil)
+// 调用按键读取接口，仅返回已发布且已激活的配置
+func (c *HTTPClient) GetConfigByKey(namespaceID int, environment string, key string) (*ConfigVO, error) {
+	return c.GetConfigByKeyCtx(context.Background(), namespaceID, environment, key)
+}
+
+// GetConfigByKeyCtx 根据命名空间和键获取配置，ctx 的截止时间和取消作用于请求及重试等待
+func (c *HTTPClient) GetConfigByKeyCtx(ctx context.Context, namespaceID int, environment string, key string) (*ConfigVO, error) {
+	url := fmt.Sprintf("%s/api/v1/configs/key", c.serverURL)
+	httpReq, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
 
 	q := httpReq.URL.Query()
 	q.Add("namespace_id", fmt.Sprintf("%d", namespaceID))
+	q.Add("environment", environment)
 	q.Add("key", key)
-	q.Add("is_active", "true")
-	q.Add("is_released", "true")
-	q.Add("page", "1")
-	q.Add("size", "1")
 	httpReq.URL.RawQuery = q.Encode()
 
-	resp, err := c.httpClient.Do(httpReq)
+	resp, err := c.do(httpReq, namespaceAttributes(namespaceID, environment, attribute.String("config.key", key))...)
 	if err != nil {
 		return nil, fmt.Errorf("请求失败: %w", err)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("配置不存在: namespace_id=%d, environment=%s, key=%s", namespaceID, environment, key)
+	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("请求失败: status=%d, body=%s", resp.StatusCode, string(body))
@@ -187,32 +429,110 @@ func (c *HTTPClient) GetConfigByKey(namespaceID int, key string) (*ConfigVO, err
 		return nil, fmt.Errorf("解析响应失败: %w", err)
 	}
 
-	var configList ConfigListVO
-	if err := json.Unmarshal(result.Data, &configList); err != nil {
-		return nil, fmt.Errorf("解析配置列表失败: %w", err)
+	var config ConfigVO
+	if err := json.Unmarshal(result.Data, &config); err != nil {
+		return nil, fmt.Errorf("解析配置失败: %w", err)
+	}
+	if err := c.verify(environment, config); err != nil {
+		return nil, err
+	}
+	configs := []ConfigVO{config}
+	if err := c.decrypt(configs); err != nil {
+		return nil, err
 	}
+	config = configs[0]
+
+	return &config, nil
+}
 
-	if len(configList.Items) == 0 {
-		return nil, fmt.Errorf("配置不存在: namespace_id=%d, key=%s", namespaceID, key)
+// GetConfigsByKeys 根据命名空间和一组键批量获取配置
+// 调用批量读取接口，一次请求返回全部配置，仅返回已发布且已激活的配置
+func (c *HTTPClient) GetConfigsByKeys(namespaceID int, environment string, keys []string) (*BulkGetResult, error) {
+	return c.GetConfigsByKeysCtx(context.Background(), namespaceID, environment, keys)
+}
+
+// GetConfigsByKeysCtx 根据命名空间和一组键批量获取配置，ctx 的截止时间和取消作用于请求及重试等待
+func (c *HTTPClient) GetConfigsByKeysCtx(ctx context.Context, namespaceID int, environment string, keys []string) (*BulkGetResult, error) {
+	bulk, err := c.getConfigsByKeys(ctx, namespaceID, environment, keys)
+	if err != nil {
+		return nil, err
 	}
+	if err := c.decrypt(bulk.Items); err != nil {
+		return nil, err
+	}
+	return bulk, nil
+}
 
-	return &configList.Items[0], nil
+// getConfigsByKeys 批量获取配置并校验签名（不解密，加密配置返回密文）
+func (c *HTTPClient) getConfigsByKeys(ctx context.Context, namespaceID int, environment string, keys []string) (*BulkGetResult, error) {
+	url := fmt.Sprintf("%s/api/v1/configs/bulk-get", c.serverURL)
+	body, _ := json.Marshal(map[string]interface{}{
+		"namespace_id": namespaceID,
+		"environment":  environment,
+		"keys":         keys,
+	})
+	httpReq, _ := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(httpReq, namespaceAttributes(namespaceID, environment, attribute.Int("config.key_count", len(keys)))...)
+	if err != nil {
+		return nil, fmt.Errorf("请求失败: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("请求失败: status=%d, body=%s", resp.StatusCode, string(body))
+	}
+
+	var result Response
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("解析响应失败: %w", err)
+	}
+
+	var bulk BulkGetResult
+	if err := json.Unmarshal(result.Data, &bulk); err != nil {
+		return nil, fmt.Errorf("解析配置失败: %w", err)
+	}
+	if err := c.verify(environment, bulk.Items...); err != nil {
+		return nil, err
+	}
+
+	return &bulk, nil
+}
+
+// GetConfigsByNamespace 获取命名空间在指定环境下的所有配置
+func (c *HTTPClient) GetConfigsByNamespace(namespaceID int, environment string) ([]ConfigVO, error) {
+	return c.GetConfigsByNamespaceCtx(context.Background(), namespaceID, environment)
+}
+
+// GetConfigsByNamespaceCtx 获取命名空间在指定环境下的所有配置，ctx 的截止时间和取消作用于请求及重试等待
+func (c *HTTPClient) GetConfigsByNamespaceCtx(ctx context.Context, namespaceID int, environment string) ([]ConfigVO, error) {
+	configs, err := c.getConfigsByNamespace(ctx, namespaceID, environment)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.decrypt(configs); err != nil {
+		return nil, err
+	}
+	return configs, nil
 }
 
-// GetConfigsByNamespace 获取命名空间下的所有配置
-func (c *HTTPClient) GetConfigsByNamespace(namespaceID int) ([]ConfigVO, error) {
+// getConfigsByNamespace 获取命名空间在指定环境下的所有配置并校验签名（不解密，加密配置返回密文）
+func (c *HTTPClient) getConfigsByNamespace(ctx context.Context, namespaceID int, environment string) ([]ConfigVO, error) {
 	url := fmt.Sprintf("%s/api/v1/configs", c.serverURL)
-	httpReq, _ := http.NewRequest("GET", url, nil)
+	httpReq, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
 
 	q := httpReq.URL.Query()
 	q.Add("namespace_id", fmt.Sprintf("%d", namespaceID))
+	q.Add("environment", environment)
 	q.Add("is_active", "true")
 	q.Add("is_released", "true")
 	q.Add("page", "1")
 	q.Add("size", "1000")
 	httpReq.URL.RawQuery = q.Encode()
 
-	resp, err := c.httpClient.Do(httpReq)
+	resp, err := c.do(httpReq, namespaceAttributes(namespaceID, environment)...)
 	if err != nil {
 		return nil, fmt.Errorf("请求失败: %w", err)
 	}
@@ -231,20 +551,31 @@ func (c *HTTPClient) GetConfigsByNamespace(namespaceID int) ([]ConfigVO, error)
 	if err := json.Unmarshal(result.Data, &configList); err != nil {
 		return nil, fmt.Errorf("解析配置列表失败: %w", err)
 	}
+	if err := c.verify(environment, configList.Items...); err != nil {
+		return nil, err
+	}
 
 	return configList.Items, nil
 }
 
 // Client 配置中心 SDK 客户端
+// 除默认命名空间外可注册多个命名空间（WithNamespaces），带 In 后缀的方法按命名空间 ID 读取和监听配置，
+// 不带后缀的方法操作默认命名空间；每个命名空间使用各自的环境读取和监听，配置缓存按命名空间（及其环境）分别保存
 type Client struct {
 	opts       *Options
 	httpClient *HTTPClient
-	cache      *ConfigCache
+	namespaces map[int]Namespace    // 已注册的命名空间（包含默认命名空间）
+	caches     map[int]*ConfigCache // 命名空间 ID -> 配置缓存（未启用缓存时为空）
 	watcher    Watcher
 	ctx        context.Context
 	cancel     context.CancelFunc
 	mu         sync.RWMutex
-	callbacks  map[string][]ChangeCallback
+	callbacks  map[string][]ChangeEventCallback // "命名空间ID:配置键" -> 回调
+
+	prefixWatches map[int]map[string][]prefixWatch // 命名空间 ID -> 前缀 -> 前缀和通配符监听
+
+	snapshotMu     sync.Mutex // 串行化本地快照写入
+	snapshotLoaded bool       // 启动时是否使用了本地快照
 }
 
 // New 创建配置中心客户端
@@ -261,17 +592,33 @@ func New(opts ...Option) (*Client, error) {
 	client := &Client{
 		opts:       options,
 		httpClient: NewHTTPClient(options.ServerURL),
-		callbacks:  make(map[string][]ChangeCallback),
+		callbacks:  make(map[string][]ChangeEventCallback),
+
+		prefixWatches: make(map[int]map[string][]prefixWatch),
+	}
+	client.httpClient.SetSigningPublicKey(options.SigningPublicKey)
+	client.httpClient.SetCredentials(options.Credentials)
+	client.httpClient.SetRetryPolicy(options.RetryPolicy)
+	client.httpClient.SetCircuitBreaker(options.CircuitBreaker)
+	client.httpClient.SetTracer(telemetry.NewTracer(options.TracerProvider, options.Propagator))
+	client.httpClient.SetDecrypter(options.Decrypter)
+
+	// 注册命名空间
+	if err := client.registerNamespaces(); err != nil {
+		return nil, err
 	}
 
 	// 启用缓存
 	if options.EnableCache {
-		client.cache = NewConfigCache()
+		client.caches = make(map[int]*ConfigCache, len(client.namespaces))
+		for namespaceID := range client.namespaces {
+			client.caches[namespaceID] = NewConfigCache()
+		}
 	}
 
-	// 初始化时拉取所有配置
+	// 初始化时拉取所有配置，服务端不可用时使用本地快照启动
 	if options.FetchOnInit {
-		if err := client.fetchAllConfigs(); err != nil {
+		if err := client.fetchInitialConfigs(); err != nil {
 			return nil, fmt.Errorf("拉取初始配置失败: %w", err)
 		}
 	}
@@ -292,9 +639,56 @@ func New(opts ...Option) (*Client, error) {
 	return client, nil
 }
 
+// registerNamespaces 注册默认命名空间和 Options.Namespaces 中的命名空间
+func (c *Client) registerNamespaces() error {
+	c.namespaces = make(map[int]Namespace, len(c.opts.Namespaces)+1)
+	c.namespaces[c.opts.NamespaceID] = Namespace{
+		ID:          c.opts.NamespaceID,
+		Name:        c.opts.Namespace,
+		Environment: c.environment(""),
+		Fallback:    c.opts.Fallback,
+	}
+	for _, namespace := range c.opts.Namespaces {
+		if namespace.ID <= 0 {
+			return fmt.Errorf("命名空间 ID 无效: %d", namespace.ID)
+		}
+		if _, exists := c.namespaces[namespace.ID]; exists {
+			return fmt.Errorf("命名空间重复注册: namespace_id=%d", namespace.ID)
+		}
+		namespace.Environment = c.environment(namespace.Environment)
+		c.namespaces[namespace.ID] = namespace
+	}
+	return nil
+}
+
+// environment 命名空间使用的环境：未单独设置时使用客户端的环境，均未设置时为 default
+func (c *Client) environment(environment string) string {
+	if environment == "" {
+		environment = c.opts.Environment
+	}
+	if environment == "" {
+		environment = listener.DefaultEnvironment
+	}
+	return environment
+}
+
+// namespace 获取已注册的命名空间
+func (c *Client) namespace(namespaceID int) (Namespace, error) {
+	namespace, ok := c.namespaces[namespaceID]
+	if !ok {
+		return Namespace{}, fmt.Errorf("命名空间未注册: namespace_id=%d", namespaceID)
+	}
+	return namespace, nil
+}
+
+// cacheOf 获取命名空间的配置缓存（未启用缓存时返回 nil）
+func (c *Client) cacheOf(namespaceID int) *ConfigCache {
+	return c.caches[namespaceID]
+}
+
 // createWatcher 创建底层监听器
 func (c *Client) createWatcher() error {
-	watcher, err := createWatcherFromOptions(c.opts, c.cache)
+	watcher, err := createWatcherFromOptions(c.opts, c.cachedVersion, c.cachedVersionsByPrefix, c.fetchChangedValue, c.listNamespaceValues)
 	if err != nil {
 		return err
 	}
@@ -302,15 +696,99 @@ func (c *Client) createWatcher() error {
 	return nil
 }
 
-// fetchAllConfigs 拉取所有配置
-func (c *Client) fetchAllConfigs() error {
+// fetchChangedValue 查询 Redis 通知中变更配置的最新值（校验签名，不解密，与长轮询返回的变更一致，解密在处理变更时进行）
+func (c *Client) fetchChangedValue(ctx context.Context, namespaceID int, environment, key string) (*impl.ConfigValue, error) {
+	bulk, err := c.httpClient.getConfigsByKeys(ctx, namespaceID, environment, []string{key})
+	if err != nil {
+		return nil, err
+	}
+	for _, config := range bulk.Items {
+		if config.Key == key {
+			return &impl.ConfigValue{Value: config.Value, ValueType: config.ValueType, Version: computeVersion(config.Value)}, nil
+		}
+	}
+	return nil, nil
+}
+
+// listNamespaceValues 查询命名空间的全部配置（Redis 重连后补发前缀下断线期间新建的配置，与 fetchChangedValue 一致不解密）
+func (c *Client) listNamespaceValues(ctx context.Context, namespaceID int, environment string) (map[string]*impl.ConfigValue, error) {
+	configs, err := c.httpClient.getConfigsByNamespace(ctx, namespaceID, environment)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]*impl.ConfigValue, len(configs))
+	for _, config := range configs {
+		values[config.Key] = &impl.ConfigValue{Value: config.Value, ValueType: config.ValueType, Version: computeVersion(config.Value)}
+	}
+	return values, nil
+}
+
+// cachedVersion 缓存中配置的版本号（未缓存时为空）
+func (c *Client) cachedVersion(namespaceID int, key string) string {
+	if cache := c.cacheOf(namespaceID); cache != nil {
+		return cache.GetVersion(key)
+	}
+	return ""
+}
+
+// cachedVersionsByPrefix 缓存中前缀下所有配置的版本号（未启用缓存时为空）
+func (c *Client) cachedVersionsByPrefix(namespaceID int, prefix string) map[string]string {
+	if cache := c.cacheOf(namespaceID); cache != nil {
+		return cache.GetVersionsByPrefix(prefix)
+	}
+	return nil
+}
+
+// fetchInitialConfigs 初始化时拉取所有命名空间的配置
+// 拉取失败的命名空间从本地快照加载，快照不可用时返回拉取错误
+func (c *Client) fetchInitialConfigs() error {
+	var (
+		failed   []int
+		fetchErr error
+	)
+	for namespaceID := range c.namespaces {
+		if err := c.fetchNamespaceConfigs(context.Background(), namespaceID); err != nil {
+			failed = append(failed, namespaceID)
+			if fetchErr == nil {
+				fetchErr = err
+			}
+		}
+	}
+	if len(failed) == 0 {
+		c.saveSnapshot()
+		return nil
+	}
+	if err := c.loadSnapshot(failed); err != nil {
+		return fetchErr
+	}
+	return nil
+}
+
+// fetchAllConfigs 拉取所有命名空间的配置
+func (c *Client) fetchAllConfigs(ctx context.Context) error {
 	if !c.opts.EnableCache {
 		return nil
 	}
 
-	configs, err := c.httpClient.GetConfigsByNamespace(c.opts.NamespaceID)
+	for namespaceID := range c.namespaces {
+		if err := c.fetchNamespaceConfigs(ctx, namespaceID); err != nil {
+			return err
+		}
+	}
+	c.saveSnapshot()
+	return nil
+}
+
+// fetchNamespaceConfigs 拉取命名空间的所有配置到缓存
+func (c *Client) fetchNamespaceConfigs(ctx context.Context, namespaceID int) error {
+	cache := c.cacheOf(namespaceID)
+	if cache == nil {
+		return nil
+	}
+
+	configs, err := c.httpClient.GetConfigsByNamespaceCtx(ctx, namespaceID, c.namespaces[namespaceID].Environment)
 	if err != nil {
-		return err
+		return fmt.Errorf("拉取命名空间 %d 的配置失败: %w", namespaceID, err)
 	}
 
 	batch := make(map[string]struct {
@@ -328,7 +806,7 @@ func (c *Client) fetchAllConfigs() error {
 		}
 	}
 
-	c.cache.SetBatch(batch)
+	cache.SetBatch(batch)
 	return nil
 }
 
@@ -353,6 +831,7 @@ func (c *Client) Start(ctx context.Context) error {
 }
 
 // Stop 停止客户端
+// HTTP 模式下停止长轮询后向服务端取消订阅，服务端立即停用订阅记录（最多等待 5s，失败时订阅在心跳超时后过期）
 func (c *Client) Stop() error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -370,40 +849,185 @@ func (c *Client) Stop() error {
 	return nil
 }
 
-// Get 获取配置
+// Get 获取默认命名空间的配置
 func (c *Client) Get(key string) (string, error) {
+	return c.GetIn(c.opts.NamespaceID, key)
+}
+
+// GetIn 获取指定命名空间的配置
+func (c *Client) GetIn(namespaceID int, key string) (string, error) {
+	return c.GetInCtx(context.Background(), namespaceID, key)
+}
+
+// GetCtx 获取默认命名空间的配置，缓存未命中时按 ctx 的截止时间和取消请求服务端
+func (c *Client) GetCtx(ctx context.Context, key string) (string, error) {
+	return c.GetInCtx(ctx, c.opts.NamespaceID, key)
+}
+
+// GetInCtx 获取指定命名空间的配置，缓存未命中时按 ctx 的截止时间和取消请求服务端
+// 请求因 ctx 超时或取消而失败时与其他失败一致：有降级配置时返回降级配置，否则返回错误
+// 启用占位符解析时返回解析后的值，占位符无法解析时返回错误
+func (c *Client) GetInCtx(ctx context.Context, namespaceID int, key string) (string, error) {
+	value, err := c.getInCtx(ctx, namespaceID, key)
+	if err != nil {
+		return "", err
+	}
+	return c.resolvePlaceholders(namespaceID, key, value)
+}
+
+// getInCtx 获取指定命名空间的配置原值（不解析占位符）
+func (c *Client) getInCtx(ctx context.Context, namespaceID int, key string) (string, error) {
+	namespace, err := c.namespace(namespaceID)
+	if err != nil {
+		return "", err
+	}
+
 	// 先从缓存获取
-	if c.opts.EnableCache {
-		if value, ok := c.cache.Get(key); ok {
+	cache := c.cacheOf(namespaceID)
+	if cache != nil {
+		if value, ok := cache.Get(key); ok {
 			return value, nil
 		}
 	}
 
 	// 缓存未命中，从服务器获取
-	config, err := c.httpClient.GetConfigByKey(c.opts.NamespaceID, key)
+	config, err := c.httpClient.GetConfigByKeyCtx(ctx, namespaceID, namespace.Environment, key)
 	if err != nil {
 		// 尝试使用降级配置
-		if fallbackValue, ok := c.opts.Fallback[key]; ok {
+		if fallbackValue, ok := namespace.Fallback[key]; ok {
 			return fallbackValue, nil
 		}
 		return "", fmt.Errorf("获取配置失败: %w", err)
 	}
 
 	// 更新缓存(使用MD5作为版本号)
-	if c.opts.EnableCache {
-		c.cache.Set(key, config.Value, computeVersion(config.Value))
+	if cache != nil {
+		cache.Set(key, config.Value, computeVersion(config.Value))
+		c.saveSnapshot()
 	}
 
 	return config.Value, nil
 }
 
-// GetAll 获取所有配置
+// GetMany 批量获取默认命名空间的配置
+func (c *Client) GetMany(keys []string) (map[string]string, error) {
+	return c.GetManyIn(c.opts.NamespaceID, keys)
+}
+
+// GetManyIn 批量获取指定命名空间的配置
+// 缓存未命中的键通过一次批量请求获取；服务端不存在的键使用降级配置，仍无值的键不在结果中
+func (c *Client) GetManyIn(namespaceID int, keys []string) (map[string]string, error) {
+	return c.GetManyInCtx(context.Background(), namespaceID, keys)
+}
+
+// GetManyCtx 批量获取默认命名空间的配置，缓存未命中时按 ctx 的截止时间和取消请求服务端
+func (c *Client) GetManyCtx(ctx context.Context, keys []string) (map[string]string, error) {
+	return c.GetManyInCtx(ctx, c.opts.NamespaceID, keys)
+}
+
+// GetManyInCtx 批量获取指定命名空间的配置，缓存未命中时按 ctx 的截止时间和取消请求服务端
+// 启用占位符解析时返回解析后的值，占位符无法解析的键不在结果中，并返回第一个解析错误
+func (c *Client) GetManyInCtx(ctx context.Context, namespaceID int, keys []string) (map[string]string, error) {
+	result, err := c.getManyInCtx(ctx, namespaceID, keys)
+	if !c.opts.ResolvePlaceholders {
+		return result, err
+	}
+	for key, value := range result {
+		resolved, resolveErr := c.resolvePlaceholders(namespaceID, key, value)
+		if resolveErr != nil {
+			delete(result, key)
+			if err == nil {
+				err = resolveErr
+			}
+			continue
+		}
+		result[key] = resolved
+	}
+	return result, err
+}
+
+// getManyInCtx 批量获取指定命名空间的配置原值（不解析占位符）
+func (c *Client) getManyInCtx(ctx context.Context, namespaceID int, keys []string) (map[string]string, error) {
+	namespace, err := c.namespace(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]string, len(keys))
+
+	// 先从缓存获取
+	cache := c.cacheOf(namespaceID)
+	pending := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if cache != nil {
+			if value, ok := cache.Get(key); ok {
+				result[key] = value
+				continue
+			}
+		}
+		pending = append(pending, key)
+	}
+	if len(pending) == 0 {
+		return result, nil
+	}
+
+	// 缓存未命中，一次请求从服务器获取
+	bulk, err := c.httpClient.GetConfigsByKeysCtx(ctx, namespaceID, namespace.Environment, pending)
+	if err != nil {
+		// 尝试使用降级配置
+		fallbackCount := 0
+		for _, key := range pending {
+			if fallbackValue, ok := namespace.Fallback[key]; ok {
+				result[key] = fallbackValue
+				fallbackCount++
+			}
+		}
+		if fallbackCount == len(pending) {
+			return result, nil
+		}
+		return result, fmt.Errorf("批量获取配置失败: %w", err)
+	}
+
+	for _, config := range bulk.Items {
+		result[config.Key] = config.Value
+		// 更新缓存(使用MD5作为版本号)
+		if cache != nil {
+			cache.Set(config.Key, config.Value, computeVersion(config.Value))
+		}
+	}
+	for _, key := range bulk.Missing {
+		if fallbackValue, ok := namespace.Fallback[key]; ok {
+			result[key] = fallbackValue
+		}
+	}
+	if cache != nil && len(bulk.Items) > 0 {
+		c.saveSnapshot()
+	}
+
+	return result, nil
+}
+
+// GetAll 获取默认命名空间的所有配置
 func (c *Client) GetAll() map[string]string {
-	if c.opts.EnableCache {
-		return c.cache.GetAll()
+	return c.GetAllIn(c.opts.NamespaceID)
+}
+
+// GetAllIn 获取指定命名空间的所有配置（命名空间未注册时返回空结果）
+// 启用占位符解析时返回解析后的值，占位符无法解析的配置保留原值
+func (c *Client) GetAllIn(namespaceID int) map[string]string {
+	return c.resolveAll(namespaceID, c.getAllIn(namespaceID))
+}
+
+// getAllIn 获取指定命名空间的所有配置原值（不解析占位符）
+func (c *Client) getAllIn(namespaceID int) map[string]string {
+	namespace, err := c.namespace(namespaceID)
+	if err != nil {
+		return make(map[string]string)
+	}
+	if cache := c.cacheOf(namespaceID); cache != nil {
+		return cache.GetAll()
 	}
 
-	configs, err := c.httpClient.GetConfigsByNamespace(c.opts.NamespaceID)
+	configs, err := c.httpClient.GetConfigsByNamespace(namespaceID, namespace.Environment)
 	if err != nil {
 		return make(map[string]string)
 	}
@@ -416,13 +1040,18 @@ func (c *Client) GetAll() map[string]string {
 	return result
 }
 
-// GetByPrefix 根据前缀获取配置
+// GetByPrefix 根据前缀获取默认命名空间的配置
 func (c *Client) GetByPrefix(prefix string) map[string]string {
-	if c.opts.EnableCache {
-		return c.cache.GetByPrefix(prefix)
+	return c.GetByPrefixIn(c.opts.NamespaceID, prefix)
+}
+
+// GetByPrefixIn 根据前缀获取指定命名空间的配置（占位符的处理与 GetAllIn 一致）
+func (c *Client) GetByPrefixIn(namespaceID int, prefix string) map[string]string {
+	if cache := c.cacheOf(namespaceID); cache != nil {
+		return c.resolveAll(namespaceID, cache.GetByPrefix(prefix))
 	}
 
-	allConfigs := c.GetAll()
+	allConfigs := c.GetAllIn(namespaceID)
 	result := make(map[string]string)
 	for key, value := range allConfigs {
 		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
@@ -433,98 +1062,261 @@ func (c *Client) GetByPrefix(prefix string) map[string]string {
 	return result
 }
 
-// Watch 监听配置变更
-func (c *Client) Watch(key string, callback ChangeCallback) error {
+// resolveAll 解析配置集合中的占位符，无法解析的配置保留原值
+func (c *Client) resolveAll(namespaceID int, configs map[string]string) map[string]string {
+	if !c.opts.ResolvePlaceholders {
+		return configs
+	}
+	for key, value := range configs {
+		configs[key] = c.resolvePlaceholdersOrRaw(namespaceID, key, value)
+	}
+	return configs
+}
+
+// Watch 监听默认命名空间的配置变更
+func (c *Client) Watch(key string, callback ChangeCallback, opts ...WatchOption) error {
+	return c.WatchIn(c.opts.NamespaceID, key, callback, opts...)
+}
+
+// WatchIn 监听指定命名空间的配置变更
+func (c *Client) WatchIn(namespaceID int, key string, callback ChangeCallback, opts ...WatchOption) error {
+	return c.WatchEventIn(namespaceID, key, callback.eventCallback(), opts...)
+}
+
+// WatchEvent 监听默认命名空间的配置变更，回调携带旧值、新值、版本号和变更类型
+func (c *Client) WatchEvent(key string, callback ChangeEventCallback, opts ...WatchOption) error {
+	return c.WatchEventIn(c.opts.NamespaceID, key, callback, opts...)
+}
+
+// WatchEventIn 监听指定命名空间的配置变更，回调携带旧值、新值、版本号和变更类型
+// 可通过 WithDebounce 合并连续的变更、WithDedupe 跳过重复版本的通知，选项只作用于本次注册的回调
+func (c *Client) WatchEventIn(namespaceID int, key string, callback ChangeEventCallback, opts ...WatchOption) error {
+	namespace, err := c.namespace(namespaceID)
+	if err != nil {
+		return err
+	}
+
 	c.mu.Lock()
-	c.callbacks[key] = append(c.callbacks[key], callback)
+	callbackKey := formatCallbackKey(namespaceID, key)
+	c.callbacks[callbackKey] = append(c.callbacks[callbackKey], newWatchCallback(callback, opts, c.stopped))
 	c.mu.Unlock()
 
 	if c.watcher != nil {
-		return c.watcher.Watch([]string{key}, c.handleConfigChange)
+		return c.watcher.Watch(namespace, []string{key}, c.handleConfigChange)
 	}
 
 	return nil
 }
 
-// GetAndWatch 获取配置并监听变更
-func (c *Client) GetAndWatch(key string, callback ChangeCallback) error {
+// GetAndWatch 获取默认命名空间的配置并监听变更
+func (c *Client) GetAndWatch(key string, callback ChangeCallback, opts ...WatchOption) error {
+	return c.GetAndWatchIn(c.opts.NamespaceID, key, callback, opts...)
+}
+
+// GetAndWatchIn 获取指定命名空间的配置并监听变更
+func (c *Client) GetAndWatchIn(namespaceID int, key string, callback ChangeCallback, opts ...WatchOption) error {
+	return c.GetAndWatchInCtx(context.Background(), namespaceID, key, callback, opts...)
+}
+
+// GetAndWatchCtx 获取默认命名空间的配置并监听变更，获取当前值的请求按 ctx 的截止时间和取消
+func (c *Client) GetAndWatchCtx(ctx context.Context, key string, callback ChangeCallback, opts ...WatchOption) error {
+	return c.GetAndWatchInCtx(ctx, c.opts.NamespaceID, key, callback, opts...)
+}
+
+// GetAndWatchInCtx 获取指定命名空间的配置并监听变更，获取当前值的请求按 ctx 的截止时间和取消（不影响之后的监听）
+func (c *Client) GetAndWatchInCtx(ctx context.Context, namespaceID int, key string, callback ChangeCallback, opts ...WatchOption) error {
+	namespace, err := c.namespace(namespaceID)
+	if err != nil {
+		return err
+	}
+
 	// 先从服务器获取最新配置和版本
-	config, err := c.httpClient.GetConfigByKey(c.opts.NamespaceID, key)
+	config, err := c.httpClient.GetConfigByKeyCtx(ctx, namespaceID, namespace.Environment, key)
 	value := ""
 
 	if err == nil && config != nil {
 		value = config.Value
 
 		// 更新缓存(使用MD5作为版本号)
-		if c.opts.EnableCache {
-			c.cache.Set(key, value, computeVersion(value))
+		if cache := c.cacheOf(namespaceID); cache != nil {
+			cache.Set(key, value, computeVersion(value))
+			c.saveSnapshot()
 		}
 	} else {
 		// 获取失败,尝试从缓存或降级配置获取
-		value, _ = c.Get(key)
+		value, _ = c.getInCtx(ctx, namespaceID, key)
 	}
 
 	// 立即调用一次回调
-	callback(key, value)
+	callback(key, c.resolvePlaceholdersOrRaw(namespaceID, key, value))
 
 	// 注册监听
-	return c.Watch(key, callback)
+	return c.WatchIn(namespaceID, key, callback, opts...)
 }
 
-// Unwatch 取消监听
+// Unwatch 取消监听默认命名空间的配置
 func (c *Client) Unwatch(key string) error {
+	return c.UnwatchIn(c.opts.NamespaceID, key)
+}
+
+// UnwatchIn 取消监听指定命名空间的配置
+func (c *Client) UnwatchIn(namespaceID int, key string) error {
+	namespace, err := c.namespace(namespaceID)
+	if err != nil {
+		return err
+	}
+
 	c.mu.Lock()
-	delete(c.callbacks, key)
+	delete(c.callbacks, formatCallbackKey(namespaceID, key))
 	c.mu.Unlock()
 
 	if c.watcher != nil {
-		return c.watcher.Unwatch([]string{key})
+		return c.watcher.Unwatch(namespace, []string{key})
 	}
 
 	return nil
 }
 
-// handleConfigChange 处理配置变更事件
-func (c *Client) handleConfigChange(key, value, version string) {
-	if c.opts.EnableCache {
-		c.cache.Set(key, value, version)
+// handleConfigChange 处理配置变更事件：更新缓存后回调该配置的监听，以及匹配的前缀和通配符监听
+func (c *Client) handleConfigChange(event *listener.ConfigChangeEvent) {
+	event, ok := c.decryptChange(event)
+	if !ok {
+		return
 	}
+	changeEvent := c.applyChange(event)
 
 	c.mu.RLock()
-	callbacks := c.callbacks[key]
+	callbacks := append([]ChangeEventCallback(nil), c.callbacks[formatCallbackKey(event.NamespaceID, event.ConfigKey)]...)
+	for prefix, watches := range c.prefixWatches[event.NamespaceID] {
+		if !strings.HasPrefix(event.ConfigKey, prefix) {
+			continue
+		}
+		for _, watch := range watches {
+			if watch.matches(event.ConfigKey) {
+				callbacks = append(callbacks, watch.callback)
+			}
+		}
+	}
 	c.mu.RUnlock()
 
 	for _, callback := range callbacks {
-		go callback(key, value)
+		go callback(changeEvent)
+	}
+}
+
+// decryptChange 解密变更事件中加密配置的值（返回副本，事件可能被多个回调共享）
+// 解密失败时丢弃该变更并从缓存中移除该配置，下次读取时重新查询
+func (c *Client) decryptChange(event *listener.ConfigChangeEvent) (*listener.ConfigChangeEvent, bool) {
+	decrypter := c.opts.Decrypter
+	if decrypter == nil || event.Action == listener.EventTypeDelete || !isEncrypted(event.ValueType, false, event.Value) {
+		return event, true
+	}
+
+	plaintext, err := decryptValue(decrypter, event.ConfigKey, event.Value)
+	if err != nil {
+		if cache := c.cacheOf(event.NamespaceID); cache != nil {
+			cache.Delete(event.ConfigKey)
+		}
+		return event, false
 	}
+	decrypted := *event
+	decrypted.Value = plaintext
+	return &decrypted, true
 }
 
-// Refresh 刷新指定配置
+// applyChange 将配置变更写入缓存（版本为空表示配置已删除，或事件未携带值，如 Redis 通知查询最新值失败，从缓存中移除，下次读取时重新查询），
+// 保存本地快照，并生成携带旧值的变更事件
+func (c *Client) applyChange(event *listener.ConfigChangeEvent) *ChangeEvent {
+	changeEvent := &ChangeEvent{
+		NamespaceID: event.NamespaceID,
+		Key:         event.ConfigKey,
+		NewValue:    event.Value,
+		Version:     event.Version,
+		Type:        ChangeTypeUpdate,
+		Timestamp:   event.Timestamp,
+	}
+	switch event.Action {
+	case listener.EventTypeCreate:
+		changeEvent.Type = ChangeTypeCreate
+	case listener.EventTypeDelete:
+		changeEvent.Type = ChangeTypeDelete
+		changeEvent.NewValue = ""
+		changeEvent.Version = ""
+	}
+
+	if cache := c.cacheOf(event.NamespaceID); cache != nil {
+		changeEvent.OldValue, _ = cache.Get(event.ConfigKey)
+		changeEvent.OldValue = c.resolvePlaceholdersOrRaw(event.NamespaceID, event.ConfigKey, changeEvent.OldValue)
+		if event.Version == "" || changeEvent.Type == ChangeTypeDelete {
+			cache.Delete(event.ConfigKey)
+		} else {
+			cache.Set(event.ConfigKey, event.Value, event.Version)
+		}
+		c.saveSnapshot()
+	}
+	changeEvent.NewValue = c.resolvePlaceholdersOrRaw(event.NamespaceID, event.ConfigKey, changeEvent.NewValue)
+
+	return changeEvent
+}
+
+// Refresh 刷新默认命名空间的指定配置
 func (c *Client) Refresh(key string) error {
-	config, err := c.httpClient.GetConfigByKey(c.opts.NamespaceID, key)
+	return c.RefreshIn(c.opts.NamespaceID, key)
+}
+
+// RefreshIn 刷新指定命名空间的指定配置
+// 熔断期间不请求服务端，返回 ErrCircuitOpen，缓存中的配置保持不变
+func (c *Client) RefreshIn(namespaceID int, key string) error {
+	return c.RefreshInCtx(context.Background(), namespaceID, key)
+}
+
+// RefreshCtx 刷新默认命名空间的指定配置，请求按 ctx 的截止时间和取消
+func (c *Client) RefreshCtx(ctx context.Context, key string) error {
+	return c.RefreshInCtx(ctx, c.opts.NamespaceID, key)
+}
+
+// RefreshInCtx 刷新指定命名空间的指定配置，请求按 ctx 的截止时间和取消（失败时缓存中的配置保持不变）
+func (c *Client) RefreshInCtx(ctx context.Context, namespaceID int, key string) error {
+	namespace, err := c.namespace(namespaceID)
+	if err != nil {
+		return err
+	}
+
+	config, err := c.httpClient.GetConfigByKeyCtx(ctx, namespaceID, namespace.Environment, key)
 	if err != nil {
 		return err
 	}
 
-	if c.opts.EnableCache {
-		c.cache.Set(key, config.Value, computeVersion(config.Value))
+	if cache := c.cacheOf(namespaceID); cache != nil {
+		cache.Set(key, config.Value, computeVersion(config.Value))
+		c.saveSnapshot()
 	}
 
 	return nil
 }
 
-// RefreshAll 刷新所有配置
+// RefreshAll 刷新所有命名空间的配置
 func (c *Client) RefreshAll() error {
-	return c.fetchAllConfigs()
+	return c.RefreshAllCtx(context.Background())
 }
 
-// Has 检查配置是否存在
+// RefreshAllCtx 刷新所有命名空间的配置，请求按 ctx 的截止时间和取消
+func (c *Client) RefreshAllCtx(ctx context.Context) error {
+	return c.fetchAllConfigs(ctx)
+}
+
+// Has 检查默认命名空间的配置是否存在
 func (c *Client) Has(key string) bool {
-	if c.opts.EnableCache {
-		return c.cache.Has(key)
+	return c.HasIn(c.opts.NamespaceID, key)
+}
+
+// HasIn 检查指定命名空间的配置是否存在
+func (c *Client) HasIn(namespaceID int, key string) bool {
+	if cache := c.cacheOf(namespaceID); cache != nil {
+		return cache.Has(key)
 	}
 
-	_, err := c.Get(key)
+	_, err := c.GetIn(namespaceID, key)
 	return err == nil
 }
 
@@ -536,11 +1328,30 @@ func (c *Client) IsRunning() bool {
 	return false
 }
 
+// Status 监听器状态（用于健康检查和监控）：连接状态、最近一次成功的时间、连续失败次数和重连次数
+// 未创建监听器时状态为 WatcherStopped
+func (c *Client) Status() WatcherStatus {
+	if c.watcher != nil {
+		return c.watcher.Status()
+	}
+	return WatcherStatus{State: WatcherStopped}
+}
+
+// CircuitBreakerStatus 读取配置的熔断器状态（用于健康检查和监控，未启用熔断时 Enabled 为 false）
+func (c *Client) CircuitBreakerStatus() CircuitBreakerStatus {
+	return c.httpClient.CircuitBreakerStatus()
+}
+
 // GetOptions 获取配置选项
 func (c *Client) GetOptions() *Options {
 	return c.opts
 }
 
+// formatCallbackKey 格式化回调键
+func formatCallbackKey(namespaceID int, key string) string {
+	return fmt.Sprintf("%d:%s", namespaceID, key)
+}
+
 // computeVersion 计算配置值的MD5版本号
 func computeVersion(content string) string {
 	hash := md5.Sum([]byte(content))
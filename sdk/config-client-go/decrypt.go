@@ -0,0 +1,135 @@
+package configsdk
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ErrDecryptFailed 加密配置值解密失败
+var ErrDecryptFailed = errors.New("配置值解密失败")
+
+// ValueTypeEncrypted 加密配置的值类型（与服务端一致）
+const ValueTypeEncrypted = "encrypted"
+
+// DefaultEncryptionKeyEnv 内置 AES-GCM 解密器默认读取密钥的环境变量
+const DefaultEncryptionKeyEnv = "CONFIG_ENCRYPTION_KEY"
+
+// Decrypter 配置值解密器
+// 设置后，值类型为 encrypted 或被服务端标记为敏感的配置在写入缓存前解密，缓存、回调和绑定中均为明文
+type Decrypter interface {
+	// Decrypt 解密配置值，key 为配置键（可用于按配置选择密钥）
+	Decrypt(key, ciphertext string) (string, error)
+}
+
+// DecrypterFunc 函数形式的 Decrypter
+type DecrypterFunc func(key, ciphertext string) (string, error)
+
+// Decrypt 解密配置值
+func (f DecrypterFunc) Decrypt(key, ciphertext string) (string, error) {
+	return f(key, ciphertext)
+}
+
+// AESGCMDecrypter 内置的 AES-GCM 解密器
+// 密文格式与服务端加密配置一致：base64(nonce + ciphertext)，nonce 为 12 字节
+type AESGCMDecrypter struct {
+	aead cipher.AEAD
+}
+
+// NewAESGCMDecrypter 创建 AES-GCM 解密器（key 长度为 16、24 或 32 字节，分别对应 AES-128、AES-192、AES-256）
+func NewAESGCMDecrypter(key []byte) (*AESGCMDecrypter, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("创建 AES 密钥失败: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("创建 GCM 失败: %w", err)
+	}
+	return &AESGCMDecrypter{aead: aead}, nil
+}
+
+// NewAESGCMDecrypterFromEnv 使用环境变量中 base64 编码的密钥创建 AES-GCM 解密器（name 为空时读取 CONFIG_ENCRYPTION_KEY）
+func NewAESGCMDecrypterFromEnv(name string) (*AESGCMDecrypter, error) {
+	if name == "" {
+		name = DefaultEncryptionKeyEnv
+	}
+	encoded := strings.TrimSpace(os.Getenv(name))
+	if encoded == "" {
+		return nil, fmt.Errorf("环境变量 %s 未设置", name)
+	}
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("环境变量 %s 不是有效的 base64 编码: %w", name, err)
+	}
+	return NewAESGCMDecrypter(key)
+}
+
+// Decrypt 解密配置值
+func (d *AESGCMDecrypter) Decrypt(_ string, ciphertext string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("密文不是有效的 base64 编码: %w", err)
+	}
+	nonceSize := d.aead.NonceSize()
+	if len(data) < nonceSize {
+		return "", errors.New("密文数据长度不足")
+	}
+	plaintext, err := d.aead.Open(nil, data[:nonceSize], data[nonceSize:], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// Encrypt 加密配置值（用于生成写入配置中心的密文，格式与 Decrypt 对应）
+func (d *AESGCMDecrypter) Encrypt(plaintext string) (string, error) {
+	nonce := make([]byte, d.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(d.aead.Seal(nonce, nonce, []byte(plaintext), nil)), nil
+}
+
+// isEncrypted 配置是否需要解密：值类型为 encrypted 或被服务端标记为敏感，且值不为空
+func isEncrypted(valueType string, sensitive bool, value string) bool {
+	return value != "" && (valueType == ValueTypeEncrypted || sensitive)
+}
+
+// decryptValue 使用解密器解密配置值，失败时返回 ErrDecryptFailed
+func decryptValue(decrypter Decrypter, key, value string) (string, error) {
+	plaintext, err := decrypter.Decrypt(key, value)
+	if err != nil {
+		return "", fmt.Errorf("%w: key=%s: %v", ErrDecryptFailed, key, err)
+	}
+	return plaintext, nil
+}
+
+// decrypt 解密加密配置的值（未设置解密器时不处理）
+// 服务端已脱敏的值无法解密，返回 ErrDecryptFailed（服务端需关闭脱敏，由客户端解密）
+func (c *HTTPClient) decrypt(configs []ConfigVO) error {
+	if c.decrypter == nil {
+		return nil
+	}
+	for i := range configs {
+		config := &configs[i]
+		if !isEncrypted(config.ValueType, config.IsSensitive, config.Value) {
+			continue
+		}
+		if config.IsMasked {
+			return fmt.Errorf("%w: key=%s: 服务端返回了脱敏值", ErrDecryptFailed, config.Key)
+		}
+		plaintext, err := decryptValue(c.decrypter, config.Key, config.Value)
+		if err != nil {
+			return err
+		}
+		config.Value = plaintext
+	}
+	return nil
+}
@@ -1,9 +1,14 @@
 package configsdk
 
 import (
+	"crypto/ed25519"
 	"time"
 
+	"config-client/share/config-client/auth"
+
 	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // WatcherType 监听器类型
@@ -14,8 +19,25 @@ const (
 	WatcherTypeHTTP WatcherType = "http"
 	// WatcherTypeRedis Redis 订阅模式
 	WatcherTypeRedis WatcherType = "redis"
+	// WatcherTypeWebSocket WebSocket 长连接模式
+	WatcherTypeWebSocket WatcherType = "websocket"
 )
 
+// Namespace 客户端注册的命名空间
+type Namespace struct {
+	// ID 命名空间 ID
+	ID int
+
+	// Name 命名空间名称
+	Name string
+
+	// Environment 环境（为空时使用 Options.Environment）
+	Environment string
+
+	// Fallback 降级配置（网络故障时使用）
+	Fallback map[string]string
+}
+
 // Options SDK 配置选项
 type Options struct {
 	// ServerURL 配置中心服务地址（必填）
@@ -27,15 +49,28 @@ type Options struct {
 	// Namespace 命名空间名称（默认: "default"）
 	Namespace string
 
+	// Environment 读取和监听配置的环境（默认: "default"）
+	Environment string
+
+	// Namespaces 默认命名空间之外注册的其他命名空间（通过 GetIn、WatchIn 等方法按命名空间 ID 访问）
+	Namespaces []Namespace
+
 	// WatcherType 监听器类型（默认: HTTP）
 	WatcherType WatcherType
 
-	// RedisClient Redis 客户端（Redis 模式需要）
-	RedisClient *redis.Client
+	// RedisClient Redis 客户端（Redis 模式需要，支持单机、哨兵和集群客户端）
+	RedisClient redis.UniversalClient
 
 	// PollingTimeout 长轮询超时时间（默认: 60s）
 	PollingTimeout time.Duration
 
+	// HeartbeatInterval HTTP 和 WebSocket 模式下的心跳间隔（默认: 30s，<=0 时不发送心跳）
+	// 心跳在后台定期发送（长轮询期间同样发送），间隔超过服务端心跳超时阈值的 1/3 时按阈值的 1/3 发送
+	HeartbeatInterval time.Duration
+
+	// PingInterval WebSocket 模式下发送 ping 的间隔（默认: 30s），超过 3 倍间隔未收到服务端的任何帧时重连
+	PingInterval time.Duration
+
 	// AutoStart 是否自动启动监听器（默认: true）
 	AutoStart bool
 
@@ -45,8 +80,46 @@ type Options struct {
 	// FetchOnInit 初始化时是否拉取所有配置（默认: true）
 	FetchOnInit bool
 
-	// Fallback 降级配置（网络故障时使用）
+	// Fallback 默认命名空间的降级配置（网络故障时使用）
 	Fallback map[string]string
+
+	// SigningPublicKey 配置值签名公钥（设置后校验服务端返回的签名，校验失败的配置值不会被应用）
+	SigningPublicKey ed25519.PublicKey
+
+	// Credentials 认证信息（服务端启用认证时需要，读取和长轮询请求均携带）
+	Credentials *auth.Credentials
+
+	// RetryPolicy 重试策略（默认: DefaultRetryPolicy()）
+	// 读取请求出现网络错误或返回可重试的状态码时按指数退避重试；长轮询失败和 Redis 连接断开后按退避时间等待，不限次数
+	RetryPolicy *RetryPolicy
+
+	// CircuitBreaker 熔断器配置（默认: DefaultCircuitBreakerConfig()，nil 表示不熔断）
+	// 读取请求连续失败后熔断，熔断期间读取直接使用缓存或降级配置，不再等待超时；长轮询不经过熔断器
+	CircuitBreaker *CircuitBreakerConfig
+
+	// TracerProvider 链路追踪的 TracerProvider（默认: OTel 全局 TracerProvider）
+	// 读取、长轮询和心跳请求均创建客户端 span
+	TracerProvider trace.TracerProvider
+
+	// Propagator 链路上下文传播器（默认: OTel 全局传播器），用于在请求头中向服务端传递链路上下文
+	Propagator propagation.TextMapPropagator
+
+	// SnapshotFile 本地快照文件路径（为空时不保存快照，需启用缓存）
+	// 每次从服务端成功获取配置后保存缓存中的配置，启动时服务端不可用则从快照加载配置
+	SnapshotFile string
+
+	// ResolvePlaceholders 是否在客户端解析配置值中的占位符（默认: false）
+	// 用于服务端未启用配置引用解析的部署：${key} 引用同一命名空间的配置，${namespace:key} 引用已注册的其他命名空间，
+	// 被引用的配置从本地缓存读取（未缓存时使用降级配置），$${...} 输出字面量 ${...}
+	ResolvePlaceholders bool
+
+	// Decrypter 配置值解密器（为空时不解密）
+	// 值类型为 encrypted 或被服务端标记为敏感的配置在写入缓存前解密，服务端需关闭脱敏以返回密文
+	Decrypter Decrypter
+
+	// OnWatcherStatusChange 监听器连接状态变化回调（为空时不回调），用于连接断开告警
+	// 在监听循环中同步调用，不应阻塞；from 为变化前的状态，status 为变化后的状态
+	OnWatcherStatusChange func(from WatcherState, status WatcherStatus)
 }
 
 // Option 配置选项函数
@@ -55,15 +128,20 @@ type Option func(*Options)
 // DefaultOptions 默认配置
 func DefaultOptions() *Options {
 	return &Options{
-		ServerURL:      "http://localhost:8080",
-		NamespaceID:    1,
-		Namespace:      "default",
-		WatcherType:    WatcherTypeHTTP,
-		PollingTimeout: 60 * time.Second,
-		AutoStart:      true,
-		EnableCache:    true,
-		FetchOnInit:    true,
-		Fallback:       make(map[string]string),
+		ServerURL:         "http://localhost:8080",
+		NamespaceID:       1,
+		Namespace:         "default",
+		Environment:       "default",
+		WatcherType:       WatcherTypeHTTP,
+		PollingTimeout:    60 * time.Second,
+		HeartbeatInterval: 30 * time.Second,
+		PingInterval:      30 * time.Second,
+		AutoStart:         true,
+		EnableCache:       true,
+		FetchOnInit:       true,
+		Fallback:          make(map[string]string),
+		RetryPolicy:       DefaultRetryPolicy(),
+		CircuitBreaker:    DefaultCircuitBreakerConfig(),
 	}
 }
 
@@ -88,6 +166,21 @@ func WithNamespaceID(id int) Option {
 	}
 }
 
+// WithEnvironment 设置读取和监听配置的环境（如 dev、test、prod）
+// 读取、批量读取、拉取全部配置和长轮询请求均携带该环境，未单独设置环境的命名空间使用该环境
+func WithEnvironment(environment string) Option {
+	return func(o *Options) {
+		o.Environment = environment
+	}
+}
+
+// WithNamespaces 注册默认命名空间之外的其他命名空间
+func WithNamespaces(namespaces ...Namespace) Option {
+	return func(o *Options) {
+		o.Namespaces = append(o.Namespaces, namespaces...)
+	}
+}
+
 // WithHTTPWatcher 使用 HTTP 长轮询监听器
 func WithHTTPWatcher(timeout time.Duration) Option {
 	return func(o *Options) {
@@ -98,8 +191,28 @@ func WithHTTPWatcher(timeout time.Duration) Option {
 	}
 }
 
+// WithWebSocketWatcher 使用 WebSocket 监听器（需要服务端支持 WebSocket 监听接口）
+// 在一个长连接上持续监听，省去长轮询每次请求的连接和认证开销；连接断开后按重试策略退避重连并重新订阅，
+// pingInterval 为发送 ping 的间隔（<=0 时使用默认的 30s）
+func WithWebSocketWatcher(pingInterval time.Duration) Option {
+	return func(o *Options) {
+		o.WatcherType = WatcherTypeWebSocket
+		if pingInterval > 0 {
+			o.PingInterval = pingInterval
+		}
+	}
+}
+
+// WithHeartbeatInterval 设置 HTTP 和 WebSocket 模式下的心跳间隔（<=0 时不发送心跳）
+// 设置的间隔为上限，服务端在心跳响应中返回心跳超时阈值后，实际间隔不超过阈值的 1/3
+func WithHeartbeatInterval(interval time.Duration) Option {
+	return func(o *Options) {
+		o.HeartbeatInterval = interval
+	}
+}
+
 // WithRedisWatcher 使用 Redis 订阅监听器
-func WithRedisWatcher(client *redis.Client) Option {
+func WithRedisWatcher(client redis.UniversalClient) Option {
 	return func(o *Options) {
 		o.WatcherType = WatcherTypeRedis
 		o.RedisClient = client
@@ -127,13 +240,104 @@ func WithFetchOnInit(fetch bool) Option {
 	}
 }
 
-// WithFallback 设置降级配置
+// WithFallback 设置默认命名空间的降级配置（其他命名空间通过 Namespace.Fallback 设置）
 func WithFallback(fallback map[string]string) Option {
 	return func(o *Options) {
 		o.Fallback = fallback
 	}
 }
 
+// WithSigningPublicKey 设置配置值签名公钥（服务端需配置 security.signing_private_key）
+// 读取和长轮询返回的配置值签名缺失或校验失败时拒绝应用：读取返回 ErrInvalidSignature（有降级配置时使用降级配置），
+// 长轮询丢弃该变更；Redis 模式的通知不携带配置值，缓存失效后重新读取时校验
+func WithSigningPublicKey(publicKey ed25519.PublicKey) Option {
+	return func(o *Options) {
+		o.SigningPublicKey = publicKey
+	}
+}
+
+// WithAPIKey 使用 API Key 认证（X-API-Key 请求头）
+func WithAPIKey(key string) Option {
+	return func(o *Options) {
+		o.Credentials = auth.APIKey(key)
+	}
+}
+
+// WithBearerToken 使用固定的 Bearer 令牌认证（Authorization 请求头）
+func WithBearerToken(token string) Option {
+	return func(o *Options) {
+		o.Credentials = auth.BearerToken(token)
+	}
+}
+
+// WithTokenProvider 使用令牌提供者认证（Authorization: Bearer），每次请求前获取令牌，用于令牌轮换
+// 获取令牌失败时请求不会发送，读取返回错误（有降级配置时使用降级配置），长轮询按失败重试
+func WithTokenProvider(provider TokenProvider) Option {
+	return func(o *Options) {
+		o.Credentials = auth.NewCredentials(auth.SchemeBearer, provider)
+	}
+}
+
+// WithRetryPolicy 设置重试策略（nil 表示读取请求不重试，长轮询失败后使用默认退避时间）
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(o *Options) {
+		o.RetryPolicy = policy
+	}
+}
+
+// WithCircuitBreaker 设置熔断器配置（nil 表示不熔断）
+func WithCircuitBreaker(cfg *CircuitBreakerConfig) Option {
+	return func(o *Options) {
+		o.CircuitBreaker = cfg
+	}
+}
+
+// WithTracerProvider 设置链路追踪的 TracerProvider（不设置时使用 OTel 全局 TracerProvider）
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(o *Options) {
+		o.TracerProvider = provider
+	}
+}
+
+// WithPropagator 设置链路上下文传播器（不设置时使用 OTel 全局传播器）
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(o *Options) {
+		o.Propagator = propagator
+	}
+}
+
+// WithSnapshotFile 设置本地快照文件路径（需启用缓存）
+// 配置中心不可用时服务可使用快照中最近一次获取的配置启动；快照以明文保存配置值，文件权限为 0600
+func WithSnapshotFile(path string) Option {
+	return func(o *Options) {
+		o.SnapshotFile = path
+	}
+}
+
+// WithPlaceholderResolution 设置是否在客户端解析配置值中的占位符（需启用缓存，被引用的配置需已缓存或有降级配置）
+// 读取时解析，缓存中保存原值；引用不存在、循环引用或嵌套超过 10 层时 Get 返回错误，GetAll、GetByPrefix 和变更事件保留原值
+func WithPlaceholderResolution(enable bool) Option {
+	return func(o *Options) {
+		o.ResolvePlaceholders = enable
+	}
+}
+
+// WithDecrypter 设置配置值解密器，用于端到端加密的配置（密文写入配置中心，仅客户端持有密钥）
+// 读取时解密失败返回 ErrDecryptFailed（有降级配置时使用降级配置），长轮询丢弃该变更
+func WithDecrypter(decrypter Decrypter) Option {
+	return func(o *Options) {
+		o.Decrypter = decrypter
+	}
+}
+
+// WithWatcherStatusCallback 设置监听器连接状态变化回调（连接断开、重连成功、启动和停止时调用）
+// 断开后 status.ConsecutiveFailures 和 status.LastError 记录连续失败次数和最近一次错误，可据此告警
+func WithWatcherStatusCallback(callback func(from WatcherState, status WatcherStatus)) Option {
+	return func(o *Options) {
+		o.OnWatcherStatusChange = callback
+	}
+}
+
 // WithRedisOptions 使用 Redis 选项创建监听器
 func WithRedisOptions(opt *redis.Options) Option {
 	return func(o *Options) {
@@ -141,3 +345,12 @@ func WithRedisOptions(opt *redis.Options) Option {
 		o.RedisClient = redis.NewClient(opt)
 	}
 }
+
+// WithRedisUniversalOptions 使用 Redis 通用选项创建监听器（支持哨兵和集群）
+// MasterName 不为空时为哨兵模式，Addrs 多于一个时为集群模式，否则为单机模式
+func WithRedisUniversalOptions(opt *redis.UniversalOptions) Option {
+	return func(o *Options) {
+		o.WatcherType = WatcherTypeRedis
+		o.RedisClient = redis.NewUniversalClient(opt)
+	}
+}
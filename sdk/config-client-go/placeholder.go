@@ -0,0 +1,123 @@
+package configsdk
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// ErrPlaceholderNotFound 占位符引用的配置在本地缓存和降级配置中均不存在
+	ErrPlaceholderNotFound = errors.New("占位符引用的配置不存在")
+	// ErrPlaceholderCycle 占位符存在循环引用
+	ErrPlaceholderCycle = errors.New("占位符存在循环引用")
+	// ErrPlaceholderTooDeep 占位符嵌套层级超过上限
+	ErrPlaceholderTooDeep = errors.New("占位符嵌套层级过深")
+)
+
+// maxPlaceholderDepth 占位符的最大嵌套层级（与服务端配置引用一致）
+const maxPlaceholderDepth = 10
+
+// placeholderPattern 占位符表达式：${key} 引用同一命名空间的配置，${namespace:key} 引用已注册的其他命名空间（按名称）
+// 以 $$ 开头的表达式视为转义，输出字面量 ${...}
+var placeholderPattern = regexp.MustCompile(`\$?\$\{(?:([a-zA-Z0-9_-]+):)?([a-zA-Z0-9_.-]+)\}`)
+
+// resolvePlaceholders 解析配置值中的占位符（未启用占位符解析或值中没有占位符时原样返回）
+func (c *Client) resolvePlaceholders(namespaceID int, key, value string) (string, error) {
+	if !c.opts.ResolvePlaceholders || !strings.Contains(value, "${") {
+		return value, nil
+	}
+	return c.resolveValue(namespaceID, value, []string{c.placeholderName(namespaceID, key)})
+}
+
+// resolvePlaceholdersOrRaw 解析配置值中的占位符，无法解析时返回原值（用于无法返回错误的读取方法和变更事件）
+func (c *Client) resolvePlaceholdersOrRaw(namespaceID int, key, value string) string {
+	resolved, err := c.resolvePlaceholders(namespaceID, key, value)
+	if err != nil {
+		return value
+	}
+	return resolved
+}
+
+// resolveValue 递归解析配置值中的占位符，chain 为当前解析链（namespace:key），用于检测循环引用
+func (c *Client) resolveValue(namespaceID int, value string, chain []string) (string, error) {
+	if len(chain) > maxPlaceholderDepth {
+		return "", fmt.Errorf("%w: %s", ErrPlaceholderTooDeep, strings.Join(chain, " -> "))
+	}
+
+	var resolveErr error
+	resolved := placeholderPattern.ReplaceAllStringFunc(value, func(match string) string {
+		if resolveErr != nil {
+			return match
+		}
+		// 1. 转义的表达式输出字面量
+		if strings.HasPrefix(match, "$$") {
+			return match[1:]
+		}
+
+		// 2. 确定被引用配置所在的命名空间
+		groups := placeholderPattern.FindStringSubmatch(match)
+		refNamespaceID := namespaceID
+		if groups[1] != "" {
+			id, ok := c.namespaceIDByName(groups[1])
+			if !ok {
+				resolveErr = fmt.Errorf("%w: %s（命名空间未注册）", ErrPlaceholderNotFound, groups[1]+":"+groups[2])
+				return match
+			}
+			refNamespaceID = id
+		}
+
+		// 3. 检测循环引用
+		ref := c.placeholderName(refNamespaceID, groups[2])
+		for _, name := range chain {
+			if name == ref {
+				resolveErr = fmt.Errorf("%w: %s -> %s", ErrPlaceholderCycle, strings.Join(chain, " -> "), ref)
+				return match
+			}
+		}
+
+		// 4. 从本地缓存或降级配置读取被引用的配置，并递归解析
+		refValue, ok := c.lookupPlaceholder(refNamespaceID, groups[2])
+		if !ok {
+			resolveErr = fmt.Errorf("%w: %s", ErrPlaceholderNotFound, ref)
+			return match
+		}
+		refValue, resolveErr = c.resolveValue(refNamespaceID, refValue, append(chain[:len(chain):len(chain)], ref))
+		return refValue
+	})
+	if resolveErr != nil {
+		return "", resolveErr
+	}
+	return resolved, nil
+}
+
+// lookupPlaceholder 读取占位符引用的配置：优先使用本地缓存，未缓存时使用降级配置，不请求服务端
+func (c *Client) lookupPlaceholder(namespaceID int, key string) (string, bool) {
+	if cache := c.cacheOf(namespaceID); cache != nil {
+		if value, ok := cache.Get(key); ok {
+			return value, true
+		}
+	}
+	value, ok := c.namespaces[namespaceID].Fallback[key]
+	return value, ok
+}
+
+// namespaceIDByName 按名称查找已注册的命名空间
+func (c *Client) namespaceIDByName(name string) (int, bool) {
+	for id, namespace := range c.namespaces {
+		if namespace.Name == name {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+// placeholderName 解析链和错误信息中的配置名称（namespace:key，命名空间未设置名称时使用 ID）
+func (c *Client) placeholderName(namespaceID int, key string) string {
+	name := c.namespaces[namespaceID].Name
+	if name == "" {
+		name = fmt.Sprint(namespaceID)
+	}
+	return name + ":" + key
+}
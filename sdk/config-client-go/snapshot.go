@@ -0,0 +1,173 @@
+package configsdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// errSnapshotDisabled 未配置本地快照文件
+var errSnapshotDisabled = errors.New("未配置本地快照文件")
+
+// snapshotFileVersion 快照文件格式版本（2: 按命名空间保存）
+const snapshotFileVersion = 2
+
+// snapshotFile 本地快照文件内容
+type snapshotFile struct {
+	FormatVersion int                 `json:"format_version"` // 快照文件格式版本
+	SavedAt       time.Time           `json:"saved_at"`       // 保存时间
+	Namespaces    []snapshotNamespace `json:"namespaces"`     // 各命名空间的配置
+}
+
+// snapshotNamespace 快照中命名空间的配置
+type snapshotNamespace struct {
+	NamespaceID int                      `json:"namespace_id"` // 命名空间 ID
+	Namespace   string                   `json:"namespace"`    // 命名空间名称
+	Environment string                   `json:"environment"`  // 环境
+	Configs     map[string]snapshotEntry `json:"configs"`      // 配置键 -> 配置
+}
+
+// snapshotEntry 快照中的配置
+type snapshotEntry struct {
+	Value   string `json:"value"`
+	Version string `json:"version"`
+}
+
+// SaveSnapshot 将所有命名空间缓存中的配置写入本地快照文件
+// 每次从服务端成功获取配置或收到变更后会自动保存，一般无需手动调用；未配置快照文件或未启用缓存时不保存
+func (c *Client) SaveSnapshot() error {
+	if c.opts.SnapshotFile == "" || !c.opts.EnableCache {
+		return nil
+	}
+
+	snapshot := &snapshotFile{
+		FormatVersion: snapshotFileVersion,
+		SavedAt:       time.Now(),
+		Namespaces:    make([]snapshotNamespace, 0, len(c.namespaces)),
+	}
+	for namespaceID, namespace := range c.namespaces {
+		cache := c.cacheOf(namespaceID)
+		entry := snapshotNamespace{
+			NamespaceID: namespaceID,
+			Namespace:   namespace.Name,
+			Environment: namespace.Environment,
+			Configs:     make(map[string]snapshotEntry),
+		}
+		cache.mu.RLock()
+		for key, item := range cache.items {
+			entry.Configs[key] = snapshotEntry{Value: item.Value, Version: item.Version}
+		}
+		cache.mu.RUnlock()
+		snapshot.Namespaces = append(snapshot.Namespaces, entry)
+	}
+	sort.Slice(snapshot.Namespaces, func(i, j int) bool {
+		return snapshot.Namespaces[i].NamespaceID < snapshot.Namespaces[j].NamespaceID
+	})
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("序列化本地快照失败: %w", err)
+	}
+
+	c.snapshotMu.Lock()
+	defer c.snapshotMu.Unlock()
+	return writeFileAtomic(c.opts.SnapshotFile, data)
+}
+
+// LoadedFromSnapshot 启动时服务端不可用，是否使用了本地快照中的配置
+func (c *Client) LoadedFromSnapshot() bool {
+	return c.snapshotLoaded
+}
+
+// saveSnapshot 自动保存本地快照（保存失败不影响配置读取，下次获取配置时重试）
+func (c *Client) saveSnapshot() {
+	_ = c.SaveSnapshot()
+}
+
+// loadSnapshot 从本地快照文件加载指定命名空间的配置到缓存
+// 快照中缺少任一命名空间，或命名空间名称、环境不一致时不加载，避免误用其他命名空间或环境的配置
+func (c *Client) loadSnapshot(namespaceIDs []int) error {
+	if c.opts.SnapshotFile == "" || !c.opts.EnableCache {
+		return errSnapshotDisabled
+	}
+
+	data, err := os.ReadFile(c.opts.SnapshotFile)
+	if err != nil {
+		return fmt.Errorf("读取本地快照失败: %w", err)
+	}
+	var snapshot snapshotFile
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return fmt.Errorf("解析本地快照失败: %w", err)
+	}
+	if snapshot.FormatVersion != snapshotFileVersion {
+		return fmt.Errorf("不支持的本地快照格式版本: %d", snapshot.FormatVersion)
+	}
+	saved := make(map[int]snapshotNamespace, len(snapshot.Namespaces))
+	for _, namespace := range snapshot.Namespaces {
+		saved[namespace.NamespaceID] = namespace
+	}
+	for _, namespaceID := range namespaceIDs {
+		namespace, ok := saved[namespaceID]
+		if !ok {
+			return fmt.Errorf("本地快照中没有命名空间: namespace_id=%d", namespaceID)
+		}
+		if namespace.Namespace != c.namespaces[namespaceID].Name || namespace.Environment != c.namespaces[namespaceID].Environment {
+			return fmt.Errorf("本地快照的命名空间不一致: namespace_id=%d, namespace=%s, environment=%s",
+				namespaceID, namespace.Namespace, namespace.Environment)
+		}
+	}
+
+	for _, namespaceID := range namespaceIDs {
+		configs := saved[namespaceID].Configs
+		batch := make(map[string]struct {
+			Value   string
+			Version string
+		}, len(configs))
+		for key, entry := range configs {
+			batch[key] = struct {
+				Value   string
+				Version string
+			}{
+				Value:   entry.Value,
+				Version: entry.Version,
+			}
+		}
+		c.cacheOf(namespaceID).SetBatch(batch)
+	}
+	c.snapshotLoaded = true
+	return nil
+}
+
+// writeFileAtomic 先写入同目录下的临时文件再重命名，进程中途退出时不会留下不完整的快照
+func writeFileAtomic(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("创建快照目录失败: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("创建临时快照文件失败: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("写入快照文件失败: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("写入快照文件失败: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("写入快照文件失败: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("替换快照文件失败: %w", err)
+	}
+	return nil
+}
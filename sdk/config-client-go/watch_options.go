@@ -0,0 +1,143 @@
+package configsdk
+
+import (
+	"sync"
+	"time"
+)
+
+// WatchOption 监听选项（每次监听分别设置，只作用于本次注册的回调）
+type WatchOption func(*watchOptions)
+
+// watchOptions 监听选项
+type watchOptions struct {
+	debounce time.Duration // 防抖的静默时间（为 0 时不防抖）
+	dedupe   bool          // 是否跳过与上一次回调版本相同的变更
+}
+
+// WithDebounce 合并连续的变更：同一配置在 quiet 时间内没有新的变更后才回调，只回调最后一次变更
+// 合并后的事件旧值为第一次变更前的值、新值和版本为最后一次变更的值；先新建后更新的配置变更类型仍为 ChangeTypeCreate
+// 按前缀、通配符或全部配置监听时按配置键分别防抖；quiet<=0 时不防抖
+func WithDebounce(quiet time.Duration) WatchOption {
+	return func(o *watchOptions) {
+		o.debounce = quiet
+	}
+}
+
+// WithDedupe 跳过与上一次回调版本相同的变更（如重连后重复推送、多个通知渠道重复通知同一版本）
+// 连续的删除视为相同的变更；未携带版本的变更（如 Redis 模式获取配置值失败时）不去重
+func WithDedupe() WatchOption {
+	return func(o *watchOptions) {
+		o.dedupe = true
+	}
+}
+
+// watchFilter 按监听选项对变更做防抖和去重后回调
+type watchFilter struct {
+	callback ChangeEventCallback
+	opts     watchOptions
+	stopped  func() bool // 客户端是否已停止（停止后不再回调等待中的变更）
+
+	mu        sync.Mutex
+	delivered map[string]string         // "命名空间ID:配置键" -> 最近一次回调的版本（删除为空）
+	pending   map[string]*pendingChange // "命名空间ID:配置键" -> 等待防抖的变更
+}
+
+// pendingChange 等待防抖的变更
+type pendingChange struct {
+	event *ChangeEvent
+	timer *time.Timer
+}
+
+// newWatchCallback 按监听选项包装回调，未设置选项时返回原回调
+func newWatchCallback(callback ChangeEventCallback, opts []WatchOption, stopped func() bool) ChangeEventCallback {
+	var options watchOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.debounce <= 0 && !options.dedupe {
+		return callback
+	}
+
+	filter := &watchFilter{
+		callback:  callback,
+		opts:      options,
+		stopped:   stopped,
+		delivered: make(map[string]string),
+		pending:   make(map[string]*pendingChange),
+	}
+	return filter.onChange
+}
+
+// onChange 收到变更：需要防抖时合并到等待中的变更并重新计时，否则直接回调
+func (f *watchFilter) onChange(event *ChangeEvent) {
+	if f.opts.debounce <= 0 {
+		f.deliver(event)
+		return
+	}
+
+	key := formatCallbackKey(event.NamespaceID, event.Key)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if p, exists := f.pending[key]; exists {
+		p.event = mergeChange(p.event, event)
+		p.timer.Reset(f.opts.debounce)
+		return
+	}
+	p := &pendingChange{event: event}
+	p.timer = time.AfterFunc(f.opts.debounce, func() { f.flush(key, p) })
+	f.pending[key] = p
+}
+
+// flush 静默时间到期后回调合并的变更（已被新的等待取代时忽略，计时器重置前已触发的情况）
+func (f *watchFilter) flush(key string, p *pendingChange) {
+	f.mu.Lock()
+	if f.pending[key] != p {
+		f.mu.Unlock()
+		return
+	}
+	delete(f.pending, key)
+	event := p.event
+	f.mu.Unlock()
+
+	if f.stopped() {
+		return
+	}
+	f.deliver(event)
+}
+
+// deliver 回调变更（开启去重时跳过与上一次回调版本相同的变更）
+func (f *watchFilter) deliver(event *ChangeEvent) {
+	if f.opts.dedupe {
+		key := formatCallbackKey(event.NamespaceID, event.Key)
+		f.mu.Lock()
+		if event.Version == "" && event.Type != ChangeTypeDelete {
+			// 未携带版本，无法判断是否重复，下一次变更不与之前的版本比较
+			delete(f.delivered, key)
+		} else if last, exists := f.delivered[key]; exists && last == event.Version {
+			f.mu.Unlock()
+			return
+		} else {
+			f.delivered[key] = event.Version
+		}
+		f.mu.Unlock()
+	}
+	f.callback(event)
+}
+
+// mergeChange 合并同一配置的两次变更（返回新的事件，事件可能被多个回调共享，不修改原事件）
+func mergeChange(first, last *ChangeEvent) *ChangeEvent {
+	merged := *last
+	merged.OldValue = first.OldValue
+	if first.Type == ChangeTypeCreate && last.Type == ChangeTypeUpdate {
+		merged.Type = ChangeTypeCreate
+	}
+	return &merged
+}
+
+// stopped 客户端是否已停止
+func (c *Client) stopped() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.ctx != nil && c.ctx.Err() != nil
+}
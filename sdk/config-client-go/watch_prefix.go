@@ -0,0 +1,229 @@
+package configsdk
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"config-client/share/config-client/listener"
+)
+
+// prefixWatch 前缀或通配符监听
+type prefixWatch struct {
+	pattern  string              // 通配符（按前缀监听时为空）
+	callback ChangeEventCallback // 变更回调
+}
+
+// matches 配置键是否匹配监听（按前缀监听时前缀下的配置均匹配）
+func (w prefixWatch) matches(key string) bool {
+	if w.pattern == "" {
+		return true
+	}
+	matched, _ := path.Match(w.pattern, key)
+	return matched
+}
+
+// WatchPrefix 监听默认命名空间中指定前缀下的配置变更（前缀下新建的配置同样回调）
+func (c *Client) WatchPrefix(prefix string, callback ChangeCallback, opts ...WatchOption) error {
+	return c.WatchPrefixIn(c.opts.NamespaceID, prefix, callback, opts...)
+}
+
+// WatchPrefixIn 监听指定命名空间中指定前缀下的配置变更
+// HTTP 模式下服务端按前缀订阅，前缀下新建的配置也会通知；配置删除时回调的值为空
+func (c *Client) WatchPrefixIn(namespaceID int, prefix string, callback ChangeCallback, opts ...WatchOption) error {
+	return c.WatchPrefixEventIn(namespaceID, prefix, callback.eventCallback(), opts...)
+}
+
+// WatchPrefixEvent 监听默认命名空间中指定前缀下的配置变更，回调携带旧值、新值、版本号和变更类型
+func (c *Client) WatchPrefixEvent(prefix string, callback ChangeEventCallback, opts ...WatchOption) error {
+	return c.WatchPrefixEventIn(c.opts.NamespaceID, prefix, callback, opts...)
+}
+
+// WatchPrefixEventIn 监听指定命名空间中指定前缀下的配置变更，回调携带旧值、新值、版本号和变更类型
+// 前缀下新建的配置变更类型为 ChangeTypeCreate
+func (c *Client) WatchPrefixEventIn(namespaceID int, prefix string, callback ChangeEventCallback, opts ...WatchOption) error {
+	if prefix == "" {
+		return errors.New("监听的前缀不能为空（监听全部配置请使用 WatchAll）")
+	}
+	return c.watchPrefix(namespaceID, prefix, prefixWatch{callback: newWatchCallback(callback, opts, c.stopped)})
+}
+
+// WatchPattern 按通配符监听默认命名空间的配置变更（如 "db.*.url"）
+func (c *Client) WatchPattern(pattern string, callback ChangeCallback, opts ...WatchOption) error {
+	return c.WatchPatternIn(c.opts.NamespaceID, pattern, callback, opts...)
+}
+
+// WatchPatternIn 按通配符监听指定命名空间的配置变更
+// 通配符语法与 path.Match 一致（* 匹配任意个非 / 字符，? 匹配单个字符，[...] 匹配字符集）；
+// 向服务端订阅第一个通配符之前的前缀，收到变更后在客户端按通配符过滤，因此通配符不能以通配字符开头
+func (c *Client) WatchPatternIn(namespaceID int, pattern string, callback ChangeCallback, opts ...WatchOption) error {
+	return c.WatchPatternEventIn(namespaceID, pattern, callback.eventCallback(), opts...)
+}
+
+// WatchPatternEvent 按通配符监听默认命名空间的配置变更，回调携带旧值、新值、版本号和变更类型
+func (c *Client) WatchPatternEvent(pattern string, callback ChangeEventCallback, opts ...WatchOption) error {
+	return c.WatchPatternEventIn(c.opts.NamespaceID, pattern, callback, opts...)
+}
+
+// WatchPatternEventIn 按通配符监听指定命名空间的配置变更，回调携带旧值、新值、版本号和变更类型
+func (c *Client) WatchPatternEventIn(namespaceID int, pattern string, callback ChangeEventCallback, opts ...WatchOption) error {
+	prefix, err := patternPrefix(pattern)
+	if err != nil {
+		return err
+	}
+	return c.watchPrefix(namespaceID, prefix, prefixWatch{pattern: pattern, callback: newWatchCallback(callback, opts, c.stopped)})
+}
+
+// WatchAll 监听默认命名空间下全部配置的变更（新建的配置同样回调，配置删除时回调的值为空）
+func (c *Client) WatchAll(callback ChangeCallback, opts ...WatchOption) error {
+	return c.WatchAllIn(c.opts.NamespaceID, callback, opts...)
+}
+
+// WatchAllIn 监听指定命名空间下全部配置的变更
+// 以空前缀向服务端订阅，无需预先知道配置键：新建的配置自动加入监听，删除的配置回调 ChangeTypeDelete 后移除
+func (c *Client) WatchAllIn(namespaceID int, callback ChangeCallback, opts ...WatchOption) error {
+	return c.WatchAllEventIn(namespaceID, callback.eventCallback(), opts...)
+}
+
+// WatchAllEvent 监听默认命名空间下全部配置的变更，回调携带旧值、新值、版本号和变更类型
+func (c *Client) WatchAllEvent(callback ChangeEventCallback, opts ...WatchOption) error {
+	return c.WatchAllEventIn(c.opts.NamespaceID, callback, opts...)
+}
+
+// WatchAllEventIn 监听指定命名空间下全部配置的变更，回调携带旧值、新值、版本号和变更类型
+func (c *Client) WatchAllEventIn(namespaceID int, callback ChangeEventCallback, opts ...WatchOption) error {
+	return c.watchPrefix(namespaceID, "", prefixWatch{callback: newWatchCallback(callback, opts, c.stopped)})
+}
+
+// UnwatchAll 取消默认命名空间的全部配置监听
+func (c *Client) UnwatchAll() error {
+	return c.UnwatchAllIn(c.opts.NamespaceID)
+}
+
+// UnwatchAllIn 取消指定命名空间的全部配置监听（按键、前缀和通配符监听不受影响）
+func (c *Client) UnwatchAllIn(namespaceID int) error {
+	return c.unwatchPrefix(namespaceID, "", "")
+}
+
+// UnwatchPrefix 取消默认命名空间的前缀监听
+func (c *Client) UnwatchPrefix(prefix string) error {
+	return c.UnwatchPrefixIn(c.opts.NamespaceID, prefix)
+}
+
+// UnwatchPrefixIn 取消指定命名空间的前缀监听（同一前缀下的通配符监听不受影响）
+func (c *Client) UnwatchPrefixIn(namespaceID int, prefix string) error {
+	return c.unwatchPrefix(namespaceID, prefix, "")
+}
+
+// UnwatchPattern 取消默认命名空间的通配符监听
+func (c *Client) UnwatchPattern(pattern string) error {
+	return c.UnwatchPatternIn(c.opts.NamespaceID, pattern)
+}
+
+// UnwatchPatternIn 取消指定命名空间的通配符监听
+func (c *Client) UnwatchPatternIn(namespaceID int, pattern string) error {
+	prefix, err := patternPrefix(pattern)
+	if err != nil {
+		return err
+	}
+	return c.unwatchPrefix(namespaceID, prefix, pattern)
+}
+
+// watchPrefix 注册前缀或通配符监听，前缀首次监听时向监听器订阅
+func (c *Client) watchPrefix(namespaceID int, prefix string, watch prefixWatch) error {
+	namespace, err := c.namespace(namespaceID)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	watches, exists := c.prefixWatches[namespaceID]
+	if !exists {
+		watches = make(map[string][]prefixWatch)
+		c.prefixWatches[namespaceID] = watches
+	}
+	_, subscribed := watches[prefix]
+	watches[prefix] = append(watches[prefix], watch)
+	c.mu.Unlock()
+
+	if c.watcher != nil && !subscribed {
+		return c.watcher.WatchPrefix(namespace, prefix, c.prefixChangeHandler(prefix))
+	}
+
+	return nil
+}
+
+// unwatchPrefix 移除前缀或通配符监听（pattern 为空时移除按前缀的监听），前缀下没有监听时取消订阅
+func (c *Client) unwatchPrefix(namespaceID int, prefix, pattern string) error {
+	namespace, err := c.namespace(namespaceID)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	watches := c.prefixWatches[namespaceID]
+	remaining := make([]prefixWatch, 0, len(watches[prefix]))
+	for _, watch := range watches[prefix] {
+		if watch.pattern != pattern {
+			remaining = append(remaining, watch)
+		}
+	}
+	if len(remaining) > 0 {
+		watches[prefix] = remaining
+	} else {
+		delete(watches, prefix)
+		if len(watches) == 0 {
+			delete(c.prefixWatches, namespaceID)
+		}
+	}
+	c.mu.Unlock()
+
+	if c.watcher != nil && len(remaining) == 0 {
+		return c.watcher.UnwatchPrefix(namespace, prefix)
+	}
+
+	return nil
+}
+
+// prefixChangeHandler 创建前缀订阅的配置变更处理函数
+// 同一变更会送达配置匹配的每个前缀订阅（如同时监听全部配置和某个前缀），只由匹配的最短前缀处理；
+// 配置同时按键监听时，变更通过按键监听送达并回调前缀监听，这里不再处理，避免重复回调
+func (c *Client) prefixChangeHandler(prefix string) listener.ConfigChangeCallback {
+	return func(event *listener.ConfigChangeEvent) {
+		c.mu.RLock()
+		_, watched := c.callbacks[formatCallbackKey(event.NamespaceID, event.ConfigKey)]
+		owner := !watched && c.shortestPrefix(event.NamespaceID, event.ConfigKey) == prefix
+		c.mu.RUnlock()
+
+		if owner {
+			c.handleConfigChange(event)
+		}
+	}
+}
+
+// shortestPrefix 配置键匹配的最短监听前缀（调用方需持有读锁）
+func (c *Client) shortestPrefix(namespaceID int, key string) string {
+	shortest, found := "", false
+	for prefix := range c.prefixWatches[namespaceID] {
+		if strings.HasPrefix(key, prefix) && (!found || len(prefix) < len(shortest)) {
+			shortest, found = prefix, true
+		}
+	}
+	return shortest
+}
+
+// patternPrefix 校验通配符并返回第一个通配字符之前的前缀
+func patternPrefix(pattern string) (string, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return "", fmt.Errorf("通配符格式错误: %s", pattern)
+	}
+	prefix := pattern
+	if i := strings.IndexAny(pattern, `*?[\`); i >= 0 {
+		prefix = pattern[:i]
+	}
+	if prefix == "" {
+		return "", fmt.Errorf("通配符不能以通配字符开头: %s", pattern)
+	}
+	return prefix, nil
+}
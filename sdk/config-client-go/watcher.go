@@ -3,6 +3,7 @@ package configsdk
 import (
 	"config-client/share/config-client/listener"
 	"config-client/share/config-client/listener/impl"
+	"config-client/share/config-client/telemetry"
 	"context"
 	"fmt"
 )
@@ -11,28 +12,66 @@ import (
 type Watcher interface {
 	Start(ctx context.Context) error
 	Stop() error
-	Watch(keys []string, callback func(key, value, version string)) error
-	Unwatch(keys []string) error
+	Watch(namespace Namespace, keys []string, callback listener.ConfigChangeCallback) error
+	Unwatch(namespace Namespace, keys []string) error
+	WatchPrefix(namespace Namespace, prefix string, callback listener.ConfigChangeCallback) error
+	UnwatchPrefix(namespace Namespace, prefix string) error
 	IsRunning() bool
+	Status() WatcherStatus
 }
 
-// httpWatcher HTTP 长轮询监听器包装
+// WatcherState 监听器连接状态
+type WatcherState = listener.ConnectionState
+
+const (
+	// WatcherStopped 未启动或已停止
+	WatcherStopped = listener.StateStopped
+	// WatcherConnecting 已启动，尚未成功连接服务端
+	WatcherConnecting = listener.StateConnecting
+	// WatcherConnected 已连接
+	WatcherConnected = listener.StateConnected
+	// WatcherDisconnected 连接失败或断开，正在退避重试
+	WatcherDisconnected = listener.StateDisconnected
+)
+
+// WatcherStatus 监听器状态（连接状态、最近一次成功的时间、连续失败次数、重连次数和最近一次错误）
+type WatcherStatus = listener.WatcherStatus
+
+// versionLookup 查询客户端缓存中配置的版本号
+type versionLookup func(namespaceID int, key string) string
+
+// prefixVersionLookup 查询客户端缓存中前缀下所有配置的版本号（配置键 -> 版本号）
+type prefixVersionLookup func(namespaceID int, prefix string) map[string]string
+
+// pollingWatcher 底层的 HTTP 长轮询或 WebSocket 监听器
+type pollingWatcher interface {
+	listener.Watcher
+	WatchPrefix(prefixes []*listener.WatchPrefix, callback listener.ConfigChangeCallback) error
+	UnwatchPrefix(prefixes []*listener.WatchPrefix) error
+	SetStatusCallback(callback listener.StatusChangeCallback)
+}
+
+// httpWatcher HTTP 长轮询（或 WebSocket）监听器包装
 type httpWatcher struct {
-	underlying  *impl.HTTPPollingWatcher // 底层 HTTP 长轮询监听器
-	namespaceID int
-	namespace   string
-	cache       *ConfigCache // 引用客户端的缓存,用于获取版本号
+	underlying pollingWatcher // 底层 HTTP 长轮询或 WebSocket 监听器
+	versionOf  versionLookup  // 查询客户端缓存中的版本号
+
+	prefixVersionsOf prefixVersionLookup // 查询客户端缓存中前缀下配置的版本号
 }
 
 // redisWatcher Redis 订阅监听器包装
 type redisWatcher struct {
-	underlying  *impl.RedisWatcher // 底层 Redis 监听器
-	namespaceID int
-	namespace   string
+	underlying *impl.RedisWatcher // 底层 Redis 监听器
+	versionOf  versionLookup      // 查询客户端缓存中的版本号（重连后据此补发断线期间的变更）
+
+	prefixVersionsOf prefixVersionLookup // 查询客户端缓存中前缀下配置的版本号
 }
 
 // createWatcherFromOptions 根据选项创建监听器
-func createWatcherFromOptions(opts *Options, cache *ConfigCache) (Watcher, error) {
+// Redis 模式下通过 fetchValue 查询变更配置的最新值，回调收到的事件与 HTTP 长轮询一致；
+// 连接断开重连后通过 fetchValue 和 listConfigs 查询监听配置的最新值，补发断线期间的变更
+func createWatcherFromOptions(opts *Options, versionOf versionLookup, prefixVersionsOf prefixVersionLookup,
+	fetchValue impl.ValueFetcher, listConfigs impl.ConfigLister) (Watcher, error) {
 	switch opts.WatcherType {
 	case WatcherTypeHTTP:
 		if opts.ServerURL == "" {
@@ -40,11 +79,41 @@ func createWatcherFromOptions(opts *Options, cache *ConfigCache) (Watcher, error
 		}
 		// 创建底层 HTTP 长轮询监听器
 		underlying := impl.NewHTTPPollingWatcher(opts.ServerURL, opts.PollingTimeout)
+		underlying.SetHeartbeatInterval(opts.HeartbeatInterval)
+		underlying.SetCredentials(opts.Credentials)
+		underlying.SetRetryPolicy(opts.RetryPolicy)
+		underlying.SetTracer(telemetry.NewTracer(opts.TracerProvider, opts.Propagator))
+		if opts.SigningPublicKey != nil {
+			underlying.SetSigningPublicKey(opts.SigningPublicKey)
+		}
+		underlying.SetStatusCallback(opts.OnWatcherStatusChange)
 		return &httpWatcher{
-			underlying:  underlying,
-			namespaceID: opts.NamespaceID,
-			namespace:   opts.Namespace,
-			cache:       cache, // 传递缓存引用
+			underlying: underlying,
+			versionOf:  versionOf, // 使用客户端缓存中的版本号
+
+			prefixVersionsOf: prefixVersionsOf,
+		}, nil
+
+	case WatcherTypeWebSocket:
+		if opts.ServerURL == "" {
+			return nil, fmt.Errorf("WebSocket 模式需要配置 ServerURL")
+		}
+		// 创建底层 WebSocket 监听器（监听请求、心跳和变更处理与 HTTP 长轮询一致）
+		underlying := impl.NewWebSocketWatcher(opts.ServerURL)
+		underlying.SetPingInterval(opts.PingInterval)
+		underlying.SetHeartbeatInterval(opts.HeartbeatInterval)
+		underlying.SetCredentials(opts.Credentials)
+		underlying.SetRetryPolicy(opts.RetryPolicy)
+		underlying.SetTracer(telemetry.NewTracer(opts.TracerProvider, opts.Propagator))
+		if opts.SigningPublicKey != nil {
+			underlying.SetSigningPublicKey(opts.SigningPublicKey)
+		}
+		underlying.SetStatusCallback(opts.OnWatcherStatusChange)
+		return &httpWatcher{
+			underlying: underlying,
+			versionOf:  versionOf,
+
+			prefixVersionsOf: prefixVersionsOf,
 		}, nil
 
 	case WatcherTypeRedis:
@@ -53,10 +122,15 @@ func createWatcherFromOptions(opts *Options, cache *ConfigCache) (Watcher, error
 		}
 		// 创建底层 Redis 监听器
 		underlying := impl.NewRedisWatcher(opts.RedisClient)
+		underlying.SetValueFetcher(fetchValue)
+		underlying.SetConfigLister(listConfigs)
+		underlying.SetRetryPolicy(opts.RetryPolicy)
+		underlying.SetStatusCallback(opts.OnWatcherStatusChange)
 		return &redisWatcher{
-			underlying:  underlying,
-			namespaceID: opts.NamespaceID,
-			namespace:   opts.Namespace,
+			underlying: underlying,
+			versionOf:  versionOf,
+
+			prefixVersionsOf: prefixVersionsOf,
 		}, nil
 
 	default:
@@ -64,6 +138,40 @@ func createWatcherFromOptions(opts *Options, cache *ConfigCache) (Watcher, error
 	}
 }
 
+// toWatchKeys 将命名空间下的配置键转换为底层的 WatchKey 列表
+func toWatchKeys(namespace Namespace, keys []string, versionOf versionLookup) []*listener.WatchKey {
+	watchKeys := make([]*listener.WatchKey, len(keys))
+	for i, key := range keys {
+		version := ""
+		if versionOf != nil {
+			version = versionOf(namespace.ID, key)
+		}
+		watchKeys[i] = &listener.WatchKey{
+			NamespaceID: namespace.ID,
+			Namespace:   namespace.Name,
+			Environment: namespace.Environment,
+			Key:         key,
+			Version:     version,
+		}
+	}
+	return watchKeys
+}
+
+// toWatchPrefixes 将命名空间下的前缀转换为底层的 WatchPrefix 列表
+func toWatchPrefixes(namespace Namespace, prefix string, prefixVersionsOf prefixVersionLookup) []*listener.WatchPrefix {
+	var versions map[string]string
+	if prefixVersionsOf != nil {
+		versions = prefixVersionsOf(namespace.ID, prefix)
+	}
+	return []*listener.WatchPrefix{{
+		NamespaceID: namespace.ID,
+		Namespace:   namespace.Name,
+		Environment: namespace.Environment,
+		Prefix:      prefix,
+		Versions:    versions,
+	}}
+}
+
 // Start 启动 HTTP 监听器
 func (w *httpWatcher) Start(ctx context.Context) error {
 	return w.underlying.Start(ctx)
@@ -75,43 +183,26 @@ func (w *httpWatcher) Stop() error {
 }
 
 // Watch 监听配置
-func (w *httpWatcher) Watch(keys []string, callback func(key, value, version string)) error {
-	// 将简化的 key 列表转换为底层的 WatchKey 列表
-	watchKeys := make([]*listener.WatchKey, len(keys))
-	for i, key := range keys {
-		version := ""
-		// 从缓存中获取当前版本号(如果有)
-		if w.cache != nil {
-			version = w.cache.GetVersion(key)
-		}
+func (w *httpWatcher) Watch(namespace Namespace, keys []string, callback listener.ConfigChangeCallback) error {
+	// 从缓存中获取当前版本号(如果有)，避免重复推送已缓存的配置
+	watchKeys := toWatchKeys(namespace, keys, w.versionOf)
 
-		watchKeys[i] = &listener.WatchKey{
-			NamespaceID: w.namespaceID,
-			Namespace:   w.namespace,
-			Key:         key,
-			Version:     version, // 使用缓存中的版本
-		}
-	}
+	return w.underlying.Watch(watchKeys, callback)
+}
 
-	// 将简化的回调转换为底层的回调
-	underlyingCallback := func(event *listener.ConfigChangeEvent) {
-		callback(event.ConfigKey, event.Value, event.Version)
-	}
+// Unwatch 取消监听
+func (w *httpWatcher) Unwatch(namespace Namespace, keys []string) error {
+	return w.underlying.Unwatch(toWatchKeys(namespace, keys, nil))
+}
 
-	return w.underlying.Watch(watchKeys, underlyingCallback)
+// WatchPrefix 监听前缀（上报缓存中前缀下配置的版本号，服务端只返回版本不同或新建的配置）
+func (w *httpWatcher) WatchPrefix(namespace Namespace, prefix string, callback listener.ConfigChangeCallback) error {
+	return w.underlying.WatchPrefix(toWatchPrefixes(namespace, prefix, w.prefixVersionsOf), callback)
 }
 
-// Unwatch 取消监听
-func (w *httpWatcher) Unwatch(keys []string) error {
-	watchKeys := make([]*listener.WatchKey, len(keys))
-	for i, key := range keys {
-		watchKeys[i] = &listener.WatchKey{
-			NamespaceID: w.namespaceID,
-			Namespace:   w.namespace,
-			Key:         key,
-		}
-	}
-	return w.underlying.Unwatch(watchKeys)
+// UnwatchPrefix 取消前缀监听
+func (w *httpWatcher) UnwatchPrefix(namespace Namespace, prefix string) error {
+	return w.underlying.UnwatchPrefix(toWatchPrefixes(namespace, prefix, nil))
 }
 
 // IsRunning 是否运行中
@@ -119,6 +210,11 @@ func (w *httpWatcher) IsRunning() bool {
 	return w.underlying.IsRunning()
 }
 
+// Status 监听器状态
+func (w *httpWatcher) Status() WatcherStatus {
+	return w.underlying.Status()
+}
+
 // Start 启动 Redis 监听器
 func (w *redisWatcher) Start(ctx context.Context) error {
 	return w.underlying.Start(ctx)
@@ -130,40 +226,34 @@ func (w *redisWatcher) Stop() error {
 }
 
 // Watch 监听配置
-func (w *redisWatcher) Watch(keys []string, callback func(key, value, version string)) error {
-	// 将简化的 key 列表转换为底层的 WatchKey 列表
-	watchKeys := make([]*listener.WatchKey, len(keys))
-	for i, key := range keys {
-		watchKeys[i] = &listener.WatchKey{
-			NamespaceID: w.namespaceID,
-			Namespace:   w.namespace,
-			Key:         key,
-			Version:     "", // 初始版本为空
-		}
-	}
+func (w *redisWatcher) Watch(namespace Namespace, keys []string, callback listener.ConfigChangeCallback) error {
+	// Redis 通知只在配置变更时推送，缓存中的版本号用于重连后判断断线期间是否变更
+	watchKeys := toWatchKeys(namespace, keys, w.versionOf)
 
-	// 将简化的回调转换为底层的回调
-	underlyingCallback := func(event *listener.ConfigChangeEvent) {
-		callback(event.ConfigKey, event.Value, event.Version)
-	}
-
-	return w.underlying.Watch(watchKeys, underlyingCallback)
+	return w.underlying.Watch(watchKeys, callback)
 }
 
 // Unwatch 取消监听
-func (w *redisWatcher) Unwatch(keys []string) error {
-	watchKeys := make([]*listener.WatchKey, len(keys))
-	for i, key := range keys {
-		watchKeys[i] = &listener.WatchKey{
-			NamespaceID: w.namespaceID,
-			Namespace:   w.namespace,
-			Key:         key,
-		}
-	}
-	return w.underlying.Unwatch(watchKeys)
+func (w *redisWatcher) Unwatch(namespace Namespace, keys []string) error {
+	return w.underlying.Unwatch(toWatchKeys(namespace, keys, nil))
+}
+
+// WatchPrefix 监听前缀（Redis 通知包含所有配置的变更，按前缀过滤；缓存中前缀下配置的版本号用于重连后补发断线期间的变更）
+func (w *redisWatcher) WatchPrefix(namespace Namespace, prefix string, callback listener.ConfigChangeCallback) error {
+	return w.underlying.WatchPrefix(toWatchPrefixes(namespace, prefix, w.prefixVersionsOf), callback)
+}
+
+// UnwatchPrefix 取消前缀监听
+func (w *redisWatcher) UnwatchPrefix(namespace Namespace, prefix string) error {
+	return w.underlying.UnwatchPrefix(toWatchPrefixes(namespace, prefix, nil))
 }
 
 // IsRunning 是否运行中
 func (w *redisWatcher) IsRunning() bool {
 	return w.underlying.IsRunning()
 }
+
+// Status 监听器状态
+func (w *redisWatcher) Status() WatcherStatus {
+	return w.underlying.Status()
+}
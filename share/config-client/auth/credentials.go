@@ -0,0 +1,89 @@
+// Package auth 配置中心客户端认证
+// 客户端向每个请求附加 API Key 或 Bearer 令牌，令牌通过 TokenProvider 获取，支持在运行期间轮换
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// APIKeyHeader API Key 请求头（与服务端认证中间件一致）
+const APIKeyHeader = "X-API-Key"
+
+// Scheme 认证方式
+type Scheme string
+
+const (
+	SchemeAPIKey Scheme = "api_key" // X-API-Key: <token>
+	SchemeBearer Scheme = "bearer"  // Authorization: Bearer <token>
+)
+
+// TokenProvider 访问令牌提供者
+// 每次请求前调用，实现方可缓存令牌并在过期前刷新，实现令牌轮换；需支持并发调用
+type TokenProvider interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenProviderFunc 函数形式的 TokenProvider
+type TokenProviderFunc func(ctx context.Context) (string, error)
+
+// Token 实现 TokenProvider 接口
+func (f TokenProviderFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// StaticToken 固定令牌
+type StaticToken string
+
+// Token 实现 TokenProvider 接口
+func (t StaticToken) Token(context.Context) (string, error) {
+	return string(t), nil
+}
+
+// Credentials 客户端认证信息
+type Credentials struct {
+	Scheme   Scheme        // 认证方式
+	Provider TokenProvider // 令牌提供者
+}
+
+// NewCredentials 创建认证信息
+func NewCredentials(scheme Scheme, provider TokenProvider) *Credentials {
+	return &Credentials{Scheme: scheme, Provider: provider}
+}
+
+// APIKey 使用固定 API Key 认证（X-API-Key 请求头）
+func APIKey(key string) *Credentials {
+	return NewCredentials(SchemeAPIKey, StaticToken(key))
+}
+
+// BearerToken 使用固定 Bearer 令牌认证（Authorization 请求头）
+func BearerToken(token string) *Credentials {
+	return NewCredentials(SchemeBearer, StaticToken(token))
+}
+
+// Apply 为请求附加认证信息（Credentials 为 nil 时不处理）
+// 令牌获取失败时返回错误，不发送未认证的请求
+func (c *Credentials) Apply(req *http.Request) error {
+	if c == nil || c.Provider == nil {
+		return nil
+	}
+
+	token, err := c.Provider.Token(req.Context())
+	if err != nil {
+		return fmt.Errorf("获取访问令牌失败: %w", err)
+	}
+	if token == "" {
+		return nil
+	}
+
+	switch c.Scheme {
+	case SchemeAPIKey:
+		req.Header.Set(APIKeyHeader, token)
+	case SchemeBearer, "":
+		req.Header.Set("Authorization", "Bearer "+token)
+	default:
+		return fmt.Errorf("不支持的认证方式: %s", c.Scheme)
+	}
+	return nil
+}
@@ -6,8 +6,11 @@ import (
 	"sync"
 	"time"
 
+	"config-client/share/config-client/auth"
 	"config-client/share/config-client/listener"
 	"config-client/share/config-client/listener/impl"
+	"config-client/share/config-client/retry"
+	"config-client/share/config-client/telemetry"
 
 	"github.com/redis/go-redis/v9"
 )
@@ -16,13 +19,14 @@ import (
 type WatcherType string
 
 const (
-	WatcherTypeHTTP  WatcherType = "http"  // HTTP长轮询
-	WatcherTypeRedis WatcherType = "redis" // Redis直连
+	WatcherTypeHTTP      WatcherType = "http"      // HTTP长轮询
+	WatcherTypeRedis     WatcherType = "redis"     // Redis直连
+	WatcherTypeWebSocket WatcherType = "websocket" // WebSocket长连接
 )
 
 // Config 客户端配置
 type Config struct {
-	// ServerURL 配置中心服务地址（HTTP模式使用）
+	// ServerURL 配置中心服务地址（HTTP和WebSocket模式使用）
 	ServerURL string
 
 	// NamespaceID 默认命名空间ID
@@ -31,17 +35,32 @@ type Config struct {
 	// Namespace 默认命名空间名称
 	Namespace string
 
-	// WatcherType 监听器类型（http/redis）
+	// Environment 监听的环境（为空时为 default）
+	Environment string
+
+	// WatcherType 监听器类型（http/redis/websocket）
 	WatcherType WatcherType
 
-	// RedisClient Redis客户端（Redis模式使用）
-	RedisClient *redis.Client
+	// RedisClient Redis客户端（Redis模式使用，支持 *redis.Client、*redis.ClusterClient 及哨兵客户端）
+	RedisClient redis.UniversalClient
+
+	// ValueFetcher 查询配置最新值的函数（Redis模式使用，设置后回调的事件携带最新值，为 nil 时只携带配置键）
+	ValueFetcher impl.ValueFetcher
 
 	// PollingTimeout 长轮询超时时间（HTTP模式使用）
 	PollingTimeout time.Duration
 
 	// AutoStart 是否自动启动监听器
 	AutoStart bool
+
+	// Credentials 认证信息（HTTP和WebSocket模式使用，服务端启用认证时需要）
+	Credentials *auth.Credentials
+
+	// RetryPolicy 长轮询失败或连接断开后的退避策略（HTTP和WebSocket模式使用，为 nil 时使用默认策略）
+	RetryPolicy *retry.Policy
+
+	// Tracer 链路追踪（HTTP和WebSocket模式使用，为 nil 时使用 OTel 全局实现）
+	Tracer *telemetry.Tracer
 }
 
 // DefaultConfig 默认配置
@@ -50,6 +69,7 @@ func DefaultConfig() *Config {
 		ServerURL:      "http://localhost:8080",
 		NamespaceID:    1,
 		Namespace:      "default",
+		Environment:    listener.DefaultEnvironment,
 		WatcherType:    WatcherTypeHTTP,
 		PollingTimeout: 60 * time.Second,
 		AutoStart:      true,
@@ -102,13 +122,29 @@ func createWatcher(cfg *Config) (listener.Watcher, error) {
 		if cfg.ServerURL == "" {
 			return nil, fmt.Errorf("HTTP模式需要配置ServerURL")
 		}
-		return impl.NewHTTPPollingWatcher(cfg.ServerURL, cfg.PollingTimeout), nil
+		watcher := impl.NewHTTPPollingWatcher(cfg.ServerURL, cfg.PollingTimeout)
+		watcher.SetCredentials(cfg.Credentials)
+		watcher.SetRetryPolicy(cfg.RetryPolicy)
+		watcher.SetTracer(cfg.Tracer)
+		return watcher, nil
 
 	case WatcherTypeRedis:
 		if cfg.RedisClient == nil {
 			return nil, fmt.Errorf("Redis模式需要配置RedisClient")
 		}
-		return impl.NewRedisWatcher(cfg.RedisClient), nil
+		watcher := impl.NewRedisWatcher(cfg.RedisClient)
+		watcher.SetValueFetcher(cfg.ValueFetcher)
+		return watcher, nil
+
+	case WatcherTypeWebSocket:
+		if cfg.ServerURL == "" {
+			return nil, fmt.Errorf("WebSocket模式需要配置ServerURL")
+		}
+		watcher := impl.NewWebSocketWatcher(cfg.ServerURL)
+		watcher.SetCredentials(cfg.Credentials)
+		watcher.SetRetryPolicy(cfg.RetryPolicy)
+		watcher.SetTracer(cfg.Tracer)
+		return watcher, nil
 
 	default:
 		return nil, fmt.Errorf("不支持的监听器类型: %s", cfg.WatcherType)
@@ -164,6 +200,7 @@ func (c *Client) Watch(keys []string, callback listener.ConfigChangeCallback) er
 		watchKeys[i] = &listener.WatchKey{
 			NamespaceID: c.cfg.NamespaceID,
 			Namespace:   c.cfg.Namespace,
+			Environment: c.cfg.Environment,
 			Key:         key,
 			Version:     "", // 初次监听，版本为空
 		}
@@ -179,6 +216,7 @@ func (c *Client) WatchWithVersion(keys map[string]string, callback listener.Conf
 		watchKeys = append(watchKeys, &listener.WatchKey{
 			NamespaceID: c.cfg.NamespaceID,
 			Namespace:   c.cfg.Namespace,
+			Environment: c.cfg.Environment,
 			Key:         key,
 			Version:     version,
 		})
@@ -194,6 +232,7 @@ func (c *Client) WatchNamespace(namespaceID int, namespace string, keys []string
 		watchKeys[i] = &listener.WatchKey{
 			NamespaceID: namespaceID,
 			Namespace:   namespace,
+			Environment: c.cfg.Environment,
 			Key:         key,
 			Version:     "",
 		}
@@ -12,8 +12,12 @@ import (
 // WatcherConfig 监听器配置
 type WatcherConfig struct {
 	Type      WatcherType    // 监听器类型
-	ServerURL string         // HTTP服务地址
-	RedisOpt  *redis.Options // Redis配置选项
+	ServerURL string         // HTTP服务地址（HTTP和WebSocket模式使用）
+	RedisOpt  *redis.Options // Redis配置选项（单机模式）
+
+	// RedisUniversalOpt Redis通用配置选项（哨兵/集群模式，设置后优先于 RedisOpt）
+	// MasterName 不为空时为哨兵模式，Addrs 多于一个时为集群模式
+	RedisUniversalOpt *redis.UniversalOptions
 }
 
 // NewWatcher 创建监听器（工厂方法）
@@ -26,12 +30,21 @@ func NewWatcher(cfg *WatcherConfig) (listener.Watcher, error) {
 		return impl.NewHTTPPollingWatcher(cfg.ServerURL, 0), nil
 
 	case WatcherTypeRedis:
+		if cfg.RedisUniversalOpt != nil {
+			return impl.NewRedisWatcher(redis.NewUniversalClient(cfg.RedisUniversalOpt)), nil
+		}
 		if cfg.RedisOpt == nil {
-			return nil, fmt.Errorf("Redis模式需要配置RedisOpt")
+			return nil, fmt.Errorf("Redis模式需要配置RedisOpt或RedisUniversalOpt")
 		}
 		client := redis.NewClient(cfg.RedisOpt)
 		return impl.NewRedisWatcher(client), nil
 
+	case WatcherTypeWebSocket:
+		if cfg.ServerURL == "" {
+			return nil, fmt.Errorf("WebSocket模式需要配置ServerURL")
+		}
+		return impl.NewWebSocketWatcher(cfg.ServerURL), nil
+
 	default:
 		return nil, fmt.Errorf("不支持的监听器类型: %s", cfg.Type)
 	}
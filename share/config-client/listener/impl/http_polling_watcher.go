@@ -3,19 +3,28 @@ package impl
 import (
 	"bytes"
 	"context"
+	"crypto/ed25519"
 	"crypto/rand"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
+	"config-client/share/config-client/auth"
 	"config-client/share/config-client/listener"
+	"config-client/share/config-client/retry"
+	"config-client/share/config-client/signing"
+	"config-client/share/config-client/telemetry"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // HTTPPollingWatcher HTTP长轮询配置监听器
@@ -29,18 +38,60 @@ type HTTPPollingWatcher struct {
 	mu             sync.RWMutex                             // 读写锁
 	watchKeys      map[string]*listener.WatchKey            // key -> WatchKey (key格式: "namespaceID:configKey")
 	callbacks      map[string]listener.ConfigChangeCallback // key -> callback
+	sequences      map[int]int64                            // 命名空间ID -> 服务端返回的最新事件序号（断线重连时用于补发遗漏的变更）
+	heartbeat      time.Duration                            // 心跳间隔（两次长轮询之间保持订阅活跃，<=0 时不发送）
+	publicKey      ed25519.PublicKey                        // 配置值签名公钥（设置后校验变更中的签名）
+	credentials    *auth.Credentials                        // 认证信息（服务端启用认证时附加到每个请求）
+	retryPolicy    *retry.Policy                            // 重试策略（长轮询失败后按指数退避等待）
+	tracer         *telemetry.Tracer                        // 链路追踪（为 nil 时使用 OTel 全局实现）
 	running        bool                                     // 是否正在运行
 	ctx            context.Context                          // 上下文
 	cancel         context.CancelFunc                       // 取消函数
 	wg             sync.WaitGroup                           // 等待组
+
+	prefixes        map[string]*listener.WatchPrefix         // 前缀监听（key格式: "namespaceID:prefix"）
+	prefixCallbacks map[string]listener.ConfigChangeCallback // 前缀 -> callback
+
+	heartbeatTimeout time.Duration // 服务端返回的心跳超时阈值（未收到心跳响应时为 0）
+	pollDelay        time.Duration // 服务端建议的下一次请求前的等待时间（未返回时为 0）
+
+	tracker statusTracker // 连接状态
 }
 
+// DefaultHeartbeatInterval 默认心跳间隔（小于服务端默认的心跳超时）
+const DefaultHeartbeatInterval = 30 * time.Second
+
+// minPollingRetryDelay 长轮询失败后的最短等待时间（避免退避时间为 0 时连续请求）
+const minPollingRetryDelay = 100 * time.Millisecond
+
+// unsubscribeTimeout 停止时取消订阅的超时时间（服务端不可用时不阻塞停止）
+const unsubscribeTimeout = 5 * time.Second
+
+// defaultPollDelay 长轮询成功后发起下一次请求前的默认等待时间（服务端未返回建议间隔时使用）
+const defaultPollDelay = 200 * time.Millisecond
+
+// maxPollDelay 服务端建议的请求间隔上限（避免异常的建议值使客户端长时间不再监听）
+const maxPollDelay = 5 * time.Minute
+
+// minHeartbeatInterval 按服务端心跳超时调整后的最短心跳间隔
+const minHeartbeatInterval = time.Second
+
 // HTTPPollingRequest 长轮询请求
 type HTTPPollingRequest struct {
 	ClientID       string             `json:"client_id"`       // 客户端唯一标识
 	ClientIP       string             `json:"client_ip"`       // 客户端IP地址
 	ClientHostname string             `json:"client_hostname"` // 客户端主机名
 	ConfigKeys     []ConfigKeyVersion `json:"config_keys"`     // 配置键列表
+	LastSequences  map[int]int64      `json:"last_sequences"`  // 各命名空间上次响应返回的事件序号（首次请求为空）
+	Prefixes       []PrefixVersions   `json:"prefixes"`        // 前缀列表（前缀下新建的配置同样返回）
+}
+
+// PrefixVersions 前缀及其下已知配置的版本
+type PrefixVersions struct {
+	NamespaceID int               `json:"namespace_id"` // 命名空间ID
+	Environment string            `json:"environment"`  // 环境
+	Prefix      string            `json:"prefix"`       // 配置键前缀
+	Versions    map[string]string `json:"versions"`     // 前缀下已知配置的版本（配置键 -> 版本号）
 }
 
 // ConfigKeyVersion 配置键及其版本
@@ -56,15 +107,23 @@ type HTTPPollingResponse struct {
 	Changed    bool                 `json:"changed"`
 	ConfigKeys []string             `json:"config_keys"`
 	Configs    []ConfigChangeDetail `json:"configs"`
+	Sequence   int64                `json:"sequence"`
+	Sequences  map[int]int64        `json:"sequences"`
+
+	NextPollDelayMs int64 `json:"next_poll_delay_ms"` // 服务端建议的下一次请求前的等待时间（毫秒，未返回时为 0）
 }
 
 // ConfigChangeDetail 配置变更详情
 type ConfigChangeDetail struct {
 	NamespaceID int    `json:"namespace_id"`
+	Environment string `json:"environment"`
 	ConfigKey   string `json:"config_key"`
 	Version     string `json:"version"`
 	Value       string `json:"value"`
 	ValueType   string `json:"value_type"`
+	IsCanary    bool   `json:"is_canary"`
+	Deleted     bool   `json:"deleted"`
+	Signature   string `json:"signature"`
 }
 
 // NewHTTPPollingWatcher 创建HTTP长轮询监听器
@@ -86,10 +145,63 @@ func NewHTTPPollingWatcher(serverURL string, timeout time.Duration) *HTTPPolling
 		timeout:   timeout,
 		watchKeys: make(map[string]*listener.WatchKey),
 		callbacks: make(map[string]listener.ConfigChangeCallback),
+		sequences: make(map[int]int64),
+		heartbeat: DefaultHeartbeatInterval,
 		running:   false,
+
+		retryPolicy: retry.DefaultPolicy(),
+
+		prefixes:        make(map[string]*listener.WatchPrefix),
+		prefixCallbacks: make(map[string]listener.ConfigChangeCallback),
 	}
 }
 
+// SetHeartbeatInterval 设置心跳间隔（需在 Start 之前调用，<=0 时不发送心跳）
+// 服务端在心跳响应中返回心跳超时阈值，设置的间隔超过阈值的 1/3 时按阈值的 1/3 发送，避免订阅被误判为超时
+func (w *HTTPPollingWatcher) SetHeartbeatInterval(interval time.Duration) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.heartbeat = interval
+}
+
+// SetRetryPolicy 设置重试策略（需在 Start 之前调用，为 nil 时使用默认策略）
+// 长轮询失败后按策略的退避时间等待后重试（不限次数），服务端返回 429 或 503 时按其返回的 Retry-After 等待
+func (w *HTTPPollingWatcher) SetRetryPolicy(policy *retry.Policy) {
+	if policy == nil {
+		policy = retry.DefaultPolicy()
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.retryPolicy = policy
+}
+
+// SetTracer 设置链路追踪（需在 Start 之前调用），长轮询和心跳请求均创建客户端 span 并传递链路上下文
+func (w *HTTPPollingWatcher) SetTracer(tracer *telemetry.Tracer) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.tracer = tracer
+}
+
+// SetCredentials 设置认证信息（需在 Start 之前调用），长轮询和心跳请求均携带
+func (w *HTTPPollingWatcher) SetCredentials(credentials *auth.Credentials) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.credentials = credentials
+}
+
+// SetSigningPublicKey 设置配置值签名公钥（需在 Start 之前调用）
+// 设置后校验每个变更携带的签名，签名缺失或校验失败的变更不回调
+func (w *HTTPPollingWatcher) SetSigningPublicKey(publicKey ed25519.PublicKey) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.publicKey = publicKey
+}
+
+// SetStatusCallback 设置连接状态变化回调（在监听循环中同步调用，不应阻塞；为 nil 时不回调）
+func (w *HTTPPollingWatcher) SetStatusCallback(callback listener.StatusChangeCallback) {
+	w.tracker.SetStatusCallback(callback)
+}
+
 // generateClientID 生成唯一的客户端ID
 func generateClientID() string {
 	// 使用主机名+随机字符串
@@ -116,6 +228,7 @@ func (w *HTTPPollingWatcher) Start(ctx context.Context) error {
 	}
 	w.running = true
 	w.mu.Unlock()
+	w.tracker.starting()
 
 	ctx, cancel := context.WithCancel(ctx)
 	w.ctx = ctx
@@ -125,10 +238,23 @@ func (w *HTTPPollingWatcher) Start(ctx context.Context) error {
 	w.wg.Add(1)
 	go w.pollingLoop()
 
+	w.startHeartbeat()
 	return nil
 }
 
+// startHeartbeat 启动心跳循环（心跳间隔 <=0 时不启动）
+func (w *HTTPPollingWatcher) startHeartbeat() {
+	w.mu.RLock()
+	heartbeat := w.heartbeat
+	w.mu.RUnlock()
+	if heartbeat > 0 {
+		w.wg.Add(1)
+		go w.heartbeatLoop(heartbeat)
+	}
+}
+
 // Stop 停止监听器
+// 停止长轮询和心跳后向服务端取消订阅，服务端立即停用订阅记录，不必等待心跳超时（取消失败只记录日志）
 func (w *HTTPPollingWatcher) Stop() error {
 	w.mu.Lock()
 	if !w.running {
@@ -143,6 +269,13 @@ func (w *HTTPPollingWatcher) Stop() error {
 	}
 
 	w.wg.Wait()
+	w.tracker.stopped()
+
+	ctx, cancel := context.WithTimeout(context.Background(), unsubscribeTimeout)
+	defer cancel()
+	if err := w.unsubscribe(ctx); err != nil {
+		hlog.Warnf("取消订阅失败: %v", err)
+	}
 	return nil
 }
 
@@ -185,6 +318,50 @@ func (w *HTTPPollingWatcher) UnwatchAll() error {
 
 	w.watchKeys = make(map[string]*listener.WatchKey)
 	w.callbacks = make(map[string]listener.ConfigChangeCallback)
+	w.prefixes = make(map[string]*listener.WatchPrefix)
+	w.prefixCallbacks = make(map[string]listener.ConfigChangeCallback)
+	return nil
+}
+
+// WatchPrefix 添加前缀监听
+// 长轮询请求携带前缀及其下已知配置的版本，服务端在前缀下任一配置变更（包括新建配置）时返回
+func (w *HTTPPollingWatcher) WatchPrefix(prefixes []*listener.WatchPrefix, callback listener.ConfigChangeCallback) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, prefix := range prefixes {
+		k := w.formatKey(prefix.NamespaceID, prefix.Prefix)
+		watchPrefix := *prefix
+		watchPrefix.Versions = make(map[string]string, len(prefix.Versions))
+		for key, version := range prefix.Versions {
+			watchPrefix.Versions[key] = version
+		}
+		// 如果已经存在监听,保留原有的版本号(避免重复触发)
+		if existing, exists := w.prefixes[k]; exists {
+			for key, version := range existing.Versions {
+				if _, known := watchPrefix.Versions[key]; !known {
+					watchPrefix.Versions[key] = version
+				}
+			}
+		}
+		w.prefixes[k] = &watchPrefix
+		w.prefixCallbacks[k] = callback
+	}
+
+	return nil
+}
+
+// UnwatchPrefix 取消前缀监听
+func (w *HTTPPollingWatcher) UnwatchPrefix(prefixes []*listener.WatchPrefix) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, prefix := range prefixes {
+		k := w.formatKey(prefix.NamespaceID, prefix.Prefix)
+		delete(w.prefixes, k)
+		delete(w.prefixCallbacks, k)
+	}
+
 	return nil
 }
 
@@ -195,10 +372,16 @@ func (w *HTTPPollingWatcher) IsRunning() bool {
 	return w.running
 }
 
+// Status 连接状态、最近一次长轮询成功的时间、连续失败次数和重连次数
+func (w *HTTPPollingWatcher) Status() listener.WatcherStatus {
+	return w.tracker.Status()
+}
+
 // pollingLoop 长轮询循环
 func (w *HTTPPollingWatcher) pollingLoop() {
 	defer w.wg.Done()
 
+	failures := 0 // 连续失败次数
 	for {
 		select {
 		case <-w.ctx.Done():
@@ -208,7 +391,7 @@ func (w *HTTPPollingWatcher) pollingLoop() {
 
 		// 如果没有监听的配置，等待一段时间
 		w.mu.RLock()
-		hasKeys := len(w.watchKeys) > 0
+		hasKeys := len(w.watchKeys) > 0 || len(w.prefixes) > 0
 		w.mu.RUnlock()
 
 		if !hasKeys {
@@ -218,58 +401,44 @@ func (w *HTTPPollingWatcher) pollingLoop() {
 
 		// 执行长轮询请求
 		if err := w.doPolling(); err != nil {
-			hlog.Errorf("长轮询请求失败: %v", err)
-			// 出错后等待一段时间再重试
+			// 出错后按指数退避等待再重试（服务端繁忙时按其建议的时间等待）
+			if w.ctx.Err() != nil {
+				return
+			}
+			w.tracker.fail(err)
+			failures++
+			delay := max(w.retryPolicy.Backoff(failures), minPollingRetryDelay)
+			var busyErr *serverBusyError
+			if errors.As(err, &busyErr) && busyErr.retryAfter > delay {
+				delay = busyErr.retryAfter
+			}
+			hlog.Errorf("长轮询请求失败（连续 %d 次），%v 后重试: %v", failures, delay, err)
 			select {
 			case <-w.ctx.Done():
 				return
-			case <-time.After(5 * time.Second):
+			case <-time.After(delay):
 				continue
 			}
 		}
+		failures = 0
+		w.tracker.succeed()
 
-		// 成功后短暂间隔再发起下一次请求，避免服务器压力过大
+		// 成功后按服务端建议的间隔（未返回时短暂间隔）再发起下一次请求，避免服务器压力过大
 		select {
 		case <-w.ctx.Done():
 			return
-		case <-time.After(200 * time.Millisecond):
+		case <-time.After(w.nextPollDelay(defaultPollDelay)):
 		}
 	}
 }
 
 // doPolling 执行一次长轮询请求
 func (w *HTTPPollingWatcher) doPolling() error {
-	// 构建请求
-	w.mu.RLock()
-	keys := make([]*listener.WatchKey, 0, len(w.watchKeys))
-	for _, key := range w.watchKeys {
-		keys = append(keys, key)
-	}
-	w.mu.RUnlock()
-
-	if len(keys) == 0 {
+	reqBody, namespaceID := w.buildPollingRequest()
+	if reqBody == nil {
 		return nil
 	}
 
-	// 构建请求体
-	configKeys := make([]ConfigKeyVersion, len(keys))
-	for i, key := range keys {
-		configKeys[i] = ConfigKeyVersion{
-			NamespaceID: key.NamespaceID,
-			Environment: "default", // 使用默认环境
-			ConfigKey:   key.Key,
-			Version:     key.Version,
-		}
-		hlog.Infof("[客户端发送] 配置键: %d:%s, 版本号: %s", key.NamespaceID, key.Key, key.Version)
-	}
-
-	reqBody := HTTPPollingRequest{
-		ClientID:       w.clientID,
-		ClientIP:       w.clientIP,
-		ClientHostname: w.clientHostname,
-		ConfigKeys:     configKeys,
-	}
-
 	jsonData, err := json.Marshal(reqBody)
 	if err != nil {
 		return fmt.Errorf("序列化请求失败: %w", err)
@@ -284,12 +453,16 @@ func (w *HTTPPollingWatcher) doPolling() error {
 
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := w.httpClient.Do(req)
+	resp, err := w.do(req, attribute.String("client.id", w.clientID), attribute.Int("poll.key_count", len(reqBody.ConfigKeys)),
+		attribute.Int("poll.prefix_count", len(reqBody.Prefixes)))
 	if err != nil {
-		return fmt.Errorf("发送请求失败: %w", err)
+		return err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		return newServerBusyError(resp.Header.Get("Retry-After"))
+	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		return fmt.Errorf("请求失败: status=%d, body=%s", resp.StatusCode, string(body))
@@ -315,21 +488,298 @@ func (w *HTTPPollingWatcher) doPolling() error {
 			return fmt.Errorf("解析响应失败: %w (原始错误: %v)", err2, err)
 		}
 		// 直接解析成功
-		if pollingResp.Changed {
-			w.handleConfigChanges(&pollingResp)
-		}
+		w.handlePollingResponse(namespaceID, &pollingResp)
 		return nil
 	}
 
 	// 标准格式解析成功
-	if standardResp.Data.Changed {
-		w.handleConfigChanges(&standardResp.Data)
+	w.handlePollingResponse(namespaceID, &standardResp.Data)
+	return nil
+}
+
+// buildPollingRequest 按当前监听的配置、前缀及其版本和事件序号构建长轮询请求
+// 返回请求和第一个配置（没有按键监听的配置时为第一个前缀）所在的命名空间，没有监听的配置时返回 nil
+func (w *HTTPPollingWatcher) buildPollingRequest() (*HTTPPollingRequest, int) {
+	w.mu.RLock()
+	keys := make([]*listener.WatchKey, 0, len(w.watchKeys))
+	for _, key := range w.watchKeys {
+		keys = append(keys, key)
+	}
+	prefixes := make([]PrefixVersions, 0, len(w.prefixes))
+	for _, prefix := range w.prefixes {
+		versions := make(map[string]string, len(prefix.Versions))
+		for key, version := range prefix.Versions {
+			versions[key] = version
+		}
+		prefixes = append(prefixes, PrefixVersions{
+			NamespaceID: prefix.NamespaceID,
+			Environment: prefix.EnvironmentOrDefault(),
+			Prefix:      prefix.Prefix,
+			Versions:    versions,
+		})
+	}
+	w.mu.RUnlock()
+
+	if len(keys) == 0 && len(prefixes) == 0 {
+		return nil, 0
+	}
+
+	// 按命名空间和配置键排序，保证每次请求的配置顺序一致
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].NamespaceID != keys[j].NamespaceID {
+			return keys[i].NamespaceID < keys[j].NamespaceID
+		}
+		return keys[i].Key < keys[j].Key
+	})
+	sort.Slice(prefixes, func(i, j int) bool {
+		if prefixes[i].NamespaceID != prefixes[j].NamespaceID {
+			return prefixes[i].NamespaceID < prefixes[j].NamespaceID
+		}
+		return prefixes[i].Prefix < prefixes[j].Prefix
+	})
+	// 第一个配置（没有按键监听的配置时为第一个前缀）所在的命名空间，与服务端的 sequence 对应
+	var namespaceID int
+	if len(keys) > 0 {
+		namespaceID = keys[0].NamespaceID
+	} else {
+		namespaceID = prefixes[0].NamespaceID
+	}
+
+	// 构建请求体
+	configKeys := make([]ConfigKeyVersion, len(keys))
+	for i, key := range keys {
+		configKeys[i] = ConfigKeyVersion{
+			NamespaceID: key.NamespaceID,
+			Environment: key.EnvironmentOrDefault(),
+			ConfigKey:   key.Key,
+			Version:     key.Version,
+		}
+		hlog.Infof("[客户端发送] 配置键: %d:%s, 版本号: %s", key.NamespaceID, key.Key, key.Version)
+	}
+
+	reqBody := &HTTPPollingRequest{
+		ClientID:       w.clientID,
+		ClientIP:       w.clientIP,
+		ClientHostname: w.clientHostname,
+		ConfigKeys:     configKeys,
+		Prefixes:       prefixes,
+	}
+	w.mu.RLock()
+	if len(w.sequences) > 0 {
+		reqBody.LastSequences = make(map[int]int64, len(w.sequences))
+		for namespaceID, sequence := range w.sequences {
+			reqBody.LastSequences[namespaceID] = sequence
+		}
+	}
+	w.mu.RUnlock()
+
+	return reqBody, namespaceID
+}
+
+// do 附加认证信息并发送请求，为请求创建客户端 span 并在请求头中传递链路上下文
+func (w *HTTPPollingWatcher) do(req *http.Request, attrs ...attribute.KeyValue) (*http.Response, error) {
+	req, span := w.tracer.StartRequest(req, attrs...)
+	w.tracer.Inject(req)
+
+	if err := w.credentials.Apply(req); err != nil {
+		telemetry.EndRequest(span, nil, err)
+		return nil, err
+	}
+	resp, err := w.httpClient.Do(req)
+	telemetry.EndRequest(span, resp, err)
+	if err != nil {
+		return nil, fmt.Errorf("发送请求失败: %w", err)
+	}
+	return resp, nil
+}
+
+// serverBusyError 服务端繁忙（长轮询连接数已达上限或暂不可用）
+type serverBusyError struct {
+	retryAfter time.Duration // 服务端建议的重试等待时间（未返回 Retry-After 时为 0）
+}
+
+func (e *serverBusyError) Error() string {
+	return fmt.Sprintf("服务端繁忙，%v 后重试", e.retryAfter)
+}
+
+// newServerBusyError 根据 Retry-After 头创建服务端繁忙错误（头缺失或无效时按退避时间等待）
+func newServerBusyError(retryAfter string) *serverBusyError {
+	return &serverBusyError{retryAfter: retry.RetryAfter(retryAfter)}
+}
+
+// heartbeatLoop 心跳循环：定期上报心跳，避免长轮询间隔较长时订阅被服务端判定为超时
+// 长轮询期间同样发送心跳，服务端据此区分已下线的客户端和轮询较慢的客户端
+func (w *HTTPPollingWatcher) heartbeatLoop(interval time.Duration) {
+	defer w.wg.Done()
+
+	timer := time.NewTimer(w.heartbeatInterval(interval))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-timer.C:
+			if err := w.sendHeartbeat(); err != nil {
+				hlog.Warnf("发送心跳失败: %v", err)
+			}
+			timer.Reset(w.heartbeatInterval(interval))
+		}
+	}
+}
+
+// heartbeatInterval 实际的心跳间隔：不超过服务端心跳超时阈值的 1/3（未收到阈值时使用设置的间隔）
+func (w *HTTPPollingWatcher) heartbeatInterval(interval time.Duration) time.Duration {
+	w.mu.RLock()
+	timeout := w.heartbeatTimeout
+	w.mu.RUnlock()
+
+	if limit := max(timeout/3, minHeartbeatInterval); timeout > 0 && interval > limit {
+		return limit
+	}
+	return interval
+}
+
+// subscriptionScope 服务端记录订阅的范围（命名空间和环境）
+type subscriptionScope struct {
+	namespaceID int
+	environment string
+}
+
+// subscriptionScopes 监听的配置和前缀所在的命名空间和环境
+func (w *HTTPPollingWatcher) subscriptionScopes() map[subscriptionScope]bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	scopes := make(map[subscriptionScope]bool)
+	for _, key := range w.watchKeys {
+		scopes[subscriptionScope{namespaceID: key.NamespaceID, environment: key.EnvironmentOrDefault()}] = true
+	}
+	for _, prefix := range w.prefixes {
+		scopes[subscriptionScope{namespaceID: prefix.NamespaceID, environment: prefix.EnvironmentOrDefault()}] = true
+	}
+	return scopes
+}
+
+// sendHeartbeat 发送一次心跳
+// 服务端按命名空间和环境分别记录订阅，这里为每个监听的命名空间和环境各发送一次心跳
+func (w *HTTPPollingWatcher) sendHeartbeat() error {
+	for scope := range w.subscriptionScopes() {
+		if err := w.sendNamespaceHeartbeat(scope.namespaceID, scope.environment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unsubscribe 取消每个监听的命名空间和环境下的订阅（尝试全部范围，返回遇到的第一个错误）
+func (w *HTTPPollingWatcher) unsubscribe(ctx context.Context) error {
+	var firstErr error
+	for scope := range w.subscriptionScopes() {
+		if _, err := w.sendSubscriptionRequest(ctx, "unsubscribe", scope.namespaceID, scope.environment); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// sendNamespaceHeartbeat 发送指定命名空间和环境的心跳，并记录服务端返回的心跳超时阈值
+func (w *HTTPPollingWatcher) sendNamespaceHeartbeat(namespaceID int, environment string) error {
+	body, err := w.sendSubscriptionRequest(w.ctx, "heartbeat", namespaceID, environment)
+	if err != nil || body == nil {
+		return err
 	}
 
+	var heartbeatResp struct {
+		Data struct {
+			HeartbeatTimeoutSeconds int `json:"heartbeat_timeout_seconds"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &heartbeatResp); err != nil {
+		return nil // 旧版本服务端或非标准响应，按设置的间隔发送
+	}
+	if seconds := heartbeatResp.Data.HeartbeatTimeoutSeconds; seconds > 0 {
+		w.mu.Lock()
+		w.heartbeatTimeout = time.Duration(seconds) * time.Second
+		w.mu.Unlock()
+	}
 	return nil
 }
 
+// sendSubscriptionRequest 发送指定命名空间和环境的订阅请求（心跳或取消订阅），返回响应体（订阅不存在时为 nil）
+func (w *HTTPPollingWatcher) sendSubscriptionRequest(ctx context.Context, action string, namespaceID int, environment string) ([]byte, error) {
+	jsonData, err := json.Marshal(map[string]interface{}{
+		"client_id":    w.clientID,
+		"namespace_id": namespaceID,
+		"environment":  environment, // 与长轮询请求使用的环境一致
+	})
+	if err != nil {
+		return nil, fmt.Errorf("序列化请求失败: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/api/v1/subscriptions/%s", w.serverURL, action)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("创建请求失败: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.do(req, attribute.String("client.id", w.clientID), attribute.Int("config.namespace_id", namespaceID),
+		attribute.String("config.environment", environment))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// 订阅在首次长轮询时创建，此前的心跳或取消订阅返回 404，忽略即可
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("请求失败: status=%d, body=%s", resp.StatusCode, string(body))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("读取响应失败: %w", err)
+	}
+	return body, nil
+}
+
+// nextPollDelay 下一次请求前的等待时间：服务端返回了建议间隔时按建议（不超过 maxPollDelay），否则为 fallback
+func (w *HTTPPollingWatcher) nextPollDelay(fallback time.Duration) time.Duration {
+	w.mu.RLock()
+	delay := w.pollDelay
+	w.mu.RUnlock()
+
+	if delay <= 0 {
+		return fallback
+	}
+	return min(delay, maxPollDelay)
+}
+
+// handlePollingResponse 处理长轮询响应：记录各命名空间的事件序号和服务端建议的请求间隔，并分发配置变更
+// 服务端未返回 sequences 时（旧版本服务端），sequence 对应第一个配置的命名空间
+func (w *HTTPPollingWatcher) handlePollingResponse(namespaceID int, resp *HTTPPollingResponse) {
+	w.mu.Lock()
+	w.pollDelay = time.Duration(resp.NextPollDelayMs) * time.Millisecond
+	if len(resp.Sequences) > 0 {
+		for ns, sequence := range resp.Sequences {
+			if sequence > 0 {
+				w.sequences[ns] = sequence
+			}
+		}
+	} else if resp.Sequence > 0 {
+		w.sequences[namespaceID] = resp.Sequence
+	}
+	w.mu.Unlock()
+
+	if resp.Changed {
+		w.handleConfigChanges(resp)
+	}
+}
+
 // handleConfigChanges 处理配置变更
+// 变更的配置按键监听时回调按键监听的回调，匹配前缀监听时回调前缀的回调（两者均匹配时各回调一次）
 func (w *HTTPPollingWatcher) handleConfigChanges(resp *HTTPPollingResponse) {
 	w.mu.RLock()
 	// 先读取需要的数据
@@ -340,46 +790,65 @@ func (w *HTTPPollingWatcher) handleConfigChanges(resp *HTTPPollingResponse) {
 
 	for _, config := range resp.Configs {
 		key := w.formatKey(config.NamespaceID, config.ConfigKey)
-		callback, exists := w.callbacks[key]
-		if !exists {
+		callbacks, namespace, environment, known := w.matchCallbacks(key, config.NamespaceID, config.ConfigKey)
+		if len(callbacks) == 0 {
 			continue
 		}
 
-		// 获取原始 WatchKey 信息
-		watchKey, exists := w.watchKeys[key]
-		if !exists {
-			continue
+		// 校验配置值签名（按监听的环境校验，不信任响应中的环境），校验失败的变更丢弃（版本号仍然更新，避免反复拉取同一变更）
+		if w.publicKey != nil {
+			if err := signing.Verify(w.publicKey, config.NamespaceID, environment, config.ConfigKey, config.Value, config.Signature); err != nil {
+				hlog.Errorf("配置值签名校验失败，丢弃变更: %v", err)
+				continue
+			}
 		}
 
-		// 构建事件
+		// 构建事件（响应中已携带最新值，无需再查询配置）
 		event := &listener.ConfigChangeEvent{
 			NamespaceID: config.NamespaceID,
+			Namespace:   namespace,
 			ConfigKey:   config.ConfigKey,
 			Action:      listener.EventTypeUpdate,
 			Value:       config.Value,
+			ValueType:   config.ValueType,
 			Version:     config.Version,
 			Timestamp:   time.Now(),
 		}
-
-		if watchKey.Namespace != "" {
-			event.Namespace = watchKey.Namespace
+		// 客户端此前未持有的配置（未上报版本，如前缀下新建的配置）为创建
+		if config.Deleted {
+			event.Action = listener.EventTypeDelete
+		} else if !known {
+			event.Action = listener.EventTypeCreate
 		}
 
-		eventsToSend = append(eventsToSend, struct {
-			event    *listener.ConfigChangeEvent
-			callback listener.ConfigChangeCallback
-		}{event: event, callback: callback})
+		for _, callback := range callbacks {
+			eventsToSend = append(eventsToSend, struct {
+				event    *listener.ConfigChangeEvent
+				callback listener.ConfigChangeCallback
+			}{event: event, callback: callback})
+		}
 	}
 	w.mu.RUnlock()
 
 	// 更新版本号（需要写锁）
-	if len(eventsToSend) > 0 {
+	if len(resp.Configs) > 0 {
 		w.mu.Lock()
 		for _, config := range resp.Configs {
 			key := w.formatKey(config.NamespaceID, config.ConfigKey)
 			if watchKey, exists := w.watchKeys[key]; exists {
 				watchKey.Version = config.Version
 			}
+			for _, prefix := range w.prefixes {
+				if prefix.NamespaceID != config.NamespaceID || !strings.HasPrefix(config.ConfigKey, prefix.Prefix) {
+					continue
+				}
+				// 已删除的配置不再上报版本，重新创建时作为新配置返回
+				if config.Deleted {
+					delete(prefix.Versions, config.ConfigKey)
+				} else {
+					prefix.Versions[config.ConfigKey] = config.Version
+				}
+			}
 		}
 		w.mu.Unlock()
 	}
@@ -390,6 +859,43 @@ func (w *HTTPPollingWatcher) handleConfigChanges(resp *HTTPPollingResponse) {
 	}
 }
 
+// matchCallbacks 查找变更配置对应的回调（按键监听和匹配的前缀监听）、命名空间名称、监听的环境，
+// 以及客户端此前是否持有该配置（上报过版本号），调用方需持有读锁
+func (w *HTTPPollingWatcher) matchCallbacks(key string, namespaceID int, configKey string) ([]listener.ConfigChangeCallback, string, string, bool) {
+	callbacks := make([]listener.ConfigChangeCallback, 0, 1)
+	namespace, environment := "", ""
+	known := false
+
+	if callback, exists := w.callbacks[key]; exists {
+		if watchKey, exists := w.watchKeys[key]; exists {
+			callbacks = append(callbacks, callback)
+			namespace = watchKey.Namespace
+			environment = watchKey.EnvironmentOrDefault()
+			known = watchKey.Version != ""
+		}
+	}
+
+	for k, prefix := range w.prefixes {
+		if prefix.NamespaceID != namespaceID || !strings.HasPrefix(configKey, prefix.Prefix) {
+			continue
+		}
+		if callback, exists := w.prefixCallbacks[k]; exists {
+			callbacks = append(callbacks, callback)
+			if namespace == "" {
+				namespace = prefix.Namespace
+			}
+			if environment == "" {
+				environment = prefix.EnvironmentOrDefault()
+			}
+			if prefix.Versions[configKey] != "" {
+				known = true
+			}
+		}
+	}
+
+	return callbacks, namespace, environment, known
+}
+
 // formatKey 格式化配置键
 func (w *HTTPPollingWatcher) formatKey(namespaceID int, configKey string) string {
 	return fmt.Sprintf("%d:%s", namespaceID, configKey)
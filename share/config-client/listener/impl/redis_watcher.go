@@ -3,25 +3,40 @@ package impl
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"strings"
 	"sync"
 	"time"
 
 	"config-client/share/config-client/listener"
+	"config-client/share/config-client/retry"
 
+	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/redis/go-redis/v9"
 )
 
 const (
 	// ConfigChangeChannel Redis Pub/Sub 通道名称
 	ConfigChangeChannel = "config:change"
+
+	// fetchValueTimeout 查询变更配置最新值的超时时间
+	fetchValueTimeout = 5 * time.Second
+
+	// gapRecoveryTimeout 重连后查询监听配置最新值的超时时间
+	gapRecoveryTimeout = 30 * time.Second
+
+	// redisPingInterval 超过该时间未收到消息时发送 ping，再经过该时间仍未收到任何回复时视为连接断开
+	redisPingInterval = 30 * time.Second
 )
 
 // RedisWatcher Redis直连配置监听器
-// 通过订阅Redis Pub/Sub频道实时接收配置变更事件
+// 通过订阅Redis Pub/Sub频道实时接收配置变更事件，支持单机、哨兵和集群模式的客户端
+// Redis 通知只携带变更的配置键，设置 ValueFetcher 后在回调前查询最新值，回调收到的事件与 HTTP 长轮询一致
+// 连接断开后按重试策略退避重连并重新订阅频道，重连成功后查询监听配置的最新值，补发断线期间遗漏的变更
 type RedisWatcher struct {
-	client    *redis.Client                              // Redis客户端
+	client    redis.UniversalClient                      // Redis客户端
 	mu        sync.RWMutex                               // 读写锁
 	watchKeys map[string]*listener.WatchKey              // key -> WatchKey (key格式: "namespaceID:configKey")
 	callbacks map[string][]listener.ConfigChangeCallback // key -> callbacks (支持多个回调)
@@ -29,7 +44,15 @@ type RedisWatcher struct {
 	ctx       context.Context                            // 上下文
 	cancel    context.CancelFunc                         // 取消函数
 	wg        sync.WaitGroup                             // 等待组
-	pubsub    *redis.PubSub                              // Pub/Sub实例
+	pubsub    *redis.PubSub                              // Pub/Sub实例（重连时替换，读写需持有锁）
+	fetcher   ValueFetcher                               // 查询变更配置的最新值（为 nil 时事件不携带值）
+	lister    ConfigLister                               // 查询命名空间下的全部配置（重连后补发前缀下新建的配置，为 nil 时不补发）
+	retry     *retry.Policy                              // 重连的退避策略
+
+	prefixes        map[string]*listener.WatchPrefix           // 前缀监听（key格式: "namespaceID:prefix"）
+	prefixCallbacks map[string][]listener.ConfigChangeCallback // 前缀 -> callbacks
+
+	tracker statusTracker // 连接状态
 }
 
 // RedisConfigEvent Redis中的配置变更事件
@@ -37,19 +60,70 @@ type RedisConfigEvent struct {
 	NamespaceID int    `json:"namespace_id"`
 	ConfigKey   string `json:"config_key"`
 	ConfigID    int    `json:"config_id"`
+	Environment string `json:"environment"` // 配置所属环境（为空表示未知）
 	Action      string `json:"action"`
 }
 
+// ConfigValue 查询到的配置最新值
+type ConfigValue struct {
+	Value     string // 配置值
+	ValueType string // 值类型
+	Version   string // 版本号
+}
+
+// ValueFetcher 查询配置在指定环境下的最新值，配置不存在或不可读取时返回 nil
+type ValueFetcher func(ctx context.Context, namespaceID int, environment, key string) (*ConfigValue, error)
+
+// ConfigLister 查询命名空间在指定环境下的全部配置（配置键 -> 最新值）
+type ConfigLister func(ctx context.Context, namespaceID int, environment string) (map[string]*ConfigValue, error)
+
 // NewRedisWatcher 创建Redis监听器
-func NewRedisWatcher(client *redis.Client) *RedisWatcher {
+func NewRedisWatcher(client redis.UniversalClient) *RedisWatcher {
 	return &RedisWatcher{
 		client:    client,
 		watchKeys: make(map[string]*listener.WatchKey),
 		callbacks: make(map[string][]listener.ConfigChangeCallback),
 		running:   false,
+		retry:     retry.DefaultPolicy(),
+
+		prefixes:        make(map[string]*listener.WatchPrefix),
+		prefixCallbacks: make(map[string][]listener.ConfigChangeCallback),
 	}
 }
 
+// SetValueFetcher 设置查询配置最新值的函数（需在 Start 之前调用）
+// 设置后创建和更新事件在回调前查询最新值并填充值、值类型和版本，配置已不存在或不可读取时按删除事件回调；
+// 查询失败时事件不携带值（记录日志），与未设置时一致
+func (w *RedisWatcher) SetValueFetcher(fetcher ValueFetcher) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.fetcher = fetcher
+}
+
+// SetConfigLister 设置查询命名空间全部配置的函数（需在 Start 之前调用）
+// 设置后重连时按前缀监听的配置同样补发断线期间新建的配置，未设置时只补发已知配置的更新和删除
+func (w *RedisWatcher) SetConfigLister(lister ConfigLister) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.lister = lister
+}
+
+// SetRetryPolicy 设置重连的退避策略（需在 Start 之前调用，为 nil 时使用默认策略）
+// 连接断开后按策略的退避时间等待后重连（不限次数），重连成功后重置
+func (w *RedisWatcher) SetRetryPolicy(policy *retry.Policy) {
+	if policy == nil {
+		policy = retry.DefaultPolicy()
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.retry = policy
+}
+
+// SetStatusCallback 设置连接状态变化回调（在接收循环中同步调用，不应阻塞；为 nil 时不回调）
+func (w *RedisWatcher) SetStatusCallback(callback listener.StatusChangeCallback) {
+	w.tracker.SetStatusCallback(callback)
+}
+
 // Start 启动监听器
 func (w *RedisWatcher) Start(ctx context.Context) error {
 	w.mu.Lock()
@@ -59,23 +133,27 @@ func (w *RedisWatcher) Start(ctx context.Context) error {
 	}
 	w.running = true
 	w.mu.Unlock()
+	w.tracker.starting()
 
 	ctx, cancel := context.WithCancel(ctx)
 	w.ctx = ctx
 	w.cancel = cancel
 
-	// 订阅Redis频道
-	w.pubsub = w.client.Subscribe(ctx, ConfigChangeChannel)
-
-	// 等待订阅确认
-	_, err := w.pubsub.Receive(ctx)
+	// 订阅Redis频道并等待订阅确认
+	pubsub, err := w.subscribe()
 	if err != nil {
 		cancel()
 		w.mu.Lock()
 		w.running = false
 		w.mu.Unlock()
-		return fmt.Errorf("订阅Redis频道失败: %w", err)
+		w.tracker.fail(err)
+		w.tracker.stopped()
+		return err
 	}
+	w.mu.Lock()
+	w.pubsub = pubsub
+	w.mu.Unlock()
+	w.tracker.succeed()
 
 	// 启动事件监听循环
 	w.wg.Add(1)
@@ -98,13 +176,17 @@ func (w *RedisWatcher) Stop() error {
 		w.cancel()
 	}
 
-	if w.pubsub != nil {
-		if err := w.pubsub.Close(); err != nil {
+	w.mu.RLock()
+	pubsub := w.pubsub
+	w.mu.RUnlock()
+	if pubsub != nil {
+		if err := pubsub.Close(); err != nil {
 			return fmt.Errorf("关闭Redis Pub/Sub失败: %w", err)
 		}
 	}
 
 	w.wg.Wait()
+	w.tracker.stopped()
 	return nil
 }
 
@@ -115,7 +197,12 @@ func (w *RedisWatcher) Watch(keys []*listener.WatchKey, callback listener.Config
 
 	for _, key := range keys {
 		k := w.formatKey(key.NamespaceID, key.Key)
-		w.watchKeys[k] = key
+		watchKey := *key
+		// 如果已经存在监听,保留原有的版本号(重连后据此判断断线期间是否变更)
+		if existing, exists := w.watchKeys[k]; exists && watchKey.Version == "" {
+			watchKey.Version = existing.Version
+		}
+		w.watchKeys[k] = &watchKey
 		w.callbacks[k] = append(w.callbacks[k], callback)
 	}
 
@@ -143,6 +230,48 @@ func (w *RedisWatcher) UnwatchAll() error {
 
 	w.watchKeys = make(map[string]*listener.WatchKey)
 	w.callbacks = make(map[string][]listener.ConfigChangeCallback)
+	w.prefixes = make(map[string]*listener.WatchPrefix)
+	w.prefixCallbacks = make(map[string][]listener.ConfigChangeCallback)
+	return nil
+}
+
+// WatchPrefix 添加前缀监听（Redis 通知包含所有配置的变更，按前缀过滤即可；前缀下已知配置的版本用于重连后补发遗漏的变更）
+func (w *RedisWatcher) WatchPrefix(prefixes []*listener.WatchPrefix, callback listener.ConfigChangeCallback) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, prefix := range prefixes {
+		k := w.formatKey(prefix.NamespaceID, prefix.Prefix)
+		watchPrefix := *prefix
+		watchPrefix.Versions = make(map[string]string, len(prefix.Versions))
+		for key, version := range prefix.Versions {
+			watchPrefix.Versions[key] = version
+		}
+		if existing, exists := w.prefixes[k]; exists {
+			for key, version := range existing.Versions {
+				if _, known := watchPrefix.Versions[key]; !known {
+					watchPrefix.Versions[key] = version
+				}
+			}
+		}
+		w.prefixes[k] = &watchPrefix
+		w.prefixCallbacks[k] = append(w.prefixCallbacks[k], callback)
+	}
+
+	return nil
+}
+
+// UnwatchPrefix 取消前缀监听
+func (w *RedisWatcher) UnwatchPrefix(prefixes []*listener.WatchPrefix) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, prefix := range prefixes {
+		k := w.formatKey(prefix.NamespaceID, prefix.Prefix)
+		delete(w.prefixes, k)
+		delete(w.prefixCallbacks, k)
+	}
+
 	return nil
 }
 
@@ -153,55 +282,178 @@ func (w *RedisWatcher) IsRunning() bool {
 	return w.running
 }
 
-// receiveLoop 接收Redis消息循环
+// Status 连接状态、最近一次收到通知（或订阅确认）的时间、连续失败次数和重连次数
+func (w *RedisWatcher) Status() listener.WatcherStatus {
+	return w.tracker.Status()
+}
+
+// receiveLoop 接收Redis消息循环：连接断开后退避重连，重连成功后补发断线期间遗漏的变更
 func (w *RedisWatcher) receiveLoop() {
 	defer w.wg.Done()
 
-	ch := w.pubsub.Channel()
 	for {
-		select {
-		case <-w.ctx.Done():
+		err := w.receive()
+		if w.ctx.Err() != nil {
 			return
-		case msg, ok := <-ch:
-			if !ok {
-				return
+		}
+		hlog.Errorf("Redis 订阅连接断开: %v", err)
+		w.tracker.fail(err)
+		if !w.reconnect() {
+			return
+		}
+		w.recoverGap()
+	}
+}
+
+// receive 接收消息直到连接断开（返回断开的原因）或监听器停止
+// 超过 redisPingInterval 未收到消息时发送 ping，再经过 redisPingInterval 仍未收到任何回复时视为连接断开
+func (w *RedisWatcher) receive() error {
+	w.mu.RLock()
+	pubsub := w.pubsub
+	w.mu.RUnlock()
+
+	idle := false // 是否已发送 ping 且尚未收到回复
+	for {
+		msg, err := pubsub.ReceiveTimeout(w.ctx, redisPingInterval)
+		if err != nil {
+			if w.ctx.Err() != nil {
+				return err
 			}
+			var netErr net.Error
+			if !errors.As(err, &netErr) || !netErr.Timeout() {
+				return err
+			}
+			if idle {
+				return fmt.Errorf("超过 %v 未收到 Redis 的回复", 2*redisPingInterval)
+			}
+			if err := pubsub.Ping(w.ctx); err != nil {
+				return err
+			}
+			idle = true
+			continue
+		}
+
+		idle = false
+		if msg, ok := msg.(*redis.Message); ok {
+			w.tracker.succeed()
 			w.handleMessage(msg)
 		}
 	}
 }
 
+// reconnect 按退避策略重新订阅频道直到成功（替换 Pub/Sub 实例），监听器停止时返回 false
+func (w *RedisWatcher) reconnect() bool {
+	w.mu.RLock()
+	policy := w.retry
+	w.mu.RUnlock()
+
+	for failures := 1; ; failures++ {
+		delay := max(policy.Backoff(failures), minPollingRetryDelay)
+		select {
+		case <-w.ctx.Done():
+			return false
+		case <-time.After(delay):
+		}
+
+		pubsub, err := w.subscribe()
+		if err != nil {
+			if w.ctx.Err() != nil {
+				return false
+			}
+			hlog.Errorf("Redis 重新订阅失败（连续 %d 次），%v 后重试: %v", failures, policy.Backoff(failures+1), err)
+			w.tracker.fail(err)
+			continue
+		}
+
+		w.mu.Lock()
+		old := w.pubsub
+		w.pubsub = pubsub
+		w.mu.Unlock()
+		if old != nil {
+			old.Close()
+		}
+		// Stop 在替换前关闭了旧实例，新实例需在此关闭
+		if w.ctx.Err() != nil {
+			pubsub.Close()
+			return false
+		}
+
+		hlog.Infof("Redis 重新订阅成功（重试 %d 次）", failures)
+		w.tracker.succeed()
+		return true
+	}
+}
+
+// subscribe 订阅配置变更频道并等待订阅确认
+func (w *RedisWatcher) subscribe() (*redis.PubSub, error) {
+	pubsub := w.client.Subscribe(w.ctx, ConfigChangeChannel)
+	if _, err := pubsub.Receive(w.ctx); err != nil {
+		pubsub.Close()
+		return nil, fmt.Errorf("订阅Redis频道失败: %w", err)
+	}
+	return pubsub, nil
+}
+
 // handleMessage 处理Redis消息
+// 事件携带环境时只回调监听该环境的配置和前缀（与 HTTP 长轮询一致），未携带环境时回调所有匹配的监听
 func (w *RedisWatcher) handleMessage(msg *redis.Message) {
 	// 解析事件
 	var event RedisConfigEvent
 	if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
 		return
 	}
+	w.notify(&event, nil, false)
+}
 
+// notify 回调与事件匹配的按键和前缀监听，并记录配置的最新版本
+// fetched 为 true 时 value 为已查询到的最新值（nil 表示配置已不存在），否则按需查询最新值
+func (w *RedisWatcher) notify(event *RedisConfigEvent, value *ConfigValue, fetched bool) {
 	key := w.formatKey(event.NamespaceID, event.ConfigKey)
 
 	w.mu.RLock()
-	callbacks, exists := w.callbacks[key]
-	watchKey, hasKey := w.watchKeys[key]
+	var callbacks []listener.ConfigChangeCallback
+	namespace, environment := "", ""
+	if watchKey, exists := w.watchKeys[key]; exists && matchesEnvironment(event.Environment, watchKey.EnvironmentOrDefault()) {
+		callbacks = append(callbacks, w.callbacks[key]...)
+		namespace, environment = watchKey.Namespace, watchKey.EnvironmentOrDefault()
+	}
+	for k, prefix := range w.prefixes {
+		if prefix.NamespaceID != event.NamespaceID || !strings.HasPrefix(event.ConfigKey, prefix.Prefix) ||
+			!matchesEnvironment(event.Environment, prefix.EnvironmentOrDefault()) {
+			continue
+		}
+		callbacks = append(callbacks, w.prefixCallbacks[k]...)
+		if namespace == "" {
+			namespace = prefix.Namespace
+		}
+		if environment == "" {
+			environment = prefix.EnvironmentOrDefault()
+		}
+	}
+	fetcher := w.fetcher
 	w.mu.RUnlock()
 
-	if !exists || len(callbacks) == 0 {
+	if len(callbacks) == 0 {
 		return
 	}
 
 	// 构建变更事件
 	changeEvent := &listener.ConfigChangeEvent{
 		NamespaceID: event.NamespaceID,
+		Namespace:   namespace,
 		ConfigKey:   event.ConfigKey,
 		ConfigID:    event.ConfigID,
 		Action:      listener.ConfigEventType(event.Action),
 		Timestamp:   time.Now(),
 	}
 
-	if hasKey && watchKey.Namespace != "" {
-		changeEvent.Namespace = watchKey.Namespace
+	// 查询最新值（删除事件不需要查询）
+	if fetched {
+		applyValue(changeEvent, value)
+	} else if fetcher != nil && changeEvent.Action != listener.EventTypeDelete {
+		w.fillValue(fetcher, changeEvent, environment)
 	}
+	w.recordVersion(changeEvent, environment)
 
 	// 异步调用所有回调
 	for _, callback := range callbacks {
@@ -209,6 +461,195 @@ func (w *RedisWatcher) handleMessage(msg *redis.Message) {
 	}
 }
 
+// fillValue 查询变更配置的最新值并填充到事件中，配置已不存在或不可读取时转为删除事件
+func (w *RedisWatcher) fillValue(fetcher ValueFetcher, event *listener.ConfigChangeEvent, environment string) {
+	ctx, cancel := context.WithTimeout(w.ctx, fetchValueTimeout)
+	defer cancel()
+
+	value, err := fetcher(ctx, event.NamespaceID, environment, event.ConfigKey)
+	if err != nil {
+		hlog.Warnf("查询变更配置的最新值失败，回调不携带值: namespace_id=%d, environment=%s, key=%s, error=%v",
+			event.NamespaceID, environment, event.ConfigKey, err)
+		return
+	}
+	applyValue(event, value)
+}
+
+// applyValue 将查询到的最新值填充到事件中，配置已不存在或不可读取时转为删除事件
+func applyValue(event *listener.ConfigChangeEvent, value *ConfigValue) {
+	if value == nil {
+		event.Action = listener.EventTypeDelete
+		return
+	}
+	event.Value = value.Value
+	event.ValueType = value.ValueType
+	event.Version = value.Version
+}
+
+// recordVersion 记录监听的配置回调后的版本（重连后据此判断断线期间是否变更），事件未携带版本时保留原有版本
+func (w *RedisWatcher) recordVersion(event *listener.ConfigChangeEvent, environment string) {
+	deleted := event.Action == listener.EventTypeDelete
+	if !deleted && event.Version == "" {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if watchKey, exists := w.watchKeys[w.formatKey(event.NamespaceID, event.ConfigKey)]; exists && watchKey.EnvironmentOrDefault() == environment {
+		watchKey.Version = event.Version
+	}
+	for _, prefix := range w.prefixes {
+		if prefix.NamespaceID != event.NamespaceID || prefix.EnvironmentOrDefault() != environment ||
+			!strings.HasPrefix(event.ConfigKey, prefix.Prefix) {
+			continue
+		}
+		if deleted {
+			delete(prefix.Versions, event.ConfigKey)
+		} else {
+			prefix.Versions[event.ConfigKey] = event.Version
+		}
+	}
+}
+
+// gapChange 重连后发现的断线期间的变更
+type gapChange struct {
+	event *RedisConfigEvent
+	value *ConfigValue // 最新值（删除时为 nil）
+}
+
+// recoverGap 重连后查询监听配置的最新值，与已知版本比较后补发断线期间遗漏的变更（未设置 ValueFetcher 时跳过）
+// 业务规则：
+// 1. 按键监听的配置：版本变化时回调更新，配置已不存在时回调删除；此前未记录版本的配置只记录当前版本
+// 2. 按前缀监听的配置：设置了 ConfigLister 时查询命名空间的全部配置，前缀下新建的配置回调创建，否则只查询前缀下已知的配置
+// 3. 同一配置同时匹配按键和前缀监听时只补发一次
+func (w *RedisWatcher) recoverGap() {
+	w.mu.RLock()
+	fetcher, lister := w.fetcher, w.lister
+	keys := make([]listener.WatchKey, 0, len(w.watchKeys))
+	for _, key := range w.watchKeys {
+		keys = append(keys, *key)
+	}
+	prefixes := make([]listener.WatchPrefix, 0, len(w.prefixes))
+	for _, prefix := range w.prefixes {
+		copied := *prefix
+		copied.Versions = make(map[string]string, len(prefix.Versions))
+		for key, version := range prefix.Versions {
+			copied.Versions[key] = version
+		}
+		prefixes = append(prefixes, copied)
+	}
+	w.mu.RUnlock()
+
+	if fetcher == nil {
+		hlog.Warnf("未设置 ValueFetcher，无法补发 Redis 断线期间的配置变更")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(w.ctx, gapRecoveryTimeout)
+	defer cancel()
+
+	changes := make(map[string]*gapChange) // "命名空间ID:环境:配置键" -> 变更
+	record := func(namespaceID int, environment, key, known string, value *ConfigValue, listed bool) {
+		change := gapChangeOf(namespaceID, environment, key, known, value, listed)
+		id := fmt.Sprintf("%d:%s:%s", namespaceID, environment, key)
+		if _, exists := changes[id]; change != nil && !exists {
+			changes[id] = change
+		}
+	}
+	fetch := func(namespaceID int, environment, key string) (*ConfigValue, bool) {
+		value, err := fetcher(ctx, namespaceID, environment, key)
+		if err != nil {
+			hlog.Warnf("重连后查询配置的最新值失败: namespace_id=%d, environment=%s, key=%s, error=%v", namespaceID, environment, key, err)
+			return nil, false
+		}
+		return value, true
+	}
+
+	// 1. 按键监听的配置
+	for _, key := range keys {
+		environment := key.EnvironmentOrDefault()
+		value, ok := fetch(key.NamespaceID, environment, key.Key)
+		if !ok {
+			continue
+		}
+		if key.Version == "" && value != nil {
+			w.recordVersion(&listener.ConfigChangeEvent{
+				NamespaceID: key.NamespaceID,
+				ConfigKey:   key.Key,
+				Action:      listener.EventTypeUpdate,
+				Version:     value.Version,
+			}, environment)
+			continue
+		}
+		record(key.NamespaceID, environment, key.Key, key.Version, value, false)
+	}
+
+	// 2. 按前缀监听的配置（同一命名空间和环境只查询一次全部配置）
+	listed := make(map[string]map[string]*ConfigValue)
+	for _, prefix := range prefixes {
+		environment := prefix.EnvironmentOrDefault()
+		if lister != nil {
+			scope := fmt.Sprintf("%d:%s", prefix.NamespaceID, environment)
+			values, exists := listed[scope]
+			if !exists {
+				var err error
+				if values, err = lister(ctx, prefix.NamespaceID, environment); err != nil {
+					hlog.Warnf("重连后查询命名空间的全部配置失败，只补发已知配置的变更: namespace_id=%d, environment=%s, error=%v",
+						prefix.NamespaceID, environment, err)
+				}
+				listed[scope] = values
+			}
+			if values != nil {
+				for key, value := range values {
+					if strings.HasPrefix(key, prefix.Prefix) {
+						record(prefix.NamespaceID, environment, key, prefix.Versions[key], value, true)
+					}
+				}
+				for key, known := range prefix.Versions {
+					if _, exists := values[key]; !exists {
+						record(prefix.NamespaceID, environment, key, known, nil, true)
+					}
+				}
+				continue
+			}
+		}
+		for key, known := range prefix.Versions {
+			if value, ok := fetch(prefix.NamespaceID, environment, key); ok {
+				record(prefix.NamespaceID, environment, key, known, value, false)
+			}
+		}
+	}
+
+	if len(changes) > 0 {
+		hlog.Infof("补发 Redis 断线期间的配置变更: %d 个", len(changes))
+	}
+	for _, change := range changes {
+		w.notify(change.event, change.value, true)
+	}
+}
+
+// gapChangeOf 比较配置的已知版本和最新值，返回断线期间的变更（未变更或无法判断时返回 nil）
+// listed 为 true 时最新值来自命名空间的全部配置，未知版本的配置视为断线期间新建
+func gapChangeOf(namespaceID int, environment, key, known string, value *ConfigValue, listed bool) *gapChange {
+	event := &RedisConfigEvent{NamespaceID: namespaceID, ConfigKey: key, Environment: environment}
+	switch {
+	case value != nil && known == "" && listed:
+		event.Action = string(listener.EventTypeCreate)
+	case value == nil && known != "":
+		event.Action = string(listener.EventTypeDelete)
+	case value != nil && known != "" && value.Version != known:
+		event.Action = string(listener.EventTypeUpdate)
+	default:
+		return nil
+	}
+	return &gapChange{event: event, value: value}
+}
+
+// matchesEnvironment 事件的环境是否与监听的环境一致（事件未携带环境时视为一致）
+func matchesEnvironment(eventEnvironment, watchEnvironment string) bool {
+	return eventEnvironment == "" || eventEnvironment == watchEnvironment
+}
+
 // formatKey 格式化配置键
 func (w *RedisWatcher) formatKey(namespaceID int, configKey string) string {
 	return fmt.Sprintf("%d:%s", namespaceID, configKey)
@@ -0,0 +1,101 @@
+package impl
+
+import (
+	"sync"
+	"time"
+
+	"config-client/share/config-client/listener"
+)
+
+// statusTracker 记录监听器的连接状态，状态变化时回调
+type statusTracker struct {
+	mu        sync.Mutex
+	status    listener.WatcherStatus
+	connected bool                          // 是否连接成功过（此后断开再连接成功计为重连）
+	onChange  listener.StatusChangeCallback // 状态变化回调
+}
+
+// Status 当前状态
+func (t *statusTracker) Status() listener.WatcherStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.status
+}
+
+// SetStatusCallback 设置连接状态变化回调（在监听循环中同步调用，不应阻塞）
+func (t *statusTracker) SetStatusCallback(callback listener.StatusChangeCallback) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.onChange = callback
+}
+
+// starting 监听器启动（重新启动后首次连接成功不计为重连）
+func (t *statusTracker) starting() {
+	t.update(func(s *listener.WatcherStatus) {
+		s.State = listener.StateConnecting
+		t.connected = false
+	})
+}
+
+// stopped 监听器停止
+func (t *statusTracker) stopped() {
+	t.update(func(s *listener.WatcherStatus) {
+		s.State = listener.StateStopped
+	})
+}
+
+// connect 连接建立（WebSocket 握手成功），断开后再次建立计为重连
+func (t *statusTracker) connect() {
+	t.update(t.markConnected)
+}
+
+// succeed 成功收到服务端响应：连接状态变为已连接，连续失败次数清零
+func (t *statusTracker) succeed() {
+	t.update(func(s *listener.WatcherStatus) {
+		t.markConnected(s)
+		s.LastSuccessAt = time.Now()
+		s.ConsecutiveFailures = 0
+	})
+}
+
+// fail 请求失败或连接断开：连接状态变为已断开，连续失败次数加一
+func (t *statusTracker) fail(err error) {
+	t.update(func(s *listener.WatcherStatus) {
+		s.State = listener.StateDisconnected
+		s.ConsecutiveFailures++
+		s.LastError = err
+	})
+}
+
+// reject 连接保持但请求被服务端拒绝：连接状态不变，连续失败次数加一
+func (t *statusTracker) reject(err error) {
+	t.update(func(s *listener.WatcherStatus) {
+		s.ConsecutiveFailures++
+		s.LastError = err
+	})
+}
+
+// markConnected 标记为已连接（调用方需持有锁）
+func (t *statusTracker) markConnected(s *listener.WatcherStatus) {
+	if s.State == listener.StateConnected {
+		return
+	}
+	if t.connected {
+		s.Reconnects++
+	}
+	t.connected = true
+	s.State = listener.StateConnected
+}
+
+// update 修改状态，连接状态变化时在锁外回调
+func (t *statusTracker) update(fn func(s *listener.WatcherStatus)) {
+	t.mu.Lock()
+	from := t.status.State
+	fn(&t.status)
+	status, onChange := t.status, t.onChange
+	t.mu.Unlock()
+
+	if onChange != nil && status.State != from {
+		onChange(from, status)
+	}
+}
@@ -0,0 +1,340 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"config-client/share/config-client/listener"
+	"config-client/share/config-client/telemetry"
+	"config-client/share/websocket"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// WebSocketWatcher WebSocket 配置监听器
+// 在一个 WebSocket 连接上持续发送监听请求（与长轮询请求相同），服务端有变更时返回结果，省去长轮询每次请求的连接和认证开销
+// 监听的配置增减时立即重新发送监听请求；服务端在监听结果中建议了请求间隔时，等待该间隔后再发送下一个监听请求；
+// 连接断开后按重试策略退避重连，并携带已知的版本和事件序号重新订阅，不遗漏断线期间的变更
+// 心跳、取消订阅、签名校验和变更分发与 HTTP 长轮询监听器一致
+type WebSocketWatcher struct {
+	*HTTPPollingWatcher
+
+	pingInterval time.Duration // 发送 ping 的间隔，超过 3 倍间隔未收到服务端的任何帧时重连
+	resubscribe  chan struct{} // 监听的配置或前缀变化时通知重新发送监听请求
+}
+
+// DefaultPingInterval 默认 ping 间隔（与服务端一致）
+const DefaultPingInterval = 30 * time.Second
+
+// webSocketDialTimeout 建立连接和握手的超时时间
+const webSocketDialTimeout = 10 * time.Second
+
+// WebSocket 监听的消息类型（与服务端一致）
+const (
+	webSocketMessageWatch  = "watch"
+	webSocketMessageResult = "result"
+	webSocketMessageError  = "error"
+)
+
+// webSocketWatchMessage 客户端发送的监听请求
+type webSocketWatchMessage struct {
+	Type string              `json:"type"`
+	Data *HTTPPollingRequest `json:"data"`
+}
+
+// webSocketServerMessage 服务端返回的监听结果或错误
+type webSocketServerMessage struct {
+	Type       string               `json:"type"`
+	Data       *HTTPPollingResponse `json:"data"`
+	Code       int                  `json:"code"`
+	Message    string               `json:"message"`
+	RetryAfter int                  `json:"retry_after"` // 建议重新发送请求前等待的时间（秒）
+}
+
+// NewWebSocketWatcher 创建 WebSocket 监听器
+func NewWebSocketWatcher(serverURL string) *WebSocketWatcher {
+	return &WebSocketWatcher{
+		HTTPPollingWatcher: NewHTTPPollingWatcher(serverURL, 0),
+		pingInterval:       DefaultPingInterval,
+		resubscribe:        make(chan struct{}, 1),
+	}
+}
+
+// SetPingInterval 设置 ping 间隔（需在 Start 之前调用，<=0 时使用默认间隔）
+func (w *WebSocketWatcher) SetPingInterval(interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultPingInterval
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.pingInterval = interval
+}
+
+// Start 启动监听器
+func (w *WebSocketWatcher) Start(ctx context.Context) error {
+	w.mu.Lock()
+	if w.running {
+		w.mu.Unlock()
+		return fmt.Errorf("监听器已在运行中")
+	}
+	w.running = true
+	w.mu.Unlock()
+	w.tracker.starting()
+
+	ctx, cancel := context.WithCancel(ctx)
+	w.ctx = ctx
+	w.cancel = cancel
+
+	// 启动 WebSocket 监听循环
+	w.wg.Add(1)
+	go w.watchLoop()
+
+	w.startHeartbeat()
+	return nil
+}
+
+// Watch 添加监听配置（运行中时立即按新的配置重新发送监听请求）
+func (w *WebSocketWatcher) Watch(keys []*listener.WatchKey, callback listener.ConfigChangeCallback) error {
+	defer w.notifyResubscribe()
+	return w.HTTPPollingWatcher.Watch(keys, callback)
+}
+
+// Unwatch 取消监听配置
+func (w *WebSocketWatcher) Unwatch(keys []*listener.WatchKey) error {
+	defer w.notifyResubscribe()
+	return w.HTTPPollingWatcher.Unwatch(keys)
+}
+
+// UnwatchAll 取消所有监听
+func (w *WebSocketWatcher) UnwatchAll() error {
+	defer w.notifyResubscribe()
+	return w.HTTPPollingWatcher.UnwatchAll()
+}
+
+// WatchPrefix 添加前缀监听
+func (w *WebSocketWatcher) WatchPrefix(prefixes []*listener.WatchPrefix, callback listener.ConfigChangeCallback) error {
+	defer w.notifyResubscribe()
+	return w.HTTPPollingWatcher.WatchPrefix(prefixes, callback)
+}
+
+// UnwatchPrefix 取消前缀监听
+func (w *WebSocketWatcher) UnwatchPrefix(prefixes []*listener.WatchPrefix) error {
+	defer w.notifyResubscribe()
+	return w.HTTPPollingWatcher.UnwatchPrefix(prefixes)
+}
+
+// notifyResubscribe 通知监听循环重新发送监听请求（已有未处理的通知时合并）
+func (w *WebSocketWatcher) notifyResubscribe() {
+	select {
+	case w.resubscribe <- struct{}{}:
+	default:
+	}
+}
+
+// watchLoop 连接循环：没有监听的配置时等待，连接断开后按重试策略退避重连
+func (w *WebSocketWatcher) watchLoop() {
+	defer w.wg.Done()
+
+	failures := 0 // 连续失败次数（连接收到过监听结果后清零）
+	for {
+		if w.ctx.Err() != nil {
+			return
+		}
+
+		// 如果没有监听的配置，等待增加监听（或一段时间后再次检查）
+		w.mu.RLock()
+		hasKeys := len(w.watchKeys) > 0 || len(w.prefixes) > 0
+		w.mu.RUnlock()
+		if !hasKeys {
+			select {
+			case <-w.ctx.Done():
+				return
+			case <-w.resubscribe:
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+
+		received, err := w.session()
+		if received {
+			failures = 0
+		}
+		if err == nil || w.ctx.Err() != nil {
+			continue
+		}
+
+		// 出错后按指数退避等待再重连（握手被限流时按服务端建议的时间等待）
+		w.tracker.fail(err)
+		failures++
+		delay := max(w.retryPolicy.Backoff(failures), minPollingRetryDelay)
+		var busyErr *serverBusyError
+		if errors.As(err, &busyErr) && busyErr.retryAfter > delay {
+			delay = busyErr.retryAfter
+		}
+		hlog.Errorf("WebSocket 监听连接失败（连续 %d 次），%v 后重连: %v", failures, delay, err)
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// session 建立连接并持续监听，直到连接断开、监听器停止或不再有监听的配置
+// 返回本次连接是否收到过监听结果；连接断开时返回错误，监听器停止或不再有监听的配置时返回 nil
+func (w *WebSocketWatcher) session() (bool, error) {
+	conn, err := w.dial()
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+	w.tracker.connect()
+
+	w.mu.RLock()
+	pingInterval := w.pingInterval
+	w.mu.RUnlock()
+	conn.SetReadTimeout(3 * pingInterval)
+
+	ctx, cancel := context.WithCancel(w.ctx)
+	defer cancel()
+
+	// 1. 读取服务端消息（监听器停止时关闭连接以中断读取）
+	messages := make(chan *webSocketServerMessage)
+	readErr := make(chan error, 1)
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+	go func() {
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				readErr <- err
+				return
+			}
+			var msg webSocketServerMessage
+			if err := json.Unmarshal(data, &msg); err != nil {
+				readErr <- fmt.Errorf("解析监听消息失败: %w", err)
+				return
+			}
+			select {
+			case messages <- &msg:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// 2. 发送监听请求（已有的重新订阅通知由本次请求一并处理）
+	select {
+	case <-w.resubscribe:
+	default:
+	}
+	namespaceID, ok, err := w.sendWatch(conn)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	// 3. 处理监听结果并发送下一个监听请求，定期发送 ping 保活
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+
+	received := false
+	failures := 0              // 服务端连续返回错误的次数
+	var retry <-chan time.Time // 服务端返回错误后重新发送监听请求的定时器
+	for {
+		select {
+		case <-ctx.Done():
+			return received, nil
+		case err := <-readErr:
+			return received, fmt.Errorf("WebSocket 连接断开: %w", err)
+		case <-ticker.C:
+			if err := conn.WritePing(); err != nil {
+				return received, fmt.Errorf("发送 ping 失败: %w", err)
+			}
+			continue
+		case <-w.resubscribe:
+		case <-retry:
+		case msg := <-messages:
+			if msg.Type == webSocketMessageError {
+				// 请求被拒绝（如无权限或服务端繁忙），连接保持，按退避时间等待后重新发送
+				failures++
+				delay := max(w.retryPolicy.Backoff(failures), minPollingRetryDelay)
+				if retryAfter := time.Duration(msg.RetryAfter) * time.Second; retryAfter > delay {
+					delay = retryAfter
+				}
+				hlog.Errorf("WebSocket 监听请求失败（连续 %d 次），%v 后重试: code=%d, message=%s", failures, delay, msg.Code, msg.Message)
+				w.tracker.reject(fmt.Errorf("监听请求被拒绝: code=%d, message=%s", msg.Code, msg.Message))
+				retry = time.After(delay)
+				continue
+			}
+			if msg.Type != webSocketMessageResult || msg.Data == nil {
+				continue
+			}
+			received = true
+			failures = 0
+			w.tracker.succeed()
+			w.handlePollingResponse(namespaceID, msg.Data)
+			// 服务端建议了请求间隔时等待后再发送（期间监听的配置变化时立即发送）
+			if delay := w.nextPollDelay(0); delay > 0 {
+				retry = time.After(delay)
+				continue
+			}
+		}
+
+		retry = nil
+		if namespaceID, ok, err = w.sendWatch(conn); err != nil || !ok {
+			return received, err
+		}
+	}
+}
+
+// sendWatch 按当前监听的配置发送监听请求，返回第一个配置所在的命名空间（与服务端的 sequence 对应），没有监听的配置时 ok 为 false
+func (w *WebSocketWatcher) sendWatch(conn *websocket.Conn) (int, bool, error) {
+	req, namespaceID := w.buildPollingRequest()
+	if req == nil {
+		return 0, false, nil
+	}
+	data, err := json.Marshal(webSocketWatchMessage{Type: webSocketMessageWatch, Data: req})
+	if err != nil {
+		return 0, false, fmt.Errorf("序列化请求失败: %w", err)
+	}
+	if err := conn.WriteMessage(websocket.OpText, data); err != nil {
+		return 0, false, fmt.Errorf("发送监听请求失败: %w", err)
+	}
+	return namespaceID, true, nil
+}
+
+// dial 建立 WebSocket 连接，握手请求附加认证信息并传递链路上下文
+func (w *WebSocketWatcher) dial() (*websocket.Conn, error) {
+	ctx, cancel := context.WithTimeout(w.ctx, webSocketDialTimeout)
+	defer cancel()
+
+	url := fmt.Sprintf("%s/api/v1/configs/watch/ws", w.serverURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("创建请求失败: %w", err)
+	}
+	req, span := w.tracer.StartRequest(req, attribute.String("client.id", w.clientID))
+	w.tracer.Inject(req)
+	if err := w.credentials.Apply(req); err != nil {
+		telemetry.EndRequest(span, nil, err)
+		return nil, err
+	}
+
+	conn, err := websocket.Dial(req)
+	telemetry.EndRequest(span, nil, err)
+	if err != nil {
+		var handshakeErr *websocket.HandshakeError
+		if errors.As(err, &handshakeErr) && handshakeErr.StatusCode == http.StatusTooManyRequests {
+			return nil, newServerBusyError(handshakeErr.Header.Get("Retry-After"))
+		}
+		return nil, fmt.Errorf("建立 WebSocket 连接失败: %w", err)
+	}
+	return conn, nil
+}
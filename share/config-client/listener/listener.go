@@ -22,6 +22,7 @@ type ConfigChangeEvent struct {
 	ConfigID    int             `json:"config_id"`    // 配置ID
 	Action      ConfigEventType `json:"action"`       // 操作类型
 	Value       string          `json:"value"`        // 配置值
+	ValueType   string          `json:"value_type"`   // 值类型（HTTP 长轮询时由服务端返回）
 	Version     string          `json:"version"`      // 版本号
 	Timestamp   time.Time       `json:"timestamp"`    // 变更时间
 }
@@ -29,14 +30,26 @@ type ConfigChangeEvent struct {
 // ConfigChangeCallback 配置变更回调函数
 type ConfigChangeCallback func(event *ConfigChangeEvent)
 
+// DefaultEnvironment 默认环境（WatchKey.Environment 为空时使用）
+const DefaultEnvironment = "default"
+
 // WatchKey 监听的配置键
 type WatchKey struct {
 	NamespaceID int    // 命名空间ID
 	Namespace   string // 命名空间名称
+	Environment string // 环境（为空时为 default）
 	Key         string // 配置键
 	Version     string // 当前版本号
 }
 
+// EnvironmentOrDefault 监听的环境（未指定时为默认环境）
+func (k *WatchKey) EnvironmentOrDefault() string {
+	if k.Environment == "" {
+		return DefaultEnvironment
+	}
+	return k.Environment
+}
+
 // Watcher 配置监听器接口
 type Watcher interface {
 	// Start 启动监听器
@@ -58,4 +71,75 @@ type Watcher interface {
 
 	// IsRunning 是否正在运行
 	IsRunning() bool
+
+	// Status 连接状态、最近一次成功的时间、连续失败次数和重连次数
+	Status() WatcherStatus
+}
+
+// WatchPrefix 按前缀监听的配置（前缀下新建的配置同样通知）
+type WatchPrefix struct {
+	NamespaceID int               // 命名空间ID
+	Namespace   string            // 命名空间名称
+	Environment string            // 环境（为空时为 default）
+	Prefix      string            // 配置键前缀（为空时监听命名空间下的全部配置）
+	Versions    map[string]string // 前缀下已知配置的版本号（配置键 -> 版本号），收到变更后由监听器更新
+}
+
+// EnvironmentOrDefault 监听的环境（未指定时为默认环境）
+func (p *WatchPrefix) EnvironmentOrDefault() string {
+	if p.Environment == "" {
+		return DefaultEnvironment
+	}
+	return p.Environment
+}
+
+// PrefixWatcher 支持按前缀监听的配置监听器
+type PrefixWatcher interface {
+	// WatchPrefix 添加前缀监听，前缀下任一配置（包括新建的配置）变更时回调
+	WatchPrefix(prefixes []*WatchPrefix, callback ConfigChangeCallback) error
+
+	// UnwatchPrefix 取消前缀监听
+	UnwatchPrefix(prefixes []*WatchPrefix) error
+}
+
+// ConnectionState 监听器连接状态
+type ConnectionState int
+
+const (
+	// StateStopped 未启动或已停止
+	StateStopped ConnectionState = iota
+	// StateConnecting 已启动，尚未成功连接服务端（或没有监听的配置）
+	StateConnecting
+	// StateConnected 已连接：最近一次长轮询成功、WebSocket 连接已建立或 Redis 频道已订阅
+	StateConnected
+	// StateDisconnected 连接失败或断开，正在退避重试
+	StateDisconnected
+)
+
+// String 状态名称
+func (s ConnectionState) String() string {
+	switch s {
+	case StateStopped:
+		return "stopped"
+	case StateConnecting:
+		return "connecting"
+	case StateConnected:
+		return "connected"
+	case StateDisconnected:
+		return "disconnected"
+	default:
+		return "unknown"
+	}
 }
+
+// WatcherStatus 监听器状态
+type WatcherStatus struct {
+	State               ConnectionState // 当前连接状态
+	LastSuccessAt       time.Time       // 最近一次成功收到服务端响应的时间（长轮询返回、WebSocket 收到监听结果、Redis 收到通知或订阅确认）
+	ConsecutiveFailures int             // 连续失败次数（成功后清零）
+	Reconnects          int             // 连接断开后重新连接成功的次数
+	LastError           error           // 最近一次失败的错误
+}
+
+// StatusChangeCallback 监听器连接状态变化回调（from 为变化前的状态，status 为变化后的状态）
+type StatusChangeCallback func(from ConnectionState, status WatcherStatus)
@@ -0,0 +1,101 @@
+// Package retry 配置中心客户端的重试策略
+// 请求失败后按指数退避等待（首次等待 BaseBackoff，之后每次翻倍，不超过 MaxBackoff），并加入随机抖动，
+// 避免大量客户端在服务端恢复时同时重试
+package retry
+
+import (
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Policy 重试策略
+type Policy struct {
+	// MaxAttempts 最大尝试次数（包含首次请求，<=1 表示不重试）；长轮询失败后不限次数，只使用退避时间
+	MaxAttempts int
+
+	// BaseBackoff 首次重试前的等待时间
+	BaseBackoff time.Duration
+
+	// MaxBackoff 最大等待时间
+	MaxBackoff time.Duration
+
+	// Jitter 随机抖动比例（0-1），实际等待时间在 [退避时间*(1-Jitter), 退避时间] 之间随机取值
+	Jitter float64
+
+	// RetryableStatusCodes 可重试的 HTTP 状态码（网络错误始终可重试）
+	RetryableStatusCodes []int
+}
+
+// DefaultPolicy 默认重试策略：最多请求 3 次，退避时间 500ms 起、最长 30s，抖动 20%，429/502/503/504 可重试
+func DefaultPolicy() *Policy {
+	return &Policy{
+		MaxAttempts: 3,
+		BaseBackoff: 500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		Jitter:      0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// NoRetry 不重试的策略（失败后立即返回，长轮询失败后等待 1 秒）
+func NoRetry() *Policy {
+	return &Policy{MaxAttempts: 1, BaseBackoff: time.Second, MaxBackoff: time.Second}
+}
+
+// Attempts 最大尝试次数（至少 1 次）
+func (p *Policy) Attempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// Backoff 第 failures 次失败后的等待时间（failures 从 1 开始）
+func (p *Policy) Backoff(failures int) time.Duration {
+	if p == nil || p.BaseBackoff <= 0 {
+		return 0
+	}
+	if failures < 1 {
+		failures = 1
+	}
+
+	backoff := p.BaseBackoff
+	for i := 1; i < failures && backoff <= math.MaxInt64/2; i++ {
+		backoff *= 2
+		if p.MaxBackoff > 0 && backoff >= p.MaxBackoff {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+
+	jitter := min(max(p.Jitter, 0), 1)
+	if jitter > 0 {
+		backoff -= time.Duration(float64(backoff) * jitter * rand.Float64())
+	}
+	return backoff
+}
+
+// IsRetryableStatus 状态码是否可重试
+func (p *Policy) IsRetryableStatus(statusCode int) bool {
+	return p != nil && slices.Contains(p.RetryableStatusCodes, statusCode)
+}
+
+// RetryAfter 解析 Retry-After 响应头（秒数），头缺失或无效时返回 0
+func RetryAfter(header string) time.Duration {
+	seconds, err := strconv.Atoi(header)
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
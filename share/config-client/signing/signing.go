@@ -0,0 +1,91 @@
+// Package signing 配置值签名（Ed25519）
+// 服务端使用私钥对下发的配置值签名，客户端使用公钥校验后再应用配置值，防止传输链路或缓存被篡改
+package signing
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Algorithm 签名算法
+const Algorithm = "ed25519"
+
+// payloadPrefix 签名内容前缀（签名格式版本）
+// v2 起签名绑定环境，v1 签名（不含环境）不再接受，服务端启用 v2 后客户端需同步升级
+const payloadPrefix = "config-sign-v2"
+
+// ErrInvalidSignature 签名缺失或校验失败
+var ErrInvalidSignature = errors.New("invalid config signature")
+
+// Payload 构造待签名内容
+// 签名绑定命名空间、环境、配置键和配置值，签名不能挪用到其他配置（包括其他环境的同名配置）上；已删除的配置按空值签名
+// 同一配置的历史签名仍然有效，不能防止重放同一配置的旧值
+func Payload(namespaceID int, environment string, key string, value string) []byte {
+	var b strings.Builder
+	b.Grow(len(payloadPrefix) + len(environment) + len(key) + len(value) + 32)
+	b.WriteString(payloadPrefix)
+	b.WriteByte('\n')
+	b.WriteString(strconv.Itoa(namespaceID))
+	b.WriteByte('\n')
+	b.WriteString(strconv.Itoa(len(environment)))
+	b.WriteByte(':')
+	b.WriteString(environment)
+	b.WriteByte('\n')
+	b.WriteString(strconv.Itoa(len(key)))
+	b.WriteByte(':')
+	b.WriteString(key)
+	b.WriteByte('\n')
+	b.WriteString(value)
+	return []byte(b.String())
+}
+
+// Sign 对配置值签名，返回 base64 编码的签名
+func Sign(privateKey ed25519.PrivateKey, namespaceID int, environment string, key string, value string) string {
+	signature := ed25519.Sign(privateKey, Payload(namespaceID, environment, key, value))
+	return base64.StdEncoding.EncodeToString(signature)
+}
+
+// Verify 校验配置值签名（base64 编码），签名缺失或不匹配时返回 ErrInvalidSignature
+// environment 应为客户端请求的环境，而不是响应中携带的环境
+func Verify(publicKey ed25519.PublicKey, namespaceID int, environment string, key string, value string, signature string) error {
+	if signature == "" {
+		return fmt.Errorf("%w: 缺少签名: namespace_id=%d, environment=%s, key=%s", ErrInvalidSignature, namespaceID, environment, key)
+	}
+	raw, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || !ed25519.Verify(publicKey, Payload(namespaceID, environment, key, value), raw) {
+		return fmt.Errorf("%w: namespace_id=%d, environment=%s, key=%s", ErrInvalidSignature, namespaceID, environment, key)
+	}
+	return nil
+}
+
+// ParsePrivateKey 解析 base64 编码的 Ed25519 私钥（32 字节种子或 64 字节私钥）
+func ParsePrivateKey(encoded string) (ed25519.PrivateKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("私钥不是有效的 base64: %w", err)
+	}
+	switch len(raw) {
+	case ed25519.SeedSize:
+		return ed25519.NewKeyFromSeed(raw), nil
+	case ed25519.PrivateKeySize:
+		return ed25519.PrivateKey(raw), nil
+	default:
+		return nil, fmt.Errorf("私钥长度应为 %d 或 %d 字节，实际 %d 字节", ed25519.SeedSize, ed25519.PrivateKeySize, len(raw))
+	}
+}
+
+// ParsePublicKey 解析 base64 编码的 Ed25519 公钥（32 字节）
+func ParsePublicKey(encoded string) (ed25519.PublicKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("公钥不是有效的 base64: %w", err)
+	}
+	if len(raw) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("公钥长度应为 %d 字节，实际 %d 字节", ed25519.PublicKeySize, len(raw))
+	}
+	return ed25519.PublicKey(raw), nil
+}
@@ -0,0 +1,81 @@
+// Package telemetry 配置中心客户端的 OpenTelemetry 埋点
+// 为客户端发往配置中心的每个请求创建客户端 span，并通过请求头（W3C traceparent）传递链路上下文，
+// 服务端的链路追踪中间件据此将请求挂在客户端的链路下，可在同一条链路中查看配置读取的耗时
+package telemetry
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName 埋点名称
+const InstrumentationName = "config-client/sdk"
+
+// Tracer 客户端请求的链路追踪
+// 未设置 TracerProvider 或传播器时使用 OTel 全局实现（应用未初始化 OTel 时为空实现，开销可忽略）
+type Tracer struct {
+	provider   trace.TracerProvider
+	propagator propagation.TextMapPropagator
+}
+
+// NewTracer 创建客户端请求的链路追踪（provider、propagator 为 nil 时使用全局实现）
+func NewTracer(provider trace.TracerProvider, propagator propagation.TextMapPropagator) *Tracer {
+	return &Tracer{provider: provider, propagator: propagator}
+}
+
+// StartRequest 为请求创建客户端 span（以 "方法 路径" 命名），返回携带 span 上下文的请求
+// 请求的上下文中已有 span 时（如业务请求中读取配置），新 span 挂在其下
+func (t *Tracer) StartRequest(req *http.Request, attrs ...attribute.KeyValue) (*http.Request, trace.Span) {
+	ctx, span := t.tracer().Start(req.Context(), req.Method+" "+req.URL.Path,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.URLFull(req.URL.String()),
+			semconv.ServerAddress(req.URL.Hostname()),
+		),
+		trace.WithAttributes(attrs...),
+	)
+	return req.WithContext(ctx), span
+}
+
+// Inject 将请求上下文中的链路信息写入请求头（每次重试的请求均需写入）
+func (t *Tracer) Inject(req *http.Request) {
+	t.textMapPropagator().Inject(req.Context(), propagation.HeaderCarrier(req.Header))
+}
+
+// EndRequest 记录响应状态并结束 span：请求失败或服务端返回 5xx 时将 span 标记为失败
+func EndRequest(span trace.Span, resp *http.Response, err error) {
+	switch {
+	case err != nil:
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	case resp != nil:
+		span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+		if resp.StatusCode >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, "")
+		}
+	}
+	span.End()
+}
+
+// tracer 获取 Tracer（未设置 TracerProvider 时使用全局实现）
+func (t *Tracer) tracer() trace.Tracer {
+	if t == nil || t.provider == nil {
+		return otel.Tracer(InstrumentationName)
+	}
+	return t.provider.Tracer(InstrumentationName)
+}
+
+// textMapPropagator 获取传播器（未设置时使用全局实现）
+func (t *Tracer) textMapPropagator() propagation.TextMapPropagator {
+	if t == nil || t.propagator == nil {
+		return otel.GetTextMapPropagator()
+	}
+	return t.propagator
+}
@@ -10,11 +10,23 @@ import (
 
 // Config 应用配置
 type Config struct {
-	Database DatabaseConfig `yaml:"database"`
-	Redis    RedisConfig    `yaml:"redis"`
-	Server   ServerConfig   `yaml:"server"`
-	Log      LogConfig      `yaml:"log"`
-	Security SecurityConfig `yaml:"security"`
+	Database     DatabaseConfig     `yaml:"database"`
+	Redis        RedisConfig        `yaml:"redis"`
+	Server       ServerConfig       `yaml:"server"`
+	Log          LogConfig          `yaml:"log"`
+	Security     SecurityConfig     `yaml:"security"`
+	Tracing      TracingConfig      `yaml:"tracing"`
+	Listener     ListenerConfig     `yaml:"listener"`
+	History      HistoryConfig      `yaml:"history"`
+	Expiry       ExpiryConfig       `yaml:"expiry"`
+	File         FileConfig         `yaml:"file"`
+	Release      ReleaseConfig      `yaml:"release"`
+	Webhook      WebhookConfig      `yaml:"webhook"`
+	Notification NotificationConfig `yaml:"notification"`
+	Auth         AuthConfig         `yaml:"auth"`
+	Bootstrap    BootstrapConfig    `yaml:"bootstrap"`
+	Quota        QuotaConfig        `yaml:"quota"`
+	ConfigCache  ConfigCacheConfig  `yaml:"config_cache"`
 }
 
 // DatabaseConfig 数据库配置
@@ -29,23 +41,31 @@ type DatabaseConfig struct {
 	MaxIdleConns    int    `yaml:"max_idle_conns"`
 	ConnMaxLifetime int    `yaml:"conn_max_lifetime"` // 秒
 	LogLevel        string `yaml:"log_level"`
-	AutoMigrate     bool   `yaml:"auto_migrate"`
+	Migrate         bool   `yaml:"migrate"`      // 启动时执行内嵌的版本化迁移脚本
+	AutoMigrate     bool   `yaml:"auto_migrate"` // 启动时按持久化对象执行 GORM AutoMigrate（仅补齐缺失的表和列，用于开发环境）
+
+	Replicas []string `yaml:"replicas"` // 只读副本 DSN 列表（为空时读写都使用主库）
 }
 
 // RedisConfig Redis配置
 type RedisConfig struct {
-	Host         string `yaml:"host"`
-	Port         int    `yaml:"port"`
-	Password     string `yaml:"password"`
-	DB           int    `yaml:"db"`
-	PoolSize     int    `yaml:"pool_size"`
-	MinIdleConns int    `yaml:"min_idle_conns"`
-	DialTimeout  int    `yaml:"dial_timeout"`  // 秒
-	ReadTimeout  int    `yaml:"read_timeout"`  // 秒
-	WriteTimeout int    `yaml:"write_timeout"` // 秒
-	PoolTimeout  int    `yaml:"pool_timeout"`  // 秒
-	IdleTimeout  int    `yaml:"idle_timeout"`  // 秒
-	MaxConnAge   int    `yaml:"max_conn_age"`  // 秒
+	Disabled         bool     `yaml:"disabled"`          // 禁用 Redis（仅依赖数据库运行，要求监听器类型为 postgres 或 memory）
+	Mode             string   `yaml:"mode"`              // 部署模式: standalone（单机）, sentinel（哨兵）, cluster（集群）
+	Addrs            []string `yaml:"addrs"`             // 哨兵或集群节点地址（为空时使用 host:port）
+	MasterName       string   `yaml:"master_name"`       // 哨兵模式主节点名称
+	SentinelPassword string   `yaml:"sentinel_password"` // 哨兵节点密码
+	Host             string   `yaml:"host"`
+	Port             int      `yaml:"port"`
+	Password         string   `yaml:"password"`
+	DB               int      `yaml:"db"`
+	PoolSize         int      `yaml:"pool_size"`
+	MinIdleConns     int      `yaml:"min_idle_conns"`
+	DialTimeout      int      `yaml:"dial_timeout"`  // 秒
+	ReadTimeout      int      `yaml:"read_timeout"`  // 秒
+	WriteTimeout     int      `yaml:"write_timeout"` // 秒
+	PoolTimeout      int      `yaml:"pool_timeout"`  // 秒
+	IdleTimeout      int      `yaml:"idle_timeout"`  // 秒
+	MaxConnAge       int      `yaml:"max_conn_age"`  // 秒
 }
 
 // GetAddr 获取Redis地址
@@ -53,6 +73,14 @@ func (r *RedisConfig) GetAddr() string {
 	return fmt.Sprintf("%s:%d", r.Host, r.Port)
 }
 
+// GetAddrs 获取节点地址列表（未配置 addrs 时使用 host:port）
+func (r *RedisConfig) GetAddrs() []string {
+	if len(r.Addrs) > 0 {
+		return r.Addrs
+	}
+	return []string{r.GetAddr()}
+}
+
 // GetDialTimeout 获取拨号超时
 func (r *RedisConfig) GetDialTimeout() time.Duration {
 	return time.Duration(r.DialTimeout) * time.Second
@@ -88,8 +116,98 @@ func (r *RedisConfig) GetMaxConnAge() time.Duration {
 
 // ServerConfig 服务器配置
 type ServerConfig struct {
-	Port int    `yaml:"port"`
-	Mode string `yaml:"mode"`
+	Port        int               `yaml:"port"`
+	Mode        string            `yaml:"mode"`
+	Compression CompressionConfig `yaml:"compression"` // 响应压缩配置
+	RateLimit   RateLimitConfig   `yaml:"rate_limit"`  // 限流配置
+	Idempotency IdempotencyConfig `yaml:"idempotency"` // 幂等键配置
+	AccessLog   AccessLogConfig   `yaml:"access_log"`  // 访问日志配置
+	Debug       DebugConfig       `yaml:"debug"`       // 调试接口配置
+	Console     ConsoleConfig     `yaml:"console"`     // Web 管理控制台配置
+	TLS         TLSConfig         `yaml:"tls"`         // HTTPS 及双向 TLS 配置
+
+	// RFC 7807 错误响应的问题类型 URI 前缀（类型 URI 为前缀 + 错误码），为空时使用 urn:config-client:error:
+	ProblemTypeBaseURI string `yaml:"problem_type_base_uri"`
+}
+
+// TLS 客户端证书校验方式
+const (
+	TLSClientAuthRequire       = "require"         // 必须提供由客户端 CA 签发的证书
+	TLSClientAuthVerifyIfGiven = "verify_if_given" // 提供证书时校验，未提供时仍允许连接（配合 API Key 认证逐步迁移）
+)
+
+// TLSConfig HTTPS 配置
+// 启用后服务端只接受 TLS 连接；配置 client_ca_file 时启用双向 TLS（mTLS），校验客户端证书
+type TLSConfig struct {
+	Enabled      bool   `yaml:"enabled"`        // 是否启用 HTTPS
+	CertFile     string `yaml:"cert_file"`      // 服务端证书文件（PEM，可包含中间证书链）
+	KeyFile      string `yaml:"key_file"`       // 服务端私钥文件（PEM）
+	ClientCAFile string `yaml:"client_ca_file"` // 客户端 CA 证书文件（PEM），为空时不校验客户端证书
+	ClientAuth   string `yaml:"client_auth"`    // 客户端证书校验方式: require（默认）, verify_if_given
+	MinVersion   string `yaml:"min_version"`    // 最低 TLS 版本: 1.2（默认）, 1.3
+}
+
+// ConsoleConfig Web 管理控制台配置（/console）
+type ConsoleConfig struct {
+	Enabled bool `yaml:"enabled"` // 是否提供内嵌的管理控制台页面（页面直接调用 /api/v1 接口，需自行做好访问控制）
+}
+
+// DebugConfig 调试接口配置（/debug/pprof 和 /debug/runtime）
+type DebugConfig struct {
+	Enabled bool   `yaml:"enabled"` // 是否开启调试接口（生产环境仅在排查问题时临时开启）
+	Token   string `yaml:"token"`   // 访问令牌（请求头 X-Debug-Token），为空时不校验
+}
+
+// AccessLogConfig 访问日志配置
+type AccessLogConfig struct {
+	Enabled   bool     `yaml:"enabled"`    // 是否输出 JSON 结构化访问日志
+	Output    string   `yaml:"output"`     // 输出目标: stdout, stderr
+	SkipPaths []string `yaml:"skip_paths"` // 不记录访问日志的路径（如健康检查）
+}
+
+// CompressionConfig 响应压缩配置
+type CompressionConfig struct {
+	Enabled       bool     `yaml:"enabled"`        // 是否启用 gzip/deflate 响应压缩
+	Level         int      `yaml:"level"`          // 压缩级别（1-9，越大压缩率越高、CPU 开销越大）
+	MinLength     int      `yaml:"min_length"`     // 最小压缩字节数，小于该值的响应不压缩
+	ExcludedPaths []string `yaml:"excluded_paths"` // 不压缩的路径前缀
+}
+
+// RateLimitConfig 限流配置（作用于长轮询和配置查询接口）
+type RateLimitConfig struct {
+	Enabled   bool          `yaml:"enabled"`    // 是否启用限流
+	Backend   string        `yaml:"backend"`    // 令牌桶存储: memory（单实例）, redis（多实例共享）
+	PerIP     RateLimitRule `yaml:"per_ip"`     // 按客户端IP限流
+	PerClient RateLimitRule `yaml:"per_client"` // 按客户端ID限流
+}
+
+// IdempotencyConfig 幂等键配置（作用于创建、更新、发布、回滚等写接口）
+type IdempotencyConfig struct {
+	Enabled bool   `yaml:"enabled"` // 是否启用 Idempotency-Key 支持
+	Backend string `yaml:"backend"` // 幂等记录存储: memory（单实例）, redis（多实例共享）
+	TTL     int    `yaml:"ttl"`     // 幂等记录有效期（秒）
+}
+
+// GetTTL 获取幂等记录有效期
+func (i *IdempotencyConfig) GetTTL() time.Duration {
+	return time.Duration(i.TTL) * time.Second
+}
+
+// RateLimitRule 令牌桶限流规则
+type RateLimitRule struct {
+	Rate  float64 `yaml:"rate"`  // 每秒补充的令牌数，0 表示不限流
+	Burst int     `yaml:"burst"` // 桶容量（允许的突发请求数），默认等于 rate
+}
+
+// GetBurst 获取桶容量（未配置时取 rate，至少为 1）
+func (r RateLimitRule) GetBurst() int {
+	if r.Burst > 0 {
+		return r.Burst
+	}
+	if burst := int(r.Rate); burst > 1 {
+		return burst
+	}
+	return 1
 }
 
 // LogConfig 日志配置
@@ -103,6 +221,261 @@ type LogConfig struct {
 type SecurityConfig struct {
 	EncryptionKey  string `yaml:"encryption_key"`  // AES-256加密密钥（必须32字节）
 	MaskingEnabled bool   `yaml:"masking_enabled"` // 是否启用脱敏功能
+
+	ContentHashAlgorithm string `yaml:"content_hash_algorithm"` // 新写入配置的内容哈希算法: md5（默认）, sha256
+	SigningPrivateKey    string `yaml:"signing_private_key"`    // 配置值签名私钥（base64 编码的 Ed25519 32 字节种子或 64 字节私钥），为空时不签名
+	SigningKeyID         string `yaml:"signing_key_id"`         // 签名密钥ID（随签名返回，便于密钥轮换）
+}
+
+// TracingConfig 链路追踪配置（OpenTelemetry）
+type TracingConfig struct {
+	Enabled     bool              `yaml:"enabled"`      // 是否启用链路追踪
+	ServiceName string            `yaml:"service_name"` // 服务名称
+	Exporter    string            `yaml:"exporter"`     // 导出器: otlp（OTLP/HTTP）, stdout
+	Endpoint    string            `yaml:"endpoint"`     // OTLP 接收端地址（host:port）
+	Insecure    bool              `yaml:"insecure"`     // OTLP 是否使用明文 HTTP
+	Headers     map[string]string `yaml:"headers"`      // OTLP 请求附加头（如鉴权令牌）
+	SampleRatio float64           `yaml:"sample_ratio"` // 采样率（0-1），上游已采样的请求始终采样
+}
+
+// ListenerConfig 配置变更事件监听器配置
+type ListenerConfig struct {
+	Type     string                 `yaml:"type"`     // 监听器类型: redis（Redis Pub/Sub）, kafka, nats, postgres, memory
+	Kafka    KafkaListenerConfig    `yaml:"kafka"`    // Kafka 监听器配置
+	NATS     NATSListenerConfig     `yaml:"nats"`     // NATS JetStream 监听器配置
+	Postgres PostgresListenerConfig `yaml:"postgres"` // PostgreSQL LISTEN/NOTIFY 监听器配置
+	Outbox   OutboxConfig           `yaml:"outbox"`   // 事务性发件箱配置
+
+	EventRetention     int `yaml:"event_retention"`      // 事件日志保留时长（秒），客户端断线超过该时长后退回版本比较
+	PushTraceRetention int `yaml:"push_trace_retention"` // 通知下发记录保留时长（秒）
+}
+
+// GetEventRetention 获取事件日志保留时长
+func (l *ListenerConfig) GetEventRetention() time.Duration {
+	return time.Duration(l.EventRetention) * time.Second
+}
+
+// GetPushTraceRetention 获取通知下发记录保留时长
+func (l *ListenerConfig) GetPushTraceRetention() time.Duration {
+	return time.Duration(l.PushTraceRetention) * time.Second
+}
+
+// KafkaListenerConfig Kafka 监听器配置
+type KafkaListenerConfig struct {
+	Brokers     []string `yaml:"brokers"`      // Broker 地址列表
+	Topic       string   `yaml:"topic"`        // 配置变更事件主题
+	GroupPrefix string   `yaml:"group_prefix"` // 消费组前缀（每个实例使用独立消费组，以便所有实例都收到全部事件）
+}
+
+// NATSListenerConfig NATS JetStream 监听器配置
+type NATSListenerConfig struct {
+	URL               string `yaml:"url"`                // 服务器地址（多个地址以逗号分隔）
+	Stream            string `yaml:"stream"`             // 流名称
+	SubjectPrefix     string `yaml:"subject_prefix"`     // 主题前缀（实际主题为 前缀.命名空间ID）
+	ConsumerName      string `yaml:"consumer_name"`      // 持久化消费者名称（每个实例唯一且重启后保持不变，默认主机名）
+	MaxAge            int    `yaml:"max_age"`            // 事件保留时长（秒）
+	InactiveThreshold int    `yaml:"inactive_threshold"` // 消费者不活跃多久后由服务端清理（秒）
+}
+
+// GetMaxAge 获取事件保留时长
+func (n *NATSListenerConfig) GetMaxAge() time.Duration {
+	return time.Duration(n.MaxAge) * time.Second
+}
+
+// GetInactiveThreshold 获取消费者不活跃清理阈值
+func (n *NATSListenerConfig) GetInactiveThreshold() time.Duration {
+	return time.Duration(n.InactiveThreshold) * time.Second
+}
+
+// PostgresListenerConfig PostgreSQL LISTEN/NOTIFY 监听器配置（复用 database 连接配置）
+type PostgresListenerConfig struct {
+	Channel           string `yaml:"channel"`            // 通知通道名称
+	ReconnectInterval int    `yaml:"reconnect_interval"` // 订阅连接断开后的重连间隔（秒）
+}
+
+// GetReconnectInterval 获取重连间隔
+func (p *PostgresListenerConfig) GetReconnectInterval() time.Duration {
+	return time.Duration(p.ReconnectInterval) * time.Second
+}
+
+// OutboxConfig 事务性发件箱配置
+// 启用后配置变更事件与配置写入在同一事务内保存，由后台任务投递到监听器，监听器不可用时事件不会丢失
+type OutboxConfig struct {
+	Enabled      bool `yaml:"enabled"`       // 是否启用
+	PollInterval int  `yaml:"poll_interval"` // 轮询间隔（毫秒）
+	BatchSize    int  `yaml:"batch_size"`    // 单次投递条数
+	Retention    int  `yaml:"retention"`     // 已投递事件保留时长（秒）
+}
+
+// GetPollInterval 获取轮询间隔
+func (o *OutboxConfig) GetPollInterval() time.Duration {
+	return time.Duration(o.PollInterval) * time.Millisecond
+}
+
+// GetRetention 获取已投递事件保留时长
+func (o *OutboxConfig) GetRetention() time.Duration {
+	return time.Duration(o.Retention) * time.Second
+}
+
+// HistoryConfig 配置变更历史配置
+type HistoryConfig struct {
+	Retention HistoryRetentionConfig `yaml:"retention"` // 保留策略
+}
+
+// HistoryRetentionConfig 变更历史保留策略配置
+// 由后台任务定期清理超出保留策略的变更记录，max_age_days 与 max_per_config 同时配置时两条规则都生效
+type HistoryRetentionConfig struct {
+	Enabled      bool                 `yaml:"enabled"`        // 是否启用
+	MaxAgeDays   int                  `yaml:"max_age_days"`   // 保留天数（0 表示不按时间清理）
+	MaxPerConfig int                  `yaml:"max_per_config"` // 每个配置保留的最新记录数（0 表示不按条数清理）
+	Interval     int                  `yaml:"interval"`       // 清理任务执行间隔（秒）
+	BatchSize    int                  `yaml:"batch_size"`     // 单批清理条数
+	Archive      HistoryArchiveConfig `yaml:"archive"`        // 清理前归档配置
+}
+
+// HistoryArchiveConfig 变更历史归档配置
+type HistoryArchiveConfig struct {
+	Type string `yaml:"type"` // 归档方式: none（直接删除）, table（归档表 t_change_history_archive）, file（JSON Lines 文件，可同步到对象存储）
+	Dir  string `yaml:"dir"`  // 归档文件目录（type 为 file 时生效）
+}
+
+// GetMaxAge 获取保留时长
+func (h *HistoryRetentionConfig) GetMaxAge() time.Duration {
+	return time.Duration(h.MaxAgeDays) * 24 * time.Hour
+}
+
+// GetInterval 获取清理任务执行间隔
+func (h *HistoryRetentionConfig) GetInterval() time.Duration {
+	return time.Duration(h.Interval) * time.Second
+}
+
+// ExpiryConfig 配置过期处理配置
+// 由后台任务定期处理到达 expires_at 的配置，处理时发布配置变更事件
+type ExpiryConfig struct {
+	Enabled   bool   `yaml:"enabled"`    // 是否启用
+	Action    string `yaml:"action"`     // 过期处理方式: deactivate（停用）, delete（删除，进入回收站）
+	Interval  int    `yaml:"interval"`   // 检查间隔（秒）
+	BatchSize int    `yaml:"batch_size"` // 单批处理条数
+}
+
+// GetInterval 获取过期检查间隔
+func (e *ExpiryConfig) GetInterval() time.Duration {
+	return time.Duration(e.Interval) * time.Second
+}
+
+// FileConfig 文件类型配置
+// 证书、logback.xml 等文件内容单独存储，通过流式上传下载接口读写
+type FileConfig struct {
+	MaxSize int64 `yaml:"max_size"` // 单个文件大小上限（字节）
+}
+
+// ReleaseConfig 发布管理配置
+type ReleaseConfig struct {
+	// ApprovalEnvironments 受保护环境：发布前必须由版本创建人以外的用户审批通过（未配置时为 prod，配置为空列表时不启用审批）
+	ApprovalEnvironments []string `yaml:"approval_environments"`
+	// FreezeWindows 发布冻结窗口：窗口内拒绝发布和回滚，除非请求携带 freeze_override 并填写覆盖原因
+	FreezeWindows []FreezeWindowConfig `yaml:"freeze_windows"`
+}
+
+// FreezeWindowConfig 发布冻结窗口配置（按周循环）
+type FreezeWindowConfig struct {
+	Name         string   `yaml:"name"`          // 窗口名称
+	NamespaceIDs []int    `yaml:"namespace_ids"` // 生效的命名空间ID（为空表示全部）
+	Environments []string `yaml:"environments"`  // 生效的环境（为空表示全部）
+	Start        string   `yaml:"start"`         // 开始时间，格式 "Fri 18:00"
+	End          string   `yaml:"end"`           // 结束时间，格式 "Mon 08:00"（早于开始时间表示跨周）
+	Timezone     string   `yaml:"timezone"`      // 时区，例如 Asia/Shanghai（为空时使用服务器本地时区）
+}
+
+// WebhookConfig Webhook 投递配置
+// 启用后命名空间内的配置变更和发布事件写入投递记录，由后台任务投递到注册的 Webhook，多实例并发领取互不重复
+type WebhookConfig struct {
+	Enabled      bool `yaml:"enabled"`       // 是否启用
+	PollInterval int  `yaml:"poll_interval"` // 轮询间隔（毫秒）
+	BatchSize    int  `yaml:"batch_size"`    // 单次投递条数
+	MaxAttempts  int  `yaml:"max_attempts"`  // 最大尝试次数（含首次投递）
+	Timeout      int  `yaml:"timeout"`       // 单次请求超时（秒）
+	Retention    int  `yaml:"retention"`     // 已结束投递记录保留时长（秒）
+}
+
+// GetPollInterval 获取轮询间隔
+func (w *WebhookConfig) GetPollInterval() time.Duration {
+	return time.Duration(w.PollInterval) * time.Millisecond
+}
+
+// GetTimeout 获取单次请求超时
+func (w *WebhookConfig) GetTimeout() time.Duration {
+	return time.Duration(w.Timeout) * time.Second
+}
+
+// GetRetention 获取已结束投递记录保留时长
+func (w *WebhookConfig) GetRetention() time.Duration {
+	return time.Duration(w.Retention) * time.Second
+}
+
+// NotificationConfig 发布通知配置
+// 启用后命名空间内的发布、回滚和灰度失败发送到通过 /api/v1/notification-channels 配置的钉钉、Slack 或邮件渠道
+type NotificationConfig struct {
+	Enabled    bool       `yaml:"enabled"`     // 是否启用
+	ReleaseURL string     `yaml:"release_url"` // 详情链接模板，支持 {namespace_id}、{namespace}、{environment}、{release_id}、{version} 占位符（为空时消息不带链接）
+	Timeout    int        `yaml:"timeout"`     // 单次发送超时（秒）
+	SMTP       SMTPConfig `yaml:"smtp"`        // 邮件服务器（未配置 host 时不支持邮件渠道）
+}
+
+// SMTPConfig 邮件服务器配置
+type SMTPConfig struct {
+	Host        string `yaml:"host"`         // 服务器地址
+	Port        int    `yaml:"port"`         // 端口
+	Username    string `yaml:"username"`     // 用户名（为空时不认证）
+	Password    string `yaml:"password"`     // 密码
+	From        string `yaml:"from"`         // 发件人地址
+	ImplicitTLS bool   `yaml:"implicit_tls"` // 是否使用隐式 TLS（465 端口），否则服务器支持时使用 STARTTLS
+}
+
+// GetTimeout 获取单次发送超时
+func (n *NotificationConfig) GetTimeout() time.Duration {
+	return time.Duration(n.Timeout) * time.Second
+}
+
+// AuthConfig 接口认证配置
+// 启用后 /api/ 下的接口（public_paths 除外）需通过 Authorization: Bearer <key> 或 X-API-Key 请求头携带 API Key
+type AuthConfig struct {
+	Enabled     bool     `yaml:"enabled"`      // 是否启用
+	CacheTTL    int      `yaml:"cache_ttl"`    // 认证结果缓存时长（秒），吊销的密钥在其他实例上最长在该时长后失效
+	PublicPaths []string `yaml:"public_paths"` // 无需认证的接口路径
+}
+
+// GetCacheTTL 获取认证结果缓存时长
+func (a *AuthConfig) GetCacheTTL() time.Duration {
+	return time.Duration(a.CacheTTL) * time.Second
+}
+
+// BootstrapConfig 首次启动初始化配置
+type BootstrapConfig struct {
+	SeedFile string `yaml:"seed_file"` // 种子文件路径（YAML），为空时不加载；已存在的数据不会被覆盖
+}
+
+// QuotaConfig 命名空间默认配额
+// 命名空间未单独设置（PUT /api/v1/namespaces/:id/quota）的配额项使用该默认值，0 表示不限制
+type QuotaConfig struct {
+	MaxConfigs          int `yaml:"max_configs"`           // 配置数量上限（所有环境合计）
+	MaxValueSize        int `yaml:"max_value_size"`        // 单个配置值大小上限（字节）
+	MaxSubscribers      int `yaml:"max_subscribers"`       // 活跃订阅数量上限
+	MaxReleasesRetained int `yaml:"max_releases_retained"` // 每个环境保留的发布版本数量
+}
+
+// ConfigCacheConfig 配置读取缓存（长轮询比较版本时按 命名空间:配置键:环境 缓存配置值和版本）
+// 收到配置变更事件时失效对应缓存，有效期作为事件丢失时的兜底
+type ConfigCacheConfig struct {
+	Enabled    bool   `yaml:"enabled"`     // 是否启用
+	Backend    string `yaml:"backend"`     // 缓存存储: memory（进程内）, redis（多实例共享）
+	TTL        int    `yaml:"ttl"`         // 缓存有效期（秒）
+	MaxEntries int    `yaml:"max_entries"` // 进程内缓存最大条目数
+}
+
+// GetTTL 获取缓存有效期
+func (c *ConfigCacheConfig) GetTTL() time.Duration {
+	return time.Duration(c.TTL) * time.Second
 }
 
 // GetDSN 获取数据库DSN连接字符串
@@ -164,6 +537,9 @@ func setDefaults(config *Config) {
 	}
 
 	// Redis默认值
+	if config.Redis.Mode == "" {
+		config.Redis.Mode = "standalone"
+	}
 	if config.Redis.Host == "" {
 		config.Redis.Host = "localhost"
 	}
@@ -199,6 +575,24 @@ func setDefaults(config *Config) {
 	if config.Server.Mode == "" {
 		config.Server.Mode = "debug"
 	}
+	if config.Server.Compression.Level <= 0 || config.Server.Compression.Level > 9 {
+		config.Server.Compression.Level = 6
+	}
+	if config.Server.Compression.MinLength <= 0 {
+		config.Server.Compression.MinLength = 1024
+	}
+	if config.Server.RateLimit.Backend == "" {
+		config.Server.RateLimit.Backend = "memory"
+	}
+	if config.Server.Idempotency.Backend == "" {
+		config.Server.Idempotency.Backend = "memory"
+	}
+	if config.Server.Idempotency.TTL == 0 {
+		config.Server.Idempotency.TTL = 86400
+	}
+	if config.Server.AccessLog.Output == "" {
+		config.Server.AccessLog.Output = "stdout"
+	}
 
 	// 日志默认值
 	if config.Log.Level == "" {
@@ -211,6 +605,153 @@ func setDefaults(config *Config) {
 		config.Log.Output = "stdout"
 	}
 
+	// 链路追踪默认值
+	if config.Tracing.ServiceName == "" {
+		config.Tracing.ServiceName = "config-center"
+	}
+	if config.Tracing.Exporter == "" {
+		config.Tracing.Exporter = "otlp"
+	}
+	if config.Tracing.Endpoint == "" {
+		config.Tracing.Endpoint = "localhost:4318"
+	}
+	if config.Tracing.SampleRatio <= 0 || config.Tracing.SampleRatio > 1 {
+		config.Tracing.SampleRatio = 1
+	}
+
+	// 监听器默认值
+	if config.Listener.Type == "" {
+		config.Listener.Type = "redis"
+	}
+	if len(config.Listener.Kafka.Brokers) == 0 {
+		config.Listener.Kafka.Brokers = []string{"localhost:9092"}
+	}
+	if config.Listener.Kafka.Topic == "" {
+		config.Listener.Kafka.Topic = "config-change"
+	}
+	if config.Listener.Kafka.GroupPrefix == "" {
+		config.Listener.Kafka.GroupPrefix = "config-center"
+	}
+	if config.Listener.NATS.URL == "" {
+		config.Listener.NATS.URL = "nats://localhost:4222"
+	}
+	if config.Listener.NATS.Stream == "" {
+		config.Listener.NATS.Stream = "CONFIG_CHANGES"
+	}
+	if config.Listener.NATS.SubjectPrefix == "" {
+		config.Listener.NATS.SubjectPrefix = "config.change"
+	}
+	if config.Listener.NATS.ConsumerName == "" {
+		hostname, _ := os.Hostname()
+		config.Listener.NATS.ConsumerName = "config-center-" + hostname
+	}
+	if config.Listener.NATS.MaxAge == 0 {
+		config.Listener.NATS.MaxAge = 86400
+	}
+	if config.Listener.NATS.InactiveThreshold == 0 {
+		config.Listener.NATS.InactiveThreshold = 86400
+	}
+	if config.Listener.EventRetention == 0 {
+		config.Listener.EventRetention = 86400
+	}
+	if config.Listener.PushTraceRetention == 0 {
+		config.Listener.PushTraceRetention = 604800
+	}
+	if config.Listener.Postgres.Channel == "" {
+		config.Listener.Postgres.Channel = "config_change"
+	}
+	if config.Listener.Postgres.ReconnectInterval == 0 {
+		config.Listener.Postgres.ReconnectInterval = 3
+	}
+	if config.Listener.Outbox.PollInterval == 0 {
+		config.Listener.Outbox.PollInterval = 1000
+	}
+	if config.Listener.Outbox.BatchSize == 0 {
+		config.Listener.Outbox.BatchSize = 100
+	}
+	if config.Listener.Outbox.Retention == 0 {
+		config.Listener.Outbox.Retention = 86400
+	}
+
+	// 配置读取缓存默认值
+	if config.ConfigCache.Backend == "" {
+		config.ConfigCache.Backend = "memory"
+	}
+	if config.ConfigCache.TTL == 0 {
+		config.ConfigCache.TTL = 300
+	}
+	if config.ConfigCache.MaxEntries == 0 {
+		config.ConfigCache.MaxEntries = 100000
+	}
+
+	// 变更历史保留策略默认值
+	if config.History.Retention.Interval == 0 {
+		config.History.Retention.Interval = 3600
+	}
+	if config.History.Retention.BatchSize == 0 {
+		config.History.Retention.BatchSize = 500
+	}
+	if config.History.Retention.Archive.Type == "" {
+		config.History.Retention.Archive.Type = "none"
+	}
+	if config.History.Retention.Archive.Dir == "" {
+		config.History.Retention.Archive.Dir = "./data/history-archive"
+	}
+
+	// 配置过期处理默认值
+	if config.Expiry.Action == "" {
+		config.Expiry.Action = "deactivate"
+	}
+	if config.Expiry.Interval == 0 {
+		config.Expiry.Interval = 60
+	}
+	if config.Expiry.BatchSize == 0 {
+		config.Expiry.BatchSize = 100
+	}
+
+	// 文件类型配置默认值
+	if config.File.MaxSize == 0 {
+		config.File.MaxSize = 10 << 20
+	}
+
+	// 发布审批默认值（显式配置为空列表时不启用）
+	if config.Release.ApprovalEnvironments == nil {
+		config.Release.ApprovalEnvironments = []string{"prod"}
+	}
+
+	// Webhook 投递默认值
+	if config.Webhook.PollInterval == 0 {
+		config.Webhook.PollInterval = 1000
+	}
+	if config.Webhook.BatchSize == 0 {
+		config.Webhook.BatchSize = 50
+	}
+	if config.Webhook.MaxAttempts == 0 {
+		config.Webhook.MaxAttempts = 8
+	}
+	if config.Webhook.Timeout == 0 {
+		config.Webhook.Timeout = 10
+	}
+	if config.Webhook.Retention == 0 {
+		config.Webhook.Retention = 604800
+	}
+
+	// 发布通知默认值
+	if config.Notification.Timeout == 0 {
+		config.Notification.Timeout = 10
+	}
+	if config.Notification.SMTP.Port == 0 {
+		config.Notification.SMTP.Port = 587
+	}
+
+	// 接口认证默认值
+	if config.Auth.CacheTTL == 0 {
+		config.Auth.CacheTTL = 60
+	}
+	if config.Auth.PublicPaths == nil {
+		config.Auth.PublicPaths = []string{"/api/v1/openapi.json", "/api/v1/docs"}
+	}
+
 	// 安全配置默认值
 	if config.Security.EncryptionKey == "" {
 		// 默认密钥（生产环境必须修改！）
@@ -9,4 +9,22 @@ const (
 
 	// OperatorIPKey 操作人IP上下文键
 	OperatorIPKey ContextKey = "operator_ip"
+
+	// ChangeReasonKey 变更原因上下文键
+	ChangeReasonKey ContextKey = "change_reason"
+
+	// RequestIDKey 请求ID上下文键
+	RequestIDKey ContextKey = "request_id"
+
+	// ClientIDKey 客户端ID上下文键
+	ClientIDKey ContextKey = "client_id"
+
+	// PrincipalKey 认证主体上下文键
+	PrincipalKey ContextKey = "principal"
+
+	// TenantIDKey 租户ID上下文键
+	TenantIDKey ContextKey = "tenant_id"
+
+	// ReadReplicaKey 允许读取只读副本的上下文键
+	ReadReplicaKey ContextKey = "read_replica"
 )
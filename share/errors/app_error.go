@@ -1,12 +1,24 @@
 package errors
 
-import "fmt"
+import (
+	"fmt"
+
+	"config-client/share/types"
+)
 
 // AppError 应用错误基类
 type AppError struct {
 	Code    int    `json:"code"`    // 错误码
 	Message string `json:"message"` // 错误信息
 	Err     error  `json:"-"`       // 原始错误
+
+	RetryAfter int `json:"-"` // 建议客户端重试的等待时间（秒），大于0时响应携带 Retry-After 头
+
+	Variant string `json:"-"` // 消息变体（同一错误码对应多种消息时区分消息模板）
+	Params  Params `json:"-"` // 消息模板参数（用于生成其他语言的错误消息）
+
+	HTTPStatus int                `json:"-"` // 响应状态码（大于0时优先于按错误码推断的状态码）
+	Fields     []types.FieldError `json:"-"` // 请求参数校验失败的字段明细
 }
 
 func (e *AppError) Error() string {
@@ -16,6 +28,25 @@ func (e *AppError) Error() string {
 	return fmt.Sprintf("[%d] %s", e.Code, e.Message)
 }
 
+// WithRetryAfter 设置建议客户端重试的等待时间（秒）
+func (e *AppError) WithRetryAfter(seconds int) *AppError {
+	e.RetryAfter = seconds
+	return e
+}
+
+// WithHTTPStatus 指定响应状态码
+// 错误码末两位与状态码类型不一致的历史错误码（错误码需保持不变）通过该方法指定正确的状态码
+func (e *AppError) WithHTTPStatus(status int) *AppError {
+	e.HTTPStatus = status
+	return e
+}
+
+// WithFields 设置请求参数校验失败的字段明细
+func (e *AppError) WithFields(fields []types.FieldError) *AppError {
+	e.Fields = fields
+	return e
+}
+
 // Unwrap 实现 errors.Unwrap 接口
 func (e *AppError) Unwrap() error {
 	return e.Err
@@ -44,13 +75,15 @@ func Wrap(code int, message string, err error) *AppError {
 
 const (
 	// 通用错误码 10000-10999
-	Success       = 200   // 成功
-	BadRequest    = 10001 // 请求参数错误
-	Unauthorized  = 10002 // 未授权
-	Forbidden     = 10003 // 禁止访问
-	NotFound      = 10004 // 资源不存在
-	Conflict      = 10005 // 资源冲突
-	InternalError = 10006 // 内部错误
+	Success             = 200   // 成功
+	BadRequest          = 10001 // 请求参数错误
+	Unauthorized        = 10002 // 未授权
+	Forbidden           = 10003 // 禁止访问
+	NotFound            = 10004 // 资源不存在
+	Conflict            = 10005 // 资源冲突
+	InternalError       = 10006 // 内部错误
+	UnprocessableEntity = 10022 // 请求内容无法处理 (422)
+	TooManyRequests     = 10029 // 请求过于频繁 (429)
 )
 
 // ErrBadRequest 请求参数错误
@@ -78,7 +111,19 @@ func ErrConflict(message string) *AppError {
 	return New(Conflict, message)
 }
 
+// ErrValidation 请求参数校验失败
+func ErrValidation(fields []types.FieldError) *AppError {
+	return New(BadRequest, "请求参数校验失败").
+		WithVariant("validation").
+		WithFields(fields)
+}
+
 // ErrInternal 内部错误
 func ErrInternal(message string, err error) *AppError {
 	return Wrap(InternalError, message, err)
 }
+
+// ErrTooManyRequests 请求过于频繁
+func ErrTooManyRequests(message string) *AppError {
+	return New(TooManyRequests, message)
+}
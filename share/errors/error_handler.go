@@ -1,26 +1,61 @@
 package errors
 
 import (
+	"config-client/share/logger"
 	"config-client/share/types"
 	"context"
 	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/cloudwego/hertz/pkg/app"
 )
 
 // HandleError 统一错误处理
 // 支持处理 AppError 及其继承类型（如 UserError）
+// 错误响应的 trace_id 为当前请求ID，便于按请求ID检索日志
+// 错误消息按 Accept-Language 请求头选择语言，响应携带 Content-Language 头，错误码不随语言变化
+// 响应状态码优先使用 AppError.HTTPStatus，未指定时按错误码末两位推断；参数校验错误在 errors 中返回字段明细
+// 请求头 Accept 优先接受 application/problem+json 时返回 RFC 7807 格式的错误响应，否则返回统一响应结构
 func HandleError(ctx context.Context, c *app.RequestContext, err error) {
+	language := NegotiateLanguage(string(c.Request.Header.Peek("Accept-Language")))
+	c.Response.Header.Set("Content-Language", language)
+
 	// 使用 errors.As 支持嵌入类型的解包
 	var appErr *AppError
 	if errors.As(err, &appErr) {
-		status := getHTTPStatus(appErr.Code)
-		c.JSON(status, types.Error(appErr.Code, appErr.Message))
+		status := appErr.HTTPStatus
+		if status <= 0 {
+			status = getHTTPStatus(appErr.Code)
+		}
+		if appErr.RetryAfter > 0 {
+			c.Response.Header.Set("Retry-After", strconv.Itoa(appErr.RetryAfter))
+		}
+		writeError(ctx, c, status, appErr.Code, appErr.LocalizedMessage(language), localizeFieldErrors(appErr.Fields, language))
+		return
+	}
+
+	internalErr := New(InternalError, "内部服务错误")
+	writeError(ctx, c, http.StatusInternalServerError, internalErr.Code, internalErr.LocalizedMessage(language), nil)
+}
+
+// writeError 按 Accept 请求头输出错误响应（统一响应结构或 application/problem+json）
+func writeError(ctx context.Context, c *app.RequestContext, status int, code int, message string, fields []types.FieldError) {
+	if AcceptsProblem(string(c.Request.Header.Peek("Accept"))) {
+		c.JSON(status, NewProblem(ctx, status, code, message, fields))
+		c.Response.Header.SetContentType(ProblemContentType)
 		return
 	}
 
-	c.JSON(http.StatusInternalServerError, types.Error(InternalError, "内部服务错误"))
+	resp := types.Error(code, message)
+	resp.Errors = fields
+	c.JSON(status, WithTraceID(ctx, resp))
+}
+
+// WithTraceID 将当前请求ID写入响应的 trace_id
+func WithTraceID(ctx context.Context, resp *types.Response) *types.Response {
+	resp.TraceID = logger.RequestIDFromContext(ctx)
+	return resp
 }
 
 // getHTTPStatus 根据业务错误码获取对应的 HTTP 状态码
@@ -43,6 +78,12 @@ func getHTTPStatus(code int) int {
 		return http.StatusNotFound
 	case 5: // xxx05: conflict
 		return http.StatusConflict
+	case 13: // xxx13: request_entity_too_large
+		return http.StatusRequestEntityTooLarge
+	case 22: // xxx22: unprocessable_entity
+		return http.StatusUnprocessableEntity
+	case 29: // xxx29: too_many_requests
+		return http.StatusTooManyRequests
 	default:
 		return http.StatusInternalServerError
 	}
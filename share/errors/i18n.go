@@ -0,0 +1,173 @@
+package errors
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"config-client/share/types"
+)
+
+// 错误消息语言
+// 错误消息以中文编写（AppError.Message），其他语言通过消息目录翻译，错误码保持不变，调用方应按错误码处理错误
+const (
+	LanguageZH = "zh" // 中文（源语言）
+	LanguageEN = "en" // 英文
+
+	DefaultLanguage = LanguageZH // 默认语言（未携带 Accept-Language 或不支持请求的语言时使用）
+)
+
+// MessageKey 消息目录的键
+// 同一错误码对应多种消息时（如配置键为空、配置键格式无效均为 ConfigKeyInvalid），通过 Variant 区分消息模板
+type MessageKey struct {
+	Code    int    // 错误码
+	Variant string // 消息变体（为空表示错误码的默认消息）
+}
+
+// Catalog 消息目录：消息键 -> 消息模板
+// 模板中的 {name} 占位符使用 AppError.Params 中的同名参数替换
+type Catalog map[MessageKey]string
+
+// Params 消息模板参数
+type Params map[string]string
+
+var (
+	catalogsMu sync.RWMutex
+	catalogs   = map[string]Catalog{
+		LanguageEN: {
+			// 通用错误码的消息由调用方传入，只翻译固定的消息
+			{Code: InternalError}: "internal server error",
+
+			{Code: BadRequest, Variant: "malformed_request"}:               "malformed request parameters: {error}",
+			{Code: BadRequest, Variant: "validation"}:                      "request validation failed",
+			{Code: BadRequest, Variant: "idempotency_key_too_long"}:        "Idempotency-Key must not exceed 255 characters",
+			{Code: UnprocessableEntity, Variant: "idempotency_key_reused"}: "Idempotency-Key has already been used for a different request",
+			{Code: Conflict, Variant: "idempotency_in_progress"}:           "a request with the same Idempotency-Key is still in progress, please retry later",
+			{Code: TooManyRequests, Variant: "rate_limited"}:               "too many requests, please retry after {seconds} seconds",
+		},
+	}
+)
+
+// RegisterCatalog 注册指定语言的消息目录（与已注册的目录合并，同一消息键会被覆盖）
+// 各业务模块在 init 中注册本模块错误码的翻译
+func RegisterCatalog(language string, catalog Catalog) {
+	catalogsMu.Lock()
+	defer catalogsMu.Unlock()
+	merged, ok := catalogs[language]
+	if !ok {
+		merged = make(Catalog, len(catalog))
+		catalogs[language] = merged
+	}
+	for key, template := range catalog {
+		merged[key] = template
+	}
+}
+
+// Languages 支持的语言（默认语言及已注册消息目录的语言，按名称排序）
+func Languages() []string {
+	catalogsMu.RLock()
+	defer catalogsMu.RUnlock()
+	languages := []string{DefaultLanguage}
+	for language := range catalogs {
+		if language != DefaultLanguage {
+			languages = append(languages, language)
+		}
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// WithParams 设置消息模板参数
+func (e *AppError) WithParams(params Params) *AppError {
+	e.Params = params
+	return e
+}
+
+// WithVariant 设置消息变体
+func (e *AppError) WithVariant(variant string) *AppError {
+	e.Variant = variant
+	return e
+}
+
+// LocalizedMessage 按语言获取错误消息
+// 无消息变体时使用错误码的默认模板，有消息变体时只使用变体模板；没有对应模板时返回原始消息（AppError.Message）
+func (e *AppError) LocalizedMessage(language string) string {
+	message, ok := Message(language, MessageKey{Code: e.Code, Variant: e.Variant}, e.Params)
+	if !ok {
+		return e.Message
+	}
+	return message
+}
+
+// Message 按语言和消息键生成消息，消息目录中没有对应模板时返回 false
+func Message(language string, key MessageKey, params Params) (string, bool) {
+	catalogsMu.RLock()
+	template, ok := catalogs[language][key]
+	catalogsMu.RUnlock()
+	if !ok {
+		return "", false
+	}
+	return renderTemplate(template, params), true
+}
+
+// localizeFieldErrors 按语言生成字段校验错误的说明（没有对应模板时保留原说明）
+// 字段说明的消息键为 {Code: BadRequest, Variant: FieldError.Variant}，模板参数为 field、rule、param
+func localizeFieldErrors(fields []types.FieldError, language string) []types.FieldError {
+	if len(fields) == 0 {
+		return nil
+	}
+	localized := make([]types.FieldError, len(fields))
+	for i, field := range fields {
+		localized[i] = field
+		if field.Variant == "" {
+			continue
+		}
+		params := Params{"field": field.Field, "rule": field.Rule, "param": field.Param}
+		if message, ok := Message(language, MessageKey{Code: BadRequest, Variant: field.Variant}, params); ok {
+			localized[i].Message = message
+		}
+	}
+	return localized
+}
+
+// renderTemplate 使用参数替换模板中的 {name} 占位符（缺少的参数保留占位符）
+func renderTemplate(template string, params Params) string {
+	if len(params) == 0 || !strings.Contains(template, "{") {
+		return template
+	}
+	pairs := make([]string, 0, len(params)*2)
+	for name, value := range params {
+		pairs = append(pairs, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(pairs...).Replace(template)
+}
+
+// NegotiateLanguage 根据 Accept-Language 请求头选择响应语言
+// 按质量值（q）从高到低匹配支持的语言，只比较主语言标签（en-US 匹配 en）；没有匹配的语言时返回默认语言
+func NegotiateLanguage(acceptLanguage string) string {
+	if acceptLanguage == "" {
+		return DefaultLanguage
+	}
+
+	supported := make(map[string]bool)
+	for _, language := range Languages() {
+		supported[language] = true
+	}
+
+	best, bestQuality := DefaultLanguage, 0.0
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality, ok := parseQuality(params)
+		if !ok {
+			continue
+		}
+		primary, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+		if primary == "*" {
+			primary = DefaultLanguage
+		}
+		if supported[primary] && quality > bestQuality {
+			best, bestQuality = primary, quality
+		}
+	}
+	return best
+}
@@ -0,0 +1,84 @@
+package errors
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"config-client/share/logger"
+	"config-client/share/types"
+)
+
+// ProblemContentType RFC 7807 错误响应的媒体类型
+const ProblemContentType = "application/problem+json"
+
+// DefaultProblemTypeBaseURI 问题类型 URI 的默认前缀（类型 URI 为前缀 + 错误码，如 urn:config-client:error:20104）
+const DefaultProblemTypeBaseURI = "urn:config-client:error:"
+
+// problemTypeBaseURI 问题类型 URI 前缀
+var problemTypeBaseURI = DefaultProblemTypeBaseURI
+
+// SetProblemTypeBaseURI 设置问题类型 URI 前缀（如错误码文档地址 https://docs.example.com/errors/），需在服务启动前调用
+func SetProblemTypeBaseURI(baseURI string) {
+	problemTypeBaseURI = baseURI
+}
+
+// ProblemType 错误码对应的问题类型 URI
+func ProblemType(code int) string {
+	return problemTypeBaseURI + strconv.Itoa(code)
+}
+
+// NewProblem 构建 RFC 7807 错误响应，instance 为当前请求ID
+func NewProblem(ctx context.Context, status int, code int, detail string, fields []types.FieldError) *types.Problem {
+	return &types.Problem{
+		Type:     ProblemType(code),
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: logger.RequestIDFromContext(ctx),
+		Code:     code,
+		Errors:   fields,
+	}
+}
+
+// AcceptsProblem 根据 Accept 请求头判断是否返回 application/problem+json
+// 仅在显式接受 application/problem+json 且质量值（q）不低于 application/json 时返回 true；*/* 等通配符仍返回统一响应结构
+func AcceptsProblem(accept string) bool {
+	if !strings.Contains(strings.ToLower(accept), ProblemContentType) {
+		return false
+	}
+
+	problemQuality, jsonQuality := 0.0, 0.0
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, _ := strings.Cut(part, ";")
+		quality, ok := parseQuality(params)
+		if !ok {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(mediaType)) {
+		case ProblemContentType:
+			problemQuality = max(problemQuality, quality)
+		case "application/json":
+			jsonQuality = max(jsonQuality, quality)
+		}
+	}
+	return problemQuality > 0 && problemQuality >= jsonQuality
+}
+
+// parseQuality 解析 Accept 系列请求头中媒体范围或语言标签的参数，返回质量值（未指定 q 时为 1）
+// 质量值格式无效时返回 false，调用方忽略该项
+func parseQuality(params string) (float64, bool) {
+	for _, param := range strings.Split(params, ";") {
+		name, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+		if !strings.EqualFold(name, "q") {
+			continue
+		}
+		quality, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || quality < 0 || quality > 1 {
+			return 0, false
+		}
+		return quality, true
+	}
+	return 1, true
+}
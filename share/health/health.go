@@ -0,0 +1,127 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// 检查状态
+const (
+	StatusUp   = "up"   // 依赖可用
+	StatusDown = "down" // 依赖不可用
+)
+
+// defaultCheckTimeout 单个依赖检查的默认超时时间
+const defaultCheckTimeout = 2 * time.Second
+
+// CheckFunc 依赖检查函数，依赖不可用时返回错误
+type CheckFunc func(ctx context.Context) error
+
+// DependencyStatus 单个依赖的检查结果
+type DependencyStatus struct {
+	Status      string     `json:"status"`                  // up / down
+	LatencyMs   float64    `json:"latency_ms"`              // 本次检查耗时（毫秒）
+	Error       string     `json:"error,omitempty"`         // 本次检查错误
+	LastError   string     `json:"last_error,omitempty"`    // 最近一次失败的错误（恢复后保留，便于排查抖动）
+	LastErrorAt *time.Time `json:"last_error_at,omitempty"` // 最近一次失败时间
+}
+
+// Report 就绪检查报告
+type Report struct {
+	Status       string                       `json:"status"` // 所有依赖可用时为 up
+	Time         time.Time                    `json:"time"`
+	Dependencies map[string]*DependencyStatus `json:"dependencies"`
+}
+
+// dependency 已注册的依赖
+type dependency struct {
+	name        string
+	check       CheckFunc
+	lastError   string
+	lastErrorAt time.Time
+}
+
+// Checker 依赖就绪检查器
+// 并发检查所有已注册依赖，记录每个依赖的耗时和最近一次失败信息
+type Checker struct {
+	mu           sync.Mutex
+	dependencies []*dependency
+	timeout      time.Duration
+}
+
+// NewChecker 创建依赖就绪检查器，timeout 为单个依赖的检查超时（<=0 时使用默认值 2 秒）
+func NewChecker(timeout time.Duration) *Checker {
+	if timeout <= 0 {
+		timeout = defaultCheckTimeout
+	}
+	return &Checker{timeout: timeout}
+}
+
+// Register 注册依赖检查
+func (c *Checker) Register(name string, check CheckFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dependencies = append(c.dependencies, &dependency{name: name, check: check})
+}
+
+// Check 并发检查所有依赖
+func (c *Checker) Check(ctx context.Context) *Report {
+	c.mu.Lock()
+	dependencies := append([]*dependency(nil), c.dependencies...)
+	c.mu.Unlock()
+
+	// 1. 并发执行检查
+	results := make([]*DependencyStatus, len(dependencies))
+	var wg sync.WaitGroup
+	for i, dep := range dependencies {
+		wg.Add(1)
+		go func(i int, dep *dependency) {
+			defer wg.Done()
+			results[i] = c.run(ctx, dep)
+		}(i, dep)
+	}
+	wg.Wait()
+
+	// 2. 汇总报告
+	report := &Report{
+		Status:       StatusUp,
+		Time:         time.Now(),
+		Dependencies: make(map[string]*DependencyStatus, len(dependencies)),
+	}
+	for i, dep := range dependencies {
+		report.Dependencies[dep.name] = results[i]
+		if results[i].Status != StatusUp {
+			report.Status = StatusDown
+		}
+	}
+	return report
+}
+
+// run 执行单个依赖检查并更新最近一次失败信息
+func (c *Checker) run(ctx context.Context, dep *dependency) *DependencyStatus {
+	checkCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := dep.check(checkCtx)
+	status := &DependencyStatus{
+		Status:    StatusUp,
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil {
+		status.Status = StatusDown
+		status.Error = err.Error()
+		dep.lastError = err.Error()
+		dep.lastErrorAt = time.Now()
+	}
+	if dep.lastError != "" {
+		lastErrorAt := dep.lastErrorAt
+		status.LastError = dep.lastError
+		status.LastErrorAt = &lastErrorAt
+	}
+	return status
+}
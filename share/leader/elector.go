@@ -0,0 +1,158 @@
+package leader
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/redis/go-redis/v9"
+)
+
+// Elector 主节点选举器
+// 多实例部署时，定时清理等后台任务只应在主节点上执行
+type Elector interface {
+	// IsLeader 当前实例是否为主节点
+	IsLeader() bool
+}
+
+// leaderKeyPrefix Redis 选举键前缀
+const leaderKeyPrefix = "config:leader:"
+
+// DefaultLeaseTTL 默认租约时长（主节点宕机后最长经过该时长由其他实例接管）
+const DefaultLeaseTTL = 15 * time.Second
+
+// renewScript 续约脚本：仅当锁仍由本实例持有时延长过期时间
+var renewScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+  return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseScript 释放脚本：仅当锁仍由本实例持有时删除
+var releaseScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+  return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// RedisElector 基于 Redis 租约锁的主节点选举
+// 通过 SET NX PX 竞选，主节点每 ttl/3 续约一次；续约失败（网络异常、锁被抢占）立即退位，
+// 保证任意时刻最多只有一个实例认为自己是主节点
+type RedisElector struct {
+	client   redis.UniversalClient
+	key      string
+	identity string
+	ttl      time.Duration
+
+	leader atomic.Bool
+	cancel context.CancelFunc
+	done   chan struct{}
+	once   sync.Once
+}
+
+// NewRedisElector 创建 Redis 主节点选举器
+// name: 选举名称（同名选举器之间竞争）；ttl: 租约时长（<=0 时使用默认值）
+func NewRedisElector(client redis.UniversalClient, name string, ttl time.Duration) *RedisElector {
+	if ttl <= 0 {
+		ttl = DefaultLeaseTTL
+	}
+	return &RedisElector{
+		client:   client,
+		key:      leaderKeyPrefix + name,
+		identity: newIdentity(),
+		ttl:      ttl,
+		done:     make(chan struct{}),
+	}
+}
+
+// Identity 当前实例标识
+func (e *RedisElector) Identity() string {
+	return e.identity
+}
+
+// IsLeader 当前实例是否为主节点
+func (e *RedisElector) IsLeader() bool {
+	return e.leader.Load()
+}
+
+// Start 启动选举循环（非阻塞）
+func (e *RedisElector) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	e.cancel = cancel
+
+	go func() {
+		defer close(e.done)
+
+		ticker := time.NewTicker(e.ttl / 3)
+		defer ticker.Stop()
+
+		e.tick(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				e.tick(ctx)
+			}
+		}
+	}()
+}
+
+// Stop 停止选举并主动释放主节点身份，便于其他实例立即接管
+func (e *RedisElector) Stop() {
+	e.once.Do(func() {
+		if e.cancel == nil {
+			return
+		}
+		e.cancel()
+		<-e.done
+
+		if e.leader.Swap(false) {
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+			defer cancel()
+			if err := releaseScript.Run(ctx, e.client, []string{e.key}, e.identity).Err(); err != nil {
+				hlog.Warnf("释放主节点身份失败: key=%s, err=%v", e.key, err)
+			}
+		}
+	})
+}
+
+// tick 竞选或续约
+func (e *RedisElector) tick(ctx context.Context) {
+	if e.leader.Load() {
+		renewed, err := renewScript.Run(ctx, e.client, []string{e.key}, e.identity, e.ttl.Milliseconds()).Int()
+		if err != nil || renewed == 0 {
+			e.leader.Store(false)
+			hlog.Warnf("主节点续约失败，已退位: key=%s, identity=%s, err=%v", e.key, e.identity, err)
+		}
+		return
+	}
+
+	acquired, err := e.client.SetNX(ctx, e.key, e.identity, e.ttl).Result()
+	if err != nil {
+		if ctx.Err() == nil {
+			hlog.Warnf("竞选主节点失败: key=%s, err=%v", e.key, err)
+		}
+		return
+	}
+	if acquired {
+		e.leader.Store(true)
+		hlog.Infof("当选主节点: key=%s, identity=%s", e.key, e.identity)
+	}
+}
+
+// newIdentity 生成实例标识（主机名-进程号-随机串）
+func newIdentity() string {
+	hostname, _ := os.Hostname()
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("%s-%d-%s", hostname, os.Getpid(), hex.EncodeToString(b))
+}
@@ -0,0 +1,200 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"config-client/share/constants"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// callDepth 从 log.Output 回溯到业务调用方的栈深度
+// 业务代码 -> hlog.Xxx -> ContextLogger.Xxx -> ContextLogger.output -> log.Output
+const callDepth = 4
+
+// levelPrefixes 日志级别前缀（与 hlog 默认日志格式保持一致）
+var levelPrefixes = map[hlog.Level]string{
+	hlog.LevelTrace:  "[Trace] ",
+	hlog.LevelDebug:  "[Debug] ",
+	hlog.LevelInfo:   "[Info] ",
+	hlog.LevelNotice: "[Notice] ",
+	hlog.LevelWarn:   "[Warn] ",
+	hlog.LevelError:  "[Error] ",
+	hlog.LevelFatal:  "[Fatal] ",
+}
+
+// ContextLogger 上下文感知的日志实现
+// Ctx* 方法会从上下文中取出请求ID、客户端ID附加到日志中，便于按请求串联 handler 与领域层日志
+type ContextLogger struct {
+	stdlog *log.Logger
+	level  hlog.Level
+}
+
+// NewContextLogger 创建上下文感知的日志实现
+func NewContextLogger(level hlog.Level, output io.Writer) *ContextLogger {
+	return &ContextLogger{
+		stdlog: log.New(output, "", log.LstdFlags|log.Lshortfile|log.Lmicroseconds),
+		level:  level,
+	}
+}
+
+// Init 按日志配置替换 hlog 全局日志实现
+func Init(level, output string) {
+	hlog.SetLogger(NewContextLogger(ParseLevel(level), outputWriter(output)))
+}
+
+// ParseLevel 解析日志级别，无法识别时返回 info
+func ParseLevel(level string) hlog.Level {
+	switch strings.ToLower(level) {
+	case "trace":
+		return hlog.LevelTrace
+	case "debug":
+		return hlog.LevelDebug
+	case "notice":
+		return hlog.LevelNotice
+	case "warn", "warning":
+		return hlog.LevelWarn
+	case "error":
+		return hlog.LevelError
+	case "fatal":
+		return hlog.LevelFatal
+	default:
+		return hlog.LevelInfo
+	}
+}
+
+// outputWriter 根据配置获取日志输出目标
+func outputWriter(output string) io.Writer {
+	if output == "stderr" {
+		return os.Stderr
+	}
+	return os.Stdout
+}
+
+// RequestIDFromContext 从上下文中获取请求ID
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestID, _ := ctx.Value(constants.RequestIDKey).(string)
+	return requestID
+}
+
+// contextFields 从上下文中提取需要附加到日志的字段
+func contextFields(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	var b strings.Builder
+	if requestID := RequestIDFromContext(ctx); requestID != "" {
+		b.WriteString("request_id=")
+		b.WriteString(requestID)
+		b.WriteByte(' ')
+	}
+	if clientID, ok := ctx.Value(constants.ClientIDKey).(string); ok && clientID != "" {
+		b.WriteString("client_id=")
+		b.WriteString(clientID)
+		b.WriteByte(' ')
+	}
+	return b.String()
+}
+
+// output 输出日志
+func (l *ContextLogger) output(level hlog.Level, fields, msg string) {
+	if level < l.level {
+		return
+	}
+	_ = l.stdlog.Output(callDepth, levelPrefixes[level]+fields+msg)
+	if level == hlog.LevelFatal {
+		os.Exit(1)
+	}
+}
+
+// ==================== hlog.Control ====================
+
+// SetLevel 设置日志级别
+func (l *ContextLogger) SetLevel(level hlog.Level) {
+	l.level = level
+}
+
+// SetOutput 设置日志输出目标
+func (l *ContextLogger) SetOutput(w io.Writer) {
+	l.stdlog.SetOutput(w)
+}
+
+// ==================== hlog.Logger ====================
+
+func (l *ContextLogger) Trace(v ...interface{})  { l.output(hlog.LevelTrace, "", fmt.Sprint(v...)) }
+func (l *ContextLogger) Debug(v ...interface{})  { l.output(hlog.LevelDebug, "", fmt.Sprint(v...)) }
+func (l *ContextLogger) Info(v ...interface{})   { l.output(hlog.LevelInfo, "", fmt.Sprint(v...)) }
+func (l *ContextLogger) Notice(v ...interface{}) { l.output(hlog.LevelNotice, "", fmt.Sprint(v...)) }
+func (l *ContextLogger) Warn(v ...interface{})   { l.output(hlog.LevelWarn, "", fmt.Sprint(v...)) }
+func (l *ContextLogger) Error(v ...interface{})  { l.output(hlog.LevelError, "", fmt.Sprint(v...)) }
+func (l *ContextLogger) Fatal(v ...interface{})  { l.output(hlog.LevelFatal, "", fmt.Sprint(v...)) }
+
+// ==================== hlog.FormatLogger ====================
+
+func (l *ContextLogger) Tracef(format string, v ...interface{}) {
+	l.output(hlog.LevelTrace, "", fmt.Sprintf(format, v...))
+}
+
+func (l *ContextLogger) Debugf(format string, v ...interface{}) {
+	l.output(hlog.LevelDebug, "", fmt.Sprintf(format, v...))
+}
+
+func (l *ContextLogger) Infof(format string, v ...interface{}) {
+	l.output(hlog.LevelInfo, "", fmt.Sprintf(format, v...))
+}
+
+func (l *ContextLogger) Noticef(format string, v ...interface{}) {
+	l.output(hlog.LevelNotice, "", fmt.Sprintf(format, v...))
+}
+
+func (l *ContextLogger) Warnf(format string, v ...interface{}) {
+	l.output(hlog.LevelWarn, "", fmt.Sprintf(format, v...))
+}
+
+func (l *ContextLogger) Errorf(format string, v ...interface{}) {
+	l.output(hlog.LevelError, "", fmt.Sprintf(format, v...))
+}
+
+func (l *ContextLogger) Fatalf(format string, v ...interface{}) {
+	l.output(hlog.LevelFatal, "", fmt.Sprintf(format, v...))
+}
+
+// ==================== hlog.CtxLogger ====================
+
+func (l *ContextLogger) CtxTracef(ctx context.Context, format string, v ...interface{}) {
+	l.output(hlog.LevelTrace, contextFields(ctx), fmt.Sprintf(format, v...))
+}
+
+func (l *ContextLogger) CtxDebugf(ctx context.Context, format string, v ...interface{}) {
+	l.output(hlog.LevelDebug, contextFields(ctx), fmt.Sprintf(format, v...))
+}
+
+func (l *ContextLogger) CtxInfof(ctx context.Context, format string, v ...interface{}) {
+	l.output(hlog.LevelInfo, contextFields(ctx), fmt.Sprintf(format, v...))
+}
+
+func (l *ContextLogger) CtxNoticef(ctx context.Context, format string, v ...interface{}) {
+	l.output(hlog.LevelNotice, contextFields(ctx), fmt.Sprintf(format, v...))
+}
+
+func (l *ContextLogger) CtxWarnf(ctx context.Context, format string, v ...interface{}) {
+	l.output(hlog.LevelWarn, contextFields(ctx), fmt.Sprintf(format, v...))
+}
+
+func (l *ContextLogger) CtxErrorf(ctx context.Context, format string, v ...interface{}) {
+	l.output(hlog.LevelError, contextFields(ctx), fmt.Sprintf(format, v...))
+}
+
+func (l *ContextLogger) CtxFatalf(ctx context.Context, format string, v ...interface{}) {
+	l.output(hlog.LevelFatal, contextFields(ctx), fmt.Sprintf(format, v...))
+}
+
+var _ hlog.FullLogger = (*ContextLogger)(nil)
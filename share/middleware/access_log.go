@@ -0,0 +1,124 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"config-client/share/config"
+	"config-client/share/constants"
+	"config-client/share/tracing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// accessLogEntry 访问日志条目
+type accessLogEntry struct {
+	Time      string  `json:"time"`
+	RequestID string  `json:"request_id"`
+	TraceID   string  `json:"trace_id,omitempty"`
+	Method    string  `json:"method"`
+	Path      string  `json:"path"`
+	Query     string  `json:"query,omitempty"`
+	Status    int     `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+	BytesIn   int     `json:"bytes_in"`
+	BytesOut  int     `json:"bytes_out"`
+	ClientIP  string  `json:"client_ip"`
+	ClientID  string  `json:"client_id,omitempty"`
+	Operator  string  `json:"operator,omitempty"`
+	UserAgent string  `json:"user_agent,omitempty"`
+}
+
+// AccessLog 结构化访问日志中间件
+// 每个请求输出一行 JSON，包含请求ID、方法、路径、状态码、耗时和调用方身份（IP、客户端ID、操作人）；
+// 需注册在 RequestID、Tracing 之后以获取请求ID和 TraceID
+func AccessLog(cfg config.AccessLogConfig) app.HandlerFunc {
+	skipPaths := make(map[string]struct{}, len(cfg.SkipPaths))
+	for _, path := range cfg.SkipPaths {
+		skipPaths[path] = struct{}{}
+	}
+	writer := &accessLogWriter{out: accessLogOutput(cfg.Output)}
+
+	return func(ctx context.Context, c *app.RequestContext) {
+		path := string(c.Request.URI().Path())
+		if _, ok := skipPaths[path]; ok {
+			c.Next(ctx)
+			return
+		}
+
+		start := time.Now()
+		clientID, _ := ctx.Value(constants.ClientIDKey).(string)
+		operator := extractOperator(c)
+		bytesIn := requestBodySize(c)
+
+		c.Next(ctx)
+
+		requestID, _ := ctx.Value(constants.RequestIDKey).(string)
+		writer.write(&accessLogEntry{
+			Time:      start.Format(time.RFC3339Nano),
+			RequestID: requestID,
+			TraceID:   tracing.TraceIDFromContext(ctx),
+			Method:    string(c.Method()),
+			Path:      path,
+			Query:     string(c.Request.URI().QueryString()),
+			Status:    c.Response.StatusCode(),
+			LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+			BytesIn:   bytesIn,
+			BytesOut:  len(c.Response.Body()),
+			ClientIP:  c.ClientIP(),
+			ClientID:  clientID,
+			Operator:  operator,
+			UserAgent: string(c.UserAgent()),
+		})
+	}
+}
+
+// accessLogWriter 访问日志输出（保证并发写入时每条日志完整成行）
+type accessLogWriter struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+// write 输出一条访问日志
+func (w *accessLogWriter) write(entry *accessLogEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	line = append(line, '\n')
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	_, _ = w.out.Write(line)
+}
+
+// accessLogOutput 根据配置获取访问日志输出目标
+func accessLogOutput(output string) io.Writer {
+	if output == "stderr" {
+		return os.Stderr
+	}
+	return os.Stdout
+}
+
+// extractOperator 从请求中提取操作人（请求头 X-Operator 或 JSON 请求体中的 operator）
+func extractOperator(c *app.RequestContext) string {
+	if operator := string(c.Request.Header.Peek("X-Operator")); operator != "" {
+		return operator
+	}
+	return parseCallerBody(c).Operator
+}
+
+// requestBodySize 获取请求体大小，流式请求体使用 Content-Length，避免在处理器之前读取整个请求体
+func requestBodySize(c *app.RequestContext) int {
+	if c.Request.IsBodyStream() {
+		if size := c.Request.Header.ContentLength(); size > 0 {
+			return size
+		}
+		return 0
+	}
+	return len(c.Request.Body())
+}
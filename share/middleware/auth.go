@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"config-client/share/config"
+	"config-client/share/constants"
+	shareErrors "config-client/share/errors"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// APIKeyHeader API Key 请求头（也可以使用 Authorization: Bearer <key>）
+const APIKeyHeader = "X-API-Key"
+
+// authProtectedPrefix 需要认证的路径前缀（健康检查、控制台静态资源、调试接口不在此范围）
+const authProtectedPrefix = "/api/"
+
+// Principal 认证主体
+type Principal struct {
+	ID       int        // API Key ID
+	Name     string     // API Key 名称
+	Role     string     // 角色
+	TenantID int        // 所属租户ID
+	Scope    *ReadScope // 只读令牌的读取范围（管理员密钥为 nil）
+}
+
+// Authenticator 请求认证器
+type Authenticator interface {
+	// Authenticate 校验请求携带的密钥，失败时返回 AppError（错误码决定响应状态码）
+	Authenticate(ctx context.Context, token string) (*Principal, error)
+}
+
+// AuthenticatorFunc 函数形式的认证器
+type AuthenticatorFunc func(ctx context.Context, token string) (*Principal, error)
+
+// Authenticate 实现 Authenticator 接口
+func (f AuthenticatorFunc) Authenticate(ctx context.Context, token string) (*Principal, error) {
+	return f(ctx, token)
+}
+
+// Auth 接口认证中间件
+// /api/ 下除 public_paths 以外的请求需携带 API Key，认证通过后将认证主体放入上下文
+func Auth(authenticator Authenticator, cfg config.AuthConfig) app.HandlerFunc {
+	publicPaths := make(map[string]struct{}, len(cfg.PublicPaths))
+	for _, path := range cfg.PublicPaths {
+		publicPaths[path] = struct{}{}
+	}
+
+	return func(ctx context.Context, c *app.RequestContext) {
+		path := string(c.Request.URI().Path())
+		if _, ok := publicPaths[path]; ok || !strings.HasPrefix(path, authProtectedPrefix) {
+			c.Next(ctx)
+			return
+		}
+
+		principal, err := authenticator.Authenticate(ctx, extractToken(c))
+		if err != nil {
+			c.Response.Header.Set("WWW-Authenticate", `Bearer realm="config-center"`)
+			shareErrors.HandleError(ctx, c, err)
+			c.Abort()
+			return
+		}
+
+		c.Next(context.WithValue(ctx, constants.PrincipalKey, principal))
+	}
+}
+
+// PrincipalFromContext 获取认证主体（未启用认证时返回 nil）
+func PrincipalFromContext(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(constants.PrincipalKey).(*Principal)
+	return principal
+}
+
+// extractToken 从请求头中提取密钥（Authorization: Bearer 优先）
+func extractToken(c *app.RequestContext) string {
+	if auth := string(c.Request.Header.Peek("Authorization")); auth != "" {
+		if token, ok := strings.CutPrefix(auth, "Bearer "); ok {
+			return strings.TrimSpace(token)
+		}
+	}
+	return strings.TrimSpace(string(c.Request.Header.Peek(APIKeyHeader)))
+}
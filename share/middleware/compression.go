@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"strings"
+
+	"config-client/share/config"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/compress"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// compressibleTypes 可压缩的响应内容类型前缀
+var compressibleTypes = []string{
+	"application/json",
+	"application/problem+json",
+	"application/x-yaml",
+	"application/yaml",
+	"application/xml",
+	"application/javascript",
+	"text/",
+}
+
+// Compression 响应压缩中间件
+// 根据请求头 Accept-Encoding 对响应体进行 gzip 或 deflate 压缩（gzip 优先）
+// 以下情况不压缩：
+// 1. 响应体小于 min_length 或为流式响应
+// 2. 响应已设置 Content-Encoding
+// 3. 内容类型不可压缩（如图片、压缩包）
+// 4. 请求路径命中 excluded_paths 前缀
+func Compression(cfg config.CompressionConfig) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		c.Next(ctx)
+
+		encoding := negotiateEncoding(string(c.Request.Header.Peek("Accept-Encoding")))
+		if encoding == "" || !shouldCompress(c, cfg) {
+			return
+		}
+
+		body := c.Response.Body()
+		var compressed []byte
+		switch encoding {
+		case "gzip":
+			compressed = compress.AppendGzipBytesLevel(nil, body, cfg.Level)
+		case "deflate":
+			// Content-Encoding: deflate 表示 zlib 格式（RFC 1950），不是原始 DEFLATE 数据
+			var buf bytes.Buffer
+			writer, err := zlib.NewWriterLevel(&buf, cfg.Level)
+			if err != nil {
+				hlog.CtxWarnf(ctx, "创建 deflate 压缩器失败: %v", err)
+				return
+			}
+			if _, err := writer.Write(body); err != nil || writer.Close() != nil {
+				hlog.CtxWarnf(ctx, "deflate 压缩响应失败: %v", err)
+				return
+			}
+			compressed = buf.Bytes()
+		}
+
+		// 压缩后体积未减小时保留原始响应
+		if len(compressed) >= len(body) {
+			return
+		}
+
+		c.Response.SetBody(compressed)
+		c.Response.Header.Set("Content-Encoding", encoding)
+		c.Response.Header.Add("Vary", "Accept-Encoding")
+	}
+}
+
+// shouldCompress 判断响应是否需要压缩
+func shouldCompress(c *app.RequestContext, cfg config.CompressionConfig) bool {
+	if c.Response.IsBodyStream() || len(c.Response.Header.Peek("Content-Encoding")) > 0 {
+		return false
+	}
+	if len(c.Response.Body()) < cfg.MinLength {
+		return false
+	}
+
+	path := string(c.Request.URI().Path())
+	for _, excluded := range cfg.ExcludedPaths {
+		if excluded != "" && strings.HasPrefix(path, excluded) {
+			return false
+		}
+	}
+
+	contentType := strings.ToLower(string(c.Response.Header.ContentType()))
+	for _, prefix := range compressibleTypes {
+		if strings.HasPrefix(contentType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// negotiateEncoding 根据 Accept-Encoding 选择压缩算法（gzip 优先，忽略 q=0 的编码）
+func negotiateEncoding(acceptEncoding string) string {
+	accepted := make(map[string]bool)
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		params = strings.ReplaceAll(params, " ", "")
+		if params == "q=0" || params == "q=0.0" || params == "q=0.00" || params == "q=0.000" {
+			continue
+		}
+		accepted[strings.ToLower(strings.TrimSpace(name))] = true
+	}
+
+	switch {
+	case accepted["gzip"] || accepted["*"]:
+		return "gzip"
+	case accepted["deflate"]:
+		return "deflate"
+	}
+	return ""
+}
@@ -0,0 +1,321 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"config-client/share/config"
+	shareErrors "config-client/share/errors"
+	"config-client/share/tenant"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// IdempotencyKeyHeader 幂等键请求头
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotencyReplayedHeader 重放响应标记响应头
+	IdempotencyReplayedHeader = "Idempotency-Replayed"
+
+	// maxIdempotencyKeyLength 幂等键最大长度
+	maxIdempotencyKeyLength = 255
+	// idempotencyLockTTL 请求处理中占位记录的有效期（超时后视为处理异常，允许重试）
+	idempotencyLockTTL = time.Minute
+)
+
+// 幂等记录状态
+const (
+	idempotencyStatusProcessing = "processing" // 首次请求处理中
+	idempotencyStatusCompleted  = "completed"  // 已完成并缓存响应
+)
+
+// IdempotencyRecord 幂等记录
+type IdempotencyRecord struct {
+	Digest      string `json:"digest"`       // 请求摘要（方法+路径+查询参数+请求体）
+	Status      string `json:"status"`       // 状态: processing, completed
+	StatusCode  int    `json:"status_code"`  // 响应状态码
+	ContentType string `json:"content_type"` // 响应内容类型
+	Body        []byte `json:"body"`         // 响应体
+}
+
+// IdempotencyStore 幂等记录存储
+type IdempotencyStore interface {
+	// Acquire 占用幂等键：键不存在时写入处理中记录并返回 (nil, true)；
+	// 键已存在时返回已有记录和 false
+	Acquire(ctx context.Context, key string, record *IdempotencyRecord, ttl time.Duration) (*IdempotencyRecord, bool, error)
+
+	// Save 保存已完成请求的响应
+	Save(ctx context.Context, key string, record *IdempotencyRecord, ttl time.Duration) error
+
+	// Release 释放幂等键（请求处理失败时调用，允许客户端重试）
+	Release(ctx context.Context, key string) error
+}
+
+// NewIdempotencyStore 根据配置创建幂等记录存储
+// backend 为 redis 且 client 不为空时使用 Redis（多实例共享），否则使用进程内存储
+func NewIdempotencyStore(cfg config.IdempotencyConfig, client redis.UniversalClient) IdempotencyStore {
+	if cfg.Backend == "redis" && client != nil {
+		return NewRedisIdempotencyStore(client)
+	}
+	return NewMemoryIdempotencyStore()
+}
+
+// Idempotency 幂等中间件
+// 请求携带 Idempotency-Key 时，同一幂等键在有效期内只执行一次，重试请求直接返回首次请求的响应：
+// 1. 首次请求执行成功（状态码 < 500）后缓存响应，重试时原样返回并设置 Idempotency-Replayed: true
+// 2. 首次请求仍在处理中时，重试请求返回 409
+// 3. 同一幂等键携带不同的请求内容时返回 422
+// 4. 首次请求失败（5xx 或 panic）时释放幂等键，允许客户端重试
+// 幂等键按租户和认证主体隔离（需注册在 Auth 和 Tenant 之后）
+// 未携带 Idempotency-Key 的请求不受影响；存储异常时放行请求
+func Idempotency(store IdempotencyStore, cfg config.IdempotencyConfig) app.HandlerFunc {
+	ttl := cfg.GetTTL()
+	return func(ctx context.Context, c *app.RequestContext) {
+		idempotencyKey := string(c.Request.Header.Peek(IdempotencyKeyHeader))
+		if idempotencyKey == "" {
+			c.Next(ctx)
+			return
+		}
+		if len(idempotencyKey) > maxIdempotencyKeyLength {
+			shareErrors.HandleError(ctx, c, shareErrors.ErrBadRequest("Idempotency-Key 长度不能超过 255").
+				WithVariant("idempotency_key_too_long"))
+			c.Abort()
+			return
+		}
+
+		// 1. 占用幂等键（按租户、认证主体、方法和路径隔离，不同调用方和不同接口可使用相同的幂等键）
+		key := idempotencyScope(ctx) + " " + string(c.Method()) + " " + string(c.Path()) + " " + idempotencyKey
+		digest := requestDigest(c)
+		existing, acquired, err := store.Acquire(ctx, key,
+			&IdempotencyRecord{Digest: digest, Status: idempotencyStatusProcessing}, idempotencyLockTTL)
+		if err != nil {
+			hlog.CtxWarnf(ctx, "幂等检查失败，放行请求: key=%s, err=%v", idempotencyKey, err)
+			c.Next(ctx)
+			return
+		}
+
+		// 2. 幂等键已被占用：校验请求内容并重放响应
+		if !acquired {
+			replayIdempotentResponse(ctx, c, existing, digest)
+			return
+		}
+
+		// 3. 执行请求，失败时释放幂等键
+		completed := false
+		defer func() {
+			if !completed {
+				if err := store.Release(context.WithoutCancel(ctx), key); err != nil {
+					hlog.CtxWarnf(ctx, "释放幂等键失败: key=%s, err=%v", idempotencyKey, err)
+				}
+			}
+		}()
+
+		c.Next(ctx)
+
+		statusCode := c.Response.StatusCode()
+		if statusCode >= consts.StatusInternalServerError {
+			return
+		}
+
+		// 4. 缓存响应
+		record := &IdempotencyRecord{
+			Digest:      digest,
+			Status:      idempotencyStatusCompleted,
+			StatusCode:  statusCode,
+			ContentType: string(c.Response.Header.ContentType()),
+			Body:        append([]byte(nil), c.Response.Body()...),
+		}
+		if err := store.Save(context.WithoutCancel(ctx), key, record, ttl); err != nil {
+			hlog.CtxWarnf(ctx, "保存幂等响应失败: key=%s, err=%v", idempotencyKey, err)
+			return
+		}
+		completed = true
+	}
+}
+
+// replayIdempotentResponse 返回幂等键已有记录对应的响应
+func replayIdempotentResponse(ctx context.Context, c *app.RequestContext, record *IdempotencyRecord, digest string) {
+	if record.Digest != digest {
+		shareErrors.HandleError(ctx, c, shareErrors.New(shareErrors.UnprocessableEntity, "Idempotency-Key 已用于内容不同的请求").
+			WithVariant("idempotency_key_reused"))
+		c.Abort()
+		return
+	}
+	if record.Status != idempotencyStatusCompleted {
+		shareErrors.HandleError(ctx, c, shareErrors.ErrConflict("相同 Idempotency-Key 的请求正在处理中，请稍后重试").
+			WithVariant("idempotency_in_progress").
+			WithRetryAfter(1))
+		c.Abort()
+		return
+	}
+
+	c.Response.Header.Set(IdempotencyReplayedHeader, "true")
+	c.Data(record.StatusCode, record.ContentType, record.Body)
+	c.Abort()
+}
+
+// idempotencyScope 幂等键的调用方范围（租户ID和 API Key ID，未启用认证时 API Key ID 为 0）
+// 需注册在 Tenant 之后，否则不同租户使用相同的幂等键时会重放其他租户的响应
+func idempotencyScope(ctx context.Context) string {
+	tenantID, _ := tenant.FromContext(ctx)
+	principalID := 0
+	if principal := PrincipalFromContext(ctx); principal != nil {
+		principalID = principal.ID
+	}
+	return "t" + strconv.Itoa(tenantID) + ":k" + strconv.Itoa(principalID)
+}
+
+// requestDigest 计算请求摘要（方法、路径、查询参数和请求体）
+func requestDigest(c *app.RequestContext) string {
+	h := sha256.New()
+	h.Write(c.Method())
+	h.Write([]byte{0})
+	h.Write(c.Path())
+	h.Write([]byte{0})
+	h.Write(c.Request.URI().QueryString())
+	h.Write([]byte{0})
+	h.Write(c.Request.Body())
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ==================== 进程内存储 ====================
+
+// memoryIdempotencyEntry 进程内幂等记录
+type memoryIdempotencyEntry struct {
+	record    *IdempotencyRecord
+	expiresAt time.Time
+}
+
+// MemoryIdempotencyStore 进程内幂等记录存储（仅对单实例生效）
+type MemoryIdempotencyStore struct {
+	mu        sync.Mutex
+	entries   map[string]*memoryIdempotencyEntry
+	lastSweep time.Time
+}
+
+// NewMemoryIdempotencyStore 创建进程内幂等记录存储
+func NewMemoryIdempotencyStore() *MemoryIdempotencyStore {
+	return &MemoryIdempotencyStore{
+		entries:   make(map[string]*memoryIdempotencyEntry),
+		lastSweep: time.Now(),
+	}
+}
+
+// Acquire 占用幂等键
+func (s *MemoryIdempotencyStore) Acquire(ctx context.Context, key string, record *IdempotencyRecord, ttl time.Duration) (*IdempotencyRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.sweep(now)
+
+	if entry, ok := s.entries[key]; ok && now.Before(entry.expiresAt) {
+		return entry.record, false, nil
+	}
+	s.entries[key] = &memoryIdempotencyEntry{record: record, expiresAt: now.Add(ttl)}
+	return nil, true, nil
+}
+
+// Save 保存已完成请求的响应
+func (s *MemoryIdempotencyStore) Save(ctx context.Context, key string, record *IdempotencyRecord, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = &memoryIdempotencyEntry{record: record, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+
+// Release 释放幂等键
+func (s *MemoryIdempotencyStore) Release(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, key)
+	return nil
+}
+
+// sweep 定期回收过期记录，避免内存无限增长
+func (s *MemoryIdempotencyStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < time.Minute {
+		return
+	}
+	s.lastSweep = now
+	for key, entry := range s.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(s.entries, key)
+		}
+	}
+}
+
+// ==================== Redis 存储 ====================
+
+// idempotencyKeyPrefix Redis 幂等键前缀
+const idempotencyKeyPrefix = "config:idempotency:"
+
+// RedisIdempotencyStore 基于 Redis 的幂等记录存储（多实例共享）
+type RedisIdempotencyStore struct {
+	client redis.UniversalClient
+}
+
+// NewRedisIdempotencyStore 创建 Redis 幂等记录存储
+func NewRedisIdempotencyStore(client redis.UniversalClient) *RedisIdempotencyStore {
+	return &RedisIdempotencyStore{client: client}
+}
+
+// Acquire 占用幂等键（SET NX 保证多实例并发请求只有一个能占用）
+func (s *RedisIdempotencyStore) Acquire(ctx context.Context, key string, record *IdempotencyRecord, ttl time.Duration) (*IdempotencyRecord, bool, error) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, false, err
+	}
+
+	redisKey := s.redisKey(key)
+	ok, err := s.client.SetNX(ctx, redisKey, data, ttl).Result()
+	if err != nil {
+		return nil, false, err
+	}
+	if ok {
+		return nil, true, nil
+	}
+
+	raw, err := s.client.Get(ctx, redisKey).Bytes()
+	if errors.Is(err, redis.Nil) {
+		// 占位记录恰好过期或被释放，重新占用
+		return s.Acquire(ctx, key, record, ttl)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	var existing IdempotencyRecord
+	if err := json.Unmarshal(raw, &existing); err != nil {
+		return nil, false, err
+	}
+	return &existing, false, nil
+}
+
+// Save 保存已完成请求的响应
+func (s *RedisIdempotencyStore) Save(ctx context.Context, key string, record *IdempotencyRecord, ttl time.Duration) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, s.redisKey(key), data, ttl).Err()
+}
+
+// Release 释放幂等键
+func (s *RedisIdempotencyStore) Release(ctx context.Context, key string) error {
+	return s.client.Del(ctx, s.redisKey(key)).Err()
+}
+
+// redisKey 幂等键较长且包含任意字符，取摘要作为 Redis 键
+func (s *RedisIdempotencyStore) redisKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return idempotencyKeyPrefix + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,247 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"config-client/share/config"
+	"config-client/share/errors"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/redis/go-redis/v9"
+)
+
+// RateLimiter 令牌桶限流器
+type RateLimiter interface {
+	// Allow 尝试从 key 对应的令牌桶中取出一个令牌
+	// 返回是否放行；拒绝时同时返回建议的重试等待时间
+	Allow(ctx context.Context, key string, rule config.RateLimitRule) (bool, time.Duration, error)
+}
+
+// NewRateLimiter 根据配置创建限流器
+// backend 为 redis 且 client 不为空时使用 Redis 令牌桶（多实例共享限额），否则使用进程内令牌桶
+func NewRateLimiter(cfg config.RateLimitConfig, client redis.UniversalClient) RateLimiter {
+	if cfg.Backend == "redis" && client != nil {
+		return NewRedisRateLimiter(client)
+	}
+	return NewMemoryRateLimiter()
+}
+
+// RateLimit 限流中间件
+// 按客户端IP和客户端ID（请求头 X-Client-ID、查询参数 client_id 或 JSON 请求体中的 client_id）分别限流，
+// 超出限额时返回 429 并通过 Retry-After 告知客户端重试等待秒数；限流器自身异常时放行
+func RateLimit(limiter RateLimiter, cfg config.RateLimitConfig) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		// 1. 按 IP 限流
+		if cfg.PerIP.Rate > 0 {
+			if !checkRateLimit(ctx, c, limiter, "ip:"+c.ClientIP(), cfg.PerIP) {
+				return
+			}
+		}
+
+		// 2. 按客户端ID限流
+		if cfg.PerClient.Rate > 0 {
+			if clientID := extractClientID(c); clientID != "" {
+				if !checkRateLimit(ctx, c, limiter, "client:"+clientID, cfg.PerClient) {
+					return
+				}
+			}
+		}
+
+		c.Next(ctx)
+	}
+}
+
+// checkRateLimit 检查限额，超出时写入 429 响应并返回 false
+func checkRateLimit(ctx context.Context, c *app.RequestContext, limiter RateLimiter, key string, rule config.RateLimitRule) bool {
+	allowed, retryAfter, err := limiter.Allow(ctx, key, rule)
+	if err != nil {
+		hlog.CtxWarnf(ctx, "限流检查失败，放行请求: key=%s, err=%v", key, err)
+		return true
+	}
+	if allowed {
+		return true
+	}
+
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	errors.HandleError(ctx, c, errors.ErrTooManyRequests("请求过于频繁，请 "+strconv.Itoa(seconds)+" 秒后重试").
+		WithVariant("rate_limited").
+		WithParams(errors.Params{"seconds": strconv.Itoa(seconds)}).
+		WithRetryAfter(seconds))
+	c.Abort()
+	return false
+}
+
+// extractClientID 从请求中提取客户端ID
+func extractClientID(c *app.RequestContext) string {
+	if clientID := string(c.Request.Header.Peek("X-Client-ID")); clientID != "" {
+		return clientID
+	}
+	if clientID := c.Query("client_id"); clientID != "" {
+		return clientID
+	}
+
+	// 长轮询等接口在 JSON 请求体中携带 client_id
+	return parseCallerBody(c).ClientID
+}
+
+// maxCallerBodySize 解析调用方身份时读取的最大请求体字节数（导入等大请求体不解析）
+const maxCallerBodySize = 64 * 1024
+
+// callerBody JSON 请求体中的调用方身份字段
+type callerBody struct {
+	ClientID string `json:"client_id"`
+	Operator string `json:"operator"`
+}
+
+// parseCallerBody 从 JSON 请求体中解析调用方身份，非 JSON 或解析失败时返回空值
+func parseCallerBody(c *app.RequestContext) callerBody {
+	var body callerBody
+	if !strings.HasPrefix(string(c.ContentType()), "application/json") {
+		return body
+	}
+	if c.Request.Header.ContentLength() > maxCallerBodySize {
+		return body
+	}
+	raw := c.Request.Body()
+	if len(raw) == 0 || len(raw) > maxCallerBodySize {
+		return body
+	}
+	_ = json.Unmarshal(raw, &body)
+	return body
+}
+
+// ==================== 进程内令牌桶 ====================
+
+// bucketIdleTTL 空闲令牌桶的回收时间
+const bucketIdleTTL = 10 * time.Minute
+
+// tokenBucket 令牌桶
+type tokenBucket struct {
+	tokens   float64
+	lastSeen time.Time
+}
+
+// MemoryRateLimiter 进程内令牌桶限流器（仅对单实例生效）
+type MemoryRateLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+// NewMemoryRateLimiter 创建进程内令牌桶限流器
+func NewMemoryRateLimiter() *MemoryRateLimiter {
+	return &MemoryRateLimiter{
+		buckets:   make(map[string]*tokenBucket),
+		lastSweep: time.Now(),
+	}
+}
+
+// Allow 尝试取出一个令牌
+func (l *MemoryRateLimiter) Allow(ctx context.Context, key string, rule config.RateLimitRule) (bool, time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.sweep(now)
+
+	burst := float64(rule.GetBurst())
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: burst, lastSeen: now}
+		l.buckets[key] = bucket
+	}
+
+	// 按流逝时间补充令牌
+	bucket.tokens = math.Min(burst, bucket.tokens+now.Sub(bucket.lastSeen).Seconds()*rule.Rate)
+	bucket.lastSeen = now
+
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0, nil
+	}
+	wait := time.Duration((1 - bucket.tokens) / rule.Rate * float64(time.Second))
+	return false, wait, nil
+}
+
+// sweep 定期回收长时间空闲的令牌桶，避免内存无限增长
+func (l *MemoryRateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < time.Minute {
+		return
+	}
+	l.lastSweep = now
+	for key, bucket := range l.buckets {
+		if now.Sub(bucket.lastSeen) > bucketIdleTTL {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// ==================== Redis 令牌桶 ====================
+
+// rateLimitKeyPrefix Redis 限流键前缀
+const rateLimitKeyPrefix = "config:ratelimit:"
+
+// tokenBucketScript 令牌桶 Lua 脚本（原子地补充并取出令牌）
+// KEYS[1]: 令牌桶键；ARGV: 速率（个/秒）、桶容量、当前时间（毫秒）
+// 返回: {是否放行, 建议等待毫秒数}
+var tokenBucketScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+if tokens == nil then
+  tokens = burst
+  ts = now
+end
+
+tokens = math.min(burst, tokens + math.max(0, now - ts) / 1000 * rate)
+
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+else
+  wait = math.ceil((1 - tokens) / rate * 1000)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tokens, 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst / rate * 1000) + 1000)
+return {allowed, wait}
+`)
+
+// RedisRateLimiter 基于 Redis 的令牌桶限流器（多实例共享限额）
+type RedisRateLimiter struct {
+	client redis.UniversalClient
+}
+
+// NewRedisRateLimiter 创建 Redis 令牌桶限流器
+func NewRedisRateLimiter(client redis.UniversalClient) *RedisRateLimiter {
+	return &RedisRateLimiter{client: client}
+}
+
+// Allow 尝试取出一个令牌
+func (l *RedisRateLimiter) Allow(ctx context.Context, key string, rule config.RateLimitRule) (bool, time.Duration, error) {
+	result, err := tokenBucketScript.Run(ctx, l.client, []string{rateLimitKeyPrefix + key},
+		rule.Rate, rule.GetBurst(), time.Now().UnixMilli()).Int64Slice()
+	if err != nil {
+		return false, 0, err
+	}
+	if len(result) != 2 {
+		return true, 0, nil
+	}
+	return result[0] == 1, time.Duration(result[1]) * time.Millisecond, nil
+}
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"config-client/share/replica"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// ReadReplica 读写分离中间件（配置了只读副本时注册）
+// /api/ 下的 GET、HEAD 请求标记为允许读取只读副本，查询、导出和历史查询等接口由副本承担；
+// 其他请求（包括写接口中的校验查询）使用主库。副本存在复制延迟，写入后立即查询可能读到旧数据
+func ReadReplica() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		method := string(c.Method())
+		if (method == consts.MethodGet || method == consts.MethodHead) &&
+			strings.HasPrefix(string(c.Request.URI().Path()), authProtectedPrefix) {
+			ctx = replica.WithReadReplica(ctx)
+		}
+		c.Next(ctx)
+	}
+}
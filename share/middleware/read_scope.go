@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	shareErrors "config-client/share/errors"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// ReadScope 只读令牌的读取范围
+type ReadScope struct {
+	NamespaceID int      // 绑定的命名空间ID
+	Environment string   // 绑定的环境
+	KeyPrefixes []string // 允许读取的配置键前缀（为空时允许命名空间下的全部配置）
+}
+
+// Allows 是否允许读取指定命名空间、环境下的配置键（key 为空时只校验命名空间和环境）
+func (s *ReadScope) Allows(namespaceID int, environment, key string) bool {
+	if namespaceID != s.NamespaceID || environment != s.Environment {
+		return false
+	}
+	if key == "" || len(s.KeyPrefixes) == 0 {
+		return true
+	}
+	for _, prefix := range s.KeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadScopeFromContext 获取只读令牌的读取范围（未认证或非只读令牌时返回 nil）
+func ReadScopeFromContext(ctx context.Context) *ReadScope {
+	if principal := PrincipalFromContext(ctx); principal != nil {
+		return principal.Scope
+	}
+	return nil
+}
+
+// RestrictReadTokens 只读令牌接口白名单中间件（需注册在 Auth 之后）
+// routes 为 "方法 路由" 形式（如 "GET /api/v1/configs/key"），按注册的路由模板匹配；
+// 只读令牌访问白名单以外的接口返回 403，具体的命名空间、环境和配置键由各接口自行校验
+func RestrictReadTokens(routes ...string) app.HandlerFunc {
+	allowed := make(map[string]struct{}, len(routes))
+	for _, route := range routes {
+		allowed[route] = struct{}{}
+	}
+
+	return func(ctx context.Context, c *app.RequestContext) {
+		if ReadScopeFromContext(ctx) == nil {
+			c.Next(ctx)
+			return
+		}
+
+		route := string(c.Method()) + " " + c.FullPath()
+		if _, ok := allowed[route]; !ok {
+			abortWithError(ctx, c, shareErrors.ErrForbidden("只读令牌无权访问该接口"))
+			return
+		}
+		c.Next(ctx)
+	}
+}
@@ -5,11 +5,9 @@ import (
 	"runtime/debug"
 
 	"config-client/share/errors"
-	"config-client/share/types"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
-	"github.com/cloudwego/hertz/pkg/protocol/consts"
 )
 
 // Recovery 统一错误恢复和处理中间件
@@ -27,7 +25,7 @@ func Recovery() app.HandlerFunc {
 					errors.HandleError(ctx, c, e)
 				} else {
 					// 非 error 类型的 panic，返回内部错误
-					c.JSON(consts.StatusInternalServerError, types.Error(errors.InternalError, "服务器内部错误"))
+					errors.HandleError(ctx, c, errors.ErrInternal("服务器内部错误", nil))
 				}
 
 				// 终止后续处理
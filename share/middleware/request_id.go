@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"config-client/share/constants"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// RequestIDHeader 请求ID请求头/响应头
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength 允许透传的请求ID最大长度
+const maxRequestIDLength = 128
+
+// RequestID 请求ID中间件
+// 优先沿用上游传入的 X-Request-ID（便于跨服务串联），否则生成新的请求ID；
+// 请求ID写入响应头，并与客户端ID一起放入上下文，供后续 handler、领域服务日志使用
+func RequestID() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		requestID := string(c.Request.Header.Peek(RequestIDHeader))
+		if !isValidRequestID(requestID) {
+			requestID = newRequestID()
+		}
+		c.Response.Header.Set(RequestIDHeader, requestID)
+
+		ctx = context.WithValue(ctx, constants.RequestIDKey, requestID)
+		if clientID := extractClientID(c); clientID != "" {
+			ctx = context.WithValue(ctx, constants.ClientIDKey, clientID)
+		}
+
+		c.Next(ctx)
+	}
+}
+
+// newRequestID 生成 32 位十六进制请求ID
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// isValidRequestID 校验上游传入的请求ID（限制长度和字符集，避免日志注入）
+func isValidRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for _, ch := range requestID {
+		switch {
+		case ch >= 'a' && ch <= 'z', ch >= 'A' && ch <= 'Z', ch >= '0' && ch <= '9':
+		case ch == '-', ch == '_', ch == '.', ch == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
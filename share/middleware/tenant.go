@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	shareErrors "config-client/share/errors"
+	"config-client/share/tenant"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// TenantHeader 指定租户的请求头（值为租户ID）
+// 仅默认租户的认证主体（或未启用认证时）可以通过该请求头代其他租户操作
+const TenantHeader = "X-Tenant-ID"
+
+// TenantChecker 租户状态校验器
+type TenantChecker interface {
+	// CheckActive 校验租户存在且已启用，失败时返回 AppError（错误码决定响应状态码）
+	CheckActive(ctx context.Context, tenantID int) error
+}
+
+// Tenant 租户解析中间件（需注册在 Auth 之后，只处理 /api/ 下的请求）
+// 租户取自认证主体；未启用认证时为默认租户。X-Tenant-ID 请求头只对默认租户生效，
+// 其他租户（以及只读令牌）携带与自身不一致的租户ID时返回 403。解析出的租户放入上下文，仓储层据此过滤数据
+func Tenant(checker TenantChecker) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		if !strings.HasPrefix(string(c.Request.URI().Path()), authProtectedPrefix) {
+			c.Next(ctx)
+			return
+		}
+
+		tenantID := tenant.DefaultTenantID
+		readOnly := false
+		if principal := PrincipalFromContext(ctx); principal != nil {
+			tenantID = principal.TenantID
+			readOnly = principal.Scope != nil
+		}
+
+		if header := strings.TrimSpace(string(c.Request.Header.Peek(TenantHeader))); header != "" {
+			requested, err := strconv.Atoi(header)
+			if err != nil || requested <= 0 {
+				abortWithError(ctx, c, shareErrors.ErrBadRequest("无效的租户ID: "+header))
+				return
+			}
+			if requested != tenantID && (tenantID != tenant.DefaultTenantID || readOnly) {
+				abortWithError(ctx, c, shareErrors.ErrForbidden("无权访问其他租户的数据"))
+				return
+			}
+			tenantID = requested
+		}
+
+		if err := checker.CheckActive(ctx, tenantID); err != nil {
+			abortWithError(ctx, c, err)
+			return
+		}
+
+		c.Next(tenant.WithTenant(ctx, tenantID))
+	}
+}
+
+// RequireSystemTenant 仅允许默认租户访问（租户管理等跨租户接口）
+func RequireSystemTenant() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		if !tenant.IsSystem(ctx) {
+			abortWithError(ctx, c, shareErrors.ErrForbidden("仅默认租户可以访问该接口"))
+			return
+		}
+		c.Next(ctx)
+	}
+}
+
+// abortWithError 输出错误响应并终止请求
+func abortWithError(ctx context.Context, c *app.RequestContext, err error) {
+	shareErrors.HandleError(ctx, c, err)
+	c.Abort()
+}
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"context"
+
+	"config-client/share/constants"
+	"config-client/share/tracing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing 链路追踪中间件
+// 从请求头（W3C traceparent）中提取上游链路，为每个请求创建服务端 span，
+// 后续应用服务、领域服务和数据库访问的 span 均挂在该 span 下；需注册在 RequestID 之后
+func Tracing() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		// 1. 提取上游链路上下文
+		ctx = otel.GetTextMapPropagator().Extract(ctx, &headerCarrier{header: &c.Request.Header})
+
+		// 2. 创建服务端 span（以路由模板命名，避免路径参数导致 span 名称发散）
+		route := c.FullPath()
+		if route == "" {
+			route = string(c.Request.URI().Path())
+		}
+		method := string(c.Method())
+		ctx, span := tracing.Tracer().Start(ctx, method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(method),
+				semconv.HTTPRoute(route),
+				semconv.URLPath(string(c.Request.URI().Path())),
+				semconv.ClientAddress(c.ClientIP()),
+				semconv.UserAgentOriginal(string(c.UserAgent())),
+			),
+		)
+		defer span.End()
+
+		if requestID, ok := ctx.Value(constants.RequestIDKey).(string); ok {
+			span.SetAttributes(attribute.String("request.id", requestID))
+		}
+		if clientID, ok := ctx.Value(constants.ClientIDKey).(string); ok {
+			span.SetAttributes(attribute.String("client.id", clientID))
+		}
+
+		c.Next(ctx)
+
+		// 3. 记录响应状态
+		status := c.Response.StatusCode()
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		if status >= 500 {
+			span.SetStatus(codes.Error, "")
+		}
+	}
+}
+
+// headerCarrier 适配 Hertz 请求头的 TextMapCarrier
+type headerCarrier struct {
+	header *protocol.RequestHeader
+}
+
+var _ propagation.TextMapCarrier = (*headerCarrier)(nil)
+
+// Get 获取请求头
+func (h *headerCarrier) Get(key string) string {
+	return string(h.header.Peek(key))
+}
+
+// Set 设置请求头
+func (h *headerCarrier) Set(key, value string) {
+	h.header.Set(key, value)
+}
+
+// Keys 获取所有请求头名称
+func (h *headerCarrier) Keys() []string {
+	keys := make([]string, 0, h.header.Len())
+	h.header.VisitAll(func(key, _ []byte) {
+		keys = append(keys, string(key))
+	})
+	return keys
+}
@@ -0,0 +1,22 @@
+// Package replica 读写分离上下文
+// 配置了只读副本时，只有标记为允许读取副本的上下文中的查询路由到副本，其余查询、全部写操作和事务使用主库
+// （见 share/repository/gorm.RegisterReadReplicas）
+package replica
+
+import (
+	"context"
+
+	"config-client/share/constants"
+)
+
+// WithReadReplica 标记上下文中的查询可以读取只读副本
+// 仅用于可以接受复制延迟的只读操作（查询接口、长轮询版本比较、历史查询），写操作前的校验查询不要使用
+func WithReadReplica(ctx context.Context) context.Context {
+	return context.WithValue(ctx, constants.ReadReplicaKey, true)
+}
+
+// Allowed 上下文中的查询是否可以读取只读副本
+func Allowed(ctx context.Context) bool {
+	allowed, _ := ctx.Value(constants.ReadReplicaKey).(bool)
+	return allowed
+}
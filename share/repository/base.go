@@ -41,6 +41,14 @@ type TransactionalRepository interface {
 	Rollback(ctx context.Context) error
 }
 
+// TransactionManager 事务管理器（工作单元）
+// 在同一事务中执行跨仓储的写操作：fn 中使用传入 ctx 的仓储操作共享同一事务，
+// fn 返回错误或发生 panic 时整体回滚，否则提交；ctx 中已存在事务时直接复用（不嵌套开启）
+type TransactionManager interface {
+	// RunInTx 在事务中执行操作
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 // Entity 实体接口，所有实体必须实现此接口
 type Entity[ID comparable] interface {
 	// GetID 获取实体主键
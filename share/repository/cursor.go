@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidCursor 分页游标格式无效
+var ErrInvalidCursor = errors.New("invalid page cursor")
+
+// Cursor 游标分页位置
+// 记录上一页最后一条数据的创建时间和ID，下一页从该位置之后（按 created_at DESC, id DESC）继续查询
+// 零值表示从第一条数据开始
+type Cursor struct {
+	CreatedAt time.Time // 创建时间
+	ID        int       // 主键ID
+}
+
+// CursorEntity 支持游标分页的实体
+type CursorEntity interface {
+	// PageCursor 实体所在的游标位置
+	PageCursor() Cursor
+}
+
+// IsZero 是否为起始位置
+func (c Cursor) IsZero() bool {
+	return c.ID == 0 && c.CreatedAt.IsZero()
+}
+
+// Encode 编码为不透明的游标字符串（起始位置编码为空字符串）
+func (c Cursor) Encode() string {
+	if c.IsZero() {
+		return ""
+	}
+	raw := strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + ":" + strconv.Itoa(c.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// MarshalText 实现 encoding.TextMarshaler，序列化为不透明的游标字符串
+func (c Cursor) MarshalText() ([]byte, error) {
+	return []byte(c.Encode()), nil
+}
+
+// UnmarshalText 实现 encoding.TextUnmarshaler
+func (c *Cursor) UnmarshalText(text []byte) error {
+	cursor, err := DecodeCursor(string(text))
+	if err != nil {
+		return err
+	}
+	*c = cursor
+	return nil
+}
+
+// DecodeCursor 解析游标字符串，空字符串解析为起始位置
+func DecodeCursor(s string) (Cursor, error) {
+	if s == "" {
+		return Cursor{}, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	nanos, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return Cursor{}, ErrInvalidCursor
+	}
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	cursorID, err := strconv.Atoi(id)
+	if err != nil || cursorID <= 0 {
+		return Cursor{}, ErrInvalidCursor
+	}
+	return Cursor{CreatedAt: time.Unix(0, unixNano), ID: cursorID}, nil
+}
@@ -0,0 +1,23 @@
+package gorm
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"config-client/share/repository"
+)
+
+// ApplyCursor 应用游标分页条件
+// 按 createdAtColumn DESC, idColumn DESC 排序，从游标位置之后查询 size+1 条（多出的一条用于判断是否还有下一页）
+func ApplyCursor(db *gorm.DB, createdAtColumn, idColumn string, cursor repository.Cursor, size int) *gorm.DB {
+	if !cursor.IsZero() {
+		db = db.Where(fmt.Sprintf("(%s, %s) < (?, ?)", createdAtColumn, idColumn), cursor.CreatedAt, cursor.ID)
+	}
+	return db.Order(createdAtColumn + " DESC").Order(idColumn + " DESC").Limit(size + 1)
+}
+
+// PageCursor 实体的游标分页位置
+func (e *BaseEntity) PageCursor() repository.Cursor {
+	return repository.Cursor{CreatedAt: e.CreatedAt, ID: e.ID}
+}
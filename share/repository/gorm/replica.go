@@ -0,0 +1,45 @@
+package gorm
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+
+	"config-client/share/replica"
+)
+
+// RegisterReadReplicas 注册只读副本（基于 GORM dbresolver）
+// 1. 写操作（创建、更新、删除）和事务始终使用主库
+// 2. 上下文经 replica.WithReadReplica 标记的查询随机路由到副本
+// 3. 其余查询固定使用主库，保证写入前的校验和写入后立即读取的一致性
+// 副本使用与主库相同的连接池参数
+func RegisterReadReplicas(db *gorm.DB, replicas []gorm.Dialector, maxOpenConns, maxIdleConns int, connMaxLifetime time.Duration) error {
+	resolver := dbresolver.Register(dbresolver.Config{
+		Replicas: replicas,
+		Policy:   dbresolver.RandomPolicy{},
+	}).
+		SetMaxOpenConns(maxOpenConns).
+		SetMaxIdleConns(maxIdleConns).
+		SetConnMaxLifetime(connMaxLifetime)
+	if err := db.Use(resolver); err != nil {
+		return err
+	}
+
+	// dbresolver 默认将所有查询路由到副本，未标记的查询在其之后切换回主库
+	if err := db.Callback().Query().After("gorm:db_resolver").Register("replica:pin_primary", pinPrimary); err != nil {
+		return err
+	}
+	if err := db.Callback().Row().After("gorm:db_resolver").Register("replica:pin_primary", pinPrimary); err != nil {
+		return err
+	}
+	return db.Callback().Raw().After("gorm:db_resolver").Register("replica:pin_primary", pinPrimary)
+}
+
+// pinPrimary 未允许读取副本的查询使用主库
+func pinPrimary(tx *gorm.DB) {
+	if tx.Statement.Context != nil && replica.Allowed(tx.Statement.Context) {
+		return
+	}
+	dbresolver.Write.ModifyStatement(tx.Statement)
+}
@@ -31,10 +31,27 @@ func (r *GormRepository[T, ID]) DB() *gorm.DB {
 
 // getDB 获取数据库连接（支持事务）
 func (r *GormRepository[T, ID]) getDB(ctx context.Context) *gorm.DB {
+	return GetDB(ctx, r.db)
+}
+
+// GetDB 获取数据库连接：上下文中存在事务时使用事务，否则使用 db
+// 供自定义仓储实现复用，使其能够参与 WithTx/RunInTx 开启的事务
+func GetDB(ctx context.Context, db *gorm.DB) *gorm.DB {
 	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
 		return tx
 	}
-	return r.db.WithContext(ctx)
+	return db.WithContext(ctx)
+}
+
+// RunInTx 在事务中执行操作
+// fn 返回错误或发生 panic 时回滚，否则提交；上下文中已存在事务时直接复用（不嵌套开启）
+func RunInTx(ctx context.Context, db *gorm.DB, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
 }
 
 // Create 创建单个实体
@@ -85,6 +102,7 @@ func (r *GormRepository[T, ID]) List(ctx context.Context) ([]*T, error) {
 }
 
 // Page 分页查询
+// request.Cursor 非 nil 时使用游标分页（按 created_at DESC, id DESC，忽略 OrderBy），实体需实现 repository.CursorEntity
 func (r *GormRepository[T, ID]) Page(ctx context.Context, request *repository.PageRequest) (*repository.PageResult[*T], error) {
 	db := r.getDB(ctx)
 
@@ -93,6 +111,10 @@ func (r *GormRepository[T, ID]) Page(ctx context.Context, request *repository.Pa
 		db = ApplyConditions(db, request.Conditions...)
 	}
 
+	if request.Cursor != nil {
+		return r.pageByCursor(db, request)
+	}
+
 	// 统计总数
 	var total int64
 	var entity T
@@ -121,6 +143,21 @@ func (r *GormRepository[T, ID]) Page(ctx context.Context, request *repository.Pa
 	return repository.NewPageResult(entities, total, request.Page, request.Size), nil
 }
 
+// pageByCursor 游标分页查询
+func (r *GormRepository[T, ID]) pageByCursor(db *gorm.DB, request *repository.PageRequest) (*repository.PageResult[*T], error) {
+	var entity T
+	if _, ok := any(&entity).(repository.CursorEntity); !ok {
+		return nil, errors.New("entity does not support cursor pagination")
+	}
+	var entities []*T
+	if err := ApplyCursor(db.Model(&entity), "created_at", "id", *request.Cursor, request.Size).Find(&entities).Error; err != nil {
+		return nil, err
+	}
+	return repository.NewCursorPageResult(entities, request.Size, func(e *T) repository.Cursor {
+		return any(e).(repository.CursorEntity).PageCursor()
+	}), nil
+}
+
 // BeginTx 开启事务
 func (r *GormRepository[T, ID]) BeginTx(ctx context.Context) (context.Context, error) {
 	tx := r.db.WithContext(ctx).Begin()
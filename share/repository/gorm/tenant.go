@@ -0,0 +1,123 @@
+package gorm
+
+import (
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"config-client/share/tenant"
+)
+
+// tenantField 租户字段名（持久化对象包含该字段即视为租户隔离的表）
+const tenantField = "TenantID"
+
+// TenantScoper 没有 tenant_id 列、通过所属命名空间或配置间接归属租户的持久化对象实现该接口
+// TenantScope 返回只保留指定租户数据的过滤条件，查询、更新、删除时由回调追加
+type TenantScoper interface {
+	TenantScope(tenantID int) clause.Expression
+}
+
+// RegisterTenantCallbacks 注册多租户回调到 GORM
+// 对包含 TenantID 字段的持久化对象：
+// 1. 创建时按上下文中的租户填充 tenant_id
+// 2. 查询、更新、删除时追加 tenant_id 过滤条件，仓储实现无需逐个处理
+// 3. 更新时忽略 tenant_id 列，数据创建后不能转移到其他租户
+// 实现 TenantScoper 的持久化对象在查询、更新、删除时追加其返回的过滤条件
+// 上下文未携带租户（后台任务）时不做过滤
+func RegisterTenantCallbacks(db *gorm.DB) error {
+	if err := db.Callback().Create().Before("gorm:create").Register("tenant:before_create", tenantBeforeCreate); err != nil {
+		return err
+	}
+	if err := db.Callback().Query().Before("gorm:query").Register("tenant:before_query", tenantScope); err != nil {
+		return err
+	}
+	if err := db.Callback().Row().Before("gorm:row").Register("tenant:before_row", tenantScope); err != nil {
+		return err
+	}
+	if err := db.Callback().Update().Before("gorm:update").Register("tenant:before_update", tenantBeforeUpdate); err != nil {
+		return err
+	}
+	return db.Callback().Delete().Before("gorm:delete").Register("tenant:before_delete", tenantScope)
+}
+
+// tenantBeforeCreate 创建前填充租户ID
+func tenantBeforeCreate(tx *gorm.DB) {
+	field := lookupTenantField(tx)
+	if field == nil {
+		return
+	}
+	tenantID, ok := tenant.FromContext(tx.Statement.Context)
+	if !ok {
+		return
+	}
+
+	ctx := tx.Statement.Context
+	rv := tx.Statement.ReflectValue
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			_ = field.Set(ctx, rv.Index(i), tenantID)
+		}
+	case reflect.Struct:
+		_ = field.Set(ctx, rv, tenantID)
+	}
+}
+
+// tenantBeforeUpdate 更新前忽略租户列并追加租户过滤条件
+func tenantBeforeUpdate(tx *gorm.DB) {
+	if field := lookupTenantField(tx); field != nil {
+		tx.Statement.Omits = append(tx.Statement.Omits, field.DBName)
+	}
+	tenantScope(tx)
+}
+
+// tenantScope 追加租户过滤条件
+// 已有条件整体加括号后再与租户条件 AND，避免 OR 条件绕过租户过滤
+func tenantScope(tx *gorm.DB) {
+	condition := tenantCondition(tx)
+	if condition == nil {
+		return
+	}
+
+	name := clause.Where{}.Name()
+	c := tx.Statement.Clauses[name]
+	where := clause.Where{Exprs: []clause.Expression{condition}}
+	if existing, ok := c.Expression.(clause.Where); ok && len(existing.Exprs) > 0 {
+		where.Exprs = []clause.Expression{clause.AndConditions{Exprs: existing.Exprs}, condition}
+	}
+	c.Name = name
+	c.Expression = where
+	tx.Statement.Clauses[name] = c
+}
+
+// tenantCondition 获取当前语句模型的租户过滤条件（上下文未携带租户或模型不区分租户时返回 nil）
+func tenantCondition(tx *gorm.DB) clause.Expression {
+	if tx.Error != nil || tx.Statement.Schema == nil || tx.Statement.SQL.Len() > 0 {
+		return nil
+	}
+	tenantID, ok := tenant.FromContext(tx.Statement.Context)
+	if !ok {
+		return nil
+	}
+
+	if field := tx.Statement.Schema.LookUpField(tenantField); field != nil {
+		return clause.Eq{
+			Column: clause.Column{Table: clause.CurrentTable, Name: field.DBName},
+			Value:  tenantID,
+		}
+	}
+	if scoper, ok := reflect.New(tx.Statement.Schema.ModelType).Interface().(TenantScoper); ok {
+		return scoper.TenantScope(tenantID)
+	}
+	return nil
+}
+
+// lookupTenantField 获取当前语句模型的租户字段（原生 SQL 或无租户字段的模型返回 nil）
+func lookupTenantField(tx *gorm.DB) *schema.Field {
+	if tx.Error != nil || tx.Statement.Schema == nil || tx.Statement.SQL.Len() > 0 {
+		return nil
+	}
+	return tx.Statement.Schema.LookUpField(tenantField)
+}
@@ -0,0 +1,27 @@
+package gorm
+
+import (
+	"context"
+
+	"config-client/share/repository"
+
+	"gorm.io/gorm"
+)
+
+// TransactionManager 基于 GORM 的事务管理器
+// 事务通过上下文传递，使用 GetDB 获取连接的仓储实现均可参与同一事务
+type TransactionManager struct {
+	db *gorm.DB
+}
+
+// NewTransactionManager 创建事务管理器
+func NewTransactionManager(db *gorm.DB) *TransactionManager {
+	return &TransactionManager{db: db}
+}
+
+// RunInTx 实现 repository.TransactionManager 接口
+func (m *TransactionManager) RunInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return RunInTx(ctx, m.db, fn)
+}
+
+var _ repository.TransactionManager = (*TransactionManager)(nil)
@@ -6,6 +6,7 @@ type PageRequest struct {
 	Size       int          `json:"size"`       // 每页数量
 	Conditions []*Condition `json:"conditions"` // 查询条件列表
 	OrderBy    []OrderBy    `json:"order_by"`   // 排序规则
+	Cursor     *Cursor      `json:"cursor"`     // 游标位置（非 nil 时使用游标分页，忽略 Page；零值表示第一页）
 }
 
 // OrderBy 排序规则
@@ -54,6 +55,9 @@ type PageResult[T any] struct {
 	Page       int   `json:"page"`        // 当前页码
 	Size       int   `json:"size"`        // 每页数量
 	TotalPages int   `json:"total_pages"` // 总页数
+
+	// NextCursor 下一页游标（仅游标分页返回，为空表示没有下一页）
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // NewPageResult 创建分页结果
@@ -71,8 +75,23 @@ func NewPageResult[T any](items []T, total int64, page, size int) *PageResult[T]
 	}
 }
 
+// NewCursorPageResult 创建游标分页结果
+// items 为按游标顺序多查询一条（size+1）的结果，多出的一条只用于判断是否还有下一页；
+// 游标分页不统计总数，Total 与 TotalPages 为 0
+func NewCursorPageResult[T any](items []T, size int, cursorOf func(T) Cursor) *PageResult[T] {
+	result := &PageResult[T]{Items: items, Size: size}
+	if size > 0 && len(items) > size {
+		result.Items = items[:size]
+		result.NextCursor = cursorOf(result.Items[size-1]).Encode()
+	}
+	return result
+}
+
 // HasNext 是否有下一页
 func (p *PageResult[T]) HasNext() bool {
+	if p.NextCursor != "" {
+		return true
+	}
 	return p.Page < p.TotalPages
 }
 
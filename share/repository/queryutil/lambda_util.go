@@ -4,6 +4,8 @@ import (
 	"reflect"
 	"strings"
 	"sync"
+
+	"config-client/share/repository"
 )
 
 // EntityFields 实体字段查询构建器（通用类型）
@@ -137,6 +139,16 @@ func (ef *EntityFields[T]) Get(fieldName string) *FieldQuery {
 	panic("field " + fieldName + " not found in entity")
 }
 
+// SortFields 根据字段名构建排序白名单（API 字段名与列名相同）
+func (ef *EntityFields[T]) SortFields(fieldNames ...string) repository.SortFields {
+	sortFields := make(repository.SortFields, len(fieldNames))
+	for _, name := range fieldNames {
+		column := ef.Get(name).GetColumnName()
+		sortFields[column] = column
+	}
+	return sortFields
+}
+
 // toSnakeCase 将驼峰命名转换为下划线命名
 func toSnakeCase(s string) string {
 	var result strings.Builder
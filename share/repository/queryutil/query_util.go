@@ -232,6 +232,11 @@ func ApplyCondition(db *gorm.DB, condition *repository.Condition) *gorm.DB {
 	return gormRepo.ApplyCondition(db, condition)
 }
 
+// ApplyCursor 应用游标分页条件（按 created_at DESC, id DESC 排序，查询 size+1 条）
+func ApplyCursor(db *gorm.DB, createdAtColumn, idColumn string, cursor repository.Cursor, size int) *gorm.DB {
+	return gormRepo.ApplyCursor(db, createdAtColumn, idColumn, cursor, size)
+}
+
 // ApplyOrderBys 批量应用排序规则
 func ApplyOrderBys(db *gorm.DB, orderBys []repository.OrderBy) *gorm.DB {
 	return gormRepo.ApplyOrderBys(db, orderBys)
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"sort"
+	"strings"
+
+	"config-client/share/errors"
+)
+
+// SortFields 排序字段白名单（API 字段名 → 数据库列名）
+// 仓储按白名单解析调用方传入的排序表达式，避免将原始字符串直接拼入 ORDER BY
+type SortFields map[string]string
+
+// ParseOrderBy 解析排序表达式，例如 "created_at desc, key"
+// 每项格式为 "字段 [asc|desc]"（不区分大小写，默认升序），字段必须在白名单内，否则返回 400 错误
+func (f SortFields) ParseOrderBy(orderBy string) ([]OrderBy, error) {
+	var orderBys []OrderBy
+	for _, item := range strings.Split(orderBy, ",") {
+		parts := strings.Fields(item)
+		if len(parts) == 0 {
+			continue
+		}
+		if len(parts) > 2 {
+			return nil, errors.ErrBadRequest("排序表达式无效: " + strings.TrimSpace(item))
+		}
+
+		column, ok := f[strings.ToLower(parts[0])]
+		if !ok {
+			return nil, errors.ErrBadRequest("不支持的排序字段: " + parts[0] + "，可选字段: " + strings.Join(f.Names(), ", "))
+		}
+
+		desc := false
+		if len(parts) == 2 {
+			switch strings.ToLower(parts[1]) {
+			case "asc":
+			case "desc":
+				desc = true
+			default:
+				return nil, errors.ErrBadRequest("排序方向无效: " + parts[1] + "，可选: asc, desc")
+			}
+		}
+		orderBys = append(orderBys, OrderBy{Field: column, Desc: desc})
+	}
+	return orderBys, nil
+}
+
+// Names 白名单中的 API 字段名（按字母排序）
+func (f SortFields) Names() []string {
+	names := make([]string, 0, len(f))
+	for name := range f {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,31 @@
+// Package tenant 多租户上下文
+// 请求的租户由认证主体解析后放入上下文，仓储层据此自动过滤和填充 tenant_id（见 share/repository/gorm.RegisterTenantCallbacks）
+package tenant
+
+import (
+	"context"
+
+	"config-client/share/constants"
+)
+
+// DefaultTenantID 默认租户ID（系统租户）
+// 未启用认证时所有请求属于默认租户；默认租户的管理员可以管理租户，并通过 X-Tenant-ID 请求头代其他租户操作
+const DefaultTenantID = 1
+
+// WithTenant 将租户ID放入上下文
+func WithTenant(ctx context.Context, tenantID int) context.Context {
+	return context.WithValue(ctx, constants.TenantIDKey, tenantID)
+}
+
+// FromContext 获取上下文中的租户ID
+// 后台任务（发件箱投递、过期清理等）的上下文不携带租户，ok 为 false，此时仓储层不做租户过滤
+func FromContext(ctx context.Context) (tenantID int, ok bool) {
+	tenantID, ok = ctx.Value(constants.TenantIDKey).(int)
+	return tenantID, ok && tenantID > 0
+}
+
+// IsSystem 上下文是否属于默认租户（或未携带租户的后台任务）
+func IsSystem(ctx context.Context) bool {
+	tenantID, ok := FromContext(ctx)
+	return !ok || tenantID == DefaultTenantID
+}
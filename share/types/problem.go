@@ -0,0 +1,14 @@
+package types
+
+// Problem RFC 7807 错误响应（Content-Type: application/problem+json）
+// 请求头 Accept 优先接受 application/problem+json 时代替统一响应结构返回；code、errors 为扩展成员，与统一响应中的同名字段一致
+type Problem struct {
+	Type     string `json:"type"`               // 问题类型 URI（每个错误码对应一个类型）
+	Title    string `json:"title"`              // 问题类型摘要（HTTP 状态码说明）
+	Status   int    `json:"status"`             // HTTP 状态码
+	Detail   string `json:"detail,omitempty"`   // 本次错误的说明（按 Accept-Language 选择语言）
+	Instance string `json:"instance,omitempty"` // 本次请求的请求ID（与 X-Request-ID 响应头一致）
+
+	Code   int          `json:"code"`             // 业务错误码
+	Errors []FieldError `json:"errors,omitempty"` // 请求参数校验失败的字段明细（仅参数校验错误返回）
+}
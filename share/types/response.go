@@ -6,6 +6,19 @@ type Response struct {
 	Message string      `json:"message"`
 	Data    interface{} `json:"data,omitempty"`
 	TraceID string      `json:"trace_id,omitempty"`
+
+	// 请求参数校验失败的字段明细（仅参数校验错误返回）
+	Errors []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError 请求参数校验失败的字段明细
+type FieldError struct {
+	Field   string `json:"field"`           // 参数名（嵌套参数以点号分隔，如 items[0].key）
+	Rule    string `json:"rule"`            // 未通过的校验规则，如 required、max、oneof
+	Param   string `json:"param,omitempty"` // 校验规则参数，如 max=255 中的 255
+	Message string `json:"message"`         // 错误说明
+
+	Variant string `json:"-"` // 错误说明的消息变体（用于生成其他语言的错误说明）
 }
 
 // Success 成功响应
@@ -0,0 +1,36 @@
+package validation
+
+import "config-client/share/errors"
+
+// 字段校验错误说明
+// 模板参数：{field} 参数名、{rule} 校验规则、{param} 校验规则参数
+func init() {
+	errors.RegisterCatalog(errors.LanguageZH, fieldMessagesZH)
+	errors.RegisterCatalog(errors.LanguageEN, fieldMessagesEN)
+}
+
+// fieldMessagesZH 中文字段校验错误说明
+var fieldMessagesZH = errors.Catalog{
+	{Code: errors.BadRequest, Variant: "field.required"}:   "{field} 不能为空",
+	{Code: errors.BadRequest, Variant: "field.min"}:        "{field} 不能小于 {param}",
+	{Code: errors.BadRequest, Variant: "field.max"}:        "{field} 不能大于 {param}",
+	{Code: errors.BadRequest, Variant: "field.len"}:        "{field} 必须等于 {param}",
+	{Code: errors.BadRequest, Variant: "field.min_length"}: "{field} 长度不能小于 {param}",
+	{Code: errors.BadRequest, Variant: "field.max_length"}: "{field} 长度不能超过 {param}",
+	{Code: errors.BadRequest, Variant: "field.len_length"}: "{field} 长度必须为 {param}",
+	{Code: errors.BadRequest, Variant: "field.oneof"}:      "{field} 必须是以下值之一: {param}",
+	{Code: errors.BadRequest, Variant: "field.rule"}:       "{field} 不符合校验规则 {rule}",
+}
+
+// fieldMessagesEN 英文字段校验错误说明
+var fieldMessagesEN = errors.Catalog{
+	{Code: errors.BadRequest, Variant: "field.required"}:   "{field} is required",
+	{Code: errors.BadRequest, Variant: "field.min"}:        "{field} must be at least {param}",
+	{Code: errors.BadRequest, Variant: "field.max"}:        "{field} must be at most {param}",
+	{Code: errors.BadRequest, Variant: "field.len"}:        "{field} must be {param}",
+	{Code: errors.BadRequest, Variant: "field.min_length"}: "{field} must contain at least {param} characters or items",
+	{Code: errors.BadRequest, Variant: "field.max_length"}: "{field} must contain at most {param} characters or items",
+	{Code: errors.BadRequest, Variant: "field.len_length"}: "{field} must contain exactly {param} characters or items",
+	{Code: errors.BadRequest, Variant: "field.oneof"}:      "{field} must be one of: {param}",
+	{Code: errors.BadRequest, Variant: "field.rule"}:       "{field} does not satisfy the {rule} rule",
+}
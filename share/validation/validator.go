@@ -0,0 +1,122 @@
+// Package validation 请求参数校验
+// 按请求 DTO 的 binding 标签（go-playground/validator 规则）校验请求参数，校验失败时返回包含字段明细的 400 错误
+package validation
+
+import (
+	"reflect"
+	"strings"
+
+	"config-client/share/errors"
+	"config-client/share/types"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ValidateTag 校验规则标签
+const ValidateTag = "binding"
+
+// Validator 请求参数校验器，实现 Hertz 的 binding.StructValidator 接口
+// 通过 server.WithCustomValidator 注册后，c.BindAndValidate 绑定参数后按 binding 标签校验
+type Validator struct {
+	validate *validator.Validate
+}
+
+// NewValidator 创建请求参数校验器
+// 字段明细中的参数名依次取 json、form、query、path 标签，与客户端传参时使用的名称一致
+func NewValidator() *Validator {
+	validate := validator.New()
+	validate.SetTagName(ValidateTag)
+	validate.RegisterTagNameFunc(fieldName)
+	return &Validator{validate: validate}
+}
+
+// ValidateStruct 校验请求参数，校验失败时返回 errors.ErrValidation
+func (v *Validator) ValidateStruct(obj interface{}) error {
+	// Hertz 绑定后传入的是结构体的 reflect.Value
+	if value, ok := obj.(reflect.Value); ok {
+		if value.CanAddr() {
+			value = value.Addr()
+		}
+		obj = value.Interface()
+	}
+
+	err := v.validate.Struct(obj)
+	if err == nil {
+		return nil
+	}
+	validationErrors, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return errors.ErrBadRequest("请求参数校验失败: " + err.Error())
+	}
+	return errors.ErrValidation(FieldErrors(validationErrors))
+}
+
+// Engine 实现 binding.StructValidator 接口
+func (v *Validator) Engine() interface{} {
+	return v.validate
+}
+
+// ValidateTag 实现 binding.StructValidator 接口
+func (v *Validator) ValidateTag() string {
+	return ValidateTag
+}
+
+// FieldErrors 转换为响应中的字段明细
+func FieldErrors(validationErrors validator.ValidationErrors) []types.FieldError {
+	fields := make([]types.FieldError, 0, len(validationErrors))
+	for _, fe := range validationErrors {
+		field := types.FieldError{
+			Field:   fieldPath(fe.Namespace()),
+			Rule:    fe.Tag(),
+			Param:   fe.Param(),
+			Variant: messageVariant(fe),
+		}
+		params := errors.Params{"field": field.Field, "rule": field.Rule, "param": field.Param}
+		message, ok := errors.Message(errors.LanguageZH, errors.MessageKey{Code: errors.BadRequest, Variant: field.Variant}, params)
+		if !ok {
+			field.Variant = fieldMessageVariant("rule")
+			message, _ = errors.Message(errors.LanguageZH, errors.MessageKey{Code: errors.BadRequest, Variant: field.Variant}, params)
+		}
+		field.Message = message
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// fieldName 字段在请求中的参数名（忽略标签选项，标签为 "-" 时使用字段名）
+func fieldName(field reflect.StructField) string {
+	for _, tag := range []string{"json", "form", "query", "path"} {
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+	return field.Name
+}
+
+// fieldPath 去掉命名空间中的顶层结构体名称（如 CreateConfigRequest.tags[0].tag_key -> tags[0].tag_key）
+func fieldPath(namespace string) string {
+	if _, path, ok := strings.Cut(namespace, "."); ok {
+		return path
+	}
+	return namespace
+}
+
+// messageVariant 字段错误说明的消息变体
+// min、max、len 对字符串、切片和映射按长度校验，使用单独的说明
+func messageVariant(fe validator.FieldError) string {
+	rule := fe.Tag()
+	switch rule {
+	case "min", "max", "len":
+		switch fe.Kind() {
+		case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+			rule += "_length"
+		}
+	}
+	return fieldMessageVariant(rule)
+}
+
+// fieldMessageVariant 字段错误说明在消息目录中的变体名称
+func fieldMessageVariant(rule string) string {
+	return "field." + rule
+}
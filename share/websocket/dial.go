@@ -0,0 +1,129 @@
+package websocket
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// HandshakeError 服务端拒绝握手（未返回 101）
+type HandshakeError struct {
+	StatusCode int         // 响应状态码
+	Header     http.Header // 响应头（如 Retry-After）
+	Body       string      // 响应体（最多 4KB）
+}
+
+func (e *HandshakeError) Error() string {
+	return fmt.Sprintf("WebSocket 握手失败: status=%d, body=%s", e.StatusCode, e.Body)
+}
+
+// Dial 按请求建立 WebSocket 连接
+// req 为 GET 请求（地址为 http:// 或 https://，也接受 ws:// 和 wss://），可预先设置认证等请求头；
+// 请求的上下文作用于建立连接和握手，握手完成后不再影响连接。服务端未返回 101 时返回 *HandshakeError
+func Dial(req *http.Request) (*Conn, error) {
+	ctx := req.Context()
+	u := *req.URL
+	switch u.Scheme {
+	case "ws":
+		u.Scheme = "http"
+	case "wss":
+		u.Scheme = "https"
+	}
+	req = req.Clone(ctx)
+	req.URL = &u
+	req.Host = u.Host
+
+	// 1. 建立 TCP（及 TLS）连接
+	addr := u.Host
+	if u.Port() == "" {
+		if u.Scheme == "https" {
+			addr = net.JoinHostPort(u.Hostname(), "443")
+		} else {
+			addr = net.JoinHostPort(u.Hostname(), "80")
+		}
+	}
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	// 上下文取消时中断握手
+	tcpConn := conn
+	stop := context.AfterFunc(ctx, func() { tcpConn.SetDeadline(time.Now()) })
+	defer stop()
+
+	if u.Scheme == "https" {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: u.Hostname()})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+
+	// 2. 发送握手请求
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	key := base64.StdEncoding.EncodeToString(nonce)
+	req.Method = http.MethodGet
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Sec-WebSocket-Key", key)
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	// 3. 校验握手响应
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		resp.Body.Close()
+		conn.Close()
+		return nil, &HandshakeError{StatusCode: resp.StatusCode, Header: resp.Header, Body: string(body)}
+	}
+	if !strings.EqualFold(resp.Header.Get("Upgrade"), "websocket") ||
+		!HeaderContainsToken(resp.Header.Get("Connection"), "upgrade") ||
+		resp.Header.Get("Sec-WebSocket-Accept") != AcceptKey(key) {
+		conn.Close()
+		return nil, fmt.Errorf("WebSocket 握手失败: 响应头不正确")
+	}
+
+	// 握手完成后上下文不再影响连接（上下文已取消时放弃连接）
+	if !stop() {
+		conn.Close()
+		return nil, ctx.Err()
+	}
+	conn.SetDeadline(time.Time{})
+	return NewConn(conn, reader, true), nil
+}
+
+// HeaderContainsToken 逗号分隔的请求头中是否包含指定值（不区分大小写），如 Connection: keep-alive, Upgrade
+func HeaderContainsToken(header, token string) bool {
+	for _, value := range strings.Split(header, ",") {
+		if strings.EqualFold(strings.TrimSpace(value), token) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,266 @@
+// Package websocket 配置监听使用的最小 WebSocket 实现（RFC 6455）
+// 只支持监听长连接需要的功能：握手、文本和二进制消息（支持分片）、ping/pong 和关闭帧，不支持扩展和子协议
+package websocket
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// 帧类型
+const (
+	OpContinuation = 0x0
+	OpText         = 0x1
+	OpBinary       = 0x2
+	OpClose        = 0x8
+	OpPing         = 0x9
+	OpPong         = 0xA
+)
+
+// CloseNormal 正常关闭的关闭码
+const CloseNormal = 1000
+
+// MaxMessageSize 单条消息（包括分片合并后）的最大长度
+const MaxMessageSize = 16 << 20
+
+// writeTimeout 单次写入的超时时间（对端不读取时避免写入永久阻塞）
+const writeTimeout = 10 * time.Second
+
+// acceptGUID 计算 Sec-WebSocket-Accept 使用的固定 GUID
+const acceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// ErrClosed 对端已关闭连接（收到关闭帧）
+var ErrClosed = errors.New("WebSocket 连接已关闭")
+
+// AcceptKey 根据握手请求的 Sec-WebSocket-Key 计算响应的 Sec-WebSocket-Accept
+func AcceptKey(key string) string {
+	sum := sha1.Sum([]byte(key + acceptGUID))
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// timeoutConn 以超时时间（而不是截止时间）控制读写的连接，如 Hertz 基于 netpoll 的连接（不支持 SetDeadline）
+type timeoutConn interface {
+	SetReadTimeout(timeout time.Duration) error
+	SetWriteTimeout(timeout time.Duration) error
+}
+
+// Conn 已完成握手的 WebSocket 连接
+// 读取需在单个协程中进行，写入（消息、ping、关闭）可在多个协程中并发调用
+type Conn struct {
+	conn    net.Conn
+	reader  *bufio.Reader
+	client  bool        // 客户端发送的帧需要掩码，服务端发送的帧不能掩码
+	timeout timeoutConn // 底层连接以超时时间控制读写时不为 nil
+
+	readTimeout time.Duration // 读取超时（为 0 时不超时）
+
+	writeMu   sync.Mutex
+	closeOnce sync.Once
+}
+
+// NewConn 包装已完成握手的连接，reader 为握手时使用的缓冲读取器（可能已缓冲对端发送的帧），为 nil 时新建
+func NewConn(conn net.Conn, reader *bufio.Reader, client bool) *Conn {
+	if reader == nil {
+		reader = bufio.NewReader(conn)
+	}
+	c := &Conn{conn: conn, reader: reader, client: client}
+	if timeout, ok := conn.(timeoutConn); ok {
+		c.timeout = timeout
+		timeout.SetWriteTimeout(writeTimeout)
+	}
+	return c
+}
+
+// SetReadTimeout 设置读取超时：超过该时间未收到任何帧（包括 ping 和 pong）时 ReadMessage 返回错误，用于发现失效的连接
+func (c *Conn) SetReadTimeout(timeout time.Duration) {
+	c.readTimeout = timeout
+	if c.timeout != nil {
+		c.timeout.SetReadTimeout(timeout)
+	}
+}
+
+// ReadMessage 读取一条文本或二进制消息，返回帧类型和合并分片后的内容
+// 收到 ping 时自动回复 pong，pong 只用于刷新读取超时；收到关闭帧时回复关闭帧并返回 ErrClosed
+func (c *Conn) ReadMessage() (int, []byte, error) {
+	opcode := 0
+	var message []byte
+	for {
+		if c.readTimeout > 0 && c.timeout == nil {
+			if err := c.conn.SetReadDeadline(time.Now().Add(c.readTimeout)); err != nil {
+				return 0, nil, err
+			}
+		}
+		fin, op, payload, err := c.readFrame()
+		if err != nil {
+			return 0, nil, err
+		}
+
+		switch op {
+		case OpPing:
+			if err := c.writeFrame(OpPong, payload); err != nil {
+				return 0, nil, err
+			}
+			continue
+		case OpPong:
+			continue
+		case OpClose:
+			c.writeFrame(OpClose, payload[:min(len(payload), 2)])
+			return 0, nil, ErrClosed
+		case OpContinuation:
+			if opcode == 0 {
+				return 0, nil, errors.New("WebSocket 协议错误: 未开始的分片消息")
+			}
+		case OpText, OpBinary:
+			if opcode != 0 {
+				return 0, nil, errors.New("WebSocket 协议错误: 分片消息未结束")
+			}
+			opcode = op
+		default:
+			return 0, nil, fmt.Errorf("WebSocket 协议错误: 未知的帧类型 %d", op)
+		}
+
+		if len(message)+len(payload) > MaxMessageSize {
+			return 0, nil, fmt.Errorf("WebSocket 消息超过 %d 字节", MaxMessageSize)
+		}
+		message = append(message, payload...)
+		if fin {
+			return opcode, message, nil
+		}
+	}
+}
+
+// WriteMessage 发送一条文本或二进制消息（不分片）
+func (c *Conn) WriteMessage(opcode int, data []byte) error {
+	return c.writeFrame(opcode, data)
+}
+
+// WritePing 发送 ping（对端回复 pong，用于保活和发现失效的连接）
+func (c *Conn) WritePing() error {
+	return c.writeFrame(OpPing, nil)
+}
+
+// Close 发送关闭帧（尽力而为）后关闭底层连接，可重复调用
+func (c *Conn) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		payload := make([]byte, 2)
+		binary.BigEndian.PutUint16(payload, CloseNormal)
+		c.writeFrame(OpClose, payload)
+		err = c.conn.Close()
+	})
+	return err
+}
+
+// readFrame 读取一帧，返回是否为最后一个分片、帧类型和（已去掩码的）内容
+func (c *Conn) readFrame() (bool, int, []byte, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(c.reader, header[:]); err != nil {
+		return false, 0, nil, err
+	}
+	fin := header[0]&0x80 != 0
+	if header[0]&0x70 != 0 {
+		return false, 0, nil, errors.New("WebSocket 协议错误: 不支持扩展")
+	}
+	op := int(header[0] & 0x0F)
+	masked := header[1]&0x80 != 0
+	if masked == c.client {
+		return false, 0, nil, errors.New("WebSocket 协议错误: 帧掩码不正确")
+	}
+
+	length := uint64(header[1] & 0x7F)
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(c.reader, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(c.reader, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+	if op >= OpClose && (!fin || length > 125) {
+		return false, 0, nil, errors.New("WebSocket 协议错误: 控制帧不能分片或超过 125 字节")
+	}
+	if length > MaxMessageSize {
+		return false, 0, nil, fmt.Errorf("WebSocket 消息超过 %d 字节", MaxMessageSize)
+	}
+
+	var maskKey [4]byte
+	if masked {
+		if _, err := io.ReadFull(c.reader, maskKey[:]); err != nil {
+			return false, 0, nil, err
+		}
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.reader, payload); err != nil {
+		return false, 0, nil, err
+	}
+	if masked {
+		maskBytes(payload, maskKey)
+	}
+	return fin, op, payload, nil
+}
+
+// writeFrame 发送一帧（客户端发送时加掩码）
+func (c *Conn) writeFrame(op int, payload []byte) error {
+	frame := make([]byte, 0, 14+len(payload))
+	frame = append(frame, 0x80|byte(op))
+
+	var maskBit byte
+	if c.client {
+		maskBit = 0x80
+	}
+	switch length := len(payload); {
+	case length <= 125:
+		frame = append(frame, maskBit|byte(length))
+	case length <= 0xFFFF:
+		frame = append(frame, maskBit|126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(length))
+	default:
+		frame = append(frame, maskBit|127)
+		frame = binary.BigEndian.AppendUint64(frame, uint64(length))
+	}
+
+	if c.client {
+		var maskKey [4]byte
+		if _, err := rand.Read(maskKey[:]); err != nil {
+			return err
+		}
+		frame = append(frame, maskKey[:]...)
+		start := len(frame)
+		frame = append(frame, payload...)
+		maskBytes(frame[start:], maskKey)
+	} else {
+		frame = append(frame, payload...)
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if c.timeout == nil {
+		if err := c.conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+			return err
+		}
+	}
+	_, err := c.conn.Write(frame)
+	return err
+}
+
+// maskBytes 按掩码键对内容做异或（加掩码和去掩码相同）
+func maskBytes(data []byte, key [4]byte) {
+	for i := range data {
+		data[i] ^= key[i%4]
+	}
+}